@@ -0,0 +1,108 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute
+
+import "reflect"
+
+// maxBuilderSets is the maximum number of Sets a SetBuilder keeps to reuse.
+const maxBuilderSets = 128
+
+// SetBuilder builds Sets while reusing its memory and the Sets it previously
+// built. It is meant for hot paths creating Sets from attributes only known
+// when a measurement is recorded, where [NewSet] would allocate for every
+// call.
+//
+// The attributes of the Set to build are added with Add. Set returns the Set
+// of the added attributes and Reset removes them so the SetBuilder can be
+// reused. If the SetBuilder previously built an equal Set, that Set is
+// returned without allocating. Up to 128 distinct Sets are kept to be reused.
+//
+// A SetBuilder must not be used concurrently. Use a SetBuilder per goroutine
+// or a [sync.Pool] of SetBuilders for concurrent use. The zero value is ready
+// to use.
+type SetBuilder struct {
+	kvs  []KeyValue
+	sets map[uint64]Set
+}
+
+// Add adds kvs to the attributes of the Set being built. As with [NewSet],
+// the last value added for a key is used.
+func (b *SetBuilder) Add(kvs ...KeyValue) {
+	b.kvs = append(b.kvs, kvs...)
+}
+
+// Len returns the number of attributes added, including the duplicate keys.
+func (b *SetBuilder) Len() int {
+	return len(b.kvs)
+}
+
+// Reset removes all the added attributes. The memory of the SetBuilder and
+// the Sets it built are kept to be reused.
+func (b *SetBuilder) Reset() {
+	clear(b.kvs) // Let GC collect the referenced values.
+	b.kvs = b.kvs[:0]
+}
+
+// Set returns the Set of the added attributes. It is equal to the Set
+// returned by [NewSet] for the same attributes.
+//
+// The added attributes are kept and more can be added to build another Set.
+func (b *SetBuilder) Set() Set {
+	if len(b.kvs) == 0 {
+		return emptySet
+	}
+
+	kvs := sortDedup(b.kvs)
+	hash := hashKVs(kvs)
+	if s, ok := b.sets[hash]; ok && equalData(s.data, kvs) {
+		return s
+	}
+
+	// newSet copies kvs so the Set does not share the memory of b.
+	s := newSet(kvs)
+	if b.sets == nil {
+		b.sets = make(map[uint64]Set)
+	} else if len(b.sets) >= maxBuilderSets {
+		clear(b.sets)
+	}
+	b.sets[hash] = s
+	return s
+}
+
+// equalData reports whether data, the data of a Set, holds kvs.
+func equalData(data any, kvs []KeyValue) bool {
+	switch d := data.(type) {
+	case [1]KeyValue:
+		return len(kvs) == 1 && d == [1]KeyValue(kvs)
+	case [2]KeyValue:
+		return len(kvs) == 2 && d == [2]KeyValue(kvs)
+	case [3]KeyValue:
+		return len(kvs) == 3 && d == [3]KeyValue(kvs)
+	case [4]KeyValue:
+		return len(kvs) == 4 && d == [4]KeyValue(kvs)
+	case [5]KeyValue:
+		return len(kvs) == 5 && d == [5]KeyValue(kvs)
+	case [6]KeyValue:
+		return len(kvs) == 6 && d == [6]KeyValue(kvs)
+	case [7]KeyValue:
+		return len(kvs) == 7 && d == [7]KeyValue(kvs)
+	case [8]KeyValue:
+		return len(kvs) == 8 && d == [8]KeyValue(kvs)
+	case [9]KeyValue:
+		return len(kvs) == 9 && d == [9]KeyValue(kvs)
+	case [10]KeyValue:
+		return len(kvs) == 10 && d == [10]KeyValue(kvs)
+	}
+
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Array || v.Len() != len(kvs) {
+		return false
+	}
+	for i, kv := range kvs {
+		if v.Index(i).Interface().(KeyValue) != kv {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package attribute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestSetBuilder(t *testing.T) {
+	tests := [][]attribute.KeyValue{
+		nil,
+		{attribute.String("A", "B")},
+		{attribute.String("C", "D"), attribute.String("A", "B")},
+		{attribute.String("A", "1"), attribute.Int("C", 2), attribute.String("A", "B")},
+		{attribute.Bool("b", true), attribute.Int64Slice("a", []int64{1, 2})},
+	}
+	// Sets larger than the fixed size arrays.
+	var large []attribute.KeyValue
+	for i := range 12 {
+		large = append(large, attribute.Int(fmt.Sprintf("k%02d", 11-i), i))
+	}
+	tests = append(tests, large)
+
+	var b attribute.SetBuilder
+	for _, kvs := range tests {
+		for range 2 {
+			b.Reset()
+			b.Add(kvs...)
+			assert.Equal(t, len(kvs), b.Len())
+
+			want := attribute.NewSet(append([]attribute.KeyValue(nil), kvs...)...)
+			got := b.Set()
+			assert.True(t, want.Equals(&got), "%v != %v", want.ToSlice(), got.ToSlice())
+			assert.Equal(t, want.Equivalent(), got.Equivalent())
+		}
+	}
+}
+
+func TestSetBuilderSetIsImmutable(t *testing.T) {
+	var b attribute.SetBuilder
+	b.Add(attribute.String("A", "B"))
+	s := b.Set()
+
+	b.Reset()
+	b.Add(attribute.String("A", "C"))
+	_ = b.Set()
+
+	v, ok := s.Value("A")
+	assert.True(t, ok)
+	assert.Equal(t, "B", v.AsString())
+}
+
+func TestSetBuilderAddAfterSet(t *testing.T) {
+	var b attribute.SetBuilder
+	b.Add(attribute.String("B", "1"), attribute.String("A", "1"))
+	_ = b.Set()
+	b.Add(attribute.String("A", "2"))
+
+	got := b.Set()
+	want := attribute.NewSet(attribute.String("A", "2"), attribute.String("B", "1"))
+	assert.True(t, want.Equals(&got), "%v != %v", want.ToSlice(), got.ToSlice())
+}
+
+func TestSetBuilderAllocs(t *testing.T) {
+	kvs := []attribute.KeyValue{
+		attribute.String("method", "GET"),
+		attribute.Int("status", 200),
+		attribute.String("route", "/"),
+		attribute.Bool("ok", true),
+		attribute.String("host", "localhost"),
+		attribute.String("scheme", "http"),
+	}
+
+	var b attribute.SetBuilder
+	b.Add(kvs...)
+	_ = b.Set()
+	b.Reset()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		b.Add(kvs...)
+		_ = b.Set()
+		b.Reset()
+	})
+	assert.Zero(t, allocs, "building a Set previously built allocates")
+}
+
+func BenchmarkSetBuilder(b *testing.B) {
+	for _, n := range []int{4, 8} {
+		kvs := make([]attribute.KeyValue, n)
+		for i := range kvs {
+			kvs[i] = attribute.Int(fmt.Sprintf("key%d", n-i), i)
+		}
+
+		b.Run(fmt.Sprintf("NewSet/%d", n), func(b *testing.B) {
+			cp := make([]attribute.KeyValue, n)
+			b.ReportAllocs()
+			for b.Loop() {
+				copy(cp, kvs)
+				_ = attribute.NewSet(cp...)
+			}
+		})
+
+		b.Run(fmt.Sprintf("SetBuilder/%d", n), func(b *testing.B) {
+			var builder attribute.SetBuilder
+			b.ReportAllocs()
+			for b.Loop() {
+				builder.Reset()
+				builder.Add(kvs...)
+				_ = builder.Set()
+			}
+		})
+	}
+}
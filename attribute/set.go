@@ -233,11 +233,26 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 		return emptySet, nil
 	}
 
+	kvs = sortDedup(kvs)
+
+	if filter != nil {
+		if div := filteredToFront(kvs, filter); div != 0 {
+			return newSet(kvs[div:]), kvs[:div]
+		}
+	}
+	return newSet(kvs), nil
+}
+
+// sortDedup sorts kvs by key and de-duplicates it with last-value-wins
+// semantics in-place. The unique values are returned, they are contiguous at
+// the end of kvs while the overwritten values are moved to its beginning.
+func sortDedup(kvs []KeyValue) []KeyValue {
 	// Stable sort so the following de-duplication can implement
-	// last-value-wins semantics.
-	slices.SortStableFunc(kvs, func(a, b KeyValue) int {
-		return cmp.Compare(a.Key, b.Key)
-	})
+	// last-value-wins semantics. Skip sorting attributes already sorted, as
+	// is common when they are built in a fixed order.
+	if !slices.IsSortedFunc(kvs, compareKeys) {
+		slices.SortStableFunc(kvs, compareKeys)
+	}
 
 	position := len(kvs) - 1
 	offset := position - 1
@@ -255,14 +270,12 @@ func NewSetWithFiltered(kvs []KeyValue, filter Filter) (Set, []KeyValue) {
 		position--
 		kvs[offset], kvs[position] = kvs[position], kvs[offset]
 	}
-	kvs = kvs[position:]
+	return kvs[position:]
+}
 
-	if filter != nil {
-		if div := filteredToFront(kvs, filter); div != 0 {
-			return newSet(kvs[div:]), kvs[:div]
-		}
-	}
-	return newSet(kvs), nil
+// compareKeys compares the keys of a and b.
+func compareKeys(a, b KeyValue) int {
+	return cmp.Compare(a.Key, b.Key)
 }
 
 // NewSetWithSortableFiltered returns a new Set.
@@ -294,6 +307,41 @@ func NewSetWithSortableFiltered(kvs []KeyValue, _ *Sortable, filter Filter) (Set
 	return NewSetWithFiltered(kvs, filter)
 }
 
+// NewSetFromSortedStringPairs returns a new Set of string attributes from
+// pairs, an alternating list of keys and values (i.e. key1, value1, key2,
+// value2, ...). If pairs has an odd length, the last key has no value and is
+// dropped.
+//
+// This is an advanced constructor for hot paths where the caller already
+// guarantees the attributes are valid. The keys must be sorted in increasing
+// order and unique. Unlike [NewSet], no sorting or de-duplication is done and
+// the keys and values are referenced as is, not copied. If the keys are not
+// sorted and unique, the returned Set is invalid: it will not be equal to an
+// equivalent Set created with [NewSet] and its lookups may fail.
+//
+// Use [NewSet] unless benchmarks show the construction of a Set is a
+// bottleneck.
+func NewSetFromSortedStringPairs(pairs ...string) Set {
+	// A trailing key without a value is dropped.
+	n := len(pairs) / 2
+	if n == 0 {
+		return emptySet
+	}
+
+	// Small sets are built on the stack and copied into the Set data.
+	var buf [10]KeyValue
+	var kvs []KeyValue
+	if n <= len(buf) {
+		kvs = buf[:n]
+	} else {
+		kvs = make([]KeyValue, n)
+	}
+	for i := range kvs {
+		kvs[i] = KeyValue{Key: Key(pairs[2*i]), Value: StringValue(pairs[2*i+1])}
+	}
+	return newSet(kvs)
+}
+
 // filteredToFront filters slice in-place using keep function. All KeyValues that need to
 // be removed are moved to the front. All KeyValues that need to be kept are
 // moved (in-order) to the back. The index for the first KeyValue to be kept is
@@ -355,6 +403,117 @@ func (l *Set) Filter(re Filter) (Set, []KeyValue) {
 	return newSet(slice[div:]), slice[:div]
 }
 
+// Union returns a Set with the attributes of l and o. The value of o is used
+// for the keys in both sets.
+//
+// The sets are merged in their key order. This avoids the copy and sort of
+// their attributes required to create the union with NewSet.
+func (l *Set) Union(o *Set) Set {
+	n, m := l.Len(), o.Len()
+	switch {
+	case m == 0:
+		return l.orEmpty()
+	case n == 0:
+		return o.orEmpty()
+	}
+
+	kvs := make([]KeyValue, 0, n+m)
+	var i, j int
+	for i < n && j < m {
+		a, _ := l.Get(i)
+		b, _ := o.Get(j)
+		switch {
+		case a.Key < b.Key:
+			kvs = append(kvs, a)
+			i++
+		case a.Key > b.Key:
+			kvs = append(kvs, b)
+			j++
+		default:
+			kvs = append(kvs, b)
+			i++
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		kv, _ := l.Get(i)
+		kvs = append(kvs, kv)
+	}
+	for ; j < m; j++ {
+		kv, _ := o.Get(j)
+		kvs = append(kvs, kv)
+	}
+	return newSet(kvs)
+}
+
+// Intersection returns a Set with the attributes of l whose key is in o.
+// The values of o are ignored.
+func (l *Set) Intersection(o *Set) Set {
+	return l.mergeKeys(o, true)
+}
+
+// Difference returns a Set with the attributes of l whose key is not in o.
+// The values of o are ignored.
+func (l *Set) Difference(o *Set) Set {
+	return l.mergeKeys(o, false)
+}
+
+// mergeKeys returns a Set with the attributes of l whose key is in o if in is
+// true, or whose key is not in o otherwise. l is returned if all its
+// attributes are kept.
+func (l *Set) mergeKeys(o *Set, in bool) Set {
+	n, m := l.Len(), o.Len()
+	if n == 0 || (m == 0 && in) {
+		return emptySet
+	}
+
+	var kvs []KeyValue
+	var j int
+	for i := range n {
+		a, _ := l.Get(i)
+		for j < m {
+			if b, _ := o.Get(j); b.Key >= a.Key {
+				break
+			}
+			j++
+		}
+		var found bool
+		if j < m {
+			b, _ := o.Get(j)
+			found = b.Key == a.Key
+		}
+		if found != in {
+			if kvs == nil {
+				// Copy the kept attributes once the first one is dropped.
+				kvs = make([]KeyValue, 0, n-1)
+				for k := range i {
+					kv, _ := l.Get(k)
+					kvs = append(kvs, kv)
+				}
+			}
+			continue
+		}
+		if kvs != nil {
+			kvs = append(kvs, a)
+		}
+	}
+	if kvs == nil {
+		return l.orEmpty()
+	}
+	if len(kvs) == 0 {
+		return emptySet
+	}
+	return newSet(kvs)
+}
+
+// orEmpty returns *l, or the empty Set if l is nil or the zero Set.
+func (l *Set) orEmpty() Set {
+	if l == nil || l.hash == 0 {
+		return emptySet
+	}
+	return *l
+}
+
 // newSet returns a new set based on the sorted and uniqued kvs.
 func newSet(kvs []KeyValue) Set {
 	s := Set{
@@ -4,6 +4,7 @@
 package attribute_test
 
 import (
+	"fmt"
 	"reflect"
 	"regexp"
 	"strings"
@@ -599,3 +600,116 @@ func BenchmarkNewSetStringAttrs(b *testing.B) {
 		})
 	}
 }
+
+func TestNewSetFromSortedStringPairs(t *testing.T) {
+	empty, want := attribute.NewSetFromSortedStringPairs(), attribute.NewSet()
+	assert.True(t, want.Equals(&empty))
+
+	for _, n := range []int{1, 10, 11} {
+		var (
+			pairs []string
+			kvs   []attribute.KeyValue
+		)
+		for i := range n {
+			k, v := fmt.Sprintf("key%02d", i), fmt.Sprintf("value%d", i)
+			pairs = append(pairs, k, v)
+			kvs = append(kvs, attribute.String(k, v))
+		}
+		want := attribute.NewSet(kvs...)
+		got := attribute.NewSetFromSortedStringPairs(pairs...)
+		assert.Truef(t, want.Equals(&got), "%d pairs", n)
+		assert.Equalf(t, want.Equivalent(), got.Equivalent(), "%d pairs", n)
+		v, ok := got.Value("key00")
+		assert.True(t, ok)
+		assert.Equal(t, "value0", v.AsString())
+	}
+
+	// A trailing key without a value is dropped.
+	got := attribute.NewSetFromSortedStringPairs("key")
+	assert.True(t, empty.Equals(&got), "single key")
+	got = attribute.NewSetFromSortedStringPairs("a", "1", "b")
+	want = attribute.NewSet(attribute.String("a", "1"))
+	assert.True(t, want.Equals(&got), "trailing key")
+}
+
+func BenchmarkNewSetFromSortedStringPairs(b *testing.B) {
+	pairs := []string{"A5", "4", "A7", "1", "B1", "2", "B3", "2", "C2", "5", "C4", "1", "C6", "3"}
+	b.ReportAllocs()
+	for b.Loop() {
+		attribute.NewSetFromSortedStringPairs(pairs...)
+	}
+}
+
+func TestSetAlgebra(t *testing.T) {
+	a := attribute.NewSet(
+		attribute.String("A", "a"),
+		attribute.String("B", "a"),
+		attribute.String("C", "a"),
+	)
+	b := attribute.NewSet(
+		attribute.String("B", "b"),
+		attribute.String("D", "b"),
+	)
+	empty := attribute.NewSet()
+
+	tests := []struct {
+		name string
+		got  attribute.Set
+		want attribute.Set
+	}{
+		{
+			name: "Union",
+			got:  a.Union(&b),
+			want: attribute.NewSet(
+				attribute.String("A", "a"),
+				attribute.String("B", "b"),
+				attribute.String("C", "a"),
+				attribute.String("D", "b"),
+			),
+		},
+		{name: "UnionEmpty", got: a.Union(&empty), want: a},
+		{name: "EmptyUnion", got: empty.Union(&b), want: b},
+		{name: "UnionNil", got: a.Union(nil), want: a},
+		{
+			name: "Intersection",
+			got:  a.Intersection(&b),
+			want: attribute.NewSet(attribute.String("B", "a")),
+		},
+		{name: "IntersectionSelf", got: a.Intersection(&a), want: a},
+		{name: "IntersectionEmpty", got: a.Intersection(&empty), want: empty},
+		{
+			name: "Difference",
+			got:  a.Difference(&b),
+			want: attribute.NewSet(
+				attribute.String("A", "a"),
+				attribute.String("C", "a"),
+			),
+		},
+		{name: "DifferenceSelf", got: a.Difference(&a), want: empty},
+		{name: "DifferenceEmpty", got: a.Difference(&empty), want: a},
+		{name: "EmptyDifference", got: empty.Difference(&a), want: empty},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want.ToSlice(), test.got.ToSlice())
+			assert.True(t, test.want.Equals(&test.got))
+			assert.Equal(t, test.want.Equivalent(), test.got.Equivalent())
+		})
+	}
+}
+
+func BenchmarkSetUnion(b *testing.B) {
+	s1 := attribute.NewSet(
+		attribute.String("http.request.method", "GET"),
+		attribute.String("http.route", "/users/:id"),
+		attribute.Int("http.response.status_code", 200),
+	)
+	s2 := attribute.NewSet(
+		attribute.String("service.name", "users"),
+		attribute.String("service.version", "1.2.3"),
+	)
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = s1.Union(&s2)
+	}
+}
@@ -33,6 +33,25 @@ var (
 	errInvalidMember   = errors.New("invalid baggage list-member")
 	errMemberNumber    = errors.New("too many list-members in baggage-string")
 	errBaggageBytes    = errors.New("baggage-string too large")
+	errInvalidUTF8     = errors.New("invalid UTF-8")
+)
+
+// InvalidUTF8Policy is the handling of the list-member values of a
+// baggage-string containing a percent-encoded invalid UTF-8 sequence by
+// [ParseWithInvalidUTF8].
+type InvalidUTF8Policy int
+
+const (
+	// InvalidUTF8Replace replaces the invalid UTF-8 sequences of the values
+	// with the replacement character U+FFFD, as recommended by the W3C
+	// Baggage specification. This is the handling of [Parse].
+	InvalidUTF8Replace InvalidUTF8Policy = iota
+	// InvalidUTF8Drop drops the list-members with an invalid UTF-8 value
+	// without returning an error.
+	InvalidUTF8Drop
+	// InvalidUTF8Error drops the list-members with an invalid UTF-8 value
+	// and returns an error, as for the other invalid list-members.
+	InvalidUTF8Error
 )
 
 // Property is an additional metadata entry for a baggage list-member.
@@ -309,8 +328,9 @@ func newInvalidMember() Member {
 
 // parseMember attempts to decode a Member from the passed string. It returns
 // an error if the input is invalid according to the W3C Baggage
-// specification.
-func parseMember(member string) (Member, error) {
+// specification. If strict, it also returns an error if the decoded value is
+// not valid UTF-8 instead of replacing the invalid sequences.
+func parseMember(member string, strict bool) (Member, error) {
 	var props properties
 	keyValue, properties, found := strings.Cut(member, propertyDelimiter)
 	if found {
@@ -347,6 +367,9 @@ func parseMember(member string) (Member, error) {
 	if err != nil {
 		return newInvalidMember(), fmt.Errorf("%w: %w", errInvalidValue, err)
 	}
+	if strict && !utf8.ValidString(unescapeVal) {
+		return newInvalidMember(), fmt.Errorf("%w: %w: %q", errInvalidValue, errInvalidUTF8, v)
+	}
 
 	value := replaceInvalidUTF8Sequences(len(rawVal), unescapeVal)
 	return Member{key: key, value: value, properties: props, hasData: true}, nil
@@ -510,6 +533,13 @@ func New(members ...Member) (Baggage, error) {
 // Invalid members are skipped and the error is returned along with the
 // partial result containing the valid members.
 func Parse(bStr string) (Baggage, error) {
+	return ParseWithInvalidUTF8(bStr, InvalidUTF8Replace)
+}
+
+// ParseWithInvalidUTF8 decodes a baggage-string the same as Parse, handling
+// the values containing a percent-encoded invalid UTF-8 sequence according
+// to policy. An unknown policy is handled as InvalidUTF8Replace.
+func ParseWithInvalidUTF8(bStr string, policy InvalidUTF8Policy) (Baggage, error) {
 	if bStr == "" {
 		return Baggage{}, nil
 	}
@@ -518,20 +548,21 @@ func Parse(bStr string) (Baggage, error) {
 		return Baggage{}, fmt.Errorf("%w: %d", errBaggageBytes, n)
 	}
 
-	b := make(baggage.List)
-	sizes := make(map[string]int) // Track per-key byte sizes
-	var totalBytes int
+	lb := newListBuilder()
 	var parseErrors int
 	var truncateErr error
 	for memberStr := range strings.SplitSeq(bStr, listDelimiter) {
 		// Check member count limit.
-		if len(b) >= maxMembers {
+		if len(lb.list) >= maxMembers {
 			truncateErr = errors.Join(truncateErr, errMemberNumber)
 			break
 		}
 
-		m, err := parseMember(memberStr)
+		m, err := parseMember(memberStr, policy == InvalidUTF8Drop || policy == InvalidUTF8Error)
 		if err != nil {
+			if policy == InvalidUTF8Drop && errors.Is(err, errInvalidUTF8) {
+				continue
+			}
 			parseErrors++
 			if parseErrors <= maxParseErrors {
 				truncateErr = errors.Join(truncateErr, err)
@@ -539,46 +570,165 @@ func Parse(bStr string) (Baggage, error) {
 			continue // skip invalid member, keep processing
 		}
 
-		// Check byte size limit.
-		// Account for comma separator between members.
-		memberBytes := len(m.String())
-		_, existingKey := b[m.key]
-		if !existingKey && len(b) > 0 {
-			memberBytes++ // comma separator only for new keys
+		if err := lb.add(m); err != nil {
+			truncateErr = errors.Join(truncateErr, err)
+			break
 		}
+	}
 
-		// Calculate new totalBytes if we add/overwrite this key
-		var newTotalBytes int
-		if oldSize, exists := sizes[m.key]; exists {
-			// Overwriting existing key: subtract old size, add new size
-			newTotalBytes = totalBytes - oldSize + memberBytes
-		} else {
-			// New key
-			newTotalBytes = totalBytes + memberBytes
+	if dropped := parseErrors - maxParseErrors; dropped > 0 {
+		truncateErr = errors.Join(truncateErr, fmt.Errorf("and %d more invalid member(s)", dropped))
+	}
+
+	return lb.baggage(), truncateErr
+}
+
+// ParseStrict decodes a baggage-string the same as Parse, but rejects the
+// whole baggage-string if any part of it does not conform to the W3C Baggage
+// specification. It is meant to check the baggage-strings produced by an
+// implementation, e.g. in tests.
+//
+// An empty Baggage and an error are returned if bStr contains an invalid
+// list-member, if it exceeds the limits of the specification, or if a value
+// contains a percent-encoded invalid UTF-8 sequence, which Parse replaces
+// with the replacement character.
+func ParseStrict(bStr string) (Baggage, error) {
+	if bStr == "" {
+		return Baggage{}, nil
+	}
+
+	if n := len(bStr); n > maxBytesPerBaggageString {
+		return Baggage{}, fmt.Errorf("%w: %d", errBaggageBytes, n)
+	}
+
+	lb := newListBuilder()
+	for memberStr := range strings.SplitSeq(bStr, listDelimiter) {
+		if len(lb.list) >= maxMembers {
+			return Baggage{}, errMemberNumber
+		}
+		m, err := parseMember(memberStr, true)
+		if err != nil {
+			return Baggage{}, err
 		}
+		if err := lb.add(m); err != nil {
+			return Baggage{}, err
+		}
+	}
+	return lb.baggage(), nil
+}
 
-		if newTotalBytes > maxBytesPerBaggageString {
-			truncateErr = errors.Join(truncateErr, errBaggageBytes)
+// DroppedMember is a part of a baggage-string dropped by ParseLenient.
+type DroppedMember struct {
+	// Member is the dropped part of the baggage-string. It is a single
+	// list-member, or all the remaining list-members if a limit was
+	// reached.
+	Member string
+	// Err is the reason Member was dropped.
+	Err error
+}
+
+// ParseLenient decodes a baggage-string salvaging as many list-members as
+// possible. It is meant to interoperate with senders that do not fully
+// conform to the W3C Baggage specification.
+//
+// The invalid list-members are dropped and the valid ones are kept. Unlike
+// Parse, a baggage-string exceeding the maximum allowed bytes (8192) is not
+// rejected: the list-members are accumulated left-to-right until 8192 bytes
+// of bStr are read, the encoded baggage would exceed 8192 bytes, or the
+// baggage contains 64 distinct keys, and the remaining list-members are
+// dropped.
+//
+// All the dropped parts of bStr are returned with the reason they were
+// dropped, in the order they appear in bStr.
+func ParseLenient(bStr string) (Baggage, []DroppedMember) {
+	if bStr == "" {
+		return Baggage{}, nil
+	}
+
+	lb := newListBuilder()
+	var dropped []DroppedMember
+	for rest, more := bStr, true; more; {
+		offset := len(bStr) - len(rest)
+		var memberStr string
+		memberStr, rest, more = strings.Cut(rest, listDelimiter)
+
+		if len(lb.list) >= maxMembers {
+			dropped = append(dropped, DroppedMember{Member: bStr[offset:], Err: errMemberNumber})
+			break
+		}
+		if offset+len(memberStr) > maxBytesPerBaggageString {
+			dropped = append(dropped, DroppedMember{Member: bStr[offset:], Err: errBaggageBytes})
 			break
 		}
 
-		// OpenTelemetry resolves duplicates by last-one-wins.
-		b[m.key] = baggage.Item{
-			Value:      m.value,
-			Properties: m.properties.asInternal(),
+		m, err := parseMember(memberStr, false)
+		if err != nil {
+			dropped = append(dropped, DroppedMember{Member: memberStr, Err: err})
+			continue
+		}
+		if err := lb.add(m); err != nil {
+			dropped = append(dropped, DroppedMember{Member: bStr[offset:], Err: err})
+			break
 		}
-		sizes[m.key] = memberBytes
-		totalBytes = newTotalBytes
 	}
+	return lb.baggage(), dropped
+}
 
-	if dropped := parseErrors - maxParseErrors; dropped > 0 {
-		truncateErr = errors.Join(truncateErr, fmt.Errorf("and %d more invalid member(s)", dropped))
+// listBuilder accumulates parsed list-members within the byte limit of the
+// W3C Baggage specification.
+type listBuilder struct {
+	list       baggage.List
+	sizes      map[string]int // Track per-key byte sizes
+	totalBytes int
+}
+
+func newListBuilder() *listBuilder {
+	return &listBuilder{
+		list:  make(baggage.List),
+		sizes: make(map[string]int),
 	}
+}
 
-	if len(b) == 0 {
-		return Baggage{}, truncateErr
+// add adds m to the list. It returns an error and does not add m if the
+// encoded list would exceed the maximum allowed bytes.
+func (lb *listBuilder) add(m Member) error {
+	// Account for comma separator between members.
+	memberBytes := len(m.String())
+	_, existingKey := lb.list[m.key]
+	if !existingKey && len(lb.list) > 0 {
+		memberBytes++ // comma separator only for new keys
+	}
+
+	// Calculate new totalBytes if we add/overwrite this key
+	var newTotalBytes int
+	if oldSize, exists := lb.sizes[m.key]; exists {
+		// Overwriting existing key: subtract old size, add new size
+		newTotalBytes = lb.totalBytes - oldSize + memberBytes
+	} else {
+		// New key
+		newTotalBytes = lb.totalBytes + memberBytes
 	}
-	return Baggage{b}, truncateErr
+
+	if newTotalBytes > maxBytesPerBaggageString {
+		return errBaggageBytes
+	}
+
+	// OpenTelemetry resolves duplicates by last-one-wins.
+	lb.list[m.key] = baggage.Item{
+		Value:      m.value,
+		Properties: m.properties.asInternal(),
+	}
+	lb.sizes[m.key] = memberBytes
+	lb.totalBytes = newTotalBytes
+	return nil
+}
+
+// baggage returns the Baggage of the accumulated list-members.
+func (lb *listBuilder) baggage() Baggage {
+	if len(lb.list) == 0 {
+		return Baggage{}
+	}
+	return Baggage{lb.list}
 }
 
 // Member returns the baggage list-member identified by key.
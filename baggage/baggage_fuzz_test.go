@@ -0,0 +1,46 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func FuzzParse(f *testing.F) {
+	f.Add("key1=val1,key2=val2")
+	f.Add("key1=val1;prop1=1;prop2,key2=%C3%A9")
+	f.Add("key=%FF%FE,=,;;,key2=v=a=l")
+	f.Add("  key  =  val  ;  p  =  1  ,,")
+	f.Add("key=привет,ключ=val")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, policy := range []InvalidUTF8Policy{InvalidUTF8Replace, InvalidUTF8Drop, InvalidUTF8Error} {
+			b, _ := ParseWithInvalidUTF8(s, policy)
+			if n := b.Len(); n > maxMembers {
+				t.Fatalf("policy %d: %d list-members exceed the limit: %q", policy, n, s)
+			}
+			enc := b.String()
+			if n := len(enc); n > maxBytesPerBaggageString {
+				t.Fatalf("policy %d: %d bytes exceed the limit: %q", policy, n, s)
+			}
+			for _, m := range b.Members() {
+				if !utf8.ValidString(m.Value()) {
+					t.Fatalf("policy %d: invalid UTF-8 value %q: %q", policy, m.Value(), s)
+				}
+			}
+
+			// The encoded baggage must be decoded to the same baggage.
+			got, err := ParseStrict(enc)
+			if err != nil {
+				t.Fatalf("policy %d: invalid encoding %q of %q: %v", policy, enc, s, err)
+			}
+			if got.Len() != b.Len() {
+				t.Fatalf("policy %d: roundtrip of %q: got %d list-members, want %d", policy, s, got.Len(), b.Len())
+			}
+		}
+
+		_, _ = ParseLenient(s)
+	})
+}
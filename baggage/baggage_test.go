@@ -4,6 +4,7 @@
 package baggage
 
 import (
+	"errors"
 	"fmt"
 	"math/rand/v2"
 	"slices"
@@ -1320,3 +1321,180 @@ func TestParseErrorCapAllInvalid(t *testing.T) {
 		"should cap individual parse errors at maxParseErrors")
 	assert.Contains(t, errs, "and 15 more invalid member(s)")
 }
+
+func TestParseStrict(t *testing.T) {
+	b, err := ParseStrict("foo=1;p, bar=%C3%A9,foo=2")
+	require.NoError(t, err)
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, "2", b.Member("foo").Value())
+	assert.Equal(t, "é", b.Member("bar").Value())
+
+	b, err = ParseStrict("")
+	require.NoError(t, err)
+	assert.Equal(t, 0, b.Len())
+
+	for _, tc := range []struct {
+		name string
+		in   string
+		err  error
+	}{
+		{name: "InvalidMember", in: "foo=1,bar", err: errInvalidMember},
+		{name: "InvalidKey", in: "foo=1,b ar=2", err: errInvalidKey},
+		{name: "InvalidUTF8", in: "foo=1,bar=%FF", err: errInvalidValue},
+		{name: "EmptyMember", in: "foo=1,", err: errInvalidMember},
+		{name: "TooLarge", in: "foo=" + strings.Repeat("a", maxBytesPerBaggageString), err: errBaggageBytes},
+		{name: "TooManyMembers", in: members(maxMembers + 1), err: errMemberNumber},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			b, err := ParseStrict(tc.in)
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, Baggage{}, b)
+
+			// Parse is lenient about all but the byte limit of the
+			// baggage-string.
+			b, _ = Parse(tc.in)
+			if !errors.Is(tc.err, errBaggageBytes) {
+				assert.Positive(t, b.Len())
+			}
+		})
+	}
+}
+
+func TestParseWithInvalidUTF8(t *testing.T) {
+	const in = "foo=1,bar=%FF,baz=%C3%A9"
+
+	b, err := ParseWithInvalidUTF8(in, InvalidUTF8Replace)
+	require.NoError(t, err)
+	assert.Equal(t, 3, b.Len())
+	assert.Equal(t, "\uFFFD", b.Member("bar").Value())
+
+	b, err = ParseWithInvalidUTF8(in, InvalidUTF8Drop)
+	require.NoError(t, err)
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, "é", b.Member("baz").Value())
+
+	b, err = ParseWithInvalidUTF8(in, InvalidUTF8Error)
+	assert.ErrorIs(t, err, errInvalidUTF8)
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, "1", b.Member("foo").Value())
+
+	b, err = ParseWithInvalidUTF8(in+",qux", InvalidUTF8Drop)
+	assert.ErrorIs(t, err, errInvalidMember, "other invalid list-members")
+	assert.NotErrorIs(t, err, errInvalidUTF8)
+	assert.Equal(t, 2, b.Len())
+}
+
+// members returns a baggage-string with n distinct list-members.
+func members(n int) string {
+	parts := make([]string, n)
+	for i := range parts {
+		parts[i] = fmt.Sprintf("k%d=v", i)
+	}
+	return strings.Join(parts, ",")
+}
+
+func TestParseLenient(t *testing.T) {
+	b, dropped := ParseLenient("foo=1, bad , b ar=2,bar=%FF;p")
+	assert.Equal(t, 2, b.Len())
+	assert.Equal(t, "1", b.Member("foo").Value())
+	assert.Equal(t, "�", b.Member("bar").Value())
+	require.Len(t, dropped, 2)
+	assert.Equal(t, " bad ", dropped[0].Member)
+	assert.ErrorIs(t, dropped[0].Err, errInvalidMember)
+	assert.Equal(t, " b ar=2", dropped[1].Member)
+	assert.ErrorIs(t, dropped[1].Err, errInvalidKey)
+
+	b, dropped = ParseLenient("")
+	assert.Equal(t, 0, b.Len())
+	assert.Empty(t, dropped)
+}
+
+func TestParseLenientLimits(t *testing.T) {
+	t.Run("TooManyMembers", func(t *testing.T) {
+		b, dropped := ParseLenient(members(maxMembers + 2))
+		assert.Equal(t, maxMembers, b.Len())
+		require.Len(t, dropped, 1)
+		assert.Equal(t, fmt.Sprintf("k%d=v,k%d=v", maxMembers, maxMembers+1), dropped[0].Member)
+		assert.ErrorIs(t, dropped[0].Err, errMemberNumber)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		// Parse rejects the whole baggage-string, ParseLenient keeps the
+		// list-members within the limit.
+		bStr := "foo=1,bar=" + strings.Repeat("a", maxBytesPerBaggageString) + ",baz=2"
+		b, err := Parse(bStr)
+		assert.ErrorIs(t, err, errBaggageBytes)
+		assert.Equal(t, 0, b.Len())
+
+		b, dropped := ParseLenient(bStr)
+		assert.Equal(t, 1, b.Len())
+		assert.Equal(t, "1", b.Member("foo").Value())
+		require.Len(t, dropped, 1)
+		assert.Equal(t, bStr[len("foo=1,"):], dropped[0].Member)
+		assert.ErrorIs(t, dropped[0].Err, errBaggageBytes)
+	})
+}
+
+func TestMemberPriority(t *testing.T) {
+	m, err := NewMember("key", "value", NewPriorityProperty(-3))
+	require.NoError(t, err)
+	assert.Equal(t, -3, m.Priority())
+	assert.Equal(t, "key=value;priority=-3", m.String())
+
+	m, err = NewMember("key", "value")
+	require.NoError(t, err)
+	assert.Equal(t, 0, m.Priority(), "no priority")
+
+	p, err := NewKeyValueProperty(PriorityKey, "high")
+	require.NoError(t, err)
+	m, err = NewMember("key", "value", p)
+	require.NoError(t, err)
+	assert.Equal(t, 0, m.Priority(), "invalid priority")
+}
+
+func TestBaggageTrim(t *testing.T) {
+	value := strings.Repeat("a", 3000)
+	member := func(key string, priority int) Member {
+		m, err := NewMember(key, value, NewPriorityProperty(priority))
+		require.NoError(t, err)
+		return m
+	}
+
+	var b Baggage
+	for _, m := range []Member{member("low", -1), member("default", 0), member("high", 1), member("higher", 2)} {
+		var err error
+		b, err = b.SetMember(m)
+		require.NoError(t, err)
+	}
+	assert.Greater(t, len(b.String()), maxBytesPerBaggageString)
+
+	got, dropped := b.Trim()
+	require.Len(t, dropped, 2)
+	assert.Equal(t, "low", dropped[0].Key())
+	assert.Equal(t, "default", dropped[1].Key())
+	assert.Equal(t, 2, got.Len())
+	assert.Equal(t, 2, got.Member("higher").Priority())
+	assert.Equal(t, 1, got.Member("high").Priority())
+	assert.LessOrEqual(t, len(got.String()), maxBytesPerBaggageString)
+	assert.Equal(t, 4, b.Len(), "b is not modified")
+
+	got, dropped = got.Trim()
+	assert.Empty(t, dropped)
+	assert.Equal(t, 2, got.Len())
+}
+
+func TestBaggageTrimMembers(t *testing.T) {
+	var b Baggage
+	for i := range maxMembers + 2 {
+		m, err := NewMember(fmt.Sprintf("key%d", i), "v", NewPriorityProperty(i))
+		require.NoError(t, err)
+		b, err = b.SetMember(m)
+		require.NoError(t, err)
+	}
+
+	got, dropped := b.Trim()
+	require.Len(t, dropped, 2)
+	assert.Equal(t, "key0", dropped[0].Key())
+	assert.Equal(t, "key1", dropped[1].Key())
+	assert.Equal(t, maxMembers, got.Len())
+}
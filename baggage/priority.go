@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package baggage // import "go.opentelemetry.io/otel/baggage"
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// PriorityKey is the key of the property of a list-member holding its
+// priority, an integer. The list-members with the lowest priority are dropped
+// first when a Baggage is trimmed to the limits of the W3C Baggage
+// specification. A list-member without a valid priority has a priority of
+// zero.
+//
+// This is a convention of this package, the priority is propagated with the
+// list-member as any other property.
+const PriorityKey = "priority"
+
+// NewPriorityProperty returns a Property setting the priority of a
+// list-member to priority.
+func NewPriorityProperty(priority int) Property {
+	return Property{
+		key:      PriorityKey,
+		value:    strconv.Itoa(priority),
+		hasValue: true,
+	}
+}
+
+// Priority returns the priority of m set with a property with the
+// [PriorityKey] key, or zero if m has no such property or its value is not an
+// integer.
+func (m Member) Priority() int {
+	for _, p := range m.properties {
+		if p.key != PriorityKey {
+			continue
+		}
+		priority, err := strconv.Atoi(p.value)
+		if err != nil {
+			return 0
+		}
+		return priority
+	}
+	return 0
+}
+
+// Trim returns b without the list-members dropped for b to satisfy the
+// limits of the W3C Baggage specification, 64 list-members and 8192 bytes,
+// and the dropped list-members.
+//
+// The list-members with the lowest [Member.Priority] are dropped first, the
+// largest ones first among the list-members of the same priority. b is
+// returned unchanged if it satisfies the limits.
+func (b Baggage) Trim() (Baggage, []Member) {
+	members := b.Members()
+	sizes := make(map[string]int, len(members))
+	bytes := 0
+	for _, m := range members {
+		sizes[m.key] = len(m.String())
+		bytes += sizes[m.key]
+	}
+	// encoded is the size of the baggage-string of the n first members,
+	// with their comma separators.
+	encoded := func(n, bytes int) int { return bytes + max(n-1, 0) }
+	if len(members) <= maxMembers && encoded(len(members), bytes) <= maxBytesPerBaggageString {
+		return b, nil
+	}
+
+	slices.SortFunc(members, func(a, b Member) int {
+		if c := cmp.Compare(a.Priority(), b.Priority()); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(sizes[b.key], sizes[a.key]); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.key, b.key)
+	})
+	n := 0
+	for kept := len(members); kept > maxMembers || encoded(kept, bytes) > maxBytesPerBaggageString; kept-- {
+		bytes -= sizes[members[n].key]
+		n++
+	}
+
+	dropped := members[:n:n]
+	list := maps.Clone(b.list)
+	for _, m := range dropped {
+		delete(list, m.key)
+	}
+	return Baggage{list: list}, dropped
+}
@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var errNotOne = errors.New("exactly one type must be set")
+
+// decodeChoice decodes node into v, a pointer to a struct whose fields are
+// pointers to the possible types of a value. Unlike the default decoding, a
+// field whose key is present in node with no value (e.g. "console:") is set
+// to its zero value instead of being left nil.
+func decodeChoice(node *yaml.Node, v any) error {
+	if err := node.Decode(v); err != nil {
+		return err
+	}
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+	rt := rv.Type()
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, val := node.Content[i], node.Content[i+1]
+		if val.Tag != "!!null" {
+			continue
+		}
+		for j := range rt.NumField() {
+			name, _, _ := strings.Cut(rt.Field(j).Tag.Get("yaml"), ",")
+			f := rv.Field(j)
+			if name == key.Value && f.Kind() == reflect.Pointer && f.IsNil() {
+				f.Set(reflect.New(f.Type().Elem()))
+			}
+		}
+	}
+	return nil
+}
+
+// checkChoice returns an error if v, a struct of pointers, does not have
+// exactly one field set. The error names what is the choice of.
+func checkChoice(what string, v any) error {
+	rv := reflect.ValueOf(v)
+	n := 0
+	for i := range rv.NumField() {
+		if f := rv.Field(i); f.Kind() == reflect.Pointer && !f.IsNil() {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("%s: %w, got %d", what, errNotOne, n)
+	}
+	return nil
+}
+
+// UnmarshalYAML decodes a SpanExporter from node.
+func (e *SpanExporter) UnmarshalYAML(node *yaml.Node) error {
+	type plain SpanExporter
+	return decodeChoice(node, (*plain)(e))
+}
+
+// UnmarshalYAML decodes a MetricExporter from node.
+func (e *MetricExporter) UnmarshalYAML(node *yaml.Node) error {
+	type plain MetricExporter
+	return decodeChoice(node, (*plain)(e))
+}
+
+// UnmarshalYAML decodes a LogRecordExporter from node.
+func (e *LogRecordExporter) UnmarshalYAML(node *yaml.Node) error {
+	type plain LogRecordExporter
+	return decodeChoice(node, (*plain)(e))
+}
+
+// UnmarshalYAML decodes a Sampler from node.
+func (s *Sampler) UnmarshalYAML(node *yaml.Node) error {
+	type plain Sampler
+	return decodeChoice(node, (*plain)(s))
+}
+
+// UnmarshalYAML decodes an Aggregation from node.
+func (a *Aggregation) UnmarshalYAML(node *yaml.Node) error {
+	type plain Aggregation
+	return decodeChoice(node, (*plain)(a))
+}
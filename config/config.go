@@ -0,0 +1,250 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/otel/log"
+	lognoop "go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+// fileEnv is the environment variable name the path of the configuration
+// file is read from.
+const fileEnv = "OTEL_EXPERIMENTAL_CONFIG_FILE"
+
+// supportedFileFormats are the major versions of the file format supported.
+var supportedFileFormats = []string{"0.3", "0.4", "1.0"}
+
+// ErrNoConfigFile is returned by [NewSDKFromEnv] if the
+// OTEL_EXPERIMENTAL_CONFIG_FILE environment variable is not set.
+var ErrNoConfigFile = errors.New("config: " + fileEnv + " is not set")
+
+// SDK holds the providers configured from an [OpenTelemetryConfiguration].
+type SDK struct {
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	loggerProvider log.LoggerProvider
+	propagator     propagation.TextMapPropagator
+	shutdown       []func(context.Context) error
+}
+
+// TracerProvider returns the configured TracerProvider.
+func (s SDK) TracerProvider() trace.TracerProvider {
+	return s.tracerProvider
+}
+
+// MeterProvider returns the configured MeterProvider.
+func (s SDK) MeterProvider() metric.MeterProvider {
+	return s.meterProvider
+}
+
+// LoggerProvider returns the configured LoggerProvider.
+func (s SDK) LoggerProvider() log.LoggerProvider {
+	return s.loggerProvider
+}
+
+// Propagator returns the configured TextMapPropagator.
+func (s SDK) Propagator() propagation.TextMapPropagator {
+	return s.propagator
+}
+
+// Shutdown shuts down all the configured providers, flushing the telemetry
+// they hold. All the providers are shut down even if one returns an error.
+// The returned error joins the errors of all the providers.
+func (s SDK) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, f := range s.shutdown {
+		errs = append(errs, f(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// noopSDK returns an SDK with no-op providers and propagator.
+func noopSDK() SDK {
+	return SDK{
+		tracerProvider: tracenoop.NewTracerProvider(),
+		meterProvider:  metricnoop.NewMeterProvider(),
+		loggerProvider: lognoop.NewLoggerProvider(),
+		propagator:     propagation.NewCompositeTextMapPropagator(),
+	}
+}
+
+// ConfigurationOption configures how [NewSDK] creates an [SDK].
+type ConfigurationOption interface {
+	apply(configOptions) configOptions
+}
+
+type configOptions struct {
+	ctx    context.Context
+	config OpenTelemetryConfiguration
+}
+
+type configurationOptionFunc func(configOptions) configOptions
+
+func (fn configurationOptionFunc) apply(o configOptions) configOptions {
+	return fn(o)
+}
+
+// WithContext sets the context used to create the exporters.
+func WithContext(ctx context.Context) ConfigurationOption {
+	return configurationOptionFunc(func(o configOptions) configOptions {
+		o.ctx = ctx
+		return o
+	})
+}
+
+// WithOpenTelemetryConfiguration sets the configuration the SDK is created
+// from.
+func WithOpenTelemetryConfiguration(cfg OpenTelemetryConfiguration) ConfigurationOption {
+	return configurationOptionFunc(func(o configOptions) configOptions {
+		o.config = cfg
+		return o
+	})
+}
+
+// NewSDK returns an [SDK] with the providers and propagator configured by
+// the [OpenTelemetryConfiguration] set with [WithOpenTelemetryConfiguration].
+//
+// The providers that are not configured, or all of them if the configuration
+// is disabled, are no-op. An error is returned along with no-op providers if
+// the configuration is invalid, in which case the providers already created
+// are shut down.
+func NewSDK(opts ...ConfigurationOption) (SDK, error) {
+	o := configOptions{ctx: context.Background()}
+	for _, opt := range opts {
+		o = opt.apply(o)
+	}
+	cfg := o.config
+
+	s := noopSDK()
+	if err := checkFileFormat(cfg.FileFormat); err != nil {
+		return s, err
+	}
+	if cfg.Disabled != nil && *cfg.Disabled {
+		return s, nil
+	}
+
+	prop, err := newPropagator(cfg.Propagator)
+	if err != nil {
+		return noopSDK(), err
+	}
+	s.propagator = prop
+
+	res, err := newResource(cfg.Resource)
+	if err != nil {
+		return noopSDK(), err
+	}
+
+	b := builder{ctx: o.ctx, res: res, limits: cfg.AttributeLimits}
+	if cfg.TracerProvider != nil {
+		tp, err := b.tracerProvider(*cfg.TracerProvider)
+		if err != nil {
+			return noopSDK(), err
+		}
+		s.tracerProvider = tp
+		s.shutdown = append(s.shutdown, tp.Shutdown)
+	}
+	if cfg.MeterProvider != nil {
+		mp, err := b.meterProvider(*cfg.MeterProvider)
+		if err != nil {
+			return noopSDK(), errors.Join(err, s.Shutdown(o.ctx))
+		}
+		s.meterProvider = mp
+		s.shutdown = append(s.shutdown, mp.Shutdown)
+	}
+	if cfg.LoggerProvider != nil {
+		lp, err := b.loggerProvider(*cfg.LoggerProvider)
+		if err != nil {
+			return noopSDK(), errors.Join(err, s.Shutdown(o.ctx))
+		}
+		s.loggerProvider = lp
+		s.shutdown = append(s.shutdown, lp.Shutdown)
+	}
+	return s, nil
+}
+
+// NewSDKFromEnv returns an [SDK] configured by the configuration file at the
+// path of the OTEL_EXPERIMENTAL_CONFIG_FILE environment variable. See
+// [ParseYAML] for how the file is parsed.
+//
+// [ErrNoConfigFile] is returned if the environment variable is not set.
+// Otherwise, when a configuration file is used, the other environment
+// variables configuring the SDK are ignored.
+func NewSDKFromEnv(ctx context.Context) (SDK, error) {
+	path := os.Getenv(fileEnv)
+	if path == "" {
+		return noopSDK(), ErrNoConfigFile
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return noopSDK(), fmt.Errorf("config: %w", err)
+	}
+	cfg, err := ParseYAML(b)
+	if err != nil {
+		return noopSDK(), err
+	}
+	return NewSDK(WithContext(ctx), WithOpenTelemetryConfiguration(*cfg))
+}
+
+// ParseYAML parses the YAML declarative configuration file b.
+//
+// References to environment variables in the form ${NAME}, ${env:NAME}, or
+// ${NAME:-default} in scalar values are replaced with the value of the
+// variable, or the default value if the variable is not set or empty. "$$"
+// is replaced with "$". The references are replaced after parsing, so the
+// value of a variable is always the value of the scalar it is referenced in
+// and cannot change the structure of the configuration.
+func ParseYAML(b []byte) (*OpenTelemetryConfiguration, error) {
+	var root yaml.Node
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	if err := dec.Decode(&root); err != nil {
+		return nil, fmt.Errorf("config: invalid YAML: %w", err)
+	}
+	expandEnvNode(&root)
+
+	var cfg OpenTelemetryConfiguration
+	if err := root.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("config: invalid YAML: %w", err)
+	}
+	if err := checkFileFormat(cfg.FileFormat); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+// checkFileFormat returns an error if the file format version f is not
+// supported.
+func checkFileFormat(f string) error {
+	if f == "" {
+		return errors.New("config: file_format is required")
+	}
+	for _, s := range supportedFileFormats {
+		if f == s || strings.HasPrefix(f, s+".") || strings.HasPrefix(f, s+"-") {
+			return nil
+		}
+	}
+	return fmt.Errorf("config: unsupported file_format %q", f)
+}
+
+// builder creates the providers sharing a Resource and attribute limits.
+type builder struct {
+	ctx    context.Context
+	res    *resource.Resource
+	limits *AttributeLimits
+}
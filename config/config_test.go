@@ -0,0 +1,209 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	lognoop "go.opentelemetry.io/otel/log/noop"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestParseYAML(t *testing.T) {
+	t.Setenv("SERVICE_NAME", "checkout")
+	t.Setenv("API_KEY", "secret")
+
+	b, err := os.ReadFile(filepath.Join("testdata", "full.yaml"))
+	require.NoError(t, err)
+	cfg, err := ParseYAML(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.0", cfg.FileFormat)
+	require.NotNil(t, cfg.Resource)
+	assert.Equal(t, "checkout", cfg.Resource.Attributes[0].Value)
+	assert.Equal(t, []map[string]any{{"tracecontext": nil}, {"baggage": nil}}, cfg.Propagator.Composite)
+
+	require.NotNil(t, cfg.TracerProvider)
+	require.Len(t, cfg.TracerProvider.Processors, 2)
+	otlp := cfg.TracerProvider.Processors[0].Batch.Exporter.OTLPHTTP
+	require.NotNil(t, otlp)
+	assert.Equal(t, []NameStringValuePair{{Name: "api-key", Value: ptr("secret")}}, otlp.Headers)
+	assert.Equal(t, &Console{}, cfg.TracerProvider.Processors[1].Simple.Exporter.Console)
+	assert.NotNil(t, cfg.TracerProvider.Sampler.ParentBased.RemoteParentNotSampled.AlwaysOff)
+
+	require.NotNil(t, cfg.MeterProvider)
+	grpc := cfg.MeterProvider.Readers[0].Periodic.Exporter.OTLPGRPC
+	require.NotNil(t, grpc)
+	assert.Equal(t, ptr("http://localhost:4317"), grpc.Endpoint)
+	assert.Equal(t, ptr("delta"), grpc.TemporalityPreference)
+	assert.Equal(t, []float64{0.1, 0.5, 1, 5}, cfg.MeterProvider.Views[0].Stream.Aggregation.ExplicitBucketHistogram.Boundaries)
+
+	require.NotNil(t, cfg.LoggerProvider)
+	assert.Equal(t, ptr(16), cfg.LoggerProvider.Limits.AttributeCountLimit)
+}
+
+func TestParseYAMLErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		yaml string
+	}{
+		{name: "InvalidYAML", yaml: "file_format: [1.0"},
+		{name: "NoFileFormat", yaml: "disabled: true"},
+		{name: "UnsupportedFileFormat", yaml: `file_format: "2.0"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseYAML([]byte(tt.yaml))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewSDK(t *testing.T) {
+	b, err := os.ReadFile(filepath.Join("testdata", "full.yaml"))
+	require.NoError(t, err)
+	cfg, err := ParseYAML(b)
+	require.NoError(t, err)
+
+	sdk, err := NewSDK(WithContext(t.Context()), WithOpenTelemetryConfiguration(*cfg))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sdk.Shutdown(t.Context()) })
+
+	assert.IsType(t, &sdktrace.TracerProvider{}, sdk.TracerProvider())
+	assert.IsType(t, &sdkmetric.MeterProvider{}, sdk.MeterProvider())
+	assert.IsType(t, &sdklog.LoggerProvider{}, sdk.LoggerProvider())
+	assert.ElementsMatch(t, []string{
+		"traceparent", "tracestate", "baggage", "b3",
+	}, sdk.Propagator().Fields())
+}
+
+func TestNewSDKNoop(t *testing.T) {
+	for _, cfg := range []OpenTelemetryConfiguration{
+		{FileFormat: "1.0"},
+		{
+			FileFormat:     "1.0",
+			Disabled:       ptr(true),
+			TracerProvider: &TracerProvider{},
+		},
+	} {
+		sdk, err := NewSDK(WithOpenTelemetryConfiguration(cfg))
+		require.NoError(t, err)
+		assert.IsType(t, tracenoop.TracerProvider{}, sdk.TracerProvider())
+		assert.IsType(t, metricnoop.MeterProvider{}, sdk.MeterProvider())
+		assert.IsType(t, lognoop.LoggerProvider{}, sdk.LoggerProvider())
+		assert.NoError(t, sdk.Shutdown(t.Context()))
+	}
+}
+
+func TestNewSDKErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  OpenTelemetryConfiguration
+	}{
+		{name: "NoFileFormat"},
+		{
+			name: "UnknownPropagator",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				Propagator: &Propagator{CompositeList: ptr("unknown")},
+			},
+		},
+		{
+			name: "InvalidResourceAttribute",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				Resource: &Resource{Attributes: []AttributeNameValue{
+					{Name: "key", Value: "value", Type: ptr("int")},
+				}},
+			},
+		},
+		{
+			name: "NoExporter",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				TracerProvider: &TracerProvider{Processors: []SpanProcessor{
+					{Simple: &SimpleSpanProcessor{}},
+				}},
+			},
+		},
+		{
+			name: "MultipleExporters",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				LoggerProvider: &LoggerProvider{Processors: []LogRecordProcessor{
+					{Simple: &SimpleLogRecordProcessor{Exporter: LogRecordExporter{
+						Console:  &Console{},
+						OTLPHTTP: &OTLPHTTPExporter{},
+					}}},
+				}},
+			},
+		},
+		{
+			name: "UnsupportedEncoding",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				TracerProvider: &TracerProvider{Processors: []SpanProcessor{
+					{Simple: &SimpleSpanProcessor{Exporter: SpanExporter{
+						OTLPHTTP: &OTLPHTTPExporter{Encoding: ptr("json")},
+					}}},
+				}},
+			},
+		},
+		{
+			name: "UnsupportedTemporality",
+			cfg: OpenTelemetryConfiguration{
+				FileFormat: "1.0",
+				MeterProvider: &MeterProvider{Readers: []MetricReader{
+					{Periodic: &PeriodicMetricReader{Exporter: MetricExporter{
+						OTLPHTTP: &OTLPHTTPMetricExporter{TemporalityPreference: ptr("unknown")},
+					}}},
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSDK(WithOpenTelemetryConfiguration(tt.cfg))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestNewSDKFromEnv(t *testing.T) {
+	t.Setenv(fileEnv, "")
+	_, err := NewSDKFromEnv(t.Context())
+	assert.ErrorIs(t, err, ErrNoConfigFile)
+
+	path := filepath.Join(t.TempDir(), "otel.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+file_format: "1.0"
+tracer_provider:
+  processors:
+    - simple:
+        exporter:
+          console:
+`), 0o600))
+	t.Setenv(fileEnv, path)
+
+	sdk, err := NewSDKFromEnv(t.Context())
+	require.NoError(t, err)
+	assert.IsType(t, &sdktrace.TracerProvider{}, sdk.TracerProvider())
+	assert.IsType(t, metricnoop.MeterProvider{}, sdk.MeterProvider())
+	assert.NoError(t, sdk.Shutdown(t.Context()))
+
+	t.Setenv(fileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+	_, err = NewSDKFromEnv(t.Context())
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package config creates the OpenTelemetry SDK providers from a declarative
+configuration file.
+
+The configuration file follows the [OpenTelemetry configuration data model]
+in YAML. It describes the Resource, the propagators, and the pipelines of the
+TracerProvider, MeterProvider, and LoggerProvider: their exporters,
+processors, readers, samplers, views, and limits. For example:
+
+	file_format: "1.0"
+	resource:
+	  attributes:
+	    - name: service.name
+	      value: ${SERVICE_NAME:-checkout}
+	propagator:
+	  composite:
+	    - tracecontext:
+	    - baggage:
+	tracer_provider:
+	  sampler:
+	    parent_based:
+	      root:
+	        trace_id_ratio_based:
+	          ratio: 0.25
+	  processors:
+	    - batch:
+	        exporter:
+	          otlp_http:
+	            endpoint: http://collector:4318/v1/traces
+	meter_provider:
+	  readers:
+	    - periodic:
+	        interval: 30000
+	        exporter:
+	          otlp_grpc:
+	            endpoint: http://collector:4317
+	logger_provider:
+	  processors:
+	    - batch:
+	        exporter:
+	          console:
+
+Use [ParseYAML] and [NewSDK] to create the providers from a file, or
+[NewSDKFromEnv] to use the file at the path of the
+OTEL_EXPERIMENTAL_CONFIG_FILE environment variable.
+
+The OTLP exporters over HTTP (protobuf encoded) and gRPC, and the console
+exporters writing to the standard output, are supported. Pull based metric
+readers are not.
+
+[OpenTelemetry configuration data model]: https://github.com/open-telemetry/opentelemetry-configuration
+*/
+package config
@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"os"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// envRef matches the escaped "$$" and the environment variable references
+// ${NAME}, ${env:NAME}, and their forms with a default value
+// ${NAME:-default}.
+var envRef = regexp.MustCompile(`\$\$|\$\{(?:env:)?([a-zA-Z_][a-zA-Z0-9_]*)(?::-([^\n}]*))?\}`)
+
+// expandEnv replaces the environment variable references in s with the
+// value of the referenced variables. A reference to a variable that is not
+// set is replaced with its default value, or the empty string if it has none.
+// An escaped "$$" is replaced with "$". It reports whether s contained any
+// reference.
+func expandEnv(s string) (string, bool) {
+	var found bool
+	out := envRef.ReplaceAllStringFunc(s, func(ref string) string {
+		found = true
+		if ref == "$$" {
+			return "$"
+		}
+		m := envRef.FindStringSubmatch(ref)
+		if v, ok := os.LookupEnv(m[1]); ok && v != "" {
+			return v
+		}
+		return m[2]
+	})
+	return out, found
+}
+
+// expandEnvNode replaces the environment variable references in the scalar
+// values of the YAML document node n, see expandEnv. Mapping keys are not
+// expanded.
+//
+// The references are replaced once the document is parsed so the value of a
+// variable cannot change the structure of the document: it is always the
+// value of the scalar it is referenced in. The type of an unquoted scalar is
+// resolved from its value after the replacement, e.g. "${PORT}" is an int if
+// PORT is "4318".
+func expandEnvNode(n *yaml.Node) {
+	switch n.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range n.Content {
+			expandEnvNode(c)
+		}
+	case yaml.MappingNode:
+		for i := 1; i < len(n.Content); i += 2 {
+			expandEnvNode(n.Content[i])
+		}
+	case yaml.ScalarNode:
+		v, ok := expandEnv(n.Value)
+		if !ok {
+			return
+		}
+		n.Value = v
+		if n.Style == 0 {
+			// Resolve the tag of the plain scalar from its new value.
+			n.Tag = ""
+		}
+	}
+}
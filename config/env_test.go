@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("SET", "value")
+	t.Setenv("EMPTY", "")
+
+	tests := []struct {
+		in, want string
+	}{
+		{in: "${SET}", want: "value"},
+		{in: "${env:SET}", want: "value"},
+		{in: "a ${SET} b", want: "a value b"},
+		{in: "${UNSET_VAR}", want: ""},
+		{in: "${UNSET_VAR:-default}", want: "default"},
+		{in: "${EMPTY:-default}", want: "default"},
+		{in: "${SET:-default}", want: "value"},
+		{in: "$${SET}", want: "${SET}"},
+		{in: "$$$$", want: "$$"},
+		{in: "$SET", want: "$SET"},
+		{in: "${1INVALID}", want: "${1INVALID}"},
+	}
+	for _, tt := range tests {
+		got, _ := expandEnv(tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestParseYAMLEnvNoInjection(t *testing.T) {
+	t.Setenv("SVC", "x\ndisabled: true")
+	t.Setenv("QUOTE", `a" : b`)
+
+	cfg, err := ParseYAML([]byte(`file_format: "1.0"
+resource:
+  attributes:
+    - name: service.name
+      value: ${SVC}
+    - name: quoted
+      value: "${QUOTE}"
+`))
+	require.NoError(t, err)
+	assert.Nil(t, cfg.Disabled)
+	require.NotNil(t, cfg.Resource)
+	require.Len(t, cfg.Resource.Attributes, 2)
+	assert.Equal(t, "x\ndisabled: true", cfg.Resource.Attributes[0].Value)
+	assert.Equal(t, `a" : b`, cfg.Resource.Attributes[1].Value)
+}
+
+func TestParseYAMLEnvTypes(t *testing.T) {
+	t.Setenv("DISABLED", "true")
+	t.Setenv("COUNT", "16")
+
+	cfg, err := ParseYAML([]byte(`file_format: "1.0"
+disabled: ${DISABLED}
+logger_provider:
+  limits:
+    attribute_count_limit: ${COUNT}
+resource:
+  attributes:
+    - name: count
+      value: "${COUNT}"
+`))
+	require.NoError(t, err)
+	assert.Equal(t, ptr(true), cfg.Disabled)
+	assert.Equal(t, ptr(16), cfg.LoggerProvider.Limits.AttributeCountLimit)
+	assert.Equal(t, "16", cfg.Resource.Attributes[0].Value, "quoted scalar is a string")
+}
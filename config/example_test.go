@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"context"
+	"log"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/config"
+	"go.opentelemetry.io/otel/log/global"
+)
+
+func Example() {
+	ctx := context.Background()
+
+	cfg, err := config.ParseYAML([]byte(`
+file_format: "1.0"
+resource:
+  attributes:
+    - name: service.name
+      value: ${SERVICE_NAME:-checkout}
+tracer_provider:
+  processors:
+    - batch:
+        exporter:
+          otlp_http:
+            endpoint: http://localhost:4318/v1/traces
+`))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	sdk, err := config.NewSDK(config.WithContext(ctx), config.WithOpenTelemetryConfiguration(*cfg))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer func() {
+		if err := sdk.Shutdown(ctx); err != nil {
+			log.Print(err)
+		}
+	}()
+
+	otel.SetTracerProvider(sdk.TracerProvider())
+	otel.SetMeterProvider(sdk.MeterProvider())
+	otel.SetTextMapPropagator(sdk.Propagator())
+	global.SetLoggerProvider(sdk.LoggerProvider())
+}
+
+func ExampleNewSDKFromEnv() {
+	ctx := context.Background()
+
+	// The configuration file is read from the path of the
+	// OTEL_EXPERIMENTAL_CONFIG_FILE environment variable.
+	sdk, err := config.NewSDKFromEnv(ctx)
+	if err != nil {
+		log.Print(err)
+	}
+	defer func() { _ = sdk.Shutdown(ctx) }()
+
+	otel.SetTracerProvider(sdk.TracerProvider())
+}
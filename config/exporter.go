@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Supported values of the compression of OTLP exporters.
+const (
+	compressionGzip = "gzip"
+	compressionNone = "none"
+)
+
+// checkEncoding returns an error if the encoding of an OTLP HTTP exporter is
+// not supported.
+func checkEncoding(e *string) error {
+	if e != nil && *e != "protobuf" {
+		return fmt.Errorf("unsupported encoding %q", *e)
+	}
+	return nil
+}
+
+// gzip returns whether the compression c is gzip, or an error if c is not
+// supported.
+func gzip(c *string) (bool, error) {
+	if c == nil {
+		return false, nil
+	}
+	switch *c {
+	case compressionGzip:
+		return true, nil
+	case compressionNone:
+		return false, nil
+	}
+	return false, fmt.Errorf("unsupported compression %q", *c)
+}
+
+// headers returns the headers of the headers list and headers, the latter
+// taking precedence.
+func headers(list *string, h []NameStringValuePair) (map[string]string, error) {
+	out := make(map[string]string)
+	if list != nil {
+		kvs, err := parseList(*list)
+		if err != nil {
+			return nil, fmt.Errorf("headers_list: %w", err)
+		}
+		for k, v := range kvs {
+			out[k] = v
+		}
+	}
+	for _, p := range h {
+		if p.Value != nil {
+			out[p.Name] = *p.Value
+		}
+	}
+	return out, nil
+}
+
+// milliseconds returns ms as a Duration. It returns false if ms is not set
+// or negative.
+func milliseconds(ms *int) (time.Duration, bool) {
+	if ms == nil || *ms < 0 {
+		return 0, false
+	}
+	return time.Duration(*ms) * time.Millisecond, true
+}
+
+// tlsConfig returns the tls.Config configured by c, or nil if c does not
+// configure certificates.
+func tlsConfig(c *ClientTLS) (*tls.Config, error) {
+	if c == nil || (c.CAFile == nil && c.CertFile == nil && c.KeyFile == nil) {
+		return nil, nil
+	}
+
+	cfg := &tls.Config{}
+	if c.CAFile != nil {
+		b, err := os.ReadFile(*c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls ca_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.New("tls ca_file: no valid certificate")
+		}
+		cfg.RootCAs = pool
+	}
+	if c.CertFile != nil || c.KeyFile != nil {
+		if c.CertFile == nil || c.KeyFile == nil {
+			return nil, errors.New("tls: cert_file and key_file must be set together")
+		}
+		cert, err := tls.LoadX509KeyPair(*c.CertFile, *c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("tls: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return cfg, nil
+}
+
+// otlpSettings are the settings of an OTLP exporter common to all signals.
+type otlpSettings struct {
+	endpoint string
+	headers  map[string]string
+	gzip     bool
+	timeout  time.Duration
+	tls      *tls.Config
+	insecure bool
+}
+
+// httpSettings returns the settings of the OTLP HTTP exporter e.
+func httpSettings(e *OTLPHTTPExporter) (otlpSettings, error) {
+	if err := checkEncoding(e.Encoding); err != nil {
+		return otlpSettings{}, err
+	}
+	return settings(e.Endpoint, e.HeadersList, e.Headers, e.Compression, e.Timeout, e.TLS)
+}
+
+// grpcSettings returns the settings of the OTLP gRPC exporter e.
+func grpcSettings(e *OTLPGRPCExporter) (otlpSettings, error) {
+	s, err := settings(e.Endpoint, e.HeadersList, e.Headers, e.Compression, e.Timeout, e.TLS)
+	if err != nil {
+		return s, err
+	}
+	s.insecure = e.TLS != nil && e.TLS.Insecure != nil && *e.TLS.Insecure
+	return s, nil
+}
+
+func settings(endpoint, headersList *string, h []NameStringValuePair, compression *string, timeout *int, c *ClientTLS) (otlpSettings, error) {
+	var (
+		s   otlpSettings
+		err error
+	)
+	if endpoint != nil {
+		s.endpoint = *endpoint
+	}
+	if s.headers, err = headers(headersList, h); err != nil {
+		return s, err
+	}
+	if s.gzip, err = gzip(compression); err != nil {
+		return s, err
+	}
+	s.timeout, _ = milliseconds(timeout)
+	if s.tls, err = tlsConfig(c); err != nil {
+		return s, err
+	}
+	return s, nil
+}
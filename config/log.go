@@ -0,0 +1,140 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// loggerProvider returns the LoggerProvider configured by lp.
+func (b builder) loggerProvider(lp LoggerProvider) (*sdklog.LoggerProvider, error) {
+	opts := []sdklog.LoggerProviderOption{sdklog.WithResource(b.res)}
+	for _, l := range []*AttributeLimits{b.limits, lp.Limits} {
+		if l == nil {
+			continue
+		}
+		if l.AttributeCountLimit != nil {
+			opts = append(opts, sdklog.WithAttributeCountLimit(*l.AttributeCountLimit))
+		}
+		if l.AttributeValueLengthLimit != nil {
+			opts = append(opts, sdklog.WithAttributeValueLengthLimit(*l.AttributeValueLengthLimit))
+		}
+	}
+
+	var processors []sdklog.Processor
+	for i, p := range lp.Processors {
+		proc, err := b.logRecordProcessor(p)
+		if err != nil {
+			err = fmt.Errorf("config: logger_provider processor %d: %w", i, err)
+			var errs []error
+			for _, p := range processors {
+				errs = append(errs, p.Shutdown(b.ctx))
+			}
+			return nil, errors.Join(err, errors.Join(errs...))
+		}
+		processors = append(processors, proc)
+		opts = append(opts, sdklog.WithProcessor(proc))
+	}
+	return sdklog.NewLoggerProvider(opts...), nil
+}
+
+// logRecordProcessor returns the Processor configured by p.
+func (b builder) logRecordProcessor(p LogRecordProcessor) (sdklog.Processor, error) {
+	if err := checkChoice("processor", p); err != nil {
+		return nil, err
+	}
+	if p.Simple != nil {
+		exp, err := b.logRecordExporter(p.Simple.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdklog.NewSimpleProcessor(exp), nil
+	}
+
+	bp := p.Batch
+	exp, err := b.logRecordExporter(bp.Exporter)
+	if err != nil {
+		return nil, err
+	}
+	var opts []sdklog.BatchProcessorOption
+	if d, ok := milliseconds(bp.ScheduleDelay); ok {
+		opts = append(opts, sdklog.WithExportInterval(d))
+	}
+	if d, ok := milliseconds(bp.ExportTimeout); ok {
+		opts = append(opts, sdklog.WithExportTimeout(d))
+	}
+	if bp.MaxQueueSize != nil {
+		opts = append(opts, sdklog.WithMaxQueueSize(*bp.MaxQueueSize))
+	}
+	if bp.MaxExportBatchSize != nil {
+		opts = append(opts, sdklog.WithExportMaxBatchSize(*bp.MaxExportBatchSize))
+	}
+	return sdklog.NewBatchProcessor(exp, opts...), nil
+}
+
+// logRecordExporter returns the Exporter configured by e.
+func (b builder) logRecordExporter(e LogRecordExporter) (sdklog.Exporter, error) {
+	if err := checkChoice("exporter", e); err != nil {
+		return nil, err
+	}
+	switch {
+	case e.Console != nil:
+		return stdoutlog.New(stdoutlog.WithPrettyPrint())
+	case e.OTLPHTTP != nil:
+		s, err := httpSettings(e.OTLPHTTP)
+		if err != nil {
+			return nil, fmt.Errorf("otlp_http: %w", err)
+		}
+		var opts []otlploghttp.Option
+		if s.endpoint != "" {
+			opts = append(opts, otlploghttp.WithEndpointURL(s.endpoint))
+		}
+		if len(s.headers) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(s.headers))
+		}
+		if s.gzip {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+		}
+		if s.timeout > 0 {
+			opts = append(opts, otlploghttp.WithTimeout(s.timeout))
+		}
+		if s.tls != nil {
+			opts = append(opts, otlploghttp.WithTLSClientConfig(s.tls))
+		}
+		return otlploghttp.New(b.ctx, opts...)
+	}
+
+	s, err := grpcSettings(e.OTLPGRPC)
+	if err != nil {
+		return nil, fmt.Errorf("otlp_grpc: %w", err)
+	}
+	var opts []otlploggrpc.Option
+	if s.endpoint != "" {
+		opts = append(opts, otlploggrpc.WithEndpointURL(s.endpoint))
+	}
+	if len(s.headers) > 0 {
+		opts = append(opts, otlploggrpc.WithHeaders(s.headers))
+	}
+	if s.gzip {
+		opts = append(opts, otlploggrpc.WithCompressor(compressionGzip))
+	}
+	if s.timeout > 0 {
+		opts = append(opts, otlploggrpc.WithTimeout(s.timeout))
+	}
+	if s.tls != nil {
+		opts = append(opts, otlploggrpc.WithTLSCredentials(credentials.NewTLS(s.tls)))
+	}
+	if s.insecure {
+		opts = append(opts, otlploggrpc.WithInsecure())
+	}
+	return otlploggrpc.New(b.ctx, opts...)
+}
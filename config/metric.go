@@ -0,0 +1,273 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// instrumentKinds are the InstrumentKinds of the instrument types of a view
+// selector.
+var instrumentKinds = map[string]sdkmetric.InstrumentKind{
+	"counter":                    sdkmetric.InstrumentKindCounter,
+	"gauge":                      sdkmetric.InstrumentKindGauge,
+	"histogram":                  sdkmetric.InstrumentKindHistogram,
+	"observable_counter":         sdkmetric.InstrumentKindObservableCounter,
+	"observable_gauge":           sdkmetric.InstrumentKindObservableGauge,
+	"observable_up_down_counter": sdkmetric.InstrumentKindObservableUpDownCounter,
+	"up_down_counter":            sdkmetric.InstrumentKindUpDownCounter,
+}
+
+// meterProvider returns the MeterProvider configured by mp.
+func (b builder) meterProvider(mp MeterProvider) (*sdkmetric.MeterProvider, error) {
+	opts := []sdkmetric.Option{sdkmetric.WithResource(b.res)}
+
+	for i, v := range mp.Views {
+		view, err := newView(v)
+		if err != nil {
+			return nil, fmt.Errorf("config: meter_provider view %d: %w", i, err)
+		}
+		opts = append(opts, sdkmetric.WithView(view))
+	}
+
+	var readers []sdkmetric.Reader
+	for i, r := range mp.Readers {
+		reader, err := b.metricReader(r)
+		if err != nil {
+			err = fmt.Errorf("config: meter_provider reader %d: %w", i, err)
+			var errs []error
+			for _, r := range readers {
+				errs = append(errs, r.Shutdown(b.ctx))
+			}
+			return nil, errors.Join(err, errors.Join(errs...))
+		}
+		readers = append(readers, reader)
+		opts = append(opts, sdkmetric.WithReader(reader))
+	}
+	return sdkmetric.NewMeterProvider(opts...), nil
+}
+
+// metricReader returns the Reader configured by r.
+func (b builder) metricReader(r MetricReader) (sdkmetric.Reader, error) {
+	if err := checkChoice("reader", r); err != nil {
+		return nil, err
+	}
+
+	if r.Pull != nil {
+		return nil, errors.New("pull: unsupported metric reader, only periodic readers are supported")
+	}
+
+	p := r.Periodic
+	exp, err := b.metricExporter(p.Exporter)
+	if err != nil {
+		return nil, err
+	}
+	var opts []sdkmetric.PeriodicReaderOption
+	if d, ok := milliseconds(p.Interval); ok && d > 0 {
+		opts = append(opts, sdkmetric.WithInterval(d))
+	}
+	if d, ok := milliseconds(p.Timeout); ok && d > 0 {
+		opts = append(opts, sdkmetric.WithTimeout(d))
+	}
+	return sdkmetric.NewPeriodicReader(exp, opts...), nil
+}
+
+// temporality returns the TemporalitySelector of the temporality preference
+// p.
+func temporality(p *string) (sdkmetric.TemporalitySelector, error) {
+	if p == nil {
+		return sdkmetric.CumulativeTemporalitySelector, nil
+	}
+	switch *p {
+	case "cumulative":
+		return sdkmetric.CumulativeTemporalitySelector, nil
+	case "delta":
+		return sdkmetric.DeltaTemporalitySelector, nil
+	case "low_memory":
+		return sdkmetric.LowMemoryTemporalitySelector, nil
+	}
+	return nil, fmt.Errorf("unsupported temporality_preference %q", *p)
+}
+
+// metricExporter returns the Exporter configured by e.
+func (b builder) metricExporter(e MetricExporter) (sdkmetric.Exporter, error) {
+	if err := checkChoice("exporter", e); err != nil {
+		return nil, err
+	}
+	switch {
+	case e.Console != nil:
+		return stdoutmetric.New(stdoutmetric.WithPrettyPrint())
+	case e.OTLPHTTP != nil:
+		s, err := httpSettings(&e.OTLPHTTP.OTLPHTTPExporter)
+		if err != nil {
+			return nil, fmt.Errorf("otlp_http: %w", err)
+		}
+		t, err := temporality(e.OTLPHTTP.TemporalityPreference)
+		if err != nil {
+			return nil, fmt.Errorf("otlp_http: %w", err)
+		}
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithTemporalitySelector(t)}
+		if s.endpoint != "" {
+			opts = append(opts, otlpmetrichttp.WithEndpointURL(s.endpoint))
+		}
+		if len(s.headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(s.headers))
+		}
+		if s.gzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		}
+		if s.timeout > 0 {
+			opts = append(opts, otlpmetrichttp.WithTimeout(s.timeout))
+		}
+		if s.tls != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(s.tls))
+		}
+		return otlpmetrichttp.New(b.ctx, opts...)
+	}
+
+	s, err := grpcSettings(&e.OTLPGRPC.OTLPGRPCExporter)
+	if err != nil {
+		return nil, fmt.Errorf("otlp_grpc: %w", err)
+	}
+	t, err := temporality(e.OTLPGRPC.TemporalityPreference)
+	if err != nil {
+		return nil, fmt.Errorf("otlp_grpc: %w", err)
+	}
+	opts := []otlpmetricgrpc.Option{otlpmetricgrpc.WithTemporalitySelector(t)}
+	if s.endpoint != "" {
+		opts = append(opts, otlpmetricgrpc.WithEndpointURL(s.endpoint))
+	}
+	if len(s.headers) > 0 {
+		opts = append(opts, otlpmetricgrpc.WithHeaders(s.headers))
+	}
+	if s.gzip {
+		opts = append(opts, otlpmetricgrpc.WithCompressor(compressionGzip))
+	}
+	if s.timeout > 0 {
+		opts = append(opts, otlpmetricgrpc.WithTimeout(s.timeout))
+	}
+	if s.tls != nil {
+		opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(s.tls)))
+	}
+	if s.insecure {
+		opts = append(opts, otlpmetricgrpc.WithInsecure())
+	}
+	return otlpmetricgrpc.New(b.ctx, opts...)
+}
+
+// newView returns the View configured by v.
+func newView(v View) (sdkmetric.View, error) {
+	var inst sdkmetric.Instrument
+	sel := v.Selector
+	if sel.InstrumentName != nil {
+		inst.Name = *sel.InstrumentName
+	}
+	if sel.InstrumentType != nil {
+		k, ok := instrumentKinds[*sel.InstrumentType]
+		if !ok {
+			return nil, fmt.Errorf("unsupported instrument_type %q", *sel.InstrumentType)
+		}
+		inst.Kind = k
+	}
+	if sel.Unit != nil {
+		inst.Unit = *sel.Unit
+	}
+	if sel.MeterName != nil {
+		inst.Scope.Name = *sel.MeterName
+	}
+	if sel.MeterVersion != nil {
+		inst.Scope.Version = *sel.MeterVersion
+	}
+	if sel.MeterSchemaURL != nil {
+		inst.Scope.SchemaURL = *sel.MeterSchemaURL
+	}
+	if inst.IsEmpty() {
+		return nil, errors.New("empty selector")
+	}
+
+	var stream sdkmetric.Stream
+	vs := v.Stream
+	if vs.Name != nil {
+		stream.Name = *vs.Name
+	}
+	if vs.Description != nil {
+		stream.Description = *vs.Description
+	}
+	if vs.Aggregation != nil {
+		agg, err := aggregation(*vs.Aggregation)
+		if err != nil {
+			return nil, err
+		}
+		stream.Aggregation = agg
+	}
+	if vs.AttributeKeys != nil {
+		stream.AttributeFilter = attributeFilter(*vs.AttributeKeys)
+	}
+	return sdkmetric.NewView(inst, stream), nil
+}
+
+// aggregation returns the Aggregation configured by a.
+func aggregation(a Aggregation) (sdkmetric.Aggregation, error) {
+	if err := checkChoice("aggregation", a); err != nil {
+		return nil, err
+	}
+	switch {
+	case a.Default != nil:
+		return sdkmetric.AggregationDefault{}, nil
+	case a.Drop != nil:
+		return sdkmetric.AggregationDrop{}, nil
+	case a.Sum != nil:
+		return sdkmetric.AggregationSum{}, nil
+	case a.LastValue != nil:
+		return sdkmetric.AggregationLastValue{}, nil
+	case a.ExplicitBucketHistogram != nil:
+		h := a.ExplicitBucketHistogram
+		return sdkmetric.AggregationExplicitBucketHistogram{
+			Boundaries: h.Boundaries,
+			NoMinMax:   h.RecordMinMax != nil && !*h.RecordMinMax,
+		}, nil
+	}
+
+	h := a.Base2ExponentialBucketHistogram
+	agg := sdkmetric.AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+	if h.MaxSize != nil {
+		agg.MaxSize = int32(*h.MaxSize) //nolint:gosec // Validated by the SDK.
+	}
+	if h.MaxScale != nil {
+		agg.MaxScale = int32(*h.MaxScale) //nolint:gosec // Validated by the SDK.
+	}
+	agg.NoMinMax = h.RecordMinMax != nil && !*h.RecordMinMax
+	return agg, nil
+}
+
+// attributeFilter returns the attribute Filter of ie.
+func attributeFilter(ie IncludeExclude) attribute.Filter {
+	included := make(map[attribute.Key]struct{}, len(ie.Included))
+	for _, k := range ie.Included {
+		included[attribute.Key(k)] = struct{}{}
+	}
+	excluded := make(map[attribute.Key]struct{}, len(ie.Excluded))
+	for _, k := range ie.Excluded {
+		excluded[attribute.Key(k)] = struct{}{}
+	}
+	return func(kv attribute.KeyValue) bool {
+		if _, ok := excluded[kv.Key]; ok {
+			return false
+		}
+		if len(ie.Included) == 0 {
+			return true
+		}
+		_, ok := included[kv.Key]
+		return ok
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func TestNewView(t *testing.T) {
+	v, err := newView(View{
+		Selector: ViewSelector{
+			InstrumentName: ptr("latency"),
+			InstrumentType: ptr("histogram"),
+			MeterName:      ptr("meter"),
+		},
+		Stream: ViewStream{
+			Name: ptr("latency.renamed"),
+			Aggregation: &Aggregation{Base2ExponentialBucketHistogram: &Base2ExponentialBucketHistogramAggregation{
+				MaxSize: ptr(40),
+			}},
+			AttributeKeys: &IncludeExclude{Included: []string{"a", "b"}, Excluded: []string{"b"}},
+		},
+	})
+	require.NoError(t, err)
+
+	stream, ok := v(sdkmetric.Instrument{
+		Name:  "latency",
+		Kind:  sdkmetric.InstrumentKindHistogram,
+		Scope: instrumentation.Scope{Name: "meter"},
+	})
+	require.True(t, ok)
+	assert.Equal(t, "latency.renamed", stream.Name)
+	assert.Equal(t, sdkmetric.AggregationBase2ExponentialHistogram{MaxSize: 40, MaxScale: 20}, stream.Aggregation)
+	assert.True(t, stream.AttributeFilter(attribute.String("a", "")))
+	assert.False(t, stream.AttributeFilter(attribute.String("b", "")))
+	assert.False(t, stream.AttributeFilter(attribute.String("c", "")))
+
+	_, ok = v(sdkmetric.Instrument{Name: "latency", Kind: sdkmetric.InstrumentKindCounter})
+	assert.False(t, ok)
+}
+
+func TestNewViewErrors(t *testing.T) {
+	for _, v := range []View{
+		{},
+		{Selector: ViewSelector{InstrumentType: ptr("unknown")}},
+		{
+			Selector: ViewSelector{InstrumentName: ptr("*")},
+			Stream:   ViewStream{Aggregation: &Aggregation{}},
+		},
+	} {
+		_, err := newView(v)
+		assert.Error(t, err)
+	}
+}
+
+func TestAggregation(t *testing.T) {
+	tests := []struct {
+		agg  Aggregation
+		want sdkmetric.Aggregation
+	}{
+		{Aggregation{Default: &struct{}{}}, sdkmetric.AggregationDefault{}},
+		{Aggregation{Drop: &struct{}{}}, sdkmetric.AggregationDrop{}},
+		{Aggregation{Sum: &struct{}{}}, sdkmetric.AggregationSum{}},
+		{Aggregation{LastValue: &struct{}{}}, sdkmetric.AggregationLastValue{}},
+		{
+			Aggregation{ExplicitBucketHistogram: &ExplicitBucketHistogramAggregation{
+				Boundaries:   []float64{1, 2},
+				RecordMinMax: ptr(false),
+			}},
+			sdkmetric.AggregationExplicitBucketHistogram{Boundaries: []float64{1, 2}, NoMinMax: true},
+		},
+	}
+	for _, tt := range tests {
+		got, err := aggregation(tt.agg)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestPullMetricReaderUnsupported(t *testing.T) {
+	cfg, err := ParseYAML([]byte(`file_format: "1.0"
+meter_provider:
+  readers:
+    - pull:
+        exporter:
+          prometheus:
+            port: 9464
+`))
+	require.NoError(t, err)
+	require.NotNil(t, cfg.MeterProvider.Readers[0].Pull)
+
+	_, err = NewSDK(WithContext(t.Context()), WithOpenTelemetryConfiguration(*cfg))
+	assert.ErrorContains(t, err, "pull: unsupported metric reader")
+}
@@ -0,0 +1,448 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+// OpenTelemetryConfiguration is the root of the declarative configuration
+// file model.
+type OpenTelemetryConfiguration struct {
+	// FileFormat is the version of the configuration file format. It is
+	// required.
+	FileFormat string `yaml:"file_format"`
+	// Disabled disables the SDK. All the providers are no-op if true.
+	Disabled *bool `yaml:"disabled"`
+	// Resource configures the Resource shared by all the providers.
+	Resource *Resource `yaml:"resource"`
+	// AttributeLimits are the limits applied to all the attributes unless
+	// overridden by the limits of a provider.
+	AttributeLimits *AttributeLimits `yaml:"attribute_limits"`
+	// Propagator configures the TextMapPropagator.
+	Propagator *Propagator `yaml:"propagator"`
+	// TracerProvider configures the TracerProvider. If nil, the
+	// TracerProvider is a no-op.
+	TracerProvider *TracerProvider `yaml:"tracer_provider"`
+	// MeterProvider configures the MeterProvider. If nil, the MeterProvider
+	// is a no-op.
+	MeterProvider *MeterProvider `yaml:"meter_provider"`
+	// LoggerProvider configures the LoggerProvider. If nil, the
+	// LoggerProvider is a no-op.
+	LoggerProvider *LoggerProvider `yaml:"logger_provider"`
+}
+
+// Resource configures a Resource.
+type Resource struct {
+	// Attributes are the attributes of the Resource.
+	Attributes []AttributeNameValue `yaml:"attributes"`
+	// AttributesList are attributes of the Resource in the format of the
+	// OTEL_RESOURCE_ATTRIBUTES environment variable. Attributes take
+	// precedence over AttributesList.
+	AttributesList *string `yaml:"attributes_list"`
+	// SchemaURL is the schema URL of the Resource.
+	SchemaURL *string `yaml:"schema_url"`
+}
+
+// AttributeNameValue is a typed attribute.
+type AttributeNameValue struct {
+	// Name is the attribute key.
+	Name string `yaml:"name"`
+	// Value is the attribute value.
+	Value any `yaml:"value"`
+	// Type is the attribute type. One of "string", "bool", "int", "double",
+	// "string_array", "bool_array", "int_array", or "double_array". If nil,
+	// the type is inferred from Value.
+	Type *string `yaml:"type"`
+}
+
+// AttributeLimits are the limits of attributes.
+type AttributeLimits struct {
+	// AttributeValueLengthLimit is the maximum length of string attribute
+	// values.
+	AttributeValueLengthLimit *int `yaml:"attribute_value_length_limit"`
+	// AttributeCountLimit is the maximum number of attributes.
+	AttributeCountLimit *int `yaml:"attribute_count_limit"`
+}
+
+// Propagator configures a composite TextMapPropagator.
+type Propagator struct {
+	// Composite are the propagators, in order. Each entry has a single key,
+	// the name of the propagator.
+	Composite []map[string]any `yaml:"composite"`
+	// CompositeList are the names of the propagators in the format of the
+	// OTEL_PROPAGATORS environment variable. Propagators listed are added
+	// after the ones of Composite.
+	CompositeList *string `yaml:"composite_list"`
+}
+
+// TracerProvider configures a TracerProvider.
+type TracerProvider struct {
+	// Processors are the span processors, in the order they are registered.
+	Processors []SpanProcessor `yaml:"processors"`
+	// Limits are the span limits.
+	Limits *SpanLimits `yaml:"limits"`
+	// Sampler is the sampler. If nil, the parent based always on sampler is
+	// used.
+	Sampler *Sampler `yaml:"sampler"`
+}
+
+// SpanProcessor configures a span processor. Exactly one field must be set.
+type SpanProcessor struct {
+	// Batch configures a batch span processor.
+	Batch *BatchSpanProcessor `yaml:"batch"`
+	// Simple configures a simple span processor.
+	Simple *SimpleSpanProcessor `yaml:"simple"`
+}
+
+// BatchSpanProcessor configures a batch span processor.
+type BatchSpanProcessor struct {
+	// ScheduleDelay is the delay between two exports, in milliseconds.
+	ScheduleDelay *int `yaml:"schedule_delay"`
+	// ExportTimeout is the maximum duration of an export, in milliseconds.
+	ExportTimeout *int `yaml:"export_timeout"`
+	// MaxQueueSize is the maximum queue size.
+	MaxQueueSize *int `yaml:"max_queue_size"`
+	// MaxExportBatchSize is the maximum batch size.
+	MaxExportBatchSize *int `yaml:"max_export_batch_size"`
+	// Exporter is the span exporter.
+	Exporter SpanExporter `yaml:"exporter"`
+}
+
+// SimpleSpanProcessor configures a simple span processor.
+type SimpleSpanProcessor struct {
+	// Exporter is the span exporter.
+	Exporter SpanExporter `yaml:"exporter"`
+}
+
+// SpanExporter configures a span exporter. Exactly one field must be set.
+type SpanExporter struct {
+	// OTLPHTTP configures an OTLP exporter using HTTP.
+	OTLPHTTP *OTLPHTTPExporter `yaml:"otlp_http"`
+	// OTLPGRPC configures an OTLP exporter using gRPC.
+	OTLPGRPC *OTLPGRPCExporter `yaml:"otlp_grpc"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console"`
+}
+
+// SpanLimits are the limits of spans.
+type SpanLimits struct {
+	// AttributeValueLengthLimit is the maximum length of string attribute
+	// values.
+	AttributeValueLengthLimit *int `yaml:"attribute_value_length_limit"`
+	// AttributeCountLimit is the maximum number of span attributes.
+	AttributeCountLimit *int `yaml:"attribute_count_limit"`
+	// EventCountLimit is the maximum number of span events.
+	EventCountLimit *int `yaml:"event_count_limit"`
+	// LinkCountLimit is the maximum number of span links.
+	LinkCountLimit *int `yaml:"link_count_limit"`
+	// EventAttributeCountLimit is the maximum number of attributes per span
+	// event.
+	EventAttributeCountLimit *int `yaml:"event_attribute_count_limit"`
+	// LinkAttributeCountLimit is the maximum number of attributes per span
+	// link.
+	LinkAttributeCountLimit *int `yaml:"link_attribute_count_limit"`
+}
+
+// Sampler configures a sampler. Exactly one field must be set.
+type Sampler struct {
+	// AlwaysOn configures a sampler sampling all spans.
+	AlwaysOn *struct{} `yaml:"always_on"`
+	// AlwaysOff configures a sampler sampling no spans.
+	AlwaysOff *struct{} `yaml:"always_off"`
+	// TraceIDRatioBased configures a sampler sampling a ratio of the traces.
+	TraceIDRatioBased *TraceIDRatioBasedSampler `yaml:"trace_id_ratio_based"`
+	// ParentBased configures a sampler using the sampling decision of the
+	// parent span.
+	ParentBased *ParentBasedSampler `yaml:"parent_based"`
+}
+
+// TraceIDRatioBasedSampler configures a trace ID ratio based sampler.
+type TraceIDRatioBasedSampler struct {
+	// Ratio is the ratio of traces sampled, between 0 and 1. If nil, 1 is
+	// used.
+	Ratio *float64 `yaml:"ratio"`
+}
+
+// ParentBasedSampler configures a parent based sampler. The samplers not set
+// use the default of the parent based sampler.
+type ParentBasedSampler struct {
+	// Root is the sampler used for spans without a parent.
+	Root *Sampler `yaml:"root"`
+	// RemoteParentSampled is the sampler used for spans with a sampled
+	// remote parent.
+	RemoteParentSampled *Sampler `yaml:"remote_parent_sampled"`
+	// RemoteParentNotSampled is the sampler used for spans with a remote
+	// parent that is not sampled.
+	RemoteParentNotSampled *Sampler `yaml:"remote_parent_not_sampled"`
+	// LocalParentSampled is the sampler used for spans with a sampled local
+	// parent.
+	LocalParentSampled *Sampler `yaml:"local_parent_sampled"`
+	// LocalParentNotSampled is the sampler used for spans with a local parent
+	// that is not sampled.
+	LocalParentNotSampled *Sampler `yaml:"local_parent_not_sampled"`
+}
+
+// MeterProvider configures a MeterProvider.
+type MeterProvider struct {
+	// Readers are the metric readers.
+	Readers []MetricReader `yaml:"readers"`
+	// Views are the views, in the order they are registered.
+	Views []View `yaml:"views"`
+}
+
+// MetricReader configures a metric reader. Exactly one field must be set.
+type MetricReader struct {
+	// Periodic configures a periodic reader.
+	Periodic *PeriodicMetricReader `yaml:"periodic"`
+	// Pull configures a pull reader, e.g. a Prometheus exporter. Pull
+	// readers are not supported: NewSDK returns an error if one is
+	// configured.
+	Pull *PullMetricReader `yaml:"pull"`
+}
+
+// PullMetricReader configures a pull reader.
+type PullMetricReader struct {
+	// Exporter is the pull metric exporter, e.g. "prometheus", and its
+	// configuration.
+	Exporter map[string]any `yaml:"exporter"`
+}
+
+// PeriodicMetricReader configures a periodic reader.
+type PeriodicMetricReader struct {
+	// Interval is the delay between two exports, in milliseconds.
+	Interval *int `yaml:"interval"`
+	// Timeout is the maximum duration of an export, in milliseconds.
+	Timeout *int `yaml:"timeout"`
+	// Exporter is the metric exporter.
+	Exporter MetricExporter `yaml:"exporter"`
+}
+
+// MetricExporter configures a metric exporter. Exactly one field must be
+// set.
+type MetricExporter struct {
+	// OTLPHTTP configures an OTLP exporter using HTTP.
+	OTLPHTTP *OTLPHTTPMetricExporter `yaml:"otlp_http"`
+	// OTLPGRPC configures an OTLP exporter using gRPC.
+	OTLPGRPC *OTLPGRPCMetricExporter `yaml:"otlp_grpc"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console"`
+}
+
+// OTLPHTTPMetricExporter configures an OTLP metric exporter using HTTP.
+type OTLPHTTPMetricExporter struct {
+	OTLPHTTPExporter `yaml:",inline"`
+
+	// TemporalityPreference is the temporality of the exported metrics. One
+	// of "cumulative", "delta", or "low_memory". If nil, "cumulative" is
+	// used.
+	TemporalityPreference *string `yaml:"temporality_preference"`
+}
+
+// OTLPGRPCMetricExporter configures an OTLP metric exporter using gRPC.
+type OTLPGRPCMetricExporter struct {
+	OTLPGRPCExporter `yaml:",inline"`
+
+	// TemporalityPreference is the temporality of the exported metrics. One
+	// of "cumulative", "delta", or "low_memory". If nil, "cumulative" is
+	// used.
+	TemporalityPreference *string `yaml:"temporality_preference"`
+}
+
+// View configures a view.
+type View struct {
+	// Selector selects the instruments the view applies to.
+	Selector ViewSelector `yaml:"selector"`
+	// Stream is the stream of the selected instruments.
+	Stream ViewStream `yaml:"stream"`
+}
+
+// ViewSelector selects instruments. Unset fields match all instruments.
+type ViewSelector struct {
+	// InstrumentName is the instrument name. It may contain the "*" and "?"
+	// wildcards.
+	InstrumentName *string `yaml:"instrument_name"`
+	// InstrumentType is the instrument type. One of "counter", "gauge",
+	// "histogram", "observable_counter", "observable_gauge",
+	// "observable_up_down_counter", or "up_down_counter".
+	InstrumentType *string `yaml:"instrument_type"`
+	// Unit is the instrument unit.
+	Unit *string `yaml:"unit"`
+	// MeterName is the name of the meter of the instrument.
+	MeterName *string `yaml:"meter_name"`
+	// MeterVersion is the version of the meter of the instrument.
+	MeterVersion *string `yaml:"meter_version"`
+	// MeterSchemaURL is the schema URL of the meter of the instrument.
+	MeterSchemaURL *string `yaml:"meter_schema_url"`
+}
+
+// ViewStream configures the stream of a view.
+type ViewStream struct {
+	// Name is the stream name.
+	Name *string `yaml:"name"`
+	// Description is the stream description.
+	Description *string `yaml:"description"`
+	// Aggregation is the stream aggregation.
+	Aggregation *Aggregation `yaml:"aggregation"`
+	// AttributeKeys filters the attributes of the stream.
+	AttributeKeys *IncludeExclude `yaml:"attribute_keys"`
+}
+
+// IncludeExclude filters attribute keys. If Included is set, only the
+// included keys are kept. Excluded keys are always dropped.
+type IncludeExclude struct {
+	// Included are the kept keys.
+	Included []string `yaml:"included"`
+	// Excluded are the dropped keys.
+	Excluded []string `yaml:"excluded"`
+}
+
+// Aggregation configures an aggregation. Exactly one field must be set.
+type Aggregation struct {
+	// Default uses the default aggregation of the instrument.
+	Default *struct{} `yaml:"default"`
+	// Drop drops all the measurements.
+	Drop *struct{} `yaml:"drop"`
+	// Sum aggregates the arithmetic sum of the measurements.
+	Sum *struct{} `yaml:"sum"`
+	// LastValue aggregates the last measurement.
+	LastValue *struct{} `yaml:"last_value"`
+	// ExplicitBucketHistogram aggregates the measurements in a histogram
+	// with explicit bucket boundaries.
+	ExplicitBucketHistogram *ExplicitBucketHistogramAggregation `yaml:"explicit_bucket_histogram"`
+	// Base2ExponentialBucketHistogram aggregates the measurements in a base2
+	// exponential histogram.
+	Base2ExponentialBucketHistogram *Base2ExponentialBucketHistogramAggregation `yaml:"base2_exponential_bucket_histogram"`
+}
+
+// ExplicitBucketHistogramAggregation configures an explicit bucket histogram
+// aggregation.
+type ExplicitBucketHistogramAggregation struct {
+	// Boundaries are the increasing bucket boundaries.
+	Boundaries []float64 `yaml:"boundaries"`
+	// RecordMinMax records the minimum and maximum measurements. If nil,
+	// true is used.
+	RecordMinMax *bool `yaml:"record_min_max"`
+}
+
+// Base2ExponentialBucketHistogramAggregation configures a base2 exponential
+// histogram aggregation.
+type Base2ExponentialBucketHistogramAggregation struct {
+	// MaxScale is the maximum scale. If nil, 20 is used.
+	MaxScale *int `yaml:"max_scale"`
+	// MaxSize is the maximum number of buckets. If nil, 160 is used.
+	MaxSize *int `yaml:"max_size"`
+	// RecordMinMax records the minimum and maximum measurements. If nil,
+	// true is used.
+	RecordMinMax *bool `yaml:"record_min_max"`
+}
+
+// LoggerProvider configures a LoggerProvider.
+type LoggerProvider struct {
+	// Processors are the log record processors, in the order they are
+	// registered.
+	Processors []LogRecordProcessor `yaml:"processors"`
+	// Limits are the log record limits.
+	Limits *AttributeLimits `yaml:"limits"`
+}
+
+// LogRecordProcessor configures a log record processor. Exactly one field
+// must be set.
+type LogRecordProcessor struct {
+	// Batch configures a batch log record processor.
+	Batch *BatchLogRecordProcessor `yaml:"batch"`
+	// Simple configures a simple log record processor.
+	Simple *SimpleLogRecordProcessor `yaml:"simple"`
+}
+
+// BatchLogRecordProcessor configures a batch log record processor.
+type BatchLogRecordProcessor struct {
+	// ScheduleDelay is the delay between two exports, in milliseconds.
+	ScheduleDelay *int `yaml:"schedule_delay"`
+	// ExportTimeout is the maximum duration of an export, in milliseconds.
+	ExportTimeout *int `yaml:"export_timeout"`
+	// MaxQueueSize is the maximum queue size.
+	MaxQueueSize *int `yaml:"max_queue_size"`
+	// MaxExportBatchSize is the maximum batch size.
+	MaxExportBatchSize *int `yaml:"max_export_batch_size"`
+	// Exporter is the log record exporter.
+	Exporter LogRecordExporter `yaml:"exporter"`
+}
+
+// SimpleLogRecordProcessor configures a simple log record processor.
+type SimpleLogRecordProcessor struct {
+	// Exporter is the log record exporter.
+	Exporter LogRecordExporter `yaml:"exporter"`
+}
+
+// LogRecordExporter configures a log record exporter. Exactly one field must
+// be set.
+type LogRecordExporter struct {
+	// OTLPHTTP configures an OTLP exporter using HTTP.
+	OTLPHTTP *OTLPHTTPExporter `yaml:"otlp_http"`
+	// OTLPGRPC configures an OTLP exporter using gRPC.
+	OTLPGRPC *OTLPGRPCExporter `yaml:"otlp_grpc"`
+	// Console configures an exporter writing to the standard output.
+	Console *Console `yaml:"console"`
+}
+
+// OTLPHTTPExporter configures an OTLP exporter using HTTP.
+type OTLPHTTPExporter struct {
+	// Endpoint is the URL of the signal endpoint (e.g.
+	// http://localhost:4318/v1/traces).
+	Endpoint *string `yaml:"endpoint"`
+	// Headers are the headers sent with each export request.
+	Headers []NameStringValuePair `yaml:"headers"`
+	// HeadersList are headers in the format of the OTEL_EXPORTER_OTLP_HEADERS
+	// environment variable. Headers take precedence over HeadersList.
+	HeadersList *string `yaml:"headers_list"`
+	// Compression is the compression. One of "gzip" or "none".
+	Compression *string `yaml:"compression"`
+	// Timeout is the maximum duration of an export request, in
+	// milliseconds.
+	Timeout *int `yaml:"timeout"`
+	// Encoding is the payload encoding. Only "protobuf" is supported.
+	Encoding *string `yaml:"encoding"`
+	// TLS configures the TLS of the connection.
+	TLS *ClientTLS `yaml:"tls"`
+}
+
+// OTLPGRPCExporter configures an OTLP exporter using gRPC.
+type OTLPGRPCExporter struct {
+	// Endpoint is the URL of the collector (e.g. http://localhost:4317).
+	Endpoint *string `yaml:"endpoint"`
+	// Headers are the metadata sent with each export request.
+	Headers []NameStringValuePair `yaml:"headers"`
+	// HeadersList are headers in the format of the OTEL_EXPORTER_OTLP_HEADERS
+	// environment variable. Headers take precedence over HeadersList.
+	HeadersList *string `yaml:"headers_list"`
+	// Compression is the compression. One of "gzip" or "none".
+	Compression *string `yaml:"compression"`
+	// Timeout is the maximum duration of an export request, in
+	// milliseconds.
+	Timeout *int `yaml:"timeout"`
+	// TLS configures the TLS of the connection.
+	TLS *ClientTLS `yaml:"tls"`
+}
+
+// ClientTLS configures the TLS of a client connection.
+type ClientTLS struct {
+	// CAFile is the path of the PEM encoded CA certificates used to verify
+	// the server certificate.
+	CAFile *string `yaml:"ca_file"`
+	// KeyFile is the path of the PEM encoded client private key.
+	KeyFile *string `yaml:"key_file"`
+	// CertFile is the path of the PEM encoded client certificate.
+	CertFile *string `yaml:"cert_file"`
+	// Insecure disables TLS. It is only used with gRPC, the scheme of the
+	// endpoint determines it with HTTP.
+	Insecure *bool `yaml:"insecure"`
+}
+
+// NameStringValuePair is a header.
+type NameStringValuePair struct {
+	// Name is the header name.
+	Name string `yaml:"name"`
+	// Value is the header value.
+	Value *string `yaml:"value"`
+}
+
+// Console configures an exporter writing to the standard output.
+type Console struct{}
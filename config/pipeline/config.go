@@ -0,0 +1,213 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// config contains the options of a Pipeline.
+type config struct {
+	resource *resource.Resource
+
+	otlpEndpoint string
+	otlpHeaders  map[string]string
+
+	sampler     sdktrace.Sampler
+	temporality sdkmetric.TemporalitySelector
+
+	// The processors and readers are created by New, so no goroutine is
+	// started by the options.
+	spanProcessors []func() sdktrace.SpanProcessor
+	readers        []func() sdkmetric.Reader
+	logProcessors  []func() sdklog.Processor
+
+	tracerProviderOpts []sdktrace.TracerProviderOption
+	meterProviderOpts  []sdkmetric.Option
+	loggerProviderOpts []sdklog.LoggerProviderOption
+}
+
+func newConfig(opts []Option) config {
+	var cfg config
+	for _, opt := range opts {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option configures a Pipeline.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithResource sets the Resource of the TracerProvider, MeterProvider, and
+// LoggerProvider. It is merged with the environment Resource by the
+// providers, the same as their own WithResource options.
+//
+// By default, the default Resource of the providers is used.
+func WithResource(res *resource.Resource) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.resource = res
+		return cfg
+	})
+}
+
+// WithOTLPEndpoint exports the spans, metrics, and log records with OTLP over
+// HTTP to the collector at endpointURL, e.g. "http://collector:4318". The
+// signal paths, "/v1/traces", "/v1/metrics", and "/v1/logs", are appended
+// to the path of endpointURL.
+//
+// The spans and log records are exported in batches, the metrics
+// periodically. The OTLP exporters are configured with the OTEL_EXPORTER_OTLP_*
+// environment variables for the other settings, e.g. the timeout or the
+// compression.
+//
+// By default, no OTLP exporter is created.
+func WithOTLPEndpoint(endpointURL string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.otlpEndpoint = endpointURL
+		return cfg
+	})
+}
+
+// WithOTLPHeaders sets the HTTP headers sent with the OTLP exports of all the
+// signals, e.g. an authentication header. It has no effect without
+// WithOTLPEndpoint.
+func WithOTLPHeaders(headers map[string]string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.otlpHeaders = headers
+		return cfg
+	})
+}
+
+// WithSampler sets the Sampler of the TracerProvider.
+//
+// By default, the default Sampler of the TracerProvider is used.
+func WithSampler(sampler sdktrace.Sampler) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.sampler = sampler
+		return cfg
+	})
+}
+
+// WithTemporalitySelector sets the TemporalitySelector of the OTLP metric
+// exporter created with WithOTLPEndpoint. It has no effect on the readers
+// passed to WithMetricReader and the exporters passed to WithMetricExporter,
+// which select their own temporality.
+//
+// By default, the cumulative temporality is used for all instruments.
+func WithTemporalitySelector(selector sdkmetric.TemporalitySelector) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.temporality = selector
+		return cfg
+	})
+}
+
+// WithSpanExporter exports the spans with exporter in batches, using a
+// BatchSpanProcessor with its default settings.
+//
+// This option can be used multiple times to export to multiple destinations.
+func WithSpanExporter(exporter sdktrace.SpanExporter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.spanProcessors = append(cfg.spanProcessors, func() sdktrace.SpanProcessor {
+			return sdktrace.NewBatchSpanProcessor(exporter)
+		})
+		return cfg
+	})
+}
+
+// WithSpanProcessor registers processor with the TracerProvider.
+//
+// This option can be used multiple times.
+func WithSpanProcessor(processor sdktrace.SpanProcessor) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.spanProcessors = append(cfg.spanProcessors, func() sdktrace.SpanProcessor { return processor })
+		return cfg
+	})
+}
+
+// WithMetricExporter exports the metrics with exporter periodically, using a
+// PeriodicReader with its default settings.
+//
+// This option can be used multiple times to export to multiple destinations.
+func WithMetricExporter(exporter sdkmetric.Exporter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.readers = append(cfg.readers, func() sdkmetric.Reader {
+			return sdkmetric.NewPeriodicReader(exporter)
+		})
+		return cfg
+	})
+}
+
+// WithMetricReader registers reader with the MeterProvider.
+//
+// This option can be used multiple times.
+func WithMetricReader(reader sdkmetric.Reader) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.readers = append(cfg.readers, func() sdkmetric.Reader { return reader })
+		return cfg
+	})
+}
+
+// WithLogExporter exports the log records with exporter in batches, using a
+// BatchProcessor with its default settings.
+//
+// This option can be used multiple times to export to multiple destinations.
+func WithLogExporter(exporter sdklog.Exporter) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.logProcessors = append(cfg.logProcessors, func() sdklog.Processor {
+			return sdklog.NewBatchProcessor(exporter)
+		})
+		return cfg
+	})
+}
+
+// WithLogProcessor registers processor with the LoggerProvider.
+//
+// This option can be used multiple times.
+func WithLogProcessor(processor sdklog.Processor) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.logProcessors = append(cfg.logProcessors, func() sdklog.Processor { return processor })
+		return cfg
+	})
+}
+
+// WithTracerProviderOptions passes opts to the TracerProvider, e.g. to set
+// its SpanLimits. They are applied after the settings of the Pipeline and
+// take precedence over them.
+func WithTracerProviderOptions(opts ...sdktrace.TracerProviderOption) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.tracerProviderOpts = append(cfg.tracerProviderOpts, opts...)
+		return cfg
+	})
+}
+
+// WithMeterProviderOptions passes opts to the MeterProvider, e.g. to register
+// views. They are applied after the settings of the Pipeline and take
+// precedence over them.
+func WithMeterProviderOptions(opts ...sdkmetric.Option) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.meterProviderOpts = append(cfg.meterProviderOpts, opts...)
+		return cfg
+	})
+}
+
+// WithLoggerProviderOptions passes opts to the LoggerProvider, e.g. to set
+// its attribute limits. They are applied after the settings of the Pipeline
+// and take precedence over them.
+func WithLoggerProviderOptions(opts ...sdklog.LoggerProviderOption) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.loggerProviderOpts = append(cfg.loggerProviderOpts, opts...)
+		return cfg
+	})
+}
@@ -0,0 +1,38 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package pipeline sets up the TracerProvider, MeterProvider, and
+LoggerProvider of the OpenTelemetry SDK from a single declaration.
+
+Setting up the three signals with the SDK packages repeats the same steps
+for each of them: creating an exporter for the same collector endpoint,
+wrapping it in a processor or reader, and creating a provider with the same
+Resource. The application then needs to keep the three providers to shut
+them down. A [Pipeline] does these steps once:
+
+	p, err := pipeline.New(ctx,
+		pipeline.WithResource(res),
+		pipeline.WithOTLPEndpoint("http://collector:4318"),
+		pipeline.WithSampler(trace.TraceIDRatioBased(0.25)),
+	)
+	if err != nil {
+		return err
+	}
+	defer p.Shutdown(context.Background())
+	p.SetGlobal()
+
+Unlike [go.opentelemetry.io/otel/config.NewSDK], which creates the providers
+from a declarative configuration model, a Pipeline is declared in code and
+accepts the exporters, processors, readers, and options of the SDK packages.
+
+The settings shared by the signals, such as the Resource, the OTLP endpoint,
+and the metric temporality, are declared once and applied consistently to
+all of them. The providers can be further customized with the options of
+their SDK packages, see [WithTracerProviderOptions],
+[WithMeterProviderOptions], and [WithLoggerProviderOptions].
+
+This package is experimental. Its API may change in backwards incompatible
+ways in minor releases.
+*/
+package pipeline
@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline_test
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/config/pipeline"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+func Example() {
+	ctx := context.Background()
+
+	p, err := pipeline.New(ctx,
+		// The Resource is shared by the three signals.
+		pipeline.WithResource(resource.NewSchemaless(attribute.String("service.name", "checkout"))),
+		// The spans, metrics, and logs are exported to the same collector.
+		pipeline.WithOTLPEndpoint("http://localhost:4318"),
+		pipeline.WithSampler(trace.ParentBased(trace.TraceIDRatioBased(0.25))),
+		pipeline.WithTemporalitySelector(func(metric.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		}),
+	)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	defer func() {
+		if err := p.Shutdown(context.Background()); err != nil {
+			fmt.Println(err)
+		}
+	}()
+
+	// Register the providers as the global ones used by the
+	// instrumentation libraries.
+	p.SetGlobal()
+}
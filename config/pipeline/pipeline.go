@@ -0,0 +1,182 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Pipeline holds a TracerProvider, a MeterProvider, and a LoggerProvider set
+// up from the same options.
+//
+// Use [New] to create a Pipeline.
+type Pipeline struct {
+	tracerProvider *sdktrace.TracerProvider
+	meterProvider  *sdkmetric.MeterProvider
+	loggerProvider *sdklog.LoggerProvider
+}
+
+// New returns a new Pipeline configured with opts. ctx is used to create the
+// OTLP exporters, see WithOTLPEndpoint.
+//
+// An error is returned if the OTLP exporters cannot be created. No provider
+// is created in that case.
+func New(ctx context.Context, opts ...Option) (*Pipeline, error) {
+	cfg := newConfig(opts)
+
+	if cfg.otlpEndpoint != "" {
+		var err error
+		cfg, err = withOTLPExporters(ctx, cfg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var tpOpts []sdktrace.TracerProviderOption
+	var mpOpts []sdkmetric.Option
+	var lpOpts []sdklog.LoggerProviderOption
+	if cfg.resource != nil {
+		tpOpts = append(tpOpts, sdktrace.WithResource(cfg.resource))
+		mpOpts = append(mpOpts, sdkmetric.WithResource(cfg.resource))
+		lpOpts = append(lpOpts, sdklog.WithResource(cfg.resource))
+	}
+	if cfg.sampler != nil {
+		tpOpts = append(tpOpts, sdktrace.WithSampler(cfg.sampler))
+	}
+	for _, newProcessor := range cfg.spanProcessors {
+		tpOpts = append(tpOpts, sdktrace.WithSpanProcessor(newProcessor()))
+	}
+	for _, newReader := range cfg.readers {
+		mpOpts = append(mpOpts, sdkmetric.WithReader(newReader()))
+	}
+	for _, newProcessor := range cfg.logProcessors {
+		lpOpts = append(lpOpts, sdklog.WithProcessor(newProcessor()))
+	}
+
+	return &Pipeline{
+		tracerProvider: sdktrace.NewTracerProvider(append(tpOpts, cfg.tracerProviderOpts...)...),
+		meterProvider:  sdkmetric.NewMeterProvider(append(mpOpts, cfg.meterProviderOpts...)...),
+		loggerProvider: sdklog.NewLoggerProvider(append(lpOpts, cfg.loggerProviderOpts...)...),
+	}, nil
+}
+
+// withOTLPExporters returns cfg with the OTLP exporters of its endpoint
+// registered first.
+func withOTLPExporters(ctx context.Context, cfg config) (config, error) {
+	u, err := url.Parse(cfg.otlpEndpoint)
+	if err != nil {
+		return cfg, fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return cfg, fmt.Errorf("invalid OTLP endpoint: %q: missing scheme or host", cfg.otlpEndpoint)
+	}
+
+	traceOpts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(u.JoinPath("v1/traces").String())}
+	metricOpts := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpointURL(u.JoinPath("v1/metrics").String())}
+	logOpts := []otlploghttp.Option{otlploghttp.WithEndpointURL(u.JoinPath("v1/logs").String())}
+	if cfg.otlpHeaders != nil {
+		traceOpts = append(traceOpts, otlptracehttp.WithHeaders(cfg.otlpHeaders))
+		metricOpts = append(metricOpts, otlpmetrichttp.WithHeaders(cfg.otlpHeaders))
+		logOpts = append(logOpts, otlploghttp.WithHeaders(cfg.otlpHeaders))
+	}
+	if cfg.temporality != nil {
+		metricOpts = append(metricOpts, otlpmetrichttp.WithTemporalitySelector(cfg.temporality))
+	}
+
+	spanExp, err := otlptracehttp.New(ctx, traceOpts...)
+	if err != nil {
+		return cfg, fmt.Errorf("OTLP span exporter: %w", err)
+	}
+	metricExp, err := otlpmetrichttp.New(ctx, metricOpts...)
+	if err != nil {
+		return cfg, errors.Join(
+			fmt.Errorf("OTLP metric exporter: %w", err),
+			spanExp.Shutdown(ctx),
+		)
+	}
+	logExp, err := otlploghttp.New(ctx, logOpts...)
+	if err != nil {
+		return cfg, errors.Join(
+			fmt.Errorf("OTLP log exporter: %w", err),
+			spanExp.Shutdown(ctx),
+			metricExp.Shutdown(ctx),
+		)
+	}
+
+	// Options only append, the user options are kept after the OTLP ones.
+	otlp := newConfig([]Option{
+		WithSpanExporter(spanExp),
+		WithMetricExporter(metricExp),
+		WithLogExporter(logExp),
+	})
+	cfg.spanProcessors = append(otlp.spanProcessors, cfg.spanProcessors...)
+	cfg.readers = append(otlp.readers, cfg.readers...)
+	cfg.logProcessors = append(otlp.logProcessors, cfg.logProcessors...)
+	return cfg, nil
+}
+
+// TracerProvider returns the TracerProvider of the Pipeline.
+func (p *Pipeline) TracerProvider() *sdktrace.TracerProvider {
+	return p.tracerProvider
+}
+
+// MeterProvider returns the MeterProvider of the Pipeline.
+func (p *Pipeline) MeterProvider() *sdkmetric.MeterProvider {
+	return p.meterProvider
+}
+
+// LoggerProvider returns the LoggerProvider of the Pipeline.
+func (p *Pipeline) LoggerProvider() *sdklog.LoggerProvider {
+	return p.loggerProvider
+}
+
+// SetGlobal registers the providers of the Pipeline as the global
+// TracerProvider and MeterProvider of go.opentelemetry.io/otel and as the
+// global LoggerProvider of go.opentelemetry.io/otel/log/global.
+//
+// The LoggerProvider is also registered with otel.RegisterShutdownHook, so
+// otel.Shutdown shuts down all the providers of the Pipeline.
+func (p *Pipeline) SetGlobal() {
+	otel.SetTracerProvider(p.tracerProvider)
+	otel.SetMeterProvider(p.meterProvider)
+	logglobal.SetLoggerProvider(p.loggerProvider)
+	otel.RegisterShutdownHook(p.loggerProvider.Shutdown)
+}
+
+// ForceFlush flushes the telemetry held by all the providers. All the
+// providers are flushed even if one returns an error. The returned error
+// joins the errors of all the providers.
+func (p *Pipeline) ForceFlush(ctx context.Context) error {
+	return errors.Join(
+		p.tracerProvider.ForceFlush(ctx),
+		p.meterProvider.ForceFlush(ctx),
+		p.loggerProvider.ForceFlush(ctx),
+	)
+}
+
+// Shutdown shuts down all the providers, flushing the telemetry they hold.
+// All the providers are shut down even if one returns an error. The returned
+// error joins the errors of all the providers.
+//
+// The MeterProvider is shut down last so the metrics recorded while the
+// other providers are shut down are exported.
+func (p *Pipeline) Shutdown(ctx context.Context) error {
+	return errors.Join(
+		p.tracerProvider.Shutdown(ctx),
+		p.loggerProvider.Shutdown(ctx),
+		p.meterProvider.Shutdown(ctx),
+	)
+}
@@ -0,0 +1,195 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package pipeline
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	collectormetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// logExporter records the exported log records.
+type logExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *logExporter) Export(_ context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (*logExporter) Shutdown(context.Context) error { return nil }
+
+func (*logExporter) ForceFlush(context.Context) error { return nil }
+
+func TestPipeline(t *testing.T) {
+	res := resource.NewSchemaless(attribute.String("service.name", "pipeline-test"))
+	spanExp := tracetest.NewInMemoryExporter()
+	reader := sdkmetric.NewManualReader()
+	logExp := &logExporter{}
+
+	p, err := New(t.Context(),
+		WithResource(res),
+		WithSampler(sdktrace.NeverSample()),
+		WithSpanExporter(spanExp),
+		WithMetricReader(reader),
+		WithLogExporter(logExp),
+		// The provider options take precedence over the Pipeline settings.
+		WithTracerProviderOptions(sdktrace.WithSampler(sdktrace.AlwaysSample())),
+	)
+	require.NoError(t, err)
+
+	_, span := p.TracerProvider().Tracer("test").Start(t.Context(), "span")
+	span.End()
+
+	counter, err := p.MeterProvider().Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1)
+
+	var r log.Record
+	r.SetBody(attribute.StringValue("message"))
+	p.LoggerProvider().Logger("test").Emit(t.Context(), r)
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+
+	spans := spanExp.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "span", spans[0].Name)
+	assert.Equal(t, "pipeline-test", serviceName(spans[0].Resource))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	assert.Equal(t, "pipeline-test", serviceName(rm.Resource))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	logExp.mu.Lock()
+	require.Len(t, logExp.records, 1)
+	assert.Equal(t, "pipeline-test", serviceName(logExp.records[0].Resource()))
+	logExp.mu.Unlock()
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.ErrorIs(t, reader.Collect(t.Context(), &rm), sdkmetric.ErrReaderShutdown)
+}
+
+func serviceName(res *resource.Resource) string {
+	v, _ := res.Set().Value("service.name")
+	return v.AsString()
+}
+
+func TestPipelineOTLPEndpoint(t *testing.T) {
+	var (
+		mu          sync.Mutex
+		paths       []string
+		authorized  = true
+		metricsBody []byte
+	)
+	handleExport := func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		paths = append(paths, r.URL.Path)
+		authorized = authorized && r.Header.Get("Authorization") == "Bearer token"
+		if r.URL.Path == "/otlp/v1/metrics" {
+			metricsBody = body
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(handleExport))
+	t.Cleanup(srv.Close)
+
+	p, err := New(t.Context(),
+		WithOTLPEndpoint(srv.URL+"/otlp"),
+		WithOTLPHeaders(map[string]string{"Authorization": "Bearer token"}),
+		WithTemporalitySelector(func(sdkmetric.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		}),
+	)
+	require.NoError(t, err)
+
+	_, span := p.TracerProvider().Tracer("test").Start(t.Context(), "span")
+	span.End()
+	counter, err := p.MeterProvider().Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1)
+	p.LoggerProvider().Logger("test").Emit(t.Context(), log.Record{})
+
+	require.NoError(t, p.Shutdown(t.Context()))
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.ElementsMatch(t, []string{"/otlp/v1/traces", "/otlp/v1/metrics", "/otlp/v1/logs"}, paths)
+	assert.True(t, authorized, "headers not sent")
+
+	var req collectormetricpb.ExportMetricsServiceRequest
+	require.NoError(t, proto.Unmarshal(metricsBody, &req))
+	var temporality []metricpb.AggregationTemporality
+	for _, rm := range req.ResourceMetrics {
+		for _, sm := range rm.ScopeMetrics {
+			for _, m := range sm.Metrics {
+				temporality = append(temporality, m.GetSum().GetAggregationTemporality())
+			}
+		}
+	}
+	assert.Equal(t, []metricpb.AggregationTemporality{
+		metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+	}, temporality)
+}
+
+func TestPipelineInvalidOTLPEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"collector:4318", "http://%zz", "/v1"} {
+		p, err := New(t.Context(), WithOTLPEndpoint(endpoint))
+		assert.Error(t, err, endpoint)
+		assert.Nil(t, p, endpoint)
+	}
+}
+
+func TestPipelineSetGlobal(t *testing.T) {
+	tp, mp, lp := otel.GetTracerProvider(), otel.GetMeterProvider(), logglobal.GetLoggerProvider()
+	t.Cleanup(func() {
+		otel.SetTracerProvider(tp)
+		otel.SetMeterProvider(mp)
+		logglobal.SetLoggerProvider(lp)
+	})
+
+	logExp := &logExporter{}
+	p, err := New(t.Context(), WithLogProcessor(sdklog.NewSimpleProcessor(logExp)))
+	require.NoError(t, err)
+	p.SetGlobal()
+	assert.Same(t, p.TracerProvider(), otel.GetTracerProvider())
+	assert.Same(t, p.MeterProvider(), otel.GetMeterProvider())
+	assert.Same(t, p.LoggerProvider(), logglobal.GetLoggerProvider())
+
+	require.NoError(t, otel.Shutdown(t.Context()))
+
+	// otel.Shutdown shut down the LoggerProvider.
+	logglobal.Logger("test").Emit(t.Context(), log.Record{})
+	assert.Empty(t, logExp.records)
+}
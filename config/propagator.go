@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// propagators are the TextMapPropagators that can be configured by name.
+var propagators = map[string]propagation.TextMapPropagator{
+	"tracecontext": propagation.TraceContext{},
+	"baggage":      propagation.Baggage{},
+	"b3":           propagation.B3{InjectEncoding: propagation.B3SingleHeader},
+	"b3multi":      propagation.B3{InjectEncoding: propagation.B3MultipleHeader},
+	"jaeger":       propagation.Jaeger{},
+}
+
+// newPropagator returns the composite TextMapPropagator configured by p. If
+// p is nil, the tracecontext and baggage propagators are used.
+func newPropagator(p *Propagator) (propagation.TextMapPropagator, error) {
+	if p == nil {
+		return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}), nil
+	}
+
+	var names []string
+	for _, c := range p.Composite {
+		for name := range c {
+			names = append(names, name)
+		}
+	}
+	if p.CompositeList != nil {
+		for name := range strings.SplitSeq(*p.CompositeList, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+
+	var props []propagation.TextMapPropagator
+	var seen []string
+	for _, name := range names {
+		if name == "none" || slices.Contains(seen, name) {
+			continue
+		}
+		prop, ok := propagators[name]
+		if !ok {
+			return nil, fmt.Errorf("config: unsupported propagator %q", name)
+		}
+		seen = append(seen, name)
+		props = append(props, prop)
+	}
+	return propagation.NewCompositeTextMapPropagator(props...), nil
+}
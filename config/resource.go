@@ -0,0 +1,157 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// newResource returns the Resource configured by r, including the attributes
+// of the default Resource.
+func newResource(r *Resource) (*resource.Resource, error) {
+	if r == nil {
+		return resource.Default(), nil
+	}
+
+	var attrs []attribute.KeyValue
+	if r.AttributesList != nil {
+		kvs, err := parseList(*r.AttributesList)
+		if err != nil {
+			return nil, fmt.Errorf("config: resource attributes_list: %w", err)
+		}
+		for k, v := range kvs {
+			attrs = append(attrs, attribute.String(k, v))
+		}
+	}
+	for _, a := range r.Attributes {
+		kv, err := a.keyValue()
+		if err != nil {
+			return nil, fmt.Errorf("config: resource attribute %q: %w", a.Name, err)
+		}
+		attrs = append(attrs, kv)
+	}
+
+	// The configured attributes and schema URL take precedence over the
+	// default ones, even if the schema URLs conflict.
+	def := resource.Default()
+	schemaURL := def.SchemaURL()
+	if r.SchemaURL != nil {
+		schemaURL = *r.SchemaURL
+	}
+	return resource.NewWithAttributes(schemaURL, append(def.Attributes(), attrs...)...), nil
+}
+
+// keyValue returns the attribute a describes.
+func (a AttributeNameValue) keyValue() (attribute.KeyValue, error) {
+	if a.Name == "" {
+		return attribute.KeyValue{}, fmt.Errorf("name is required")
+	}
+	k := attribute.Key(a.Name)
+
+	t := ""
+	if a.Type != nil {
+		t = *a.Type
+	}
+	switch t {
+	case "":
+		switch v := a.Value.(type) {
+		case string:
+			return k.String(v), nil
+		case bool:
+			return k.Bool(v), nil
+		case int:
+			return k.Int(v), nil
+		case float64:
+			return k.Float64(v), nil
+		}
+	case "string":
+		if v, ok := a.Value.(string); ok {
+			return k.String(v), nil
+		}
+	case "bool":
+		if v, ok := a.Value.(bool); ok {
+			return k.Bool(v), nil
+		}
+	case "int":
+		if v, ok := a.Value.(int); ok {
+			return k.Int(v), nil
+		}
+	case "double":
+		switch v := a.Value.(type) {
+		case float64:
+			return k.Float64(v), nil
+		case int:
+			return k.Float64(float64(v)), nil
+		}
+	case "string_array":
+		if v, ok := sliceOf[string](a.Value); ok {
+			return k.StringSlice(v), nil
+		}
+	case "bool_array":
+		if v, ok := sliceOf[bool](a.Value); ok {
+			return k.BoolSlice(v), nil
+		}
+	case "int_array":
+		if v, ok := sliceOf[int](a.Value); ok {
+			return k.IntSlice(v), nil
+		}
+	case "double_array":
+		if v, ok := sliceOf[float64](a.Value); ok {
+			return k.Float64Slice(v), nil
+		}
+		if v, ok := sliceOf[int](a.Value); ok {
+			f := make([]float64, len(v))
+			for i := range v {
+				f[i] = float64(v[i])
+			}
+			return k.Float64Slice(f), nil
+		}
+	default:
+		return attribute.KeyValue{}, fmt.Errorf("unsupported type %q", t)
+	}
+	return attribute.KeyValue{}, fmt.Errorf("invalid value %v for type %q", a.Value, t)
+}
+
+// sliceOf returns v as a []T if v is a slice of T values.
+func sliceOf[T any](v any) ([]T, bool) {
+	s, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+	out := make([]T, len(s))
+	for i, e := range s {
+		if out[i], ok = e.(T); !ok {
+			return nil, false
+		}
+	}
+	return out, true
+}
+
+// parseList parses s, a comma-separated list of key=value pairs whose values
+// may be URL encoded.
+func parseList(s string) (map[string]string, error) {
+	out := make(map[string]string)
+	for p := range strings.SplitSeq(s, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(p, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			return nil, fmt.Errorf("invalid key=value pair %q", p)
+		}
+		uv, err := url.PathUnescape(strings.TrimSpace(v))
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of %q: %w", k, err)
+		}
+		out[k] = uv
+	}
+	return out, nil
+}
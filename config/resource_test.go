@@ -0,0 +1,58 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestNewResource(t *testing.T) {
+	res, err := newResource(nil)
+	require.NoError(t, err)
+	assert.Equal(t, resource.Default(), res)
+
+	res, err = newResource(&Resource{
+		SchemaURL:      ptr("https://example.com/schema"),
+		AttributesList: ptr("service.name=list,env=prod%20eu"),
+		Attributes: []AttributeNameValue{
+			{Name: "service.name", Value: "checkout"},
+			{Name: "ratio", Value: 1, Type: ptr("double")},
+			{Name: "ports", Value: []any{80, 443}, Type: ptr("int_array")},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/schema", res.SchemaURL())
+
+	set := res.Set()
+	for _, want := range []attribute.KeyValue{
+		attribute.String("service.name", "checkout"),
+		attribute.String("env", "prod eu"),
+		attribute.Float64("ratio", 1),
+		attribute.IntSlice("ports", []int{80, 443}),
+	} {
+		got, ok := set.Value(want.Key)
+		require.True(t, ok, want.Key)
+		assert.Equal(t, want.Value.Emit(), got.Emit(), want.Key)
+	}
+	assert.True(t, set.HasValue("telemetry.sdk.name"))
+}
+
+func TestAttributeNameValueErrors(t *testing.T) {
+	for _, a := range []AttributeNameValue{
+		{Value: "no name"},
+		{Name: "key", Value: "value", Type: ptr("unknown")},
+		{Name: "key", Value: "value", Type: ptr("bool")},
+		{Name: "key", Value: []any{1, "a"}, Type: ptr("int_array")},
+		{Name: "key", Value: map[string]any{}},
+	} {
+		_, err := a.keyValue()
+		assert.Error(t, err, a)
+	}
+}
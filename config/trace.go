@@ -0,0 +1,220 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/credentials"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// tracerProvider returns the TracerProvider configured by tp.
+func (b builder) tracerProvider(tp TracerProvider) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(b.res),
+		sdktrace.WithRawSpanLimits(b.spanLimits(tp.Limits)),
+	}
+
+	if tp.Sampler != nil {
+		s, err := sampler(*tp.Sampler)
+		if err != nil {
+			return nil, fmt.Errorf("config: tracer_provider sampler: %w", err)
+		}
+		opts = append(opts, sdktrace.WithSampler(s))
+	}
+
+	var processors []sdktrace.SpanProcessor
+	shutdown := func() error {
+		var errs []error
+		for _, p := range processors {
+			errs = append(errs, p.Shutdown(b.ctx))
+		}
+		return errors.Join(errs...)
+	}
+	for i, p := range tp.Processors {
+		sp, err := b.spanProcessor(p)
+		if err != nil {
+			err = fmt.Errorf("config: tracer_provider processor %d: %w", i, err)
+			return nil, errors.Join(err, shutdown())
+		}
+		processors = append(processors, sp)
+		opts = append(opts, sdktrace.WithSpanProcessor(sp))
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+// spanLimits returns the SpanLimits configured by l, falling back to the
+// attribute limits of b and then to the SDK defaults.
+func (b builder) spanLimits(l *SpanLimits) sdktrace.SpanLimits {
+	sl := sdktrace.NewSpanLimits()
+	if b.limits != nil {
+		setInt(&sl.AttributeValueLengthLimit, b.limits.AttributeValueLengthLimit)
+		setInt(&sl.AttributeCountLimit, b.limits.AttributeCountLimit)
+	}
+	if l != nil {
+		setInt(&sl.AttributeValueLengthLimit, l.AttributeValueLengthLimit)
+		setInt(&sl.AttributeCountLimit, l.AttributeCountLimit)
+		setInt(&sl.EventCountLimit, l.EventCountLimit)
+		setInt(&sl.LinkCountLimit, l.LinkCountLimit)
+		setInt(&sl.AttributePerEventCountLimit, l.EventAttributeCountLimit)
+		setInt(&sl.AttributePerLinkCountLimit, l.LinkAttributeCountLimit)
+	}
+	return sl
+}
+
+// setInt sets dst to v if v is set.
+func setInt(dst, v *int) {
+	if v != nil {
+		*dst = *v
+	}
+}
+
+// sampler returns the Sampler configured by s.
+func sampler(s Sampler) (sdktrace.Sampler, error) {
+	if err := checkChoice("sampler", s); err != nil {
+		return nil, err
+	}
+	switch {
+	case s.AlwaysOn != nil:
+		return sdktrace.AlwaysSample(), nil
+	case s.AlwaysOff != nil:
+		return sdktrace.NeverSample(), nil
+	case s.TraceIDRatioBased != nil:
+		ratio := 1.0
+		if s.TraceIDRatioBased.Ratio != nil {
+			ratio = *s.TraceIDRatioBased.Ratio
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, fmt.Errorf("invalid trace_id_ratio_based ratio %v", ratio)
+		}
+		return sdktrace.TraceIDRatioBased(ratio), nil
+	}
+
+	pb := s.ParentBased
+	root := sdktrace.AlwaysSample()
+	var opts []sdktrace.ParentBasedSamplerOption
+	for _, c := range []struct {
+		name    string
+		sampler *Sampler
+		opt     func(sdktrace.Sampler) sdktrace.ParentBasedSamplerOption
+	}{
+		{"root", pb.Root, nil},
+		{"remote_parent_sampled", pb.RemoteParentSampled, sdktrace.WithRemoteParentSampled},
+		{"remote_parent_not_sampled", pb.RemoteParentNotSampled, sdktrace.WithRemoteParentNotSampled},
+		{"local_parent_sampled", pb.LocalParentSampled, sdktrace.WithLocalParentSampled},
+		{"local_parent_not_sampled", pb.LocalParentNotSampled, sdktrace.WithLocalParentNotSampled},
+	} {
+		if c.sampler == nil {
+			continue
+		}
+		smpl, err := sampler(*c.sampler)
+		if err != nil {
+			return nil, fmt.Errorf("parent_based %s: %w", c.name, err)
+		}
+		if c.opt == nil {
+			root = smpl
+			continue
+		}
+		opts = append(opts, c.opt(smpl))
+	}
+	return sdktrace.ParentBased(root, opts...), nil
+}
+
+// spanProcessor returns the SpanProcessor configured by p.
+func (b builder) spanProcessor(p SpanProcessor) (sdktrace.SpanProcessor, error) {
+	if err := checkChoice("processor", p); err != nil {
+		return nil, err
+	}
+	if p.Simple != nil {
+		exp, err := b.spanExporter(p.Simple.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		return sdktrace.NewSimpleSpanProcessor(exp), nil
+	}
+
+	bp := p.Batch
+	exp, err := b.spanExporter(bp.Exporter)
+	if err != nil {
+		return nil, err
+	}
+	var opts []sdktrace.BatchSpanProcessorOption
+	if d, ok := milliseconds(bp.ScheduleDelay); ok {
+		opts = append(opts, sdktrace.WithBatchTimeout(d))
+	}
+	if d, ok := milliseconds(bp.ExportTimeout); ok {
+		opts = append(opts, sdktrace.WithExportTimeout(d))
+	}
+	if bp.MaxQueueSize != nil {
+		opts = append(opts, sdktrace.WithMaxQueueSize(*bp.MaxQueueSize))
+	}
+	if bp.MaxExportBatchSize != nil {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(*bp.MaxExportBatchSize))
+	}
+	return sdktrace.NewBatchSpanProcessor(exp, opts...), nil
+}
+
+// spanExporter returns the SpanExporter configured by e.
+func (b builder) spanExporter(e SpanExporter) (sdktrace.SpanExporter, error) {
+	if err := checkChoice("exporter", e); err != nil {
+		return nil, err
+	}
+	switch {
+	case e.Console != nil:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case e.OTLPHTTP != nil:
+		s, err := httpSettings(e.OTLPHTTP)
+		if err != nil {
+			return nil, fmt.Errorf("otlp_http: %w", err)
+		}
+		var opts []otlptracehttp.Option
+		if s.endpoint != "" {
+			opts = append(opts, otlptracehttp.WithEndpointURL(s.endpoint))
+		}
+		if len(s.headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(s.headers))
+		}
+		if s.gzip {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		if s.timeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(s.timeout))
+		}
+		if s.tls != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(s.tls))
+		}
+		return otlptracehttp.New(b.ctx, opts...)
+	}
+
+	s, err := grpcSettings(e.OTLPGRPC)
+	if err != nil {
+		return nil, fmt.Errorf("otlp_grpc: %w", err)
+	}
+	var opts []otlptracegrpc.Option
+	if s.endpoint != "" {
+		opts = append(opts, otlptracegrpc.WithEndpointURL(s.endpoint))
+	}
+	if len(s.headers) > 0 {
+		opts = append(opts, otlptracegrpc.WithHeaders(s.headers))
+	}
+	if s.gzip {
+		opts = append(opts, otlptracegrpc.WithCompressor(compressionGzip))
+	}
+	if s.timeout > 0 {
+		opts = append(opts, otlptracegrpc.WithTimeout(s.timeout))
+	}
+	if s.tls != nil {
+		opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(s.tls)))
+	}
+	if s.insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+	return otlptracegrpc.New(b.ctx, opts...)
+}
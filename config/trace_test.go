@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestSampler(t *testing.T) {
+	tests := []struct {
+		name    string
+		sampler Sampler
+		want    string
+	}{
+		{
+			name:    "AlwaysOn",
+			sampler: Sampler{AlwaysOn: &struct{}{}},
+			want:    sdktrace.AlwaysSample().Description(),
+		},
+		{
+			name:    "AlwaysOff",
+			sampler: Sampler{AlwaysOff: &struct{}{}},
+			want:    sdktrace.NeverSample().Description(),
+		},
+		{
+			name:    "TraceIDRatioBased",
+			sampler: Sampler{TraceIDRatioBased: &TraceIDRatioBasedSampler{Ratio: ptr(0.25)}},
+			want:    sdktrace.TraceIDRatioBased(0.25).Description(),
+		},
+		{
+			name: "ParentBased",
+			sampler: Sampler{ParentBased: &ParentBasedSampler{
+				Root:                &Sampler{AlwaysOff: &struct{}{}},
+				RemoteParentSampled: &Sampler{TraceIDRatioBased: &TraceIDRatioBasedSampler{}},
+			}},
+			want: sdktrace.ParentBased(
+				sdktrace.NeverSample(),
+				sdktrace.WithRemoteParentSampled(sdktrace.TraceIDRatioBased(1)),
+			).Description(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := sampler(tt.sampler)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, s.Description())
+		})
+	}
+}
+
+func TestSamplerErrors(t *testing.T) {
+	for _, s := range []Sampler{
+		{},
+		{AlwaysOn: &struct{}{}, AlwaysOff: &struct{}{}},
+		{TraceIDRatioBased: &TraceIDRatioBasedSampler{Ratio: ptr(1.5)}},
+		{ParentBased: &ParentBasedSampler{Root: &Sampler{}}},
+	} {
+		_, err := sampler(s)
+		assert.Error(t, err)
+	}
+}
+
+func TestSpanLimits(t *testing.T) {
+	b := builder{limits: &AttributeLimits{
+		AttributeValueLengthLimit: ptr(100),
+		AttributeCountLimit:       ptr(10),
+	}}
+	got := b.spanLimits(&SpanLimits{AttributeCountLimit: ptr(5), LinkCountLimit: ptr(2)})
+
+	want := sdktrace.NewSpanLimits()
+	want.AttributeValueLengthLimit = 100
+	want.AttributeCountLimit = 5
+	want.LinkCountLimit = 2
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+// Default topics the telemetry is published to. These are the default topics
+// of the OpenTelemetry Collector Kafka receiver.
+const (
+	DefaultTracesTopic  = "otlp_spans"
+	DefaultMetricsTopic = "otlp_metrics"
+	DefaultLogsTopic    = "otlp_logs"
+)
+
+// Encoding is the encoding of the published messages.
+type Encoding uint8
+
+const (
+	// EncodingProto encodes messages as OTLP protobuf. It is the otlp_proto
+	// encoding of the OpenTelemetry Collector Kafka receiver.
+	EncodingProto Encoding = iota
+	// EncodingJSON encodes messages as OTLP JSON. It is the otlp_json
+	// encoding of the OpenTelemetry Collector Kafka receiver.
+	EncodingJSON
+)
+
+// String returns the name of the encoding used by the OpenTelemetry Collector
+// Kafka receiver.
+func (e Encoding) String() string {
+	switch e {
+	case EncodingProto:
+		return "otlp_proto"
+	case EncodingJSON:
+		return "otlp_json"
+	}
+	return "unknown"
+}
+
+// config contains the options for an exporter.
+type config struct {
+	topic              string
+	encoding           Encoding
+	partitionByTraceID bool
+}
+
+// newConfig returns a config configured with options. topic is the default
+// topic of the exporter.
+func newConfig(topic string, options []Option) config {
+	cfg := config{topic: topic, encoding: EncodingProto}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets the value of an option for an exporter.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithTopic sets the topic the telemetry is published to.
+//
+// By default, spans are published to [DefaultTracesTopic], metrics to
+// [DefaultMetricsTopic], and log records to [DefaultLogsTopic].
+func WithTopic(topic string) Option {
+	return optionFunc(func(cfg config) config {
+		if topic != "" {
+			cfg.topic = topic
+		}
+		return cfg
+	})
+}
+
+// WithEncoding sets the encoding of the published messages.
+//
+// By default, [EncodingProto] is used.
+func WithEncoding(enc Encoding) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.encoding = enc
+		return cfg
+	})
+}
+
+// WithPartitionByTraceID publishes the spans of each trace in a separate
+// message keyed by the hex encoded trace ID. This ensures all the spans of a
+// trace are published to the same partition by a Kafka client partitioning
+// messages by key, as required by consumers like tail-sampling processors.
+//
+// This option only applies to the trace exporter. By default, all the spans
+// of an export are published in a single message without a key.
+func WithPartitionByTraceID() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.partitionByTraceID = true
+		return cfg
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kafka provides exporters that publish OTLP encoded spans, metrics,
+// and log records to Kafka topics.
+//
+// The messages are encoded the way the OpenTelemetry Collector Kafka receiver
+// expects them: each message value is an OTLP TracesData, MetricsData, or
+// LogsData message encoded as protobuf (the otlp_proto encoding) or JSON (the
+// otlp_json encoding). By default, messages are published to the otlp_spans,
+// otlp_metrics, and otlp_logs topics, which are also the defaults of the
+// receiver.
+//
+// This package does not depend on a Kafka client. The exporters publish
+// messages with a [Producer], an adapter to the Kafka client used by the
+// application. This allows the application to configure the client (e.g. the
+// brokers, authentication, batching, and the partitioner) as it needs.
+package kafka
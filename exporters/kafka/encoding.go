@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// marshal returns m encoded with enc.
+func marshal(enc Encoding, m proto.Message) ([]byte, error) {
+	switch enc {
+	case EncodingProto:
+		return proto.Marshal(m)
+	case EncodingJSON:
+		return marshalJSON(m)
+	}
+	return nil, fmt.Errorf("kafka: unknown encoding: %d", enc)
+}
+
+// idKeys are the JSON keys of the OTLP trace and span IDs.
+var idKeys = map[string]struct{}{
+	"traceId":      {},
+	"spanId":       {},
+	"parentSpanId": {},
+}
+
+// marshalJSON returns m encoded as OTLP JSON.
+//
+// OTLP JSON differs from the standard protobuf JSON mapping: trace and span
+// IDs are hex encoded instead of base64 encoded, and enum values are encoded
+// as integers.
+func marshalJSON(m proto.Message) ([]byte, error) {
+	data, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if err := hexIDs(v); err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// hexIDs replaces the base64 encoded trace and span IDs in v with their hex
+// encoding.
+func hexIDs(v any) error {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, elem := range val {
+			if _, ok := idKeys[k]; ok {
+				s, ok := elem.(string)
+				if !ok {
+					continue
+				}
+				b, err := base64.StdEncoding.DecodeString(s)
+				if err != nil {
+					return fmt.Errorf("kafka: invalid %s: %w", k, err)
+				}
+				val[k] = hex.EncodeToString(b)
+				continue
+			}
+			if err := hexIDs(elem); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, elem := range val {
+			if err := hexIDs(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
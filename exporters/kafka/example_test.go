@@ -0,0 +1,24 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka_test
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/exporters/kafka"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func Example() {
+	// Adapt the Kafka client used by the application.
+	producer := kafka.ProducerFunc(func(context.Context, []kafka.Message) error {
+		// Publish the messages with the Kafka client, e.g. as records with the
+		// message topic, key, and value.
+		return nil
+	})
+
+	exp := kafka.NewTraceExporter(producer, kafka.WithPartitionByTraceID())
+	tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp))
+	defer func() { _ = tp.Shutdown(context.Background()) }()
+}
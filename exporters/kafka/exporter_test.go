@@ -0,0 +1,196 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type producer struct {
+	mu   sync.Mutex
+	msgs []Message
+	err  error
+}
+
+func (p *producer) Produce(_ context.Context, msgs []Message) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.msgs = append(p.msgs, msgs...)
+	return p.err
+}
+
+var (
+	traceA = trace.TraceID{0x01}
+	traceB = trace.TraceID{0x02}
+)
+
+func spans() []sdktrace.ReadOnlySpan {
+	span := func(tid trace.TraceID, sid byte) tracetest.SpanStub {
+		return tracetest.SpanStub{
+			Name:     "span",
+			SpanKind: trace.SpanKindServer,
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: tid,
+				SpanID:  trace.SpanID{sid},
+			}),
+			Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+		}
+	}
+	return tracetest.SpanStubs{span(traceA, 1), span(traceB, 2), span(traceA, 3)}.Snapshots()
+}
+
+func TestTraceExporter(t *testing.T) {
+	p := &producer{}
+	exp := NewTraceExporter(p)
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+
+	require.Len(t, p.msgs, 1)
+	assert.Equal(t, DefaultTracesTopic, p.msgs[0].Topic)
+	assert.Nil(t, p.msgs[0].Key)
+
+	var td tracepb.TracesData
+	require.NoError(t, proto.Unmarshal(p.msgs[0].Value, &td))
+	require.Len(t, td.ResourceSpans, 1)
+	require.Len(t, td.ResourceSpans[0].ScopeSpans, 1)
+	assert.Len(t, td.ResourceSpans[0].ScopeSpans[0].Spans, 3)
+}
+
+func TestTraceExporterPartitionByTraceID(t *testing.T) {
+	p := &producer{}
+	exp := NewTraceExporter(p, WithPartitionByTraceID(), WithTopic("traces"))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+
+	require.Len(t, p.msgs, 2)
+	for i, tc := range []struct {
+		id    trace.TraceID
+		spans int
+	}{{traceA, 2}, {traceB, 1}} {
+		msg := p.msgs[i]
+		assert.Equal(t, "traces", msg.Topic)
+		assert.Equal(t, tc.id.String(), string(msg.Key))
+
+		var td tracepb.TracesData
+		require.NoError(t, proto.Unmarshal(msg.Value, &td))
+		got := td.ResourceSpans[0].ScopeSpans[0].Spans
+		require.Len(t, got, tc.spans)
+		for _, s := range got {
+			assert.Equal(t, tc.id[:], s.TraceId)
+		}
+	}
+}
+
+func TestTraceExporterJSON(t *testing.T) {
+	p := &producer{}
+	exp := NewTraceExporter(p, WithEncoding(EncodingJSON))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()[:1]))
+	require.Len(t, p.msgs, 1)
+
+	var got struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID string `json:"traceId"`
+					SpanID  string `json:"spanId"`
+					Kind    int    `json:"kind"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	require.NoError(t, json.Unmarshal(p.msgs[0].Value, &got))
+	s := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	assert.Equal(t, traceA.String(), s.TraceID)
+	assert.Equal(t, trace.SpanID{1}.String(), s.SpanID)
+	assert.Equal(t, int(tracepb.Span_SPAN_KIND_SERVER), s.Kind)
+}
+
+func TestTraceExporterErrors(t *testing.T) {
+	errProduce := errors.New("produce")
+	p := &producer{err: errProduce}
+	exp := NewTraceExporter(p)
+	assert.ErrorIs(t, exp.ExportSpans(t.Context(), spans()), errProduce)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.ErrorIs(t, exp.ExportSpans(ctx, spans()), context.Canceled)
+
+	exp = NewTraceExporter(p, WithEncoding(Encoding(100)))
+	assert.Error(t, exp.ExportSpans(t.Context(), spans()))
+}
+
+func TestTraceExporterShutdown(t *testing.T) {
+	p := &producer{}
+	exp := NewTraceExporter(p)
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+	assert.Empty(t, p.msgs)
+}
+
+func TestMetricExporter(t *testing.T) {
+	p := &producer{}
+	exp := NewMetricExporter(p)
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.Empty(),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Metrics: []metricdata.Metrics{{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Value: 1}}},
+			}},
+		}},
+	}
+	require.NoError(t, exp.Export(t.Context(), rm))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+
+	require.Len(t, p.msgs, 1)
+	assert.Equal(t, DefaultMetricsTopic, p.msgs[0].Topic)
+	var md mpb.MetricsData
+	require.NoError(t, proto.Unmarshal(p.msgs[0].Value, &md))
+	require.Len(t, md.ResourceMetrics, 1)
+	assert.Equal(t, "gauge", md.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name)
+
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Export(t.Context(), rm))
+	assert.Len(t, p.msgs, 1)
+}
+
+func TestLogExporter(t *testing.T) {
+	p := &producer{}
+	exp := NewLogExporter(p, WithEncoding(EncodingJSON))
+	var r log.Record
+	r.SetBody(attribute.StringValue("message"))
+	r.SetTraceID(traceA)
+	require.NoError(t, exp.Export(t.Context(), []log.Record{r}))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+
+	require.Len(t, p.msgs, 1)
+	assert.Equal(t, DefaultLogsTopic, p.msgs[0].Topic)
+	assert.Contains(t, string(p.msgs[0].Value), `"traceId":"`+traceA.String()+`"`)
+
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Export(t.Context(), []log.Record{r}))
+	assert.Len(t, p.msgs, 1)
+}
+
+func TestEncodingString(t *testing.T) {
+	assert.Equal(t, "otlp_proto", EncodingProto.String())
+	assert.Equal(t, "otlp_json", EncodingJSON.String())
+	assert.Equal(t, "unknown", Encoding(100).String())
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"sync/atomic"
+
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/transform"
+	"go.opentelemetry.io/otel/sdk/log"
+)
+
+var _ log.Exporter = (*LogExporter)(nil)
+
+// LogExporter is a log Exporter that publishes OTLP encoded log records to
+// Kafka.
+type LogExporter struct {
+	producer Producer
+	cfg      config
+	stopped  atomic.Bool
+}
+
+// NewLogExporter returns a new LogExporter that publishes log records with
+// producer.
+func NewLogExporter(producer Producer, options ...Option) *LogExporter {
+	return &LogExporter{
+		producer: producer,
+		cfg:      newConfig(DefaultLogsTopic, options),
+	}
+}
+
+// Export publishes records to Kafka.
+//
+// This method returns an error if the records cannot be encoded or published.
+// It does nothing after Shutdown is called.
+func (e *LogExporter) Export(ctx context.Context, records []log.Record) error {
+	if e.stopped.Load() || len(records) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := marshal(e.cfg.encoding, &lpb.LogsData{
+		ResourceLogs: transform.ResourceLogs(records),
+	})
+	if err != nil {
+		return err
+	}
+	return e.producer.Produce(ctx, []Message{{Topic: e.cfg.topic, Value: data}})
+}
+
+// ForceFlush does nothing, the exporter holds no state.
+func (*LogExporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown stops the exporter. Records passed to Export after Shutdown is
+// called are dropped. The Producer is not closed and needs to be closed by the
+// application.
+func (e *LogExporter) Shutdown(ctx context.Context) error {
+	e.stopped.Store(true)
+	return ctx.Err()
+}
@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/transform"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var _ metric.Exporter = (*MetricExporter)(nil)
+
+// MetricExporter is a metric Exporter that publishes OTLP encoded metrics to
+// Kafka.
+//
+// It uses the default temporality and aggregation of the SDK for all
+// instrument kinds.
+type MetricExporter struct {
+	producer Producer
+	cfg      config
+	stopped  atomic.Bool
+}
+
+// NewMetricExporter returns a new MetricExporter that publishes metrics with
+// producer.
+func NewMetricExporter(producer Producer, options ...Option) *MetricExporter {
+	return &MetricExporter{
+		producer: producer,
+		cfg:      newConfig(DefaultMetricsTopic, options),
+	}
+}
+
+// Temporality returns the Temporality to use for an instrument kind.
+func (*MetricExporter) Temporality(k metric.InstrumentKind) metricdata.Temporality {
+	return metric.DefaultTemporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (*MetricExporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
+	return metric.DefaultAggregationSelector(k)
+}
+
+// Export publishes rm to Kafka.
+//
+// If rm contains metrics that cannot be transformed to OTLP, the other metrics
+// are published and an error is returned. It does nothing after Shutdown is
+// called.
+func (e *MetricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if e.stopped.Load() {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	otlpRM, err := transform.ResourceMetrics(rm)
+	// Best effort publish of the metrics that were transformed.
+	data, mErr := marshal(e.cfg.encoding, &mpb.MetricsData{
+		ResourceMetrics: []*mpb.ResourceMetrics{otlpRM},
+	})
+	if mErr != nil {
+		return errors.Join(err, mErr)
+	}
+	msg := Message{Topic: e.cfg.topic, Value: data}
+	return errors.Join(err, e.producer.Produce(ctx, []Message{msg}))
+}
+
+// ForceFlush does nothing, the exporter holds no state.
+func (*MetricExporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown stops the exporter. Metrics passed to Export after Shutdown is
+// called are dropped. The Producer is not closed and needs to be closed by the
+// application.
+func (e *MetricExporter) Shutdown(ctx context.Context) error {
+	e.stopped.Store(true)
+	return ctx.Err()
+}
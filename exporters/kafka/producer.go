@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import "context"
+
+// Message is a Kafka message.
+type Message struct {
+	// Topic is the topic the message is published to.
+	Topic string
+	// Key is the message key. It is nil if the message has no key.
+	//
+	// Kafka clients commonly use the key to select the partition a message is
+	// published to. All the messages with the same key are then published to
+	// the same partition.
+	Key []byte
+	// Value is the message value.
+	Value []byte
+}
+
+// Producer publishes messages to Kafka.
+//
+// A Producer is an adapter to the Kafka client used by the application.
+type Producer interface {
+	// Produce publishes msgs to Kafka. It returns once the messages are
+	// published, or an error if the messages cannot be published.
+	//
+	// The deadline or cancellation of the passed context must be honored.
+	//
+	// Produce may be called concurrently.
+	Produce(ctx context.Context, msgs []Message) error
+}
+
+// ProducerFunc is a function that implements Producer.
+type ProducerFunc func(ctx context.Context, msgs []Message) error
+
+// Produce calls f(ctx, msgs).
+func (f ProducerFunc) Produce(ctx context.Context, msgs []Message) error {
+	return f(ctx, msgs)
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kafka
+
+import (
+	"context"
+	"sync/atomic"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/tracetransform"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ sdktrace.SpanExporter = (*TraceExporter)(nil)
+
+// TraceExporter is a SpanExporter that publishes OTLP encoded spans to Kafka.
+type TraceExporter struct {
+	producer Producer
+	cfg      config
+	stopped  atomic.Bool
+}
+
+// NewTraceExporter returns a new TraceExporter that publishes spans with
+// producer.
+func NewTraceExporter(producer Producer, options ...Option) *TraceExporter {
+	return &TraceExporter{
+		producer: producer,
+		cfg:      newConfig(DefaultTracesTopic, options),
+	}
+}
+
+// ExportSpans publishes spans to Kafka.
+//
+// This method returns an error if the spans cannot be encoded or published.
+// It does nothing after Shutdown is called.
+func (e *TraceExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.stopped.Load() || len(spans) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var msgs []Message
+	if e.cfg.partitionByTraceID {
+		ids, traces := byTraceID(spans)
+		msgs = make([]Message, 0, len(ids))
+		for _, id := range ids {
+			msg, err := e.message(traces[id])
+			if err != nil {
+				return err
+			}
+			msg.Key = []byte(id.String())
+			msgs = append(msgs, msg)
+		}
+	} else {
+		msg, err := e.message(spans)
+		if err != nil {
+			return err
+		}
+		msgs = []Message{msg}
+	}
+	return e.producer.Produce(ctx, msgs)
+}
+
+// message returns the Message for spans.
+func (e *TraceExporter) message(spans []sdktrace.ReadOnlySpan) (Message, error) {
+	data, err := marshal(e.cfg.encoding, &tracepb.TracesData{
+		ResourceSpans: tracetransform.Spans(spans),
+	})
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Topic: e.cfg.topic, Value: data}, nil
+}
+
+// byTraceID returns spans grouped by trace ID and the trace IDs in the order
+// they appear in spans.
+func byTraceID(spans []sdktrace.ReadOnlySpan) ([]trace.TraceID, map[trace.TraceID][]sdktrace.ReadOnlySpan) {
+	var ids []trace.TraceID
+	traces := make(map[trace.TraceID][]sdktrace.ReadOnlySpan)
+	for _, s := range spans {
+		if s == nil {
+			continue
+		}
+		id := s.SpanContext().TraceID()
+		if _, ok := traces[id]; !ok {
+			ids = append(ids, id)
+		}
+		traces[id] = append(traces[id], s)
+	}
+	return ids, traces
+}
+
+// Shutdown stops the exporter. Spans passed to ExportSpans after Shutdown is
+// called are dropped. The Producer is not closed and needs to be closed by
+// the application.
+func (e *TraceExporter) Shutdown(ctx context.Context) error {
+	e.stopped.Store(true)
+	return ctx.Err()
+}
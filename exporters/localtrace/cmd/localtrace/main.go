@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command localtrace prints the traces stored by a localtrace Exporter.
+//
+// Usage:
+//
+//	localtrace -store spans.jsonl [-n 20]
+//	localtrace -store spans.jsonl -trace <trace-id>
+//
+// Without -trace, the most recent traces of the store are listed. With
+// -trace, the spans of the trace are printed as a tree.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/localtrace"
+)
+
+func main() {
+	store := flag.String("store", "", "path of the store")
+	n := flag.Int("n", 20, "number of recent traces to list")
+	traceID := flag.String("trace", "", "hex encoded ID of the trace to print")
+	flag.Parse()
+
+	if *store == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var err error
+	if *traceID != "" {
+		err = printTrace(os.Stdout, *store, *traceID)
+	} else {
+		err = listTraces(os.Stdout, *store, *n)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func listTraces(w io.Writer, store string, n int) error {
+	traces, err := localtrace.RecentTraces(store, n)
+	if err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TRACE ID\tSTART\tDURATION\tSPANS\tSERVICE\tROOT")
+	for _, t := range traces {
+		root := t.Root()
+		fmt.Fprintf(
+			tw, "%s\t%s\t%s\t%d\t%s\t%s\n",
+			t.TraceID, t.Start().Format(time.RFC3339), t.Duration(), len(t.Spans), root.Service, root.Name,
+		)
+	}
+	return tw.Flush()
+}
+
+func printTrace(w io.Writer, store, traceID string) error {
+	t, err := localtrace.FindTrace(store, traceID)
+	if err != nil {
+		return err
+	}
+
+	children := make(map[string][]localtrace.Span)
+	ids := make(map[string]struct{}, len(t.Spans))
+	for _, s := range t.Spans {
+		ids[s.SpanID] = struct{}{}
+	}
+	var roots []localtrace.Span
+	for _, s := range t.Spans {
+		if _, ok := ids[s.ParentSpanID]; ok {
+			children[s.ParentSpanID] = append(children[s.ParentSpanID], s)
+			continue
+		}
+		roots = append(roots, s)
+	}
+
+	var print func(s localtrace.Span, depth int)
+	print = func(s localtrace.Span, depth int) {
+		indent := strings.Repeat("  ", depth)
+		fmt.Fprintf(w, "%s%s [%s] %s %s\n", indent, s.Name, s.Kind, s.Duration(), s.StatusCode)
+		for _, k := range slices.Sorted(maps.Keys(s.Attributes)) {
+			fmt.Fprintf(w, "%s    %s=%s\n", indent, k, s.Attributes[k])
+		}
+		for _, c := range children[s.SpanID] {
+			print(c, depth+1)
+		}
+	}
+	for _, r := range roots {
+		print(r, 0)
+	}
+	return nil
+}
@@ -0,0 +1,14 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package localtrace provides a trace exporter that stores spans in a local
+// file and functions to query the stored traces.
+//
+// It is intended for development loops: traces can be inspected without
+// running a tracing backend. It is not intended for production use as the
+// store grows without bound and queries read the whole store.
+//
+// The store is a file of JSON encoded spans, one per line. Use the localtrace
+// command ([go.opentelemetry.io/otel/exporters/localtrace/cmd/localtrace]) to
+// list the recent traces of a store and to print the spans of a trace.
+package localtrace
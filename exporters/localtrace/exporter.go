@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package localtrace
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var _ sdktrace.SpanExporter = (*Exporter)(nil)
+
+// Exporter is a SpanExporter that stores spans in a local file.
+type Exporter struct {
+	mu   sync.Mutex
+	file *os.File
+	w    *bufio.Writer
+}
+
+// New returns a new Exporter that appends spans to the store at path. The
+// file is created if it does not exist.
+func New(path string) (*Exporter, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) // nolint:gosec // Path provided by the user.
+	if err != nil {
+		return nil, fmt.Errorf("localtrace: open store: %w", err)
+	}
+	return &Exporter{file: f, w: bufio.NewWriter(f)}, nil
+}
+
+// ExportSpans stores spans.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil {
+		return nil
+	}
+
+	enc := json.NewEncoder(e.w)
+	for _, s := range spans {
+		if err := enc.Encode(newSpan(s)); err != nil {
+			return fmt.Errorf("localtrace: encode span: %w", err)
+		}
+	}
+	// Flush so the spans can be queried right away.
+	return e.w.Flush()
+}
+
+// Shutdown closes the store. Spans exported after Shutdown is called are
+// dropped.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.file == nil {
+		return ctx.Err()
+	}
+
+	err := errors.Join(e.w.Flush(), e.file.Close())
+	e.file, e.w = nil, nil
+	return errors.Join(err, ctx.Err())
+}
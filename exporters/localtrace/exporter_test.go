@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package localtrace
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	exp, err := New(path)
+	require.NoError(t, err)
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithSyncer(exp),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName("svc"))),
+	)
+	tracer := tp.Tracer("scope")
+
+	ctx, root := tracer.Start(t.Context(), "first", trace.WithSpanKind(trace.SpanKindServer))
+	_, child := tracer.Start(ctx, "child", trace.WithAttributes(attribute.Int("key", 1)))
+	child.AddEvent("event")
+	child.SetStatus(codes.Error, "failed")
+	child.End()
+	root.End()
+	_, second := tracer.Start(t.Context(), "second")
+	second.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	traces, err := RecentTraces(path, 0)
+	require.NoError(t, err)
+	require.Len(t, traces, 2)
+	assert.Equal(t, "second", traces[0].Root().Name)
+	assert.Equal(t, "first", traces[1].Root().Name)
+
+	traces, err = RecentTraces(path, 1)
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+	assert.Equal(t, "second", traces[0].Root().Name)
+
+	id := root.SpanContext().TraceID().String()
+	got, err := FindTrace(path, id)
+	require.NoError(t, err)
+	assert.Equal(t, id, got.TraceID)
+	require.Len(t, got.Spans, 2)
+	r, c := got.Spans[0], got.Spans[1]
+	assert.Equal(t, "first", r.Name)
+	assert.Equal(t, "server", r.Kind)
+	assert.Equal(t, "svc", r.Service)
+	assert.Equal(t, "scope", r.Scope)
+	assert.Empty(t, r.ParentSpanID)
+	assert.Equal(t, "child", c.Name)
+	assert.Equal(t, r.SpanID, c.ParentSpanID)
+	assert.Equal(t, map[string]string{"key": "1"}, c.Attributes)
+	assert.Equal(t, "Error", c.StatusCode)
+	assert.Equal(t, "failed", c.StatusDesc)
+	require.Len(t, c.Events, 1)
+	assert.Equal(t, "event", c.Events[0].Name)
+	assert.GreaterOrEqual(t, got.Duration(), c.Duration())
+
+	_, err = FindTrace(path, trace.TraceID{0x01}.String())
+	assert.ErrorIs(t, err, ErrTraceNotFound)
+}
+
+func TestExporterAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "spans.jsonl")
+	for range 2 {
+		exp, err := New(path)
+		require.NoError(t, err)
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+		_, span := tp.Tracer("scope").Start(t.Context(), "span")
+		span.End()
+		require.NoError(t, tp.Shutdown(t.Context()))
+	}
+
+	traces, err := RecentTraces(path, 0)
+	require.NoError(t, err)
+	assert.Len(t, traces, 2)
+}
+
+func TestExporterShutdown(t *testing.T) {
+	exp, err := New(filepath.Join(t.TempDir(), "spans.jsonl"))
+	require.NoError(t, err)
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+	assert.NoError(t, exp.ExportSpans(context.Background(), nil))
+}
+
+func TestReadTracesErrors(t *testing.T) {
+	dir := t.TempDir()
+	_, err := RecentTraces(filepath.Join(dir, "missing"), 0)
+	assert.Error(t, err)
+
+	path := filepath.Join(dir, "invalid")
+	require.NoError(t, os.WriteFile(path, []byte("not json\n"), 0o600))
+	_, err = FindTrace(path, "id")
+	assert.ErrorContains(t, err, "line 1")
+}
@@ -0,0 +1,132 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package localtrace
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+)
+
+// ErrTraceNotFound is returned by FindTrace if the store does not contain the
+// trace.
+var ErrTraceNotFound = errors.New("localtrace: trace not found")
+
+// Trace is a trace stored by an Exporter.
+type Trace struct {
+	// TraceID is the hex encoded ID of the trace.
+	TraceID string
+	// Spans are the spans of the trace ordered by their start time.
+	Spans []Span
+}
+
+// Root returns the root span of the trace. If the root span is not stored,
+// the span of the trace that started first is returned.
+func (t Trace) Root() Span {
+	for _, s := range t.Spans {
+		if s.ParentSpanID == "" {
+			return s
+		}
+	}
+	if len(t.Spans) == 0 {
+		return Span{}
+	}
+	return t.Spans[0]
+}
+
+// Start returns the start time of the first span of the trace.
+func (t Trace) Start() time.Time {
+	if len(t.Spans) == 0 {
+		return time.Time{}
+	}
+	return t.Spans[0].StartTime
+}
+
+// Duration returns the time between the start of the first span and the end
+// of the last span of the trace.
+func (t Trace) Duration() time.Duration {
+	var end time.Time
+	for _, s := range t.Spans {
+		if s.EndTime.After(end) {
+			end = s.EndTime
+		}
+	}
+	return end.Sub(t.Start())
+}
+
+// RecentTraces returns the limit most recently started traces of the store at
+// path, most recent first. All the traces are returned if limit is not
+// positive.
+func RecentTraces(path string, limit int) ([]Trace, error) {
+	traces, err := readTraces(path)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(traces, func(a, b Trace) int {
+		return b.Start().Compare(a.Start())
+	})
+	if limit > 0 && len(traces) > limit {
+		traces = traces[:limit]
+	}
+	return traces, nil
+}
+
+// FindTrace returns the trace with the hex encoded traceID from the store at
+// path. ErrTraceNotFound is returned if the store does not contain the trace.
+func FindTrace(path, traceID string) (Trace, error) {
+	traces, err := readTraces(path)
+	if err != nil {
+		return Trace{}, err
+	}
+	for _, t := range traces {
+		if t.TraceID == traceID {
+			return t, nil
+		}
+	}
+	return Trace{}, ErrTraceNotFound
+}
+
+// readTraces returns all the traces of the store at path.
+func readTraces(path string) ([]Trace, error) {
+	f, err := os.Open(path) // nolint:gosec // Path provided by the user.
+	if err != nil {
+		return nil, fmt.Errorf("localtrace: open store: %w", err)
+	}
+	defer f.Close()
+
+	var traces []Trace
+	index := make(map[string]int)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var s Span
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			return nil, fmt.Errorf("localtrace: invalid span at line %d: %w", line, err)
+		}
+		i, ok := index[s.TraceID]
+		if !ok {
+			i = len(traces)
+			index[s.TraceID] = i
+			traces = append(traces, Trace{TraceID: s.TraceID})
+		}
+		traces[i].Spans = append(traces[i].Spans, s)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("localtrace: read store: %w", err)
+	}
+
+	for i := range traces {
+		slices.SortStableFunc(traces[i].Spans, func(a, b Span) int {
+			return a.StartTime.Compare(b.StartTime)
+		})
+	}
+	return traces, nil
+}
@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package localtrace
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// Span is a span stored by an Exporter.
+type Span struct {
+	TraceID      string            `json:"trace_id"`
+	SpanID       string            `json:"span_id"`
+	ParentSpanID string            `json:"parent_span_id,omitempty"`
+	Name         string            `json:"name"`
+	Kind         string            `json:"kind"`
+	StartTime    time.Time         `json:"start_time"`
+	EndTime      time.Time         `json:"end_time"`
+	StatusCode   string            `json:"status_code"`
+	StatusDesc   string            `json:"status_description,omitempty"`
+	Service      string            `json:"service,omitempty"`
+	Scope        string            `json:"scope,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+	Events       []Event           `json:"events,omitempty"`
+}
+
+// Event is an event of a stored Span.
+type Event struct {
+	Name       string            `json:"name"`
+	Time       time.Time         `json:"time"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Duration returns the duration of the span.
+func (s Span) Duration() time.Duration {
+	return s.EndTime.Sub(s.StartTime)
+}
+
+// newSpan returns the Span stored for s.
+func newSpan(s sdktrace.ReadOnlySpan) Span {
+	out := Span{
+		TraceID:    s.SpanContext().TraceID().String(),
+		SpanID:     s.SpanContext().SpanID().String(),
+		Name:       s.Name(),
+		Kind:       s.SpanKind().String(),
+		StartTime:  s.StartTime(),
+		EndTime:    s.EndTime(),
+		StatusCode: s.Status().Code.String(),
+		StatusDesc: s.Status().Description,
+		Scope:      s.InstrumentationScope().Name,
+		Attributes: attrs(s.Attributes()),
+	}
+	if p := s.Parent(); p.SpanID().IsValid() {
+		out.ParentSpanID = p.SpanID().String()
+	}
+	if res := s.Resource(); res != nil {
+		if v, ok := res.Set().Value(semconv.ServiceNameKey); ok {
+			out.Service = v.Emit()
+		}
+	}
+	for _, e := range s.Events() {
+		out.Events = append(out.Events, Event{
+			Name:       e.Name,
+			Time:       e.Time,
+			Attributes: attrs(e.Attributes),
+		})
+	}
+	return out
+}
+
+func attrs(kvs []attribute.KeyValue) map[string]string {
+	if len(kvs) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		m[string(kv.Key)] = kv.Value.Emit()
+	}
+	return m
+}
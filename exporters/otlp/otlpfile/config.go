@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+// defaultMaxFiles is the default number of rotated files kept.
+const defaultMaxFiles = 10
+
+// config contains the options for an exporter.
+type config struct {
+	maxSize  int64
+	maxFiles int
+	gzip     bool
+}
+
+// newConfig returns a config configured with options.
+func newConfig(options []Option) config {
+	cfg := config{maxFiles: defaultMaxFiles}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets the value of an option for an exporter.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithMaxSize sets the maximum size, in bytes, of the written file. When a
+// line would make the file exceed size, the file is rotated before the line
+// is written: it is renamed with the ".1" suffix, the previously rotated files
+// are renamed with the next suffix (".1" to ".2", etc.), and a new file is
+// created. A file always holds at least one line, even if the line exceeds
+// size.
+//
+// When used with [WithGzip], size limits the uncompressed size of the lines
+// written to the file.
+//
+// By default, or if size is not positive, the file is not rotated.
+func WithMaxSize(size int64) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.maxSize = size
+		return cfg
+	})
+}
+
+// WithMaxFiles sets the maximum number of rotated files kept in addition to
+// the written file. The oldest rotated files are removed. If n is zero, the
+// rotated files are removed. A negative n is ignored.
+//
+// This option only applies if [WithMaxSize] is used. By default, 10 rotated
+// files are kept.
+func WithMaxFiles(n int) Option {
+	return optionFunc(func(cfg config) config {
+		if n >= 0 {
+			cfg.maxFiles = n
+		}
+		return cfg
+	})
+}
+
+// WithGzip compresses the written files with gzip. The file needs to be
+// decompressed before it is read as OTLP JSON lines.
+//
+// Appending to an existing file adds a gzip member to it, which is supported
+// by gzip readers.
+//
+// By default, the files are not compressed.
+func WithGzip() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.gzip = true
+		return cfg
+	})
+}
@@ -0,0 +1,19 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package otlpfile provides exporters that write spans, metrics, and log
+// records to files as described by the OTLP File specification.
+//
+// Each export is written as a line holding an OTLP TracesData, MetricsData, or
+// LogsData message encoded as OTLP JSON. These messages have the same JSON
+// representation as the ExportTraceServiceRequest,
+// ExportMetricsServiceRequest, and ExportLogsServiceRequest messages of OTLP.
+// The files can be replayed into an OpenTelemetry Collector with the otlpjson
+// connector or the filelog receiver, for example in air-gapped environments.
+//
+// Unlike the stdout exporters, which use a format specific to this project,
+// the written files can be read by any OTLP JSON consumer.
+//
+// The files can be rotated when they reach a maximum size (see [WithMaxSize]
+// and [WithMaxFiles]) and compressed with gzip (see [WithGzip]).
+package otlpfile
@@ -0,0 +1,172 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var traceA = trace.TraceID{0x01}
+
+func spans() []sdktrace.ReadOnlySpan {
+	return tracetest.SpanStubs{{
+		Name:     "span",
+		SpanKind: trace.SpanKindServer,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceA,
+			SpanID:  trace.SpanID{1},
+		}),
+		Resource: resource.NewSchemaless(attribute.String("service.name", "test")),
+	}}.Snapshots()
+}
+
+// readLines returns the lines of the file at path.
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestTraceExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	exp, err := NewTraceExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 2)
+
+	var got struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID string `json:"traceId"`
+					SpanID  string `json:"spanId"`
+					Kind    int    `json:"kind"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	s := got.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	assert.Equal(t, traceA.String(), s.TraceID)
+	assert.Equal(t, trace.SpanID{1}.String(), s.SpanID)
+	assert.Equal(t, int(tracepb.Span_SPAN_KIND_SERVER), s.Kind)
+}
+
+func TestTraceExporterAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	for range 2 {
+		exp, err := NewTraceExporter(path)
+		require.NoError(t, err)
+		require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+		require.NoError(t, exp.Shutdown(t.Context()))
+	}
+	assert.Len(t, readLines(t, path), 2)
+}
+
+func TestTraceExporterErrors(t *testing.T) {
+	_, err := NewTraceExporter(filepath.Join(t.TempDir(), "missing", "traces.jsonl"))
+	assert.Error(t, err)
+
+	exp, err := NewTraceExporter(filepath.Join(t.TempDir(), "traces.jsonl"))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.ErrorIs(t, exp.ExportSpans(ctx, spans()), context.Canceled)
+	assert.ErrorIs(t, exp.ForceFlush(ctx), context.Canceled)
+}
+
+func TestTraceExporterShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	exp, err := NewTraceExporter(path)
+	require.NoError(t, err)
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans()))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+	assert.Empty(t, readLines(t, path))
+}
+
+func TestMetricExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	exp, err := NewMetricExporter(path)
+	require.NoError(t, err)
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.Empty(),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Metrics: []metricdata.Metrics{{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Value: 1}}},
+			}},
+		}},
+	}
+	require.NoError(t, exp.Export(t.Context(), rm))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 1)
+	var got struct {
+		ResourceMetrics []struct {
+			ScopeMetrics []struct {
+				Metrics []struct {
+					Name string `json:"name"`
+				} `json:"metrics"`
+			} `json:"scopeMetrics"`
+		} `json:"resourceMetrics"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &got))
+	assert.Equal(t, "gauge", got.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Name)
+}
+
+func TestLogExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs.jsonl")
+	exp, err := NewLogExporter(path)
+	require.NoError(t, err)
+	var r log.Record
+	r.SetBody(attribute.StringValue("message"))
+	r.SetTraceID(traceA)
+	require.NoError(t, exp.Export(t.Context(), []log.Record{r}))
+	require.NoError(t, exp.ForceFlush(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+	require.NoError(t, exp.Export(t.Context(), []log.Record{r}))
+
+	lines := readLines(t, path)
+	require.Len(t, lines, 1)
+	assert.Contains(t, lines[0], `"resourceLogs":`)
+	assert.Contains(t, lines[0], `"traceId":"`+traceA.String()+`"`)
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package internal provides internal functionality for the otlpfile package.
+package internal
+
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpjson.go.tmpl "--data={}" --out=otlpjson.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpjson_test.go.tmpl "--data={}" --out=otlpjson_test.go
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"context"
+	"sync/atomic"
+
+	lpb "go.opentelemetry.io/proto/otlp/logs/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpfile/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/transform"
+	"go.opentelemetry.io/otel/sdk/log"
+)
+
+var _ log.Exporter = (*LogExporter)(nil)
+
+// LogExporter is a log Exporter that writes log records to a file as OTLP
+// JSON lines.
+type LogExporter struct {
+	w       *fileWriter
+	stopped atomic.Bool
+}
+
+// NewLogExporter returns a new LogExporter that appends log records to the
+// file at path. The file is created if it does not exist.
+//
+// An error is returned if the file cannot be opened.
+func NewLogExporter(path string, options ...Option) (*LogExporter, error) {
+	w, err := newFileWriter(path, newConfig(options))
+	if err != nil {
+		return nil, err
+	}
+	return &LogExporter{w: w}, nil
+}
+
+// Export writes records to the file as a single line.
+//
+// This method returns an error if the records cannot be encoded or written.
+// It does nothing after Shutdown is called.
+func (e *LogExporter) Export(ctx context.Context, records []log.Record) error {
+	if e.stopped.Load() || len(records) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := internal.MarshalJSON(&lpb.LogsData{
+		ResourceLogs: transform.ResourceLogs(records),
+	})
+	if err != nil {
+		return err
+	}
+	return e.w.WriteLine(data)
+}
+
+// ForceFlush commits the written log records to stable storage.
+func (e *LogExporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return e.w.Sync()
+}
+
+// Shutdown closes the file. Records passed to Export after Shutdown is called
+// are dropped.
+func (e *LogExporter) Shutdown(context.Context) error {
+	e.stopped.Store(true)
+	return e.w.Close()
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpfile/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/transform"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var _ metric.Exporter = (*MetricExporter)(nil)
+
+// MetricExporter is a metric Exporter that writes metrics to a file as OTLP
+// JSON lines.
+//
+// It uses the default temporality and aggregation of the SDK for all
+// instrument kinds.
+type MetricExporter struct {
+	w       *fileWriter
+	stopped atomic.Bool
+}
+
+// NewMetricExporter returns a new MetricExporter that appends metrics to the
+// file at path. The file is created if it does not exist.
+//
+// An error is returned if the file cannot be opened.
+func NewMetricExporter(path string, options ...Option) (*MetricExporter, error) {
+	w, err := newFileWriter(path, newConfig(options))
+	if err != nil {
+		return nil, err
+	}
+	return &MetricExporter{w: w}, nil
+}
+
+// Temporality returns the Temporality to use for an instrument kind.
+func (*MetricExporter) Temporality(k metric.InstrumentKind) metricdata.Temporality {
+	return metric.DefaultTemporalitySelector(k)
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (*MetricExporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
+	return metric.DefaultAggregationSelector(k)
+}
+
+// Export writes rm to the file as a single line.
+//
+// If rm contains metrics that cannot be transformed to OTLP, the other metrics
+// are written and an error is returned. It does nothing after Shutdown is
+// called.
+func (e *MetricExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if e.stopped.Load() {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	otlpRM, err := transform.ResourceMetrics(rm)
+	// Best effort write of the metrics that were transformed.
+	data, mErr := internal.MarshalJSON(&mpb.MetricsData{
+		ResourceMetrics: []*mpb.ResourceMetrics{otlpRM},
+	})
+	if mErr != nil {
+		return errors.Join(err, mErr)
+	}
+	return errors.Join(err, e.w.WriteLine(data))
+}
+
+// ForceFlush commits the written metrics to stable storage.
+func (e *MetricExporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return e.w.Sync()
+}
+
+// Shutdown closes the file. Metrics passed to Export after Shutdown is called
+// are dropped.
+func (e *MetricExporter) Shutdown(context.Context) error {
+	e.stopped.Store(true)
+	return e.w.Close()
+}
@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"context"
+	"sync/atomic"
+
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpfile/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/tracetransform"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var _ sdktrace.SpanExporter = (*TraceExporter)(nil)
+
+// TraceExporter is a SpanExporter that writes spans to a file as OTLP JSON
+// lines.
+type TraceExporter struct {
+	w       *fileWriter
+	stopped atomic.Bool
+}
+
+// NewTraceExporter returns a new TraceExporter that appends spans to the file
+// at path. The file is created if it does not exist.
+//
+// An error is returned if the file cannot be opened.
+func NewTraceExporter(path string, options ...Option) (*TraceExporter, error) {
+	w, err := newFileWriter(path, newConfig(options))
+	if err != nil {
+		return nil, err
+	}
+	return &TraceExporter{w: w}, nil
+}
+
+// ExportSpans writes spans to the file as a single line.
+//
+// This method returns an error if the spans cannot be encoded or written. It
+// does nothing after Shutdown is called.
+func (e *TraceExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.stopped.Load() || len(spans) == 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	data, err := internal.MarshalJSON(&tracepb.TracesData{
+		ResourceSpans: tracetransform.Spans(spans),
+	})
+	if err != nil {
+		return err
+	}
+	return e.w.WriteLine(data)
+}
+
+// ForceFlush commits the written spans to stable storage.
+func (e *TraceExporter) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return e.w.Sync()
+}
+
+// Shutdown closes the file. Spans passed to ExportSpans after Shutdown is
+// called are dropped.
+func (e *TraceExporter) Shutdown(context.Context) error {
+	e.stopped.Store(true)
+	return e.w.Close()
+}
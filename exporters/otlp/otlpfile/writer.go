@@ -0,0 +1,174 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// errShutdown is returned when writing to a closed fileWriter.
+var errShutdown = errors.New("otlpfile: exporter is shut down")
+
+// fileWriter writes lines to a file, rotating it when it reaches a maximum
+// size.
+type fileWriter struct {
+	path string
+	cfg  config
+
+	mu     sync.Mutex
+	file   *os.File
+	gz     *gzip.Writer
+	w      io.Writer
+	size   int64
+	closed bool
+}
+
+// newFileWriter returns a fileWriter appending to the file at path.
+func newFileWriter(path string, cfg config) (*fileWriter, error) {
+	w := &fileWriter{path: path, cfg: cfg}
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// open opens the file at w.path for appending.
+func (w *fileWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("otlpfile: open file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("otlpfile: open file: %w", err)
+	}
+
+	w.file, w.w, w.size = f, f, info.Size()
+	if w.cfg.gzip {
+		w.gz = gzip.NewWriter(f)
+		w.w = w.gz
+		// The size of a compressed file is not comparable to the uncompressed
+		// size of the lines, only count the lines written.
+		w.size = 0
+	}
+	return nil
+}
+
+// closeFile closes the current file.
+func (w *fileWriter) closeFile() error {
+	var err error
+	if w.gz != nil {
+		err = w.gz.Close()
+		w.gz = nil
+	}
+	err = errors.Join(err, w.file.Close())
+	w.file, w.w = nil, nil
+	return err
+}
+
+// rotate renames the current file and the previously rotated files, removes
+// the rotated files exceeding the maximum number of files, and opens a new
+// file.
+func (w *fileWriter) rotate() error {
+	if err := w.closeFile(); err != nil {
+		return fmt.Errorf("otlpfile: rotate file: %w", err)
+	}
+
+	if w.cfg.maxFiles == 0 {
+		if err := os.Remove(w.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("otlpfile: rotate file: %w", err)
+		}
+		return w.open()
+	}
+
+	if err := os.Remove(w.rotatedPath(w.cfg.maxFiles)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("otlpfile: rotate file: %w", err)
+	}
+	for i := w.cfg.maxFiles - 1; i >= 1; i-- {
+		err := os.Rename(w.rotatedPath(i), w.rotatedPath(i+1))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("otlpfile: rotate file: %w", err)
+		}
+	}
+	if err := os.Rename(w.path, w.rotatedPath(1)); err != nil {
+		return fmt.Errorf("otlpfile: rotate file: %w", err)
+	}
+	return w.open()
+}
+
+// rotatedPath returns the path of the i-th rotated file.
+func (w *fileWriter) rotatedPath(i int) string {
+	return fmt.Sprintf("%s.%d", w.path, i)
+}
+
+// WriteLine writes line followed by a newline to the file. The file is rotated
+// first if writing line would make it exceed its maximum size.
+func (w *fileWriter) WriteLine(line []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return errShutdown
+	}
+	if w.file == nil {
+		// A previous rotation failed to open a new file.
+		if err := w.open(); err != nil {
+			return err
+		}
+	}
+
+	n := int64(len(line)) + 1
+	if w.cfg.maxSize > 0 && w.size > 0 && w.size+n > w.cfg.maxSize {
+		if err := w.rotate(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := w.w.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("otlpfile: write: %w", err)
+	}
+	w.size += n
+	if w.gz != nil {
+		// Make the line readable without waiting for the file to be closed.
+		if err := w.gz.Flush(); err != nil {
+			return fmt.Errorf("otlpfile: write: %w", err)
+		}
+	}
+	return nil
+}
+
+// Sync commits the written lines to stable storage.
+func (w *fileWriter) Sync() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed || w.file == nil {
+		return nil
+	}
+	if err := w.file.Sync(); err != nil {
+		return fmt.Errorf("otlpfile: sync: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file. Lines written after Close is called are rejected.
+func (w *fileWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.file == nil {
+		return nil
+	}
+	if err := w.closeFile(); err != nil {
+		return fmt.Errorf("otlpfile: close file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpfile
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readFile(t *testing.T, path string) string {
+	t.Helper()
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	return string(b)
+}
+
+func TestFileWriterRotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	w, err := newFileWriter(path, newConfig([]Option{WithMaxSize(8), WithMaxFiles(2)}))
+	require.NoError(t, err)
+
+	for _, line := range []string{"0", "1", "2", "3", "4", "long line", "5"} {
+		require.NoError(t, w.WriteLine([]byte(line)))
+	}
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "5\n", readFile(t, path))
+	assert.Equal(t, "long line\n", readFile(t, path+".1"))
+	assert.Equal(t, "4\n", readFile(t, path+".2"))
+	assert.NoFileExists(t, path+".3", "oldest file not removed")
+}
+
+func TestFileWriterRotationNoFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	w, err := newFileWriter(path, newConfig([]Option{WithMaxSize(4), WithMaxFiles(0)}))
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteLine([]byte("1")))
+	require.NoError(t, w.WriteLine([]byte("2")))
+	require.NoError(t, w.WriteLine([]byte("3")))
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "3\n", readFile(t, path))
+	assert.NoFileExists(t, path+".1")
+}
+
+func TestFileWriterExistingSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	require.NoError(t, os.WriteFile(path, []byte("existing\n"), 0o600))
+
+	w, err := newFileWriter(path, newConfig([]Option{WithMaxSize(10)}))
+	require.NoError(t, err)
+	require.NoError(t, w.WriteLine([]byte("new")))
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "existing\n", readFile(t, path+".1"))
+	assert.Equal(t, "new\n", readFile(t, path))
+}
+
+func TestFileWriterGzip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl.gz")
+	for _, line := range []string{"1", "2"} {
+		w, err := newFileWriter(path, newConfig([]Option{WithGzip()}))
+		require.NoError(t, err)
+		require.NoError(t, w.WriteLine([]byte(line)))
+		require.NoError(t, w.Close())
+	}
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	r, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "1\n2\n", string(b))
+}
+
+func TestFileWriterClosed(t *testing.T) {
+	w, err := newFileWriter(filepath.Join(t.TempDir(), "out.jsonl"), newConfig(nil))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, w.Close())
+	assert.ErrorIs(t, w.WriteLine([]byte("line")), errShutdown)
+	assert.NoError(t, w.Sync())
+}
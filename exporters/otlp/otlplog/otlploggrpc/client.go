@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 	"time"
 
@@ -35,6 +36,19 @@ type client struct {
 	maxRequestSize int
 	requestFunc    retry.RequestFunc
 
+	// dryRun configures exports to be serialized and written to dryRunSink
+	// instead of being sent.
+	dryRun     bool
+	dryRunSink io.Writer
+
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured.
+	queue *internal.PersistentQueue
+
+	// responseHandler is called with the metadata of the export responses,
+	// if not nil.
+	responseHandler func(header, trailer metadata.MD)
+
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
 	// Shutdown as conn should only be closed if we created it. Otherwise,
@@ -43,7 +57,12 @@ type client struct {
 	conn    *grpc.ClientConn
 	lsc     collogpb.LogsServiceClient
 
+	debug debugState
+
 	instrumentation *observ.Instrumentation
+	// noPayloadSizeMetrics disables the recording of the size of the
+	// requests and responses by instrumentation.
+	noPayloadSizeMetrics bool
 }
 
 // Used for testing.
@@ -52,10 +71,23 @@ var newGRPCClientFn = grpc.NewClient
 // newClient creates a new gRPC log client.
 func newClient(cfg config) (*client, error) {
 	c := &client{
-		exportTimeout:  cfg.timeout.Value,
-		maxRequestSize: cfg.maxRequestSize.Value,
-		requestFunc:    cfg.retryCfg.Value.RequestFunc(retryable),
-		conn:           cfg.gRPCConn.Value,
+		exportTimeout:   cfg.timeout.Value,
+		maxRequestSize:  cfg.maxRequestSize.Value,
+		dryRun:          cfg.dryRun.Value,
+		dryRunSink:      cfg.dryRunSink.Value,
+		requestFunc:     cfg.retryCfg.Value.RequestFunc(retryable),
+		conn:            cfg.gRPCConn.Value,
+		responseHandler: cfg.responseHandler.Value,
+
+		noPayloadSizeMetrics: cfg.noPayloadSizeMetrics.Value,
+	}
+
+	if dir := cfg.persistentQueueDir.Value; dir != "" {
+		q, err := internal.NewPersistentQueue(dir, cfg.persistentQueueMaxBytes.Value, persistable)
+		if err != nil {
+			return nil, err
+		}
+		c.queue = q
 	}
 
 	if len(cfg.headers.Value) > 0 {
@@ -79,6 +111,22 @@ func newClient(cfg config) (*client, error) {
 
 	c.lsc = collogpb.NewLogsServiceClient(c.conn)
 
+	c.debug = debugState{
+		conn:     c.conn,
+		attempts: internal.NewAttemptLog[ExportAttempt](debugAttempts),
+	}
+	switch {
+	case !c.ourConn:
+		// The credentials of a connection passed with WithGRPCConn are
+		// unknown.
+	case cfg.gRPCCredentials.Value != nil:
+		c.debug.securityProtocol = cfg.gRPCCredentials.Value.Info().SecurityProtocol
+	case cfg.insecure.Value:
+		c.debug.securityProtocol = "insecure"
+	default:
+		c.debug.securityProtocol = "tls"
+	}
+
 	var err error
 	id := nextExporterID()
 	c.instrumentation, err = observ.NewInstrumentation(id, c.conn.CanonicalTarget())
@@ -168,23 +216,75 @@ func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) (uplo
 		return fmt.Errorf("request message too large: exceeded %d bytes", maxSize)
 	}
 
-	return errors.Join(uploadErr, c.requestFunc(ctx, func(ctx context.Context) error {
-		resp, err := c.lsc.Export(ctx, pbRequest)
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedLogRecords()
-			if n != 0 || msg != "" {
-				err := internal.LogPartialSuccessError(n, msg)
-				uploadErr = errors.Join(uploadErr, err)
+	if c.dryRun {
+		return internal.DryRunMessage(c.dryRunSink, pbRequest)
+	}
+
+	send := func(ctx context.Context, pbRequest *collogpb.ExportLogsServiceRequest) error {
+		var partialErr error
+		// reqSize is the size of the request recorded by instrumentation, or
+		// -1 if the sizes are not recorded.
+		reqSize := int64(-1)
+		if c.instrumentation != nil && !c.noPayloadSizeMetrics && c.instrumentation.PayloadSizeEnabled(ctx) {
+			reqSize = int64(proto.Size(pbRequest))
+		}
+		err := c.requestFunc(ctx, func(ctx context.Context) error {
+			var header, trailer metadata.MD
+			var callOpts []grpc.CallOption
+			if c.responseHandler != nil {
+				callOpts = []grpc.CallOption{grpc.Header(&header), grpc.Trailer(&trailer)}
+			}
+			start := time.Now()
+			resp, err := c.lsc.Export(ctx, pbRequest, callOpts...)
+			c.debug.attempts.Add(ExportAttempt{
+				Time:     start,
+				Duration: time.Since(start),
+				Code:     status.Code(err),
+				Err:      err,
+			})
+			if reqSize >= 0 {
+				respSize := int64(-1)
+				if resp != nil {
+					respSize = int64(proto.Size(resp))
+				}
+				c.instrumentation.RecordPayloadSize(ctx, reqSize, respSize, status.Code(err))
 			}
+			if c.responseHandler != nil {
+				c.responseHandler(header, trailer)
+			}
+			if resp != nil && resp.PartialSuccess != nil {
+				msg := resp.PartialSuccess.GetErrorMessage()
+				n := resp.PartialSuccess.GetRejectedLogRecords()
+				if n != 0 || msg != "" {
+					err := internal.LogPartialSuccessError(n, msg)
+					partialErr = errors.Join(partialErr, err)
+				}
+			}
+			// nil is converted to OK.
+			if status.Code(err) == codes.OK {
+				// Success.
+				return nil
+			}
+			return err
+		})
+		return errors.Join(partialErr, err)
+	}
+
+	if c.queue != nil {
+		rawRequest, err := proto.Marshal(pbRequest)
+		if err != nil {
+			return err
 		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+		sendRaw := func(ctx context.Context, b []byte) error {
+			req := new(collogpb.ExportLogsServiceRequest)
+			if err := proto.Unmarshal(b, req); err != nil {
+				return err
+			}
+			return send(ctx, req)
 		}
-		return err
-	}))
+		return c.queue.Export(ctx, rawRequest, sendRaw, sendRaw)
+	}
+	return send(ctx, pbRequest)
 }
 
 // Shutdown shuts down the client, freeing all resources.
@@ -198,6 +298,12 @@ func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) (uplo
 // ensures this is called only once. The only thing that needs to be done
 // here is to release any computational resources the client holds.
 func (c *client) Shutdown(ctx context.Context) error {
+	// Stop the replay of the persisted requests before the client is
+	// released.
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
+
 	c.metadata = nil
 	c.requestFunc = nil
 	c.lsc = nil
@@ -284,6 +390,13 @@ func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	return false, 0
 }
 
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	ok, _ := retryable(err)
+	return ok
+}
+
 // throttleDelay returns if the status is RetryInfo
 // and the duration to wait for if an explicit throttle time is included.
 func throttleDelay(s *status.Status) (bool, time.Duration) {
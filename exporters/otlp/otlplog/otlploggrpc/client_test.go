@@ -4,9 +4,12 @@
 package otlploggrpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"net"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -1026,6 +1029,52 @@ func TestClientObservability(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:    "payload size",
+			enabled: true,
+			test: func(t *testing.T, scopeMetrics func() metricdata.ScopeMetrics) {
+				ctx := t.Context()
+				client, _ := clientFactory(t, nil)
+				require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+				require.NoError(t, client.Shutdown(ctx))
+
+				sizes := make(map[string]int64)
+				for _, m := range scopeMetrics().Metrics {
+					if h, ok := m.Data.(metricdata.Histogram[int64]); ok {
+						require.Len(t, h.DataPoints, 1, m.Name)
+						sizes[m.Name] = h.DataPoints[0].Sum
+					}
+				}
+				req := &collogpb.ExportLogsServiceRequest{ResourceLogs: resourceLogs}
+				assert.Equal(t, int64(proto.Size(req)), sizes[observ.RequestSizeName])
+				assert.Contains(t, sizes, observ.ResponseSizeName)
+			},
+		},
+		{
+			name:    "without payload size metrics",
+			enabled: true,
+			test: func(t *testing.T, scopeMetrics func() metricdata.ScopeMetrics) {
+				ctx := t.Context()
+				coll, err := newGRPCCollector(ctx, "", nil)
+				require.NoError(t, err)
+				client, err := newClient(newConfig([]Option{
+					WithEndpoint(coll.listener.Addr().String()),
+					WithInsecure(),
+					WithoutPayloadSizeMetrics(),
+				}))
+				require.NoError(t, err)
+				require.NoError(t, client.UploadLogs(ctx, resourceLogs))
+				require.NoError(t, client.Shutdown(ctx))
+
+				var names []string
+				for _, m := range scopeMetrics().Metrics {
+					names = append(names, m.Name)
+				}
+				assert.Contains(t, names, otelconv.SDKExporterLogExported{}.Name())
+				assert.NotContains(t, names, observ.RequestSizeName)
+				assert.NotContains(t, names, observ.ResponseSizeName)
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1312,3 +1361,195 @@ func TestNextExporterIDConcurrentSafe(t *testing.T) {
 		t.Errorf("nextExporterID() = %d; want %d", id, expected)
 	}
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := newConfig([]Option{WithInsecure(), WithDryRun(&buf)})
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UploadLogs(t.Context(), resourceLogs))
+
+	var req collogpb.ExportLogsServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	want := &collogpb.ExportLogsServiceRequest{ResourceLogs: resourceLogs}
+	assert.True(t, proto.Equal(want, &req), "serialized request")
+}
+
+func TestPersistentQueue(t *testing.T) {
+	rCh := make(chan exportResult, 5)
+	rCh <- exportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+	rCh <- exportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+	for range 3 {
+		rCh <- exportResult{}
+	}
+	coll, err := newGRPCCollector(t.Context(), "", rCh)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{
+		WithEndpoint(coll.listener.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	ctx := t.Context()
+	upload := func(schemaURL string) error {
+		rl := []*lpb.ResourceLogs{{Resource: res, ScopeLogs: scopeLogs, SchemaUrl: schemaURL}}
+		return client.UploadLogs(ctx, rl)
+	}
+
+	// The first request fails and is persisted. The second request is
+	// persisted after the first one fails again.
+	require.NoError(t, upload("1"))
+	require.NoError(t, upload("2"))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+	var failed int
+	require.Eventually(t, func() bool {
+		failed += len(coll.Collect().Dump())
+		return failed == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, upload("3"))
+	var got []string
+	assert.Eventually(t, func() bool {
+		for _, rl := range coll.Collect().Dump() {
+			got = append(got, rl.SchemaUrl)
+		}
+		return len(got) >= 3
+	}, 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Equal(t, []string{"1", "2", "3"}, got, "replay order")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueRejected(t *testing.T) {
+	rCh := make(chan exportResult, 1)
+	rCh <- exportResult{Err: status.Error(codes.InvalidArgument, "invalid")}
+	coll, err := newGRPCCollector(t.Context(), "", rCh)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{
+		WithEndpoint(coll.listener.Addr().String()),
+		WithInsecure(),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	assert.Error(t, client.UploadLogs(t.Context(), resourceLogs))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "rejected request persisted")
+}
+
+func TestPersistentQueueInvalidDir(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	cfg := newConfig([]Option{WithInsecure(), WithPersistentQueue(file, 0)})
+	_, err := newClient(cfg)
+	assert.Error(t, err)
+}
+
+// metadataLogsService responds to the export requests with metadata.
+type metadataLogsService struct {
+	collogpb.UnimplementedLogsServiceServer
+
+	requests int
+}
+
+func (s *metadataLogsService) Export(
+	ctx context.Context,
+	_ *collogpb.ExportLogsServiceRequest,
+) (*collogpb.ExportLogsServiceResponse, error) {
+	s.requests++
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-ratelimit-remaining", "10")); err != nil {
+		return nil, err
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs("server-version", "1.2.3")); err != nil {
+		return nil, err
+	}
+	if s.requests == 1 {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &collogpb.ExportLogsServiceResponse{}, nil
+}
+
+func TestResponseMetadataHandler(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	collogpb.RegisterLogsServiceServer(srv, &metadataLogsService{})
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	type response struct{ header, trailer metadata.MD }
+	var got []response
+	cfg := newConfig([]Option{
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		WithResponseMetadataHandler(func(header, trailer metadata.MD) {
+			got = append(got, response{header, trailer})
+		}),
+	})
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	require.NoError(t, client.UploadLogs(t.Context(), resourceLogs))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for _, r := range got {
+		assert.Equal(t, []string{"10"}, r.header.Get("x-ratelimit-remaining"))
+		assert.Equal(t, []string{"1.2.3"}, r.trailer.Get("server-version"))
+	}
+}
+
+func TestExporterDebugInfo(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	collogpb.RegisterLogsServiceServer(srv, &metadataLogsService{})
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+	)
+	require.NoError(t, err)
+	info := exp.DebugInfo()
+	assert.Contains(t, info.Endpoint, ln.Addr().String())
+	assert.Equal(t, "insecure", info.SecurityProtocol)
+	assert.Empty(t, info.Attempts)
+
+	require.NoError(t, exp.Export(ctx, make([]log.Record, 1)))
+	info = exp.DebugInfo()
+	assert.Equal(t, "READY", info.ConnectivityState)
+	require.Len(t, info.Attempts, 2)
+	assert.Equal(t, codes.Unavailable, info.Attempts[0].Code)
+	assert.Error(t, info.Attempts[0].Err)
+	assert.Equal(t, codes.OK, info.Attempts[1].Code)
+	assert.NoError(t, info.Attempts[1].Err)
+
+	require.NoError(t, exp.Shutdown(ctx))
+	info = exp.DebugInfo()
+	assert.Equal(t, "SHUTDOWN", info.ConnectivityState)
+	assert.Len(t, info.Attempts, 2, "kept after shutdown")
+}
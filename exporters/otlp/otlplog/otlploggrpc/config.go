@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc/internal/retry"
@@ -92,8 +94,24 @@ type config struct {
 	headers        setting[map[string]string]
 	compression    setting[Compression]
 	maxRequestSize setting[int]
-	timeout        setting[time.Duration]
-	retryCfg       setting[retry.Config]
+	dryRun         setting[bool]
+	dryRunSink     setting[io.Writer]
+
+	// persistentQueueDir is the directory export requests that failed
+	// because the endpoint was unavailable are persisted to, if not empty.
+	persistentQueueDir      setting[string]
+	persistentQueueMaxBytes setting[int64]
+
+	// responseHandler is called with the metadata of the export responses,
+	// if set.
+	responseHandler setting[func(header, trailer metadata.MD)]
+
+	// noPayloadSizeMetrics disables the metrics recording the size of the
+	// requests and responses.
+	noPayloadSizeMetrics setting[bool]
+
+	timeout  setting[time.Duration]
+	retryCfg setting[retry.Config]
 
 	// gRPC configurations
 	gRPCCredentials    setting[credentials.TransportCredentials]
@@ -371,6 +389,82 @@ func WithMaxRequestSize(size int) Option {
 	})
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return fnOpt(func(c config) config {
+		c.dryRun = newSetting(true)
+		c.dryRunSink = newSetting(sink)
+		return c
+	})
+}
+
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new log records are persisted without being sent so that the
+// order is kept.
+//
+// Log records are delivered at least once: a persisted request is only
+// removed once it has been accepted by the endpoint, so a request can be sent
+// again if the process stops after it was sent but before it was removed.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return fnOpt(func(c config) config {
+		c.persistentQueueDir = newSetting(dir)
+		c.persistentQueueMaxBytes = newSetting(maxBytes)
+		return c
+	})
+}
+
+// WithResponseMetadataHandler sets a function called with the header and
+// trailer metadata of the response to each export request, including the
+// requests that failed or are retried. Use it to read the metadata set by
+// the endpoint, e.g. to slow down the exports when a rate limit header
+// reports few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseMetadataHandler(h func(header, trailer metadata.MD)) Option {
+	return fnOpt(func(c config) config {
+		c.responseHandler = newSetting(h)
+		return c
+	})
+}
+
+// WithoutPayloadSizeMetrics disables the experimental rpc.client.request.size
+// and rpc.client.response.size self-observability metrics recording the size
+// of the protobuf encoded requests sent and responses received by the
+// exporter. Computing these sizes has a cost proportional to the size of the
+// requests, use this option to avoid it while keeping the other
+// self-observability metrics.
+func WithoutPayloadSizeMetrics() Option {
+	return fnOpt(func(c config) config {
+		c.noPayloadSizeMetrics = newSetting(true)
+		return c
+	})
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
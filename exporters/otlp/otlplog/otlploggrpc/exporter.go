@@ -28,6 +28,9 @@ type Exporter struct {
 	client   logClient
 
 	stopped atomic.Bool
+
+	// debug is the state of the client reported by DebugInfo.
+	debug *debugState
 }
 
 // Compile-time check Exporter implements [log.Exporter].
@@ -43,7 +46,9 @@ func New(_ context.Context, options ...Option) (*Exporter, error) {
 	if err != nil {
 		return nil, err
 	}
-	return newExporter(c), nil
+	e := newExporter(c)
+	e.debug = &c.debug
+	return e, nil
 }
 
 func newExporter(c logClient) *Exporter {
@@ -88,6 +93,12 @@ func (e *Exporter) Shutdown(ctx context.Context) error {
 	return err
 }
 
+// DebugInfo returns the DebugInfo of the Exporter. It does not wait for the
+// ongoing exports, and is still valid after the Exporter is shut down.
+func (e *Exporter) DebugInfo() DebugInfo {
+	return e.debug.info()
+}
+
 // ForceFlush does nothing. The Exporter holds no state.
 func (*Exporter) ForceFlush(context.Context) error {
 	return nil
@@ -5,8 +5,19 @@
 // package.
 package internal
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun.go.tmpl "--data={}" --out=dryrun.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun_test.go.tmpl "--data={}" --out=dryrun_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts.go.tmpl "--data={}" --out=attempts.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts_test.go.tmpl "--data={}" --out=attempts_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue.go.tmpl "--data={}" --out=persistentqueue.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue_test.go.tmpl "--data={}" --out=persistentqueue_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload_test.go
 
 //go:generate  gotmpl --body=../../../../../internal/shared/x/x.go.tmpl "--data={ \"pkg\": \"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc\" }"  --out=x/x.go
 //go:generate gotmpl --body=../../../../../internal/shared/x/x_test.go.tmpl "--data={}" --out=x/x_test.go
@@ -17,4 +28,5 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/attr_test.go.tmpl "--data={}" --out=transform/attr_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=transform/log.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=transform/log_attr_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_fuzz_test.go.tmpl "--data={}" --out=transform/log_fuzz_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=transform/log_test.go
@@ -99,6 +99,7 @@ type Instrumentation struct {
 	logInflightMetric         metric.Int64UpDownCounter
 	logExportedMetric         metric.Int64Counter
 	logExportedDurationMetric metric.Float64Histogram
+	payload                   *PayloadSize
 
 	presetAttrs []attribute.KeyValue
 	addOpt      metric.AddOption
@@ -142,12 +143,15 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 		err = errors.Join(err, e)
 	}
 	i.logExportedDurationMetric = logOpDurationMetric.Inst()
+
+	i.presetAttrs = getPresetAttrs(id, target)
+
+	i.payload, e = NewPayloadSize(m, i.presetAttrs)
+	err = errors.Join(err, e)
 	if err != nil {
 		return nil, err
 	}
 
-	i.presetAttrs = getPresetAttrs(id, target)
-
 	i.addOpt = metric.WithAttributeSet(attribute.NewSet(i.presetAttrs...))
 	i.recOpt = metric.WithAttributeSet(attribute.NewSet(append(
 		// Default to OK status code.
@@ -227,6 +231,21 @@ func (e ExportOp) End(err error) {
 	e.inst.logExportedDurationMetric.Record(e.ctx, time.Since(e.start).Seconds(), *recordOpt...)
 }
 
+// PayloadSizeEnabled reports whether the sizes of the export calls made with
+// ctx are recorded. Use it to avoid computing the sizes when they are not
+// recorded.
+func (i *Instrumentation) PayloadSizeEnabled(ctx context.Context) bool {
+	return i.payload.Enabled(ctx)
+}
+
+// RecordPayloadSize records the size in bytes of the protobuf encoded request
+// sent by a single export call and of the response it received. The response
+// size is only recorded if response is not negative. The code is the status
+// code of the call.
+func (i *Instrumentation) RecordPayloadSize(ctx context.Context, request, response int64, code codes.Code) {
+	i.payload.Record(ctx, request, response, code)
+}
+
 func (i *Instrumentation) recordOption(err error) metric.RecordOption {
 	if err == nil {
 		return i.recOpt
@@ -56,6 +56,10 @@ func (m *errMeter) Float64Histogram(string, ...mapi.Float64HistogramOption) (map
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Histogram(string, ...mapi.Int64HistogramOption) (mapi.Int64Histogram, error) {
+	return nil, m.err
+}
+
 func TestNewExporterMetrics(t *testing.T) {
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
@@ -86,6 +90,8 @@ func TestNewExporterMetrics(t *testing.T) {
 		assert.ErrorContains(t, err, "inflight metric")
 		assert.ErrorContains(t, err, "log exported metric")
 		assert.ErrorContains(t, err, "operation duration metric")
+		assert.ErrorContains(t, err, "request size metric")
+		assert.ErrorContains(t, err, "response size metric")
 	})
 }
 
@@ -30,6 +30,7 @@ import (
 
 type client struct {
 	uploadLogs func(context.Context, []*logpb.ResourceLogs) error
+	shutdown   func()
 }
 
 func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) error {
@@ -39,6 +40,12 @@ func (c *client) UploadLogs(ctx context.Context, rl []*logpb.ResourceLogs) error
 	return nil
 }
 
+func (c *client) Shutdown() {
+	if c.shutdown != nil {
+		c.shutdown()
+	}
+}
+
 func newNoopClient() *client {
 	return &client{}
 }
@@ -111,17 +118,30 @@ func newHTTPClient(ctx context.Context, cfg config) (*client, error) {
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
 	c := &httpClient{
-		compression:    cfg.compression.Value,
-		maxRequestSize: cfg.maxRequestSize.Value,
-		req:            req,
-		requestFunc:    cfg.retryCfg.Value.RequestFunc(evaluate),
-		client:         hc,
+		compression:     cfg.compression.Value,
+		maxRequestSize:  cfg.maxRequestSize.Value,
+		dryRun:          cfg.dryRun.Value,
+		dryRunSink:      cfg.dryRunSink.Value,
+		req:             req,
+		requestFunc:     cfg.retryCfg.Value.RequestFunc(evaluate),
+		client:          hc,
+		responseHandler: cfg.responseHandler.Value,
+
+		payloadSizeHandler: cfg.payloadSizeHandler.Value,
+		payloadTransformer: cfg.payloadTransformer.Value,
+	}
+
+	if dir := cfg.persistentQueueDir.Value; dir != "" {
+		c.queue, err = internal.NewPersistentQueue(dir, cfg.persistentQueueMaxBytes.Value, persistable)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	id := nextExporterID()
 	c.inst, err = observ.NewInstrumentation(id, cfg.endpoint.Value)
 
-	return &client{uploadLogs: c.uploadLogs}, err
+	return &client{uploadLogs: c.uploadLogs, shutdown: c.shutdown}, err
 }
 
 type httpClient struct {
@@ -132,6 +152,25 @@ type httpClient struct {
 	requestFunc    retry.RequestFunc
 	client         *http.Client
 
+	// dryRun configures exports to be serialized and written to dryRunSink
+	// instead of being sent.
+	dryRun     bool
+	dryRunSink io.Writer
+
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured.
+	queue *internal.PersistentQueue
+
+	// responseHandler is called with the headers and trailers of the export
+	// responses, if not nil.
+	responseHandler func(header, trailer http.Header)
+	// payloadSizeHandler is called with the sizes of the export payloads, if
+	// not nil.
+	payloadSizeHandler func(uncompressed, compressed int)
+	// payloadTransformer transforms the export payloads and sets the headers
+	// of the export requests, if not nil.
+	payloadTransformer func(payload []byte, header http.Header) ([]byte, error)
+
 	inst *observ.Instrumentation
 }
 
@@ -152,6 +191,13 @@ var ourTransport = &http.Transport{
 	ExpectContinueTimeout: 1 * time.Second,
 }
 
+// shutdown stops the replay of the persisted requests.
+func (c *httpClient) shutdown() {
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
+}
+
 func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs) (uploadErr error) {
 	// The Exporter synchronizes access to client methods. This is not called
 	// after the Exporter is shutdown. Only thing to do here is send data.
@@ -177,103 +223,141 @@ func (c *httpClient) uploadLogs(ctx context.Context, data []*logpb.ResourceLogs)
 	if maxSize := c.maxRequestSize; maxSize > 0 && len(body) > maxSize {
 		return fmt.Errorf("request body too large: exceeded %d bytes", maxSize)
 	}
-	request, err := c.newRequest(ctx, body)
-	if err != nil {
-		return err
+
+	if c.dryRun {
+		return internal.DryRun(c.dryRunSink, body)
 	}
 
-	return errors.Join(uploadErr, c.requestFunc(ctx, func(iCtx context.Context) error {
-		select {
-		case <-iCtx.Done():
-			return iCtx.Err()
-		default:
-		}
+	// newSend returns a function sending a request and setting statusCode to
+	// the status code of its last attempt.
+	newSend := func(statusCode *int) func(context.Context, []byte) error {
+		return func(ctx context.Context, body []byte) error {
+			request, err := c.newRequest(ctx, body)
+			if err != nil {
+				return err
+			}
+			if h := c.payloadSizeHandler; h != nil {
+				h(len(body), int(request.size))
+			}
 
-		statusCode = 0
-		request.reset(iCtx)
-		// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
-		resp, err := c.client.Do(request.Request)
-		var urlErr *url.Error
-		if errors.As(err, &urlErr) && urlErr.Temporary() {
-			return newResponseError(http.Header{}, err)
-		}
-		if err != nil {
-			return err
-		}
-		if resp != nil && resp.Body != nil {
-			defer func() {
-				if err := resp.Body.Close(); err != nil {
-					uploadErr = errors.Join(uploadErr, err)
+			var sendErr error
+			err = c.requestFunc(ctx, func(iCtx context.Context) error {
+				select {
+				case <-iCtx.Done():
+					return iCtx.Err()
+				default:
 				}
-			}()
-		}
 
-		statusCode = resp.StatusCode
-		if sc := resp.StatusCode; sc >= 200 && sc <= 299 {
-			// Success, do not retry.
+				*statusCode = 0
+				request.reset(iCtx)
+				// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
+				resp, err := c.client.Do(request.Request)
+				var urlErr *url.Error
+				if errors.As(err, &urlErr) && urlErr.Temporary() {
+					return newResponseError(http.Header{}, err)
+				}
+				if err != nil {
+					return err
+				}
+				if resp != nil && resp.Body != nil {
+					defer func() {
+						if err := resp.Body.Close(); err != nil {
+							sendErr = errors.Join(sendErr, err)
+						}
+					}()
+				}
 
-			// Read the partial success message, if any.
-			var respData bytes.Buffer
-			if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-				var maxBytesErr *http.MaxBytesError
-				if errors.As(err, &maxBytesErr) {
-					return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+				*statusCode = resp.StatusCode
+				if h := c.responseHandler; h != nil {
+					// The trailers are received once the body is read.
+					defer func() { h(resp.Header, resp.Trailer) }()
 				}
-				return err
-			}
-			if respData.Len() == 0 {
-				return nil
-			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
-				var respProto collogpb.ExportLogsServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
-					return err
+				var respSize int64
+				if c.inst != nil {
+					defer func() { c.inst.RecordPayloadSize(iCtx, request.size, respSize, *statusCode) }()
 				}
 
-				if respProto.PartialSuccess != nil {
-					msg := respProto.PartialSuccess.GetErrorMessage()
-					n := respProto.PartialSuccess.GetRejectedLogRecords()
-					if n != 0 || msg != "" {
-						err := internal.LogPartialSuccessError(n, msg)
-						uploadErr = errors.Join(uploadErr, err)
+				if sc := resp.StatusCode; sc >= 200 && sc <= 299 {
+					// Success, do not retry.
+
+					// Read the partial success message, if any.
+					var respData bytes.Buffer
+					n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+					respSize = n
+					if err != nil {
+						var maxBytesErr *http.MaxBytesError
+						if errors.As(err, &maxBytesErr) {
+							return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+						}
+						return err
+					}
+					if respData.Len() == 0 {
+						return nil
 					}
+
+					if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+						var respProto collogpb.ExportLogsServiceResponse
+						if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+							return err
+						}
+
+						if respProto.PartialSuccess != nil {
+							msg := respProto.PartialSuccess.GetErrorMessage()
+							n := respProto.PartialSuccess.GetRejectedLogRecords()
+							if n != 0 || msg != "" {
+								err := internal.LogPartialSuccessError(n, msg)
+								sendErr = errors.Join(sendErr, err)
+							}
+						}
+					}
+					return nil
 				}
-			}
-			return nil
-		}
-		// Error cases.
-
-		// server may return a message with the response
-		// body, so we read it to include in the error
-		// message to be returned. It will help in
-		// debugging the actual issue.
-		var respData bytes.Buffer
-		if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-			var maxBytesErr *http.MaxBytesError
-			if errors.As(err, &maxBytesErr) {
-				return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
-			}
-			return err
-		}
-		respStr := strings.TrimSpace(respData.String())
-		if respStr == "" {
-			respStr = "(empty)"
-		}
-		bodyErr := fmt.Errorf("body: %s", respStr)
-
-		switch resp.StatusCode {
-		case http.StatusTooManyRequests,
-			http.StatusBadGateway,
-			http.StatusServiceUnavailable,
-			http.StatusGatewayTimeout:
-			// Retryable failure.
-			return newResponseError(resp.Header, bodyErr)
-		default:
-			// Non-retryable failure.
-			return fmt.Errorf("failed to send logs to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+				// Error cases.
+
+				// server may return a message with the response
+				// body, so we read it to include in the error
+				// message to be returned. It will help in
+				// debugging the actual issue.
+				var respData bytes.Buffer
+				n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+				respSize = n
+				if err != nil {
+					var maxBytesErr *http.MaxBytesError
+					if errors.As(err, &maxBytesErr) {
+						return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+					}
+					return err
+				}
+				respStr := strings.TrimSpace(respData.String())
+				if respStr == "" {
+					respStr = "(empty)"
+				}
+				bodyErr := fmt.Errorf("body: %s", respStr)
+
+				switch resp.StatusCode {
+				case http.StatusTooManyRequests,
+					http.StatusBadGateway,
+					http.StatusServiceUnavailable,
+					http.StatusGatewayTimeout:
+					// Retryable failure.
+					return newResponseError(resp.Header, bodyErr)
+				default:
+					// Non-retryable failure.
+					return fmt.Errorf("failed to send logs to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+				}
+			})
+			return errors.Join(sendErr, err)
 		}
-	}))
+	}
+	send := newSend(&statusCode)
+
+	if c.queue != nil {
+		// The persisted requests are replayed after this export returns, with
+		// their own status code.
+		return c.queue.Export(ctx, body, send, newSend(new(int)))
+	}
+	return send(ctx, body)
 }
 
 var gzPool = sync.Pool{
@@ -287,11 +371,10 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
+	payload := body
 	switch c.compression {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
-		req.bodyReader = bodyReader(body)
-		req.GetBody = bodyReaderErr(body)
 	case GzipCompression:
 		// Ensure the content length is not used.
 		r.ContentLength = -1
@@ -313,11 +396,23 @@ func (c *httpClient) newRequest(ctx context.Context, body []byte) (request, erro
 		if err := gz.Close(); err != nil {
 			return req, err
 		}
+		payload = b.Bytes()
+	}
 
-		req.bodyReader = bodyReader(b.Bytes())
-		req.GetBody = bodyReaderErr(b.Bytes())
+	if t := c.payloadTransformer; t != nil {
+		var err error
+		payload, err = t(payload, r.Header)
+		if err != nil {
+			return req, fmt.Errorf("payload transformer: %w", err)
+		}
+		if r.ContentLength >= 0 {
+			r.ContentLength = int64(len(payload))
+		}
 	}
 
+	req.bodyReader = bodyReader(payload)
+	req.GetBody = bodyReaderErr(payload)
+	req.size = int64(len(payload))
 	return req, nil
 }
 
@@ -341,6 +436,8 @@ type request struct {
 
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
+	// size is the size of the body, after any compression, in bytes.
+	size int64
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
@@ -427,3 +524,11 @@ func evaluate(err error) (bool, time.Duration) {
 
 	return true, rErr.throttle
 }
+
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	var rErr retryableError
+	var urlErr *url.Error
+	return errors.As(err, &rErr) || errors.As(err, &urlErr)
+}
@@ -22,6 +22,8 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -48,6 +50,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -1075,13 +1078,43 @@ func TestClientInstrumentation(t *testing.T) {
 					Temporality: 0x1,
 				},
 			},
+			{
+				Name:        httpconv.ClientRequestBodySize{}.Name(),
+				Description: httpconv.ClientRequestBodySize{}.Description(),
+				Unit:        httpconv.ClientRequestBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							baseAttrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(200),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
+			{
+				Name:        httpconv.ClientResponseBodySize{}.Name(),
+				Description: httpconv.ClientResponseBodySize{}.Description(),
+				Unit:        httpconv.ClientResponseBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							baseAttrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(200),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
 		},
 	}
 
 	require.Len(t, got.ScopeMetrics, 1)
 
 	gotMetrics := got.ScopeMetrics[0].Metrics
-	require.Len(t, gotMetrics, 3, "expected 3 metrics")
+	require.Len(t, gotMetrics, 5, "expected 5 metrics")
 
 	// Assert counters without IgnoreValue
 	optCounters := []metricdatatest.Option{
@@ -1097,7 +1130,9 @@ func TestClientInstrumentation(t *testing.T) {
 		metricdatatest.IgnoreExemplars(),
 		metricdatatest.IgnoreValue(),
 	}
-	metricdatatest.AssertEqual(t, want.Metrics[2], gotMetrics[2], optDuration...)
+	for i := 2; i < len(want.Metrics); i++ {
+		metricdatatest.AssertEqual(t, want.Metrics[i], gotMetrics[i], optDuration...)
+	}
 }
 
 func TestResponseBodySizeLimit(t *testing.T) {
@@ -1322,8 +1357,273 @@ func TestWithEndpointURLNoPathUsesRootPath(t *testing.T) {
 	assert.Equal(t, "/", got, "a pathless endpoint URL must target the root path, not the default logs path")
 }
 
+func TestPayloadSizeHandler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	type sizes struct{ uncompressed, compressed int }
+	export := func(c Compression) []sizes {
+		var got []sizes
+		cfg := newConfig([]Option{
+			WithEndpointURL(srv.URL),
+			WithCompression(c),
+			WithPayloadSizeHandler(func(uncompressed, compressed int) {
+				got = append(got, sizes{uncompressed, compressed})
+			}),
+		})
+		client, err := newHTTPClient(t.Context(), cfg)
+		require.NoError(t, err)
+		require.NoError(t, client.uploadLogs(t.Context(), resourceLogs))
+		return got
+	}
+
+	got := export(NoCompression)
+	require.Len(t, got, 1)
+	assert.Positive(t, got[0].uncompressed)
+	assert.Equal(t, got[0].uncompressed, got[0].compressed, "not compressed")
+
+	uncompressed := got[0].uncompressed
+	got = export(GzipCompression)
+	require.Len(t, got, 1)
+	assert.Equal(t, uncompressed, got[0].uncompressed)
+	assert.Positive(t, got[0].compressed)
+	assert.NotEqual(t, got[0].uncompressed, got[0].compressed, "compressed")
+}
+
+func TestPayloadTransformer(t *testing.T) {
+	xor := func(b []byte) []byte {
+		out := make([]byte, len(b))
+		for i, c := range b {
+			out[i] = c ^ 0xff
+		}
+		return out
+	}
+
+	var records int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) || !assert.Equal(t, "xor", r.Header.Get("X-Payload-Transform")) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = xor(body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(bytes.NewReader(body))
+			require.NoError(t, err)
+			body, err = io.ReadAll(gz)
+			require.NoError(t, err)
+		}
+		var req collogpb.ExportLogsServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		records += len(req.ResourceLogs[0].ScopeLogs[0].LogRecords)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	transformErr := errors.New("transform")
+	export := func(c Compression, fail bool) error {
+		cfg := newConfig([]Option{
+			WithEndpointURL(srv.URL),
+			WithCompression(c),
+			WithPayloadTransformer(func(payload []byte, header http.Header) ([]byte, error) {
+				if fail {
+					return nil, transformErr
+				}
+				header.Set("X-Payload-Transform", "xor")
+				return xor(payload), nil
+			}),
+		})
+		client, err := newHTTPClient(t.Context(), cfg)
+		require.NoError(t, err)
+		return client.uploadLogs(t.Context(), resourceLogs)
+	}
+
+	require.NoError(t, export(NoCompression, false))
+	require.NoError(t, export(GzipCompression, false))
+	n := len(resourceLogs[0].ScopeLogs[0].LogRecords)
+	assert.Equal(t, 2*n, records)
+
+	assert.ErrorIs(t, export(NoCompression, true), transformErr)
+	assert.Equal(t, 2*n, records, "not sent")
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := newConfig([]Option{WithInsecure(), WithDryRun(&buf)})
+	client, err := newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, client.uploadLogs(t.Context(), resourceLogs))
+
+	var req collogpb.ExportLogsServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	want := &collogpb.ExportLogsServiceRequest{ResourceLogs: resourceLogs}
+	assert.True(t, proto.Equal(want, &req), "serialized request")
+}
+
+func TestPersistentQueue(t *testing.T) {
+	unavailable := exportResult{Err: &httpResponseError{
+		Err:    errors.New("unavailable"),
+		Status: http.StatusServiceUnavailable,
+	}}
+	rCh := make(chan exportResult, 5)
+	rCh <- unavailable
+	rCh <- unavailable
+	for range 3 {
+		rCh <- exportResult{}
+	}
+	coll, err := newHTTPCollector("", rCh)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, coll.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err := newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+
+	ctx := t.Context()
+	upload := func(schemaURL string) error {
+		rl := []*lpb.ResourceLogs{{Resource: res, ScopeLogs: scopeLogs, SchemaUrl: schemaURL}}
+		return client.UploadLogs(ctx, rl)
+	}
+
+	// The first request fails and is persisted. The second request is
+	// persisted after the first one fails again.
+	require.NoError(t, upload("1"))
+	require.NoError(t, upload("2"))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+	var failed int
+	require.Eventually(t, func() bool {
+		failed += len(coll.Collect().Dump())
+		return failed == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, upload("3"))
+	var got []string
+	assert.Eventually(t, func() bool {
+		for _, rl := range coll.Collect().Dump() {
+			got = append(got, rl.SchemaUrl)
+		}
+		return len(got) >= 3
+	}, 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Equal(t, []string{"1", "2", "3"}, got, "replay order")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueEndpointDown(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{
+		WithEndpoint(endpoint),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err := newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, client.UploadLogs(t.Context(), resourceLogs))
+
+	// A new client using the same directory sends the persisted request.
+	coll, err := newHTTPCollector("", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, coll.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	cfg = newConfig([]Option{
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err = newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, client.UploadLogs(t.Context(), resourceLogs))
+	var received int
+	assert.Eventually(t, func() bool {
+		received += len(coll.Collect().Dump())
+		return received == 2
+	}, 5*time.Second, 10*time.Millisecond, "persisted request not sent")
+}
+
+func TestPersistentQueueRejected(t *testing.T) {
+	rCh := make(chan exportResult, 1)
+	rCh <- exportResult{Err: &httpResponseError{
+		Err:    errors.New("invalid"),
+		Status: http.StatusBadRequest,
+	}}
+	coll, err := newHTTPCollector("", rCh)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, coll.Shutdown(context.Background())) }) //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+
+	dir := t.TempDir()
+	cfg := newConfig([]Option{
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithPersistentQueue(dir, 0),
+	})
+	client, err := newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+
+	assert.Error(t, client.UploadLogs(t.Context(), resourceLogs))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "rejected request persisted")
+}
+
+func TestResponseHeaderHandler(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(10-requests))
+		w.Header().Set("Trailer", "Server-Version")
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		w.Header().Set("Server-Version", "1.2.3")
+	}))
+	t.Cleanup(srv.Close)
+
+	type response struct{ header, trailer http.Header }
+	var got []response
+	cfg := newConfig([]Option{
+		WithEndpointURL(srv.URL),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		WithResponseHeaderHandler(func(header, trailer http.Header) {
+			got = append(got, response{header, trailer})
+		}),
+	})
+	client, err := newHTTPClient(t.Context(), cfg)
+	require.NoError(t, err)
+	require.NoError(t, client.uploadLogs(t.Context(), resourceLogs))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for i, r := range got {
+		assert.Equal(t, strconv.Itoa(9-i), r.header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1.2.3", r.trailer.Get("Server-Version"))
+	}
+}
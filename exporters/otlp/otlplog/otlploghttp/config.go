@@ -8,6 +8,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"os"
@@ -97,10 +98,29 @@ type config struct {
 	headers        setting[map[string]string]
 	compression    setting[Compression]
 	maxRequestSize setting[int]
+	dryRun         setting[bool]
+	dryRunSink     setting[io.Writer]
 	timeout        setting[time.Duration]
 	proxy          setting[HTTPTransportProxyFunc]
 	retryCfg       setting[retry.Config]
 	httpClient     *http.Client
+
+	// persistentQueueDir is the directory export requests that failed
+	// because the endpoint was unavailable are persisted to, if not empty.
+	persistentQueueDir      setting[string]
+	persistentQueueMaxBytes setting[int64]
+
+	// responseHandler is called with the headers and trailers of the export
+	// responses, if set.
+	responseHandler setting[func(header, trailer http.Header)]
+
+	// payloadSizeHandler is called with the sizes of the export payloads, if
+	// set.
+	payloadSizeHandler setting[func(uncompressed, compressed int)]
+
+	// payloadTransformer transforms the export payloads and sets the headers
+	// of the export requests, if set.
+	payloadTransformer setting[func(payload []byte, header http.Header) ([]byte, error)]
 }
 
 func newConfig(options []Option) config {
@@ -331,10 +351,110 @@ func WithMaxRequestSize(size int) Option {
 	})
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return fnOpt(func(c config) config {
+		c.dryRun = newSetting(true)
+		c.dryRunSink = newSetting(sink)
+		return c
+	})
+}
+
 // RetryConfig defines configuration for retrying the export of log data that
 // failed.
 type RetryConfig retry.Config
 
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new log records are persisted without being sent so that the
+// order is kept.
+//
+// Log records are delivered at least once: a persisted request is only
+// removed once it has been accepted by the endpoint, so a request can be sent
+// again if the process stops after it was sent but before it was removed.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return fnOpt(func(c config) config {
+		c.persistentQueueDir = newSetting(dir)
+		c.persistentQueueMaxBytes = newSetting(maxBytes)
+		return c
+	})
+}
+
+// WithResponseHeaderHandler sets a function called with the headers and
+// trailers of the HTTP response to each export request, including the
+// requests that failed or are retried. Use it to read the headers set by the
+// endpoint, e.g. to slow down the exports when a rate limit header reports
+// few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseHeaderHandler(h func(header, trailer http.Header)) Option {
+	return fnOpt(func(c config) config {
+		c.responseHandler = newSetting(h)
+		return c
+	})
+}
+
+// WithPayloadSizeHandler sets a function called with the size in bytes of the
+// protobuf encoded payload of each export request, before and after its
+// compression. compressed equals uncompressed if the payload is not
+// compressed. Use it to choose the compression and the size of the batches
+// based on the actual payloads.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry, and must not block.
+func WithPayloadSizeHandler(h func(uncompressed, compressed int)) Option {
+	return fnOpt(func(c config) config {
+		c.payloadSizeHandler = newSetting(h)
+		return c
+	})
+}
+
+// WithPayloadTransformer sets a function transforming the payload of each
+// export request before it is sent, e.g. to encrypt or sign it for the
+// endpoints requiring an application-layer encryption. The payload is the
+// protobuf encoded request, once compressed if a compression is configured.
+// The function can set the headers of the request in header, e.g. the key ID
+// of an envelope encryption, and returns the payload to send or an error
+// failing the export without retrying it.
+//
+// The Content-Type and Content-Encoding headers describe the payload before
+// its transformation and can be modified by the function. The size reported
+// to the handler of WithPayloadSizeHandler is the size of the transformed
+// payload.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry. It must not retain or modify payload.
+func WithPayloadTransformer(f func(payload []byte, header http.Header) ([]byte, error)) Option {
+	return fnOpt(func(c config) config {
+		c.payloadTransformer = newSetting(f)
+		return c
+	})
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
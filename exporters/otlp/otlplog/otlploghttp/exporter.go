@@ -63,7 +63,7 @@ func (e *Exporter) Shutdown(context.Context) error {
 		return nil
 	}
 
-	e.client.Store(newNoopClient())
+	e.client.Swap(newNoopClient()).Shutdown()
 	return nil
 }
 
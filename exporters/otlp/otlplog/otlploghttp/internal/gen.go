@@ -5,6 +5,12 @@
 // package.
 package internal
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun.go.tmpl "--data={}" --out=dryrun.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun_test.go.tmpl "--data={}" --out=dryrun_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue.go.tmpl "--data={}" --out=persistentqueue.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue_test.go.tmpl "--data={}" --out=persistentqueue_test.go
+
 //go:generate  gotmpl --body=../../../../../internal/shared/x/x.go.tmpl "--data={ \"pkg\": \"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp\" }"  --out=x/x.go
 //go:generate gotmpl --body=../../../../../internal/shared/x/x_test.go.tmpl "--data={}" --out=x/x_test.go
 
@@ -14,4 +20,5 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/attr_test.go.tmpl "--data={}" --out=transform/attr_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=transform/log.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=transform/log_attr_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_fuzz_test.go.tmpl "--data={}" --out=transform/log_fuzz_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=transform/log_test.go
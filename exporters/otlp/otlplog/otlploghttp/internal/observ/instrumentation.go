@@ -22,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -43,6 +44,7 @@ var (
 				1 + // server.addr
 				1 + // server.port
 				1 + // error.port
+				1 + // http.request.method
 				1 // http.response.status.code
 			s := make([]attribute.KeyValue, 0, n)
 			return &s
@@ -85,10 +87,13 @@ type Instrumentation struct {
 	inflightMetric    metric.Int64UpDownCounter
 	exportedMetric    metric.Int64Counter
 	operationDuration metric.Float64Histogram
+	reqBodySize       metric.Int64Histogram
+	respBodySize      metric.Int64Histogram
 
 	presetAttrs []attribute.KeyValue
 	addOpt      metric.AddOption
 	recordOpt   metric.RecordOption
+	payloadOpt  metric.RecordOption
 }
 
 // NewInstrumentation returns instrumentation for otlplog http exporter.
@@ -128,6 +133,20 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 	}
 	inst.operationDuration = operation.Inst()
 
+	reqBodySize, e := httpconv.NewClientRequestBodySize(m)
+	if e != nil {
+		e = fmt.Errorf("failed to create the request body size metric %w", e)
+		err = errors.Join(err, e)
+	}
+	inst.reqBodySize = reqBodySize.Inst()
+
+	respBodySize, e := httpconv.NewClientResponseBodySize(m)
+	if e != nil {
+		e = fmt.Errorf("failed to create the response body size metric %w", e)
+		err = errors.Join(err, e)
+	}
+	inst.respBodySize = respBodySize.Inst()
+
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +158,13 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 		[]attribute.KeyValue{semconv.HTTPResponseStatusCode(http.StatusOK)},
 		inst.presetAttrs...,
 	)...))
+	inst.payloadOpt = metric.WithAttributeSet(attribute.NewSet(append(
+		[]attribute.KeyValue{
+			semconv.HTTPRequestMethodPost,
+			semconv.HTTPResponseStatusCode(http.StatusOK),
+		},
+		inst.presetAttrs...,
+	)...))
 
 	return inst, nil
 }
@@ -276,6 +302,48 @@ func (e ExportOp) recordOption(err error, code int) metric.RecordOption {
 	return metric.WithAttributeSet(attribute.NewSet(*attrs...))
 }
 
+// RecordPayloadSize records the size of the request body sent and the
+// response body received by a single HTTP request made by the exporter.
+//
+// The request size is the size of the encoded, and if applicable compressed,
+// body sent. The code is the HTTP status code of the response, or 0 if no
+// response was received. The response size is only recorded if a response
+// was received.
+func (i *Instrumentation) RecordPayloadSize(ctx context.Context, request, response int64, code int) {
+	reqEnabled := i.reqBodySize.Enabled(ctx)
+	respEnabled := code != 0 && i.respBodySize.Enabled(ctx)
+	if !reqEnabled && !respEnabled {
+		return
+	}
+
+	record := get[metric.RecordOption](recordPool)
+	defer put(recordPool, record)
+	*record = append(*record, i.payloadOption(code))
+
+	if reqEnabled {
+		i.reqBodySize.Record(ctx, request, *record...)
+	}
+	if respEnabled {
+		i.respBodySize.Record(ctx, response, *record...)
+	}
+}
+
+func (i *Instrumentation) payloadOption(code int) metric.RecordOption {
+	if code == http.StatusOK {
+		return i.payloadOpt
+	}
+
+	attrs := get[attribute.KeyValue](attrsPool)
+	defer put(attrsPool, attrs)
+
+	*attrs = append(*attrs, i.presetAttrs...)
+	*attrs = append(*attrs, semconv.HTTPRequestMethodPost)
+	if code != 0 {
+		*attrs = append(*attrs, semconv.HTTPResponseStatusCode(code))
+	}
+	return metric.WithAttributeSet(attribute.NewSet(*attrs...))
+}
+
 // successful returns the number of successfully exported logs out of the n
 // that were exported based on the provided error.
 //
@@ -0,0 +1,10 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlplog/transform/attr_test.go.tmpl "--data={}" --out=attr_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlplog/transform/log.go.tmpl "--data={}" --out=log.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlplog/transform/log_attr_test.go.tmpl "--data={}" --out=log_attr_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlplog/transform/log_fuzz_test.go.tmpl "--data={}" --out=log_fuzz_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlplog/transform/log_test.go.tmpl "--data={}" --out=log_test.go
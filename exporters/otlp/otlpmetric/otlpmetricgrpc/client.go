@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"time"
 
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
@@ -19,6 +20,7 @@ import (
 	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/retry"
 )
@@ -29,6 +31,19 @@ type client struct {
 	maxRequestSize int
 	requestFunc    retry.RequestFunc
 
+	// dryRun configures exports to be serialized and written to dryRunSink
+	// instead of being sent.
+	dryRun     bool
+	dryRunSink io.Writer
+
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured.
+	queue *internal.PersistentQueue
+
+	// responseHandler is called with the metadata of the export responses,
+	// if not nil.
+	responseHandler func(header, trailer map[string][]string)
+
 	// ourConn keeps track of where conn was created: true if created here in
 	// NewClient, or false if passed with an option. This is important on
 	// Shutdown as the conn should only be closed if we created it. Otherwise,
@@ -36,21 +51,38 @@ type client struct {
 	ourConn bool
 	conn    *grpc.ClientConn
 	msc     colmetricpb.MetricsServiceClient
+
+	debug debugState
+
+	// inst records the size of the requests and responses, if not nil. It is
+	// set by the Exporter using the client.
+	inst *observ.Instrumentation
 }
 
 // newClient creates a new gRPC metric client.
 func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 	c := &client{
-		exportTimeout:  cfg.Metrics.Timeout,
-		maxRequestSize: cfg.Metrics.MaxRequestSize,
-		requestFunc:    cfg.RetryConfig.RequestFunc(retryable),
-		conn:           cfg.GRPCConn,
+		exportTimeout:   cfg.Metrics.Timeout,
+		maxRequestSize:  cfg.Metrics.MaxRequestSize,
+		dryRun:          cfg.Metrics.DryRun,
+		dryRunSink:      cfg.Metrics.DryRunSink,
+		requestFunc:     cfg.RetryConfig.RequestFunc(retryable),
+		conn:            cfg.GRPCConn,
+		responseHandler: cfg.Metrics.ResponseHandler,
 	}
 
 	if len(cfg.Metrics.Headers) > 0 {
 		c.metadata = metadata.New(cfg.Metrics.Headers)
 	}
 
+	if cfg.Metrics.PersistentQueueDir != "" {
+		q, err := internal.NewPersistentQueue(cfg.Metrics.PersistentQueueDir, cfg.Metrics.PersistentQueueMaxBytes, persistable)
+		if err != nil {
+			return nil, err
+		}
+		c.queue = q
+	}
+
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
 		// created, create one using the configuration they did provide.
@@ -70,6 +102,20 @@ func newClient(_ context.Context, cfg oconf.Config) (*client, error) {
 
 	c.msc = colmetricpb.NewMetricsServiceClient(c.conn)
 
+	c.debug = debugState{
+		conn:     c.conn,
+		attempts: internal.NewAttemptLog[ExportAttempt](debugAttempts),
+	}
+	switch {
+	case !c.ourConn:
+		// The credentials of a connection passed with WithGRPCConn are
+		// unknown.
+	case cfg.Metrics.GRPCCredentials != nil:
+		c.debug.securityProtocol = cfg.Metrics.GRPCCredentials.Info().SecurityProtocol
+	default:
+		c.debug.securityProtocol = "insecure"
+	}
+
 	return c, nil
 }
 
@@ -84,6 +130,12 @@ func (c *client) Shutdown(ctx context.Context) error {
 	// ensures this is called only once. The only thing that needs to be done
 	// here is to release any computational resources the client holds.
 
+	// Stop the replay of the persisted requests before the client is
+	// released.
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
+
 	c.metadata = nil
 	c.requestFunc = nil
 	c.msc = nil
@@ -104,7 +156,7 @@ func (c *client) Shutdown(ctx context.Context) error {
 //
 // Retryable errors from the server will be handled according to any
 // RetryConfig the client was created with.
-func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) (uploadErr error) {
+func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.ResourceMetrics) error {
 	// The otlpmetric.Exporter synchronizes access to client methods, and
 	// ensures this is not called after the Exporter is shutdown. Only thing
 	// to do here is send data.
@@ -126,23 +178,75 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 		return fmt.Errorf("request message too large: exceeded %d bytes", maxSize)
 	}
 
-	return errors.Join(uploadErr, c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.msc.Export(iCtx, pbRequest)
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedDataPoints()
-			if n != 0 || msg != "" {
-				e := internal.MetricPartialSuccessError(n, msg)
-				uploadErr = errors.Join(uploadErr, e)
+	if c.dryRun {
+		return internal.DryRunMessage(c.dryRunSink, pbRequest)
+	}
+
+	send := func(ctx context.Context, pbRequest *colmetricpb.ExportMetricsServiceRequest) error {
+		var sendErr error
+		// reqSize is the size of the request recorded by inst, or -1 if the
+		// sizes are not recorded.
+		reqSize := int64(-1)
+		if c.inst != nil && c.inst.PayloadSizeEnabled(ctx) {
+			reqSize = int64(proto.Size(pbRequest))
+		}
+		err := c.requestFunc(ctx, func(iCtx context.Context) error {
+			var header, trailer metadata.MD
+			var callOpts []grpc.CallOption
+			if c.responseHandler != nil {
+				callOpts = []grpc.CallOption{grpc.Header(&header), grpc.Trailer(&trailer)}
+			}
+			start := time.Now()
+			resp, err := c.msc.Export(iCtx, pbRequest, callOpts...)
+			c.debug.attempts.Add(ExportAttempt{
+				Time:     start,
+				Duration: time.Since(start),
+				Code:     status.Code(err),
+				Err:      err,
+			})
+			if reqSize >= 0 {
+				respSize := int64(-1)
+				if resp != nil {
+					respSize = int64(proto.Size(resp))
+				}
+				c.inst.RecordPayloadSize(iCtx, reqSize, respSize, status.Code(err))
+			}
+			if c.responseHandler != nil {
+				c.responseHandler(header, trailer)
+			}
+			if resp != nil && resp.PartialSuccess != nil {
+				msg := resp.PartialSuccess.GetErrorMessage()
+				n := resp.PartialSuccess.GetRejectedDataPoints()
+				if n != 0 || msg != "" {
+					e := internal.MetricPartialSuccessError(n, msg)
+					sendErr = errors.Join(sendErr, e)
+				}
+			}
+			// nil is converted to OK.
+			if status.Code(err) == codes.OK {
+				// Success.
+				return nil
 			}
+			return err
+		})
+		return errors.Join(sendErr, err)
+	}
+
+	if c.queue != nil {
+		rawRequest, err := proto.Marshal(pbRequest)
+		if err != nil {
+			return err
 		}
-		// nil is converted to OK.
-		if status.Code(err) == codes.OK {
-			// Success.
-			return nil
+		sendRaw := func(ctx context.Context, b []byte) error {
+			req := new(colmetricpb.ExportMetricsServiceRequest)
+			if err := proto.Unmarshal(b, req); err != nil {
+				return err
+			}
+			return send(ctx, req)
 		}
-		return err
-	}))
+		return c.queue.Export(ctx, rawRequest, sendRaw, sendRaw)
+	}
+	return send(ctx, pbRequest)
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -182,6 +286,13 @@ func retryable(err error) (bool, time.Duration) {
 	return retryableGRPCStatus(s)
 }
 
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	ok, _ := retryable(err)
+	return ok
+}
+
 func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	switch s.Code() {
 	case codes.Canceled,
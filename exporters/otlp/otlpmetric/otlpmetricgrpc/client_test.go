@@ -4,21 +4,28 @@
 package otlpmetricgrpc
 
 import (
+	"bytes"
 	"context"
+	"net"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/otest"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
@@ -269,3 +276,170 @@ func TestConfig(t *testing.T) {
 		assert.Empty(t, coll.Collect().Dump(), "oversized request must fail before sending")
 	})
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(t.Context(), WithInsecure(), WithDryRun(&buf))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	rm := &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "scope"},
+		}},
+	}
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	var req colmetricpb.ExportMetricsServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	require.Len(t, req.ResourceMetrics, 1)
+	require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+	assert.Equal(t, "scope", req.ResourceMetrics[0].ScopeMetrics[0].Scope.Name)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	unavailable := otest.ExportResult{Err: status.Error(codes.Unavailable, "unavailable")}
+	rCh := make(chan otest.ExportResult, 5)
+	rCh <- unavailable
+	rCh <- unavailable
+	for range 3 {
+		rCh <- otest.ExportResult{}
+	}
+	coll, err := otest.NewGRPCCollector("", rCh)
+	require.NoError(t, err)
+	t.Cleanup(coll.Shutdown)
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	// The first request fails and is persisted. The second request is
+	// persisted, without being sent, after the first one fails again.
+	var received int
+	collected := func(n int) func() bool {
+		return func() bool {
+			received += len(coll.Collect().Dump())
+			return received == n
+		}
+	}
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.Eventually(t, collected(2), 5*time.Second, 10*time.Millisecond)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	assert.Eventually(t, collected(5), 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueInvalidDir(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	_, err := New(t.Context(), WithInsecure(), WithPersistentQueue(file, 0))
+	assert.ErrorContains(t, err, "persistent queue")
+}
+
+// metadataMetricsService responds to the export requests with metadata.
+type metadataMetricsService struct {
+	colmetricpb.UnimplementedMetricsServiceServer
+
+	requests int
+}
+
+func (s *metadataMetricsService) Export(
+	ctx context.Context,
+	_ *colmetricpb.ExportMetricsServiceRequest,
+) (*colmetricpb.ExportMetricsServiceResponse, error) {
+	s.requests++
+	if err := grpc.SetHeader(ctx, metadata.Pairs("x-ratelimit-remaining", "10")); err != nil {
+		return nil, err
+	}
+	if err := grpc.SetTrailer(ctx, metadata.Pairs("server-version", "1.2.3")); err != nil {
+		return nil, err
+	}
+	if s.requests == 1 {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+	return &colmetricpb.ExportMetricsServiceResponse{}, nil
+}
+
+func TestResponseMetadataHandler(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	colmetricpb.RegisterMetricsServiceServer(srv, &metadataMetricsService{})
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	type response struct{ header, trailer metadata.MD }
+	var got []response
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		WithResponseMetadataHandler(func(header, trailer metadata.MD) {
+			got = append(got, response{header, trailer})
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for _, r := range got {
+		assert.Equal(t, []string{"10"}, r.header.Get("x-ratelimit-remaining"))
+		assert.Equal(t, []string{"1.2.3"}, r.trailer.Get("server-version"))
+	}
+}
+
+func TestExporterDebugInfo(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	colmetricpb.RegisterMetricsServiceServer(srv, &metadataMetricsService{})
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpoint(ln.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+	)
+	require.NoError(t, err)
+	info := exp.DebugInfo()
+	assert.Contains(t, info.Endpoint, ln.Addr().String())
+	assert.Equal(t, "insecure", info.SecurityProtocol)
+	assert.Empty(t, info.Attempts)
+
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	info = exp.DebugInfo()
+	assert.Equal(t, "READY", info.ConnectivityState)
+	require.Len(t, info.Attempts, 2)
+	assert.Equal(t, codes.Unavailable, info.Attempts[0].Code)
+	assert.Error(t, info.Attempts[0].Err)
+	assert.Equal(t, codes.OK, info.Attempts[1].Code)
+	assert.NoError(t, info.Attempts[1].Err)
+
+	require.NoError(t, exp.Shutdown(ctx))
+	info = exp.DebugInfo()
+	assert.Equal(t, "SHUTDOWN", info.ConnectivityState)
+	assert.Len(t, info.Attempts, 2, "kept after shutdown")
+}
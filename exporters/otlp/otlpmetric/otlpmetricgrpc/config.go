@@ -5,10 +5,12 @@ package otlpmetricgrpc
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
@@ -241,6 +243,72 @@ func WithMaxRequestSize(size int) Option {
 	return wrappedOption{oconf.WithMaxRequestSize(size)}
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return wrappedOption{oconf.WithDryRun(sink)}
+}
+
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new metrics are persisted without being sent so that the order
+// is kept.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return wrappedOption{oconf.WithPersistentQueue(dir, maxBytes)}
+}
+
+// WithResponseMetadataHandler sets a function called with the header and
+// trailer metadata of the response to each export request, including the
+// requests that failed or are retried. Use it to read the metadata set by
+// the endpoint, e.g. to slow down the exports when a rate limit header
+// reports few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseMetadataHandler(h func(header, trailer metadata.MD)) Option {
+	if h == nil {
+		return wrappedOption{oconf.WithResponseHandler(nil)}
+	}
+	return wrappedOption{oconf.WithResponseHandler(func(header, trailer map[string][]string) {
+		h(header, trailer)
+	})}
+}
+
+// WithoutPayloadSizeMetrics disables the experimental rpc.client.request.size
+// and rpc.client.response.size self-observability metrics recording the size
+// of the protobuf encoded requests sent and responses received by the
+// exporter. Computing these sizes has a cost proportional to the size of the
+// requests, use this option to avoid it while keeping the other
+// self-observability metrics.
+func WithoutPayloadSizeMetrics() Option {
+	return wrappedOption{oconf.NewGRPCOption(func(cfg oconf.Config) oconf.Config {
+		cfg.NoPayloadSizeMetrics = true
+		return cfg
+	})}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -259,6 +327,38 @@ func WithRetry(settings RetryConfig) Option {
 	return wrappedOption{oconf.WithRetry(retry.Config(settings))}
 }
 
+// ScopeOrder describes the order the scopes of the metric data are batched
+// into export requests when the requests are split by scope, see
+// WithScopeBatching.
+type ScopeOrder oconf.ScopeOrder
+
+const (
+	// ScopeOrderCollected batches consecutive scopes, in the order they are
+	// collected, into the same request.
+	ScopeOrderCollected = ScopeOrder(oconf.ScopeOrderCollected)
+	// ScopeOrderName batches consecutive scopes, sorted by name and version,
+	// into the same request.
+	ScopeOrderName = ScopeOrder(oconf.ScopeOrderName)
+	// ScopeOrderInterleaved distributes the scopes, from the one with the most
+	// data points to the one with the least, across the requests in turn so
+	// the requests have a similar size.
+	ScopeOrderInterleaved = ScopeOrder(oconf.ScopeOrderInterleaved)
+)
+
+// WithScopeBatching splits the export of the metric data of a collection
+// into requests containing at most scopesPerRequest scopes each, batched in
+// order. The requests are sent one after the other, and the exporter is not
+// locked in between, so the payloads of a MeterProvider with many scopes are
+// kept small and a collector limiting the size of the requests does not
+// reject the whole collection. The failure of a request does not prevent the
+// others from being sent.
+//
+// If scopesPerRequest is less than or equal to zero, the metric data of a
+// collection is exported in a single request. This is the default.
+func WithScopeBatching(scopesPerRequest int, order ScopeOrder) Option {
+	return wrappedOption{oconf.WithScopeBatching(scopesPerRequest, oconf.ScopeOrder(order))}
+}
+
 // WithTemporalitySelector sets the TemporalitySelector the client will use to
 // determine the Temporality of an instrument based on its kind. If this option
 // is not used, the client will use the DefaultTemporalitySelector from the
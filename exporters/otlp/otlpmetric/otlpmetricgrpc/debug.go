@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetricgrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
+)
+
+// debugAttempts is the number of export attempts held for DebugInfo.
+const debugAttempts = 10
+
+// DebugInfo describes the state of an Exporter. It is meant to be included
+// in the bug reports and support bundles about telemetry not being received.
+type DebugInfo struct {
+	// Endpoint is the target of the gRPC connection of the Exporter.
+	Endpoint string
+	// ConnectivityState is the state of the gRPC connection, e.g. "READY"
+	// or "TRANSIENT_FAILURE".
+	ConnectivityState string
+	// SecurityProtocol is the security protocol of the credentials of the
+	// gRPC connection, e.g. "tls" or "insecure". It is empty if unknown, when
+	// the connection is passed with WithGRPCConn.
+	SecurityProtocol string
+	// Attempts are the last 10 export attempts of the Exporter, oldest
+	// first. Each retry of an export request is an attempt.
+	Attempts []ExportAttempt
+}
+
+// ExportAttempt is an attempt to send an export request.
+type ExportAttempt struct {
+	// Time is the time the attempt started.
+	Time time.Time
+	// Duration is the duration of the attempt.
+	Duration time.Duration
+	// Code is the status code of the attempt, codes.OK if it succeeded.
+	Code codes.Code
+	// Err is the error of the attempt, nil if it succeeded.
+	Err error
+}
+
+// debugState is the state of a client reported by DebugInfo. It is not
+// cleared when the client shuts down.
+type debugState struct {
+	conn             *grpc.ClientConn
+	securityProtocol string
+	attempts         *internal.AttemptLog[ExportAttempt]
+}
+
+// info returns the DebugInfo of s.
+func (s *debugState) info() DebugInfo {
+	return DebugInfo{
+		Endpoint:          s.conn.CanonicalTarget(),
+		ConnectivityState: s.conn.GetState().String(),
+		SecurityProtocol:  s.securityProtocol,
+		Attempts:          s.attempts.Attempts(),
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/counter"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf"
@@ -33,8 +34,16 @@ type Exporter struct {
 	temporalitySelector metric.TemporalitySelector
 	aggregationSelector metric.AggregationSelector
 
+	// scopesPerRequest and scopeOrder configure the split of the exported
+	// metric data into requests by scope.
+	scopesPerRequest int
+	scopeOrder       oconf.ScopeOrder
+
 	shutdownOnce sync.Once
 
+	// debug is the state of the client reported by DebugInfo.
+	debug *debugState
+
 	// Self-observability metrics
 	inst *observ.Instrumentation
 }
@@ -63,14 +72,21 @@ func newExporter(c *client, cfg oconf.Config) (*Exporter, error) {
 		if err != nil {
 			initErr = err
 		}
+		if !cfg.NoPayloadSizeMetrics {
+			c.inst = inst
+		}
 	}
 
 	return &Exporter{
 		client: c,
+		debug:  &c.debug,
 
 		temporalitySelector: ts,
 		aggregationSelector: as,
 
+		scopesPerRequest: cfg.Metrics.ScopesPerRequest,
+		scopeOrder:       cfg.Metrics.ScopeOrder,
+
 		inst: inst,
 	}, initErr
 }
@@ -101,9 +117,7 @@ func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) e
 	defer func() { op.End(upErr) }()
 
 	// Best effort upload of transformable metrics.
-	e.clientMu.Lock()
-	upErr = e.client.UploadMetrics(ctx, otlpRm)
-	e.clientMu.Unlock()
+	upErr = e.upload(ctx, otlpRm)
 
 	if upErr != nil {
 		if err == nil {
@@ -115,6 +129,20 @@ func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) e
 	return err
 }
 
+// upload uploads rm, split into requests by scope if configured.
+func (e *Exporter) upload(ctx context.Context, rm *metricpb.ResourceMetrics) error {
+	var errs []error
+	for _, req := range internal.SplitScopes(rm, e.scopesPerRequest, e.scopeOrder) {
+		e.clientMu.Lock()
+		err := e.client.UploadMetrics(ctx, req)
+		e.clientMu.Unlock()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ForceFlush flushes any metric data held by an exporter.
 //
 // This method returns an error if called after Shutdown.
@@ -164,6 +192,12 @@ func (c shutdownClient) Shutdown(ctx context.Context) error {
 	return c.err(ctx)
 }
 
+// DebugInfo returns the DebugInfo of the Exporter. It does not wait for the
+// ongoing exports, and is still valid after the Exporter is shut down.
+func (e *Exporter) DebugInfo() DebugInfo {
+	return e.debug.info()
+}
+
 // MarshalLog returns logging data about the Exporter.
 func (*Exporter) MarshalLog() any {
 	return struct{ Type string }{Type: "OTLP/gRPC"}
@@ -4,11 +4,22 @@
 // Package internal provides internal functionally for the otlpmetricgrpc package.
 package internal
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun.go.tmpl "--data={}" --out=dryrun.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun_test.go.tmpl "--data={}" --out=dryrun_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts.go.tmpl "--data={}" --out=attempts.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts_test.go.tmpl "--data={}" --out=attempts_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue.go.tmpl "--data={}" --out=persistentqueue.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue_test.go.tmpl "--data={}" --out=persistentqueue_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess.go.tmpl "--data={}" --out=partialsuccess.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess_test.go.tmpl "--data={}" --out=partialsuccess_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/retry/retry.go.tmpl "--data={}" --out=retry/retry.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/retry/retry_test.go.tmpl "--data={}" --out=retry/retry_test.go
@@ -23,6 +34,9 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/oconf/optiontypes.go.tmpl "--data={}" --out=oconf/optiontypes.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/oconf/tls.go.tmpl "--data={}" --out=oconf/tls.go
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/scopebatch.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf\"}" --out=scopebatch.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/scopebatch_test.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf\"}" --out=scopebatch_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/client.go.tmpl "--data={\"internalImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal\"}" --out=otest/client.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/client_test.go.tmpl "--data={\"internalImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal\"}" --out=otest/client_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/collector.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc/internal/oconf\"}" --out=otest/collector.go
@@ -32,6 +46,7 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error.go.tmpl "--data={}" --out=transform/error.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error_test.go.tmpl "--data={}" --out=transform/error_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata.go.tmpl "--data={}" --out=transform/metricdata.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata_fuzz_test.go.tmpl "--data={}" --out=transform/metricdata_fuzz_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata_test.go.tmpl "--data={}" --out=transform/metricdata_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter.go.tmpl "--data={}" --out=counter/counter.go
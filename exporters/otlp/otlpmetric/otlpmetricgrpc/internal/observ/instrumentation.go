@@ -57,6 +57,7 @@ type Instrumentation struct {
 	exported otelconv.SDKExporterMetricDataPointExported
 	inflight otelconv.SDKExporterMetricDataPointInflight
 	duration otelconv.SDKExporterOperationDuration
+	payload  *PayloadSize
 	attrs    []attribute.KeyValue
 	addOpt   metric.AddOption
 	recOpt   metric.RecordOption
@@ -104,6 +105,9 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 
 	em.attrs = BaseAttrs(id, target)
 
+	em.payload, instrumentErr = NewPayloadSize(meter, em.attrs)
+	err = errors.Join(err, instrumentErr)
+
 	attrSet := attribute.NewSet(em.attrs...)
 	em.addOpt = metric.WithAttributeSet(attrSet)
 	em.recOpt = metric.WithAttributeSet(attribute.NewSet(append(
@@ -268,6 +272,21 @@ func (e ExportOp) End(err error) {
 	}
 }
 
+// PayloadSizeEnabled reports whether the sizes of the export calls made with
+// ctx are recorded. Use it to avoid computing the sizes when they are not
+// recorded.
+func (em *Instrumentation) PayloadSizeEnabled(ctx context.Context) bool {
+	return em.payload.Enabled(ctx)
+}
+
+// RecordPayloadSize records the size in bytes of the protobuf encoded request
+// sent by a single export call and of the response it received. The response
+// size is only recorded if response is not negative. The code is the status
+// code of the call.
+func (em *Instrumentation) RecordPayloadSize(ctx context.Context, request, response int64, code codes.Code) {
+	em.payload.Record(ctx, request, response, code)
+}
+
 // countProtoDataPoints counts the total number of data points in a ResourceMetrics.
 func countProtoDataPoints(rm *metricpb.ResourceMetrics) int64 {
 	if rm == nil {
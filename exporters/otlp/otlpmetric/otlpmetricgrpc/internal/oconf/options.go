@@ -10,6 +10,7 @@ package oconf
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -61,6 +62,37 @@ type (
 		Timeout        time.Duration
 		URLPath        string
 
+		// DryRun configures export requests to be serialized without being
+		// sent. The serialized requests are written to DryRunSink, if not
+		// nil.
+		DryRun     bool
+		DryRunSink io.Writer
+
+		// PersistentQueueDir is the directory export requests that failed
+		// because the endpoint was unavailable are persisted to, if not
+		// empty. The total size of the persisted requests is limited to
+		// PersistentQueueMaxBytes, if positive.
+		PersistentQueueDir      string
+		PersistentQueueMaxBytes int64
+
+		// ResponseHandler is called with the headers and trailers of the
+		// responses to the export requests, if not nil.
+		ResponseHandler func(header, trailer map[string][]string)
+
+		// PayloadSizeHandler is called with the size of the payload of the
+		// export requests before and after compression, if not nil.
+		PayloadSizeHandler func(uncompressed, compressed int)
+
+		// PayloadTransformer transforms the payload of the export requests,
+		// once compressed, and sets their headers, if not nil.
+		PayloadTransformer func(payload []byte, header map[string][]string) ([]byte, error)
+
+		// ScopesPerRequest is the maximum number of scopes of the metric
+		// data sent in an export request, if positive. The scopes are
+		// batched into requests in ScopeOrder.
+		ScopesPerRequest int
+		ScopeOrder       ScopeOrder
+
 		TemporalitySelector metric.TemporalitySelector
 		AggregationSelector metric.AggregationSelector
 
@@ -83,6 +115,10 @@ type (
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
+
+		// NoPayloadSizeMetrics disables the experimental self-observability
+		// metrics recording the size of the gRPC requests and responses.
+		NoPayloadSizeMetrics bool
 	}
 )
 
@@ -372,6 +408,51 @@ func WithMaxRequestSize(size int) GenericOption {
 	})
 }
 
+func WithDryRun(sink io.Writer) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.DryRun = true
+		cfg.Metrics.DryRunSink = sink
+		return cfg
+	})
+}
+
+func WithPersistentQueue(dir string, maxBytes int64) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.PersistentQueueDir = dir
+		cfg.Metrics.PersistentQueueMaxBytes = maxBytes
+		return cfg
+	})
+}
+
+func WithResponseHandler(h func(header, trailer map[string][]string)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.ResponseHandler = h
+		return cfg
+	})
+}
+
+func WithPayloadSizeHandler(h func(uncompressed, compressed int)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.PayloadSizeHandler = h
+		return cfg
+	})
+}
+
+func WithPayloadTransformer(t func(payload []byte, header map[string][]string) ([]byte, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.PayloadTransformer = t
+		return cfg
+	})
+}
+
+func WithScopeBatching(scopesPerRequest int, order ScopeOrder) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Metrics.ScopesPerRequest = scopesPerRequest
+		cfg.Metrics.ScopeOrder = order
+		return cfg
+	})
+}
+
 func WithTemporalitySelector(selector metric.TemporalitySelector) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Metrics.TemporalitySelector = selector
@@ -109,6 +109,20 @@ func TestSelfObservability(t *testing.T) {
 							},
 						},
 					},
+					requestSizeMetric(
+						semconv.OTelComponentName(actualComponentName),
+						semconv.OTelComponentTypeKey.String("otlp_grpc_metric_exporter"),
+						semconv.RPCResponseStatusCode(codes.OK.String()),
+						semconv.ServerAddressKey.String(addr),
+						semconv.ServerPortKey.Int(port),
+					),
+					responseSizeMetric(
+						semconv.OTelComponentName(actualComponentName),
+						semconv.OTelComponentTypeKey.String("otlp_grpc_metric_exporter"),
+						semconv.RPCResponseStatusCode(codes.OK.String()),
+						semconv.ServerAddressKey.String(addr),
+						semconv.ServerPortKey.Int(port),
+					),
 				}
 			},
 		},
@@ -186,6 +200,12 @@ func TestSelfObservability(t *testing.T) {
 							},
 						},
 					},
+					// No response is received, only the request sizes of
+					// the attempts are recorded.
+					requestSizeMetric(append(
+						[]attribute.KeyValue{semconv.RPCResponseStatusCode("Unavailable")},
+						baseAttrs...,
+					)...),
 				}
 			},
 		},
@@ -269,6 +289,20 @@ func TestSelfObservability(t *testing.T) {
 							},
 						},
 					},
+					requestSizeMetric(
+						semconv.OTelComponentName(actualComponentName),
+						semconv.OTelComponentTypeKey.String("otlp_grpc_metric_exporter"),
+						semconv.RPCResponseStatusCode(codes.OK.String()),
+						semconv.ServerAddressKey.String(addr),
+						semconv.ServerPortKey.Int(port),
+					),
+					responseSizeMetric(
+						semconv.OTelComponentName(actualComponentName),
+						semconv.OTelComponentTypeKey.String("otlp_grpc_metric_exporter"),
+						semconv.RPCResponseStatusCode(codes.OK.String()),
+						semconv.ServerAddressKey.String(addr),
+						semconv.ServerPortKey.Int(port),
+					),
 				}
 			},
 		},
@@ -364,6 +398,79 @@ func TestSelfObservability(t *testing.T) {
 	}
 }
 
+// requestSizeMetric returns the expected request size metric with a single
+// data point with attrs.
+func requestSizeMetric(attrs ...attribute.KeyValue) metricdata.Metrics {
+	return payloadSizeMetric(
+		observ.RequestSizeName,
+		"Measures the size of RPC request messages (uncompressed).",
+		attrs,
+	)
+}
+
+// responseSizeMetric returns the expected response size metric with a single
+// data point with attrs.
+func responseSizeMetric(attrs ...attribute.KeyValue) metricdata.Metrics {
+	return payloadSizeMetric(
+		observ.ResponseSizeName,
+		"Measures the size of RPC response messages (uncompressed).",
+		attrs,
+	)
+}
+
+func payloadSizeMetric(name, desc string, attrs []attribute.KeyValue) metricdata.Metrics {
+	return metricdata.Metrics{
+		Name:        name,
+		Description: desc,
+		Unit:        "By",
+		Data: metricdata.Histogram[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[int64]{
+				{Attributes: attribute.NewSet(attrs...), Count: 1},
+			},
+		},
+	}
+}
+
+func TestWithoutPayloadSizeMetrics(t *testing.T) {
+	coll, err := otest.NewGRPCCollector("", nil)
+	require.NoError(t, err)
+	defer coll.Shutdown()
+
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+
+	reader := metric.NewManualReader()
+	otel.SetMeterProvider(metric.NewMeterProvider(metric.WithReader(reader)))
+
+	exp, err := New(t.Context(),
+		WithEndpoint("dns:///"+coll.Addr().String()),
+		WithInsecure(),
+		WithoutPayloadSizeMetrics())
+	require.NoError(t, err)
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	t.Cleanup(func() {
+		require.NoError(t, exp.Shutdown(ctx))
+	})
+
+	require.NoError(t, exp.Export(t.Context(), createTestResourceMetrics()))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+
+	var names []string
+	for _, sm := range got.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			names = append(names, m.Name)
+		}
+	}
+	assert.Contains(t, names, otelconv.SDKExporterOperationDuration{}.Name())
+	assert.NotContains(t, names, observ.RequestSizeName)
+	assert.NotContains(t, names, observ.ResponseSizeName)
+}
+
 func assertScopeMetricsEqual(t *testing.T, want, got metricdata.ScopeMetrics) {
 	t.Helper()
 
@@ -389,7 +496,7 @@ func assertScopeMetricsEqual(t *testing.T, want, got metricdata.ScopeMetrics) {
 
 func isHistogramMetric(m metricdata.Metrics) bool {
 	switch m.Data.(type) {
-	case metricdata.Histogram[float64]:
+	case metricdata.Histogram[float64], metricdata.Histogram[int64]:
 		return true
 	default:
 		return false
@@ -35,7 +35,26 @@ type client struct {
 	compression    Compression
 	maxRequestSize int
 	requestFunc    retry.RequestFunc
-	httpClient     *http.Client
+
+	// dryRun configures exports to be serialized and written to dryRunSink
+	// instead of being sent.
+	dryRun     bool
+	dryRunSink io.Writer
+	httpClient *http.Client
+
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured.
+	queue *internal.PersistentQueue
+
+	// responseHandler is called with the headers and trailers of the export
+	// responses, if not nil.
+	responseHandler func(header, trailer map[string][]string)
+	// payloadSizeHandler is called with the sizes of the export payloads, if
+	// not nil.
+	payloadSizeHandler func(uncompressed, compressed int)
+	// payloadTransformer transforms the export payloads and sets the headers
+	// of the export requests, if not nil.
+	payloadTransformer func(payload []byte, header map[string][]string) ([]byte, error)
 
 	inst *observ.Instrumentation
 }
@@ -116,16 +135,31 @@ func newClient(cfg oconf.Config) (*client, error) {
 	}
 	req.Header.Set("Content-Type", "application/x-protobuf")
 
+	var queue *internal.PersistentQueue
+	if cfg.Metrics.PersistentQueueDir != "" {
+		queue, err = internal.NewPersistentQueue(cfg.Metrics.PersistentQueueDir, cfg.Metrics.PersistentQueueMaxBytes, persistable)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Initialize the instrumentation.
 	inst, err := observ.NewInstrumentation(counter.NextExporterID(), cfg.Metrics.Endpoint)
 
 	return &client{
-		compression:    Compression(cfg.Metrics.Compression),
-		maxRequestSize: cfg.Metrics.MaxRequestSize,
-		req:            req,
-		requestFunc:    cfg.RetryConfig.RequestFunc(evaluate),
-		httpClient:     httpClient,
-		inst:           inst,
+		compression:     Compression(cfg.Metrics.Compression),
+		maxRequestSize:  cfg.Metrics.MaxRequestSize,
+		dryRun:          cfg.Metrics.DryRun,
+		dryRunSink:      cfg.Metrics.DryRunSink,
+		req:             req,
+		requestFunc:     cfg.RetryConfig.RequestFunc(evaluate),
+		httpClient:      httpClient,
+		queue:           queue,
+		responseHandler: cfg.Metrics.ResponseHandler,
+		inst:            inst,
+
+		payloadSizeHandler: cfg.Metrics.PayloadSizeHandler,
+		payloadTransformer: cfg.Metrics.PayloadTransformer,
 	}, err
 }
 
@@ -135,6 +169,12 @@ func (c *client) Shutdown(ctx context.Context) error {
 	// ensures this is called only once. The only thing that needs to be done
 	// here is to release any computational resources the client holds.
 
+	// Stop the replay of the persisted requests before the client is
+	// released.
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
+
 	c.requestFunc = nil
 	c.httpClient = nil
 	return ctx.Err()
@@ -159,9 +199,9 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 	if maxSize := c.maxRequestSize; maxSize > 0 && len(body) > maxSize {
 		return fmt.Errorf("request body too large: exceeded %d bytes", maxSize)
 	}
-	request, err := c.newRequest(ctx, body)
-	if err != nil {
-		return err
+
+	if c.dryRun {
+		return internal.DryRun(c.dryRunSink, body)
 	}
 
 	var statusCode int
@@ -170,100 +210,137 @@ func (c *client) UploadMetrics(ctx context.Context, protoMetrics *metricpb.Resou
 		defer func() { op.End(uploadErr, statusCode) }()
 	}
 
-	return errors.Join(uploadErr, c.requestFunc(ctx, func(iCtx context.Context) error {
-		select {
-		case <-iCtx.Done():
-			return iCtx.Err()
-		default:
-		}
-
-		statusCode = 0
-		request.reset(iCtx)
-		// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
-		resp, err := c.httpClient.Do(request.Request)
-		var urlErr *url.Error
-		if errors.As(err, &urlErr) && urlErr.Temporary() {
-			return newResponseError(http.Header{}, err)
-		}
-		if err != nil {
-			return err
-		}
-		if resp != nil {
-			statusCode = resp.StatusCode
-			if resp.Body != nil {
-				defer func() {
-					if err := resp.Body.Close(); err != nil {
-						uploadErr = errors.Join(uploadErr, err)
-					}
-				}()
-			}
-		}
-
-		if statusCode >= 200 && statusCode <= 299 {
-			// Success, do not retry.
-
-			// Read the partial success message, if any.
-			var respData bytes.Buffer
-			if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-				var maxBytesErr *http.MaxBytesError
-				if errors.As(err, &maxBytesErr) {
-					return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
-				}
+	// newSend returns a function sending a request and setting statusCode to
+	// the status code of its last attempt.
+	newSend := func(statusCode *int) func(context.Context, []byte) error {
+		return func(ctx context.Context, body []byte) error {
+			request, err := c.newRequest(ctx, body)
+			if err != nil {
 				return err
 			}
-			if respData.Len() == 0 {
-				return nil
+			if h := c.payloadSizeHandler; h != nil {
+				h(len(body), int(request.size))
 			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
-				var respProto colmetricpb.ExportMetricsServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+			var sendErr error
+			err = c.requestFunc(ctx, func(iCtx context.Context) error {
+				select {
+				case <-iCtx.Done():
+					return iCtx.Err()
+				default:
+				}
+
+				*statusCode = 0
+				request.reset(iCtx)
+				// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
+				resp, err := c.httpClient.Do(request.Request)
+				var urlErr *url.Error
+				if errors.As(err, &urlErr) && urlErr.Temporary() {
+					return newResponseError(http.Header{}, err)
+				}
+				if err != nil {
 					return err
 				}
+				if resp != nil {
+					*statusCode = resp.StatusCode
+					if resp.Body != nil {
+						defer func() {
+							if err := resp.Body.Close(); err != nil {
+								sendErr = errors.Join(sendErr, err)
+							}
+						}()
+					}
+				}
+				if h := c.responseHandler; h != nil && resp != nil {
+					// The trailers are received once the body is read.
+					defer func() { h(resp.Header, resp.Trailer) }()
+				}
+
+				var respSize int64
+				if c.inst != nil {
+					defer func() { c.inst.RecordPayloadSize(iCtx, request.size, respSize, *statusCode) }()
+				}
 
-				if respProto.PartialSuccess != nil {
-					msg := respProto.PartialSuccess.GetErrorMessage()
-					n := respProto.PartialSuccess.GetRejectedDataPoints()
-					if n != 0 || msg != "" {
-						err := internal.MetricPartialSuccessError(n, msg)
-						uploadErr = errors.Join(uploadErr, err)
+				if *statusCode >= 200 && *statusCode <= 299 {
+					// Success, do not retry.
+
+					// Read the partial success message, if any.
+					var respData bytes.Buffer
+					n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+					respSize = n
+					if err != nil {
+						var maxBytesErr *http.MaxBytesError
+						if errors.As(err, &maxBytesErr) {
+							return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+						}
+						return err
+					}
+					if respData.Len() == 0 {
+						return nil
 					}
+
+					if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+						var respProto colmetricpb.ExportMetricsServiceResponse
+						if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+							return err
+						}
+
+						if respProto.PartialSuccess != nil {
+							msg := respProto.PartialSuccess.GetErrorMessage()
+							n := respProto.PartialSuccess.GetRejectedDataPoints()
+							if n != 0 || msg != "" {
+								err := internal.MetricPartialSuccessError(n, msg)
+								sendErr = errors.Join(sendErr, err)
+							}
+						}
+					}
+					return nil
 				}
-			}
-			return nil
-		}
-		// Error cases.
-
-		// server may return a message with the response
-		// body, so we read it to include in the error
-		// message to be returned. It will help in
-		// debugging the actual issue.
-		var respData bytes.Buffer
-		if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-			var maxBytesErr *http.MaxBytesError
-			if errors.As(err, &maxBytesErr) {
-				return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
-			}
-			return err
-		}
-		respStr := strings.TrimSpace(respData.String())
-		if respStr == "" {
-			respStr = "(empty)"
-		}
-		bodyErr := fmt.Errorf("body: %s", respStr)
-
-		switch resp.StatusCode {
-		case http.StatusTooManyRequests,
-			http.StatusBadGateway,
-			http.StatusServiceUnavailable,
-			http.StatusGatewayTimeout:
-			// Retryable failure.
-			return newResponseError(resp.Header, bodyErr)
-		default:
-			// Non-retryable failure.
-			return fmt.Errorf("failed to send metrics to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+				// Error cases.
+
+				// server may return a message with the response
+				// body, so we read it to include in the error
+				// message to be returned. It will help in
+				// debugging the actual issue.
+				var respData bytes.Buffer
+				n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+				respSize = n
+				if err != nil {
+					var maxBytesErr *http.MaxBytesError
+					if errors.As(err, &maxBytesErr) {
+						return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+					}
+					return err
+				}
+				respStr := strings.TrimSpace(respData.String())
+				if respStr == "" {
+					respStr = "(empty)"
+				}
+				bodyErr := fmt.Errorf("body: %s", respStr)
+
+				switch resp.StatusCode {
+				case http.StatusTooManyRequests,
+					http.StatusBadGateway,
+					http.StatusServiceUnavailable,
+					http.StatusGatewayTimeout:
+					// Retryable failure.
+					return newResponseError(resp.Header, bodyErr)
+				default:
+					// Non-retryable failure.
+					return fmt.Errorf("failed to send metrics to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+				}
+			})
+			return errors.Join(sendErr, err)
 		}
-	}))
+	}
+	send := newSend(&statusCode)
+
+	if c.queue != nil {
+		// The persisted requests are replayed after this export returns, with
+		// their own status code.
+		return c.queue.Export(ctx, body, send, newSend(new(int)))
+	}
+	return send(ctx, body)
 }
 
 var gzPool = sync.Pool{
@@ -277,11 +354,10 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 	r := c.req.Clone(ctx)
 	req := request{Request: r}
 
+	payload := body
 	switch c.compression {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
-		req.bodyReader = bodyReader(body)
-		req.GetBody = bodyReaderErr(body)
 	case GzipCompression:
 		// Ensure the content length is not used.
 		r.ContentLength = -1
@@ -303,11 +379,23 @@ func (c *client) newRequest(ctx context.Context, body []byte) (request, error) {
 		if err := gz.Close(); err != nil {
 			return req, err
 		}
+		payload = b.Bytes()
+	}
 
-		req.bodyReader = bodyReader(b.Bytes())
-		req.GetBody = bodyReaderErr(b.Bytes())
+	if t := c.payloadTransformer; t != nil {
+		var err error
+		payload, err = t(payload, r.Header)
+		if err != nil {
+			return req, fmt.Errorf("payload transformer: %w", err)
+		}
+		if r.ContentLength >= 0 {
+			r.ContentLength = int64(len(payload))
+		}
 	}
 
+	req.bodyReader = bodyReader(payload)
+	req.GetBody = bodyReaderErr(payload)
+	req.size = int64(len(payload))
 	return req, nil
 }
 
@@ -331,6 +419,8 @@ type request struct {
 
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
+	// size is the size of the body, after any compression, in bytes.
+	size int64
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
@@ -400,6 +490,14 @@ func (e retryableError) As(target any) bool {
 	}
 }
 
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	var rErr *retryableError
+	var urlErr *url.Error
+	return errors.As(err, &rErr) || errors.As(err, &urlErr)
+}
+
 // evaluate returns if err is retry-able. If it is and it includes an explicit
 // throttling delay, that delay is also returned.
 func evaluate(err error) (bool, time.Duration) {
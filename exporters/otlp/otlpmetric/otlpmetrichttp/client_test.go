@@ -11,9 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -23,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
 	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -37,6 +41,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -660,6 +665,36 @@ func TestClientInstrumentation(t *testing.T) {
 					Temporality: metricdata.CumulativeTemporality,
 				},
 			},
+			{
+				Name:        httpconv.ClientRequestBodySize{}.Name(),
+				Description: httpconv.ClientRequestBodySize{}.Description(),
+				Unit:        httpconv.ClientRequestBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(200),
+						)...)},
+					},
+					Temporality: metricdata.CumulativeTemporality,
+				},
+			},
+			{
+				Name:        httpconv.ClientResponseBodySize{}.Name(),
+				Description: httpconv.ClientResponseBodySize{}.Description(),
+				Unit:        httpconv.ClientResponseBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(200),
+						)...)},
+					},
+					Temporality: metricdata.CumulativeTemporality,
+				},
+			},
 		},
 	}
 	require.Len(t, got.ScopeMetrics, 1)
@@ -854,8 +889,243 @@ func TestWithEndpointURLNoPathUsesRootPath(t *testing.T) {
 	assert.Equal(t, "/", got, "a pathless endpoint URL must target the root path, not the default metrics path")
 }
 
+func TestResponseHeaderHandler(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(10-requests))
+		w.Header().Set("Trailer", "Server-Version")
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		w.Header().Set("Server-Version", "1.2.3")
+	}))
+	t.Cleanup(srv.Close)
+
+	type response struct{ header, trailer http.Header }
+	var got []response
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpointURL(srv.URL),
+		WithRetry(RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		WithResponseHeaderHandler(func(header, trailer http.Header) {
+			got = append(got, response{header, trailer})
+		}),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for i, r := range got {
+		assert.Equal(t, strconv.Itoa(9-i), r.header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1.2.3", r.trailer.Get("Server-Version"))
+	}
+}
+
+func TestPayloadSizeHandler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	type sizes struct{ uncompressed, compressed int }
+	export := func(c Compression) []sizes {
+		var got []sizes
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		exp, err := New(ctx,
+			WithEndpointURL(srv.URL),
+			WithCompression(c),
+			WithPayloadSizeHandler(func(uncompressed, compressed int) {
+				got = append(got, sizes{uncompressed, compressed})
+			}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		rm := &metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: "scope"},
+			}},
+		}
+		require.NoError(t, exp.Export(ctx, rm))
+		return got
+	}
+
+	got := export(NoCompression)
+	require.Len(t, got, 1)
+	assert.Positive(t, got[0].uncompressed)
+	assert.Equal(t, got[0].uncompressed, got[0].compressed, "not compressed")
+
+	uncompressed := got[0].uncompressed
+	got = export(GzipCompression)
+	require.Len(t, got, 1)
+	assert.Equal(t, uncompressed, got[0].uncompressed)
+	assert.Positive(t, got[0].compressed)
+	assert.NotEqual(t, got[0].uncompressed, got[0].compressed, "compressed")
+}
+
+func TestPayloadTransformer(t *testing.T) {
+	xor := func(b []byte) []byte {
+		out := make([]byte, len(b))
+		for i, c := range b {
+			out[i] = c ^ 0xff
+		}
+		return out
+	}
+
+	var scopes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) || !assert.Equal(t, "xor", r.Header.Get("X-Payload-Transform")) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = xor(body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(bytes.NewReader(body))
+			require.NoError(t, err)
+			body, err = io.ReadAll(gz)
+			require.NoError(t, err)
+		}
+		var req colmetricpb.ExportMetricsServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		scopes = append(scopes, req.ResourceMetrics[0].ScopeMetrics[0].Scope.Name)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	transformErr := errors.New("transform")
+	export := func(c Compression, fail bool) error {
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		exp, err := New(ctx,
+			WithEndpointURL(srv.URL),
+			WithCompression(c),
+			WithPayloadTransformer(func(payload []byte, header http.Header) ([]byte, error) {
+				if fail {
+					return nil, transformErr
+				}
+				header.Set("X-Payload-Transform", "xor")
+				return xor(payload), nil
+			}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+		rm := &metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{{
+				Scope: instrumentation.Scope{Name: "scope"},
+			}},
+		}
+		return exp.Export(ctx, rm)
+	}
+
+	require.NoError(t, export(NoCompression, false))
+	require.NoError(t, export(GzipCompression, false))
+	assert.Equal(t, []string{"scope", "scope"}, scopes)
+
+	assert.ErrorIs(t, export(NoCompression, true), transformErr)
+	assert.Len(t, scopes, 2, "not sent")
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(t.Context(), WithInsecure(), WithDryRun(&buf))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	rm := &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "scope"},
+		}},
+	}
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	var req colmetricpb.ExportMetricsServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	require.Len(t, req.ResourceMetrics, 1)
+	require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+	assert.Equal(t, "scope", req.ResourceMetrics[0].ScopeMetrics[0].Scope.Name)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	unavailable := otest.ExportResult{Err: &otest.HTTPResponseError{
+		Status: http.StatusServiceUnavailable,
+		Err:    errors.New("unavailable"),
+	}}
+	rCh := make(chan otest.ExportResult, 5)
+	rCh <- unavailable
+	rCh <- unavailable
+	for range 3 {
+		rCh <- otest.ExportResult{}
+	}
+	coll, err := otest.NewHTTPCollector("", rCh)
+	require.NoError(t, err)
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	t.Cleanup(func() { require.NoError(t, coll.Shutdown(ctx)) })
+
+	dir := t.TempDir()
+	exp, err := New(ctx,
+		WithEndpoint(coll.Addr().String()),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	// The first request fails and is persisted. The second request is
+	// persisted, without being sent, after the first one fails again.
+	var received int
+	collected := func(n int) func() bool {
+		return func() bool {
+			received += len(coll.Collect().Dump())
+			return received == n
+		}
+	}
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	require.Eventually(t, collected(2), 5*time.Second, 10*time.Millisecond)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	assert.Eventually(t, collected(5), 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueEndpointDown(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := New(ctx,
+		WithEndpoint(endpoint),
+		WithInsecure(),
+		WithRetry(RetryConfig{Enabled: false}),
+		WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	require.NoError(t, exp.Export(ctx, &metricdata.ResourceMetrics{}))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "request not persisted")
+}
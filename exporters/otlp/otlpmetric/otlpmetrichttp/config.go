@@ -5,6 +5,7 @@ package otlpmetrichttp
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
@@ -200,6 +201,97 @@ func WithMaxRequestSize(size int) Option {
 	return wrappedOption{oconf.WithMaxRequestSize(size)}
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return wrappedOption{oconf.WithDryRun(sink)}
+}
+
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new metrics are persisted without being sent so that the order
+// is kept.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return wrappedOption{oconf.WithPersistentQueue(dir, maxBytes)}
+}
+
+// WithResponseHeaderHandler sets a function called with the headers and
+// trailers of the HTTP response to each export request, including the
+// requests that failed or are retried. Use it to read the headers set by the
+// endpoint, e.g. to slow down the exports when a rate limit header reports
+// few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseHeaderHandler(h func(header, trailer http.Header)) Option {
+	if h == nil {
+		return wrappedOption{oconf.WithResponseHandler(nil)}
+	}
+	return wrappedOption{oconf.WithResponseHandler(func(header, trailer map[string][]string) {
+		h(header, trailer)
+	})}
+}
+
+// WithPayloadSizeHandler sets a function called with the size in bytes of the
+// protobuf encoded payload of each export request, before and after its
+// compression. compressed equals uncompressed if the payload is not
+// compressed. Use it to choose the compression and the size of the batches
+// based on the actual payloads.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry, and must not block.
+func WithPayloadSizeHandler(h func(uncompressed, compressed int)) Option {
+	return wrappedOption{oconf.WithPayloadSizeHandler(h)}
+}
+
+// WithPayloadTransformer sets a function transforming the payload of each
+// export request before it is sent, e.g. to encrypt or sign it for the
+// endpoints requiring an application-layer encryption. The payload is the
+// protobuf encoded request, once compressed if a compression is configured.
+// The function can set the headers of the request in header, e.g. the key ID
+// of an envelope encryption, and returns the payload to send or an error
+// failing the export without retrying it.
+//
+// The Content-Type and Content-Encoding headers describe the payload before
+// its transformation and can be modified by the function. The size reported
+// to the handler of WithPayloadSizeHandler is the size of the transformed
+// payload.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry. It must not retain or modify payload.
+func WithPayloadTransformer(f func(payload []byte, header http.Header) ([]byte, error)) Option {
+	if f == nil {
+		return wrappedOption{oconf.WithPayloadTransformer(nil)}
+	}
+	return wrappedOption{oconf.WithPayloadTransformer(
+		func(payload []byte, header map[string][]string) ([]byte, error) {
+			return f(payload, header)
+		},
+	)}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that are
 // returned by the target endpoint.
 //
@@ -214,6 +306,38 @@ func WithRetry(rc RetryConfig) Option {
 	return wrappedOption{oconf.WithRetry(retry.Config(rc))}
 }
 
+// ScopeOrder describes the order the scopes of the metric data are batched
+// into export requests when the requests are split by scope, see
+// WithScopeBatching.
+type ScopeOrder oconf.ScopeOrder
+
+const (
+	// ScopeOrderCollected batches consecutive scopes, in the order they are
+	// collected, into the same request.
+	ScopeOrderCollected = ScopeOrder(oconf.ScopeOrderCollected)
+	// ScopeOrderName batches consecutive scopes, sorted by name and version,
+	// into the same request.
+	ScopeOrderName = ScopeOrder(oconf.ScopeOrderName)
+	// ScopeOrderInterleaved distributes the scopes, from the one with the most
+	// data points to the one with the least, across the requests in turn so
+	// the requests have a similar size.
+	ScopeOrderInterleaved = ScopeOrder(oconf.ScopeOrderInterleaved)
+)
+
+// WithScopeBatching splits the export of the metric data of a collection
+// into requests containing at most scopesPerRequest scopes each, batched in
+// order. The requests are sent one after the other, and the exporter is not
+// locked in between, so the payloads of a MeterProvider with many scopes are
+// kept small and a collector limiting the size of the requests does not
+// reject the whole collection. The failure of a request does not prevent the
+// others from being sent.
+//
+// If scopesPerRequest is less than or equal to zero, the metric data of a
+// collection is exported in a single request. This is the default.
+func WithScopeBatching(scopesPerRequest int, order ScopeOrder) Option {
+	return wrappedOption{oconf.WithScopeBatching(scopesPerRequest, oconf.ScopeOrder(order))}
+}
+
 // WithTemporalitySelector sets the TemporalitySelector the client will use to
 // determine the Temporality of an instrument based on its kind. If this option
 // is not used, the client will use the DefaultTemporalitySelector from the
@@ -5,6 +5,7 @@ package otlpmetrichttp_test
 
 import (
 	"context"
+	"net/http"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
@@ -29,3 +30,18 @@ func Example() {
 	// From here, the meterProvider can be used by instrumentation to collect
 	// telemetry.
 }
+
+func ExampleNewPullHandler() {
+	// Serve the metrics of the MeterProvider to be scraped by an OTLP
+	// receiver instead of pushing them.
+	reader := metric.NewManualReader()
+	meterProvider := metric.NewMeterProvider(metric.WithReader(reader))
+	defer func() {
+		if err := meterProvider.Shutdown(context.Background()); err != nil {
+			panic(err)
+		}
+	}()
+	otel.SetMeterProvider(meterProvider)
+
+	http.Handle("/v1/metrics", otlpmetrichttp.NewPullHandler(reader))
+}
@@ -11,6 +11,7 @@ import (
 
 	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/transform"
 	"go.opentelemetry.io/otel/internal/global"
@@ -30,6 +31,11 @@ type Exporter struct {
 	temporalitySelector metric.TemporalitySelector
 	aggregationSelector metric.AggregationSelector
 
+	// scopesPerRequest and scopeOrder configure the split of the exported
+	// metric data into requests by scope.
+	scopesPerRequest int
+	scopeOrder       oconf.ScopeOrder
+
 	shutdownOnce sync.Once
 }
 
@@ -51,6 +57,9 @@ func newExporter(c *client, cfg oconf.Config) (*Exporter, error) {
 
 		temporalitySelector: ts,
 		aggregationSelector: as,
+
+		scopesPerRequest: cfg.Metrics.ScopesPerRequest,
+		scopeOrder:       cfg.Metrics.ScopeOrder,
 	}, nil
 }
 
@@ -73,9 +82,7 @@ func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) e
 
 	otlpRm, err := transform.ResourceMetrics(rm)
 	// Best effort upload of transformable metrics.
-	e.clientMu.Lock()
-	upErr := e.client.UploadMetrics(ctx, otlpRm)
-	e.clientMu.Unlock()
+	upErr := e.upload(ctx, otlpRm)
 	if upErr != nil {
 		if err == nil {
 			return fmt.Errorf("failed to upload metrics: %w", upErr)
@@ -86,6 +93,20 @@ func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) e
 	return err
 }
 
+// upload uploads rm, split into requests by scope if configured.
+func (e *Exporter) upload(ctx context.Context, rm *metricpb.ResourceMetrics) error {
+	var errs []error
+	for _, req := range internal.SplitScopes(rm, e.scopesPerRequest, e.scopeOrder) {
+		e.clientMu.Lock()
+		err := e.client.UploadMetrics(ctx, req)
+		e.clientMu.Unlock()
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // ForceFlush flushes any metric data held by an exporter.
 //
 // This method returns an error if called after Shutdown.
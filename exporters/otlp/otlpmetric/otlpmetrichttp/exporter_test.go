@@ -4,15 +4,19 @@
 package otlpmetrichttp
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/otest"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
@@ -106,3 +110,45 @@ func TestExporterDoesNotBlockTemporalityAndAggregation(t *testing.T) {
 	close(rCh)
 	wg.Wait()
 }
+
+type uploadRecorder struct {
+	scopes [][]string
+	err    error
+}
+
+func (c *uploadRecorder) UploadMetrics(_ context.Context, rm *metricpb.ResourceMetrics) error {
+	var names []string
+	for _, sm := range rm.ScopeMetrics {
+		names = append(names, sm.Scope.Name)
+	}
+	c.scopes = append(c.scopes, names)
+	if len(c.scopes) == 1 {
+		return c.err
+	}
+	return nil
+}
+
+func (*uploadRecorder) Shutdown(context.Context) error { return nil }
+
+func TestExporterScopeBatching(t *testing.T) {
+	opts := []Option{WithInsecure(), WithScopeBatching(2, ScopeOrderName)}
+	cfg := oconf.NewHTTPConfig(asHTTPOptions(opts)...)
+	client, err := newClient(cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, client.Shutdown(context.Background())) })
+	exp, err := newExporter(client, cfg)
+	require.NoError(t, err)
+	uploadErr := errors.New("request too large")
+	rec := &uploadRecorder{err: uploadErr}
+	exp.client = rec
+
+	rm := &metricdata.ResourceMetrics{}
+	for _, name := range []string{"c", "a", "b"} {
+		rm.ScopeMetrics = append(rm.ScopeMetrics, metricdata.ScopeMetrics{
+			Scope: instrumentation.Scope{Name: name},
+		})
+	}
+	err = exp.Export(t.Context(), rm)
+	assert.ErrorIs(t, err, uploadErr)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, rec.scopes, "all requests sent")
+}
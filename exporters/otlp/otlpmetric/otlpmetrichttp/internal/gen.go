@@ -4,6 +4,15 @@
 // Package internal provides internal functionally for the otlpmetrichttp package.
 package internal
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun.go.tmpl "--data={}" --out=dryrun.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun_test.go.tmpl "--data={}" --out=dryrun_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue.go.tmpl "--data={}" --out=persistentqueue.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue_test.go.tmpl "--data={}" --out=persistentqueue_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson.go.tmpl "--data={}" --out=otlpjson.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpjson_test.go.tmpl "--data={}" --out=otlpjson_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess.go.tmpl "--data={}" --out=partialsuccess.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess_test.go.tmpl "--data={}" --out=partialsuccess_test.go
 
@@ -20,6 +29,9 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/oconf/optiontypes.go.tmpl "--data={}" --out=oconf/optiontypes.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/oconf/tls.go.tmpl "--data={}" --out=oconf/tls.go
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/scopebatch.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf\"}" --out=scopebatch.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/scopebatch_test.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf\"}" --out=scopebatch_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/client.go.tmpl "--data={\"internalImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal\"}" --out=otest/client.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/client_test.go.tmpl "--data={\"internalImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal\"}" --out=otest/client_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/otest/collector.go.tmpl "--data={\"oconfImportPath\": \"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/oconf\"}" --out=otest/collector.go
@@ -29,6 +41,7 @@ package internal
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error.go.tmpl "--data={}" --out=transform/error.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/error_test.go.tmpl "--data={}" --out=transform/error_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata.go.tmpl "--data={}" --out=transform/metricdata.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata_fuzz_test.go.tmpl "--data={}" --out=transform/metricdata_fuzz_test.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/otlpmetric/transform/metricdata_test.go.tmpl "--data={}" --out=transform/metricdata_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter.go.tmpl "--data={}" --out=counter/counter.go
@@ -26,6 +26,7 @@ import (
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -51,6 +52,7 @@ var (
 				1 + // server.addr
 				1 + // server.port
 				1 + // error.type
+				1 + // http.request.method
 				1 // http.response.status_code
 			s := make([]attribute.KeyValue, 0, n)
 			// Return a pointer to a slice instead of a slice itself
@@ -96,9 +98,13 @@ type Instrumentation struct {
 	exportedMetric metric.Int64Counter
 	opDuration     metric.Float64Histogram
 
-	attrs  []attribute.KeyValue
-	addOpt metric.AddOption
-	recOpt metric.RecordOption
+	reqBodySize  metric.Int64Histogram
+	respBodySize metric.Int64Histogram
+
+	attrs      []attribute.KeyValue
+	addOpt     metric.AddOption
+	recOpt     metric.RecordOption
+	payloadOpt metric.RecordOption
 }
 
 // NewInstrumentation returns instrumentation for an OTLP over HTTP metric
@@ -127,6 +133,13 @@ func NewInstrumentation(id int64, endpoint string) (*Instrumentation, error) {
 			[]attribute.KeyValue{semconv.HTTPResponseStatusCode(http.StatusOK)},
 			attrs...,
 		)...)),
+		payloadOpt: metric.WithAttributeSet(attribute.NewSet(append(
+			[]attribute.KeyValue{
+				semconv.HTTPRequestMethodPost,
+				semconv.HTTPResponseStatusCode(http.StatusOK),
+			},
+			attrs...,
+		)...)),
 	}
 
 	mp := otel.GetMeterProvider()
@@ -159,6 +172,20 @@ func NewInstrumentation(id int64, endpoint string) (*Instrumentation, error) {
 	}
 	i.opDuration = opDuration.Inst()
 
+	reqBodySize, e := httpconv.NewClientRequestBodySize(m)
+	if e != nil {
+		e = fmt.Errorf("failed to create request body size metric: %w", e)
+		err = errors.Join(err, e)
+	}
+	i.reqBodySize = reqBodySize.Inst()
+
+	respBodySize, e := httpconv.NewClientResponseBodySize(m)
+	if e != nil {
+		e = fmt.Errorf("failed to create response body size metric: %w", e)
+		err = errors.Join(err, e)
+	}
+	i.respBodySize = respBodySize.Inst()
+
 	return i, err
 }
 
@@ -372,6 +399,55 @@ func (i *Instrumentation) recordOption(err error, status int) metric.RecordOptio
 	return metric.WithAttributeSet(attribute.NewSet(*attrs...))
 }
 
+// RecordPayloadSize records the size of the request body sent and the
+// response body received by a single HTTP request made by the exporter.
+//
+// The request size is the size of the encoded, and if applicable compressed,
+// body sent. The status is the HTTP status code of the response, or 0 if no
+// response was received. The response size is only recorded if a response
+// was received.
+func (i *Instrumentation) RecordPayloadSize(ctx context.Context, request, response int64, status int) {
+	reqEnabled := i.reqBodySize.Enabled(ctx)
+	respEnabled := status != 0 && i.respBodySize.Enabled(ctx)
+	if !reqEnabled && !respEnabled {
+		return
+	}
+
+	recOpt := get[metric.RecordOption](recordOptPool)
+	defer put(recordOptPool, recOpt)
+	*recOpt = append(*recOpt, i.payloadOption(status))
+
+	if reqEnabled {
+		i.reqBodySize.Record(ctx, request, *recOpt...)
+	}
+	if respEnabled {
+		i.respBodySize.Record(ctx, response, *recOpt...)
+	}
+}
+
+// payloadOption returns a RecordOption with attributes describing an HTTP
+// request that resulted in status.
+//
+// If status is 200, the default payloadOpt of the Instrumentation is
+// returned.
+func (i *Instrumentation) payloadOption(status int) metric.RecordOption {
+	if status == http.StatusOK {
+		return i.payloadOpt
+	}
+
+	attrs := get[attribute.KeyValue](measureAttrsPool)
+	defer put(measureAttrsPool, attrs)
+	*attrs = append(*attrs, i.attrs...)
+	*attrs = append(*attrs, semconv.HTTPRequestMethodPost)
+	if status != 0 {
+		*attrs = append(*attrs, semconv.HTTPResponseStatusCode(status))
+	}
+
+	// Do not inefficiently make a copy of attrs by using WithAttributes
+	// instead of WithAttributeSet.
+	return metric.WithAttributeSet(attribute.NewSet(*attrs...))
+}
+
 // successful returns the number of successfully exported metrics out of the n
 // that were exported based on the provided error.
 //
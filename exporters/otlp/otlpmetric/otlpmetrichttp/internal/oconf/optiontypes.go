@@ -31,6 +31,23 @@ const (
 	GzipCompression
 )
 
+// ScopeOrder describes the order the scopes of the metric data are batched
+// into export requests when the requests are split by scope.
+type ScopeOrder int
+
+const (
+	// ScopeOrderCollected batches consecutive scopes, in the order they are
+	// collected, into the same request.
+	ScopeOrderCollected ScopeOrder = iota
+	// ScopeOrderName batches consecutive scopes, sorted by name and version,
+	// into the same request.
+	ScopeOrderName
+	// ScopeOrderInterleaved distributes the scopes, from the one with the most
+	// data points to the one with the least, across the requests in turn so
+	// the requests have a similar size.
+	ScopeOrderInterleaved
+)
+
 // RetrySettings defines configuration for retrying batches in case of export failure
 // using an exponential backoff.
 type RetrySettings struct {
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetrichttp
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp/internal/transform"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	contentTypeProto = "application/x-protobuf"
+	contentTypeJSON  = "application/json"
+)
+
+// pullHandler serves the metrics collected by a ManualReader.
+type pullHandler struct {
+	reader *metric.ManualReader
+}
+
+// NewPullHandler returns an http.Handler that serves the metrics currently
+// held by reader as an OTLP/HTTP export request each time it is requested.
+// This allows the metrics to be scraped by an OTLP receiver instead of being
+// pushed to it.
+//
+// The response body is an ExportMetricsServiceRequest encoded as binary
+// protobuf, or as OTLP/JSON if "application/json" is listed in the Accept
+// header of the request before any protobuf media type. Only GET requests are
+// served.
+//
+// The temporality and aggregation of the served metrics are the ones
+// configured for reader. If reader uses delta temporality, each request
+// resets the state reported to the next one, so reader should only be
+// scraped by a single client.
+func NewPullHandler(reader *metric.ManualReader) http.Handler {
+	return &pullHandler{reader: reader}
+}
+
+// ServeHTTP collects the metrics of the reader and writes them to w.
+func (h *pullHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := h.reader.Collect(r.Context(), &rm); err != nil {
+		otel.Handle(fmt.Errorf("otlp pull handler: collect: %w", err))
+		http.Error(w, "failed to collect metrics", http.StatusInternalServerError)
+		return
+	}
+
+	pbRm, err := transform.ResourceMetrics(&rm)
+	if err != nil {
+		// Best effort: serve the metrics that could be transformed.
+		otel.Handle(fmt.Errorf("otlp pull handler: %w", err))
+	}
+	msg := &colmetricpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricpb.ResourceMetrics{pbRm},
+	}
+
+	contentType := negotiate(r.Header.Get("Accept"))
+	var body []byte
+	if contentType == contentTypeJSON {
+		body, err = internal.MarshalJSON(msg)
+	} else {
+		body, err = proto.Marshal(msg)
+	}
+	if err != nil {
+		otel.Handle(fmt.Errorf("otlp pull handler: marshal: %w", err))
+		http.Error(w, "failed to encode metrics", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		otel.Handle(fmt.Errorf("otlp pull handler: write: %w", err))
+	}
+}
+
+// negotiate returns the content type to encode the response with based on
+// the accept header of the request. Binary protobuf is used unless JSON is
+// preferred.
+func negotiate(accept string) string {
+	for v := range strings.SplitSeq(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(v)
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case contentTypeProto, "application/protobuf", "*/*", "application/*":
+			return contentTypeProto
+		case contentTypeJSON:
+			return contentTypeJSON
+		}
+	}
+	return contentTypeProto
+}
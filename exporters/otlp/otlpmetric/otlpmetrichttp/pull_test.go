@@ -0,0 +1,161 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlpmetrichttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPullHandler(t *testing.T) {
+	reader := metric.NewManualReader(metric.WithTemporalitySelector(
+		func(metric.InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		},
+	))
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	t.Cleanup(func() { require.NoError(t, mp.Shutdown(t.Context())) })
+
+	ctr, err := mp.Meter(t.Name()).Int64Counter("requests")
+	require.NoError(t, err)
+	h := NewPullHandler(reader)
+
+	pull := func(accept string) (*colmetricpb.ExportMetricsServiceRequest, string) {
+		t.Helper()
+		req := httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		contentType := rec.Header().Get("Content-Type")
+		msg := new(colmetricpb.ExportMetricsServiceRequest)
+		switch contentType {
+		case contentTypeJSON:
+			require.NoError(t, protojson.Unmarshal(rec.Body.Bytes(), msg))
+		default:
+			require.NoError(t, proto.Unmarshal(rec.Body.Bytes(), msg))
+		}
+		return msg, contentType
+	}
+	sum := func(msg *colmetricpb.ExportMetricsServiceRequest) int64 {
+		t.Helper()
+		require.Len(t, msg.ResourceMetrics, 1)
+		require.Len(t, msg.ResourceMetrics[0].ScopeMetrics, 1)
+		m := msg.ResourceMetrics[0].ScopeMetrics[0].Metrics
+		require.Len(t, m, 1)
+		assert.Equal(t, "requests", m[0].Name)
+		return m[0].GetSum().DataPoints[0].GetAsInt()
+	}
+
+	ctr.Add(t.Context(), 3)
+	msg, contentType := pull("")
+	assert.Equal(t, contentTypeProto, contentType)
+	assert.Equal(t, int64(3), sum(msg))
+
+	ctr.Add(t.Context(), 2)
+	msg, contentType = pull("application/json, application/x-protobuf;q=0.5")
+	assert.Equal(t, contentTypeJSON, contentType)
+	assert.Equal(t, int64(2), sum(msg), "delta temporality not preserved")
+
+	ctr.Add(t.Context(), 1)
+	msg, contentType = pull("text/plain, application/x-protobuf, application/json")
+	assert.Equal(t, contentTypeProto, contentType)
+	assert.Equal(t, int64(1), sum(msg))
+}
+
+func TestPullHandlerOTLPJSON(t *testing.T) {
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	t.Cleanup(func() { require.NoError(t, mp.Shutdown(t.Context())) })
+
+	ctr, err := mp.Meter(t.Name()).Int64Counter("requests")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		SpanID:     trace.SpanID{1, 2, 3, 4, 5, 6, 7, 8},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctr.Add(trace.ContextWithSpanContext(t.Context(), sc), 1)
+
+	req := httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody)
+	req.Header.Set("Accept", contentTypeJSON)
+	rec := httptest.NewRecorder()
+	NewPullHandler(reader).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, contentTypeJSON, rec.Header().Get("Content-Type"))
+
+	var got struct {
+		ResourceMetrics []struct {
+			ScopeMetrics []struct {
+				Metrics []struct {
+					Sum struct {
+						AggregationTemporality int `json:"aggregationTemporality"`
+						DataPoints             []struct {
+							Exemplars []struct {
+								TraceID string `json:"traceId"`
+								SpanID  string `json:"spanId"`
+							} `json:"exemplars"`
+						} `json:"dataPoints"`
+					} `json:"sum"`
+				} `json:"metrics"`
+			} `json:"scopeMetrics"`
+		} `json:"resourceMetrics"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+	require.Len(t, got.ResourceMetrics, 1)
+	require.Len(t, got.ResourceMetrics[0].ScopeMetrics, 1)
+	require.Len(t, got.ResourceMetrics[0].ScopeMetrics[0].Metrics, 1)
+	sum := got.ResourceMetrics[0].ScopeMetrics[0].Metrics[0].Sum
+	assert.Equal(t, int(metricpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE), sum.AggregationTemporality)
+	require.Len(t, sum.DataPoints, 1)
+	require.Len(t, sum.DataPoints[0].Exemplars, 1)
+	assert.Equal(t, "0102030405060708090a0b0c0d0e0f10", sum.DataPoints[0].Exemplars[0].TraceID)
+	assert.Equal(t, "0102030405060708", sum.DataPoints[0].Exemplars[0].SpanID)
+}
+
+func TestPullHandlerMethodNotAllowed(t *testing.T) {
+	h := NewPullHandler(metric.NewManualReader())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/metrics", http.NoBody))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	assert.Equal(t, http.MethodGet, rec.Header().Get("Allow"))
+}
+
+func TestPullHandlerCollectError(t *testing.T) {
+	// An unregistered reader cannot collect.
+	h := NewPullHandler(metric.NewManualReader())
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", http.NoBody))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestNegotiate(t *testing.T) {
+	for accept, want := range map[string]string{
+		"":                               contentTypeProto,
+		"*/*":                            contentTypeProto,
+		"application/json":               contentTypeJSON,
+		"application/json; charset=utf8": contentTypeJSON,
+		"text/html, application/json":    contentTypeJSON,
+		"application/protobuf":           contentTypeProto,
+		"invalid;;, application/json":    contentTypeJSON,
+	} {
+		assert.Equal(t, want, negotiate(accept), "Accept: %q", accept)
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/attribute.go.tmpl "--data={}" --out=attribute.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/attribute_test.go.tmpl "--data={}" --out=attribute_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/error.go.tmpl "--data={}" --out=error.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/error_test.go.tmpl "--data={}" --out=error_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/metricdata.go.tmpl "--data={}" --out=metricdata.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/metricdata_fuzz_test.go.tmpl "--data={}" --out=metricdata_fuzz_test.go
+//go:generate gotmpl --body=../../../../internal/shared/otlp/otlpmetric/transform/metricdata_test.go.tmpl "--data={}" --out=metricdata_test.go
@@ -0,0 +1,415 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"fmt"
+	"time"
+
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// FromResourceMetrics returns the metricdata ResourceMetrics generated from
+// the OTLP rm. It is the inverse of [ResourceMetrics].
+//
+// The value type of gauges and sums is int64 if all their data points and
+// exemplars hold integer values, and float64 otherwise. The value type of
+// histograms and exponential histograms is always float64 as OTLP encodes
+// their sums and extrema as doubles.
+//
+// If rm contains invalid metrics, an error will be returned along with a
+// ResourceMetrics that contains the valid metrics.
+func FromResourceMetrics(rm *mpb.ResourceMetrics) (*metricdata.ResourceMetrics, error) {
+	if rm == nil {
+		return &metricdata.ResourceMetrics{Resource: resource.Empty()}, nil
+	}
+	sms, err := FromScopeMetrics(rm.ScopeMetrics)
+	return &metricdata.ResourceMetrics{
+		Resource:     resource.NewWithAttributes(rm.SchemaUrl, FromKeyValues(rm.GetResource().GetAttributes())...),
+		ScopeMetrics: sms,
+	}, err
+}
+
+// FromScopeMetrics returns the metricdata ScopeMetrics generated from the OTLP
+// sms. It is the inverse of [ScopeMetrics]. If sms contains invalid metrics,
+// an error will be returned along with a slice that contains the valid
+// metrics.
+func FromScopeMetrics(sms []*mpb.ScopeMetrics) ([]metricdata.ScopeMetrics, error) {
+	errs := &multiErr{datatype: "ScopeMetrics"}
+	out := make([]metricdata.ScopeMetrics, 0, len(sms))
+	for _, sm := range sms {
+		if sm == nil {
+			continue
+		}
+		ms, err := FromMetrics(sm.Metrics)
+		if err != nil {
+			errs.append(err)
+		}
+
+		out = append(out, metricdata.ScopeMetrics{
+			Scope: instrumentation.Scope{
+				Name:       sm.GetScope().GetName(),
+				Version:    sm.GetScope().GetVersion(),
+				SchemaURL:  sm.SchemaUrl,
+				Attributes: attribute.NewSet(FromKeyValues(sm.GetScope().GetAttributes())...),
+			},
+			Metrics: ms,
+		})
+	}
+	return out, errs.errOrNil()
+}
+
+// FromMetrics returns the metricdata Metrics generated from the OTLP ms. It
+// is the inverse of [Metrics]. If ms contains invalid metrics, they are not
+// included in the returned slice and an error is returned.
+func FromMetrics(ms []*mpb.Metric) ([]metricdata.Metrics, error) {
+	errs := &multiErr{datatype: "Metrics"}
+	out := make([]metricdata.Metrics, 0, len(ms))
+	for _, m := range ms {
+		if m == nil {
+			continue
+		}
+		o, err := fromMetric(m)
+		if err != nil {
+			// Do not include invalid data. Drop the metric, report the error.
+			errs.append(errMetric{m: m, err: err})
+			continue
+		}
+		out = append(out, o)
+	}
+	return out, errs.errOrNil()
+}
+
+func fromMetric(m *mpb.Metric) (metricdata.Metrics, error) {
+	var err error
+	out := metricdata.Metrics{
+		Name:        m.Name,
+		Description: m.Description,
+		Unit:        m.Unit,
+	}
+	switch a := m.Data.(type) {
+	case *mpb.Metric_Gauge:
+		pts := a.Gauge.GetDataPoints()
+		if isInt(pts) {
+			out.Data = metricdata.Gauge[int64]{DataPoints: fromDataPoints[int64](pts)}
+		} else {
+			out.Data = metricdata.Gauge[float64]{DataPoints: fromDataPoints[float64](pts)}
+		}
+	case *mpb.Metric_Sum:
+		var t metricdata.Temporality
+		t, err = FromTemporality(a.Sum.GetAggregationTemporality())
+		pts := a.Sum.GetDataPoints()
+		if isInt(pts) {
+			out.Data = metricdata.Sum[int64]{
+				DataPoints:  fromDataPoints[int64](pts),
+				Temporality: t,
+				IsMonotonic: a.Sum.GetIsMonotonic(),
+			}
+		} else {
+			out.Data = metricdata.Sum[float64]{
+				DataPoints:  fromDataPoints[float64](pts),
+				Temporality: t,
+				IsMonotonic: a.Sum.GetIsMonotonic(),
+			}
+		}
+	case *mpb.Metric_Histogram:
+		var t metricdata.Temporality
+		t, err = FromTemporality(a.Histogram.GetAggregationTemporality())
+		out.Data = metricdata.Histogram[float64]{
+			DataPoints:  fromHistogramDataPoints(a.Histogram.GetDataPoints()),
+			Temporality: t,
+		}
+	case *mpb.Metric_ExponentialHistogram:
+		var t metricdata.Temporality
+		t, err = FromTemporality(a.ExponentialHistogram.GetAggregationTemporality())
+		out.Data = metricdata.ExponentialHistogram[float64]{
+			DataPoints:  fromExponentialHistogramDataPoints(a.ExponentialHistogram.GetDataPoints()),
+			Temporality: t,
+		}
+	case *mpb.Metric_Summary:
+		out.Data = metricdata.Summary{
+			DataPoints: fromSummaryDataPoints(a.Summary.GetDataPoints()),
+		}
+	default:
+		return out, fmt.Errorf("%w: %T", errUnknownAggregation, a)
+	}
+	return out, err
+}
+
+// FromTemporality returns the metricdata Temporality generated from the OTLP
+// t. If t is unknown, an error is returned along with the undefined
+// Temporality.
+func FromTemporality(t mpb.AggregationTemporality) (metricdata.Temporality, error) {
+	switch t {
+	case mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA:
+		return metricdata.DeltaTemporality, nil
+	case mpb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE:
+		return metricdata.CumulativeTemporality, nil
+	default:
+		return metricdata.Temporality(0), fmt.Errorf("%w: %s", errUnknownTemporality, t)
+	}
+}
+
+// isInt returns true if all the values of dPts and their exemplars are
+// integers.
+func isInt(dPts []*mpb.NumberDataPoint) bool {
+	for _, dPt := range dPts {
+		if _, ok := dPt.GetValue().(*mpb.NumberDataPoint_AsDouble); ok {
+			return false
+		}
+		for _, e := range dPt.GetExemplars() {
+			if _, ok := e.GetValue().(*mpb.Exemplar_AsDouble); ok {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func fromDataPoints[N int64 | float64](dPts []*mpb.NumberDataPoint) []metricdata.DataPoint[N] {
+	out := make([]metricdata.DataPoint[N], 0, len(dPts))
+	for _, dPt := range dPts {
+		if dPt == nil {
+			continue
+		}
+		var v N
+		switch val := dPt.Value.(type) {
+		case *mpb.NumberDataPoint_AsInt:
+			v = N(val.AsInt)
+		case *mpb.NumberDataPoint_AsDouble:
+			v = N(val.AsDouble)
+		}
+		out = append(out, metricdata.DataPoint[N]{
+			Attributes: attribute.NewSet(FromKeyValues(dPt.Attributes)...),
+			StartTime:  fromUnixNano(dPt.StartTimeUnixNano),
+			Time:       fromUnixNano(dPt.TimeUnixNano),
+			Value:      v,
+			Exemplars:  fromExemplars[N](dPt.Exemplars),
+		})
+	}
+	return out
+}
+
+func fromHistogramDataPoints(dPts []*mpb.HistogramDataPoint) []metricdata.HistogramDataPoint[float64] {
+	out := make([]metricdata.HistogramDataPoint[float64], 0, len(dPts))
+	for _, dPt := range dPts {
+		if dPt == nil {
+			continue
+		}
+		hdp := metricdata.HistogramDataPoint[float64]{
+			Attributes:   attribute.NewSet(FromKeyValues(dPt.Attributes)...),
+			StartTime:    fromUnixNano(dPt.StartTimeUnixNano),
+			Time:         fromUnixNano(dPt.TimeUnixNano),
+			Count:        dPt.Count,
+			Bounds:       dPt.ExplicitBounds,
+			BucketCounts: dPt.BucketCounts,
+			Sum:          dPt.GetSum(),
+			Exemplars:    fromExemplars[float64](dPt.Exemplars),
+		}
+		if dPt.Min != nil {
+			hdp.Min = metricdata.NewExtrema(*dPt.Min)
+		}
+		if dPt.Max != nil {
+			hdp.Max = metricdata.NewExtrema(*dPt.Max)
+		}
+		out = append(out, hdp)
+	}
+	return out
+}
+
+func fromExponentialHistogramDataPoints(
+	dPts []*mpb.ExponentialHistogramDataPoint,
+) []metricdata.ExponentialHistogramDataPoint[float64] {
+	out := make([]metricdata.ExponentialHistogramDataPoint[float64], 0, len(dPts))
+	for _, dPt := range dPts {
+		if dPt == nil {
+			continue
+		}
+		ehdp := metricdata.ExponentialHistogramDataPoint[float64]{
+			Attributes:     attribute.NewSet(FromKeyValues(dPt.Attributes)...),
+			StartTime:      fromUnixNano(dPt.StartTimeUnixNano),
+			Time:           fromUnixNano(dPt.TimeUnixNano),
+			Count:          dPt.Count,
+			Sum:            dPt.GetSum(),
+			Scale:          dPt.Scale,
+			ZeroCount:      dPt.ZeroCount,
+			ZeroThreshold:  dPt.ZeroThreshold,
+			PositiveBucket: fromExponentialBucket(dPt.Positive),
+			NegativeBucket: fromExponentialBucket(dPt.Negative),
+			Exemplars:      fromExemplars[float64](dPt.Exemplars),
+		}
+		if dPt.Min != nil {
+			ehdp.Min = metricdata.NewExtrema(*dPt.Min)
+		}
+		if dPt.Max != nil {
+			ehdp.Max = metricdata.NewExtrema(*dPt.Max)
+		}
+		out = append(out, ehdp)
+	}
+	return out
+}
+
+func fromExponentialBucket(b *mpb.ExponentialHistogramDataPoint_Buckets) metricdata.ExponentialBucket {
+	return metricdata.ExponentialBucket{
+		Offset: b.GetOffset(),
+		Counts: b.GetBucketCounts(),
+	}
+}
+
+func fromSummaryDataPoints(dPts []*mpb.SummaryDataPoint) []metricdata.SummaryDataPoint {
+	out := make([]metricdata.SummaryDataPoint, 0, len(dPts))
+	for _, dPt := range dPts {
+		if dPt == nil {
+			continue
+		}
+		qs := make([]metricdata.QuantileValue, 0, len(dPt.QuantileValues))
+		for _, q := range dPt.QuantileValues {
+			if q == nil {
+				continue
+			}
+			qs = append(qs, metricdata.QuantileValue{Quantile: q.Quantile, Value: q.Value})
+		}
+		out = append(out, metricdata.SummaryDataPoint{
+			Attributes:     attribute.NewSet(FromKeyValues(dPt.Attributes)...),
+			StartTime:      fromUnixNano(dPt.StartTimeUnixNano),
+			Time:           fromUnixNano(dPt.TimeUnixNano),
+			Count:          dPt.Count,
+			Sum:            dPt.Sum,
+			QuantileValues: qs,
+		})
+	}
+	return out
+}
+
+func fromExemplars[N int64 | float64](exemplars []*mpb.Exemplar) []metricdata.Exemplar[N] {
+	out := make([]metricdata.Exemplar[N], 0, len(exemplars))
+	for _, e := range exemplars {
+		if e == nil {
+			continue
+		}
+		var v N
+		switch val := e.Value.(type) {
+		case *mpb.Exemplar_AsInt:
+			v = N(val.AsInt)
+		case *mpb.Exemplar_AsDouble:
+			v = N(val.AsDouble)
+		}
+		out = append(out, metricdata.Exemplar[N]{
+			FilteredAttributes: FromKeyValues(e.FilteredAttributes),
+			Time:               fromUnixNano(e.TimeUnixNano),
+			Value:              v,
+			SpanID:             e.SpanId,
+			TraceID:            e.TraceId,
+		})
+	}
+	return out
+}
+
+// fromUnixNano returns the time for an OTLP timestamp. A zero timestamp is
+// returned as the zero time.
+func fromUnixNano(ns uint64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(ns)) // nolint:gosec // Overflow is not expected before year 2262.
+}
+
+// FromKeyValues transforms OTLP key-values into attribute KeyValues. It is the
+// inverse of [KeyValues].
+func FromKeyValues(kvs []*cpb.KeyValue) []attribute.KeyValue {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	out := make([]attribute.KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		if kv == nil {
+			continue
+		}
+		out = append(out, attribute.KeyValue{
+			Key:   attribute.Key(kv.Key),
+			Value: FromValue(kv.Value),
+		})
+	}
+	return out
+}
+
+// FromValue transforms an OTLP AnyValue into an attribute Value. It is the
+// inverse of [Value].
+//
+// OTLP arrays with values all of the same boolean, integer, double, or string
+// type are transformed into the matching attribute slice type. Other arrays
+// are transformed into an attribute SLICE.
+func FromValue(v *cpb.AnyValue) attribute.Value {
+	if v == nil {
+		return attribute.Value{}
+	}
+	switch val := v.Value.(type) {
+	case *cpb.AnyValue_BoolValue:
+		return attribute.BoolValue(val.BoolValue)
+	case *cpb.AnyValue_IntValue:
+		return attribute.Int64Value(val.IntValue)
+	case *cpb.AnyValue_DoubleValue:
+		return attribute.Float64Value(val.DoubleValue)
+	case *cpb.AnyValue_StringValue:
+		return attribute.StringValue(val.StringValue)
+	case *cpb.AnyValue_BytesValue:
+		return attribute.ByteSliceValue(val.BytesValue)
+	case *cpb.AnyValue_ArrayValue:
+		return fromArray(val.ArrayValue.GetValues())
+	case *cpb.AnyValue_KvlistValue:
+		return attribute.MapValue(FromKeyValues(val.KvlistValue.GetValues())...)
+	}
+	return attribute.Value{}
+}
+
+// fromArray transforms the values of an OTLP ArrayValue into an attribute
+// Value.
+func fromArray(vals []*cpb.AnyValue) attribute.Value {
+	converted := make([]attribute.Value, len(vals))
+	typ := attribute.INVALID
+	for i, v := range vals {
+		converted[i] = FromValue(v)
+		switch {
+		case i == 0:
+			typ = converted[i].Type()
+		case typ != converted[i].Type():
+			typ = attribute.INVALID
+		}
+	}
+
+	switch typ {
+	case attribute.BOOL:
+		s := make([]bool, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsBool()
+		}
+		return attribute.BoolSliceValue(s)
+	case attribute.INT64:
+		s := make([]int64, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsInt64()
+		}
+		return attribute.Int64SliceValue(s)
+	case attribute.FLOAT64:
+		s := make([]float64, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsFloat64()
+		}
+		return attribute.Float64SliceValue(s)
+	case attribute.STRING:
+		s := make([]string, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsString()
+		}
+		return attribute.StringSliceValue(s)
+	}
+	return attribute.SliceValue(converted...)
+}
@@ -0,0 +1,158 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package transform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cpb "go.opentelemetry.io/proto/otlp/common/v1"
+	mpb "go.opentelemetry.io/proto/otlp/metrics/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func TestFromMetrics(t *testing.T) {
+	tests := []struct {
+		name string
+		pb   *mpb.Metric
+		want metricdata.Aggregation
+	}{
+		{
+			name: "Int64Gauge",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Gauge{Gauge: pbGaugeInt64}},
+			want: otelGaugeInt64,
+		},
+		{
+			name: "Float64Gauge",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Gauge{Gauge: pbGaugeFloat64}},
+			want: otelGaugeFloat64,
+		},
+		{
+			name: "ZeroStartTimeGauge",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Gauge{Gauge: pbGaugeZeroStartTime}},
+			want: otelGaugeZeroStartTime,
+		},
+		{
+			name: "Int64Sum",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Sum{Sum: pbSumInt64}},
+			want: otelSumInt64,
+		},
+		{
+			name: "Float64Sum",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Sum{Sum: pbSumFloat64}},
+			want: otelSumFloat64,
+		},
+		{
+			name: "Histogram",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Histogram{Histogram: pbHistFloat64}},
+			want: otelHistFloat64,
+		},
+		{
+			name: "ExponentialHistogram",
+			pb:   &mpb.Metric{Data: &mpb.Metric_ExponentialHistogram{ExponentialHistogram: pbExpoHistFloat64}},
+			want: otelExpoHistFloat64,
+		},
+		{
+			name: "Summary",
+			pb:   &mpb.Metric{Data: &mpb.Metric_Summary{Summary: pbSummary}},
+			want: otelSummary,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.pb.Name, tt.pb.Description, tt.pb.Unit = "name", "description", "1"
+			got, err := FromMetrics([]*mpb.Metric{tt.pb})
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, "name", got[0].Name)
+			assert.Equal(t, "description", got[0].Description)
+			assert.Equal(t, "1", got[0].Unit)
+			metricdatatest.AssertAggregationsEqual(t, tt.want, got[0].Data)
+		})
+	}
+}
+
+func TestFromMetricsInvalid(t *testing.T) {
+	got, err := FromMetrics([]*mpb.Metric{
+		{Name: "unknown"},
+		{Name: "invalid", Data: &mpb.Metric_Sum{Sum: &mpb.Sum{}}},
+		{Name: "valid", Data: &mpb.Metric_Gauge{Gauge: pbGaugeInt64}},
+	})
+	assert.ErrorIs(t, err, errUnknownAggregation)
+	assert.ErrorIs(t, err, errUnknownTemporality)
+	require.Len(t, got, 1)
+	assert.Equal(t, "valid", got[0].Name)
+}
+
+func TestFromResourceMetrics(t *testing.T) {
+	scope := instrumentation.Scope{
+		Name:       "scope",
+		Version:    "v0.1.0",
+		SchemaURL:  "https://example.com/scope",
+		Attributes: attribute.NewSet(attribute.String("key", "value")),
+	}
+	want := metricdata.ResourceMetrics{
+		Resource: otelRes,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: scope,
+			Metrics: []metricdata.Metrics{{
+				Name:        "gauge",
+				Description: "description",
+				Unit:        "1",
+				Data:        otelGaugeFloat64,
+			}},
+		}},
+	}
+
+	pb, err := ResourceMetrics(&want)
+	require.NoError(t, err)
+	got, err := FromResourceMetrics(pb)
+	require.NoError(t, err)
+	metricdatatest.AssertEqual(t, want, *got)
+	assert.Equal(t, otelRes.SchemaURL(), got.Resource.SchemaURL())
+}
+
+func TestFromResourceMetricsNil(t *testing.T) {
+	got, err := FromResourceMetrics(nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, got.Resource.Len())
+	assert.Empty(t, got.ScopeMetrics)
+}
+
+func TestFromValue(t *testing.T) {
+	tests := []struct {
+		name string
+		v    attribute.Value
+	}{
+		{"Empty", attribute.Value{}},
+		{"Bool", attribute.BoolValue(true)},
+		{"BoolSlice", attribute.BoolSliceValue([]bool{true, false})},
+		{"Int64", attribute.Int64Value(1)},
+		{"Int64Slice", attribute.Int64SliceValue([]int64{1, 2})},
+		{"Float64", attribute.Float64Value(1.5)},
+		{"Float64Slice", attribute.Float64SliceValue([]float64{1.5, 2.5})},
+		{"String", attribute.StringValue("value")},
+		{"StringSlice", attribute.StringSliceValue([]string{"a", "b"})},
+		{"ByteSlice", attribute.ByteSliceValue([]byte("value"))},
+		{"Slice", attribute.SliceValue(attribute.StringValue("a"), attribute.Int64Value(1))},
+		{"Map", attribute.MapValue(attribute.String("key", "value"), attribute.Int("int", 1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.v, FromValue(Value(tt.v)))
+		})
+	}
+}
+
+func TestFromValueNil(t *testing.T) {
+	assert.Equal(t, attribute.Value{}, FromValue(nil))
+	assert.Equal(t, attribute.Value{}, FromValue(&cpb.AnyValue{}))
+}
@@ -5,6 +5,7 @@
 Package otlptrace contains abstractions for OTLP span exporters.
 See the official OTLP span exporter implementations:
   - [go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc],
-  - [go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp].
+  - [go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp],
+  - [go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracewriter].
 */
 package otlptrace
@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"sync"
 
-	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/internal/tracetransform"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/tracetransform"
 	tracesdk "go.opentelemetry.io/otel/sdk/trace"
 )
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"sync"
 	"time"
 
@@ -25,6 +26,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/observ"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
+	"go.opentelemetry.io/otel/metric"
 )
 
 type client struct {
@@ -35,6 +37,27 @@ type client struct {
 	maxRequestSize int
 	requestFunc    retry.RequestFunc
 
+	// dryRun configures exports to be serialized and written to dryRunSink
+	// instead of being sent.
+	dryRun     bool
+	dryRunSink io.Writer
+
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured. It is created in Start using queueDir
+	// and queueMaxBytes.
+	queueDir      string
+	queueMaxBytes int64
+	queue         *internal.PersistentQueue
+
+	// responseHandler is called with the metadata of the export responses,
+	// if not nil.
+	responseHandler func(header, trailer map[string][]string)
+
+	// securityProtocol is the security protocol of the credentials of conn,
+	// if known, and attempts the last export attempts.
+	securityProtocol string
+	attempts         *internal.AttemptLog[ExportAttempt]
+
 	// stopCtx is used as a parent context for all exports. Therefore, when it
 	// is canceled with the stopFunc all exports are canceled.
 	stopCtx context.Context
@@ -50,8 +73,14 @@ type client struct {
 	tscMu   sync.RWMutex
 	tsc     coltracepb.TraceServiceClient
 
-	instID int64
-	inst   *observ.Instrumentation
+	// meterProvider is used to record self-observability metrics, if not
+	// nil.
+	meterProvider metric.MeterProvider
+	instID        int64
+	inst          *observ.Instrumentation
+	// noPayloadSizeMetrics disables the recording of the size of the
+	// requests and responses by inst.
+	noPayloadSizeMetrics bool
 }
 
 // Compile time check *client implements otlptrace.Client.
@@ -68,15 +97,33 @@ func newClient(opts ...Option) *client {
 	ctx, cancel := context.WithCancel(context.Background()) //nolint:gosec  // cancel called in client shutdown.
 
 	c := &client{
-		endpoint:       cfg.Traces.Endpoint,
-		exportTimeout:  cfg.Traces.Timeout,
-		maxRequestSize: cfg.Traces.MaxRequestSize,
-		requestFunc:    cfg.RetryConfig.RequestFunc(retryable),
-		dialOpts:       cfg.DialOptions,
-		stopCtx:        ctx,
-		stopFunc:       cancel,
-		conn:           cfg.GRPCConn,
-		instID:         counter.NextExporterID(),
+		endpoint:        cfg.Traces.Endpoint,
+		exportTimeout:   cfg.Traces.Timeout,
+		maxRequestSize:  cfg.Traces.MaxRequestSize,
+		requestFunc:     cfg.RetryConfig.RequestFunc(retryable),
+		dryRun:          cfg.Traces.DryRun,
+		dryRunSink:      cfg.Traces.DryRunSink,
+		queueDir:        cfg.Traces.PersistentQueueDir,
+		queueMaxBytes:   cfg.Traces.PersistentQueueMaxBytes,
+		responseHandler: cfg.Traces.ResponseHandler,
+		dialOpts:        cfg.DialOptions,
+		stopCtx:         ctx,
+		stopFunc:        cancel,
+		conn:            cfg.GRPCConn,
+		meterProvider:   cfg.Traces.MeterProvider,
+		instID:          counter.NextExporterID(),
+		attempts:        internal.NewAttemptLog[ExportAttempt](debugAttempts),
+
+		noPayloadSizeMetrics: cfg.NoPayloadSizeMetrics,
+	}
+	switch {
+	case c.conn != nil:
+		// The credentials of a connection passed with WithGRPCConn are
+		// unknown.
+	case cfg.Traces.GRPCCredentials != nil:
+		c.securityProtocol = cfg.Traces.GRPCCredentials.Info().SecurityProtocol
+	default:
+		c.securityProtocol = "insecure"
 	}
 
 	if len(cfg.Traces.Headers) > 0 {
@@ -88,6 +135,14 @@ func newClient(opts ...Option) *client {
 
 // Start establishes a gRPC connection to the collector.
 func (c *client) Start(context.Context) error {
+	if c.queueDir != "" && c.queue == nil {
+		q, err := internal.NewPersistentQueue(c.queueDir, c.queueMaxBytes, persistable)
+		if err != nil {
+			return err
+		}
+		c.queue = q
+	}
+
 	if c.conn == nil {
 		// If the caller did not provide a ClientConn when the client was
 		// created, create one using the configuration they did provide.
@@ -109,7 +164,7 @@ func (c *client) Start(context.Context) error {
 	var err error
 	if c.inst == nil {
 		target := c.conn.CanonicalTarget()
-		c.inst, err = observ.NewInstrumentation(c.instID, target)
+		c.inst, err = observ.NewInstrumentation(c.meterProvider, c.instID, target)
 	}
 
 	// The otlptrace.Client interface states this method is called just once,
@@ -175,6 +230,11 @@ func (c *client) Stop(ctx context.Context) error {
 		return errAlreadyStopped
 	}
 
+	// Stop the replay of the persisted requests before c.tsc is cleared.
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
+
 	// Clear c.tsc to signal the client is stopped.
 	c.tsc = nil
 
@@ -229,24 +289,101 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		return fmt.Errorf("request message too large: exceeded %d bytes", maxSize)
 	}
 
-	return c.requestFunc(ctx, func(iCtx context.Context) error {
-		resp, err := c.tsc.Export(iCtx, pbRequest)
-		if resp != nil && resp.PartialSuccess != nil {
-			msg := resp.PartialSuccess.GetErrorMessage()
-			n := resp.PartialSuccess.GetRejectedSpans()
-			if n != 0 || msg != "" {
-				e := internal.TracePartialSuccessError(n, msg)
-				uploadErr = errors.Join(uploadErr, e)
+	if c.dryRun {
+		return internal.DryRunMessage(c.dryRunSink, pbRequest)
+	}
+
+	// newSend returns a function sending a request and setting code to the
+	// status code of its last attempt.
+	newSend := func(code *codes.Code) func(context.Context, *coltracepb.ExportTraceServiceRequest) error {
+		return func(ctx context.Context, pbRequest *coltracepb.ExportTraceServiceRequest) error {
+			var partialErr error
+			// reqSize is the size of the request recorded by inst, or -1 if
+			// the sizes are not recorded.
+			reqSize := int64(-1)
+			if c.inst != nil && !c.noPayloadSizeMetrics && c.inst.PayloadSizeEnabled(ctx) {
+				reqSize = int64(proto.Size(pbRequest))
 			}
+			return c.requestFunc(ctx, func(iCtx context.Context) error {
+				var header, trailer metadata.MD
+				var callOpts []grpc.CallOption
+				if c.responseHandler != nil {
+					callOpts = []grpc.CallOption{grpc.Header(&header), grpc.Trailer(&trailer)}
+				}
+				start := time.Now()
+				resp, err := c.tsc.Export(iCtx, pbRequest, callOpts...)
+				c.attempts.Add(ExportAttempt{
+					Time:     start,
+					Duration: time.Since(start),
+					Code:     status.Code(err),
+					Err:      err,
+				})
+				if reqSize >= 0 {
+					respSize := int64(-1)
+					if resp != nil {
+						respSize = int64(proto.Size(resp))
+					}
+					c.inst.RecordPayloadSize(iCtx, reqSize, respSize, status.Code(err))
+				}
+				if c.responseHandler != nil {
+					c.responseHandler(header, trailer)
+				}
+				if resp != nil && resp.PartialSuccess != nil {
+					msg := resp.PartialSuccess.GetErrorMessage()
+					n := resp.PartialSuccess.GetRejectedSpans()
+					if n != 0 || msg != "" {
+						e := internal.TracePartialSuccessError(n, msg)
+						partialErr = errors.Join(partialErr, e)
+					}
+				}
+				// nil is converted to OK.
+				*code = status.Code(err)
+				if *code == codes.OK {
+					// Success.
+					return partialErr
+				}
+				return errors.Join(partialErr, err)
+			})
 		}
-		// nil is converted to OK.
-		code = status.Code(err)
-		if code == codes.OK {
-			// Success.
-			return uploadErr
+	}
+	send := newSend(&code)
+
+	if c.queue != nil {
+		rawRequest, err := proto.Marshal(pbRequest)
+		if err != nil {
+			return err
 		}
-		return errors.Join(uploadErr, err)
-	})
+		sendRaw := func(send func(context.Context, *coltracepb.ExportTraceServiceRequest) error) func(context.Context, []byte) error {
+			return func(ctx context.Context, b []byte) error {
+				req := new(coltracepb.ExportTraceServiceRequest)
+				if err := proto.Unmarshal(b, req); err != nil {
+					return err
+				}
+				return send(ctx, req)
+			}
+		}
+		// The persisted requests are replayed after this export returns, with
+		// their own status code.
+		return c.queue.Export(ctx, rawRequest, sendRaw(send), sendRaw(newSend(new(codes.Code))))
+	}
+	return send(ctx, pbRequest)
+}
+
+// debugInfo returns the DebugInfo of c.
+func (c *client) debugInfo() DebugInfo {
+	info := DebugInfo{
+		Endpoint:         c.endpoint,
+		SecurityProtocol: c.securityProtocol,
+		Attempts:         c.attempts.Attempts(),
+	}
+	c.tscMu.RLock()
+	// conn is set before tsc when the client starts.
+	if c.tsc != nil {
+		info.Endpoint = c.conn.CanonicalTarget()
+		info.ConnectivityState = c.conn.GetState().String()
+	}
+	c.tscMu.RUnlock()
+	return info
 }
 
 // exportContext returns a copy of parent with an appropriate deadline and
@@ -316,6 +453,13 @@ func retryableGRPCStatus(s *status.Status) (bool, time.Duration) {
 	return false, 0
 }
 
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	ok, _ := retryable(err)
+	return ok
+}
+
 // throttleDelay returns of the status is RetryInfo
 // and the its duration to wait for if an explicit throttle time.
 func throttleDelay(s *status.Status) (bool, time.Duration) {
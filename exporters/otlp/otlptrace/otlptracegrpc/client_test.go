@@ -9,6 +9,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"google.golang.org/grpc/encoding/gzip"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -41,6 +44,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -470,6 +474,73 @@ func TestPartialSuccess(t *testing.T) {
 	assert.ErrorIs(t, err, want)
 }
 
+func TestResponseMetadataHandler(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors:  []error{status.Error(codes.Unavailable, "unavailable")},
+		header:  metadata.Pairs("x-ratelimit-remaining", "10"),
+		trailer: metadata.Pairs("server-version", "1.2.3"),
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	type response struct{ header, trailer metadata.MD }
+	var got []response
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		otlptracegrpc.WithResponseMetadataHandler(func(header, trailer metadata.MD) {
+			got = append(got, response{header, trailer})
+		}),
+	)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for _, r := range got {
+		assert.Equal(t, []string{"10"}, r.header.Get("x-ratelimit-remaining"))
+		assert.Equal(t, []string{"1.2.3"}, r.trailer.Get("server-version"))
+	}
+}
+
+func TestClientDebugInfo(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.Unavailable, "unavailable")},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	client := otlptracegrpc.NewClient(
+		otlptracegrpc.WithInsecure(),
+		otlptracegrpc.WithEndpoint(mc.endpoint),
+		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+	)
+	info, ok := otlptracegrpc.ClientDebugInfo(client)
+	require.True(t, ok)
+	assert.Equal(t, mc.endpoint, info.Endpoint)
+	assert.Equal(t, "insecure", info.SecurityProtocol)
+	assert.Empty(t, info.ConnectivityState, "not started")
+	assert.Empty(t, info.Attempts)
+
+	exp, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+
+	info, ok = otlptracegrpc.ClientDebugInfo(client)
+	require.True(t, ok)
+	assert.Contains(t, info.Endpoint, mc.endpoint)
+	assert.Equal(t, "READY", info.ConnectivityState)
+	require.Len(t, info.Attempts, 2)
+	assert.Equal(t, codes.Unavailable, info.Attempts[0].Code)
+	assert.Error(t, info.Attempts[0].Err)
+	assert.Equal(t, codes.OK, info.Attempts[1].Code)
+	assert.NoError(t, info.Attempts[1].Err)
+	assert.False(t, info.Attempts[1].Time.Before(info.Attempts[0].Time))
+
+	_, ok = otlptracegrpc.ClientDebugInfo(nil)
+	assert.False(t, ok)
+}
+
 func TestCustomUserAgent(t *testing.T) {
 	customUserAgent := "custom-user-agent"
 	mc := runMockCollector(t)
@@ -485,6 +556,63 @@ func TestCustomUserAgent(t *testing.T) {
 	require.Contains(t, headers.Get("user-agent")[0], customUserAgent)
 }
 
+func TestClientWithSelfObservability(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, the option enables observability.
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	exp := newGRPCExporter(t, t.Context(), mc.endpoint, otlptracegrpc.WithSelfObservability(mp))
+	localSpans := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(t.Context(), localSpans))
+	require.NoError(t, exp.Shutdown(t.Context()))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, observ.ScopeName, got.ScopeMetrics[0].Scope.Name)
+
+	var names []string
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.Contains(t, names, otelconv.SDKExporterSpanExported{}.Name())
+	assert.Contains(t, names, otelconv.SDKExporterOperationDuration{}.Name())
+	assert.Contains(t, names, observ.RequestSizeName)
+	assert.Contains(t, names, observ.ResponseSizeName)
+}
+
+func TestClientWithoutPayloadSizeMetrics(t *testing.T) {
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+
+	mc := runMockCollector(t)
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	exp := newGRPCExporter(
+		t, t.Context(), mc.endpoint,
+		otlptracegrpc.WithSelfObservability(mp),
+		otlptracegrpc.WithoutPayloadSizeMetrics(),
+	)
+	localSpans := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(t.Context(), localSpans))
+	require.NoError(t, exp.Shutdown(t.Context()))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+
+	var names []string
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.Contains(t, names, otelconv.SDKExporterSpanExported{}.Name())
+	assert.NotContains(t, names, observ.RequestSizeName)
+	assert.NotContains(t, names, observ.ResponseSizeName)
+}
+
 func TestClientInstrumentation(t *testing.T) {
 	// Enable instrumentation for this test.
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
@@ -573,11 +701,39 @@ func TestClientInstrumentation(t *testing.T) {
 					Temporality: 0x1,
 				},
 			},
+			{
+				Name:        observ.RequestSizeName,
+				Description: "Measures the size of RPC request messages (uncompressed).",
+				Unit:        "By",
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.RPCResponseStatusCode(codes.OK.String()),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
+			{
+				Name:        observ.ResponseSizeName,
+				Description: "Measures the size of RPC response messages (uncompressed).",
+				Unit:        "By",
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.RPCResponseStatusCode(codes.OK.String()),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
 		},
 	}
 	require.Len(t, got.ScopeMetrics, 1)
 	gotMetrics := got.ScopeMetrics[0].Metrics
-	require.Len(t, gotMetrics, 3)
+	require.Len(t, gotMetrics, 5)
 
 	metricdatatest.AssertEqual(t, want.Metrics[0], gotMetrics[0], metricdatatest.IgnoreTimestamp())
 	metricdatatest.AssertEqual(t, want.Metrics[1], gotMetrics[1], metricdatatest.IgnoreTimestamp())
@@ -588,6 +744,18 @@ func TestClientInstrumentation(t *testing.T) {
 		metricdatatest.IgnoreTimestamp(),
 		metricdatatest.IgnoreValue(),
 	)
+	for i := 3; i < len(gotMetrics); i++ {
+		m := gotMetrics[i]
+		metricdatatest.AssertEqual(
+			t,
+			want.Metrics[i],
+			m,
+			metricdatatest.IgnoreTimestamp(),
+			metricdatatest.IgnoreValue(),
+		)
+		dp := m.Data.(metricdata.Histogram[int64]).DataPoints[0]
+		assert.Positive(t, dp.Sum, m.Name)
+	}
 }
 
 func canonical(t *testing.T, endpoint string) string {
@@ -649,3 +817,91 @@ func BenchmarkExporterExportSpans(b *testing.B) {
 		run(b)
 	})
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := otlptracegrpc.New(
+		t.Context(),
+		otlptracegrpc.WithInsecure(),
+		otlptracegrpc.WithDryRun(&buf),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(t.Context(), spans))
+
+	var req coltracepb.ExportTraceServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	require.Len(t, req.ResourceSpans, 1)
+	require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+	require.Len(t, req.ResourceSpans[0].ScopeSpans[0].Spans, 1)
+	assert.Equal(t, "span", req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+
+	invalid := tracetest.SpanStubs{{Name: "\xff"}}.Snapshots()
+	assert.Error(t, exp.ExportSpans(t.Context(), invalid), "invalid request")
+}
+
+func TestPersistentQueue(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{
+			status.Error(codes.Unavailable, "unavailable"),
+			status.Error(codes.Unavailable, "unavailable"),
+		},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, mc.endpoint,
+		otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{Enabled: false}),
+		otlptracegrpc.WithPersistentQueue(dir, 0),
+	)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	// The first request fails and is persisted. The second request is
+	// persisted after the first one fails again.
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+	assert.Empty(t, mc.getSpans())
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, exp.ExportSpans(ctx, roSpans))
+	assert.Eventually(t, func() bool {
+		return len(mc.getSpans()) == 3*len(roSpans)
+	}, 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueRejected(t *testing.T) {
+	mc := runMockCollectorWithConfig(t, &mockConfig{
+		errors: []error{status.Error(codes.InvalidArgument, "invalid")},
+	})
+	t.Cleanup(func() { require.NoError(t, mc.stop()) })
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp := newGRPCExporter(t, ctx, mc.endpoint, otlptracegrpc.WithPersistentQueue(dir, 0))
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(ctx)) })
+
+	assert.Error(t, exp.ExportSpans(ctx, roSpans))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "rejected request persisted")
+}
+
+func TestPersistentQueueInvalidDir(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(file, nil, 0o600))
+
+	client := otlptracegrpc.NewClient(otlptracegrpc.WithInsecure(), otlptracegrpc.WithPersistentQueue(file, 0))
+	_, err := otlptrace.New(t.Context(), client)
+	assert.Error(t, err)
+}
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracegrpc // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+// debugAttempts is the number of export attempts held for DebugInfo.
+const debugAttempts = 10
+
+// DebugInfo describes the state of a client. It is meant to be included in
+// the bug reports and support bundles about telemetry not being received.
+type DebugInfo struct {
+	// Endpoint is the target of the gRPC connection of the client.
+	Endpoint string
+	// ConnectivityState is the state of the gRPC connection, e.g. "READY"
+	// or "TRANSIENT_FAILURE". It is empty if the client is not started.
+	ConnectivityState string
+	// SecurityProtocol is the security protocol of the credentials of the
+	// gRPC connection, e.g. "tls" or "insecure". It is empty if unknown, when
+	// the connection is passed with WithGRPCConn.
+	SecurityProtocol string
+	// Attempts are the last 10 export attempts of the client, oldest first.
+	// Each retry of an export request is an attempt.
+	Attempts []ExportAttempt
+}
+
+// ExportAttempt is an attempt to send an export request.
+type ExportAttempt struct {
+	// Time is the time the attempt started.
+	Time time.Time
+	// Duration is the duration of the attempt.
+	Duration time.Duration
+	// Code is the status code of the attempt, codes.OK if it succeeded.
+	Code codes.Code
+	// Err is the error of the attempt, nil if it succeeded.
+	Err error
+}
+
+// ClientDebugInfo returns the DebugInfo of c and true if c was returned by
+// NewClient. Otherwise, it returns false. Use NewClient and otlptrace.New
+// instead of New to create an exporter with a client whose DebugInfo can be
+// retrieved.
+func ClientDebugInfo(c otlptrace.Client) (DebugInfo, bool) {
+	cl, ok := c.(*client)
+	if !ok {
+		return DebugInfo{}, false
+	}
+	return cl.debugInfo(), true
+}
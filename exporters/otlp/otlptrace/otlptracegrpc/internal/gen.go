@@ -4,6 +4,15 @@
 // Package internal provides internal functionally for the otlptracegrpc package.
 package internal
 
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun.go.tmpl "--data={}" --out=dryrun.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/dryrun_test.go.tmpl "--data={}" --out=dryrun_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts.go.tmpl "--data={}" --out=attempts.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/attempts_test.go.tmpl "--data={}" --out=attempts_test.go
+
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue.go.tmpl "--data={}" --out=persistentqueue.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/persistentqueue_test.go.tmpl "--data={}" --out=persistentqueue_test.go
+
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess.go.tmpl "--data={}" --out=partialsuccess.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/partialsuccess_test.go.tmpl "--data={}" --out=partialsuccess_test.go
 
@@ -29,6 +38,8 @@ package internal
 
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target.go
 //go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/target_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/target_test.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload.go
+//go:generate gotmpl --body=../../../../../internal/shared/otlp/observ/payload_test.go.tmpl "--data={ \"pkg\": \"observ\" }" --out=observ/payload_test.go
 
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter.go.tmpl "--data={}" --out=counter/counter.go
 //go:generate gotmpl --body=../../../../../internal/shared/counter/counter_test.go.tmpl "--data={}" --out=counter/counter_test.go
@@ -89,6 +89,7 @@ type Instrumentation struct {
 	inflightSpans metric.Int64UpDownCounter
 	exportedSpans metric.Int64Counter
 	opDuration    metric.Float64Histogram
+	payload       *PayloadSize
 
 	attrs  []attribute.KeyValue
 	addOpt metric.AddOption
@@ -96,17 +97,21 @@ type Instrumentation struct {
 }
 
 // NewInstrumentation returns instrumentation for an OTLP over gPRC trace
-// exporter with the provided ID using the global MeterProvider.
+// exporter with the provided ID using mp.
 //
 // The id should be the unique exporter instance ID. It is used
 // to set the "component.name" attribute.
 //
 // The target is the endpoint the exporter is exporting to.
 //
-// If the experimental observability is disabled, nil is returned.
-func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
-	if !x.Observability.Enabled() {
-		return nil, nil
+// If mp is nil, the global MeterProvider is used when the experimental
+// observability is enabled, otherwise nil is returned.
+func NewInstrumentation(mp metric.MeterProvider, id int64, target string) (*Instrumentation, error) {
+	if mp == nil {
+		if !x.Observability.Enabled() {
+			return nil, nil
+		}
+		mp = otel.GetMeterProvider()
 	}
 
 	attrs := BaseAttrs(id, target)
@@ -124,7 +129,6 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 		)...)),
 	}
 
-	mp := otel.GetMeterProvider()
 	m := mp.Meter(
 		ScopeName,
 		metric.WithInstrumentationVersion(Version),
@@ -154,6 +158,9 @@ func NewInstrumentation(id int64, target string) (*Instrumentation, error) {
 	}
 	i.opDuration = opDuration.Inst()
 
+	i.payload, e = NewPayloadSize(m, attrs)
+	err = errors.Join(err, e)
+
 	return i, err
 }
 
@@ -283,6 +290,21 @@ func (e ExportOp) End(err error, code codes.Code) {
 	}
 }
 
+// PayloadSizeEnabled reports whether the sizes of the export calls made with
+// ctx are recorded. Use it to avoid computing the sizes when they are not
+// recorded.
+func (i *Instrumentation) PayloadSizeEnabled(ctx context.Context) bool {
+	return i.payload.Enabled(ctx)
+}
+
+// RecordPayloadSize records the size in bytes of the protobuf encoded request
+// sent by a single export call and of the response it received. The response
+// size is only recorded if response is not negative. The code is the status
+// code of the call.
+func (i *Instrumentation) RecordPayloadSize(ctx context.Context, request, response int64, code codes.Code) {
+	i.payload.Record(ctx, request, response, code)
+}
+
 // recordOption returns a RecordOption with attributes representing the
 // outcome of the operation being recorded.
 //
@@ -68,6 +68,10 @@ func (m *errMeter) Float64Histogram(string, ...mapi.Float64HistogramOption) (map
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Histogram(string, ...mapi.Int64HistogramOption) (mapi.Int64Histogram, error) {
+	return nil, m.err
+}
+
 func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	orig := otel.GetMeterProvider()
 	t.Cleanup(func() { otel.SetMeterProvider(orig) })
@@ -76,17 +80,19 @@ func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
-	_, err := observ.NewInstrumentation(ID, Target)
+	_, err := observ.NewInstrumentation(nil, ID, Target)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 
 	assert.ErrorContains(t, err, "inflight metric")
 	assert.ErrorContains(t, err, "span exported metric")
 	assert.ErrorContains(t, err, "operation duration metric")
+	assert.ErrorContains(t, err, "request size metric")
+	assert.ErrorContains(t, err, "response size metric")
 }
 
 func TestNewInstrumentationObservabilityDisabled(t *testing.T) {
 	// Do not set OTEL_GO_X_OBSERVABILITY.
-	got, err := observ.NewInstrumentation(ID, Target)
+	got, err := observ.NewInstrumentation(nil, ID, Target)
 	assert.NoError(t, err)
 	assert.Nil(t, got)
 }
@@ -103,7 +109,7 @@ func setup(t *testing.T) (*observ.Instrumentation, func() metricdata.ScopeMetric
 	mp := metric.NewMeterProvider(metric.WithReader(r))
 	otel.SetMeterProvider(mp)
 
-	inst, err := observ.NewInstrumentation(ID, Target)
+	inst, err := observ.NewInstrumentation(nil, ID, Target)
 	require.NoError(t, err)
 	require.NotNil(t, inst)
 
@@ -332,7 +338,7 @@ func BenchmarkInstrumentationExportSpans(b *testing.B) {
 	setup := func(b *testing.B) *observ.Instrumentation {
 		b.Helper()
 		b.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
-		inst, err := observ.NewInstrumentation(ID, Target)
+		inst, err := observ.NewInstrumentation(nil, ID, Target)
 		if err != nil {
 			b.Fatalf("failed to create instrumentation: %v", err)
 		}
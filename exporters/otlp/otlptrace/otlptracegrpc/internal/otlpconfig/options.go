@@ -10,6 +10,7 @@ package otlpconfig
 import (
 	"crypto/tls"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -25,6 +26,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 )
 
 const (
@@ -54,12 +56,45 @@ type (
 		Timeout        time.Duration
 		URLPath        string
 
+		// DryRun configures export requests to be serialized without being
+		// sent. The serialized requests are written to DryRunSink, if not
+		// nil.
+		DryRun     bool
+		DryRunSink io.Writer
+
+		// PersistentQueueDir is the directory export requests that failed
+		// because the endpoint was unavailable are persisted to, if not
+		// empty. The total size of the persisted requests is limited to
+		// PersistentQueueMaxBytes, if positive.
+		PersistentQueueDir      string
+		PersistentQueueMaxBytes int64
+
+		// ResponseHandler is called with the headers and trailers of the
+		// responses to the export requests, if not nil.
+		ResponseHandler func(header, trailer map[string][]string)
+
+		// PayloadSizeHandler is called with the size of the payload of the
+		// export requests before and after compression, if not nil.
+		PayloadSizeHandler func(uncompressed, compressed int)
+
+		// PayloadTransformer transforms the payload of the export requests,
+		// once compressed, and sets their headers, if not nil.
+		PayloadTransformer func(payload []byte, header map[string][]string) ([]byte, error)
+
+		// MeterProvider is the MeterProvider self-observability metrics are
+		// recorded with. If nil, the global MeterProvider is used when the
+		// experimental observability is enabled.
+		MeterProvider metric.MeterProvider
+
 		// gRPC configurations
 		GRPCCredentials credentials.TransportCredentials
 
 		// HTTP configurations
 		Proxy      HTTPTransportProxyFunc
 		HTTPClient *http.Client
+		// RetryableStatusCodes are the HTTP response status codes that are
+		// retried. If nil, the default status codes are retried.
+		RetryableStatusCodes []int
 	}
 
 	Config struct {
@@ -73,6 +108,10 @@ type (
 		ServiceConfig      string
 		DialOptions        []grpc.DialOption
 		GRPCConn           *grpc.ClientConn
+
+		// NoPayloadSizeMetrics disables the experimental self-observability
+		// metrics recording the size of the gRPC requests and responses.
+		NoPayloadSizeMetrics bool
 	}
 )
 
@@ -318,6 +357,20 @@ func WithRetry(rc retry.Config) GenericOption {
 	})
 }
 
+func WithRetryMaxElapsedTime(d time.Duration) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.RetryConfig.MaxElapsedTime = d
+		return cfg
+	})
+}
+
+func WithRetryableStatusCodes(codes []int) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.RetryableStatusCodes = append([]int{}, codes...)
+		return cfg
+	})
+}
+
 func WithTLSClientConfig(tlsCfg *tls.Config) GenericOption {
 	return newSplitOption(func(cfg Config) Config {
 		cfg.Traces.TLSCfg = tlsCfg.Clone()
@@ -363,6 +416,50 @@ func WithMaxRequestSize(size int) GenericOption {
 	})
 }
 
+func WithDryRun(sink io.Writer) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.DryRun = true
+		cfg.Traces.DryRunSink = sink
+		return cfg
+	})
+}
+
+func WithPersistentQueue(dir string, maxBytes int64) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.PersistentQueueDir = dir
+		cfg.Traces.PersistentQueueMaxBytes = maxBytes
+		return cfg
+	})
+}
+
+func WithResponseHandler(h func(header, trailer map[string][]string)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.ResponseHandler = h
+		return cfg
+	})
+}
+
+func WithPayloadSizeHandler(h func(uncompressed, compressed int)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.PayloadSizeHandler = h
+		return cfg
+	})
+}
+
+func WithPayloadTransformer(t func(payload []byte, header map[string][]string) ([]byte, error)) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.PayloadTransformer = t
+		return cfg
+	})
+}
+
+func WithSelfObservability(mp metric.MeterProvider) GenericOption {
+	return newGenericOption(func(cfg Config) Config {
+		cfg.Traces.MeterProvider = mp
+		return cfg
+	})
+}
+
 func WithProxy(pf HTTPTransportProxyFunc) GenericOption {
 	return newGenericOption(func(cfg Config) Config {
 		cfg.Traces.Proxy = pf
@@ -26,6 +26,8 @@ func makeMockCollector(t testing.TB, mockConfig *mockConfig) *mockCollector {
 			storage: otlptracetest.NewSpansStorage(),
 			errors:  mockConfig.errors,
 			partial: mockConfig.partial,
+			header:  mockConfig.header,
+			trailer: mockConfig.trailer,
 		},
 		stopped: make(chan struct{}),
 	}
@@ -41,6 +43,9 @@ type mockTraceService struct {
 	storage     otlptracetest.SpansStorage
 	headers     metadata.MD
 	exportBlock chan struct{}
+
+	// header and trailer are sent with the responses.
+	header, trailer metadata.MD
 }
 
 func (mts *mockTraceService) getHeaders() metadata.MD {
@@ -71,6 +76,13 @@ func (mts *mockTraceService) Export(
 		mts.mu.Unlock()
 	}()
 
+	if err := grpc.SetHeader(ctx, mts.header); err != nil {
+		return nil, err
+	}
+	if err := grpc.SetTrailer(ctx, mts.trailer); err != nil {
+		return nil, err
+	}
+
 	if mts.exportBlock != nil {
 		// Do this with the lock held so the mockCollector.Stop does not
 		// abandon cleaning up resources.
@@ -105,6 +117,8 @@ type mockConfig struct {
 	errors   []error
 	endpoint string
 	partial  *collectortracepb.ExportTracePartialSuccess
+	header   metadata.MD
+	trailer  metadata.MD
 }
 
 var _ collectortracepb.TraceServiceServer = (*mockTraceService)(nil)
@@ -5,14 +5,17 @@ package otlptracegrpc
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc/internal/retry"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Option applies an option to the gRPC driver.
@@ -202,6 +205,84 @@ func WithMaxRequestSize(size int) Option {
 	return wrappedOption{otlpconfig.WithMaxRequestSize(size)}
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return wrappedOption{otlpconfig.WithDryRun(sink)}
+}
+
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new spans are persisted without being sent so that the order
+// is kept.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return wrappedOption{otlpconfig.WithPersistentQueue(dir, maxBytes)}
+}
+
+// WithResponseMetadataHandler sets a function called with the header and
+// trailer metadata of the response to each export request, including the
+// requests that failed or are retried. Use it to read the metadata set by
+// the endpoint, e.g. to slow down the exports when a rate limit header
+// reports few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseMetadataHandler(h func(header, trailer metadata.MD)) Option {
+	if h == nil {
+		return wrappedOption{otlpconfig.WithResponseHandler(nil)}
+	}
+	return wrappedOption{otlpconfig.WithResponseHandler(func(header, trailer map[string][]string) {
+		h(header, trailer)
+	})}
+}
+
+// WithSelfObservability configures the exporter to record its
+// self-observability metrics (e.g. exported spans and export duration) with
+// mp.
+//
+// This enables the self-observability metrics regardless of the
+// OTEL_GO_X_OBSERVABILITY environment variable. If this option is not used or
+// mp is nil, the metrics are recorded with the global MeterProvider only when
+// that environment variable enables them.
+func WithSelfObservability(mp metric.MeterProvider) Option {
+	return wrappedOption{otlpconfig.WithSelfObservability(mp)}
+}
+
+// WithoutPayloadSizeMetrics disables the experimental rpc.client.request.size
+// and rpc.client.response.size self-observability metrics recording the size
+// of the protobuf encoded requests sent and responses received by the
+// exporter. Computing these sizes has a cost proportional to the size of the
+// requests, use this option to avoid it while keeping the other
+// self-observability metrics.
+func WithoutPayloadSizeMetrics() Option {
+	return wrappedOption{otlpconfig.NewGRPCOption(func(cfg otlpconfig.Config) otlpconfig.Config {
+		cfg.NoPayloadSizeMetrics = true
+		return cfg
+	})}
+}
+
 // WithRetry sets the retry policy for transient retryable errors that may be
 // returned by the target endpoint when exporting a batch of spans.
 //
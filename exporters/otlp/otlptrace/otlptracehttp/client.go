@@ -63,6 +63,15 @@ var ourTransport = &http.Transport{
 	ExpectContinueTimeout: 1 * time.Second,
 }
 
+// defaultRetryableStatusCodes are the HTTP response status codes retried if
+// no status codes are configured with WithRetryableStatusCodes.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
 var errInsecureEndpointWithTLS = errors.New("insecure HTTP endpoint cannot use TLS client configuration")
 
 type client struct {
@@ -70,10 +79,15 @@ type client struct {
 	cfg         otlpconfig.SignalConfig
 	generalCfg  otlpconfig.Config
 	requestFunc retry.RequestFunc
+	retryable   map[int]struct{}
 	client      *http.Client
 	stopCh      chan struct{}
 	stopOnce    sync.Once
 
+	// queue persists the export requests that failed because the endpoint
+	// was unavailable, if configured. It is created in Start.
+	queue *internal.PersistentQueue
+
 	instID int64
 	inst   *observ.Instrumentation
 }
@@ -105,12 +119,22 @@ func NewClient(opts ...Option) otlptrace.Client {
 		}
 	}
 
+	codes := cfg.Traces.RetryableStatusCodes
+	if codes == nil {
+		codes = defaultRetryableStatusCodes
+	}
+	retryable := make(map[int]struct{}, len(codes))
+	for _, code := range codes {
+		retryable[code] = struct{}{}
+	}
+
 	stopCh := make(chan struct{})
 	return &client{
 		name:        "traces",
 		cfg:         cfg.Traces,
 		generalCfg:  cfg,
 		requestFunc: cfg.RetryConfig.RequestFunc(evaluate),
+		retryable:   retryable,
 		stopCh:      stopCh,
 		client:      httpClient,
 		instID:      counter.NextExporterID(),
@@ -123,6 +147,14 @@ func (c *client) Start(ctx context.Context) error {
 		return errInsecureEndpointWithTLS
 	}
 
+	if c.cfg.PersistentQueueDir != "" && c.queue == nil {
+		q, err := internal.NewPersistentQueue(c.cfg.PersistentQueueDir, c.cfg.PersistentQueueMaxBytes, persistable)
+		if err != nil {
+			return err
+		}
+		c.queue = q
+	}
+
 	// Initialize the instrumentation if not already done.
 	//
 	// Initialize here instead of NewClient to allow any errors to be passed
@@ -130,7 +162,7 @@ func (c *client) Start(ctx context.Context) error {
 	// enable instrumentation can be set via code.
 	var err error
 	if c.inst == nil {
-		c.inst, err = observ.NewInstrumentation(c.instID, c.cfg.Endpoint)
+		c.inst, err = observ.NewInstrumentation(c.cfg.MeterProvider, c.instID, c.cfg.Endpoint)
 	}
 
 	// nothing to do
@@ -147,6 +179,10 @@ func (c *client) Stop(ctx context.Context) error {
 	c.stopOnce.Do(func() {
 		close(c.stopCh)
 	})
+	// Stop the replay of the persisted requests.
+	if c.queue != nil {
+		c.queue.Shutdown()
+	}
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -172,9 +208,8 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		return fmt.Errorf("request body too large: exceeded %d bytes", maxSize)
 	}
 
-	request, err := c.newRequest(rawRequest)
-	if err != nil {
-		return err
+	if c.cfg.DryRun {
+		return internal.DryRun(c.cfg.DryRunSink, rawRequest)
 	}
 
 	var statusCode int
@@ -189,98 +224,131 @@ func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.Resourc
 		defer func() { op.End(uploadErr, statusCode) }()
 	}
 
-	return errors.Join(uploadErr, c.requestFunc(ctx, func(ctx context.Context) error {
-		select {
-		case <-ctx.Done():
-			return ctx.Err()
-		default:
-		}
+	// newSend returns a function sending a request and setting statusCode to
+	// the status code of its last attempt.
+	newSend := func(statusCode *int) func(context.Context, []byte) error {
+		return func(ctx context.Context, rawRequest []byte) error {
+			request, err := c.newRequest(rawRequest)
+			if err != nil {
+				return err
+			}
+			if h := c.cfg.PayloadSizeHandler; h != nil {
+				h(len(rawRequest), int(request.size))
+			}
 
-		statusCode = 0
-		request.reset(ctx)
-		// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
-		resp, err := c.client.Do(request.Request)
-		var urlErr *url.Error
-		if errors.As(err, &urlErr) && urlErr.Temporary() {
-			return newResponseError(http.Header{}, err)
-		}
-		if err != nil {
-			return err
-		}
+			var sendErr error
+			err = c.requestFunc(ctx, func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				default:
+				}
 
-		if resp != nil && resp.Body != nil {
-			defer func() {
-				if err := resp.Body.Close(); err != nil {
-					uploadErr = errors.Join(uploadErr, err)
+				*statusCode = 0
+				request.reset(ctx)
+				// nolint:gosec // URL is constructed from validated OTLP endpoint configuration
+				resp, err := c.client.Do(request.Request)
+				var urlErr *url.Error
+				if errors.As(err, &urlErr) && urlErr.Temporary() {
+					return newResponseError(http.Header{}, err)
+				}
+				if err != nil {
+					return err
 				}
-			}()
-		}
 
-		statusCode = resp.StatusCode
-		if statusCode >= 200 && statusCode <= 299 {
-			// Success, do not retry.
-			// Read the partial success message, if any.
-			var respData bytes.Buffer
-			if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-				var maxBytesErr *http.MaxBytesError
-				if errors.As(err, &maxBytesErr) {
-					return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+				if resp != nil && resp.Body != nil {
+					defer func() {
+						if err := resp.Body.Close(); err != nil {
+							sendErr = errors.Join(sendErr, err)
+						}
+					}()
 				}
-				return err
-			}
-			if respData.Len() == 0 {
-				return nil
-			}
 
-			if resp.Header.Get("Content-Type") == "application/x-protobuf" {
-				var respProto coltracepb.ExportTraceServiceResponse
-				if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
-					return err
+				*statusCode = resp.StatusCode
+				if h := c.cfg.ResponseHandler; h != nil {
+					// The trailers are received once the body is read.
+					defer func() { h(resp.Header, resp.Trailer) }()
+				}
+
+				var respSize int64
+				if c.inst != nil {
+					defer func() { c.inst.RecordPayloadSize(ctx, request.size, respSize, *statusCode) }()
 				}
 
-				if respProto.PartialSuccess != nil {
-					msg := respProto.PartialSuccess.GetErrorMessage()
-					n := respProto.PartialSuccess.GetRejectedSpans()
-					if n != 0 || msg != "" {
-						err := internal.TracePartialSuccessError(n, msg)
-						uploadErr = errors.Join(uploadErr, err)
+				if *statusCode >= 200 && *statusCode <= 299 {
+					// Success, do not retry.
+					// Read the partial success message, if any.
+					var respData bytes.Buffer
+					n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+					respSize = n
+					if err != nil {
+						var maxBytesErr *http.MaxBytesError
+						if errors.As(err, &maxBytesErr) {
+							return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+						}
+						return err
+					}
+					if respData.Len() == 0 {
+						return nil
+					}
+
+					if resp.Header.Get("Content-Type") == "application/x-protobuf" {
+						var respProto coltracepb.ExportTraceServiceResponse
+						if err := proto.Unmarshal(respData.Bytes(), &respProto); err != nil {
+							return err
+						}
+
+						if respProto.PartialSuccess != nil {
+							msg := respProto.PartialSuccess.GetErrorMessage()
+							n := respProto.PartialSuccess.GetRejectedSpans()
+							if n != 0 || msg != "" {
+								err := internal.TracePartialSuccessError(n, msg)
+								sendErr = errors.Join(sendErr, err)
+							}
+						}
 					}
+					return nil
 				}
-			}
-			return nil
-		}
-		// Error cases.
-
-		// server may return a message with the response
-		// body, so we read it to include in the error
-		// message to be returned. It will help in
-		// debugging the actual issue.
-		var respData bytes.Buffer
-		if _, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize)); err != nil {
-			var maxBytesErr *http.MaxBytesError
-			if errors.As(err, &maxBytesErr) {
-				return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
-			}
-			return err
-		}
-		respStr := strings.TrimSpace(respData.String())
-		if respStr == "" {
-			respStr = "(empty)"
-		}
-		bodyErr := fmt.Errorf("body: %s", respStr)
-
-		switch statusCode {
-		case http.StatusTooManyRequests,
-			http.StatusBadGateway,
-			http.StatusServiceUnavailable,
-			http.StatusGatewayTimeout:
-			// Retryable failure.
-			return newResponseError(resp.Header, bodyErr)
-		default:
-			// Non-retryable failure.
-			return fmt.Errorf("failed to send to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+				// Error cases.
+
+				// server may return a message with the response
+				// body, so we read it to include in the error
+				// message to be returned. It will help in
+				// debugging the actual issue.
+				var respData bytes.Buffer
+				n, err := io.Copy(&respData, http.MaxBytesReader(nil, resp.Body, maxResponseBodySize))
+				respSize = n
+				if err != nil {
+					var maxBytesErr *http.MaxBytesError
+					if errors.As(err, &maxBytesErr) {
+						return fmt.Errorf("response body too large: exceeded %d bytes", maxBytesErr.Limit)
+					}
+					return err
+				}
+				respStr := strings.TrimSpace(respData.String())
+				if respStr == "" {
+					respStr = "(empty)"
+				}
+				bodyErr := fmt.Errorf("body: %s", respStr)
+
+				if _, ok := c.retryable[*statusCode]; ok {
+					// Retryable failure.
+					return newResponseError(resp.Header, bodyErr)
+				}
+				// Non-retryable failure.
+				return fmt.Errorf("failed to send to %s: %s (%w)", request.URL, resp.Status, bodyErr)
+			})
+			return errors.Join(sendErr, err)
 		}
-	}))
+	}
+	send := newSend(&statusCode)
+
+	if c.queue != nil {
+		// The persisted requests are replayed after this export returns, with
+		// their own status code.
+		return c.queue.Export(ctx, rawRequest, send, newSend(new(int)))
+	}
+	return send(ctx, rawRequest)
 }
 
 func (c *client) newRequest(body []byte) (request, error) {
@@ -299,11 +367,10 @@ func (c *client) newRequest(body []byte) (request, error) {
 	r.Header.Set("Content-Type", contentTypeProto)
 
 	req := request{Request: r}
+	payload := body
 	switch Compression(c.cfg.Compression) {
 	case NoCompression:
 		r.ContentLength = int64(len(body))
-		req.bodyReader = bodyReader(body)
-		req.GetBody = bodyReaderErr(body)
 	case GzipCompression:
 		// Ensure the content length is not used.
 		r.ContentLength = -1
@@ -325,11 +392,22 @@ func (c *client) newRequest(body []byte) (request, error) {
 		if err := gz.Close(); err != nil {
 			return req, err
 		}
+		payload = b.Bytes()
+	}
 
-		req.bodyReader = bodyReader(b.Bytes())
-		req.GetBody = bodyReaderErr(b.Bytes())
+	if t := c.cfg.PayloadTransformer; t != nil {
+		payload, err = t(payload, r.Header)
+		if err != nil {
+			return req, fmt.Errorf("payload transformer: %w", err)
+		}
+		if r.ContentLength >= 0 {
+			r.ContentLength = int64(len(payload))
+		}
 	}
 
+	req.bodyReader = bodyReader(payload)
+	req.GetBody = bodyReaderErr(payload)
+	req.size = int64(len(payload))
 	return req, nil
 }
 
@@ -362,6 +440,8 @@ type request struct {
 
 	// bodyReader allows the same body to be used for multiple requests.
 	bodyReader func() io.ReadCloser
+	// size is the size of the body, after any compression, in bytes.
+	size int64
 }
 
 // reset reinitializes the request Body and uses ctx for the request.
@@ -449,6 +529,14 @@ func evaluate(err error) (bool, time.Duration) {
 	return true, rErr.throttle
 }
 
+// persistable returns if err identifies a request that was not accepted
+// because the endpoint is unavailable and can be sent again later.
+func persistable(err error) bool {
+	var rErr *retryableError
+	var urlErr *url.Error
+	return errors.As(err, &rErr) || errors.As(err, &urlErr)
+}
+
 func (c *client) getScheme() string {
 	if c.cfg.Insecure {
 		return "http"
@@ -11,9 +11,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -22,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/protobuf/proto"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -37,6 +41,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -310,6 +316,92 @@ func TestNoRetry(t *testing.T) {
 	assert.Empty(t, mc.GetSpans())
 }
 
+func TestRetryableStatusCodes(t *testing.T) {
+	tests := []struct {
+		name      string
+		codes     []int
+		status    int
+		wantError bool
+	}{
+		{
+			name:   "CustomRetried",
+			codes:  []int{http.StatusConflict},
+			status: http.StatusConflict,
+		},
+		{
+			name:      "DefaultNotRetried",
+			codes:     []int{http.StatusConflict},
+			status:    http.StatusServiceUnavailable,
+			wantError: true,
+		},
+		{
+			name:      "NoneRetried",
+			codes:     []int{},
+			status:    http.StatusTooManyRequests,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mc := runMockCollector(t, mockCollectorConfig{
+				InjectHTTPStatus: []int{tt.status},
+			})
+			defer mc.MustStop(t)
+			driver := otlptracehttp.NewClient(
+				otlptracehttp.WithEndpoint(mc.Endpoint()),
+				otlptracehttp.WithInsecure(),
+				otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+					Enabled:         true,
+					InitialInterval: time.Nanosecond,
+					MaxInterval:     time.Nanosecond,
+					MaxElapsedTime:  time.Minute,
+				}),
+				otlptracehttp.WithRetryableStatusCodes(tt.codes...),
+			)
+			ctx := t.Context()
+			exporter, err := otlptrace.New(ctx, driver)
+			require.NoError(t, err)
+			defer func() {
+				assert.NoError(t, exporter.Shutdown(ctx))
+			}()
+
+			err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+			if tt.wantError {
+				assert.ErrorContains(t, err, fmt.Sprintf("%d %s", tt.status, http.StatusText(tt.status)))
+				assert.Empty(t, mc.GetSpans())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, mc.GetSpans(), 1)
+		})
+	}
+}
+
+func TestRetryMaxElapsedTime(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus:     []int{http.StatusTooManyRequests},
+		InjectResponseHeader: []map[string]string{{"Retry-After": "3600"}},
+	})
+	defer mc.MustStop(t)
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithRetryMaxElapsedTime(time.Second),
+	)
+	ctx := t.Context()
+	exporter, err := otlptrace.New(ctx, driver)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, exporter.Shutdown(ctx))
+	}()
+
+	// The Retry-After delay exceeds the max elapsed time.
+	err = exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	assert.ErrorContains(t, err, "max retry time would elapse")
+	assert.Empty(t, mc.GetSpans())
+}
+
 func TestEmptyData(t *testing.T) {
 	mcCfg := mockCollectorConfig{}
 	mc := runMockCollector(t, mcCfg)
@@ -501,6 +593,39 @@ func TestCollectorRespondingNonProtobufContent(t *testing.T) {
 	assert.Len(t, mc.GetSpans(), 1)
 }
 
+func TestClientWithSelfObservability(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, the option enables observability.
+	reader := metric.NewManualReader()
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+
+	mc := runMockCollector(t, mockCollectorConfig{})
+	t.Cleanup(func() { require.NoError(t, mc.Stop()) })
+
+	driver := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithSelfObservability(mp),
+	)
+	exporter, err := otlptrace.New(t.Context(), driver)
+	require.NoError(t, err)
+
+	localSpans := tracetest.SpanStubs{{Name: "Span 0"}, {Name: "Span 1"}}.Snapshots()
+	require.NoError(t, exporter.ExportSpans(t.Context(), localSpans))
+	require.NoError(t, exporter.Shutdown(t.Context()))
+
+	var got metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &got))
+	require.Len(t, got.ScopeMetrics, 1)
+	assert.Equal(t, observ.ScopeName, got.ScopeMetrics[0].Scope.Name)
+
+	var names []string
+	for _, m := range got.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.Contains(t, names, otelconv.SDKExporterSpanExported{}.Name())
+	assert.Contains(t, names, otelconv.SDKExporterOperationDuration{}.Name())
+}
+
 func TestClientInstrumentation(t *testing.T) {
 	// Enable instrumentation for this test.
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
@@ -594,21 +719,53 @@ func TestClientInstrumentation(t *testing.T) {
 					Temporality: 0x1,
 				},
 			},
+			{
+				Name:        httpconv.ClientRequestBodySize{}.Name(),
+				Description: httpconv.ClientRequestBodySize{}.Description(),
+				Unit:        httpconv.ClientRequestBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(400),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
+			{
+				Name:        httpconv.ClientResponseBodySize{}.Name(),
+				Description: httpconv.ClientResponseBodySize{}.Description(),
+				Unit:        httpconv.ClientResponseBodySize{}.Unit(),
+				Data: metricdata.Histogram[int64]{
+					DataPoints: []metricdata.HistogramDataPoint[int64]{
+						{Attributes: attribute.NewSet(append(
+							attrs,
+							semconv.HTTPRequestMethodPost,
+							semconv.HTTPResponseStatusCode(400),
+						)...)},
+					},
+					Temporality: 0x1,
+				},
+			},
 		},
 	}
 	require.Len(t, got.ScopeMetrics, 1)
 	gotMetrics := got.ScopeMetrics[0].Metrics
-	require.Len(t, gotMetrics, 3)
+	require.Len(t, gotMetrics, 5)
 
 	metricdatatest.AssertEqual(t, want.Metrics[0], gotMetrics[0], metricdatatest.IgnoreTimestamp())
 	metricdatatest.AssertEqual(t, want.Metrics[1], gotMetrics[1], metricdatatest.IgnoreTimestamp())
-	metricdatatest.AssertEqual(
-		t,
-		want.Metrics[2],
-		gotMetrics[2],
-		metricdatatest.IgnoreTimestamp(),
-		metricdatatest.IgnoreValue(),
-	)
+	for i := 2; i < len(want.Metrics); i++ {
+		metricdatatest.AssertEqual(
+			t,
+			want.Metrics[i],
+			gotMetrics[i],
+			metricdatatest.IgnoreTimestamp(),
+			metricdatatest.IgnoreValue(),
+		)
+	}
 }
 
 func TestResponseBodySizeLimit(t *testing.T) {
@@ -957,8 +1114,257 @@ func TestWithEndpointURLNoPathUsesRootPath(t *testing.T) {
 	assert.Equal(t, "/", got, "a pathless endpoint URL must target the root path, not the default traces path")
 }
 
+func TestResponseHeaderHandler(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(10-requests))
+		w.Header().Set("Trailer", "Server-Version")
+		if requests == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		} else {
+			w.WriteHeader(http.StatusOK)
+		}
+		w.Header().Set("Server-Version", "1.2.3")
+	}))
+	t.Cleanup(srv.Close)
+
+	type response struct{ header, trailer http.Header }
+	var got []response
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	client := otlptracehttp.NewClient(
+		otlptracehttp.WithEndpointURL(srv.URL),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: true, InitialInterval: time.Nanosecond}),
+		otlptracehttp.WithResponseHeaderHandler(func(header, trailer http.Header) {
+			got = append(got, response{header, trailer})
+		}),
+	)
+	exporter, err := otlptrace.New(ctx, client)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exporter.Shutdown(ctx)) })
+	require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+
+	// The handler is called for the failed and the retried requests.
+	require.Len(t, got, 2)
+	for i, r := range got {
+		assert.Equal(t, strconv.Itoa(9-i), r.header.Get("X-RateLimit-Remaining"))
+		assert.Equal(t, "1.2.3", r.trailer.Get("Server-Version"))
+	}
+}
+
+func TestPayloadSizeHandler(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	type sizes struct{ uncompressed, compressed int }
+	export := func(c otlptracehttp.Compression) []sizes {
+		var got []sizes
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		exporter, err := otlptracehttp.New(ctx,
+			otlptracehttp.WithEndpointURL(srv.URL),
+			otlptracehttp.WithCompression(c),
+			otlptracehttp.WithPayloadSizeHandler(func(uncompressed, compressed int) {
+				got = append(got, sizes{uncompressed, compressed})
+			}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exporter.Shutdown(ctx)) })
+		require.NoError(t, exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+		return got
+	}
+
+	got := export(otlptracehttp.NoCompression)
+	require.Len(t, got, 1)
+	assert.Positive(t, got[0].uncompressed)
+	assert.Equal(t, got[0].uncompressed, got[0].compressed, "not compressed")
+
+	uncompressed := got[0].uncompressed
+	got = export(otlptracehttp.GzipCompression)
+	require.Len(t, got, 1)
+	assert.Equal(t, uncompressed, got[0].uncompressed)
+	assert.Positive(t, got[0].compressed)
+	assert.NotEqual(t, got[0].uncompressed, got[0].compressed, "compressed")
+}
+
+func TestPayloadTransformer(t *testing.T) {
+	xor := func(b []byte) []byte {
+		out := make([]byte, len(b))
+		for i, c := range b {
+			out[i] = c ^ 0xff
+		}
+		return out
+	}
+
+	var spans int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if !assert.NoError(t, err) || !assert.Equal(t, "xor", r.Header.Get("X-Payload-Transform")) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, strconv.Itoa(len(body)), r.Header.Get("X-Payload-Size"))
+		body = xor(body)
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			gz, err := gzip.NewReader(bytes.NewReader(body))
+			require.NoError(t, err)
+			body, err = io.ReadAll(gz)
+			require.NoError(t, err)
+		}
+		var req coltracepb.ExportTraceServiceRequest
+		require.NoError(t, proto.Unmarshal(body, &req))
+		spans += len(req.ResourceSpans[0].ScopeSpans[0].Spans)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	transformErr := errors.New("transform")
+	export := func(c otlptracehttp.Compression, fail bool) error {
+		ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+		exporter, err := otlptracehttp.New(ctx,
+			otlptracehttp.WithEndpointURL(srv.URL),
+			otlptracehttp.WithCompression(c),
+			otlptracehttp.WithPayloadTransformer(func(payload []byte, header http.Header) ([]byte, error) {
+				if fail {
+					return nil, transformErr
+				}
+				header.Set("X-Payload-Transform", "xor")
+				header.Set("X-Payload-Size", strconv.Itoa(len(payload)))
+				return xor(payload), nil
+			}),
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() { require.NoError(t, exporter.Shutdown(ctx)) })
+		return exporter.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan())
+	}
+
+	require.NoError(t, export(otlptracehttp.NoCompression, false))
+	require.NoError(t, export(otlptracehttp.GzipCompression, false))
+	assert.Equal(t, 2, spans)
+
+	assert.ErrorIs(t, export(otlptracehttp.NoCompression, true), transformErr)
+	assert.Equal(t, 2, spans, "not sent")
+}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
 	return f(r)
 }
+
+func TestDryRun(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := otlptracehttp.New(
+		t.Context(),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithDryRun(&buf),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, exp.Shutdown(context.Background())) })
+
+	spans := tracetest.SpanStubs{{Name: "span"}}.Snapshots()
+	require.NoError(t, exp.ExportSpans(t.Context(), spans))
+
+	var req coltracepb.ExportTraceServiceRequest
+	require.NoError(t, proto.Unmarshal(buf.Bytes(), &req))
+	require.Len(t, req.ResourceSpans, 1)
+	require.Len(t, req.ResourceSpans[0].ScopeSpans, 1)
+	require.Len(t, req.ResourceSpans[0].ScopeSpans[0].Spans, 1)
+	assert.Equal(t, "span", req.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+}
+
+func TestPersistentQueue(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{503, 503},
+	})
+	defer mc.MustStop(t)
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+		otlptracehttp.WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	// The first request fails and is persisted. The second request is
+	// persisted after the first one fails again.
+	require.NoError(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	require.NoError(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2, "failed requests not persisted")
+	assert.Empty(t, mc.GetSpans())
+
+	// The persisted requests are replayed in the background, in order, with
+	// the new one.
+	require.NoError(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Eventually(t, func() bool {
+		return len(mc.GetSpans()) == 3
+	}, 5*time.Second, 10*time.Millisecond, "persisted requests not sent")
+	assert.Eventually(t, func() bool {
+		entries, err := os.ReadDir(dir)
+		return err == nil && len(entries) == 0
+	}, 5*time.Second, 10*time.Millisecond, "sent requests not removed")
+}
+
+func TestPersistentQueueEndpointDown(t *testing.T) {
+	ln, err := (&net.ListenConfig{}).Listen(t.Context(), "tcp", "localhost:0")
+	require.NoError(t, err)
+	endpoint := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(endpoint),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}),
+		otlptracehttp.WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	require.NoError(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	require.NoError(t, exp.Shutdown(ctx))
+
+	// A new exporter using the same directory sends the persisted request.
+	mc := runMockCollector(t, mockCollectorConfig{})
+	defer mc.MustStop(t)
+	exp, err = otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	require.NoError(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	assert.Eventually(t, func() bool {
+		return len(mc.GetSpans()) == 2
+	}, 5*time.Second, 10*time.Millisecond, "persisted request not sent")
+}
+
+func TestPersistentQueueRejected(t *testing.T) {
+	mc := runMockCollector(t, mockCollectorConfig{
+		InjectHTTPStatus: []int{400},
+	})
+	defer mc.MustStop(t)
+
+	dir := t.TempDir()
+	ctx := context.Background() //nolint:usetesting // required to avoid getting a canceled context at cleanup.
+	exp, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(mc.Endpoint()),
+		otlptracehttp.WithInsecure(),
+		otlptracehttp.WithPersistentQueue(dir, 0),
+	)
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, exp.Shutdown(ctx)) }()
+
+	assert.Error(t, exp.ExportSpans(ctx, otlptracetest.SingleReadOnlySpan()))
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "rejected request persisted")
+}
@@ -25,6 +25,7 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/httpconv"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
 
@@ -70,6 +71,10 @@ func (m *errMeter) Float64Histogram(string, ...mapi.Float64HistogramOption) (map
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Histogram(string, ...mapi.Int64HistogramOption) (mapi.Int64Histogram, error) {
+	return nil, m.err
+}
+
 func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	orig := otel.GetMeterProvider()
 	t.Cleanup(func() { otel.SetMeterProvider(orig) })
@@ -78,17 +83,19 @@ func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
-	_, err := observ.NewInstrumentation(ID, Endpoint)
+	_, err := observ.NewInstrumentation(nil, ID, Endpoint)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 
 	assert.ErrorContains(t, err, "inflight metric")
 	assert.ErrorContains(t, err, "span exported metric")
 	assert.ErrorContains(t, err, "operation duration metric")
+	assert.ErrorContains(t, err, "request body size metric")
+	assert.ErrorContains(t, err, "response body size metric")
 }
 
 func TestNewInstrumentationObservabilityDisabled(t *testing.T) {
 	// Do not set OTEL_GO_X_OBSERVABILITY.
-	got, err := observ.NewInstrumentation(ID, Endpoint)
+	got, err := observ.NewInstrumentation(nil, ID, Endpoint)
 	assert.NoError(t, err)
 	assert.Nil(t, got)
 }
@@ -105,7 +112,7 @@ func setup(t *testing.T) (*observ.Instrumentation, func() metricdata.ScopeMetric
 	mp := metric.NewMeterProvider(metric.WithReader(r))
 	otel.SetMeterProvider(mp)
 
-	inst, err := observ.NewInstrumentation(ID, Endpoint)
+	inst, err := observ.NewInstrumentation(nil, ID, Endpoint)
 	require.NoError(t, err)
 	require.NotNil(t, inst)
 
@@ -264,6 +271,69 @@ func TestInstrumentationExportSpansInvalidPartialErrored(t *testing.T) {
 	assertMetrics(t, collect(), n+n, success, err, http.StatusServiceUnavailable)
 }
 
+func TestInstrumentationRecordPayloadSize(t *testing.T) {
+	inst, collect := setup(t)
+
+	inst.RecordPayloadSize(t.Context(), 100, 10, http.StatusOK)
+	inst.RecordPayloadSize(t.Context(), 200, 20, http.StatusServiceUnavailable)
+	// No response received, only the request size is recorded.
+	inst.RecordPayloadSize(t.Context(), 300, 0, 0)
+
+	httpSet := func(statusCode int) attribute.Set {
+		attrs := append(baseAttrs(nil), semconv.HTTPRequestMethodPost)
+		if statusCode != 0 {
+			attrs = append(attrs, semconv.HTTPResponseStatusCode(statusCode))
+		}
+		return attribute.NewSet(attrs...)
+	}
+	dp := func(statusCode int) metricdata.HistogramDataPoint[int64] {
+		return metricdata.HistogramDataPoint[int64]{Attributes: httpSet(statusCode)}
+	}
+
+	got := collect()
+	assert.Equal(t, Scope, got.Scope, "unexpected scope")
+	require.Len(t, got.Metrics, 2, "expected 2 metrics")
+
+	o := []metricdatatest.Option{
+		metricdatatest.IgnoreTimestamp(),
+		metricdatatest.IgnoreValue(),
+	}
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        httpconv.ClientRequestBodySize{}.Name(),
+		Description: httpconv.ClientRequestBodySize{}.Description(),
+		Unit:        httpconv.ClientRequestBodySize{}.Unit(),
+		Data: metricdata.Histogram[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[int64]{
+				dp(http.StatusOK),
+				dp(http.StatusServiceUnavailable),
+				dp(0),
+			},
+		},
+	}, got.Metrics[0], o...)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name:        httpconv.ClientResponseBodySize{}.Name(),
+		Description: httpconv.ClientResponseBodySize{}.Description(),
+		Unit:        httpconv.ClientResponseBodySize{}.Unit(),
+		Data: metricdata.Histogram[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[int64]{
+				dp(http.StatusOK),
+				dp(http.StatusServiceUnavailable),
+			},
+		},
+	}, got.Metrics[1], o...)
+
+	sums := map[string]int64{}
+	for _, m := range got.Metrics {
+		for _, d := range m.Data.(metricdata.Histogram[int64]).DataPoints {
+			sums[m.Name] += d.Sum
+		}
+	}
+	assert.Equal(t, int64(600), sums[httpconv.ClientRequestBodySize{}.Name()])
+	assert.Equal(t, int64(30), sums[httpconv.ClientResponseBodySize{}.Name()])
+}
+
 func TestBaseAttrs(t *testing.T) {
 	tests := []struct {
 		endpoint string
@@ -361,7 +431,7 @@ func BenchmarkInstrumentationExportSpans(b *testing.B) {
 	setup := func(b *testing.B) *observ.Instrumentation {
 		b.Helper()
 		b.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
-		inst, err := observ.NewInstrumentation(ID, Endpoint)
+		inst, err := observ.NewInstrumentation(nil, ID, Endpoint)
 		if err != nil {
 			b.Fatalf("failed to create instrumentation: %v", err)
 		}
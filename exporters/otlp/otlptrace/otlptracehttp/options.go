@@ -5,12 +5,14 @@ package otlptracehttp
 
 import (
 	"crypto/tls"
+	"io"
 	"net/http"
 	"net/url"
 	"time"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/otlpconfig"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp/internal/retry"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Compression describes the compression used for payloads sent to the
@@ -153,6 +155,109 @@ func WithMaxRequestSize(size int) Option {
 	return wrappedOption{otlpconfig.WithMaxRequestSize(size)}
 }
 
+// WithDryRun configures the exporter to serialize and validate export
+// requests without sending them. Each serialized export request is written
+// to sink with a single call to Write, so the length of the written bytes is
+// the size of the request before compression. If sink is nil, the serialized
+// requests are discarded.
+//
+// An export fails if its request cannot be serialized or exceeds the maximum
+// request size (see WithMaxRequestSize). This can be used to validate the
+// volume and content of the telemetry of an application, e.g. in continuous
+// integration, without sending it to a backend.
+func WithDryRun(sink io.Writer) Option {
+	return wrappedOption{otlpconfig.WithDryRun(sink)}
+}
+
+// WithPersistentQueue configures the exporter to persist export requests to the
+// directory dir when they fail because the endpoint is unavailable, e.g. after
+// the retries configured with WithRetry are exhausted. If an export fails
+// because its context is done, its request is also persisted, and an error is
+// returned to report it was not sent. Each subsequent export, including the
+// exports of a restarted process using the same directory, sends a batch of at
+// most 16 persisted requests in the background, in the order they were
+// persisted, and stops at the first one failing because the endpoint is still
+// unavailable. Shutting down the exporter stops sending them. While requests
+// are persisted, new spans are persisted without being sent so that the order
+// is kept.
+//
+// The total size of the persisted requests is limited to maxBytes. Requests
+// that would exceed this size are dropped and an error is returned. If
+// maxBytes is less than or equal to zero, the size is not limited.
+//
+// The directory is created if it does not exist. It must not be shared with
+// another exporter, including the exporter of another process.
+func WithPersistentQueue(dir string, maxBytes int64) Option {
+	return wrappedOption{otlpconfig.WithPersistentQueue(dir, maxBytes)}
+}
+
+// WithResponseHeaderHandler sets a function called with the headers and
+// trailers of the HTTP response to each export request, including the
+// requests that failed or are retried. Use it to read the headers set by the
+// endpoint, e.g. to slow down the exports when a rate limit header reports
+// few remaining requests, or to log the version of the collector.
+//
+// The function is called synchronously by the export and must not block.
+// It must not modify or retain header and trailer.
+func WithResponseHeaderHandler(h func(header, trailer http.Header)) Option {
+	if h == nil {
+		return wrappedOption{otlpconfig.WithResponseHandler(nil)}
+	}
+	return wrappedOption{otlpconfig.WithResponseHandler(func(header, trailer map[string][]string) {
+		h(header, trailer)
+	})}
+}
+
+// WithPayloadSizeHandler sets a function called with the size in bytes of the
+// protobuf encoded payload of each export request, before and after its
+// compression. compressed equals uncompressed if the payload is not
+// compressed. Use it to choose the compression and the size of the batches
+// based on the actual payloads.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry, and must not block.
+func WithPayloadSizeHandler(h func(uncompressed, compressed int)) Option {
+	return wrappedOption{otlpconfig.WithPayloadSizeHandler(h)}
+}
+
+// WithPayloadTransformer sets a function transforming the payload of each
+// export request before it is sent, e.g. to encrypt or sign it for the
+// endpoints requiring an application-layer encryption. The payload is the
+// protobuf encoded request, once compressed if a compression is configured.
+// The function can set the headers of the request in header, e.g. the key ID
+// of an envelope encryption, and returns the payload to send or an error
+// failing the export without retrying it.
+//
+// The Content-Type and Content-Encoding headers describe the payload before
+// its transformation and can be modified by the function. The size reported
+// to the handler of WithPayloadSizeHandler is the size of the transformed
+// payload.
+//
+// The function is called synchronously by the export, once per request and
+// not per retry. It must not retain or modify payload.
+func WithPayloadTransformer(f func(payload []byte, header http.Header) ([]byte, error)) Option {
+	if f == nil {
+		return wrappedOption{otlpconfig.WithPayloadTransformer(nil)}
+	}
+	return wrappedOption{otlpconfig.WithPayloadTransformer(
+		func(payload []byte, header map[string][]string) ([]byte, error) {
+			return f(payload, header)
+		},
+	)}
+}
+
+// WithSelfObservability configures the exporter to record its
+// self-observability metrics (e.g. exported spans and export duration) with
+// mp.
+//
+// This enables the self-observability metrics regardless of the
+// OTEL_GO_X_OBSERVABILITY environment variable. If this option is not used or
+// mp is nil, the metrics are recorded with the global MeterProvider only when
+// that environment variable enables them.
+func WithSelfObservability(mp metric.MeterProvider) Option {
+	return wrappedOption{otlpconfig.WithSelfObservability(mp)}
+}
+
 // WithRetry configures the retry policy for transient errors that may occurs
 // when exporting traces. An exponential back-off algorithm is used to ensure
 // endpoints are not overwhelmed with retries. If unset, the default retry
@@ -162,6 +267,37 @@ func WithRetry(rc RetryConfig) Option {
 	return wrappedOption{otlpconfig.WithRetry(retry.Config(rc))}
 }
 
+// WithRetryMaxElapsedTime sets the maximum amount of time, including retries,
+// spent trying to export each batch of spans. It overrides only the
+// MaxElapsedTime of the retry policy (see [WithRetry]) and leaves the other
+// retry settings unchanged. Options are applied in order, a later [WithRetry]
+// option overrides this option.
+//
+// Delays requested by the server with a Retry-After header are honored
+// within this limit. If a requested delay would exceed it, the batch is
+// dropped without waiting. Set this to a value larger than the longest
+// Retry-After delay expected to be honored.
+//
+// A value of zero means no limit.
+func WithRetryMaxElapsedTime(d time.Duration) Option {
+	return wrappedOption{otlpconfig.WithRetryMaxElapsedTime(d)}
+}
+
+// WithRetryableStatusCodes sets the HTTP response status codes that are
+// considered transient and retried. Responses with any other non-successful
+// status code are not retried.
+//
+// If this option is not used, responses with the 429 (Too Many Requests), 502
+// (Bad Gateway), 503 (Service Unavailable), and 504 (Gateway Timeout) status
+// codes are retried. Passing no codes disables retrying based on the status
+// code.
+func WithRetryableStatusCodes(codes ...int) Option {
+	if codes == nil {
+		codes = []int{}
+	}
+	return wrappedOption{otlpconfig.WithRetryableStatusCodes(codes)}
+}
+
 // WithProxy sets the Proxy function the client will use to determine the
 // proxy to use for an HTTP request. If this option is not used, the client
 // will use [http.ProxyFromEnvironment].
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracewriter // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracewriter"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// resourceSpansField is the field number of the resource_spans field of the
+// ExportTraceServiceRequest message.
+const resourceSpansField protowire.Number = 1
+
+var errShutdown = errors.New("the client is shutdown")
+
+type client struct {
+	// mu serializes the writes to w so the messages are not interleaved.
+	mu      sync.Mutex
+	w       io.Writer
+	stopped bool
+}
+
+// NewClient creates a new OTLP trace client writing length-prefixed
+// ExportTraceServiceRequest messages to w.
+//
+// Each request is written with a single call to Write, and the calls are
+// serialized. The client does not close w when it is stopped, the caller
+// owns it. w must not be nil.
+func NewClient(w io.Writer) otlptrace.Client {
+	return &client{w: w}
+}
+
+// Start does nothing, w is ready to be written to.
+func (*client) Start(context.Context) error {
+	return nil
+}
+
+// Stop makes the client return an error for all the later uploads.
+func (c *client) Stop(context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stopped = true
+	return nil
+}
+
+// UploadTraces writes the length-prefixed ExportTraceServiceRequest of
+// protoSpans to the writer of c.
+func (c *client) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b, err := marshalRequest(protoSpans)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.stopped {
+		return errShutdown
+	}
+	if _, err := c.w.Write(b); err != nil {
+		return fmt.Errorf("write request: %w", err)
+	}
+	return nil
+}
+
+// marshalRequest returns the ExportTraceServiceRequest of protoSpans
+// prefixed with its varint encoded length.
+//
+// The request message only has the repeated resource_spans field. It is
+// encoded here so this package does not depend on the gRPC service
+// definitions of the collector package.
+func marshalRequest(protoSpans []*tracepb.ResourceSpans) ([]byte, error) {
+	var msg []byte
+	for _, rs := range protoSpans {
+		rsb, err := proto.Marshal(rs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request: %w", err)
+		}
+		msg = protowire.AppendTag(msg, resourceSpansField, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, rsb)
+	}
+
+	b := make([]byte, 0, protowire.SizeVarint(uint64(len(msg)))+len(msg))
+	b = protowire.AppendVarint(b, uint64(len(msg)))
+	return append(b, msg...), nil
+}
+
+// MarshalLog is the marshaling function used by the logging system to
+// represent this Client.
+func (c *client) MarshalLog() any {
+	return struct {
+		Type   string
+		Writer string
+	}{
+		Type:   "otlptracewriter",
+		Writer: fmt.Sprintf("%T", c.w),
+	}
+}
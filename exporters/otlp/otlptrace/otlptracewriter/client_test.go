@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracewriter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+// readRequest reads a length-prefixed ExportTraceServiceRequest from r and
+// returns its resource spans.
+func readRequest(t *testing.T, r *bufio.Reader) []*tracepb.ResourceSpans {
+	t.Helper()
+
+	n, err := binary.ReadUvarint(r)
+	require.NoError(t, err)
+	msg := make([]byte, n)
+	_, err = io.ReadFull(r, msg)
+	require.NoError(t, err)
+
+	var out []*tracepb.ResourceSpans
+	for len(msg) > 0 {
+		num, typ, n := protowire.ConsumeTag(msg)
+		require.GreaterOrEqual(t, n, 0)
+		require.Equal(t, resourceSpansField, num)
+		require.Equal(t, protowire.BytesType, typ)
+		msg = msg[n:]
+
+		b, n := protowire.ConsumeBytes(msg)
+		require.GreaterOrEqual(t, n, 0)
+		msg = msg[n:]
+
+		rs := new(tracepb.ResourceSpans)
+		require.NoError(t, proto.Unmarshal(b, rs))
+		out = append(out, rs)
+	}
+	return out
+}
+
+func spans(names ...string) tracetest.SpanStubs {
+	out := make(tracetest.SpanStubs, len(names))
+	for i, name := range names {
+		out[i] = tracetest.SpanStub{
+			Name: name,
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: trace.TraceID{1},
+				SpanID:  trace.SpanID{byte(i + 1)},
+			}),
+		}
+	}
+	return out
+}
+
+func TestExporter(t *testing.T) {
+	var buf bytes.Buffer
+	exp, err := New(t.Context(), &buf)
+	require.NoError(t, err)
+
+	require.NoError(t, exp.ExportSpans(t.Context(), spans("a", "b").Snapshots()))
+	require.NoError(t, exp.ExportSpans(t.Context(), spans("c").Snapshots()))
+	// Empty exports are not written.
+	require.NoError(t, exp.ExportSpans(t.Context(), nil))
+	require.NoError(t, exp.Shutdown(t.Context()))
+
+	r := bufio.NewReader(&buf)
+	var got [][]string
+	for range 2 {
+		var names []string
+		for _, rs := range readRequest(t, r) {
+			for _, ss := range rs.ScopeSpans {
+				for _, s := range ss.Spans {
+					names = append(names, s.Name)
+				}
+			}
+		}
+		got = append(got, names)
+	}
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, got)
+	_, err = r.ReadByte()
+	assert.ErrorIs(t, err, io.EOF, "unexpected trailing data")
+}
+
+type errWriter struct{ err error }
+
+func (w errWriter) Write([]byte) (int, error) { return 0, w.err }
+
+func TestClientErrors(t *testing.T) {
+	rs := []*tracepb.ResourceSpans{{}}
+
+	errWrite := errors.New("write")
+	c := NewClient(errWriter{err: errWrite})
+	assert.ErrorIs(t, c.UploadTraces(t.Context(), rs), errWrite)
+
+	var buf bytes.Buffer
+	c = NewClient(&buf)
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	assert.ErrorIs(t, c.UploadTraces(ctx, rs), context.Canceled)
+
+	require.NoError(t, c.Stop(t.Context()))
+	assert.ErrorIs(t, c.UploadTraces(t.Context(), rs), errShutdown)
+	assert.Zero(t, buf.Len())
+}
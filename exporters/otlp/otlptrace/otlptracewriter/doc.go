@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package otlptracewriter provides an OTLP span exporter writing length-prefixed
+protobuf messages to an [io.Writer].
+
+Each export writes an ExportTraceServiceRequest message of the
+opentelemetry.proto.collector.trace.v1 package, prefixed with its length
+encoded as a protobuf varint. This is the delimited format read by
+[google.golang.org/protobuf/encoding/protodelim.UnmarshalFrom] and by the
+parseDelimitedFrom methods of the other protobuf implementations. It allows
+custom transports, e.g. an agent sidecar reading a local pipe or a Unix
+socket, without the overhead of HTTP or gRPC.
+
+Exporter should be created using [New].
+*/
+package otlptracewriter // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracewriter"
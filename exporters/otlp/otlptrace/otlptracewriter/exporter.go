@@ -0,0 +1,21 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otlptracewriter // import "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracewriter"
+
+import (
+	"context"
+	"io"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+)
+
+// New constructs a new Exporter writing to w and starts it.
+func New(ctx context.Context, w io.Writer) (*otlptrace.Exporter, error) {
+	return otlptrace.New(ctx, NewClient(w))
+}
+
+// NewUnstarted constructs a new Exporter writing to w and does not start it.
+func NewUnstarted(w io.Writer) *otlptrace.Exporter {
+	return otlptrace.NewUnstarted(NewClient(w))
+}
@@ -1,8 +1,12 @@
 // Copyright The OpenTelemetry Authors
 // SPDX-License-Identifier: Apache-2.0
 
-// Package tracetransform provides conversion functionality for the otlptrace
-// exporters.
+// Package tracetransform provides the conversion of OpenTelemetry spans to and
+// from their OTLP representation.
+//
+// It is the conversion used by the otlptrace exporters. Exporters sending OTLP
+// spans with another transport (e.g. a message queue or object storage) can
+// use it to produce the same OTLP spans.
 package tracetransform
 
 import (
@@ -0,0 +1,321 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetransform
+
+import (
+	"time"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ReadOnlySpans transforms a slice of OTLP ResourceSpans into a slice of
+// OpenTelemetry spans. It is the inverse of [Spans].
+//
+// The information of the OTLP spans that cannot be represented by a
+// ReadOnlySpan is lost. The trace flags and remote status of the span parents
+// are not included in OTLP, and the parents of the returned spans only have
+// their trace ID, span ID, and remote status set. A TraceState that cannot be
+// parsed is dropped. The child span count of the returned spans is always 0.
+func ReadOnlySpans(rss []*tracepb.ResourceSpans) []tracesdk.ReadOnlySpan {
+	var out []tracesdk.ReadOnlySpan
+	for _, rs := range rss {
+		if rs == nil {
+			continue
+		}
+		res := FromResource(rs.Resource, rs.SchemaUrl)
+		for _, ss := range rs.ScopeSpans {
+			if ss == nil {
+				continue
+			}
+			scope := FromInstrumentationScope(ss.Scope, ss.SchemaUrl)
+			for _, s := range ss.Spans {
+				if s == nil {
+					continue
+				}
+				stub := fromSpan(s)
+				stub.Resource = res
+				stub.InstrumentationScope = scope
+				out = append(out, stub.Snapshot())
+			}
+		}
+	}
+	return out
+}
+
+// FromResource transforms an OTLP Resource with schemaURL into a Resource. It
+// is the inverse of [Resource].
+func FromResource(r *resourcepb.Resource, schemaURL string) *resource.Resource {
+	if r == nil {
+		return resource.NewWithAttributes(schemaURL)
+	}
+	return resource.NewWithAttributes(schemaURL, FromKeyValues(r.Attributes)...)
+}
+
+// FromInstrumentationScope transforms an OTLP InstrumentationScope with
+// schemaURL into an instrumentation Scope. It is the inverse of
+// [InstrumentationScope].
+func FromInstrumentationScope(s *commonpb.InstrumentationScope, schemaURL string) instrumentation.Scope {
+	if s == nil {
+		return instrumentation.Scope{SchemaURL: schemaURL}
+	}
+	return instrumentation.Scope{
+		Name:       s.Name,
+		Version:    s.Version,
+		SchemaURL:  schemaURL,
+		Attributes: attribute.NewSet(FromKeyValues(s.Attributes)...),
+	}
+}
+
+// FromKeyValues transforms OTLP key-values into attribute KeyValues. It is the
+// inverse of [KeyValues].
+func FromKeyValues(kvs []*commonpb.KeyValue) []attribute.KeyValue {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	out := make([]attribute.KeyValue, 0, len(kvs))
+	for _, kv := range kvs {
+		if kv == nil {
+			continue
+		}
+		out = append(out, attribute.KeyValue{
+			Key:   attribute.Key(kv.Key),
+			Value: FromValue(kv.Value),
+		})
+	}
+	return out
+}
+
+// FromValue transforms an OTLP AnyValue into an attribute Value. It is the
+// inverse of [Value].
+//
+// OTLP arrays with values all of the same boolean, integer, double, or string
+// type are transformed into the matching attribute slice type. Other arrays
+// are transformed into an attribute SLICE.
+func FromValue(v *commonpb.AnyValue) attribute.Value {
+	if v == nil {
+		return attribute.Value{}
+	}
+	switch val := v.Value.(type) {
+	case *commonpb.AnyValue_BoolValue:
+		return attribute.BoolValue(val.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return attribute.Int64Value(val.IntValue)
+	case *commonpb.AnyValue_DoubleValue:
+		return attribute.Float64Value(val.DoubleValue)
+	case *commonpb.AnyValue_StringValue:
+		return attribute.StringValue(val.StringValue)
+	case *commonpb.AnyValue_BytesValue:
+		return attribute.ByteSliceValue(val.BytesValue)
+	case *commonpb.AnyValue_ArrayValue:
+		return fromArray(val.ArrayValue.GetValues())
+	case *commonpb.AnyValue_KvlistValue:
+		return attribute.MapValue(FromKeyValues(val.KvlistValue.GetValues())...)
+	}
+	return attribute.Value{}
+}
+
+// fromArray transforms the values of an OTLP ArrayValue into an attribute
+// Value.
+func fromArray(vals []*commonpb.AnyValue) attribute.Value {
+	converted := make([]attribute.Value, len(vals))
+	typ := attribute.INVALID
+	for i, v := range vals {
+		converted[i] = FromValue(v)
+		switch {
+		case i == 0:
+			typ = converted[i].Type()
+		case typ != converted[i].Type():
+			typ = attribute.INVALID
+		}
+	}
+
+	switch typ {
+	case attribute.BOOL:
+		s := make([]bool, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsBool()
+		}
+		return attribute.BoolSliceValue(s)
+	case attribute.INT64:
+		s := make([]int64, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsInt64()
+		}
+		return attribute.Int64SliceValue(s)
+	case attribute.FLOAT64:
+		s := make([]float64, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsFloat64()
+		}
+		return attribute.Float64SliceValue(s)
+	case attribute.STRING:
+		s := make([]string, len(converted))
+		for i, v := range converted {
+			s[i] = v.AsString()
+		}
+		return attribute.StringSliceValue(s)
+	}
+	return attribute.SliceValue(converted...)
+}
+
+// fromSpan transforms an OTLP span into a SpanStub without a resource and
+// instrumentation scope.
+func fromSpan(s *tracepb.Span) tracetest.SpanStub {
+	var tid trace.TraceID
+	copy(tid[:], s.TraceId)
+	var sid trace.SpanID
+	copy(sid[:], s.SpanId)
+	ts, err := trace.ParseTraceState(s.TraceState)
+	if err != nil {
+		ts = trace.TraceState{}
+	}
+
+	stub := tracetest.SpanStub{
+		Name: s.Name,
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    tid,
+			SpanID:     sid,
+			TraceFlags: trace.TraceFlags(s.Flags & 0xff), // nolint:gosec // Masked to 8 bits.
+			TraceState: ts,
+		}),
+		SpanKind:          fromSpanKind(s.Kind),
+		StartTime:         fromUnixNano(s.StartTimeUnixNano),
+		EndTime:           fromUnixNano(s.EndTimeUnixNano),
+		Attributes:        FromKeyValues(s.Attributes),
+		Events:            fromSpanEvents(s.Events),
+		Links:             fromLinks(tid, s.Links),
+		Status:            fromStatus(s.Status),
+		DroppedAttributes: int(s.DroppedAttributesCount),
+		DroppedEvents:     int(s.DroppedEventsCount),
+		DroppedLinks:      int(s.DroppedLinksCount),
+	}
+
+	if len(s.ParentSpanId) > 0 {
+		var psid trace.SpanID
+		copy(psid[:], s.ParentSpanId)
+		stub.Parent = trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: tid,
+			SpanID:  psid,
+			Remote:  isRemote(s.Flags),
+		})
+	}
+	return stub
+}
+
+// isRemote returns whether OTLP span flags indicate a remote span context.
+func isRemote(flags uint32) bool {
+	return flags&uint32(tracepb.SpanFlags_SPAN_FLAGS_CONTEXT_IS_REMOTE_MASK) != 0
+}
+
+// fromUnixNano returns the time for an OTLP timestamp. A zero timestamp is
+// returned as the zero time.
+func fromUnixNano(ns uint64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(ns)) // nolint:gosec // Overflow is not expected before year 2262.
+}
+
+// fromStatus transforms an OTLP span status into a span Status.
+func fromStatus(s *tracepb.Status) tracesdk.Status {
+	if s == nil {
+		return tracesdk.Status{}
+	}
+	var c codes.Code
+	switch s.Code {
+	case tracepb.Status_STATUS_CODE_OK:
+		c = codes.Ok
+	case tracepb.Status_STATUS_CODE_ERROR:
+		c = codes.Error
+	default:
+		c = codes.Unset
+	}
+	return tracesdk.Status{Code: c, Description: s.Message}
+}
+
+// fromLinks transforms OTLP span links into span Links.
+func fromLinks(traceID trace.TraceID, links []*tracepb.Span_Link) []tracesdk.Link {
+	if len(links) == 0 {
+		return nil
+	}
+
+	out := make([]tracesdk.Link, 0, len(links))
+	for _, l := range links {
+		if l == nil {
+			continue
+		}
+		tid := traceID
+		if len(l.TraceId) > 0 {
+			copy(tid[:], l.TraceId)
+		}
+		var sid trace.SpanID
+		copy(sid[:], l.SpanId)
+		ts, err := trace.ParseTraceState(l.TraceState)
+		if err != nil {
+			ts = trace.TraceState{}
+		}
+		out = append(out, tracesdk.Link{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    tid,
+				SpanID:     sid,
+				TraceFlags: trace.TraceFlags(l.Flags & 0xff), // nolint:gosec // Masked to 8 bits.
+				TraceState: ts,
+				Remote:     isRemote(l.Flags),
+			}),
+			Attributes:            FromKeyValues(l.Attributes),
+			DroppedAttributeCount: int(l.DroppedAttributesCount),
+		})
+	}
+	return out
+}
+
+// fromSpanEvents transforms OTLP span events into span Events.
+func fromSpanEvents(es []*tracepb.Span_Event) []tracesdk.Event {
+	if len(es) == 0 {
+		return nil
+	}
+
+	out := make([]tracesdk.Event, 0, len(es))
+	for _, e := range es {
+		if e == nil {
+			continue
+		}
+		out = append(out, tracesdk.Event{
+			Name:                  e.Name,
+			Time:                  fromUnixNano(e.TimeUnixNano),
+			Attributes:            FromKeyValues(e.Attributes),
+			DroppedAttributeCount: int(e.DroppedAttributesCount),
+		})
+	}
+	return out
+}
+
+// fromSpanKind transforms an OTLP span kind into a SpanKind.
+func fromSpanKind(kind tracepb.Span_SpanKind) trace.SpanKind {
+	switch kind {
+	case tracepb.Span_SPAN_KIND_INTERNAL:
+		return trace.SpanKindInternal
+	case tracepb.Span_SPAN_KIND_CLIENT:
+		return trace.SpanKindClient
+	case tracepb.Span_SPAN_KIND_SERVER:
+		return trace.SpanKindServer
+	case tracepb.Span_SPAN_KIND_PRODUCER:
+		return trace.SpanKindProducer
+	case tracepb.Span_SPAN_KIND_CONSUMER:
+		return trace.SpanKindConsumer
+	default:
+		return trace.SpanKindUnspecified
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetransform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	tracesdk "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestReadOnlySpansRoundTrip(t *testing.T) {
+	start := time.Unix(1585674086, 1234)
+	end := start.Add(10 * time.Second)
+	ts, err := trace.ParseTraceState("key1=val1,key2=val2")
+	require.NoError(t, err)
+	tid := trace.TraceID{0x01}
+
+	want := tracetest.SpanStub{
+		Name: "span",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    tid,
+			SpanID:     trace.SpanID{0x02},
+			TraceFlags: trace.FlagsSampled,
+			TraceState: ts,
+		}),
+		Parent: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: tid,
+			SpanID:  trace.SpanID{0x03},
+			Remote:  true,
+		}),
+		SpanKind:  trace.SpanKindClient,
+		StartTime: start,
+		EndTime:   end,
+		Attributes: []attribute.KeyValue{
+			attribute.Bool("bool", true),
+			attribute.Int64("int", 1),
+			attribute.Float64("float", 1.5),
+			attribute.String("string", "value"),
+			attribute.StringSlice("strings", []string{"a", "b"}),
+			attribute.Int64Slice("ints", []int64{1, 2}),
+		},
+		Events: []tracesdk.Event{{
+			Name:                  "event",
+			Time:                  start.Add(time.Second),
+			Attributes:            []attribute.KeyValue{attribute.String("key", "value")},
+			DroppedAttributeCount: 1,
+		}},
+		Links: []tracesdk.Link{{
+			SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x04},
+				SpanID:     trace.SpanID{0x05},
+				TraceFlags: trace.FlagsSampled,
+				Remote:     true,
+			}),
+			Attributes:            []attribute.KeyValue{attribute.String("key", "value")},
+			DroppedAttributeCount: 2,
+		}},
+		Status:            tracesdk.Status{Code: codes.Error, Description: "error"},
+		DroppedAttributes: 1,
+		DroppedEvents:     2,
+		DroppedLinks:      3,
+		Resource:          resource.NewWithAttributes("https://example.com/schema", attribute.String("rk", "rv")),
+		InstrumentationScope: instrumentation.Scope{
+			Name:       "scope",
+			Version:    "v0.1.0",
+			SchemaURL:  "https://example.com/scope",
+			Attributes: attribute.NewSet(attribute.String("sk", "sv")),
+		},
+	}
+
+	got := ReadOnlySpans(Spans(tracetest.SpanStubs{want}.Snapshots()))
+	require.Len(t, got, 1)
+	stub := tracetest.SpanStubFromReadOnlySpan(got[0])
+
+	assert.True(t, want.Resource.Equal(stub.Resource), "resource")
+	assert.Equal(t, want.Resource.SchemaURL(), stub.Resource.SchemaURL())
+	want.Resource, stub.Resource = nil, nil
+	want.InstrumentationLibrary = want.InstrumentationScope //nolint:staticcheck // Set by SpanStubFromReadOnlySpan.
+	assert.Equal(t, want, stub)
+}
+
+func TestReadOnlySpansNil(t *testing.T) {
+	assert.Nil(t, ReadOnlySpans(nil))
+	assert.Nil(t, ReadOnlySpans([]*tracepb.ResourceSpans{
+		nil,
+		{ScopeSpans: []*tracepb.ScopeSpans{nil, {Spans: []*tracepb.Span{nil}}}},
+	}))
+}
+
+func TestReadOnlySpansInvalidTraceState(t *testing.T) {
+	got := ReadOnlySpans([]*tracepb.ResourceSpans{{
+		ScopeSpans: []*tracepb.ScopeSpans{{
+			Spans: []*tracepb.Span{{Name: "span", TraceState: "invalid tracestate"}},
+		}},
+	}})
+	require.Len(t, got, 1)
+	assert.Equal(t, "span", got[0].Name())
+	assert.Equal(t, 0, got[0].SpanContext().TraceState().Len())
+	assert.True(t, got[0].StartTime().IsZero())
+}
+
+func TestFromValue(t *testing.T) {
+	tests := []struct {
+		name string
+		v    attribute.Value
+	}{
+		{"Empty", attribute.Value{}},
+		{"Bool", attribute.BoolValue(true)},
+		{"BoolSlice", attribute.BoolSliceValue([]bool{true, false})},
+		{"Int64", attribute.Int64Value(1)},
+		{"Int64Slice", attribute.Int64SliceValue([]int64{1, 2})},
+		{"Float64", attribute.Float64Value(1.5)},
+		{"Float64Slice", attribute.Float64SliceValue([]float64{1.5, 2.5})},
+		{"String", attribute.StringValue("value")},
+		{"StringSlice", attribute.StringSliceValue([]string{"a", "b"})},
+		{"ByteSlice", attribute.ByteSliceValue([]byte("value"))},
+		{"Slice", attribute.SliceValue(attribute.StringValue("a"), attribute.Int64Value(1))},
+		{"Map", attribute.MapValue(attribute.String("key", "value"), attribute.Int("int", 1))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.v, FromValue(Value(tt.v)))
+		})
+	}
+}
+
+func TestFromValueNil(t *testing.T) {
+	assert.Equal(t, attribute.Value{}, FromValue(nil))
+	assert.Equal(t, attribute.Value{}, FromValue(&commonpb.AnyValue{}))
+}
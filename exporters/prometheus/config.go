@@ -4,6 +4,8 @@
 package prometheus
 
 import (
+	"maps"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/otlptranslator"
 
@@ -18,10 +20,14 @@ type config struct {
 	translationStrategy      otlptranslator.TranslationStrategyOption
 	withoutUnits             bool
 	withoutCounterSuffixes   bool
+	withoutExemplars         bool
 	readerOpts               []metric.ManualReaderOption
 	disableScopeInfo         bool
 	namespace                string
 	resourceAttributesFilter attribute.Filter
+
+	tenantFunc        TenantFunc
+	tenantRegisterers map[string]prometheus.Registerer
 }
 
 // newConfig creates a validated config configured with options.
@@ -165,6 +171,17 @@ func WithoutCounterSuffixes() Option {
 	})
 }
 
+// WithoutExemplars configures the Exporter to not export the exemplars
+// recorded by the SDK. By default, the exemplars of counters and histograms
+// are exported along with the trace ID, span ID, and filtered attributes they
+// were recorded with. Use this option for backends that reject exemplars.
+func WithoutExemplars() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.withoutExemplars = true
+		return cfg
+	})
+}
+
 // WithoutScopeInfo configures the Exporter to not export
 // labels about Instrumentation Scope to all metric points.
 func WithoutScopeInfo() Option {
@@ -196,3 +213,27 @@ func WithResourceAsConstantLabels(resourceFilter attribute.Filter) Option {
 		return cfg
 	})
 }
+
+// WithTenants configures the Exporter to serve the data points of each
+// tenant with its own Registerer, so a single MeterProvider can expose a
+// scrape endpoint per tenant or per component. The tenant of each data point
+// is returned by f, and its data points are collected by the Registerer of
+// registerers for the tenant. The data points of the tenants without a
+// Registerer are collected by the Registerer configured with
+// [WithRegisterer].
+//
+// Each Registerer collects the target_info metric, unless
+// [WithoutTargetInfo] is used. The data points are collected from the
+// MeterProvider once per Registerer gathered.
+//
+// If f is nil, this option has no effect.
+func WithTenants(f TenantFunc, registerers map[string]prometheus.Registerer) Option {
+	return optionFunc(func(cfg config) config {
+		if f == nil {
+			return cfg
+		}
+		cfg.tenantFunc = f
+		cfg.tenantRegisterers = maps.Clone(registerers)
+		return cfg
+	})
+}
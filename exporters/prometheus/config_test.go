@@ -181,6 +181,17 @@ func TestNewConfig(t *testing.T) {
 				withoutCounterSuffixes: true,
 			},
 		},
+		{
+			name: "exemplars disabled",
+			options: []Option{
+				WithoutExemplars(),
+			},
+			wantConfig: config{
+				translationStrategy: otlptranslator.UnderscoreEscapingWithSuffixes,
+				registerer:          prometheus.DefaultRegisterer,
+				withoutExemplars:    true,
+			},
+		},
 		{
 			name: "with namespace",
 			options: []Option{
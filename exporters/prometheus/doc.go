@@ -8,4 +8,10 @@
 // The Prometheus exporter ignores metrics from the Prometheus bridge. To
 // export these metrics, simply register them directly with the Prometheus
 // Handler.
+//
+// Series of synchronous gauges are exposed until their attribute sets become
+// stale (see the Staleness of the Stream of a View in
+// go.opentelemetry.io/otel/sdk/metric). Once stale, a series is no longer
+// exposed and Prometheus records a staleness marker for it on its next
+// scrape.
 package prometheus
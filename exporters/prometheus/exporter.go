@@ -8,6 +8,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"maps"
 	"math"
 	"slices"
 	"strings"
@@ -84,9 +85,12 @@ type keyVals struct {
 // collector is used to implement prometheus.Collector.
 type collector struct {
 	reader metric.Reader
+	// tenant filters the collected data points, if not nil.
+	tenant tenantFilter
 
 	withoutUnits             bool
 	withoutCounterSuffixes   bool
+	withoutExemplars         bool
 	disableScopeInfo         bool
 	namespace                string
 	resourceAttributesFilter attribute.Filter
@@ -130,30 +134,67 @@ func New(opts ...Option) (*Exporter, error) {
 		}
 	}
 
-	collector := &collector{
-		reader:                   reader,
-		disableTargetInfo:        cfg.disableTargetInfo,
-		withoutUnits:             cfg.withoutUnits,
-		withoutCounterSuffixes:   cfg.withoutCounterSuffixes,
-		disableScopeInfo:         cfg.disableScopeInfo,
-		metricFamilies:           make(map[string]*dto.MetricFamily),
-		namespace:                escapedNamespace,
-		resourceAttributesFilter: cfg.resourceAttributesFilter,
-		metricNamer:              otlptranslator.NewMetricNamer(escapedNamespace, cfg.translationStrategy),
-		unitNamer:                otlptranslator.UnitNamer{UTF8Allowed: !cfg.translationStrategy.ShouldEscape()},
-		labelNamer:               labelNamer,
+	newCollector := func(tenant tenantFilter) *collector {
+		return &collector{
+			reader:                   reader,
+			tenant:                   tenant,
+			disableTargetInfo:        cfg.disableTargetInfo,
+			withoutUnits:             cfg.withoutUnits,
+			withoutCounterSuffixes:   cfg.withoutCounterSuffixes,
+			withoutExemplars:         cfg.withoutExemplars,
+			disableScopeInfo:         cfg.disableScopeInfo,
+			metricFamilies:           make(map[string]*dto.MetricFamily),
+			namespace:                escapedNamespace,
+			resourceAttributesFilter: cfg.resourceAttributesFilter,
+			metricNamer:              otlptranslator.NewMetricNamer(escapedNamespace, cfg.translationStrategy),
+			unitNamer:                otlptranslator.UnitNamer{UTF8Allowed: !cfg.translationStrategy.ShouldEscape()},
+			labelNamer:               labelNamer,
+		}
 	}
 
-	if err := cfg.registerer.Register(collector); err != nil {
-		return nil, fmt.Errorf("cannot register the collector: %w", err)
+	type registration struct {
+		registerer prometheus.Registerer
+		collector  *collector
+	}
+	var regs []registration
+	if cfg.tenantFunc == nil {
+		regs = append(regs, registration{registerer: cfg.registerer, collector: newCollector(nil)})
+	} else {
+		tenants := make(map[string]bool, len(cfg.tenantRegisterers))
+		for tenant, reg := range cfg.tenantRegisterers {
+			if reg != nil {
+				tenants[tenant] = true
+			}
+		}
+		regs = append(regs, registration{
+			registerer: cfg.registerer,
+			collector:  newCollector(newTenantFilter(cfg.tenantFunc, tenants, "", false)),
+		})
+		for _, tenant := range slices.Sorted(maps.Keys(tenants)) {
+			regs = append(regs, registration{
+				registerer: cfg.tenantRegisterers[tenant],
+				collector:  newCollector(newTenantFilter(cfg.tenantFunc, tenants, tenant, true)),
+			})
+		}
+	}
+
+	for i, r := range regs {
+		if err := r.registerer.Register(r.collector); err != nil {
+			for _, registered := range regs[:i] {
+				registered.registerer.Unregister(registered.collector)
+			}
+			return nil, fmt.Errorf("cannot register the collector: %w", err)
+		}
 	}
 
 	e := &Exporter{
 		Reader: reader,
 	}
 
-	var err error
-	collector.inst, err = observ.NewInstrumentation(counter.NextExporterID())
+	inst, err := observ.NewInstrumentation(counter.NextExporterID())
+	for _, r := range regs {
+		r.collector.inst = inst
+	}
 
 	return e, err
 }
@@ -279,6 +320,12 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 		kv.vals = append(kv.vals, c.resourceKeyVals.vals...)
 
 		for k, m := range scopeMetrics.Metrics {
+			if c.tenant != nil {
+				var ok bool
+				if m.Data, ok = c.tenant.filter(scopeMetrics.Scope, m.Data); !ok {
+					continue
+				}
+			}
 			typ := c.metricType(m)
 			if typ == nil {
 				reportError(ch, nil, errInvalidMetricType)
@@ -303,17 +350,17 @@ func (c *collector) Collect(ch chan<- prometheus.Metric) {
 
 			switch v := m.Data.(type) {
 			case metricdata.Histogram[int64]:
-				addHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.Histogram[float64]:
-				addHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.ExponentialHistogram[int64]:
-				addExponentialHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addExponentialHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.ExponentialHistogram[float64]:
-				addExponentialHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addExponentialHistogramMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.Sum[int64]:
-				addSumMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addSumMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.Sum[float64]:
-				addSumMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
+				addSumMetric(ch, v, m, name, kv, c.labelNamer, c.withoutExemplars, c.inst, ctx)
 			case metricdata.Gauge[int64]:
 				addGaugeMetric(ch, v, m, name, kv, c.labelNamer, c.inst, ctx)
 			case metricdata.Gauge[float64]:
@@ -383,6 +430,7 @@ func addExponentialHistogramMetric[N int64 | float64](
 	name string,
 	kv keyVals,
 	labelNamer otlptranslator.LabelNamer,
+	withoutExemplars bool,
 	inst *observ.Instrumentation,
 	ctx context.Context,
 ) {
@@ -471,7 +519,9 @@ func addExponentialHistogramMetric[N int64 | float64](
 			)
 			continue
 		}
-		m = addExemplars(m, dp.Exemplars, labelNamer)
+		if !withoutExemplars {
+			m = addExemplars(m, dp.Exemplars, labelNamer)
+		}
 		ch <- m
 
 		success++
@@ -485,6 +535,7 @@ func addHistogramMetric[N int64 | float64](
 	name string,
 	kv keyVals,
 	labelNamer otlptranslator.LabelNamer,
+	withoutExemplars bool,
 	inst *observ.Instrumentation,
 	ctx context.Context,
 ) {
@@ -519,7 +570,9 @@ func addHistogramMetric[N int64 | float64](
 			err = errors.Join(err, fmt.Errorf("failed to NewConstMetric for histogram.DataPoints %d: %w", j, e))
 			continue
 		}
-		m = addExemplars(m, dp.Exemplars, labelNamer)
+		if !withoutExemplars {
+			m = addExemplars(m, dp.Exemplars, labelNamer)
+		}
 		ch <- m
 
 		success++
@@ -533,6 +586,7 @@ func addSumMetric[N int64 | float64](
 	name string,
 	kv keyVals,
 	labelNamer otlptranslator.LabelNamer,
+	withoutExemplars bool,
 	inst *observ.Instrumentation,
 	ctx context.Context,
 ) {
@@ -567,7 +621,7 @@ func addSumMetric[N int64 | float64](
 		}
 		// GaugeValues don't support Exemplars at this time
 		// https://github.com/prometheus/client_golang/blob/aef8aedb4b6e1fb8ac1c90790645169125594096/prometheus/metric.go#L199
-		if valueType != prometheus.GaugeValue {
+		if valueType != prometheus.GaugeValue && !withoutExemplars {
 			m = addExemplars(m, dp.Exemplars, labelNamer)
 		}
 		ch <- m
@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"slices"
 	"sync"
 	"testing"
 	"time"
@@ -1220,6 +1221,108 @@ func TestDuplicateMetrics(t *testing.T) {
 	}
 }
 
+func TestViewDescriptionAndUnit(t *testing.T) {
+	callback := otelmetric.WithFloat64Callback(func(_ context.Context, o otelmetric.Float64Observer) error {
+		o.Observe(1)
+		return nil
+	})
+	desc := otelmetric.WithDescription("instrument description")
+	unit := otelmetric.WithUnit("ms")
+	testCases := []struct {
+		name     string
+		record   func(ctx context.Context, meter otelmetric.Meter, name string) error
+		wantName string
+	}{
+		{
+			name: "Counter",
+			record: func(ctx context.Context, meter otelmetric.Meter, name string) error {
+				c, err := meter.Float64Counter(name, desc, unit)
+				c.Add(ctx, 1)
+				return err
+			},
+			wantName: "%s_seconds_total",
+		},
+		{
+			name: "UpDownCounter",
+			record: func(ctx context.Context, meter otelmetric.Meter, name string) error {
+				c, err := meter.Float64UpDownCounter(name, desc, unit)
+				c.Add(ctx, 1)
+				return err
+			},
+			wantName: "%s_seconds",
+		},
+		{
+			name: "Histogram",
+			record: func(ctx context.Context, meter otelmetric.Meter, name string) error {
+				h, err := meter.Float64Histogram(name, desc, unit)
+				h.Record(ctx, 1)
+				return err
+			},
+			wantName: "%s_seconds",
+		},
+		{
+			name: "Gauge",
+			record: func(ctx context.Context, meter otelmetric.Meter, name string) error {
+				g, err := meter.Float64Gauge(name, desc, unit)
+				g.Record(ctx, 1)
+				return err
+			},
+			wantName: "%s_seconds",
+		},
+		{
+			name: "ObservableCounter",
+			record: func(_ context.Context, meter otelmetric.Meter, name string) error {
+				_, err := meter.Float64ObservableCounter(name, desc, unit, callback)
+				return err
+			},
+			wantName: "%s_seconds_total",
+		},
+		{
+			name: "ObservableGauge",
+			record: func(_ context.Context, meter otelmetric.Meter, name string) error {
+				_, err := meter.Float64ObservableGauge(name, desc, unit, callback)
+				return err
+			},
+			wantName: "%s_seconds",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			registry := prometheus.NewRegistry()
+			exporter, err := New(WithRegisterer(registry), WithoutTargetInfo())
+			require.NoError(t, err)
+			provider := metric.NewMeterProvider(
+				metric.WithReader(exporter),
+				metric.WithView(
+					metric.NewView(
+						metric.Instrument{Name: "foo"},
+						metric.Stream{Description: "view description", Unit: "s"},
+					),
+					metric.NewView(
+						metric.Instrument{Name: "bar"},
+						metric.Stream{Name: "baz", Description: "renamed description", Unit: "s"},
+					),
+				),
+			)
+			meter := provider.Meter(t.Name())
+			require.NoError(t, tc.record(t.Context(), meter, "foo"))
+			require.NoError(t, tc.record(t.Context(), meter, "bar"))
+
+			mfs, err := registry.Gather()
+			require.NoError(t, err)
+			got := make(map[string]string)
+			for _, mf := range mfs {
+				got[mf.GetName()] = mf.GetHelp()
+			}
+			assert.Equal(t, map[string]string{
+				fmt.Sprintf(tc.wantName, "foo"): "view description",
+				fmt.Sprintf(tc.wantName, "baz"): "renamed description",
+			}, got)
+		})
+	}
+}
+
 func TestCollectorConcurrentSafe(t *testing.T) {
 	// This tests makes sure that the implemented
 	// https://pkg.go.dev/github.com/prometheus/client_golang/prometheus#Collector
@@ -1506,12 +1609,64 @@ func TestExemplars(t *testing.T) {
 	}
 }
 
+func TestWithoutExemplars(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	exporter, err := New(
+		WithRegisterer(registry),
+		WithoutTargetInfo(),
+		WithoutScopeInfo(),
+		WithoutExemplars(),
+	)
+	require.NoError(t, err)
+
+	provider := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithView(metric.NewView(
+			metric.Instrument{Name: "exponential_histogram"},
+			metric.Stream{
+				Aggregation: metric.AggregationBase2ExponentialHistogram{MaxSize: 20},
+			},
+		)),
+	)
+	meter := provider.Meter("meter")
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		SpanID:     trace.SpanID{0o1},
+		TraceID:    trace.TraceID{0o1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(t.Context(), sc)
+
+	counter, err := meter.Float64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(ctx, 9)
+	hist, err := meter.Int64Histogram("histogram")
+	require.NoError(t, err)
+	hist.Record(ctx, 9)
+	expHist, err := meter.Int64Histogram("exponential_histogram")
+	require.NoError(t, err)
+	expHist.Record(ctx, 9)
+
+	got, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	for _, family := range got {
+		for _, m := range family.GetMetric() {
+			assert.Nil(t, m.GetCounter().GetExemplar(), family.GetName())
+			for _, b := range m.GetHistogram().GetBucket() {
+				assert.Nil(t, b.GetExemplar(), family.GetName())
+			}
+			assert.Empty(t, m.GetHistogram().GetExemplars(), family.GetName())
+		}
+	}
+}
+
 func TestExponentialHistogramScaleValidation(t *testing.T) {
 	ctx := t.Context()
 
 	t.Run("normal_exponential_histogram_works", func(t *testing.T) {
 		registry := prometheus.NewRegistry()
-		exporter, err := New(WithRegisterer(registry), WithoutTargetInfo(), WithoutScopeInfo())
+		exporter, err := New(WithRegisterer(registry))
 		require.NoError(t, err)
 
 		provider := metric.NewMeterProvider(
@@ -1587,6 +1742,7 @@ func TestExponentialHistogramScaleValidation(t *testing.T) {
 			"test_histogram",
 			keyVals{},
 			otlptranslator.LabelNamer{},
+			false,
 			nil,
 			t.Context(),
 		)
@@ -1757,6 +1913,7 @@ func TestExponentialHistogramHighScaleDownscaling(t *testing.T) {
 			"test_high_scale_histogram",
 			keyVals{},
 			otlptranslator.LabelNamer{},
+			false,
 			nil,
 			t.Context(),
 		)
@@ -1821,6 +1978,7 @@ func TestExponentialHistogramHighScaleDownscaling(t *testing.T) {
 			"test_very_high_scale_histogram",
 			keyVals{},
 			otlptranslator.LabelNamer{},
+			false,
 			nil,
 			t.Context(),
 		)
@@ -1885,6 +2043,7 @@ func TestExponentialHistogramHighScaleDownscaling(t *testing.T) {
 			"test_histogram_with_negative_buckets",
 			keyVals{},
 			otlptranslator.LabelNamer{},
+			false,
 			nil,
 			t.Context(),
 		)
@@ -1943,6 +2102,7 @@ func TestExponentialHistogramHighScaleDownscaling(t *testing.T) {
 			"test_int64_exponential_histogram",
 			keyVals{},
 			otlptranslator.LabelNamer{},
+			false,
 			nil,
 			t.Context(),
 		)
@@ -3052,3 +3212,136 @@ func (m *errMeter) Int64Counter(string, ...otelmetric.Int64CounterOption) (otelm
 func (m *errMeter) Float64Histogram(string, ...otelmetric.Float64HistogramOption) (otelmetric.Float64Histogram, error) {
 	return nil, m.err
 }
+
+func TestStaleGaugeSeriesRemoved(t *testing.T) {
+	ctx := t.Context()
+	registry := prometheus.NewRegistry()
+	exporter, err := New(WithRegisterer(registry))
+	require.NoError(t, err)
+
+	provider := metric.NewMeterProvider(
+		metric.WithReader(exporter),
+		metric.WithView(metric.NewView(
+			metric.Instrument{Name: "connections"},
+			metric.Stream{Staleness: 50 * time.Millisecond},
+		)),
+	)
+	t.Cleanup(func() { require.NoError(t, provider.Shutdown(context.Background())) })
+
+	gauge, err := provider.Meter("test").Int64Gauge("connections")
+	require.NoError(t, err)
+
+	series := func() int {
+		mfs, err := registry.Gather()
+		require.NoError(t, err)
+		var n int
+		for _, mf := range mfs {
+			if mf.GetName() == "connections" {
+				n += len(mf.GetMetric())
+			}
+		}
+		return n
+	}
+
+	gauge.Record(ctx, 1, otelmetric.WithAttributes(attribute.String("conn", "a")))
+	gauge.Record(ctx, 1, otelmetric.WithAttributes(attribute.String("conn", "b")))
+	assert.Equal(t, 2, series())
+
+	time.Sleep(100 * time.Millisecond)
+	gauge.Record(ctx, 2, otelmetric.WithAttributes(attribute.String("conn", "a")))
+	// The stale series is no longer exposed, which Prometheus records with a
+	// staleness marker.
+	assert.Equal(t, 1, series())
+}
+
+func TestTenants(t *testing.T) {
+	defaultRegistry := prometheus.NewRegistry()
+	registries := map[string]*prometheus.Registry{
+		"a": prometheus.NewRegistry(),
+		"b": prometheus.NewRegistry(),
+	}
+	exporter, err := New(
+		WithRegisterer(defaultRegistry),
+		WithoutTargetInfo(),
+		WithoutScopeInfo(),
+		WithTenants(func(scope instrumentation.Scope, attrs attribute.Set) string {
+			if scope.Name == "component" {
+				return "b"
+			}
+			v, _ := attrs.Value("tenant")
+			return v.AsString()
+		}, map[string]prometheus.Registerer{
+			"a": registries["a"],
+			"b": registries["b"],
+		}),
+	)
+	require.NoError(t, err)
+	provider := metric.NewMeterProvider(metric.WithReader(exporter))
+
+	ctx := t.Context()
+	cnt, err := provider.Meter("test").Int64Counter("requests")
+	require.NoError(t, err)
+	for _, tenant := range []string{"a", "a", "c"} {
+		cnt.Add(ctx, 1, otelmetric.WithAttributes(attribute.String("tenant", tenant)))
+	}
+	hist, err := provider.Meter("component").Float64Histogram("latency")
+	require.NoError(t, err)
+	hist.Record(ctx, 1, otelmetric.WithAttributes(attribute.String("tenant", "a")))
+
+	series := func(g prometheus.Gatherer) map[string]float64 {
+		mfs, err := g.Gather()
+		require.NoError(t, err)
+		got := make(map[string]float64)
+		for _, mf := range mfs {
+			for _, m := range mf.GetMetric() {
+				var tenant string
+				for _, l := range m.GetLabel() {
+					if l.GetName() == "tenant" {
+						tenant = l.GetValue()
+					}
+				}
+				v := m.GetCounter().GetValue()
+				if m.GetHistogram() != nil {
+					v = float64(m.GetHistogram().GetSampleCount())
+				}
+				got[mf.GetName()+"/"+tenant] = v
+			}
+		}
+		return got
+	}
+	assert.Equal(t, map[string]float64{"requests_total/a": 2}, series(registries["a"]))
+	assert.Equal(t, map[string]float64{"latency/a": 1}, series(registries["b"]))
+	assert.Equal(t, map[string]float64{"requests_total/c": 1}, series(defaultRegistry))
+}
+
+// recordingRegisterer records the collectors registered, failing if err is
+// not nil.
+type recordingRegisterer struct {
+	prometheus.Registerer
+
+	err        error
+	collectors []prometheus.Collector
+}
+
+func (r *recordingRegisterer) Register(c prometheus.Collector) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.collectors = append(r.collectors, c)
+	return nil
+}
+
+func (r *recordingRegisterer) Unregister(c prometheus.Collector) bool {
+	n := len(r.collectors)
+	r.collectors = slices.DeleteFunc(r.collectors, func(other prometheus.Collector) bool { return other == c })
+	return len(r.collectors) < n
+}
+
+func TestTenantsRegistrationError(t *testing.T) {
+	registerer := new(recordingRegisterer)
+	_, err := New(WithRegisterer(registerer), WithTenants(func(instrumentation.Scope, attribute.Set) string {
+		return "a"
+	}, map[string]prometheus.Registerer{"a": &recordingRegisterer{err: assert.AnError}}))
+	require.ErrorIs(t, err, assert.AnError)
+	assert.Empty(t, registerer.collectors, "collector not unregistered")
+}
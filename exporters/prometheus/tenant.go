@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheus
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// TenantFunc returns the tenant of a data point with the attributes attrs of
+// a metric recorded by the instrumentation scope.
+type TenantFunc func(scope instrumentation.Scope, attrs attribute.Set) string
+
+// tenantFilter reports whether a data point of the instrumentation scope with
+// the attributes attrs is collected.
+type tenantFilter func(scope instrumentation.Scope, attrs attribute.Set) bool
+
+// newTenantFilter returns the tenantFilter of the collector of tenant, the
+// collector of the data points of the tenants without a Registerer if
+// registered is false.
+func newTenantFilter(f TenantFunc, tenants map[string]bool, tenant string, registered bool) tenantFilter {
+	if !registered {
+		return func(scope instrumentation.Scope, attrs attribute.Set) bool {
+			return !tenants[f(scope, attrs)]
+		}
+	}
+	return func(scope instrumentation.Scope, attrs attribute.Set) bool {
+		return f(scope, attrs) == tenant
+	}
+}
+
+// filter returns data with only the data points kept by fltr, and whether any
+// data point is kept. The data points of data are not modified.
+func (fltr tenantFilter) filter(scope instrumentation.Scope, data metricdata.Aggregation) (metricdata.Aggregation, bool) {
+	switch v := data.(type) {
+	case metricdata.Histogram[int64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, histogramPointAttrs[int64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.Histogram[float64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, histogramPointAttrs[float64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.ExponentialHistogram[int64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, expHistogramPointAttrs[int64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.ExponentialHistogram[float64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, expHistogramPointAttrs[float64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.Sum[int64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, dataPointAttrs[int64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.Sum[float64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, dataPointAttrs[float64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.Gauge[int64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, dataPointAttrs[int64])
+		return v, len(v.DataPoints) > 0
+	case metricdata.Gauge[float64]:
+		v.DataPoints = filterPoints(scope, v.DataPoints, fltr, dataPointAttrs[float64])
+		return v, len(v.DataPoints) > 0
+	}
+	return data, true
+}
+
+func dataPointAttrs[N int64 | float64](dp metricdata.DataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func histogramPointAttrs[N int64 | float64](dp metricdata.HistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+func expHistogramPointAttrs[N int64 | float64](dp metricdata.ExponentialHistogramDataPoint[N]) attribute.Set {
+	return dp.Attributes
+}
+
+// filterPoints returns the points kept by fltr in a new slice.
+func filterPoints[DP any](
+	scope instrumentation.Scope,
+	points []DP,
+	fltr tenantFilter,
+	attrs func(DP) attribute.Set,
+) []DP {
+	out := make([]DP, 0, len(points))
+	for _, dp := range points {
+		if fltr(scope, attrs(dp)) {
+			out = append(out, dp)
+		}
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"net/http"
+
+	"github.com/prometheus/otlptranslator"
+
+	"go.opentelemetry.io/otel/exporters/prometheusremotewrite/internal/retry"
+	"go.opentelemetry.io/otel/sdk/metric"
+)
+
+// DefaultEndpoint is the default URL the metrics are written to. It is the
+// Remote Write endpoint of a local Prometheus server.
+const DefaultEndpoint = "http://localhost:9090/api/v1/write"
+
+// config contains the options for an Exporter.
+type config struct {
+	endpoint            string
+	headers             map[string]string
+	client              *http.Client
+	retry               retry.Config
+	aggregationSelector metric.AggregationSelector
+	translationStrategy otlptranslator.TranslationStrategyOption
+	disableTargetInfo   bool
+	disableScopeInfo    bool
+}
+
+// newConfig returns a config configured with options.
+func newConfig(options []Option) config {
+	cfg := config{
+		endpoint:            DefaultEndpoint,
+		client:              http.DefaultClient,
+		retry:               retry.DefaultConfig,
+		aggregationSelector: metric.DefaultAggregationSelector,
+		translationStrategy: otlptranslator.UnderscoreEscapingWithSuffixes,
+	}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	return cfg
+}
+
+// Option sets the value of an option for an Exporter.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithEndpoint sets the URL of the Remote Write receiver the metrics are
+// written to, e.g. "https://mimir.example.com/api/v1/push".
+//
+// By default, [DefaultEndpoint] is used.
+func WithEndpoint(endpoint string) Option {
+	return optionFunc(func(cfg config) config {
+		if endpoint != "" {
+			cfg.endpoint = endpoint
+		}
+		return cfg
+	})
+}
+
+// WithHeaders sets additional HTTP headers sent with each write, e.g. the
+// Authorization header or the X-Scope-OrgID header identifying the tenant of
+// a Mimir or Cortex receiver.
+func WithHeaders(headers map[string]string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.headers = headers
+		return cfg
+	})
+}
+
+// WithHTTPClient sets the HTTP client used to write the metrics. Use it to
+// configure the timeout, TLS, or proxy of the writes.
+//
+// By default, [http.DefaultClient] is used.
+func WithHTTPClient(client *http.Client) Option {
+	return optionFunc(func(cfg config) config {
+		if client != nil {
+			cfg.client = client
+		}
+		return cfg
+	})
+}
+
+// RetryConfig defines configuration for retrying the writes that failed.
+type RetryConfig retry.Config
+
+// WithRetry sets the retry policy of the writes the receiver responds to
+// with a 5xx or 429 status code.
+//
+// If the receiver responds with a Retry-After header, the write is not
+// retried before the time it indicates.
+//
+// If unset, the default retry policy will be used. It will retry the write
+// 5 seconds after receiving a retryable error and increase exponentially
+// after each error for no more than a total time of 1 minute.
+func WithRetry(rc RetryConfig) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.retry = retry.Config(rc)
+		return cfg
+	})
+}
+
+// WithAggregationSelector sets the AggregationSelector the Exporter uses to
+// determine the aggregation of an instrument kind.
+//
+// By default, [metric.DefaultAggregationSelector] is used.
+func WithAggregationSelector(selector metric.AggregationSelector) Option {
+	return optionFunc(func(cfg config) config {
+		if selector != nil {
+			cfg.aggregationSelector = selector
+		}
+		return cfg
+	})
+}
+
+// WithTranslationStrategy sets how the metric and label names are translated
+// to Prometheus names.
+//
+// By default, [otlptranslator.UnderscoreEscapingWithSuffixes] is used.
+func WithTranslationStrategy(strategy otlptranslator.TranslationStrategyOption) Option {
+	return optionFunc(func(cfg config) config {
+		if strategy != "" {
+			cfg.translationStrategy = strategy
+		}
+		return cfg
+	})
+}
+
+// WithoutTargetInfo disables writing the target_info series containing the
+// resource attributes.
+func WithoutTargetInfo() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.disableTargetInfo = true
+		return cfg
+	})
+}
+
+// WithoutScopeInfo disables adding the otel_scope_name and
+// otel_scope_version labels identifying the instrumentation scope to the
+// series.
+func WithoutScopeInfo() Option {
+	return optionFunc(func(cfg config) config {
+		cfg.disableScopeInfo = true
+		return cfg
+	})
+}
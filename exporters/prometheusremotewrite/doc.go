@@ -0,0 +1,28 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package prometheusremotewrite provides a metric exporter pushing metrics
+// to a Prometheus Remote Write receiver, e.g. Prometheus, Mimir, Thanos, or
+// Cortex.
+//
+// The exporter speaks version 1.0 of the Remote Write protocol: each export
+// is sent as a snappy compressed protobuf WriteRequest in a single HTTP POST
+// request. It is meant for environments where the metrics backend can ingest
+// Remote Write but not OTLP and no OpenTelemetry Collector is available to
+// convert the metrics.
+//
+// The metrics are converted the way the Prometheus exporter
+// ([go.opentelemetry.io/otel/exporters/prometheus]) exposes them:
+//   - Monotonic sums are counters, other sums and gauges are gauges.
+//   - Histograms are classic histograms with _bucket, _sum, and _count
+//     series.
+//   - Exponential histograms are native histograms.
+//   - Resource attributes are sent as the target_info series and identify
+//     the series with the job and instance labels.
+//
+// Remote Write requires cumulative temporality, which is the only
+// temporality the exporter uses. The writes the receiver responds to with a
+// 5xx or 429 status code are retried, see [WithRetry]. The other failed
+// writes are not retried: the next export sends the totals again, so only
+// the samples of the failed write are lost.
+package prometheusremotewrite
@@ -0,0 +1,27 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite_test
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/prometheusremotewrite"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func Example() {
+	exp, err := prometheusremotewrite.New(
+		prometheusremotewrite.WithEndpoint("https://mimir.example.com/api/v1/push"),
+		prometheusremotewrite.WithHeaders(map[string]string{"X-Scope-OrgID": "tenant-1"}),
+	)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	reader := sdkmetric.NewPeriodicReader(exp, sdkmetric.WithInterval(15*time.Second))
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = mp.Shutdown(context.Background()) }()
+}
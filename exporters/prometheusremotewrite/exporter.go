@@ -0,0 +1,216 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/klauspost/compress/snappy"
+	"google.golang.org/protobuf/proto"
+
+	"go.opentelemetry.io/otel/exporters/prometheusremotewrite/internal/retry"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+const (
+	// remoteWriteVersion is the version of the Remote Write protocol spoken.
+	remoteWriteVersion = "0.1.0"
+	userAgent          = "OTel Go Prometheus Remote Write metrics exporter"
+
+	// maxErrorBodySize is the maximum number of bytes of a response body
+	// included in the error of a failed write.
+	maxErrorBodySize = 1 << 10
+)
+
+var errShutdown = errors.New("prometheusremotewrite: exporter is shutdown")
+
+// Exporter is a metric exporter writing metrics to a Prometheus Remote
+// Write receiver. Use it with a [metric.PeriodicReader].
+type Exporter struct {
+	endpoint            string
+	headers             map[string]string
+	client              *http.Client
+	requestFunc         retry.RequestFunc
+	aggregationSelector metric.AggregationSelector
+	conv                *converter
+
+	stopped atomic.Bool
+}
+
+var _ metric.Exporter = (*Exporter)(nil)
+
+// New returns a new Exporter configured with options. An error is returned
+// if the endpoint is not a valid HTTP or HTTPS URL.
+func New(options ...Option) (*Exporter, error) {
+	cfg := newConfig(options)
+
+	u, err := url.Parse(cfg.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("prometheusremotewrite: invalid endpoint: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("prometheusremotewrite: invalid endpoint scheme: %q", u.Scheme)
+	}
+
+	return &Exporter{
+		endpoint:            cfg.endpoint,
+		headers:             cfg.headers,
+		client:              cfg.client,
+		requestFunc:         cfg.retry.RequestFunc(evaluate),
+		aggregationSelector: cfg.aggregationSelector,
+		conv:                newConverter(cfg),
+	}, nil
+}
+
+// Temporality returns CumulativeTemporality for all instrument kinds. It is
+// the only temporality supported by Prometheus.
+func (*Exporter) Temporality(metric.InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+// Aggregation returns the Aggregation to use for an instrument kind.
+func (e *Exporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
+	return e.aggregationSelector(k)
+}
+
+// Export writes rm to the Remote Write receiver.
+//
+// The metrics that cannot be converted to Prometheus series are dropped and
+// an error describing them is returned once the other metrics are written.
+func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if e.stopped.Load() {
+		return errShutdown
+	}
+	defer global.Debug("Prometheus Remote Write exporter export", "Data", rm)
+
+	req, convErr := e.conv.writeRequest(rm, time.Now())
+	if len(req.Timeseries) == 0 {
+		return convErr
+	}
+	data, err := proto.Marshal(req)
+	if err != nil {
+		return errors.Join(err, convErr)
+	}
+	body := snappy.Encode(nil, data)
+	err = e.requestFunc(ctx, func(ctx context.Context) error {
+		return e.write(ctx, body)
+	})
+	return errors.Join(err, convErr)
+}
+
+// write posts the compressed WriteRequest body to the receiver. The error
+// returned for the 5xx and 429 responses is a retryableError.
+func (e *Exporter) write(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range e.headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	req.Header.Set("Content-Encoding", "snappy")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("X-Prometheus-Remote-Write-Version", remoteWriteVersion)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("prometheusremotewrite: write failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	err = fmt.Errorf("prometheusremotewrite: write failed: %s", resp.Status)
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if m := strings.TrimSpace(string(msg)); m != "" {
+		err = fmt.Errorf("prometheusremotewrite: write failed: %s: %s", resp.Status, m)
+	}
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		// Retryable failure, as defined by the Remote Write specification.
+		return newResponseError(resp.Header, err)
+	}
+	return err
+}
+
+// ForceFlush does nothing, the Exporter holds no state.
+func (*Exporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown shuts down the Exporter. Calls to Export after Shutdown return an
+// error.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.stopped.Store(true)
+	return ctx.Err()
+}
+
+// retryableError represents a write failure that can be retried.
+type retryableError struct {
+	throttle time.Duration
+	err      error
+}
+
+// newResponseError returns a retryableError wrapping err with the throttle
+// delay of the Retry-After header, if any.
+func newResponseError(header http.Header, err error) error {
+	return retryableError{throttle: retryAfterDuration(header.Get("Retry-After")), err: err}
+}
+
+// retryAfterDuration returns the delay of the Retry-After header value v, in
+// seconds or as an HTTP date. It returns 0 if v is not valid.
+func retryAfterDuration(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if t, err := strconv.ParseInt(v, 10, 64); err == nil && t >= 0 {
+		const maxRetryAfterSeconds = int64(1<<63-1) / int64(time.Second)
+		if t > maxRetryAfterSeconds {
+			return time.Duration(1<<63 - 1)
+		}
+		return time.Duration(t) * time.Second
+	}
+	if date, err := http.ParseTime(v); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+func (e retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e retryableError) Unwrap() error {
+	return e.err
+}
+
+// evaluate returns if err is retry-able. If it is and it includes an explicit
+// throttling delay, that delay is also returned.
+func evaluate(err error) (bool, time.Duration) {
+	// Do not use errors.As here, this should only be flattened one layer.
+	rErr, ok := err.(retryableError) //nolint:errorlint
+	if !ok {
+		return false, 0
+	}
+	return true, rErr.throttle
+}
+
+// MarshalLog returns logging data about the Exporter.
+func (*Exporter) MarshalLog() any {
+	return struct{ Type string }{Type: "Prometheus Remote Write"}
+}
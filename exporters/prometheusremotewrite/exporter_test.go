@@ -0,0 +1,583 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/otlptranslator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/prometheusremotewrite/internal/prompb"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// assertProtoEqual asserts the protobuf messages, or slices of messages,
+// want and got are equal.
+func assertProtoEqual(t *testing.T, want, got any, msgAndArgs ...any) {
+	t.Helper()
+	assert.Empty(t, cmp.Diff(want, got, protocmp.Transform()), msgAndArgs...)
+}
+
+// seriesKey returns the identity of the series with labels, e.g.
+// `name{a="b"}`.
+func seriesKey(labels []*prompb.Label) string {
+	var name string
+	var pairs []string
+	for _, l := range labels {
+		if l.Name == nameLabel {
+			name = l.Value
+			continue
+		}
+		pairs = append(pairs, l.Name+"="+`"`+l.Value+`"`)
+	}
+	return name + "{" + strings.Join(pairs, ",") + "}"
+}
+
+// receiver is a Remote Write receiver recording the writes. The writes are
+// decoded with the reference snappy and protobuf decoders.
+type receiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	headers  http.Header
+	requests []*prompb.WriteRequest
+	status   int
+	// retryAfter is the Retry-After header of the failed writes.
+	retryAfter string
+	// failures is the number of writes to fail with status before
+	// succeeding. It is ignored if negative.
+	failures int
+}
+
+func newReceiver(t *testing.T) *receiver {
+	r := &receiver{status: http.StatusNoContent, failures: -1}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		data, err := snappy.Decode(nil, body)
+		assert.NoError(t, err)
+		wr := new(prompb.WriteRequest)
+		assert.NoError(t, proto.Unmarshal(data, wr))
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.headers = req.Header.Clone()
+		r.requests = append(r.requests, wr)
+		if r.status != http.StatusNoContent && r.failures != 0 {
+			r.failures--
+			if r.retryAfter != "" {
+				w.Header().Set("Retry-After", r.retryAfter)
+			}
+			http.Error(w, "out of order sample", r.status)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) lastRequest(t *testing.T) *prompb.WriteRequest {
+	t.Helper()
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	require.NotEmpty(t, r.requests, "no write received")
+	return r.requests[len(r.requests)-1]
+}
+
+var (
+	now   = time.Unix(1700000000, 123_000_000)
+	nowMS = now.UnixMilli()
+
+	res = resource.NewSchemaless(
+		semconv.ServiceName("checkout"),
+		semconv.ServiceNamespace("shop"),
+		semconv.ServiceInstanceID("pod-1"),
+		attribute.String("host.name", "node-1"),
+	)
+	scope = instrumentation.Scope{Name: "lib", Version: "v1"}
+	attrs = attribute.NewSet(attribute.String("http.method", "GET"))
+
+	traceID = []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	spanID  = []byte{1, 2, 3, 4, 5, 6, 7, 8}
+)
+
+func resourceMetrics(metrics ...metricdata.Metrics) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{
+		Resource: res,
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope:   scope,
+			Metrics: metrics,
+		}},
+	}
+}
+
+func TestExporterExport(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL), WithHeaders(map[string]string{"X-Scope-OrgID": "tenant"}))
+	require.NoError(t, err)
+
+	rm := resourceMetrics(
+		metricdata.Metrics{
+			Name:        "http.server.requests",
+			Description: "Number of requests",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{{
+					Attributes: attrs,
+					Time:       now,
+					Value:      42,
+					Exemplars: []metricdata.Exemplar[int64]{{
+						FilteredAttributes: []attribute.KeyValue{attribute.String("user", "u1")},
+						Time:               now,
+						Value:              1,
+						TraceID:            traceID,
+						SpanID:             spanID,
+					}},
+				}},
+			},
+		},
+		metricdata.Metrics{
+			Name: "queue.size",
+			Unit: "{item}",
+			Data: metricdata.Sum[float64]{
+				Temporality: metricdata.CumulativeTemporality,
+				DataPoints:  []metricdata.DataPoint[float64]{{Time: now, Value: -2.5}},
+			},
+		},
+		metricdata.Metrics{
+			Name: "cpu.temperature",
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{{Attributes: attrs, Time: now, Value: 71.5}},
+			},
+		},
+	)
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	assert.Equal(t, "application/x-protobuf", rcv.headers.Get("Content-Type"))
+	assert.Equal(t, "snappy", rcv.headers.Get("Content-Encoding"))
+	assert.Equal(t, "0.1.0", rcv.headers.Get("X-Prometheus-Remote-Write-Version"))
+	assert.Equal(t, "tenant", rcv.headers.Get("X-Scope-OrgID"))
+	assert.Equal(t, userAgent, rcv.headers.Get("User-Agent"))
+
+	req := rcv.lastRequest(t)
+	got := make(map[string]*prompb.TimeSeries)
+	for _, ts := range req.Timeseries {
+		got[seriesKey(ts.Labels)] = ts
+	}
+	scopeLabels := `instance="pod-1",job="shop/checkout",otel_scope_name="lib",otel_scope_version="v1"`
+	want := map[string]*prompb.TimeSeries{
+		`target_info{host_name="node-1",instance="pod-1",job="shop/checkout"}`: {
+			Samples: []*prompb.Sample{{Value: 1}},
+		},
+		`http_server_requests_total{http_method="GET",` + scopeLabels + `}`: {
+			Samples: []*prompb.Sample{{Value: 42, Timestamp: nowMS}},
+			Exemplars: []*prompb.Exemplar{{
+				Labels: []*prompb.Label{
+					{Name: "span_id", Value: "0102030405060708"},
+					{Name: "trace_id", Value: "0102030405060708090a0b0c0d0e0f10"},
+					{Name: "user", Value: "u1"},
+				},
+				Value:     1,
+				Timestamp: nowMS,
+			}},
+		},
+		`queue_size{` + scopeLabels + `}`: {
+			Samples: []*prompb.Sample{{Value: -2.5, Timestamp: nowMS}},
+		},
+		`cpu_temperature{http_method="GET",` + scopeLabels + `}`: {
+			Samples: []*prompb.Sample{{Value: 71.5, Timestamp: nowMS}},
+		},
+	}
+	require.Len(t, got, len(want))
+	for key, w := range want {
+		require.Contains(t, got, key)
+		g := got[key]
+		if key == `target_info{host_name="node-1",instance="pod-1",job="shop/checkout"}` {
+			// target_info is timestamped with the export time.
+			require.Len(t, g.Samples, 1)
+			assert.Positive(t, g.Samples[0].Timestamp)
+			g.Samples[0].Timestamp = 0
+		}
+		assertProtoEqual(t, w.Samples, g.Samples, key)
+		assertProtoEqual(t, w.Exemplars, g.Exemplars, key)
+	}
+
+	slices.SortFunc(req.Metadata, func(a, b *prompb.MetricMetadata) int {
+		return strings.Compare(a.MetricFamilyName, b.MetricFamilyName)
+	})
+	assertProtoEqual(t, []*prompb.MetricMetadata{
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "cpu_temperature"},
+		{Type: prompb.MetricMetadata_COUNTER, MetricFamilyName: "http_server_requests_total", Help: "Number of requests"},
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "queue_size"},
+		{Type: prompb.MetricMetadata_GAUGE, MetricFamilyName: "target_info", Help: targetInfoHelp},
+	}, req.Metadata)
+}
+
+func TestExporterExportHistograms(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL), WithoutTargetInfo(), WithoutScopeInfo())
+	require.NoError(t, err)
+
+	rm := resourceMetrics(
+		metricdata.Metrics{
+			Name: "request.duration",
+			Unit: "s",
+			Data: metricdata.Histogram[float64]{
+				Temporality: metricdata.CumulativeTemporality,
+				DataPoints: []metricdata.HistogramDataPoint[float64]{{
+					Time:         now,
+					Count:        6,
+					Sum:          12.5,
+					Bounds:       []float64{0.5, 1},
+					BucketCounts: []uint64{1, 2, 3},
+					Exemplars: []metricdata.Exemplar[float64]{
+						{Time: now, Value: 0.7},
+						{Time: now, Value: 5},
+					},
+				}},
+			},
+		},
+		metricdata.Metrics{
+			Name: "payload.size",
+			Unit: "By",
+			Data: metricdata.ExponentialHistogram[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{{
+					Time:           now,
+					Count:          7,
+					Sum:            40,
+					Scale:          1,
+					ZeroCount:      1,
+					ZeroThreshold:  0,
+					PositiveBucket: metricdata.ExponentialBucket{Offset: 2, Counts: []uint64{3, 0, 2}},
+					NegativeBucket: metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1}},
+				}},
+			},
+		},
+		metricdata.Metrics{
+			Name: "latency",
+			Data: metricdata.Summary{
+				DataPoints: []metricdata.SummaryDataPoint{{
+					Time:  now,
+					Count: 10,
+					Sum:   3,
+					QuantileValues: []metricdata.QuantileValue{
+						{Quantile: 0.5, Value: 0.2},
+						{Quantile: 0.99, Value: 0.9},
+					},
+				}},
+			},
+		},
+	)
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	got := make(map[string]*prompb.TimeSeries)
+	for _, ts := range rcv.lastRequest(t).Timeseries {
+		got[seriesKey(ts.Labels)] = ts
+	}
+	ident := `instance="pod-1",job="shop/checkout"`
+	samples := map[string]float64{
+		`request_duration_seconds_bucket{` + ident + `,le="0.5"}`:  1,
+		`request_duration_seconds_bucket{` + ident + `,le="1"}`:    3,
+		`request_duration_seconds_bucket{` + ident + `,le="+Inf"}`: 6,
+		`request_duration_seconds_sum{` + ident + `}`:              12.5,
+		`request_duration_seconds_count{` + ident + `}`:            6,
+		`latency{` + ident + `,quantile="0.5"}`:                    0.2,
+		`latency{` + ident + `,quantile="0.99"}`:                   0.9,
+		`latency_sum{` + ident + `}`:                               3,
+		`latency_count{` + ident + `}`:                             10,
+	}
+	for key, v := range samples {
+		require.Contains(t, got, key)
+		assertProtoEqual(t, []*prompb.Sample{{Value: v, Timestamp: nowMS}}, got[key].Samples, key)
+	}
+	require.Len(t, got, len(samples)+1)
+
+	// Exemplars are added to the series of their bucket.
+	assert.Nil(t, got[`request_duration_seconds_bucket{`+ident+`,le="0.5"}`].Exemplars)
+	assertProtoEqual(t, []*prompb.Exemplar{{Value: 0.7, Timestamp: nowMS}}, got[`request_duration_seconds_bucket{`+ident+`,le="1"}`].Exemplars)
+	assertProtoEqual(t, []*prompb.Exemplar{{Value: 5, Timestamp: nowMS}}, got[`request_duration_seconds_bucket{`+ident+`,le="+Inf"}`].Exemplars)
+
+	native := `payload_size_bytes{` + ident + `}`
+	require.Contains(t, got, native)
+	assert.Empty(t, got[native].Samples)
+	assertProtoEqual(t, []*prompb.Histogram{{
+		Count:          &prompb.Histogram_CountInt{CountInt: 7},
+		Sum:            40,
+		Schema:         1,
+		ZeroCount:      &prompb.Histogram_ZeroCountInt{ZeroCountInt: 1},
+		PositiveSpans:  []*prompb.BucketSpan{{Offset: 3, Length: 3}},
+		PositiveDeltas: []int64{3, -3, 2},
+		NegativeSpans:  []*prompb.BucketSpan{{Offset: 0, Length: 1}},
+		NegativeDeltas: []int64{1},
+		Timestamp:      nowMS,
+	}}, got[native].Histograms)
+}
+
+func TestNativeBucketsDownscale(t *testing.T) {
+	// Buckets 3 to 8 downscaled by 2 are merged in the buckets 0 (3) to 2
+	// (8): 3>>2 = 0, 4..7>>2 = 1, 8>>2 = 2.
+	b := metricdata.ExponentialBucket{Offset: 3, Counts: []uint64{1, 1, 2, 3, 4, 5}}
+	spans, deltas := nativeBuckets(b, 2)
+	assertProtoEqual(t, []*prompb.BucketSpan{{Offset: 1, Length: 3}}, spans)
+	assert.Equal(t, []int64{1, 9, -5}, deltas)
+
+	// Negative indexes are rounded down.
+	b = metricdata.ExponentialBucket{Offset: -3, Counts: []uint64{1, 1, 1}}
+	spans, deltas = nativeBuckets(b, 1)
+	assertProtoEqual(t, []*prompb.BucketSpan{{Offset: -1, Length: 2}}, spans)
+	assert.Equal(t, []int64{1, 1}, deltas)
+
+	spans, deltas = nativeBuckets(metricdata.ExponentialBucket{}, 0)
+	assert.Nil(t, spans)
+	assert.Nil(t, deltas)
+}
+
+func TestExporterExportHighScale(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL), WithoutTargetInfo())
+	require.NoError(t, err)
+
+	rm := resourceMetrics(metricdata.Metrics{
+		Name: "h",
+		Data: metricdata.ExponentialHistogram[float64]{
+			DataPoints: []metricdata.ExponentialHistogramDataPoint[float64]{
+				{Time: now, Count: 2, Scale: 20, PositiveBucket: metricdata.ExponentialBucket{Offset: 4096, Counts: []uint64{1, 1}}},
+				{Time: now, Count: 1, Scale: -5, Attributes: attrs},
+			},
+		},
+	})
+	err = exp.Export(t.Context(), rm)
+	assert.ErrorIs(t, err, errSchemaTooLow)
+
+	// The data point with a too low scale is dropped, the other downscaled.
+	ts := rcv.lastRequest(t).Timeseries
+	require.Len(t, ts, 1)
+	require.Len(t, ts[0].Histograms, 1)
+	h := ts[0].Histograms[0]
+	assert.Equal(t, int32(nativeMaxSchema), h.Schema)
+	assertProtoEqual(t, []*prompb.BucketSpan{{Offset: 2, Length: 1}}, h.PositiveSpans)
+	assert.Equal(t, []int64{2}, h.PositiveDeltas)
+}
+
+func TestExporterLabels(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(
+		WithEndpoint(rcv.URL),
+		WithTranslationStrategy(otlptranslator.NoUTF8EscapingWithSuffixes),
+	)
+	require.NoError(t, err)
+
+	rm := &metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(semconv.ServiceName("svc")),
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{
+				Name:       "lib",
+				Attributes: attribute.NewSet(attribute.String("tier", "web"), attribute.String("name", "ignored")),
+			},
+			Metrics: []metricdata.Metrics{{
+				Name: "my.gauge",
+				Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{
+					Attributes: attribute.NewSet(
+						attribute.String("job", "override"),
+						attribute.String("http.route", "/users"),
+						attribute.String("empty", ""),
+					),
+					Time:  now,
+					Value: 1,
+				}}},
+			}},
+		}},
+	}
+	require.NoError(t, exp.Export(t.Context(), rm))
+
+	// Only the service name is in the resource, no target_info is needed.
+	ts := rcv.lastRequest(t).Timeseries
+	require.Len(t, ts, 1)
+	assertProtoEqual(t, []*prompb.Label{
+		{Name: nameLabel, Value: "my.gauge"},
+		{Name: "http.route", Value: "/users"},
+		{Name: "job", Value: "override"},
+		{Name: "otel_scope_name", Value: "lib"},
+		{Name: "otel_scope_tier", Value: "web"},
+	}, ts[0].Labels)
+}
+
+func TestExporterExportErrors(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL), WithoutTargetInfo())
+	require.NoError(t, err)
+
+	gauge := metricdata.Metrics{
+		Name: "gauge",
+		Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Time: now, Value: 1}}},
+	}
+	unknown := metricdata.Metrics{Name: "unknown", Data: nil}
+
+	// The metrics that cannot be converted are reported, the others written.
+	err = exp.Export(t.Context(), resourceMetrics(unknown, gauge))
+	assert.ErrorIs(t, err, errUnknownAggregation)
+	assert.Len(t, rcv.lastRequest(t).Timeseries, 1)
+
+	rcv.mu.Lock()
+	rcv.status = http.StatusBadRequest
+	rcv.mu.Unlock()
+	err = exp.Export(t.Context(), resourceMetrics(gauge))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400 Bad Request: out of order sample")
+}
+
+func TestExporterRetry(t *testing.T) {
+	fastRetry := WithRetry(RetryConfig{
+		Enabled:         true,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		MaxElapsedTime:  time.Minute,
+	})
+	gauge := metricdata.Metrics{
+		Name: "gauge",
+		Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Time: now, Value: 1}}},
+	}
+
+	tests := []struct {
+		name     string
+		status   int
+		opts     []Option
+		wantReqs int
+		wantErr  bool
+	}{
+		{name: "ServiceUnavailable", status: http.StatusServiceUnavailable, opts: []Option{fastRetry}, wantReqs: 3},
+		{name: "InternalServerError", status: http.StatusInternalServerError, opts: []Option{fastRetry}, wantReqs: 3},
+		{name: "TooManyRequests", status: http.StatusTooManyRequests, opts: []Option{fastRetry}, wantReqs: 3},
+		{name: "BadRequest", status: http.StatusBadRequest, opts: []Option{fastRetry}, wantReqs: 1, wantErr: true},
+		{
+			name:     "Disabled",
+			status:   http.StatusServiceUnavailable,
+			opts:     []Option{WithRetry(RetryConfig{Enabled: false})},
+			wantReqs: 1,
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rcv := newReceiver(t)
+			rcv.status, rcv.failures = tc.status, 2
+			exp, err := New(append([]Option{WithEndpoint(rcv.URL), WithoutTargetInfo()}, tc.opts...)...)
+			require.NoError(t, err)
+
+			err = exp.Export(t.Context(), resourceMetrics(gauge))
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			rcv.mu.Lock()
+			defer rcv.mu.Unlock()
+			assert.Len(t, rcv.requests, tc.wantReqs)
+		})
+	}
+}
+
+func TestExporterRetryAfter(t *testing.T) {
+	rcv := newReceiver(t)
+	rcv.status, rcv.failures, rcv.retryAfter = http.StatusTooManyRequests, -1, "3600"
+	exp, err := New(WithEndpoint(rcv.URL), WithoutTargetInfo(), WithRetry(RetryConfig{
+		Enabled:         true,
+		InitialInterval: time.Millisecond,
+		MaxInterval:     time.Millisecond,
+		MaxElapsedTime:  time.Minute,
+	}))
+	require.NoError(t, err)
+
+	// The Retry-After delay exceeds the max elapsed time, the write is not
+	// retried.
+	gauge := metricdata.Metrics{
+		Name: "gauge",
+		Data: metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Time: now, Value: 1}}},
+	}
+	err = exp.Export(t.Context(), resourceMetrics(gauge))
+	assert.ErrorContains(t, err, "max retry time would elapse")
+	assert.Len(t, rcv.requests, 1)
+}
+
+func TestEvaluate(t *testing.T) {
+	header := http.Header{"Retry-After": {"3"}}
+	retryable, throttle := evaluate(newResponseError(header, errShutdown))
+	assert.True(t, retryable)
+	assert.Equal(t, 3*time.Second, throttle)
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	retryable, throttle = evaluate(newResponseError(http.Header{"Retry-After": {date}}, errShutdown))
+	assert.True(t, retryable)
+	assert.InDelta(t, time.Hour, throttle, float64(time.Minute))
+
+	retryable, throttle = evaluate(newResponseError(http.Header{"Retry-After": {"invalid"}}, errShutdown))
+	assert.True(t, retryable)
+	assert.Zero(t, throttle)
+
+	retryable, _ = evaluate(errShutdown)
+	assert.False(t, retryable)
+}
+
+func TestExporterShutdown(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL))
+	require.NoError(t, err)
+
+	require.NoError(t, exp.ForceFlush(t.Context()))
+	require.NoError(t, exp.Shutdown(t.Context()))
+	assert.ErrorIs(t, exp.Export(t.Context(), resourceMetrics()), errShutdown)
+	assert.Empty(t, rcv.requests)
+}
+
+func TestNewInvalidEndpoint(t *testing.T) {
+	_, err := New(WithEndpoint("localhost:9090/api/v1/write"))
+	assert.Error(t, err)
+	_, err = New(WithEndpoint("http://[::1"))
+	assert.Error(t, err)
+}
+
+func TestExporterWithMeterProvider(t *testing.T) {
+	rcv := newReceiver(t)
+	exp, err := New(WithEndpoint(rcv.URL))
+	require.NoError(t, err)
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(metric.InstrumentKindUpDownCounter))
+
+	reader := metric.NewPeriodicReader(exp)
+	mp := metric.NewMeterProvider(metric.WithReader(reader), metric.WithResource(res))
+	counter, err := mp.Meter("lib").Int64Counter("jobs")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 3)
+	require.NoError(t, mp.Shutdown(t.Context()))
+
+	var found bool
+	for _, ts := range rcv.lastRequest(t).Timeseries {
+		if ts.Labels[0].Value == "jobs_total" {
+			found = true
+			require.Len(t, ts.Samples, 1)
+			assert.Equal(t, float64(3), ts.Samples[0].Value)
+		}
+	}
+	assert.True(t, found, "jobs_total series not written")
+}
@@ -0,0 +1,8 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package prompb provides the protobuf messages of the Prometheus Remote
+// Write 1.0 protocol.
+package prompb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative remote.proto
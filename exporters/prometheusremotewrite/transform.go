@@ -0,0 +1,509 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package prometheusremotewrite
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/otlptranslator"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/prometheusremotewrite/internal/prompb"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+const (
+	nameLabel     = "__name__"
+	jobLabel      = "job"
+	instanceLabel = "instance"
+	bucketLabel   = "le"
+	quantileLabel = "quantile"
+
+	scopeLabelPrefix  = "otel_scope_"
+	scopeSchemaLabel  = scopeLabelPrefix + "schema_url"
+	targetInfoHelp    = "Target metadata"
+	nativeMaxSchema   = 8
+	nativeMinSchema   = -4
+	infBucketBoundary = "+Inf"
+)
+
+var (
+	errUnknownAggregation = errors.New("unknown aggregation")
+	errSchemaTooLow       = errors.New("exponential histogram scale too low for a native histogram")
+)
+
+// converter converts metricdata into a Remote Write WriteRequest.
+type converter struct {
+	metricNamer otlptranslator.MetricNamer
+	labelNamer  otlptranslator.LabelNamer
+	unitNamer   otlptranslator.UnitNamer
+
+	disableTargetInfo bool
+	disableScopeInfo  bool
+}
+
+func newConverter(cfg config) *converter {
+	utf8 := !cfg.translationStrategy.ShouldEscape()
+	return &converter{
+		metricNamer:       otlptranslator.NewMetricNamer("", cfg.translationStrategy),
+		labelNamer:        otlptranslator.LabelNamer{UTF8Allowed: utf8},
+		unitNamer:         otlptranslator.UnitNamer{UTF8Allowed: utf8},
+		disableTargetInfo: cfg.disableTargetInfo,
+		disableScopeInfo:  cfg.disableScopeInfo,
+	}
+}
+
+// writeRequest returns the WriteRequest of rm. The target_info series is
+// timestamped with now.
+//
+// The metrics that cannot be converted are dropped and an error describing
+// them is returned along with the other metrics.
+func (c *converter) writeRequest(rm *metricdata.ResourceMetrics, now time.Time) (*prompb.WriteRequest, error) {
+	req := new(prompb.WriteRequest)
+	resLabels := resourceLabels(rm.Resource)
+
+	var errs []error
+	if !c.disableTargetInfo {
+		if err := c.addTargetInfo(req, rm.Resource, resLabels, now); err != nil {
+			errs = append(errs, fmt.Errorf("target info: %w", err))
+		}
+	}
+
+	for _, sm := range rm.ScopeMetrics {
+		base := resLabels
+		if !c.disableScopeInfo {
+			scopeLabels, err := c.scopeLabels(sm.Scope)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scope %q: %w", sm.Scope.Name, err))
+				continue
+			}
+			base = append(scopeLabels, resLabels...)
+		}
+		for _, m := range sm.Metrics {
+			if err := c.addMetric(req, m, base); err != nil {
+				errs = append(errs, fmt.Errorf("metric %q: %w", m.Name, err))
+			}
+		}
+	}
+	return req, errors.Join(errs...)
+}
+
+// resourceLabels returns the job and instance labels identifying the series
+// of res.
+func resourceLabels(res *resource.Resource) []*prompb.Label {
+	set := res.Set()
+	var labels []*prompb.Label
+	if name, ok := set.Value(semconv.ServiceNameKey); ok {
+		job := name.Emit()
+		if ns, ok := set.Value(semconv.ServiceNamespaceKey); ok && ns.Emit() != "" {
+			job = ns.Emit() + "/" + job
+		}
+		labels = append(labels, &prompb.Label{Name: jobLabel, Value: job})
+	}
+	if id, ok := set.Value(semconv.ServiceInstanceIDKey); ok {
+		labels = append(labels, &prompb.Label{Name: instanceLabel, Value: id.Emit()})
+	}
+	return labels
+}
+
+// addTargetInfo adds the target_info series with the attributes of res not
+// already in the job and instance labels.
+func (c *converter) addTargetInfo(req *prompb.WriteRequest, res *resource.Resource, resLabels []*prompb.Label, now time.Time) error {
+	attrs, _ := res.Set().Filter(func(kv attribute.KeyValue) bool {
+		switch kv.Key {
+		case semconv.ServiceNameKey, semconv.ServiceNamespaceKey, semconv.ServiceInstanceIDKey:
+			return false
+		}
+		return true
+	})
+	labels, err := c.attrLabels(nil, "", attrs.ToSlice())
+	if err != nil {
+		return err
+	}
+	if len(labels) == 0 {
+		// Nothing to add to the series identified by job and instance.
+		return nil
+	}
+
+	req.Metadata = append(req.Metadata, &prompb.MetricMetadata{
+		Type:             prompb.MetricMetadata_GAUGE,
+		MetricFamilyName: otlptranslator.TargetInfoMetricName,
+		Help:             targetInfoHelp,
+	})
+	req.Timeseries = append(req.Timeseries, &prompb.TimeSeries{
+		Labels:  seriesLabels(otlptranslator.TargetInfoMetricName, nil, labels, resLabels),
+		Samples: []*prompb.Sample{{Value: 1, Timestamp: now.UnixMilli()}},
+	})
+	return nil
+}
+
+// scopeLabels returns the labels identifying scope.
+func (c *converter) scopeLabels(scope instrumentation.Scope) ([]*prompb.Label, error) {
+	labels := []*prompb.Label{
+		{Name: otlptranslator.ScopeNameLabelKey, Value: scope.Name},
+		{Name: otlptranslator.ScopeVersionLabelKey, Value: scope.Version},
+		{Name: scopeSchemaLabel, Value: scope.SchemaURL},
+	}
+	attrs, _ := scope.Attributes.Filter(func(kv attribute.KeyValue) bool {
+		switch kv.Key {
+		case "name", "version", "schema_url":
+			return false
+		}
+		return true
+	})
+	return c.attrLabels(labels, scopeLabelPrefix, attrs.ToSlice())
+}
+
+// attrLabels appends the labels of attrs, with names prefixed by prefix, to
+// dst. Attributes with the same name once translated are merged in a label
+// with their sorted values joined by ";".
+func (c *converter) attrLabels(dst []*prompb.Label, prefix string, attrs []attribute.KeyValue) ([]*prompb.Label, error) {
+	start := len(dst)
+	for _, kv := range attrs {
+		name, err := c.labelNamer.Build(string(kv.Key))
+		if err != nil {
+			return nil, err
+		}
+		name = prefix + name
+		value := kv.Value.Emit()
+		if i := slices.IndexFunc(dst[start:], func(l *prompb.Label) bool { return l.Name == name }); i >= 0 {
+			values := append(strings.Split(dst[start+i].Value, ";"), value)
+			slices.Sort(values)
+			dst[start+i].Value = strings.Join(values, ";")
+			continue
+		}
+		dst = append(dst, &prompb.Label{Name: name, Value: value})
+	}
+	return dst, nil
+}
+
+// seriesLabels returns the sorted labels of the series name. When labels
+// have the same name, the one in the earliest argument is kept: the point
+// labels take precedence over the attribute labels which take precedence
+// over the scope and resource labels in base. Labels with an empty value are
+// dropped as Prometheus does.
+func seriesLabels(name string, point, attrs, base []*prompb.Label) []*prompb.Label {
+	labels := make([]*prompb.Label, 0, 1+len(point)+len(attrs)+len(base))
+	labels = append(labels, &prompb.Label{Name: nameLabel, Value: name})
+	labels = append(labels, point...)
+	labels = append(labels, attrs...)
+	labels = append(labels, base...)
+	slices.SortStableFunc(labels, func(a, b *prompb.Label) int { return strings.Compare(a.Name, b.Name) })
+	return slices.CompactFunc(
+		slices.DeleteFunc(labels, func(l *prompb.Label) bool { return l.Value == "" }),
+		func(a, b *prompb.Label) bool { return a.Name == b.Name },
+	)
+}
+
+// addMetric adds the series of m, with the base labels, to req.
+func (c *converter) addMetric(req *prompb.WriteRequest, m metricdata.Metrics, base []*prompb.Label) error {
+	typ, namingType := metricTypes(m.Data)
+	if typ == prompb.MetricMetadata_UNKNOWN {
+		return fmt.Errorf("%w: %T", errUnknownAggregation, m.Data)
+	}
+	name, err := c.metricNamer.Build(otlptranslator.Metric{Name: m.Name, Unit: m.Unit, Type: namingType})
+	if err != nil {
+		return err
+	}
+	req.Metadata = append(req.Metadata, &prompb.MetricMetadata{
+		Type:             typ,
+		MetricFamilyName: name,
+		Help:             m.Description,
+		Unit:             c.unitNamer.Build(m.Unit),
+	})
+
+	switch data := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		return addPoints(c, req, name, data.DataPoints, base, false)
+	case metricdata.Gauge[float64]:
+		return addPoints(c, req, name, data.DataPoints, base, false)
+	case metricdata.Sum[int64]:
+		return addPoints(c, req, name, data.DataPoints, base, data.IsMonotonic)
+	case metricdata.Sum[float64]:
+		return addPoints(c, req, name, data.DataPoints, base, data.IsMonotonic)
+	case metricdata.Histogram[int64]:
+		return addHistogram(c, req, name, data.DataPoints, base)
+	case metricdata.Histogram[float64]:
+		return addHistogram(c, req, name, data.DataPoints, base)
+	case metricdata.ExponentialHistogram[int64]:
+		return addExponentialHistogram(c, req, name, data.DataPoints, base)
+	case metricdata.ExponentialHistogram[float64]:
+		return addExponentialHistogram(c, req, name, data.DataPoints, base)
+	case metricdata.Summary:
+		return c.addSummary(req, name, data.DataPoints, base)
+	}
+	return nil
+}
+
+// metricTypes returns the Prometheus type of data and its type used to name
+// the metric. The type is UNKNOWN if data is not known.
+func metricTypes(data metricdata.Aggregation) (prompb.MetricMetadata_MetricType, otlptranslator.MetricType) {
+	switch v := data.(type) {
+	case metricdata.Gauge[int64], metricdata.Gauge[float64]:
+		return prompb.MetricMetadata_GAUGE, otlptranslator.MetricTypeGauge
+	case metricdata.Sum[int64]:
+		return sumTypes(v.IsMonotonic)
+	case metricdata.Sum[float64]:
+		return sumTypes(v.IsMonotonic)
+	case metricdata.Histogram[int64], metricdata.Histogram[float64],
+		metricdata.ExponentialHistogram[int64], metricdata.ExponentialHistogram[float64]:
+		return prompb.MetricMetadata_HISTOGRAM, otlptranslator.MetricTypeHistogram
+	case metricdata.Summary:
+		return prompb.MetricMetadata_SUMMARY, otlptranslator.MetricTypeSummary
+	}
+	return 0, otlptranslator.MetricTypeUnknown
+}
+
+func sumTypes(monotonic bool) (prompb.MetricMetadata_MetricType, otlptranslator.MetricType) {
+	if monotonic {
+		return prompb.MetricMetadata_COUNTER, otlptranslator.MetricTypeMonotonicCounter
+	}
+	return prompb.MetricMetadata_GAUGE, otlptranslator.MetricTypeNonMonotonicCounter
+}
+
+// addPoints adds a series per gauge or sum data point. The exemplars of the
+// data points are only added to counters.
+func addPoints[N int64 | float64](
+	c *converter,
+	req *prompb.WriteRequest,
+	name string,
+	dps []metricdata.DataPoint[N],
+	base []*prompb.Label,
+	withExemplars bool,
+) error {
+	var errs []error
+	for _, dp := range dps {
+		attrs, err := c.attrLabels(nil, "", dp.Attributes.ToSlice())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		ts := &prompb.TimeSeries{
+			Labels:  seriesLabels(name, nil, attrs, base),
+			Samples: []*prompb.Sample{{Value: float64(dp.Value), Timestamp: dp.Time.UnixMilli()}},
+		}
+		if withExemplars {
+			ts.Exemplars, err = exemplars(c, dp.Exemplars)
+			errs = append(errs, err)
+		}
+		req.Timeseries = append(req.Timeseries, ts)
+	}
+	return errors.Join(errs...)
+}
+
+// addHistogram adds the _bucket, _sum, and _count series of each data point.
+// The exemplars are added to the _bucket series of their bucket.
+func addHistogram[N int64 | float64](
+	c *converter,
+	req *prompb.WriteRequest,
+	name string,
+	dps []metricdata.HistogramDataPoint[N],
+	base []*prompb.Label,
+) error {
+	var errs []error
+	for _, dp := range dps {
+		attrs, err := c.attrLabels(nil, "", dp.Attributes.ToSlice())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		exs, err := exemplars(c, dp.Exemplars)
+		errs = append(errs, err)
+
+		t := dp.Time.UnixMilli()
+		var cumulative uint64
+		for i := range len(dp.Bounds) + 1 {
+			le, inBucket := infBucketBoundary, func(float64) bool { return true }
+			if i < len(dp.Bounds) {
+				bound := dp.Bounds[i]
+				le, inBucket = formatFloat(bound), func(v float64) bool { return v <= bound }
+			}
+			if i < len(dp.BucketCounts) {
+				cumulative += dp.BucketCounts[i]
+			}
+			if i == len(dp.Bounds) {
+				cumulative = dp.Count
+			}
+
+			ts := &prompb.TimeSeries{
+				Labels:  seriesLabels(name+"_bucket", []*prompb.Label{{Name: bucketLabel, Value: le}}, attrs, base),
+				Samples: []*prompb.Sample{{Value: float64(cumulative), Timestamp: t}},
+			}
+			// Each exemplar is added to the first bucket containing it.
+			exs = slices.DeleteFunc(exs, func(e *prompb.Exemplar) bool {
+				if inBucket(e.Value) {
+					ts.Exemplars = append(ts.Exemplars, e)
+					return true
+				}
+				return false
+			})
+			req.Timeseries = append(req.Timeseries, ts)
+		}
+		req.Timeseries = append(req.Timeseries,
+			&prompb.TimeSeries{
+				Labels:  seriesLabels(name+"_sum", nil, attrs, base),
+				Samples: []*prompb.Sample{{Value: float64(dp.Sum), Timestamp: t}},
+			},
+			&prompb.TimeSeries{
+				Labels:  seriesLabels(name+"_count", nil, attrs, base),
+				Samples: []*prompb.Sample{{Value: float64(dp.Count), Timestamp: t}},
+			},
+		)
+	}
+	return errors.Join(errs...)
+}
+
+// addExponentialHistogram adds a native histogram series per data point.
+func addExponentialHistogram[N int64 | float64](
+	c *converter,
+	req *prompb.WriteRequest,
+	name string,
+	dps []metricdata.ExponentialHistogramDataPoint[N],
+	base []*prompb.Label,
+) error {
+	var errs []error
+	for _, dp := range dps {
+		if dp.Scale < nativeMinSchema {
+			errs = append(errs, fmt.Errorf("%w: %d", errSchemaTooLow, dp.Scale))
+			continue
+		}
+		attrs, err := c.attrLabels(nil, "", dp.Attributes.ToSlice())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		exs, err := exemplars(c, dp.Exemplars)
+		errs = append(errs, err)
+
+		// Native histograms support schemas up to 8, downscale higher ones.
+		schema, scaleDelta := dp.Scale, int32(0)
+		if schema > nativeMaxSchema {
+			schema, scaleDelta = nativeMaxSchema, dp.Scale-nativeMaxSchema
+		}
+		h := &prompb.Histogram{
+			Count:         &prompb.Histogram_CountInt{CountInt: dp.Count},
+			Sum:           float64(dp.Sum),
+			Schema:        schema,
+			ZeroThreshold: dp.ZeroThreshold,
+			ZeroCount:     &prompb.Histogram_ZeroCountInt{ZeroCountInt: dp.ZeroCount},
+			Timestamp:     dp.Time.UnixMilli(),
+		}
+		h.PositiveSpans, h.PositiveDeltas = nativeBuckets(dp.PositiveBucket, scaleDelta)
+		h.NegativeSpans, h.NegativeDeltas = nativeBuckets(dp.NegativeBucket, scaleDelta)
+
+		req.Timeseries = append(req.Timeseries, &prompb.TimeSeries{
+			Labels:     seriesLabels(name, nil, attrs, base),
+			Exemplars:  exs,
+			Histograms: []*prompb.Histogram{h},
+		})
+	}
+	return errors.Join(errs...)
+}
+
+// nativeBuckets returns the spans and count deltas of the native histogram
+// buckets of b downscaled by scaleDelta.
+func nativeBuckets(b metricdata.ExponentialBucket, scaleDelta int32) ([]*prompb.BucketSpan, []int64) {
+	if len(b.Counts) == 0 {
+		return nil, nil
+	}
+
+	offset := b.Offset >> scaleDelta
+	counts := make([]uint64, 0, len(b.Counts))
+	for i, n := range b.Counts {
+		idx := (b.Offset + int32(i)) >> scaleDelta //nolint:gosec // Bucket counts are bounded by the max size of the aggregation.
+		if pos := int(idx - offset); pos < len(counts) {
+			counts[pos] += n
+		} else {
+			counts = append(counts, n)
+		}
+	}
+
+	// The bucket at index i of an exponential histogram has the upper bound
+	// base^(i+1), the bucket at index i of a native histogram has the upper
+	// bound base^i. All the buckets are sent in a single span.
+	deltas := make([]int64, len(counts))
+	var prev uint64
+	for i, n := range counts {
+		deltas[i] = int64(n - prev) //nolint:gosec // Deltas of counts are encoded as two's complement.
+		prev = n
+	}
+	return []*prompb.BucketSpan{{Offset: offset + 1, Length: uint32(len(counts))}}, deltas //nolint:gosec // Bucket counts are bounded by the max size of the aggregation.
+}
+
+// addSummary adds the quantile, _sum, and _count series of each data point.
+func (c *converter) addSummary(req *prompb.WriteRequest, name string, dps []metricdata.SummaryDataPoint, base []*prompb.Label) error {
+	var errs []error
+	for _, dp := range dps {
+		attrs, err := c.attrLabels(nil, "", dp.Attributes.ToSlice())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		t := dp.Time.UnixMilli()
+		for _, q := range dp.QuantileValues {
+			req.Timeseries = append(req.Timeseries, &prompb.TimeSeries{
+				Labels:  seriesLabels(name, []*prompb.Label{{Name: quantileLabel, Value: formatFloat(q.Quantile)}}, attrs, base),
+				Samples: []*prompb.Sample{{Value: q.Value, Timestamp: t}},
+			})
+		}
+		req.Timeseries = append(req.Timeseries,
+			&prompb.TimeSeries{
+				Labels:  seriesLabels(name+"_sum", nil, attrs, base),
+				Samples: []*prompb.Sample{{Value: dp.Sum, Timestamp: t}},
+			},
+			&prompb.TimeSeries{
+				Labels:  seriesLabels(name+"_count", nil, attrs, base),
+				Samples: []*prompb.Sample{{Value: float64(dp.Count), Timestamp: t}},
+			},
+		)
+	}
+	return errors.Join(errs...)
+}
+
+// exemplars returns the Remote Write exemplars of exs.
+func exemplars[N int64 | float64](c *converter, exs []metricdata.Exemplar[N]) ([]*prompb.Exemplar, error) {
+	if len(exs) == 0 {
+		return nil, nil
+	}
+	out := make([]*prompb.Exemplar, 0, len(exs))
+	var errs []error
+	for _, e := range exs {
+		labels, err := c.attrLabels(nil, "", e.FilteredAttributes)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if len(e.TraceID) > 0 {
+			labels = append(labels, &prompb.Label{Name: otlptranslator.ExemplarTraceIDKey, Value: hex.EncodeToString(e.TraceID)})
+		}
+		if len(e.SpanID) > 0 {
+			labels = append(labels, &prompb.Label{Name: otlptranslator.ExemplarSpanIDKey, Value: hex.EncodeToString(e.SpanID)})
+		}
+		slices.SortFunc(labels, func(a, b *prompb.Label) int { return strings.Compare(a.Name, b.Name) })
+		out = append(out, &prompb.Exemplar{
+			Labels:    labels,
+			Value:     float64(e.Value),
+			Timestamp: e.Time.UnixMilli(),
+		})
+	}
+	return out, errors.Join(errs...)
+}
+
+// formatFloat formats v as the value of a le or quantile label.
+func formatFloat(v float64) string {
+	if math.IsInf(v, 1) {
+		return infBucketBoundary
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
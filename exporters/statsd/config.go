@@ -0,0 +1,139 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// DefaultAddress is the default address the metrics are sent to. It is
+	// the default address of a StatsD server and of the Datadog Agent.
+	DefaultAddress = "localhost:8125"
+
+	// unixAddressPrefix is the prefix of the address of a Unix domain socket.
+	unixAddressPrefix = "unix://"
+
+	// defaultUDPPacketSize is the default maximum size of a UDP packet. It
+	// avoids fragmentation on the common network MTU of 1500 bytes.
+	defaultUDPPacketSize = 1432
+	// defaultUnixPacketSize is the default maximum size of a Unix domain
+	// socket datagram, the default of the DogStatsD clients.
+	defaultUnixPacketSize = 8192
+)
+
+// Flavor is the line protocol the metrics are sent with.
+type Flavor uint8
+
+const (
+	// FlavorDogStatsD sends the metrics with the DogStatsD protocol, the
+	// StatsD protocol extended with tags.
+	FlavorDogStatsD Flavor = iota
+	// FlavorStatsD sends the metrics with the StatsD protocol. Attributes are
+	// dropped as it does not support tags.
+	FlavorStatsD
+)
+
+// String returns the name of the flavor.
+func (f Flavor) String() string {
+	switch f {
+	case FlavorDogStatsD:
+		return "dogstatsd"
+	case FlavorStatsD:
+		return "statsd"
+	}
+	return "unknown"
+}
+
+// config contains the options for an Exporter.
+type config struct {
+	address       string
+	flavor        Flavor
+	prefix        string
+	tags          []attribute.KeyValue
+	maxPacketSize int
+}
+
+// newConfig returns a config configured with options.
+func newConfig(options []Option) config {
+	cfg := config{address: DefaultAddress}
+	for _, opt := range options {
+		cfg = opt.apply(cfg)
+	}
+	if cfg.maxPacketSize <= 0 {
+		cfg.maxPacketSize = defaultUDPPacketSize
+		if strings.HasPrefix(cfg.address, unixAddressPrefix) {
+			cfg.maxPacketSize = defaultUnixPacketSize
+		}
+	}
+	return cfg
+}
+
+// Option sets the value of an option for an Exporter.
+type Option interface {
+	apply(config) config
+}
+
+type optionFunc func(config) config
+
+func (fn optionFunc) apply(cfg config) config {
+	return fn(cfg)
+}
+
+// WithAddress sets the address of the server the metrics are sent to. It is
+// either a "host:port" UDP address or the path of a Unix domain datagram
+// socket prefixed with "unix://", e.g. "unix:///var/run/datadog/dsd.socket".
+//
+// By default, [DefaultAddress] is used.
+func WithAddress(address string) Option {
+	return optionFunc(func(cfg config) config {
+		if address != "" {
+			cfg.address = address
+		}
+		return cfg
+	})
+}
+
+// WithFlavor sets the line protocol the metrics are sent with.
+//
+// By default, [FlavorDogStatsD] is used.
+func WithFlavor(flavor Flavor) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.flavor = flavor
+		return cfg
+	})
+}
+
+// WithPrefix sets the prefix of the sent metric names, e.g. "myapp.".
+func WithPrefix(prefix string) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.prefix = prefix
+		return cfg
+	})
+}
+
+// WithTags adds tags sent with all the metrics, e.g. the environment or the
+// service. The attributes of the data points take precedence over tags with
+// the same key. Tags are only sent with [FlavorDogStatsD].
+func WithTags(tags ...attribute.KeyValue) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.tags = append(cfg.tags, tags...)
+		return cfg
+	})
+}
+
+// WithMaxPacketSize sets the maximum size, in bytes, of the datagrams sent.
+// Metric lines are batched in datagrams up to this size. A line larger than
+// the size is sent alone.
+//
+// By default, 1432 bytes are used for UDP and 8192 bytes for Unix domain
+// sockets.
+func WithMaxPacketSize(size int) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.maxPacketSize = size
+		return cfg
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package statsd provides a metric exporter sending metrics in the StatsD or
+// DogStatsD line protocol over UDP or Unix domain datagram sockets, for
+// pipelines that only ingest StatsD.
+//
+// A StatsD server aggregates the values it receives over its flush interval.
+// The exporter aligns the OpenTelemetry aggregations with this model:
+//   - Counters, observable counters, and histograms use delta temporality.
+//     Each export sends the increments since the previous export, which the
+//     server sums over its flush interval.
+//   - Monotonic sums are sent as counters (c), other sums and gauges are sent
+//     as gauges (g) with their current value.
+//   - Histograms are sent as the .count and .sum counters and the .min and
+//     .max gauges of the data points. StatsD timers and DogStatsD histograms
+//     and distributions need the individual measurements, which the SDK does
+//     not keep.
+//
+// Use the exporter with a [go.opentelemetry.io/otel/sdk/metric.PeriodicReader]
+// with an interval not greater than the flush interval of the server, so each
+// server flush contains the measurements of the previous interval.
+//
+// With the DogStatsD flavor, the attributes of the data points are sent as
+// tags. The StatsD flavor does not support tags and drops them.
+package statsd
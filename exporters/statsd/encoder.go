@@ -0,0 +1,227 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// StatsD metric types.
+const (
+	typeCounter = "c"
+	typeGauge   = "g"
+)
+
+var (
+	errUnknownAggregation = errors.New("unknown aggregation")
+	errNonFinite          = errors.New("non-finite value")
+)
+
+// encoder encodes metricdata in StatsD lines.
+type encoder struct {
+	flavor Flavor
+	prefix string
+	tags   []attribute.KeyValue
+
+	// lines are the encoded lines.
+	lines [][]byte
+}
+
+// encode appends the lines of rm to e.lines. The metrics that cannot be
+// encoded are dropped and an error describing them is returned.
+func (e *encoder) encode(rm *metricdata.ResourceMetrics) error {
+	var errs []error
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if err := e.encodeMetric(m); err != nil {
+				errs = append(errs, fmt.Errorf("metric %q: %w", m.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (e *encoder) encodeMetric(m metricdata.Metrics) error {
+	name := e.prefix + sanitizeName(m.Name)
+	switch data := m.Data.(type) {
+	case metricdata.Gauge[int64]:
+		return encodePoints(e, name, typeGauge, data.DataPoints)
+	case metricdata.Gauge[float64]:
+		return encodePoints(e, name, typeGauge, data.DataPoints)
+	case metricdata.Sum[int64]:
+		return encodePoints(e, name, sumType(data.IsMonotonic, data.Temporality), data.DataPoints)
+	case metricdata.Sum[float64]:
+		return encodePoints(e, name, sumType(data.IsMonotonic, data.Temporality), data.DataPoints)
+	case metricdata.Histogram[int64]:
+		return encodeHistogram(e, name, data.Temporality, data.DataPoints)
+	case metricdata.Histogram[float64]:
+		return encodeHistogram(e, name, data.Temporality, data.DataPoints)
+	case metricdata.ExponentialHistogram[int64]:
+		return encodeExponentialHistogram(e, name, data.Temporality, data.DataPoints)
+	case metricdata.ExponentialHistogram[float64]:
+		return encodeExponentialHistogram(e, name, data.Temporality, data.DataPoints)
+	}
+	return fmt.Errorf("%w: %T", errUnknownAggregation, m.Data)
+}
+
+// sumType returns the StatsD type of a sum. Only the increments of a
+// monotonic sum can be sent as a counter, the current value of the other sums
+// is sent as a gauge.
+func sumType(monotonic bool, temporality metricdata.Temporality) string {
+	if monotonic && temporality == metricdata.DeltaTemporality {
+		return typeCounter
+	}
+	return typeGauge
+}
+
+func encodePoints[N int64 | float64](e *encoder, name, typ string, dps []metricdata.DataPoint[N]) error {
+	var errs []error
+	for _, dp := range dps {
+		errs = append(errs, e.line(name, typ, float64(dp.Value), dp.Attributes))
+	}
+	return errors.Join(errs...)
+}
+
+// encodeHistogram encodes the .count, .sum, .min, and .max lines of each
+// data point.
+func encodeHistogram[N int64 | float64](
+	e *encoder,
+	name string,
+	temporality metricdata.Temporality,
+	dps []metricdata.HistogramDataPoint[N],
+) error {
+	var errs []error
+	for _, dp := range dps {
+		errs = append(errs, e.histogramLines(name, temporality, dp.Count, float64(dp.Sum), dp.Attributes))
+		if v, ok := dp.Min.Value(); ok {
+			errs = append(errs, e.line(name+".min", typeGauge, float64(v), dp.Attributes))
+		}
+		if v, ok := dp.Max.Value(); ok {
+			errs = append(errs, e.line(name+".max", typeGauge, float64(v), dp.Attributes))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// encodeExponentialHistogram encodes the .count, .sum, .min, and .max lines
+// of each data point.
+func encodeExponentialHistogram[N int64 | float64](
+	e *encoder,
+	name string,
+	temporality metricdata.Temporality,
+	dps []metricdata.ExponentialHistogramDataPoint[N],
+) error {
+	var errs []error
+	for _, dp := range dps {
+		errs = append(errs, e.histogramLines(name, temporality, dp.Count, float64(dp.Sum), dp.Attributes))
+		if v, ok := dp.Min.Value(); ok {
+			errs = append(errs, e.line(name+".min", typeGauge, float64(v), dp.Attributes))
+		}
+		if v, ok := dp.Max.Value(); ok {
+			errs = append(errs, e.line(name+".max", typeGauge, float64(v), dp.Attributes))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// histogramLines encodes the .count and .sum lines of a histogram data
+// point.
+func (e *encoder) histogramLines(
+	name string,
+	temporality metricdata.Temporality,
+	count uint64,
+	sum float64,
+	attrs attribute.Set,
+) error {
+	typ := sumType(true, temporality)
+	return errors.Join(
+		e.line(name+".count", typ, float64(count), attrs),
+		e.line(name+".sum", typ, sum, attrs),
+	)
+}
+
+// line encodes the line of value with the StatsD type typ.
+func (e *encoder) line(name, typ string, value float64, attrs attribute.Set) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("%w: %s", errNonFinite, name)
+	}
+
+	tags := e.appendTags(nil, attrs)
+	if e.flavor == FlavorStatsD && typ == typeGauge && value < 0 {
+		// A StatsD gauge value with a sign is a change of the gauge, not its
+		// value. Reset the gauge to set it to a negative value.
+		e.lines = append(e.lines, formatLine(name, 0, typ, tags))
+	}
+	e.lines = append(e.lines, formatLine(name, value, typ, tags))
+	return nil
+}
+
+// appendTags appends the DogStatsD tags of attrs and the constant tags to b.
+func (e *encoder) appendTags(b []byte, attrs attribute.Set) []byte {
+	if e.flavor != FlavorDogStatsD || (len(e.tags) == 0 && attrs.Len() == 0) {
+		return b
+	}
+
+	set := attrs
+	if len(e.tags) > 0 {
+		// The attributes of the data point take precedence, the last value
+		// of a key is kept.
+		set = attribute.NewSet(append(append([]attribute.KeyValue(nil), e.tags...), attrs.ToSlice()...)...)
+	}
+	for i, kv := range set.ToSlice() {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, sanitizeName(string(kv.Key))...)
+		b = append(b, ':')
+		b = append(b, sanitizeTag(kv.Value.Emit())...)
+	}
+	return b
+}
+
+// formatLine returns the line "name:value|typ|#tags".
+func formatLine(name string, value float64, typ string, tags []byte) []byte {
+	b := make([]byte, 0, len(name)+len(typ)+len(tags)+24)
+	b = append(b, name...)
+	b = append(b, ':')
+	b = strconv.AppendFloat(b, value, 'f', -1, 64)
+	b = append(b, '|')
+	b = append(b, typ...)
+	if len(tags) > 0 {
+		b = append(b, "|#"...)
+		b = append(b, tags...)
+	}
+	return b
+}
+
+// sanitizeName replaces the characters with a meaning in the line protocol
+// in a metric name or a tag key.
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', '@', '#', ',', ' ', '\n', '\r':
+			return '_'
+		}
+		return r
+	}, name)
+}
+
+// sanitizeTag replaces the characters with a meaning in the line protocol
+// in a tag value.
+func sanitizeTag(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '|', '#', ',', '\n', '\r':
+			return '_'
+		}
+		return r
+	}, s)
+}
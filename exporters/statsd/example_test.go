@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd_test
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/statsd"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+func Example() {
+	exp := statsd.New(
+		statsd.WithAddress("unix:///var/run/datadog/dsd.socket"),
+		statsd.WithTags(attribute.String("env", "prod")),
+	)
+
+	// Export at the flush interval of the server, 10 seconds by default.
+	reader := sdkmetric.NewPeriodicReader(exp, sdkmetric.WithInterval(10*time.Second))
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = mp.Shutdown(context.Background()) }()
+}
@@ -0,0 +1,170 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var errShutdown = errors.New("statsd: exporter is shutdown")
+
+// Exporter is a metric exporter sending metrics to a StatsD or DogStatsD
+// server. Use it with a [metric.PeriodicReader].
+type Exporter struct {
+	network       string
+	address       string
+	maxPacketSize int
+
+	mu      sync.Mutex
+	enc     encoder
+	conn    net.Conn
+	stopped bool
+}
+
+var _ metric.Exporter = (*Exporter)(nil)
+
+// New returns a new Exporter configured with options.
+//
+// The connection to the server is established by the first export, so the
+// server does not need to be available when the Exporter is created.
+func New(options ...Option) *Exporter {
+	cfg := newConfig(options)
+
+	network, address := "udp", cfg.address
+	if path, ok := strings.CutPrefix(cfg.address, unixAddressPrefix); ok {
+		network, address = "unixgram", path
+	}
+	return &Exporter{
+		network:       network,
+		address:       address,
+		maxPacketSize: cfg.maxPacketSize,
+		enc: encoder{
+			flavor: cfg.flavor,
+			prefix: cfg.prefix,
+			tags:   cfg.tags,
+		},
+	}
+}
+
+// Temporality returns DeltaTemporality for the counters, observable counters,
+// and histograms, whose increments are aggregated by the server. It returns
+// CumulativeTemporality for the other instrument kinds, whose current value
+// is sent as a gauge.
+func (*Exporter) Temporality(k metric.InstrumentKind) metricdata.Temporality {
+	switch k {
+	case metric.InstrumentKindCounter, metric.InstrumentKindObservableCounter, metric.InstrumentKindHistogram:
+		return metricdata.DeltaTemporality
+	}
+	return metricdata.CumulativeTemporality
+}
+
+// Aggregation returns the default Aggregation of the instrument kind, except
+// for histograms which are aggregated without buckets. The buckets of a
+// histogram cannot be sent to a StatsD server.
+func (*Exporter) Aggregation(k metric.InstrumentKind) metric.Aggregation {
+	if k == metric.InstrumentKindHistogram {
+		return metric.AggregationExplicitBucketHistogram{Boundaries: []float64{}}
+	}
+	return metric.DefaultAggregationSelector(k)
+}
+
+// Export sends rm to the server.
+//
+// The metrics that cannot be sent with the line protocol are dropped and an
+// error describing them is returned once the other metrics are sent.
+func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stopped {
+		return errShutdown
+	}
+	defer global.Debug("StatsD exporter export", "Data", rm)
+
+	e.enc.lines = e.enc.lines[:0]
+	encErr := e.enc.encode(rm)
+	if err := e.send(ctx, e.enc.lines); err != nil {
+		return errors.Join(err, encErr)
+	}
+	return encErr
+}
+
+// send sends lines batched in datagrams of at most e.maxPacketSize bytes.
+func (e *Exporter) send(ctx context.Context, lines [][]byte) error {
+	if len(lines) == 0 {
+		return nil
+	}
+	if e.conn == nil {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, e.network, e.address)
+		if err != nil {
+			return fmt.Errorf("statsd: dial: %w", err)
+		}
+		e.conn = conn
+	}
+
+	packet := make([]byte, 0, e.maxPacketSize)
+	for _, line := range lines {
+		if len(packet) > 0 && len(packet)+1+len(line) > e.maxPacketSize {
+			if err := e.write(packet); err != nil {
+				return err
+			}
+			packet = packet[:0]
+		}
+		if len(packet) > 0 {
+			packet = append(packet, '\n')
+		}
+		packet = append(packet, line...)
+	}
+	return e.write(packet)
+}
+
+// write writes packet to the connection. The connection is closed on error
+// to be established again by the next export.
+func (e *Exporter) write(packet []byte) error {
+	if _, err := e.conn.Write(packet); err != nil {
+		_ = e.conn.Close()
+		e.conn = nil
+		return fmt.Errorf("statsd: write: %w", err)
+	}
+	return nil
+}
+
+// ForceFlush does nothing, the Exporter sends the metrics when they are
+// exported.
+func (*Exporter) ForceFlush(ctx context.Context) error {
+	return ctx.Err()
+}
+
+// Shutdown closes the connection to the server. Calls to Export after
+// Shutdown return an error.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.stopped {
+		return nil
+	}
+	e.stopped = true
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close()
+	e.conn = nil
+	return err
+}
+
+// MarshalLog returns logging data about the Exporter.
+func (*Exporter) MarshalLog() any {
+	return struct{ Type string }{Type: "StatsD"}
+}
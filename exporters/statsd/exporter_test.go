@@ -0,0 +1,321 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package statsd
+
+import (
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+var attrs = attribute.NewSet(attribute.String("method", "GET"), attribute.Int("code", 200))
+
+func resourceMetrics(metrics ...metricdata.Metrics) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{Metrics: metrics}},
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	tests := []struct {
+		name string
+		enc  encoder
+		m    metricdata.Metrics
+		want []string
+	}{
+		{
+			name: "DeltaCounter",
+			m: metricdata.Metrics{
+				Name: "requests",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.DeltaTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Attributes: attrs, Value: 3}},
+				},
+			},
+			want: []string{"requests:3|c|#code:200,method:GET"},
+		},
+		{
+			name: "CumulativeCounter",
+			m: metricdata.Metrics{
+				Name: "requests",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints:  []metricdata.DataPoint[int64]{{Value: 30}},
+				},
+			},
+			want: []string{"requests:30|g"},
+		},
+		{
+			name: "UpDownCounter",
+			m: metricdata.Metrics{
+				Name: "queue.size",
+				Data: metricdata.Sum[float64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints:  []metricdata.DataPoint[float64]{{Value: -1.5}},
+				},
+			},
+			want: []string{"queue.size:-1.5|g"},
+		},
+		{
+			name: "StatsDNegativeGauge",
+			enc:  encoder{flavor: FlavorStatsD},
+			m: metricdata.Metrics{
+				Name: "temperature",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: attrs, Value: -4}, {Value: 4}},
+				},
+			},
+			want: []string{"temperature:0|g", "temperature:-4|g", "temperature:4|g"},
+		},
+		{
+			name: "Histogram",
+			m: metricdata.Metrics{
+				Name: "duration",
+				Data: metricdata.Histogram[float64]{
+					Temporality: metricdata.DeltaTemporality,
+					DataPoints: []metricdata.HistogramDataPoint[float64]{{
+						Attributes: attribute.NewSet(attribute.String("route", "/")),
+						Count:      4,
+						Sum:        1.25,
+						Min:        metricdata.NewExtrema(0.05),
+						Max:        metricdata.NewExtrema(0.75),
+					}},
+				},
+			},
+			want: []string{
+				"duration.count:4|c|#route:/",
+				"duration.sum:1.25|c|#route:/",
+				"duration.min:0.05|g|#route:/",
+				"duration.max:0.75|g|#route:/",
+			},
+		},
+		{
+			name: "ExponentialHistogram",
+			m: metricdata.Metrics{
+				Name: "size",
+				Data: metricdata.ExponentialHistogram[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					DataPoints:  []metricdata.ExponentialHistogramDataPoint[int64]{{Count: 2, Sum: 10}},
+				},
+			},
+			want: []string{"size.count:2|g", "size.sum:10|g"},
+		},
+		{
+			name: "PrefixAndTags",
+			enc: encoder{
+				prefix: "app.",
+				tags:   []attribute.KeyValue{attribute.String("env", "prod"), attribute.String("method", "POST")},
+			},
+			m: metricdata.Metrics{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: attrs, Value: 1}, {Value: 2}},
+				},
+			},
+			want: []string{
+				"app.gauge:1|g|#code:200,env:prod,method:GET",
+				"app.gauge:2|g|#env:prod,method:POST",
+			},
+		},
+		{
+			name: "StatsDDropsTags",
+			enc:  encoder{flavor: FlavorStatsD, tags: []attribute.KeyValue{attribute.String("env", "prod")}},
+			m: metricdata.Metrics{
+				Name: "gauge",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{Attributes: attrs, Value: 1}},
+				},
+			},
+			want: []string{"gauge:1|g"},
+		},
+		{
+			name: "Sanitized",
+			m: metricdata.Metrics{
+				Name: "a:b|c@d e",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{
+						Attributes: attribute.NewSet(attribute.String("k:1|#", "v:1,2|#\n")),
+						Value:      1,
+					}},
+				},
+			},
+			want: []string{"a_b_c_d_e:1|g|#k_1__:v:1_2___"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc := tt.enc
+			require.NoError(t, enc.encode(resourceMetrics(tt.m)))
+			got := make([]string, len(enc.lines))
+			for i, l := range enc.lines {
+				got[i] = string(l)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEncoderErrors(t *testing.T) {
+	var enc encoder
+	err := enc.encode(resourceMetrics(
+		metricdata.Metrics{Name: "unknown", Data: metricdata.Summary{}},
+		metricdata.Metrics{
+			Name: "gauge",
+			Data: metricdata.Gauge[float64]{
+				DataPoints: []metricdata.DataPoint[float64]{{Value: math.NaN()}, {Value: 1}},
+			},
+		},
+	))
+	assert.ErrorIs(t, err, errUnknownAggregation)
+	assert.ErrorIs(t, err, errNonFinite)
+	require.Len(t, enc.lines, 1)
+	assert.Equal(t, "gauge:1|g", string(enc.lines[0]))
+}
+
+// listen returns a datagram listener on network and its address.
+func listen(t *testing.T, network string) (net.PacketConn, string) {
+	t.Helper()
+	addr := "127.0.0.1:0"
+	if network == "unixgram" {
+		// Unix socket paths are limited to about 100 bytes, t.TempDir may be
+		// too long.
+		dir, err := os.MkdirTemp("", "statsd")
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = os.RemoveAll(dir) })
+		addr = filepath.Join(dir, "dsd.sock")
+	}
+	conn, err := net.ListenPacket(network, addr)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	if network == "unixgram" {
+		return conn, unixAddressPrefix + addr
+	}
+	return conn, conn.LocalAddr().String()
+}
+
+// receive returns the lines of the packets received by conn until no packet
+// is received for a while.
+func receive(t *testing.T, conn net.PacketConn) [][]string {
+	t.Helper()
+	var packets [][]string
+	buf := make([]byte, 65536)
+	for {
+		require.NoError(t, conn.SetReadDeadline(time.Now().Add(200*time.Millisecond)))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			return packets
+		}
+		packets = append(packets, strings.Split(string(buf[:n]), "\n"))
+	}
+}
+
+func gauges(n int) metricdata.Metrics {
+	dps := make([]metricdata.DataPoint[int64], n)
+	for i := range dps {
+		dps[i] = metricdata.DataPoint[int64]{
+			Attributes: attribute.NewSet(attribute.Int("i", i)),
+			Value:      int64(i),
+		}
+	}
+	return metricdata.Metrics{Name: "gauge", Data: metricdata.Gauge[int64]{DataPoints: dps}}
+}
+
+func TestExporterExport(t *testing.T) {
+	for _, network := range []string{"udp", "unixgram"} {
+		t.Run(network, func(t *testing.T) {
+			conn, addr := listen(t, network)
+			exp := New(WithAddress(addr))
+
+			require.NoError(t, exp.Export(t.Context(), resourceMetrics(gauges(2))))
+			assert.Equal(t, [][]string{{"gauge:0|g|#i:0", "gauge:1|g|#i:1"}}, receive(t, conn))
+
+			require.NoError(t, exp.Shutdown(t.Context()))
+			assert.ErrorIs(t, exp.Export(t.Context(), resourceMetrics(gauges(1))), errShutdown)
+		})
+	}
+}
+
+func TestExporterMaxPacketSize(t *testing.T) {
+	conn, addr := listen(t, "udp")
+	// Each line is 14 or 15 bytes, 4 lines fit in a packet.
+	exp := New(WithAddress(addr), WithMaxPacketSize(64))
+	defer func() { require.NoError(t, exp.Shutdown(t.Context())) }()
+
+	require.NoError(t, exp.Export(t.Context(), resourceMetrics(gauges(10))))
+	packets := receive(t, conn)
+	require.Len(t, packets, 3)
+
+	var lines int
+	for _, p := range packets {
+		assert.LessOrEqual(t, len(strings.Join(p, "\n")), 64)
+		lines += len(p)
+	}
+	assert.Equal(t, 10, lines)
+}
+
+func TestExporterDialError(t *testing.T) {
+	exp := New(WithAddress(unixAddressPrefix + filepath.Join(t.TempDir(), "missing.sock")))
+	assert.Error(t, exp.Export(t.Context(), resourceMetrics(gauges(1))))
+	// Nothing to send does not need a connection.
+	assert.NoError(t, exp.Export(t.Context(), resourceMetrics()))
+}
+
+func TestExporterWithMeterProvider(t *testing.T) {
+	conn, addr := listen(t, "udp")
+	exp := New(WithAddress(addr), WithPrefix("app."))
+	assert.Equal(t, metricdata.DeltaTemporality, exp.Temporality(metric.InstrumentKindCounter))
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(metric.InstrumentKindUpDownCounter))
+
+	reader := metric.NewManualReader(
+		metric.WithTemporalitySelector(exp.Temporality),
+		metric.WithAggregationSelector(exp.Aggregation),
+	)
+	mp := metric.NewMeterProvider(metric.WithReader(reader))
+	meter := mp.Meter("test")
+	counter, err := meter.Int64Counter("jobs")
+	require.NoError(t, err)
+	hist, err := meter.Float64Histogram("duration")
+	require.NoError(t, err)
+
+	export := func() []string {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+		require.NoError(t, exp.Export(t.Context(), &rm))
+		var lines []string
+		for _, p := range receive(t, conn) {
+			lines = append(lines, p...)
+		}
+		return lines
+	}
+
+	counter.Add(t.Context(), 2)
+	hist.Record(t.Context(), 0.5)
+	hist.Record(t.Context(), 1.5)
+	assert.ElementsMatch(t, []string{
+		"app.jobs:2|c",
+		"app.duration.count:2|c",
+		"app.duration.sum:2|c",
+		"app.duration.min:0.5|g",
+		"app.duration.max:1.5|g",
+	}, export())
+
+	// Only the increments since the previous export are sent.
+	counter.Add(t.Context(), 1)
+	assert.Equal(t, []string{"app.jobs:1|c"}, export())
+	require.NoError(t, exp.Shutdown(t.Context()))
+}
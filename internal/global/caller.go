@@ -0,0 +1,99 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+)
+
+// callerScope is the instrumentation scope derived from a package.
+type callerScope struct {
+	name, version string
+}
+
+var (
+	callerScopes sync.Map // map[string]callerScope
+
+	buildInfoOnce sync.Once
+	buildInfo     *debug.BuildInfo
+)
+
+// CallerScope returns the instrumentation scope name and version derived from
+// the package of the function skip frames above the caller of CallerScope. A
+// skip of 0 identifies the caller of CallerScope.
+//
+// The name is the import path of the package. The version is the version of
+// the module containing the package, as recorded in the build information of
+// the binary. It is empty if the version is unknown, e.g. for packages of the
+// main module built from a working tree.
+func CallerScope(skip int) (name, version string) {
+	var pcs [1]uintptr
+	// Skip runtime.Callers and CallerScope.
+	if runtime.Callers(skip+2, pcs[:]) < 1 {
+		return "", ""
+	}
+	frame, _ := runtime.CallersFrames(pcs[:]).Next()
+	pkg := funcPackage(frame.Function)
+	if pkg == "" {
+		return "", ""
+	}
+
+	if s, ok := callerScopes.Load(pkg); ok {
+		s := s.(callerScope)
+		return s.name, s.version
+	}
+	s := callerScope{name: pkg, version: moduleVersion(pkg)}
+	callerScopes.Store(pkg, s)
+	return s.name, s.version
+}
+
+// funcPackage returns the import path of the package of the fully-qualified
+// function name fn (e.g. "example.com/pkg.(*T).Method").
+func funcPackage(fn string) string {
+	// The package path ends at the first dot after the last slash. Dots in the
+	// last element of the path are escaped as "%2e" by the linker.
+	lastSlash := strings.LastIndexByte(fn, '/')
+	dot := strings.IndexByte(fn[lastSlash+1:], '.')
+	if dot < 0 {
+		return ""
+	}
+	return strings.ReplaceAll(fn[:lastSlash+1+dot], "%2e", ".")
+}
+
+// moduleVersion returns the version of the module containing pkg, or an empty
+// string if it is unknown.
+func moduleVersion(pkg string) string {
+	buildInfoOnce.Do(func() {
+		buildInfo, _ = debug.ReadBuildInfo()
+	})
+	if buildInfo == nil {
+		return ""
+	}
+
+	var (
+		best    string
+		version string
+	)
+	mods := append([]*debug.Module{&buildInfo.Main}, buildInfo.Deps...)
+	for _, m := range mods {
+		if m == nil || len(m.Path) <= len(best) {
+			continue
+		}
+		if pkg != m.Path && !strings.HasPrefix(pkg, m.Path+"/") {
+			continue
+		}
+		best = m.Path
+		version = m.Version
+		if m.Replace != nil && m.Replace.Version != "" {
+			version = m.Replace.Version
+		}
+	}
+	if version == "(devel)" {
+		return ""
+	}
+	return version
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallerScope(t *testing.T) {
+	name, _ := CallerScope(0)
+	assert.Equal(t, "go.opentelemetry.io/otel/internal/global", name)
+
+	wrapper := func() string {
+		name, _ := CallerScope(1)
+		return name
+	}
+	assert.Equal(t, "go.opentelemetry.io/otel/internal/global", wrapper())
+}
+
+func TestFuncPackage(t *testing.T) {
+	tests := []struct {
+		fn, want string
+	}{
+		{fn: "main.main", want: "main"},
+		{fn: "example.com/pkg.Func", want: "example.com/pkg"},
+		{fn: "example.com/pkg.(*T).Method", want: "example.com/pkg"},
+		{fn: "example.com/pkg.Func.func1", want: "example.com/pkg"},
+		{fn: "gopkg.in/yaml%2ev3.Unmarshal", want: "gopkg.in/yaml.v3"},
+		{fn: "invalid", want: ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, funcPackage(tt.fn), tt.fn)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// droppedReportInterval is the minimum interval between two summaries of the
+// dropped or overflowed telemetry. It is a variable so tests can shorten it.
+var droppedReportInterval = time.Minute
+
+var (
+	droppedMu       sync.Mutex
+	droppedCounters []*DropCounter
+
+	// droppedScheduled is true when a summary is scheduled.
+	droppedScheduled atomic.Bool
+)
+
+// DropCounter counts the telemetry dropped by the SDK for a reason.
+//
+// The counts of all the DropCounters are summarized in a warning logged at
+// most once per minute, and only if telemetry was dropped. This reports the
+// drops without logging a message for each of them.
+type DropCounter struct {
+	name string
+	// msg is the message of the warning reporting the count.
+	msg string
+	n   atomic.Int64
+}
+
+// NewDropCounter returns a new DropCounter registered for the summary of the
+// dropped telemetry. The counts are reported as "<telemetry>.<reason>", e.g.
+// "spans.queue_full".
+//
+// It is meant to be called once per telemetry and reason, when the package
+// of the SDK dropping it is initialized.
+func NewDropCounter(telemetry, reason string) *DropCounter {
+	return newDropCounter(telemetry+"."+reason, "dropped telemetry")
+}
+
+// NewOverflowCounter returns a new DropCounter counting the telemetry that
+// exceeded a cardinality limit. This telemetry is not dropped but aggregated
+// into an overflow series, so it is summarized in a separate warning. The
+// counts are reported as "<telemetry>.cardinality_overflow", e.g.
+// "metric_streams.cardinality_overflow".
+//
+// It is meant to be called once per telemetry, when the package of the SDK
+// limiting it is initialized.
+func NewOverflowCounter(telemetry string) *DropCounter {
+	return newDropCounter(telemetry+".cardinality_overflow", "telemetry exceeded the cardinality limit")
+}
+
+func newDropCounter(name, msg string) *DropCounter {
+	c := &DropCounter{name: name, msg: msg}
+	droppedMu.Lock()
+	droppedCounters = append(droppedCounters, c)
+	droppedMu.Unlock()
+	return c
+}
+
+// Add records that n telemetry items were dropped.
+func (c *DropCounter) Add(n int64) {
+	if n <= 0 {
+		return
+	}
+	c.n.Add(n)
+	if !droppedScheduled.Load() && droppedScheduled.CompareAndSwap(false, true) {
+		time.AfterFunc(droppedReportInterval, reportDropped)
+	}
+}
+
+// reportDropped logs the counts of the dropped telemetry since the last
+// report and resets them. The counts are logged in one warning per message of
+// their DropCounters.
+func reportDropped() {
+	// Allow the drops counted from now on to schedule the next report.
+	droppedScheduled.Store(false)
+
+	droppedMu.Lock()
+	var (
+		msgs []string
+		kvs  = make(map[string][]any)
+	)
+	for _, c := range droppedCounters {
+		if n := c.n.Swap(0); n > 0 {
+			if _, ok := kvs[c.msg]; !ok {
+				msgs = append(msgs, c.msg)
+			}
+			kvs[c.msg] = append(kvs[c.msg], c.name, n)
+		}
+	}
+	droppedMu.Unlock()
+
+	for _, msg := range msgs {
+		kv := append([]any{"interval", droppedReportInterval.String()}, kvs[msg]...)
+		Warn(msg, kv...)
+	}
+}
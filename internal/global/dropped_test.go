@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDropCounter(t *testing.T) {
+	orig := droppedReportInterval
+	droppedReportInterval = 10 * time.Millisecond
+	t.Cleanup(func() { droppedReportInterval = orig })
+
+	var (
+		mu   sync.Mutex
+		logs []string
+	)
+	SetLogger(funcr.New(func(prefix, args string) {
+		mu.Lock()
+		defer mu.Unlock()
+		logs = append(logs, args)
+	}, funcr.Options{Verbosity: 1}))
+	t.Cleanup(func() { ResetForTest(t) })
+	got := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), logs...)
+	}
+
+	queueFull := NewDropCounter("spans", "queue_full")
+	limit := NewDropCounter("log_records", "limit")
+	// The counters without drops are not reported.
+	_ = NewDropCounter("metric_measurements", "nan")
+
+	queueFull.Add(2)
+	queueFull.Add(3)
+	limit.Add(1)
+	limit.Add(0)
+
+	// The drops are summarized in a single message.
+	require.Eventually(t, func() bool { return len(got()) > 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{
+		`"level"=1 "msg"="dropped telemetry" "interval"="10ms" "spans.queue_full"=5 "log_records.limit"=1`,
+	}, got())
+
+	// Nothing is reported when nothing is dropped.
+	time.Sleep(3 * droppedReportInterval)
+	assert.Len(t, got(), 1)
+
+	queueFull.Add(1)
+	require.Eventually(t, func() bool { return len(got()) == 2 }, time.Second, time.Millisecond)
+	assert.Equal(t, `"level"=1 "msg"="dropped telemetry" "interval"="10ms" "spans.queue_full"=1`, got()[1])
+
+	// The overflows are reported separately from the drops.
+	overflow := NewOverflowCounter("metric_streams")
+	overflow.Add(2)
+	queueFull.Add(1)
+	require.Eventually(t, func() bool { return len(got()) == 4 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{
+		`"level"=1 "msg"="dropped telemetry" "interval"="10ms" "spans.queue_full"=1`,
+		`"level"=1 "msg"="telemetry exceeded the cardinality limit" "interval"="10ms" "metric_streams.cardinality_overflow"=2`,
+	}, got()[2:])
+}
@@ -69,3 +69,32 @@ func (p *textMapPropagator) Extract(ctx context.Context, carrier propagation.Tex
 func (p *textMapPropagator) Fields() []string {
 	return p.effectiveDelegate().Fields()
 }
+
+// currentTextMapPropagator is a TextMapPropagator that delegates all calls to
+// the current global TextMapPropagator.
+type currentTextMapPropagator struct{}
+
+// Compile-time guarantee that currentTextMapPropagator implements the
+// propagation.TextMapPropagator interface.
+var _ propagation.TextMapPropagator = currentTextMapPropagator{}
+
+// CurrentTextMapPropagator returns a TextMapPropagator that delegates all
+// calls to the global TextMapPropagator set when they are made.
+func CurrentTextMapPropagator() propagation.TextMapPropagator {
+	return currentTextMapPropagator{}
+}
+
+// Inject set cross-cutting concerns from the Context into the carrier.
+func (currentTextMapPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	TextMapPropagator().Inject(ctx, carrier)
+}
+
+// Extract reads cross-cutting concerns from the carrier into a Context.
+func (currentTextMapPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return TextMapPropagator().Extract(ctx, carrier)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (currentTextMapPropagator) Fields() []string {
+	return TextMapPropagator().Fields()
+}
@@ -93,3 +93,40 @@ func fieldsEqual(f1, f2 []string) bool {
 	}
 	return true
 }
+
+func TestCurrentTextMapPropagator(t *testing.T) {
+	ResetForTest(t)
+	current := CurrentTextMapPropagator()
+	if got := current.Fields(); len(got) != 0 {
+		t.Fatalf("default Fields returned %v, want none", got)
+	}
+
+	first := internaltest.NewTextMapPropagator("first")
+	SetTextMapPropagator(first)
+	if got := current.Fields(); !fieldsEqual(got, first.Fields()) {
+		t.Errorf("Fields returned %v, want %v", got, first.Fields())
+	}
+
+	// Unlike the default global TextMapPropagator, it follows replacements of
+	// the global TextMapPropagator.
+	second := internaltest.NewTextMapPropagator("second")
+	SetTextMapPropagator(second)
+	ctx := t.Context()
+	carrier := internaltest.NewTextMapCarrier(nil)
+	current.Inject(ctx, carrier)
+	ctx = current.Extract(ctx, carrier)
+	second.InjectedN(t, carrier, 1)
+	second.ExtractedN(t, ctx, 1)
+	first.InjectedN(t, carrier, 0)
+}
+
+func TestSetCurrentTextMapPropagator(t *testing.T) {
+	ResetForTest(t)
+	delegate := internaltest.NewTextMapPropagator("test")
+	SetTextMapPropagator(delegate)
+
+	SetTextMapPropagator(CurrentTextMapPropagator())
+	if got := TextMapPropagator(); got != delegate {
+		t.Errorf("global TextMapPropagator replaced by one delegating to it: %v", got)
+	}
+}
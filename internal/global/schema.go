@@ -0,0 +1,118 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"maps"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// schemaRegistry holds the schema URLs reported by instrumentation scopes.
+type schemaRegistry struct {
+	mu   sync.RWMutex
+	urls map[string]string
+}
+
+var globalSchemaRegistry = &schemaRegistry{}
+
+// RegisterSchemaURL is the internal implementation for otel.RegisterSchemaURL.
+func RegisterSchemaURL(scope, schemaURL string) {
+	r := globalSchemaRegistry
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if schemaURL == "" {
+		delete(r.urls, scope)
+		return
+	}
+	if r.urls == nil {
+		r.urls = make(map[string]string)
+	}
+	r.urls[scope] = schemaURL
+}
+
+// SchemaURL is the internal implementation for otel.SchemaURL.
+func SchemaURL(scope string) (string, bool) {
+	r := globalSchemaRegistry
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	u, ok := r.urls[scope]
+	return u, ok
+}
+
+// SchemaURLs is the internal implementation for otel.SchemaURLs.
+func SchemaURLs() map[string]string {
+	r := globalSchemaRegistry
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return maps.Clone(r.urls)
+}
+
+// LatestSchemaURL is the internal implementation for otel.LatestSchemaURL.
+func LatestSchemaURL() string {
+	r := globalSchemaRegistry
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var (
+		latest  string
+		version []int
+	)
+	for _, u := range r.urls {
+		v, ok := schemaVersion(u)
+		if !ok {
+			continue
+		}
+		if latest == "" || compareVersion(v, version) > 0 ||
+			(compareVersion(v, version) == 0 && u < latest) {
+			latest, version = u, v
+		}
+	}
+	return latest
+}
+
+// schemaVersion parses the version from the last path segment of a schema
+// URL (e.g. "https://opentelemetry.io/schemas/1.26.0").
+func schemaVersion(schemaURL string) ([]int, bool) {
+	i := strings.LastIndexByte(schemaURL, '/')
+	if i < 0 || i == len(schemaURL)-1 {
+		return nil, false
+	}
+
+	parts := strings.Split(schemaURL[i+1:], ".")
+	v := make([]int, len(parts))
+	for j, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 {
+			return nil, false
+		}
+		v[j] = n
+	}
+	return v, true
+}
+
+// compareVersion returns -1, 0, or +1 if a is less than, equal to, or greater
+// than b. Missing trailing components are treated as zero.
+func compareVersion(a, b []int) int {
+	for i := range max(len(a), len(b)) {
+		var x, y int
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,90 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaRegistry(t *testing.T) {
+	ResetForTest(t)
+
+	_, ok := SchemaURL("scope")
+	assert.False(t, ok)
+	assert.Empty(t, SchemaURLs())
+	assert.Empty(t, LatestSchemaURL())
+
+	RegisterSchemaURL("a", "https://opentelemetry.io/schemas/1.9.0")
+	RegisterSchemaURL("b", "https://opentelemetry.io/schemas/1.26.0")
+	RegisterSchemaURL("c", "https://opentelemetry.io/schemas/1.21.0")
+
+	got, ok := SchemaURL("b")
+	assert.True(t, ok)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", got)
+	assert.Equal(t, map[string]string{
+		"a": "https://opentelemetry.io/schemas/1.9.0",
+		"b": "https://opentelemetry.io/schemas/1.26.0",
+		"c": "https://opentelemetry.io/schemas/1.21.0",
+	}, SchemaURLs())
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.26.0", LatestSchemaURL())
+
+	// Returned map is a copy.
+	SchemaURLs()["d"] = "https://opentelemetry.io/schemas/2.0.0"
+	_, ok = SchemaURL("d")
+	assert.False(t, ok)
+
+	RegisterSchemaURL("b", "")
+	_, ok = SchemaURL("b")
+	assert.False(t, ok)
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.21.0", LatestSchemaURL())
+}
+
+func TestLatestSchemaURLIgnoresUnparsable(t *testing.T) {
+	ResetForTest(t)
+
+	RegisterSchemaURL("a", "https://example.com/schemas/latest")
+	RegisterSchemaURL("b", "https://example.com/schemas/")
+	assert.Empty(t, LatestSchemaURL())
+
+	RegisterSchemaURL("c", "https://opentelemetry.io/schemas/1.4")
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.4", LatestSchemaURL())
+}
+
+func TestCompareVersion(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		want int
+	}{
+		{[]int{1, 2, 3}, []int{1, 2, 3}, 0},
+		{[]int{1, 2}, []int{1, 2, 0}, 0},
+		{[]int{1, 10, 0}, []int{1, 9, 0}, 1},
+		{[]int{1, 9, 0}, []int{1, 10, 0}, -1},
+		{[]int{2}, []int{1, 99, 99}, 1},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, compareVersion(tt.a, tt.b), "%v vs %v", tt.a, tt.b)
+	}
+}
+
+func TestSchemaRegistryConcurrentSafe(t *testing.T) {
+	ResetForTest(t)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			scope := strconv.Itoa(i)
+			RegisterSchemaURL(scope, "https://opentelemetry.io/schemas/1."+scope+".0")
+			_, _ = SchemaURL(scope)
+			_ = SchemaURLs()
+			_ = LatestSchemaURL()
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.9.0", LatestSchemaURL())
+}
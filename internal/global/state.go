@@ -99,6 +99,16 @@ func TextMapPropagator() propagation.TextMapPropagator {
 func SetTextMapPropagator(p propagation.TextMapPropagator) {
 	current := TextMapPropagator()
 
+	if _, ok := p.(currentTextMapPropagator); ok {
+		// Do not assign a TextMapPropagator delegating to the global
+		// TextMapPropagator to avoid an infinite recursion.
+		Error(
+			errors.New("text map propagator delegates to the global text map propagator"),
+			"Setting text map propagator to one delegating to it. No propagator will be configured",
+		)
+		return
+	}
+
 	if _, cOk := current.(*textMapPropagator); cOk {
 		if _, pOk := p.(*textMapPropagator); pOk && current == p {
 			// Do not assign the default delegating TextMapPropagator to
@@ -18,5 +18,6 @@ func ResetForTest(t testing.TB) {
 		delegateTraceOnce = sync.Once{}
 		delegateTextMapPropagatorOnce = sync.Once{}
 		delegateMeterOnce = sync.Once{}
+		globalSchemaRegistry = &schemaRegistry{}
 	})
 }
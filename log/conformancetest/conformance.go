@@ -0,0 +1,199 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformancetest provides tests validating that an implementation of
+// the OpenTelemetry log API conforms to the semantics defined by the
+// OpenTelemetry specification, and that it is safe to use concurrently.
+//
+// The tests are meant to be run by the tests of the implementation, e.g.
+//
+//	func TestConformance(t *testing.T) {
+//		conformancetest.RunLoggerProviderTests(t, func() log.LoggerProvider {
+//			return mysdk.NewLoggerProvider()
+//		})
+//		conformancetest.RunLoggerTests(t, func() log.Logger {
+//			return mysdk.NewLoggerProvider().Logger("conformance")
+//		})
+//	}
+//
+// Running the tests with the race detector enabled is recommended.
+package conformancetest // import "go.opentelemetry.io/otel/log/conformancetest"
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// goroutines is the number of goroutines concurrently calling the API in
+// the concurrency tests.
+const goroutines = 20
+
+// RunLoggerProviderTests runs the conformance tests of the LoggerProvider API
+// against the LoggerProviders returned by newLoggerProvider. Each call of
+// newLoggerProvider needs to return a new LoggerProvider.
+func RunLoggerProviderTests(t *testing.T, newLoggerProvider func() log.LoggerProvider) {
+	t.Run("LoggerProvider", func(t *testing.T) {
+		t.Run("allow creating an arbitrary number of LoggerProvider instances", func(t *testing.T) {
+			t.Parallel()
+
+			for range 5 {
+				if newLoggerProvider() == nil {
+					t.Fatal("nil LoggerProvider")
+				}
+			}
+		})
+
+		t.Run("returns a Logger for any name and options", func(t *testing.T) {
+			t.Parallel()
+
+			lp := newLoggerProvider()
+			for _, name := range []string{"", "scope", "go.opentelemetry.io/otel/log/conformancetest"} {
+				l := lp.Logger(
+					name,
+					log.WithInstrumentationVersion("v1.0.0"),
+					log.WithSchemaURL("https://opentelemetry.io/schemas/1.0.0"),
+					log.WithInstrumentationAttributes(attribute.String("key", "value")),
+				)
+				if l == nil {
+					t.Errorf("nil Logger for name %q", name)
+				}
+			}
+		})
+
+		t.Run("all methods are safe to be called concurrently", func(t *testing.T) {
+			t.Parallel()
+
+			// Run with multiple LoggerProviders to ensure they encapsulate
+			// their own Loggers.
+			var wg sync.WaitGroup
+			for range 2 {
+				lp := newLoggerProvider()
+				for i := range goroutines {
+					wg.Go(func() {
+						_ = lp.Logger(fmt.Sprintf("logger %d", i%5), log.WithInstrumentationVersion(fmt.Sprint(i)))
+					})
+				}
+			}
+			wg.Wait()
+		})
+	})
+}
+
+// RunLoggerTests runs the conformance tests of the Logger API against the
+// Loggers returned by newLogger. Each call of newLogger needs to return a new
+// Logger.
+func RunLoggerTests(t *testing.T, newLogger func() log.Logger) {
+	t.Run("Logger", func(t *testing.T) {
+		t.Run("reports whether it is enabled", func(t *testing.T) {
+			t.Parallel()
+
+			l := newLogger()
+			for _, param := range []log.EnabledParameters{
+				{},
+				{Severity: log.SeverityDebug},
+				{Severity: log.SeverityFatal4, EventName: "event"},
+			} {
+				_ = l.Enabled(t.Context(), param)
+			}
+		})
+
+		t.Run("emits records of any content", func(t *testing.T) {
+			t.Parallel()
+
+			l := newLogger()
+			l.Emit(t.Context(), log.Record{})
+			for i := range 3 {
+				l.Emit(t.Context(), newRecord(i))
+			}
+		})
+
+		t.Run("does not modify emitted records", func(t *testing.T) {
+			t.Parallel()
+
+			r := newRecord(1)
+			want := r.Clone()
+			newLogger().Emit(t.Context(), r)
+			if err := equalRecords(want, r); err != nil {
+				t.Error(err)
+			}
+		})
+
+		t.Run("all methods are safe to be called concurrently", func(t *testing.T) {
+			t.Parallel()
+
+			l := newLogger()
+			var wg sync.WaitGroup
+			for i := range goroutines {
+				wg.Go(func() {
+					r := newRecord(i)
+					if l.Enabled(t.Context(), log.EnabledParameters{Severity: r.Severity(), EventName: r.EventName()}) {
+						l.Emit(t.Context(), r)
+					}
+					l.Emit(t.Context(), r)
+				})
+			}
+			wg.Wait()
+		})
+	})
+}
+
+// newRecord returns a record with all its fields set, varying with i.
+func newRecord(i int) log.Record {
+	var r log.Record
+	now := time.Now()
+	r.SetTimestamp(now)
+	r.SetObservedTimestamp(now)
+	r.SetSeverity(log.SeverityInfo + log.Severity(i%4))
+	r.SetSeverityText(fmt.Sprintf("INFO%d", i%4+1))
+	r.SetEventName(fmt.Sprintf("event.%d", i%5))
+	r.SetBody(attribute.MapValue(
+		attribute.String("message", "conformance"),
+		attribute.Int("n", i),
+	))
+	r.SetErr(errors.New("conformance error"))
+	r.AddAttributes(
+		attribute.String("key", "value"),
+		attribute.Int("n", i),
+		attribute.Slice("slice", attribute.BoolValue(true), attribute.Float64Value(1.5)),
+		attribute.Map("map", attribute.String("nested", "value")),
+	)
+	return r
+}
+
+// equalRecords returns an error describing the difference between want and
+// got, if any.
+func equalRecords(want, got log.Record) error {
+	switch {
+	case !want.Timestamp().Equal(got.Timestamp()):
+		return fmt.Errorf("timestamp modified: want %v, got %v", want.Timestamp(), got.Timestamp())
+	case want.Severity() != got.Severity():
+		return fmt.Errorf("severity modified: want %v, got %v", want.Severity(), got.Severity())
+	case want.SeverityText() != got.SeverityText():
+		return fmt.Errorf("severity text modified: want %q, got %q", want.SeverityText(), got.SeverityText())
+	case want.EventName() != got.EventName():
+		return fmt.Errorf("event name modified: want %q, got %q", want.EventName(), got.EventName())
+	case !reflect.DeepEqual(want.Body(), got.Body()):
+		return fmt.Errorf("body modified: want %v, got %v", want.Body(), got.Body())
+	}
+
+	var wantAttrs, gotAttrs []attribute.KeyValue
+	want.WalkAttributes(func(kv attribute.KeyValue) bool {
+		wantAttrs = append(wantAttrs, kv)
+		return true
+	})
+	got.WalkAttributes(func(kv attribute.KeyValue) bool {
+		gotAttrs = append(gotAttrs, kv)
+		return true
+	})
+	if !reflect.DeepEqual(wantAttrs, gotAttrs) {
+		return fmt.Errorf("attributes modified: want %v, got %v", wantAttrs, gotAttrs)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import "context"
+
+// ForceFlush flushes the log records held by provider if it has a
+// ForceFlush(context.Context) error method, as the LoggerProvider of
+// go.opentelemetry.io/otel/sdk/log and the global LoggerProvider of
+// go.opentelemetry.io/otel/log/global do. Otherwise, it does nothing and
+// returns nil.
+//
+// It is meant for the bridges of logging libraries to flush the emitted log
+// records at the synchronization points of the bridged library, e.g. when
+// the Sync method of a zap Core is called or in a logrus exit handler,
+// without depending on the SDK.
+func ForceFlush(ctx context.Context, provider LoggerProvider) error {
+	if f, ok := provider.(interface{ ForceFlush(context.Context) error }); ok {
+		return f.ForceFlush(ctx)
+	}
+	return nil
+}
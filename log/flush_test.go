@@ -0,0 +1,34 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+type flushProvider struct {
+	noop.LoggerProvider
+
+	calls int
+}
+
+func (p *flushProvider) ForceFlush(context.Context) error {
+	p.calls++
+	return assert.AnError
+}
+
+func TestForceFlush(t *testing.T) {
+	p := &flushProvider{}
+	assert.ErrorIs(t, log.ForceFlush(t.Context(), p), assert.AnError)
+	assert.Equal(t, 1, p.calls)
+
+	assert.NoError(t, log.ForceFlush(t.Context(), noop.NewLoggerProvider()))
+	assert.NoError(t, log.ForceFlush(t.Context(), nil))
+}
@@ -12,6 +12,9 @@ go.opentelemetry.io/otel.
 package global
 
 import (
+	"io"
+
+	otelglobal "go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/internal/global"
 )
@@ -32,6 +35,25 @@ func Logger(name string, options ...log.LoggerOption) log.Logger {
 	return GetLoggerProvider().Logger(name, options...)
 }
 
+// LoggerAuto returns a [log.Logger] from the globally configured
+// [log.LoggerProvider] named after the import path of the package calling
+// LoggerAuto. The version of the module containing that package, as recorded
+// in the build information of the binary, is used as the instrumentation
+// version unless options provide one.
+//
+// This allows instrumentation libraries to consistently name their scope
+// without repeating their import path. For example, calling LoggerAuto from
+// the package "example.com/foo" is equivalent to:
+//
+//	Logger("example.com/foo", log.WithInstrumentationVersion(version))
+func LoggerAuto(options ...log.LoggerOption) log.Logger {
+	name, version := otelglobal.CallerScope(1)
+	if version != "" {
+		options = append([]log.LoggerOption{log.WithInstrumentationVersion(version)}, options...)
+	}
+	return GetLoggerProvider().Logger(name, options...)
+}
+
 // GetLoggerProvider returns the globally configured [log.LoggerProvider].
 //
 // If a global LoggerProvider has not been configured with [SetLoggerProvider],
@@ -47,3 +69,24 @@ func GetLoggerProvider() log.LoggerProvider {
 func SetLoggerProvider(provider log.LoggerProvider) {
 	global.SetLoggerProvider(provider)
 }
+
+// SetFallbackWriter sets w as the writer of the records emitted by the global
+// Loggers before a global LoggerProvider is configured with
+// [SetLoggerProvider]. By default, these records are discarded.
+//
+// The records are written as lines of text, e.g.
+//
+//	2026-01-02T15:04:05.000Z ERROR example.com/app: failed to load config path=/etc/app.yaml
+//
+// It gives visibility on the records emitted during the startup of an
+// application, e.g. on a crash before the SDK is configured:
+//
+//	global.SetFallbackWriter(os.Stderr)
+//
+// Once a global LoggerProvider is configured, the records are emitted to it
+// and no longer written to w. A nil w discards the records again.
+//
+// The writes to w are serialized. Their errors are ignored.
+func SetFallbackWriter(w io.Writer) {
+	global.SetFallbackWriter(w)
+}
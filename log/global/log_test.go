@@ -22,3 +22,21 @@ func TestMultipleGlobalLoggerProvider(t *testing.T) {
 
 	assert.Equal(t, p2, GetLoggerProvider())
 }
+
+type nameRecordingLoggerProvider struct {
+	log.LoggerProvider
+
+	name string
+}
+
+func (p *nameRecordingLoggerProvider) Logger(name string, _ ...log.LoggerOption) log.Logger {
+	p.name = name
+	return noop.NewLoggerProvider().Logger(name)
+}
+
+func TestLoggerAuto(t *testing.T) {
+	p := &nameRecordingLoggerProvider{}
+	SetLoggerProvider(p)
+	_ = LoggerAuto()
+	assert.Equal(t, "go.opentelemetry.io/otel/log/global", p.name)
+}
@@ -0,0 +1,105 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"io"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// fallback is the writer of the records emitted before a delegate is set, if
+// not nil.
+var fallback atomic.Pointer[fallbackWriter]
+
+// fallbackWriter writes log records as lines of text.
+type fallbackWriter struct {
+	mu  sync.Mutex
+	w   io.Writer
+	buf []byte
+}
+
+// SetFallbackWriter sets the writer of the records emitted by the global
+// Loggers before a delegate is set. A nil w discards them.
+func SetFallbackWriter(w io.Writer) {
+	if w == nil {
+		fallback.Store(nil)
+		return
+	}
+	fallback.Store(&fallbackWriter{w: w})
+}
+
+// write writes r emitted by the Logger named scope as a line:
+//
+//	2026-01-02T15:04:05.000Z ERROR scope: body key=value err="error"
+func (f *fallbackWriter) write(scope string, r log.Record) {
+	t := r.Timestamp()
+	if t.IsZero() {
+		t = r.ObservedTimestamp()
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+	sev := r.SeverityText()
+	if sev == "" {
+		sev = r.Severity().String()
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	b := t.UTC().AppendFormat(f.buf[:0], "2006-01-02T15:04:05.000Z07:00")
+	b = append(b, ' ')
+	b = append(b, sev...)
+	b = append(b, ' ')
+	b = append(b, scope...)
+	b = append(b, ':')
+	if body := r.Body(); body.Type() != attribute.EMPTY {
+		b = append(b, ' ')
+		b = append(b, body.Emit()...)
+	}
+	if name := r.EventName(); name != "" {
+		b = append(b, " event="...)
+		b = appendFallbackValue(b, name)
+	}
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		b = append(b, ' ')
+		b = append(b, kv.Key...)
+		b = append(b, '=')
+		b = appendFallbackValue(b, kv.Value.Emit())
+		return true
+	})
+	if err := r.Err(); err != nil {
+		b = append(b, " err="...)
+		b = appendFallbackValue(b, err.Error())
+	}
+	b = append(b, '\n')
+	f.buf = b
+
+	// The errors are ignored: there is nowhere to report them.
+	_, _ = f.w.Write(b)
+}
+
+// appendFallbackValue appends s to b, quoted if it is empty or contains
+// spaces or special characters.
+func appendFallbackValue(b []byte, s string) []byte {
+	if s == "" || needsQuoting(s) {
+		return strconv.AppendQuote(b, s)
+	}
+	return append(b, s...)
+}
+
+func needsQuoting(s string) bool {
+	for _, c := range s {
+		if c <= ' ' || c == '=' || c == '"' || c >= 0x7f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package global
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/noop"
+)
+
+func TestFallbackWriter(t *testing.T) {
+	t.Cleanup(func() { SetFallbackWriter(nil) })
+
+	p := &loggerProvider{}
+	l := p.Logger("scope")
+	assert.False(t, l.Enabled(t.Context(), log.EnabledParameters{}), "no fallback")
+
+	var buf bytes.Buffer
+	SetFallbackWriter(&buf)
+	assert.True(t, l.Enabled(t.Context(), log.EnabledParameters{}), "fallback")
+
+	var r log.Record
+	r.SetTimestamp(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC))
+	r.SetSeverity(log.SeverityError)
+	r.SetBody(attribute.StringValue("failed to load config"))
+	r.AddAttributes(
+		attribute.String("path", "/etc/app.yaml"),
+		attribute.String("reason", "not found"),
+		attribute.Int("attempt", 2),
+	)
+	r.SetErr(errors.New("open failed"))
+	l.Emit(t.Context(), r)
+
+	var r2 log.Record
+	r2.SetObservedTimestamp(time.Date(2026, 1, 2, 15, 4, 6, 0, time.UTC))
+	r2.SetSeverityText("custom")
+	r2.SetEventName("app.started")
+	l.Emit(t.Context(), r2)
+
+	want := `2026-01-02T15:04:05.000Z ERROR scope: failed to load config path=/etc/app.yaml reason="not found" attempt=2 err="open failed"
+2026-01-02T15:04:06.000Z custom scope: event=app.started
+`
+	assert.Equal(t, want, buf.String())
+
+	buf.Reset()
+	p.setDelegate(noop.NewLoggerProvider())
+	l.Emit(t.Context(), r)
+	assert.Empty(t, buf.String(), "delegate set")
+
+	SetFallbackWriter(nil)
+	l = (&loggerProvider{}).Logger("scope")
+	l.Emit(t.Context(), r)
+	assert.Empty(t, buf.String(), "fallback removed")
+	assert.False(t, l.Enabled(t.Context(), log.EnabledParameters{}))
+}
@@ -67,6 +67,15 @@ func (p *loggerProvider) Logger(name string, options ...log.LoggerOption) log.Lo
 	return l
 }
 
+// ForceFlush flushes the delegate LoggerProvider, if any, with
+// log.ForceFlush.
+func (p *loggerProvider) ForceFlush(ctx context.Context) error {
+	p.mu.Lock()
+	del := p.delegate
+	p.mu.Unlock()
+	return log.ForceFlush(ctx, del)
+}
+
 func (p *loggerProvider) setDelegate(provider log.LoggerProvider) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
@@ -93,15 +102,18 @@ var _ log.Logger = (*logger)(nil)
 func (l *logger) Emit(ctx context.Context, r log.Record) {
 	if del, ok := l.delegate.Load().(log.Logger); ok {
 		del.Emit(ctx, r)
+		return
+	}
+	if f := fallback.Load(); f != nil {
+		f.write(l.name, r)
 	}
 }
 
 func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool {
-	var enabled bool
 	if del, ok := l.delegate.Load().(log.Logger); ok {
-		enabled = del.Enabled(ctx, param)
+		return del.Enabled(ctx, param)
 	}
-	return enabled
+	return fallback.Load() != nil
 }
 
 func (l *logger) setDelegate(provider log.LoggerProvider) {
@@ -200,3 +200,24 @@ func TestLoggerIdentity(t *testing.T) {
 		}
 	}
 }
+
+type flushLoggerProvider struct {
+	testLoggerProvider
+
+	flushN int
+}
+
+func (p *flushLoggerProvider) ForceFlush(context.Context) error {
+	p.flushN++
+	return nil
+}
+
+func TestLoggerProviderForceFlush(t *testing.T) {
+	p := &loggerProvider{}
+	assert.NoError(t, log.ForceFlush(t.Context(), p), "no delegate")
+
+	delegate := &flushLoggerProvider{}
+	p.setDelegate(delegate)
+	assert.NoError(t, log.ForceFlush(t.Context(), p))
+	assert.Equal(t, 1, delegate.flushN, "delegate not flushed")
+}
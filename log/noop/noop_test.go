@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/conformancetest"
 )
 
 func TestImplementationNoPanics(t *testing.T) {
@@ -67,3 +68,12 @@ func TestNewTracerProvider(t *testing.T) {
 	logger := provider.Logger("")
 	assert.Equal(t, Logger{}, logger)
 }
+
+func TestConformance(t *testing.T) {
+	conformancetest.RunLoggerProviderTests(t, func() log.LoggerProvider {
+		return NewLoggerProvider()
+	})
+	conformancetest.RunLoggerTests(t, func() log.Logger {
+		return NewLoggerProvider().Logger("conformance")
+	})
+}
@@ -25,6 +25,24 @@ func Meter(name string, opts ...metric.MeterOption) metric.Meter {
 	return GetMeterProvider().Meter(name, opts...)
 }
 
+// MeterAuto returns a Meter from the global MeterProvider named after the
+// import path of the package calling MeterAuto. The version of the module
+// containing that package, as recorded in the build information of the
+// binary, is used as the instrumentation version unless opts provide one.
+//
+// This allows instrumentation libraries to consistently name their scope
+// without repeating their import path. For example, calling MeterAuto from
+// the package "example.com/foo" is equivalent to:
+//
+//	otel.Meter("example.com/foo", metric.WithInstrumentationVersion(version))
+func MeterAuto(opts ...metric.MeterOption) metric.Meter {
+	name, version := global.CallerScope(1)
+	if version != "" {
+		opts = append([]metric.MeterOption{metric.WithInstrumentationVersion(version)}, opts...)
+	}
+	return GetMeterProvider().Meter(name, opts...)
+}
+
 // GetMeterProvider returns the registered global meter provider.
 //
 // If no global GetMeterProvider has been registered, a No-op GetMeterProvider
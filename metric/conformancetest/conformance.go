@@ -0,0 +1,307 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package conformancetest provides tests validating that an implementation of
+// the OpenTelemetry metric API conforms to the semantics defined by the
+// OpenTelemetry specification, and that it is safe to use concurrently.
+//
+// The tests are meant to be run by the tests of the implementation, e.g.
+//
+//	func TestConformance(t *testing.T) {
+//		conformancetest.RunMeterProviderTests(t, func() metric.MeterProvider {
+//			return mysdk.NewMeterProvider()
+//		})
+//		conformancetest.RunMeterTests(t, func() metric.Meter {
+//			return mysdk.NewMeterProvider().Meter("conformance")
+//		})
+//	}
+//
+// Running the tests with the race detector enabled is recommended.
+package conformancetest // import "go.opentelemetry.io/otel/metric/conformancetest"
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// goroutines is the number of goroutines concurrently calling the API in
+// the concurrency tests.
+const goroutines = 20
+
+// RunMeterProviderTests runs the conformance tests of the MeterProvider API
+// against the MeterProviders returned by newMeterProvider. Each call of
+// newMeterProvider needs to return a new MeterProvider.
+func RunMeterProviderTests(t *testing.T, newMeterProvider func() metric.MeterProvider) {
+	t.Run("MeterProvider", func(t *testing.T) {
+		t.Run("allow creating an arbitrary number of MeterProvider instances", func(t *testing.T) {
+			t.Parallel()
+
+			for range 5 {
+				if newMeterProvider() == nil {
+					t.Fatal("nil MeterProvider")
+				}
+			}
+		})
+
+		t.Run("returns a Meter for any name and options", func(t *testing.T) {
+			t.Parallel()
+
+			mp := newMeterProvider()
+			for _, name := range []string{"", "scope", "go.opentelemetry.io/otel/metric/conformancetest"} {
+				m := mp.Meter(
+					name,
+					metric.WithInstrumentationVersion("v1.0.0"),
+					metric.WithSchemaURL("https://opentelemetry.io/schemas/1.0.0"),
+					metric.WithInstrumentationAttributes(attribute.String("key", "value")),
+				)
+				if m == nil {
+					t.Errorf("nil Meter for name %q", name)
+				}
+			}
+		})
+
+		t.Run("all methods are safe to be called concurrently", func(t *testing.T) {
+			t.Parallel()
+
+			// Run with multiple MeterProviders to ensure they encapsulate
+			// their own Meters.
+			var wg sync.WaitGroup
+			for range 2 {
+				mp := newMeterProvider()
+				for i := range goroutines {
+					wg.Go(func() {
+						_ = mp.Meter(fmt.Sprintf("meter %d", i%5), metric.WithInstrumentationVersion(fmt.Sprint(i)))
+					})
+				}
+			}
+			wg.Wait()
+		})
+	})
+}
+
+// RunMeterTests runs the conformance tests of the Meter API, and of the
+// instruments and registrations it returns, against the Meters returned by
+// newMeter. Each call of newMeter needs to return a new Meter.
+func RunMeterTests(t *testing.T, newMeter func() metric.Meter) {
+	t.Run("Meter", func(t *testing.T) {
+		t.Run("creates instruments of all kinds", func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := newInstruments(newMeter(), "instrument"); err != nil {
+				t.Error(err)
+			}
+		})
+
+		t.Run("creates instruments with the same identity", func(t *testing.T) {
+			t.Parallel()
+
+			m := newMeter()
+			for range 2 {
+				if _, err := newInstruments(m, "instrument"); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+
+		t.Run("records measurements", func(t *testing.T) {
+			t.Parallel()
+
+			insts, err := newInstruments(newMeter(), "instrument")
+			if err != nil {
+				t.Fatal(err)
+			}
+			insts.record(t.Context())
+		})
+
+		t.Run("registers and unregisters callbacks", func(t *testing.T) {
+			t.Parallel()
+
+			m := newMeter()
+			insts, err := newInstruments(m, "instrument")
+			if err != nil {
+				t.Fatal(err)
+			}
+			reg, err := insts.register(m)
+			if err != nil {
+				t.Fatalf("RegisterCallback: %v", err)
+			}
+			if reg == nil {
+				t.Fatal("nil Registration")
+			}
+			if err := reg.Unregister(); err != nil {
+				t.Errorf("Unregister: %v", err)
+			}
+		})
+
+		t.Run("all methods are safe to be called concurrently", func(t *testing.T) {
+			t.Parallel()
+
+			m := newMeter()
+			var wg sync.WaitGroup
+			for i := range goroutines {
+				wg.Go(func() {
+					insts, err := newInstruments(m, fmt.Sprintf("instrument.%d", i%5))
+					if err != nil {
+						t.Error(err)
+						return
+					}
+					insts.record(t.Context())
+					reg, err := insts.register(m)
+					if err != nil {
+						t.Errorf("RegisterCallback: %v", err)
+						return
+					}
+					if err := reg.Unregister(); err != nil {
+						t.Errorf("Unregister: %v", err)
+					}
+				})
+			}
+			wg.Wait()
+		})
+	})
+}
+
+// instruments are instruments of all kinds created by the same Meter.
+type instruments struct {
+	int64Counter       metric.Int64Counter
+	int64UpDownCounter metric.Int64UpDownCounter
+	int64Histogram     metric.Int64Histogram
+	int64Gauge         metric.Int64Gauge
+
+	float64Counter       metric.Float64Counter
+	float64UpDownCounter metric.Float64UpDownCounter
+	float64Histogram     metric.Float64Histogram
+	float64Gauge         metric.Float64Gauge
+
+	int64Observables   []metric.Int64Observable
+	float64Observables []metric.Float64Observable
+}
+
+// newInstruments returns instruments of all kinds created by m with names
+// prefixed by name.
+func newInstruments(m metric.Meter, name string) (*instruments, error) {
+	var (
+		insts instruments
+		errs  []error
+		err   error
+	)
+	desc := metric.WithDescription("conformance test instrument")
+	unit := metric.WithUnit("1")
+	check := func(kind string, inst any, e error) {
+		switch {
+		case e != nil:
+			errs = append(errs, fmt.Errorf("%s: %w", kind, e))
+		case inst == nil:
+			errs = append(errs, fmt.Errorf("%s: nil instrument", kind))
+		}
+	}
+
+	insts.int64Counter, err = m.Int64Counter(name+".int64_counter", desc, unit)
+	check("Int64Counter", insts.int64Counter, err)
+	insts.int64UpDownCounter, err = m.Int64UpDownCounter(name+".int64_up_down_counter", desc, unit)
+	check("Int64UpDownCounter", insts.int64UpDownCounter, err)
+	insts.int64Histogram, err = m.Int64Histogram(
+		name+".int64_histogram", desc, unit, metric.WithExplicitBucketBoundaries(0, 10, 100),
+	)
+	check("Int64Histogram", insts.int64Histogram, err)
+	insts.int64Gauge, err = m.Int64Gauge(name+".int64_gauge", desc, unit)
+	check("Int64Gauge", insts.int64Gauge, err)
+
+	insts.float64Counter, err = m.Float64Counter(name+".float64_counter", desc, unit)
+	check("Float64Counter", insts.float64Counter, err)
+	insts.float64UpDownCounter, err = m.Float64UpDownCounter(name+".float64_up_down_counter", desc, unit)
+	check("Float64UpDownCounter", insts.float64UpDownCounter, err)
+	insts.float64Histogram, err = m.Float64Histogram(
+		name+".float64_histogram", desc, unit, metric.WithExplicitBucketBoundaries(0, 10, 100),
+	)
+	check("Float64Histogram", insts.float64Histogram, err)
+	insts.float64Gauge, err = m.Float64Gauge(name+".float64_gauge", desc, unit)
+	check("Float64Gauge", insts.float64Gauge, err)
+
+	int64Callback := metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(1, metric.WithAttributes(attribute.String("key", "value")))
+		return nil
+	})
+	int64Counter, err := m.Int64ObservableCounter(name+".int64_observable_counter", desc, unit, int64Callback)
+	check("Int64ObservableCounter", int64Counter, err)
+	int64UpDownCounter, err := m.Int64ObservableUpDownCounter(
+		name+".int64_observable_up_down_counter", desc, unit, int64Callback,
+	)
+	check("Int64ObservableUpDownCounter", int64UpDownCounter, err)
+	int64Gauge, err := m.Int64ObservableGauge(name+".int64_observable_gauge", desc, unit, int64Callback)
+	check("Int64ObservableGauge", int64Gauge, err)
+	insts.int64Observables = []metric.Int64Observable{int64Counter, int64UpDownCounter, int64Gauge}
+
+	float64Callback := metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1, metric.WithAttributes(attribute.String("key", "value")))
+		return nil
+	})
+	float64Counter, err := m.Float64ObservableCounter(name+".float64_observable_counter", desc, unit, float64Callback)
+	check("Float64ObservableCounter", float64Counter, err)
+	float64UpDownCounter, err := m.Float64ObservableUpDownCounter(
+		name+".float64_observable_up_down_counter", desc, unit, float64Callback,
+	)
+	check("Float64ObservableUpDownCounter", float64UpDownCounter, err)
+	float64Gauge, err := m.Float64ObservableGauge(name+".float64_observable_gauge", desc, unit, float64Callback)
+	check("Float64ObservableGauge", float64Gauge, err)
+	insts.float64Observables = []metric.Float64Observable{float64Counter, float64UpDownCounter, float64Gauge}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return &insts, nil
+}
+
+// record records measurements with all the synchronous instruments of i.
+func (i *instruments) record(ctx context.Context) {
+	attrs := metric.WithAttributes(attribute.String("key", "value"), attribute.Int("n", 1))
+	set := metric.WithAttributeSet(attribute.NewSet(attribute.Bool("set", true)))
+
+	_ = i.int64Counter.Enabled(ctx)
+	i.int64Counter.Add(ctx, 1)
+	i.int64Counter.Add(ctx, 2, attrs, set)
+	_ = i.int64UpDownCounter.Enabled(ctx)
+	i.int64UpDownCounter.Add(ctx, -1, attrs)
+	_ = i.int64Histogram.Enabled(ctx)
+	i.int64Histogram.Record(ctx, 5, attrs)
+	_ = i.int64Gauge.Enabled(ctx)
+	i.int64Gauge.Record(ctx, 7, set)
+
+	_ = i.float64Counter.Enabled(ctx)
+	i.float64Counter.Add(ctx, 1.5)
+	i.float64Counter.Add(ctx, 2.5, attrs, set)
+	_ = i.float64UpDownCounter.Enabled(ctx)
+	i.float64UpDownCounter.Add(ctx, -1.5, attrs)
+	_ = i.float64Histogram.Enabled(ctx)
+	i.float64Histogram.Record(ctx, 5.5, attrs)
+	_ = i.float64Gauge.Enabled(ctx)
+	i.float64Gauge.Record(ctx, 7.5, set)
+}
+
+// register registers a callback with m observing all the asynchronous
+// instruments of i.
+func (i *instruments) register(m metric.Meter) (metric.Registration, error) {
+	observables := make([]metric.Observable, 0, len(i.int64Observables)+len(i.float64Observables))
+	for _, o := range i.int64Observables {
+		observables = append(observables, o)
+	}
+	for _, o := range i.float64Observables {
+		observables = append(observables, o)
+	}
+	return m.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		attrs := metric.WithAttributes(attribute.String("key", "value"))
+		for _, inst := range i.int64Observables {
+			o.ObserveInt64(inst, 1, attrs)
+		}
+		for _, inst := range i.float64Observables {
+			o.ObserveFloat64(inst, 1, attrs)
+		}
+		return nil
+	}, observables...)
+}
@@ -196,6 +196,9 @@ func (o unitOpt) applyInt64ObservableGauge(c Int64ObservableGaugeConfig) Int64Ob
 // WithUnit sets the instrument unit.
 //
 // The unit u should be defined using the appropriate [UCUM](https://ucum.org) case-sensitive code.
+// Implementations may validate or normalize it, e.g. the WithUnitValidation
+// option of go.opentelemetry.io/otel/sdk/metric reports or replaces misspelled
+// units such as "milliseconds".
 func WithUnit(u string) InstrumentOption { return unitOpt(u) }
 
 // WithExplicitBucketBoundaries sets the instrument explicit bucket boundaries.
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/conformancetest"
 )
 
 func TestImplementationNoPanics(t *testing.T) {
@@ -133,3 +134,12 @@ func TestNewMeterProvider(t *testing.T) {
 	meter := mp.Meter("")
 	assert.Equal(t, Meter{}, meter)
 }
+
+func TestConformance(t *testing.T) {
+	conformancetest.RunMeterProviderTests(t, func() metric.MeterProvider {
+		return NewMeterProvider()
+	})
+	conformancetest.RunMeterTests(t, func() metric.Meter {
+		return NewMeterProvider().Meter("conformance")
+	})
+}
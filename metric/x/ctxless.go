@@ -0,0 +1,68 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Int64HistogramCtxless is implemented by [metric.Int64Histogram]
+// implementations able to record measurements without a context.
+//
+// Measurements recorded without a context are not correlated with the
+// active span or baggage, e.g. they are never recorded as exemplars. In
+// exchange, the implementation can skip the context handling of a
+// measurement. This is intended for hot loops where that correlation is
+// unnecessary.
+//
+// The instruments of [go.opentelemetry.io/otel/sdk/metric] implement this
+// interface.
+type Int64HistogramCtxless interface {
+	RecordCtxless(incr int64, options ...metric.RecordOption)
+}
+
+// Float64HistogramCtxless is implemented by [metric.Float64Histogram]
+// implementations able to record measurements without a context.
+//
+// Measurements recorded without a context are not correlated with the
+// active span or baggage, e.g. they are never recorded as exemplars. In
+// exchange, the implementation can skip the context handling of a
+// measurement. This is intended for hot loops where that correlation is
+// unnecessary.
+//
+// The instruments of [go.opentelemetry.io/otel/sdk/metric] implement this
+// interface.
+type Float64HistogramCtxless interface {
+	RecordCtxless(incr float64, options ...metric.RecordOption)
+}
+
+// RecordInt64Ctxless records incr with h without a context if h implements
+// [Int64HistogramCtxless]. Otherwise, incr is recorded with
+// [context.Background].
+//
+// For hot loops, prefer checking if h implements [Int64HistogramCtxless] once
+// instead of calling this function for every measurement.
+func RecordInt64Ctxless(h metric.Int64Histogram, incr int64, options ...metric.RecordOption) {
+	if r, ok := h.(Int64HistogramCtxless); ok {
+		r.RecordCtxless(incr, options...)
+		return
+	}
+	h.Record(context.Background(), incr, options...)
+}
+
+// RecordFloat64Ctxless records incr with h without a context if h implements
+// [Float64HistogramCtxless]. Otherwise, incr is recorded with
+// [context.Background].
+//
+// For hot loops, prefer checking if h implements [Float64HistogramCtxless]
+// once instead of calling this function for every measurement.
+func RecordFloat64Ctxless(h metric.Float64Histogram, incr float64, options ...metric.RecordOption) {
+	if r, ok := h.(Float64HistogramCtxless); ok {
+		r.RecordCtxless(incr, options...)
+		return
+	}
+	h.Record(context.Background(), incr, options...)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type int64Hist struct {
+	noop.Int64Histogram
+
+	ctx     context.Context
+	ctxless bool
+	val     int64
+}
+
+func (h *int64Hist) Record(ctx context.Context, val int64, _ ...metric.RecordOption) {
+	h.ctx, h.val = ctx, val
+}
+
+type int64HistCtxless struct{ int64Hist }
+
+func (h *int64HistCtxless) RecordCtxless(val int64, _ ...metric.RecordOption) {
+	h.ctxless, h.val = true, val
+}
+
+type float64Hist struct {
+	noop.Float64Histogram
+
+	ctx     context.Context
+	ctxless bool
+	val     float64
+}
+
+func (h *float64Hist) Record(ctx context.Context, val float64, _ ...metric.RecordOption) {
+	h.ctx, h.val = ctx, val
+}
+
+type float64HistCtxless struct{ float64Hist }
+
+func (h *float64HistCtxless) RecordCtxless(val float64, _ ...metric.RecordOption) {
+	h.ctxless, h.val = true, val
+}
+
+func TestRecordInt64Ctxless(t *testing.T) {
+	h := &int64Hist{}
+	RecordInt64Ctxless(h, 1)
+	if h.ctx != context.Background() || h.val != 1 {
+		t.Errorf("expected fallback to Record with background context, got %v, %d", h.ctx, h.val)
+	}
+
+	hc := &int64HistCtxless{}
+	RecordInt64Ctxless(hc, 2)
+	if !hc.ctxless || hc.ctx != nil || hc.val != 2 {
+		t.Errorf("expected RecordCtxless, got ctxless: %t, value: %d", hc.ctxless, hc.val)
+	}
+}
+
+func TestRecordFloat64Ctxless(t *testing.T) {
+	h := &float64Hist{}
+	RecordFloat64Ctxless(h, 1)
+	if h.ctx != context.Background() || h.val != 1 {
+		t.Errorf("expected fallback to Record with background context, got %v, %g", h.ctx, h.val)
+	}
+
+	hc := &float64HistCtxless{}
+	RecordFloat64Ctxless(hc, 2)
+	if !hc.ctxless || hc.ctx != nil || hc.val != 2 {
+		t.Errorf("expected RecordCtxless, got ctxless: %t, value: %g", hc.ctxless, hc.val)
+	}
+}
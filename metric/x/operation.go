@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// OperationRecorder records the count, the duration, and the error status of
+// the operations of a kind, e.g. the calls to a dependency, with consistent
+// attributes. The failed operations are recorded with the error.type
+// attribute of the semantic conventions, the other attributes are the ones
+// passed by the caller.
+//
+// The operations are counted by the Int64Counter named after the operation
+// with the ".count" suffix, with the "{operation}" unit. Their durations are
+// recorded, in seconds, by the Float64Histogram named after the operation
+// with the ".duration" suffix.
+//
+// An OperationRecorder is safe for concurrent use.
+type OperationRecorder struct {
+	count    metric.Int64Counter
+	duration metric.Float64Histogram
+}
+
+// OperationOption configures an OperationRecorder.
+type OperationOption interface {
+	applyOperation(operationConfig) operationConfig
+}
+
+type operationConfig struct {
+	description string
+	boundaries  []float64
+}
+
+type operationOptionFunc func(operationConfig) operationConfig
+
+func (fn operationOptionFunc) applyOperation(c operationConfig) operationConfig {
+	return fn(c)
+}
+
+// WithOperationDescription sets the description of the operations, used to
+// describe the instruments of an OperationRecorder.
+func WithOperationDescription(desc string) OperationOption {
+	return operationOptionFunc(func(c operationConfig) operationConfig {
+		c.description = desc
+		return c
+	})
+}
+
+// WithOperationBucketBoundaries sets the bucket boundaries, in seconds,
+// advised for the histogram of the durations of the operations.
+func WithOperationBucketBoundaries(bounds ...float64) OperationOption {
+	return operationOptionFunc(func(c operationConfig) operationConfig {
+		c.boundaries = slices.Clone(bounds)
+		return c
+	})
+}
+
+// NewOperationRecorder returns a new OperationRecorder recording the
+// operations named name, e.g. "db.query", with instruments created by meter.
+//
+// If the instruments cannot be created, an error is returned along with an
+// OperationRecorder using the instruments returned by meter.
+func NewOperationRecorder(meter metric.Meter, name string, opts ...OperationOption) (*OperationRecorder, error) {
+	var c operationConfig
+	for _, o := range opts {
+		c = o.applyOperation(c)
+	}
+
+	countOpts := []metric.Int64CounterOption{metric.WithUnit("{operation}")}
+	durationOpts := []metric.Float64HistogramOption{metric.WithUnit("s")}
+	if c.description != "" {
+		countOpts = append(countOpts, metric.WithDescription(c.description))
+		durationOpts = append(durationOpts, metric.WithDescription(c.description))
+	}
+	if c.boundaries != nil {
+		durationOpts = append(durationOpts, metric.WithExplicitBucketBoundaries(c.boundaries...))
+	}
+
+	var err error
+	count, e := meter.Int64Counter(name+".count", countOpts...)
+	if e != nil {
+		err = errors.Join(err, fmt.Errorf("failed to create operation count metric: %w", e))
+	}
+	duration, e := meter.Float64Histogram(name+".duration", durationOpts...)
+	if e != nil {
+		err = errors.Join(err, fmt.Errorf("failed to create operation duration metric: %w", e))
+	}
+	return &OperationRecorder{count: count, duration: duration}, err
+}
+
+// Record records an operation that took d and failed with err, or succeeded
+// if err is nil, with attrs.
+func (r *OperationRecorder) Record(ctx context.Context, d time.Duration, err error, attrs ...attribute.KeyValue) {
+	if err != nil {
+		attrs = append(slices.Clip(attrs), semconv.ErrorType(err))
+	}
+	opt := metric.WithAttributeSet(attribute.NewSet(attrs...))
+	if r.count != nil {
+		r.count.Add(ctx, 1, opt)
+	}
+	if r.duration != nil {
+		r.duration.Record(ctx, d.Seconds(), opt)
+	}
+}
+
+// Start returns an Operation started now, recorded with ctx and attrs when it
+// ends.
+func (r *OperationRecorder) Start(ctx context.Context, attrs ...attribute.KeyValue) Operation {
+	return Operation{recorder: r, ctx: ctx, start: time.Now(), attrs: attrs}
+}
+
+// Operation is an operation started by an OperationRecorder.
+//
+//	op := recorder.Start(ctx, attribute.String("db.operation.name", "SELECT"))
+//	rows, err := db.QueryContext(ctx, query)
+//	op.End(err)
+type Operation struct {
+	recorder *OperationRecorder
+	ctx      context.Context
+	start    time.Time
+	attrs    []attribute.KeyValue
+}
+
+// End records the operation as failed with err, or succeeded if err is nil,
+// with the attributes it was started with followed by attrs. The duration of
+// the operation is the time elapsed since it was started.
+func (o Operation) End(err error, attrs ...attribute.KeyValue) {
+	if o.recorder == nil {
+		return
+	}
+	d := time.Since(o.start)
+	if len(attrs) > 0 {
+		attrs = append(slices.Clip(o.attrs), attrs...)
+	} else {
+		attrs = o.attrs
+	}
+	o.recorder.Record(o.ctx, d, err, attrs...)
+}
@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+)
+
+type measurement struct {
+	value float64
+	attrs attribute.Set
+}
+
+type recordingCounter struct {
+	noop.Int64Counter
+	got []measurement
+}
+
+func (c *recordingCounter) Add(_ context.Context, incr int64, opts ...metric.AddOption) {
+	cfg := metric.NewAddConfig(opts)
+	c.got = append(c.got, measurement{value: float64(incr), attrs: cfg.Attributes()})
+}
+
+type recordingHistogram struct {
+	noop.Float64Histogram
+	got []measurement
+}
+
+func (h *recordingHistogram) Record(_ context.Context, v float64, opts ...metric.RecordOption) {
+	cfg := metric.NewRecordConfig(opts)
+	h.got = append(h.got, measurement{value: v, attrs: cfg.Attributes()})
+}
+
+type operationMeter struct {
+	noop.Meter
+
+	names     []string
+	count     *recordingCounter
+	duration  *recordingHistogram
+	histogram metric.Float64HistogramConfig
+	err       error
+}
+
+func (m *operationMeter) Int64Counter(name string, _ ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	m.names = append(m.names, name)
+	return m.count, m.err
+}
+
+func (m *operationMeter) Float64Histogram(
+	name string,
+	opts ...metric.Float64HistogramOption,
+) (metric.Float64Histogram, error) {
+	m.names = append(m.names, name)
+	m.histogram = metric.NewFloat64HistogramConfig(opts...)
+	return m.duration, nil
+}
+
+func newOperationMeter() *operationMeter {
+	return &operationMeter{count: &recordingCounter{}, duration: &recordingHistogram{}}
+}
+
+func TestOperationRecorder(t *testing.T) {
+	m := newOperationMeter()
+	r, err := NewOperationRecorder(
+		m,
+		"db.query",
+		WithOperationDescription("Database queries."),
+		WithOperationBucketBoundaries(0.1, 1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"db.query.count", "db.query.duration"}; len(m.names) != 2 || m.names[0] != want[0] ||
+		m.names[1] != want[1] {
+		t.Errorf("instrument names: got %v, want %v", m.names, want)
+	}
+	if got := m.histogram.Unit(); got != "s" {
+		t.Errorf("duration unit: got %q, want %q", got, "s")
+	}
+	if got := m.histogram.Description(); got != "Database queries." {
+		t.Errorf("duration description: got %q", got)
+	}
+	if got := m.histogram.ExplicitBucketBoundaries(); len(got) != 2 {
+		t.Errorf("duration boundaries: got %v", got)
+	}
+
+	table := attribute.String("db.collection.name", "users")
+	r.Record(t.Context(), 2*time.Second, nil, table)
+	r.Record(t.Context(), time.Second, errors.New("failed"), table)
+
+	op := r.Start(t.Context(), table)
+	op.End(context.DeadlineExceeded, attribute.Bool("retried", true))
+	Operation{}.End(nil) // No-op.
+
+	if len(m.count.got) != 3 || len(m.duration.got) != 3 {
+		t.Fatalf("measurements: got %d counts and %d durations, want 3", len(m.count.got), len(m.duration.got))
+	}
+	want := []attribute.Set{
+		attribute.NewSet(table),
+		attribute.NewSet(table, attribute.String("error.type", "*errors.errorString")),
+		attribute.NewSet(
+			table,
+			attribute.Bool("retried", true),
+			attribute.String("error.type", "context.deadlineExceededError"),
+		),
+	}
+	for i, w := range want {
+		if got := m.count.got[i]; got.value != 1 || !got.attrs.Equals(&w) {
+			t.Errorf("count %d: got %v %v, want 1 %v", i, got.value, got.attrs.Encoded(attribute.DefaultEncoder()),
+				w.Encoded(attribute.DefaultEncoder()))
+		}
+		if got := m.duration.got[i]; !got.attrs.Equals(&w) {
+			t.Errorf("duration %d: got %v, want %v", i, got.attrs.Encoded(attribute.DefaultEncoder()),
+				w.Encoded(attribute.DefaultEncoder()))
+		}
+	}
+	if got := m.duration.got[0].value; got != 2 {
+		t.Errorf("duration: got %v, want 2", got)
+	}
+	if got := m.duration.got[2].value; got < 0 || got > 1 {
+		t.Errorf("started operation duration: got %v", got)
+	}
+}
+
+func TestOperationRecorderError(t *testing.T) {
+	m := newOperationMeter()
+	m.err = errors.New("invalid instrument")
+	r, err := NewOperationRecorder(m, "op")
+	if !errors.Is(err, m.err) {
+		t.Errorf("error: got %v, want %v", err, m.err)
+	}
+	r.Record(t.Context(), time.Second, nil)
+	if len(m.count.got) != 1 {
+		t.Errorf("recorder not usable: got %d counts", len(m.count.got))
+	}
+}
@@ -5,6 +5,9 @@
 package x
 
 import (
+	"slices"
+	"time"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
@@ -28,6 +31,140 @@ func WithDefaultAttributes(keys ...attribute.Key) metric.InstrumentOption {
 	return defaultAttributesOption{keys: keys}
 }
 
+type attributeSetsOption struct {
+	metric.InstrumentOption
+	sets []attribute.Set
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (attributeSetsOption) Experimental() {}
+
+// AttributeSets returns the attribute sets of the option.
+func (o attributeSetsOption) AttributeSets() []attribute.Set {
+	return o.sets
+}
+
+// WithAttributeSets returns a metric.InstrumentOption that specifies the
+// attribute sets the instrument is expected to be measured with, e.g. the
+// sets of the status codes of a counter of requests. The implementation can
+// prepare the aggregation of these sets when the instrument is created so
+// their measurements do not allocate.
+// Users of [go.opentelemetry.io/otel/sdk/metric] get the sets of the
+// synchronous Counters and UpDownCounters with a sum aggregation
+// pre-registered: they are reported with a zero value when they are not
+// measured, and count towards the cardinality limit.
+//
+// If the option is passed multiple times, the attribute sets are merged.
+func WithAttributeSets(sets ...attribute.Set) metric.InstrumentOption {
+	return attributeSetsOption{sets: slices.Clone(sets)}
+}
+
+type stalenessOption struct {
+	metric.InstrumentOption
+	d time.Duration
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (stalenessOption) Experimental() {}
+
+// Staleness returns the staleness duration of the option.
+func (o stalenessOption) Staleness() time.Duration {
+	return o.d
+}
+
+// WithStaleness returns a metric.InstrumentOption that specifies the duration
+// after which an attribute set that has not been measured by a synchronous
+// Gauge is stale. The implementation should stop reporting stale attribute
+// sets and forget them, so gauges recorded for short-lived attribute sets
+// (e.g. per-connection gauges) do not accumulate. A stale attribute set is
+// reported again once it is measured again.
+// Users of [go.opentelemetry.io/otel/sdk/metric] can override it with the
+// Staleness of the Stream of a View.
+func WithStaleness(d time.Duration) metric.InstrumentOption {
+	return stalenessOption{d: d}
+}
+
+type precomputedSumOption struct {
+	metric.InstrumentOption
+	precomputed bool
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (precomputedSumOption) Experimental() {}
+
+// PrecomputedSum returns if the observations are precomputed sums.
+func (o precomputedSumOption) PrecomputedSum() bool {
+	return o.precomputed
+}
+
+// WithPrecomputedSum returns a metric.InstrumentOption that declares whether
+// the callbacks of an asynchronous Counter or UpDownCounter observe the
+// precomputed sum, the default, or the change of the sum since the previous
+// observation of the same attributes (a delta). Bridges from systems
+// reporting deltas otherwise need to accumulate them, or the sums are
+// counted incorrectly.
+// Users of [go.opentelemetry.io/otel/sdk/metric] get the observations
+// converted to the temporality of each Reader: deltas are accumulated for
+// cumulative Readers, precomputed sums are differenced for delta Readers.
+func WithPrecomputedSum(precomputed bool) metric.InstrumentOption {
+	return precomputedSumOption{precomputed: precomputed}
+}
+
+type meterAttributesOption struct {
+	metric.MeterOption
+	set attribute.Set
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (meterAttributesOption) Experimental() {}
+
+// MeterAttributes returns the attributes of the option.
+func (o meterAttributesOption) MeterAttributes() attribute.Set {
+	return o.set
+}
+
+// WithMeterAttributes returns a metric.MeterOption that specifies attributes
+// the implementation should record with all the measurements of the
+// instruments created by the Meter, e.g. the name of the component using the
+// Meter. Unlike the attributes of metric.WithInstrumentationAttributes, they
+// are attributes of the measurements, not of the instrumentation scope.
+// Users of [go.opentelemetry.io/otel/sdk/metric] get them merged with the
+// attributes of each measurement, which take precedence for duplicate keys,
+// without merging them in their own code.
+//
+// If the option is passed multiple times, the attributes are merged in the
+// order they are passed. Attributes with duplicate keys use the last value
+// passed.
+func WithMeterAttributes(attrs ...attribute.KeyValue) metric.MeterOption {
+	return meterAttributesOption{set: attribute.NewSet(slices.Clone(attrs)...)}
+}
+
+type instrumentAttributesOption struct {
+	metric.InstrumentOption
+	set attribute.Set
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (instrumentAttributesOption) Experimental() {}
+
+// InstrumentAttributes returns the attributes of the option.
+func (o instrumentAttributesOption) InstrumentAttributes() attribute.Set {
+	return o.set
+}
+
+// WithInstrumentAttributes returns a metric.InstrumentOption that specifies
+// attributes the implementation should record with all the measurements of
+// the instrument. They are merged with the attributes passed to
+// [WithMeterAttributes] for the Meter creating the instrument and take
+// precedence over them for duplicate keys.
+//
+// If the option is passed multiple times, the attributes are merged in the
+// order they are passed. Attributes with duplicate keys use the last value
+// passed.
+func WithInstrumentAttributes(attrs ...attribute.KeyValue) metric.InstrumentOption {
+	return instrumentAttributesOption{set: attribute.NewSet(slices.Clone(attrs)...)}
+}
+
 type unsafeAttributesOption struct {
 	metric.MeasurementOption
 	kvs []attribute.KeyValue
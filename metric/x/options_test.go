@@ -5,8 +5,10 @@ package x
 
 import (
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 func TestWithUnsafeAttributes(t *testing.T) {
@@ -50,3 +52,95 @@ func TestUnsafeAttributesOption_Set(t *testing.T) {
 		t.Errorf("expected attribute C='D', got %v", attrs[0])
 	}
 }
+
+func TestWithStaleness(t *testing.T) {
+	opt := WithStaleness(time.Minute)
+
+	s, ok := opt.(interface{ Staleness() time.Duration })
+	if !ok {
+		t.Fatalf("expected Staleness method")
+	}
+	if got := s.Staleness(); got != time.Minute {
+		t.Errorf("expected staleness %v, got %v", time.Minute, got)
+	}
+
+	// The option must be ignored, not panic, when applied by the API.
+	_ = metric.NewFloat64GaugeConfig(opt)
+	_ = metric.NewInt64GaugeConfig(opt)
+}
+
+func TestWithAttributeSets(t *testing.T) {
+	sets := []attribute.Set{
+		attribute.NewSet(attribute.Int("code", 200)),
+		attribute.NewSet(attribute.Int("code", 500)),
+	}
+	opt := WithAttributeSets(sets...)
+	sets[0] = *attribute.EmptySet()
+
+	s, ok := opt.(interface{ AttributeSets() []attribute.Set })
+	if !ok {
+		t.Fatalf("expected AttributeSets method")
+	}
+	got := s.AttributeSets()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 attribute sets, got %d", len(got))
+	}
+	for i, want := range []int64{200, 500} {
+		if v, _ := got[i].Value("code"); v.AsInt64() != want {
+			t.Errorf("expected code %d, got %v", want, got[i])
+		}
+	}
+
+	// The option must be ignored, not panic, when applied by the API.
+	_ = metric.NewInt64CounterConfig(opt)
+	_ = metric.NewFloat64UpDownCounterConfig(opt)
+}
+
+func TestWithPrecomputedSum(t *testing.T) {
+	for _, precomputed := range []bool{true, false} {
+		opt := WithPrecomputedSum(precomputed)
+
+		p, ok := opt.(interface{ PrecomputedSum() bool })
+		if !ok {
+			t.Fatalf("expected PrecomputedSum method")
+		}
+		if got := p.PrecomputedSum(); got != precomputed {
+			t.Errorf("expected precomputed sum %v, got %v", precomputed, got)
+		}
+
+		// The option must be ignored, not panic, when applied by the API.
+		_ = metric.NewInt64ObservableCounterConfig(opt)
+		_ = metric.NewFloat64ObservableUpDownCounterConfig(opt)
+	}
+}
+
+func TestWithMeterAttributes(t *testing.T) {
+	opt := WithMeterAttributes(attribute.String("k", "v"))
+
+	a, ok := opt.(interface{ MeterAttributes() attribute.Set })
+	if !ok {
+		t.Fatalf("expected MeterAttributes method")
+	}
+	if want, got := attribute.NewSet(attribute.String("k", "v")), a.MeterAttributes(); !got.Equals(&want) {
+		t.Errorf("expected attributes %v, got %v", want, got)
+	}
+
+	// The option must be ignored, not panic, when applied by the API.
+	_ = metric.NewMeterConfig(opt)
+}
+
+func TestWithInstrumentAttributes(t *testing.T) {
+	opt := WithInstrumentAttributes(attribute.String("k", "v"))
+
+	a, ok := opt.(interface{ InstrumentAttributes() attribute.Set })
+	if !ok {
+		t.Fatalf("expected InstrumentAttributes method")
+	}
+	if want, got := attribute.NewSet(attribute.String("k", "v")), a.InstrumentAttributes(); !got.Equals(&want) {
+		t.Errorf("expected attributes %v, got %v", want, got)
+	}
+
+	// The option must be ignored, not panic, when applied by the API.
+	_ = metric.NewInt64CounterConfig(opt)
+	_ = metric.NewFloat64ObservableGaugeConfig(opt)
+}
@@ -30,3 +30,21 @@ func TestMultipleGlobalMeterProvider(t *testing.T) {
 	got := GetMeterProvider()
 	assert.Equal(t, p2, got)
 }
+
+type nameRecordingMeterProvider struct {
+	embedded.MeterProvider
+
+	name string
+}
+
+func (p *nameRecordingMeterProvider) Meter(name string, _ ...metric.MeterOption) metric.Meter {
+	p.name = name
+	return noop.NewMeterProvider().Meter(name)
+}
+
+func TestMeterAuto(t *testing.T) {
+	p := &nameRecordingMeterProvider{}
+	SetMeterProvider(p)
+	_ = MeterAuto()
+	assert.Equal(t, "go.opentelemetry.io/otel", p.name)
+}
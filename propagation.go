@@ -18,3 +18,19 @@ func GetTextMapPropagator() propagation.TextMapPropagator {
 func SetTextMapPropagator(propagator propagation.TextMapPropagator) {
 	global.SetTextMapPropagator(propagator)
 }
+
+// GetTextMapPropagatorDelegate returns a TextMapPropagator that delegates all
+// calls to the global TextMapPropagator set when they are made.
+//
+// Unlike the TextMapPropagator returned by [GetTextMapPropagator], it keeps
+// following the global TextMapPropagator after it is replaced with
+// [SetTextMapPropagator]. Use it when the propagator is captured before the
+// global TextMapPropagator is configured, e.g. by a framework at
+// initialization, or to build a composite propagator including the global one.
+//
+// Do not set the returned TextMapPropagator, or a composite propagator
+// including it, as the global TextMapPropagator. Doing so results in an
+// infinite recursion.
+func GetTextMapPropagatorDelegate() propagation.TextMapPropagator {
+	return global.CurrentTextMapPropagator()
+}
@@ -0,0 +1,222 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// B3 single header.
+	b3ContextHeader = "b3"
+
+	// B3 multiple headers.
+	b3TraceIDHeader      = "x-b3-traceid"
+	b3SpanIDHeader       = "x-b3-spanid"
+	b3ParentSpanIDHeader = "x-b3-parentspanid"
+	b3SampledHeader      = "x-b3-sampled"
+	b3DebugFlagHeader    = "x-b3-flags"
+
+	b3Sampled    = "1"
+	b3NotSampled = "0"
+	b3Debug      = "d"
+
+	// b3TraceIDPadding pads 64-bit trace IDs to 128 bits.
+	b3TraceIDPadding = "0000000000000000"
+)
+
+// B3Encoding is a bitmask of the B3 header encodings used by a B3 propagator
+// to inject a span context.
+type B3Encoding uint8
+
+const (
+	// B3MultipleHeader is the B3 encoding that uses a header for each value
+	// of the span context (e.g. X-B3-TraceId, X-B3-SpanId, and X-B3-Sampled).
+	B3MultipleHeader B3Encoding = 1 << iota
+	// B3SingleHeader is the B3 encoding that uses the single b3 header.
+	B3SingleHeader
+)
+
+// supports reports whether e contains the encoding enc.
+func (e B3Encoding) supports(enc B3Encoding) bool {
+	return e&enc != 0
+}
+
+// B3 is a propagator that supports the B3 format
+// (https://github.com/openzipkin/b3-propagation).
+//
+// Both the single and multiple header encodings are extracted. If both are
+// present, the single header takes precedence. A B3 debug flag is extracted
+// as a sampled span context, and a deferred sampling decision as a span
+// context that is not sampled.
+type B3 struct {
+	// InjectEncoding are the B3 encodings used to inject a span context. If
+	// zero, the multiple header encoding is used.
+	InjectEncoding B3Encoding
+}
+
+var _ TextMapPropagator = B3{}
+
+// encoding returns the encodings b injects with.
+func (b B3) encoding() B3Encoding {
+	if b.InjectEncoding == 0 {
+		return B3MultipleHeader
+	}
+	return b.InjectEncoding
+}
+
+// Inject injects the span context from ctx into carrier.
+func (b B3) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	sampled := b3NotSampled
+	if sc.IsSampled() {
+		sampled = b3Sampled
+	}
+
+	enc := b.encoding()
+	if enc.supports(B3SingleHeader) {
+		carrier.Set(b3ContextHeader, sc.TraceID().String()+"-"+sc.SpanID().String()+"-"+sampled)
+	}
+	if enc.supports(B3MultipleHeader) {
+		carrier.Set(b3TraceIDHeader, sc.TraceID().String())
+		carrier.Set(b3SpanIDHeader, sc.SpanID().String())
+		carrier.Set(b3SampledHeader, sampled)
+	}
+}
+
+// Extract reads the B3 span context from carrier into a returned Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted span
+// context as the remote SpanContext. If the extracted span context is
+// invalid, the passed ctx will be returned directly instead.
+func (B3) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	var sc trace.SpanContext
+	if h := carrier.Get(b3ContextHeader); h != "" {
+		sc = extractB3Single(h)
+	} else {
+		sc = extractB3Multiple(
+			carrier.Get(b3TraceIDHeader),
+			carrier.Get(b3SpanIDHeader),
+			carrier.Get(b3ParentSpanIDHeader),
+			carrier.Get(b3SampledHeader),
+			carrier.Get(b3DebugFlagHeader),
+		)
+	}
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (b B3) Fields() []string {
+	var fields []string
+	enc := b.encoding()
+	if enc.supports(B3SingleHeader) {
+		fields = append(fields, b3ContextHeader)
+	}
+	if enc.supports(B3MultipleHeader) {
+		fields = append(fields, b3TraceIDHeader, b3SpanIDHeader, b3SampledHeader)
+	}
+	return fields
+}
+
+// extractB3Multiple returns the span context of the B3 multiple headers. An
+// invalid span context is returned if the headers are not valid.
+func extractB3Multiple(traceID, spanID, parentSpanID, sampled, flags string) trace.SpanContext {
+	var scc trace.SpanContextConfig
+	var ok bool
+	if scc.TraceID, ok = b3TraceID(traceID); !ok {
+		return trace.SpanContext{}
+	}
+	if scc.SpanID, ok = b3SpanID(spanID); !ok {
+		return trace.SpanContext{}
+	}
+	if parentSpanID != "" {
+		if _, ok = b3SpanID(parentSpanID); !ok {
+			return trace.SpanContext{}
+		}
+	}
+
+	switch strings.ToLower(sampled) {
+	case "", b3NotSampled, "false":
+	case b3Sampled, "true":
+		scc.TraceFlags = trace.FlagsSampled
+	default:
+		return trace.SpanContext{}
+	}
+	switch flags {
+	case "", "0":
+	case "1":
+		// Debug implies an accept sampling decision.
+		scc.TraceFlags = trace.FlagsSampled
+	default:
+		return trace.SpanContext{}
+	}
+
+	scc.Remote = true
+	return trace.NewSpanContext(scc)
+}
+
+// extractB3Single returns the span context of the B3 single header h. An
+// invalid span context is returned if h is not valid.
+//
+// The header has the format
+// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}, where the sampling state
+// and parent span ID are optional. A header only containing the sampling state
+// holds no span context.
+func extractB3Single(h string) trace.SpanContext {
+	parts := strings.Split(h, "-")
+	if len(parts) < 2 || len(parts) > 4 {
+		return trace.SpanContext{}
+	}
+
+	var scc trace.SpanContextConfig
+	var ok bool
+	if scc.TraceID, ok = b3TraceID(parts[0]); !ok {
+		return trace.SpanContext{}
+	}
+	if scc.SpanID, ok = b3SpanID(parts[1]); !ok {
+		return trace.SpanContext{}
+	}
+	if len(parts) > 2 {
+		switch parts[2] {
+		case b3NotSampled:
+		case b3Sampled, b3Debug:
+			scc.TraceFlags = trace.FlagsSampled
+		default:
+			return trace.SpanContext{}
+		}
+	}
+	if len(parts) > 3 {
+		if _, ok = b3SpanID(parts[3]); !ok {
+			return trace.SpanContext{}
+		}
+	}
+
+	scc.Remote = true
+	return trace.NewSpanContext(scc)
+}
+
+// b3TraceID parses a 64 or 128-bit B3 trace ID encoded as lower-hex.
+func b3TraceID(h string) (trace.TraceID, bool) {
+	if len(h) == 16 {
+		h = b3TraceIDPadding + h
+	}
+	id, err := trace.TraceIDFromHex(h)
+	return id, err == nil && !upperHex(h)
+}
+
+// b3SpanID parses a 64-bit B3 span ID encoded as lower-hex.
+func b3SpanID(h string) (trace.SpanID, bool) {
+	id, err := trace.SpanIDFromHex(h)
+	return id, err == nil && !upperHex(h)
+}
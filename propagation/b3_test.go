@@ -0,0 +1,183 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestB3Extract(t *testing.T) {
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	notSampled := sampled.WithTraceFlags(0)
+	shortTraceID := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    mustTraceIDFromHex("0000000000000000a3ce929d0e0e4736"),
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+
+	tests := []struct {
+		name    string
+		carrier propagation.MapCarrier
+		want    trace.SpanContext
+	}{
+		{"Empty", propagation.MapCarrier{}, trace.SpanContext{}},
+		{
+			"Multiple",
+			propagation.MapCarrier{
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+			sampled,
+		},
+		{
+			"MultipleNotSampled",
+			propagation.MapCarrier{
+				"x-b3-traceid":      traceIDStr,
+				"x-b3-spanid":       spanIDStr,
+				"x-b3-parentspanid": spanIDStr,
+				"x-b3-sampled":      "false",
+			},
+			notSampled,
+		},
+		{
+			"MultipleDeferred",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr},
+			notSampled,
+		},
+		{
+			"MultipleDebug",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-flags": "1"},
+			sampled,
+		},
+		{
+			"Multiple64BitTraceID",
+			propagation.MapCarrier{"x-b3-traceid": "a3ce929d0e0e4736", "x-b3-spanid": spanIDStr, "x-b3-sampled": "1"},
+			shortTraceID,
+		},
+		{
+			"MultipleInvalidSampled",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "2"},
+			trace.SpanContext{},
+		},
+		{
+			"MultipleInvalidFlags",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-flags": "2"},
+			trace.SpanContext{},
+		},
+		{
+			"MultipleInvalidParent",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-parentspanid": "x"},
+			trace.SpanContext{},
+		},
+		{
+			"MultipleMissingSpanID",
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-sampled": "1"},
+			trace.SpanContext{},
+		},
+		{
+			"MultipleUpperCase",
+			propagation.MapCarrier{"x-b3-traceid": "4BF92F3577B34DA6A3CE929D0E0E4736", "x-b3-spanid": spanIDStr},
+			trace.SpanContext{},
+		},
+		{"Single", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1"}, sampled},
+		{"SingleNoSampling", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr}, notSampled},
+		{"SingleDebug", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-d"}, sampled},
+		{"SingleParent", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-0-" + spanIDStr}, notSampled},
+		{"Single64BitTraceID", propagation.MapCarrier{"b3": "a3ce929d0e0e4736-" + spanIDStr + "-1"}, shortTraceID},
+		{"SingleSamplingOnly", propagation.MapCarrier{"b3": "1"}, trace.SpanContext{}},
+		{"SingleInvalidSampling", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-x"}, trace.SpanContext{}},
+		{"SingleInvalidParent", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1-x"}, trace.SpanContext{}},
+		{"SingleTooManyParts", propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1-" + spanIDStr + "-1"}, trace.SpanContext{}},
+		{
+			"SinglePrecedence",
+			propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "0",
+			},
+			sampled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := propagation.B3{}.Extract(t.Context(), tt.carrier)
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestB3Inject(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(t.Context(), sc)
+
+	tests := []struct {
+		name string
+		enc  propagation.B3Encoding
+		want propagation.MapCarrier
+	}{
+		{
+			"Default",
+			0,
+			propagation.MapCarrier{"x-b3-traceid": traceIDStr, "x-b3-spanid": spanIDStr, "x-b3-sampled": "1"},
+		},
+		{
+			"Single",
+			propagation.B3SingleHeader,
+			propagation.MapCarrier{"b3": traceIDStr + "-" + spanIDStr + "-1"},
+		},
+		{
+			"Both",
+			propagation.B3SingleHeader | propagation.B3MultipleHeader,
+			propagation.MapCarrier{
+				"b3":           traceIDStr + "-" + spanIDStr + "-1",
+				"x-b3-traceid": traceIDStr,
+				"x-b3-spanid":  spanIDStr,
+				"x-b3-sampled": "1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := propagation.B3{InjectEncoding: tt.enc}
+			got := propagation.MapCarrier{}
+			p.Inject(ctx, got)
+			assert.Equal(t, tt.want, got)
+			assert.ElementsMatch(t, p.Fields(), got.Keys())
+
+			// Round trip.
+			extracted := trace.SpanContextFromContext(p.Extract(t.Context(), got))
+			assert.Equal(t, sc.WithRemote(true), extracted)
+		})
+	}
+
+	t.Run("NotSampled", func(t *testing.T) {
+		got := propagation.MapCarrier{}
+		propagation.B3{}.Inject(trace.ContextWithSpanContext(t.Context(), sc.WithTraceFlags(0)), got)
+		assert.Equal(t, "0", got["x-b3-sampled"])
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		got := propagation.MapCarrier{}
+		propagation.B3{}.Inject(t.Context(), got)
+		assert.Empty(t, got)
+	})
+}
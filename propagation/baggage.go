@@ -32,13 +32,84 @@ var handleExtractErrOnce sync.Once
 //
 // This propagates user-defined baggage associated with a trace. The complete
 // specification is defined at https://www.w3.org/TR/baggage/.
-type Baggage struct{}
+//
+// The zero value injects the baggage as is, even if it exceeds the limits of
+// the specification. Use [NewBaggage] to handle oversized baggage.
+type Baggage struct {
+	// cfg is a pointer so Baggage stays comparable.
+	cfg *baggageConfig
+}
 
 var _ TextMapPropagator = Baggage{}
 
+// BaggageLimitHandler handles the outgoing baggage b exceeding the limits of
+// the W3C Baggage specification, 64 list-members and 8192 bytes, and returns
+// the baggage to inject instead.
+//
+// It can, for instance, drop the list-members of the lowest priority with
+// [baggage.Baggage.Trim] and record the dropped list-members in a metric. It
+// is called synchronously when the baggage is injected and must not block.
+type BaggageLimitHandler func(ctx context.Context, b baggage.Baggage) baggage.Baggage
+
+// BaggageOption configures a Baggage propagator.
+type BaggageOption interface {
+	applyBaggage(baggageConfig) baggageConfig
+}
+
+type baggageConfig struct {
+	limitHandler BaggageLimitHandler
+	invalidUTF8  baggage.InvalidUTF8Policy
+}
+
+type baggageOptionFunc func(baggageConfig) baggageConfig
+
+func (fn baggageOptionFunc) applyBaggage(c baggageConfig) baggageConfig {
+	return fn(c)
+}
+
+// WithBaggageLimitHandler sets the BaggageLimitHandler called when the
+// outgoing baggage exceeds the limits of the W3C Baggage specification.
+//
+// By default, the oversized baggage is injected as is, the receiver
+// dropping the list-members it cannot accept.
+func WithBaggageLimitHandler(h BaggageLimitHandler) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.limitHandler = h
+		return c
+	})
+}
+
+// WithInvalidUTF8 sets how the list-member values of the incoming baggage
+// containing a percent-encoded invalid UTF-8 sequence are handled.
+//
+// By default, the invalid UTF-8 sequences are replaced with the replacement
+// character U+FFFD.
+func WithInvalidUTF8(policy baggage.InvalidUTF8Policy) BaggageOption {
+	return baggageOptionFunc(func(c baggageConfig) baggageConfig {
+		c.invalidUTF8 = policy
+		return c
+	})
+}
+
+// NewBaggage returns a Baggage propagator configured with opts.
+func NewBaggage(opts ...BaggageOption) Baggage {
+	var c baggageConfig
+	for _, opt := range opts {
+		c = opt.applyBaggage(c)
+	}
+	if c.limitHandler == nil && c.invalidUTF8 == baggage.InvalidUTF8Replace {
+		return Baggage{}
+	}
+	return Baggage{cfg: &c}
+}
+
 // Inject sets baggage key-values from ctx into the carrier.
-func (Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
-	bStr := baggage.FromContext(ctx).String()
+func (b Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
+	bag := baggage.FromContext(ctx)
+	bStr := bag.String()
+	if b.cfg != nil && b.cfg.limitHandler != nil && (bag.Len() > maxMembers || len(bStr) > maxBytesPerBaggageString) {
+		bStr = b.cfg.limitHandler(ctx, bag).String()
+	}
 	if bStr != "" {
 		carrier.Set(baggageHeader, bStr)
 	}
@@ -47,11 +118,15 @@ func (Baggage) Inject(ctx context.Context, carrier TextMapCarrier) {
 // Extract returns a copy of parent with the baggage from the carrier added.
 // If carrier implements [ValuesGetter] (e.g. [HeaderCarrier]), Values is invoked
 // for multiple values extraction. Otherwise, Get is called.
-func (Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
+func (b Baggage) Extract(parent context.Context, carrier TextMapCarrier) context.Context {
+	policy := baggage.InvalidUTF8Replace
+	if b.cfg != nil {
+		policy = b.cfg.invalidUTF8
+	}
 	if multiCarrier, ok := carrier.(ValuesGetter); ok {
-		return extractMultiBaggage(parent, multiCarrier)
+		return extractMultiBaggage(parent, multiCarrier, policy)
 	}
-	return extractSingleBaggage(parent, carrier)
+	return extractSingleBaggage(parent, carrier, policy)
 }
 
 // Fields returns the keys who's values are set with Inject.
@@ -59,13 +134,13 @@ func (Baggage) Fields() []string {
 	return []string{baggageHeader}
 }
 
-func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) context.Context {
+func extractSingleBaggage(parent context.Context, carrier TextMapCarrier, policy baggage.InvalidUTF8Policy) context.Context {
 	bStr := carrier.Get(baggageHeader)
 	if bStr == "" {
 		return parent
 	}
 
-	bag, err := baggage.Parse(bStr)
+	bag, err := baggage.ParseWithInvalidUTF8(bStr, policy)
 	if err != nil {
 		handleExtractErrOnce.Do(func() {
 			errorhandler.GetErrorHandler().Handle(err)
@@ -77,7 +152,7 @@ func extractSingleBaggage(parent context.Context, carrier TextMapCarrier) contex
 	return baggage.ContextWithBaggage(parent, bag)
 }
 
-func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.Context {
+func extractMultiBaggage(parent context.Context, carrier ValuesGetter, policy baggage.InvalidUTF8Policy) context.Context {
 	bVals := carrier.Values(baggageHeader)
 	if len(bVals) == 0 {
 		return parent
@@ -110,7 +185,7 @@ func extractMultiBaggage(parent context.Context, carrier ValuesGetter) context.C
 
 		// If members exceed the limit, stop parsing baggage.
 		if len(members) <= maxMembers {
-			currBag, err := baggage.Parse(bStr)
+			currBag, err := baggage.ParseWithInvalidUTF8(bStr, policy)
 			if err != nil {
 				parseErrors++
 				if parseErrors <= maxParseErrors {
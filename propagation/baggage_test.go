@@ -4,6 +4,7 @@
 package propagation_test
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/baggage"
@@ -469,6 +471,60 @@ func TestInjectBaggageToHTTPReq(t *testing.T) {
 	}
 }
 
+func TestInjectOversizedBaggage(t *testing.T) {
+	var bag baggage.Baggage
+	for i, priority := range []int{0, 1, -1} {
+		p := baggage.NewPriorityProperty(priority)
+		m, err := baggage.NewMember(fmt.Sprintf("key%d", i), strings.Repeat("a", 3000), p)
+		require.NoError(t, err)
+		bag, err = bag.SetMember(m)
+		require.NoError(t, err)
+	}
+	ctx := baggage.ContextWithBaggage(t.Context(), bag)
+
+	var dropped []baggage.Member
+	propagator := propagation.NewBaggage(propagation.WithBaggageLimitHandler(
+		func(_ context.Context, b baggage.Baggage) baggage.Baggage {
+			b, dropped = b.Trim()
+			return b
+		},
+	))
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	require.Len(t, dropped, 1)
+	assert.Equal(t, "key2", dropped[0].Key())
+	got, err := baggage.Parse(carrier.Get("baggage"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, got.Len())
+
+	dropped = nil
+	ctx = baggage.ContextWithBaggage(t.Context(), got)
+	propagator.Inject(ctx, propagation.MapCarrier{})
+	assert.Nil(t, dropped, "not called within the limits")
+
+	carrier = propagation.MapCarrier{}
+	propagation.Baggage{}.Inject(baggage.ContextWithBaggage(t.Context(), bag), carrier)
+	assert.Len(t, carrier.Get("baggage"), len(bag.String()), "injected as is by default")
+	assert.Equal(t, propagation.Baggage{}, propagation.NewBaggage())
+}
+
+func TestExtractBaggageInvalidUTF8(t *testing.T) {
+	carrier := propagation.MapCarrier{"baggage": "foo=1,bar=%FF"}
+
+	bag := baggage.FromContext(propagation.Baggage{}.Extract(t.Context(), carrier))
+	assert.Equal(t, 2, bag.Len())
+	assert.Equal(t, "\uFFFD", bag.Member("bar").Value())
+
+	p := propagation.NewBaggage(propagation.WithInvalidUTF8(baggage.InvalidUTF8Drop))
+	bag = baggage.FromContext(p.Extract(t.Context(), carrier))
+	assert.Equal(t, 1, bag.Len())
+	assert.Equal(t, "1", bag.Member("foo").Value())
+
+	header := propagation.HeaderCarrier(http.Header{"Baggage": {"foo=1", "bar=%FF"}})
+	bag = baggage.FromContext(p.Extract(t.Context(), header))
+	assert.Equal(t, 1, bag.Len(), "multiple headers")
+}
+
 func TestBaggageInjectExtractRoundtrip(t *testing.T) {
 	propagator := propagation.Baggage{}
 	tests := []struct {
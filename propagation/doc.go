@@ -5,9 +5,14 @@
 Package propagation contains OpenTelemetry context propagators.
 
 OpenTelemetry propagators are used to extract and inject context data from and
-into messages exchanged by applications. The propagator supported by this
-package is the W3C Trace Context encoding
-(https://www.w3.org/TR/trace-context/), and W3C Baggage
-(https://www.w3.org/TR/baggage/).
+into messages exchanged by applications. The propagators supported by this
+package are the W3C Trace Context encoding
+(https://www.w3.org/TR/trace-context/), W3C Baggage
+(https://www.w3.org/TR/baggage/), B3
+(https://github.com/openzipkin/b3-propagation), and Jaeger
+(https://www.jaegertracing.io/docs/latest/client-libraries/#propagation-format).
+
+Use [NewFromEnv] to create the propagators configured with the
+OTEL_PROPAGATORS environment variable.
 */
 package propagation
@@ -0,0 +1,56 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// defaultPropagator is the TextMapPropagator used to encode a context if none
+// is provided.
+var defaultPropagator = NewCompositeTextMapPropagator(TraceContext{}, Baggage{})
+
+// MarshalContext encodes the values of ctx propagated by p into a compact
+// representation that can be stored or transmitted out-of-band. This is
+// useful to continue a trace in a separate process that does not receive a
+// live request, e.g. a stage of a batch pipeline that is handed its work
+// through a file in object storage.
+//
+// If p is nil, the span context (traceparent and tracestate) and the baggage
+// of ctx are encoded using the TraceContext and Baggage propagators.
+//
+// Use [UnmarshalContext] with the same propagator to restore the values.
+func MarshalContext(ctx context.Context, p TextMapPropagator) ([]byte, error) {
+	if p == nil {
+		p = defaultPropagator
+	}
+	carrier := MapCarrier{}
+	p.Inject(ctx, carrier)
+	data, err := json.Marshal(carrier)
+	if err != nil {
+		return nil, fmt.Errorf("propagation: marshal context: %w", err)
+	}
+	return data, nil
+}
+
+// UnmarshalContext returns a copy of ctx with the values encoded in data by
+// [MarshalContext] extracted by p.
+//
+// If p is nil, the span context and the baggage are extracted using the
+// TraceContext and Baggage propagators.
+//
+// An error is returned, along with ctx unchanged, if data is not a valid
+// encoding.
+func UnmarshalContext(ctx context.Context, p TextMapPropagator, data []byte) (context.Context, error) {
+	if p == nil {
+		p = defaultPropagator
+	}
+	var carrier MapCarrier
+	if err := json.Unmarshal(data, &carrier); err != nil {
+		return ctx, fmt.Errorf("propagation: unmarshal context: %w", err)
+	}
+	return p.Extract(ctx, carrier), nil
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMarshalContext(t *testing.T) {
+	ts, err := trace.ParseTraceState("vendor=value")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01},
+		SpanID:     trace.SpanID{0x02},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+		Remote:     true,
+	})
+	m, err := baggage.NewMember("key", "value")
+	require.NoError(t, err)
+	bag, err := baggage.New(m)
+	require.NoError(t, err)
+
+	ctx := trace.ContextWithRemoteSpanContext(context.Background(), sc)
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+
+	data, err := propagation.MarshalContext(ctx, nil)
+	require.NoError(t, err)
+
+	got, err := propagation.UnmarshalContext(context.Background(), nil, data)
+	require.NoError(t, err)
+	assert.Equal(t, sc, trace.SpanContextFromContext(got))
+	assert.Equal(t, bag, baggage.FromContext(got))
+}
+
+func TestMarshalContextPropagator(t *testing.T) {
+	bag, err := baggage.Parse("key=value")
+	require.NoError(t, err)
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	data, err := propagation.MarshalContext(ctx, propagation.Baggage{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"baggage":"key=value"}`, string(data))
+
+	got, err := propagation.UnmarshalContext(context.Background(), propagation.Baggage{}, data)
+	require.NoError(t, err)
+	assert.Equal(t, bag, baggage.FromContext(got))
+}
+
+func TestMarshalContextEmpty(t *testing.T) {
+	data, err := propagation.MarshalContext(context.Background(), nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	got, err := propagation.UnmarshalContext(ctx, nil, data)
+	require.NoError(t, err)
+	assert.False(t, trace.SpanContextFromContext(got).IsValid())
+	assert.Equal(t, 0, baggage.FromContext(got).Len())
+}
+
+func TestUnmarshalContextInvalid(t *testing.T) {
+	ctx := context.Background()
+	got, err := propagation.UnmarshalContext(ctx, nil, []byte("not json"))
+	assert.Error(t, err)
+	assert.Equal(t, ctx, got)
+}
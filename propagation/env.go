@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	envPropagators = "OTEL_PROPAGATORS"
+
+	// envPropagatorsNone disables propagation.
+	envPropagatorsNone = "none"
+)
+
+// errUnknownPropagator is returned when a propagator name is not supported.
+var errUnknownPropagator = errors.New("unknown propagator")
+
+// envPropagatorsByName are the propagators OTEL_PROPAGATORS supports.
+var envPropagatorsByName = map[string]TextMapPropagator{
+	"tracecontext": TraceContext{},
+	"baggage":      Baggage{},
+	"b3":           B3{InjectEncoding: B3SingleHeader},
+	"b3multi":      B3{InjectEncoding: B3MultipleHeader},
+	"jaeger":       Jaeger{},
+}
+
+// NewFromEnv returns a composite TextMapPropagator of the propagators listed
+// in the OTEL_PROPAGATORS environment variable, in the listed order.
+//
+// The supported values are "tracecontext", "baggage", "b3" (B3 single
+// header), "b3multi" (B3 multiple headers), "jaeger", and "none" to disable
+// propagation. Values are separated by commas. If the variable is not set or
+// is empty, "tracecontext,baggage" is used.
+//
+// If a listed value is not supported, an error is returned along with the
+// composite TextMapPropagator of the supported values.
+func NewFromEnv() (TextMapPropagator, error) {
+	v := strings.TrimSpace(os.Getenv(envPropagators))
+	if v == "" {
+		return NewCompositeTextMapPropagator(TraceContext{}, Baggage{}), nil
+	}
+
+	var (
+		props []TextMapPropagator
+		errs  []error
+	)
+	for name := range strings.SplitSeq(v, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == envPropagatorsNone {
+			return NewCompositeTextMapPropagator(), nil
+		}
+		p, ok := envPropagatorsByName[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %w: %q", envPropagators, errUnknownPropagator, name))
+			continue
+		}
+		props = append(props, p)
+	}
+	return NewCompositeTextMapPropagator(props...), errors.Join(errs...)
+}
@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestNewFromEnv(t *testing.T) {
+	tests := []struct {
+		env     string
+		fields  []string
+		wantErr string
+	}{
+		{"", []string{"traceparent", "tracestate", "baggage"}, ""},
+		{"tracecontext", []string{"traceparent", "tracestate"}, ""},
+		{" B3 , jaeger ", []string{"b3", "uber-trace-id"}, ""},
+		{"b3multi", []string{"x-b3-traceid", "x-b3-spanid", "x-b3-sampled"}, ""},
+		{"tracecontext,none,baggage", nil, ""},
+		{"xray,baggage,ottrace", []string{"baggage"}, `"xray"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.env, func(t *testing.T) {
+			t.Setenv("OTEL_PROPAGATORS", tt.env)
+			p, err := propagation.NewFromEnv()
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, `"ottrace"`)
+			}
+			assert.ElementsMatch(t, tt.fields, p.Fields())
+		})
+	}
+}
@@ -4,6 +4,10 @@
 package propagation_test
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
 )
@@ -18,3 +22,31 @@ func ExampleSetTextMapPropagator() {
 	// Set it as the global text map propagator.
 	otel.SetTextMapPropagator(propagator)
 }
+
+func ExampleMarshalContext() {
+	// In the stage producing the work, encode the context and store it next to
+	// the work item. The context would contain the active span and baggage.
+	ctx := context.Background()
+	data, err := propagation.MarshalContext(ctx, nil)
+	if err != nil {
+		panic(err)
+	}
+	f := filepath.Join(os.TempDir(), "work-item.otel")
+	if err := os.WriteFile(f, data, 0o600); err != nil {
+		panic(err)
+	}
+	defer os.Remove(f)
+
+	// In the stage consuming the work, restore the context and use it as the
+	// parent of the telemetry produced while processing the work item.
+	data, err = os.ReadFile(f)
+	if err != nil {
+		panic(err)
+	}
+	ctx, err = propagation.UnmarshalContext(context.Background(), nil, data)
+	if err != nil {
+		panic(err)
+	}
+	_, span := otel.Tracer("example").Start(ctx, "process")
+	defer span.End()
+}
@@ -0,0 +1,124 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// DuplicateHeaderPolicy defines the value a carrier created with
+// [NewHeaderCarrier] returns for a traceparent header with multiple values.
+type DuplicateHeaderPolicy int
+
+const (
+	// DuplicateHeaderFirst returns the first traceparent value. This is the
+	// behavior of HeaderCarrier.
+	DuplicateHeaderFirst DuplicateHeaderPolicy = iota
+	// DuplicateHeaderLast returns the last traceparent value.
+	DuplicateHeaderLast
+	// DuplicateHeaderReject returns no value for multiple traceparent values.
+	// With this policy, a TraceContext propagator does not extract a span
+	// context from a request with duplicate traceparent headers, as
+	// recommended by the W3C Trace Context specification.
+	DuplicateHeaderReject
+)
+
+// NewHeaderCarrier returns a TextMapCarrier, also implementing ValuesGetter,
+// for h that matches keys case-insensitively and resolves duplicate
+// traceparent headers according to policy.
+//
+// Unlike HeaderCarrier, the keys of h do not need to be in the canonical
+// format of [http.CanonicalHeaderKey] to be found. This supports headers added
+// to h directly, e.g. "traceparent" or "TRACEPARENT" by frameworks or proxies
+// not using the methods of http.Header. The values of all the keys of h
+// matching a key case-insensitively are considered values of that key, in
+// the order of the canonical key first and then the other keys sorted.
+//
+// The policy applies to the Get method for the traceparent key only. For the
+// tracestate key, Get returns all the values joined with a comma, as the W3C
+// Trace Context specification requires for multiple tracestate headers. For
+// the other keys, Get returns the first value. The Values method always
+// returns all the values of a key. Set replaces the values of all the matching
+// keys with the value stored with the canonical key.
+func NewHeaderCarrier(h http.Header, policy DuplicateHeaderPolicy) TextMapCarrier {
+	return headerCarrier{header: h, policy: policy}
+}
+
+type headerCarrier struct {
+	header http.Header
+	policy DuplicateHeaderPolicy
+}
+
+var (
+	_ TextMapCarrier = headerCarrier{}
+	_ ValuesGetter   = headerCarrier{}
+)
+
+// Get returns the value associated with the passed key. Multiple traceparent
+// values are resolved according to the DuplicateHeaderPolicy of c and
+// multiple tracestate values are joined with a comma.
+func (c headerCarrier) Get(key string) string {
+	vals := c.Values(key)
+	switch {
+	case len(vals) == 0:
+		return ""
+	case len(vals) == 1:
+		return vals[0]
+	case strings.EqualFold(key, tracestateHeader):
+		return strings.Join(vals, ",")
+	case !strings.EqualFold(key, traceparentHeader):
+		return vals[0]
+	}
+
+	switch c.policy {
+	case DuplicateHeaderLast:
+		return vals[len(vals)-1]
+	case DuplicateHeaderReject:
+		return ""
+	default:
+		return vals[0]
+	}
+}
+
+// Values returns all values associated with the passed key.
+func (c headerCarrier) Values(key string) []string {
+	canonical := http.CanonicalHeaderKey(key)
+	vals := c.header[canonical]
+
+	var others []string
+	for k := range c.header {
+		if k != canonical && strings.EqualFold(k, key) {
+			others = append(others, k)
+		}
+	}
+	if len(others) == 0 {
+		return vals
+	}
+
+	slices.Sort(others)
+	out := make([]string, 0, len(vals)+len(others))
+	out = append(out, vals...)
+	for _, k := range others {
+		out = append(out, c.header[k]...)
+	}
+	return out
+}
+
+// Set stores the key-value pair, replacing the values of all the keys
+// matching key case-insensitively.
+func (c headerCarrier) Set(key, value string) {
+	for k := range c.header {
+		if strings.EqualFold(k, key) {
+			delete(c.header, k)
+		}
+	}
+	c.header.Set(key, value)
+}
+
+// Keys lists the keys stored in this carrier.
+func (c headerCarrier) Keys() []string {
+	return HeaderCarrier(c.header).Keys()
+}
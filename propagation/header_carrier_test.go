@@ -0,0 +1,180 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	headerCarrierTP0 = "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"
+	headerCarrierTP1 = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+)
+
+func TestHeaderCarrierGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		policy propagation.DuplicateHeaderPolicy
+		want   string
+	}{
+		{
+			name:   "Canonical",
+			header: http.Header{"Traceparent": {"a"}},
+			want:   "a",
+		},
+		{
+			name:   "LowerCase",
+			header: http.Header{"traceparent": {"a"}},
+			want:   "a",
+		},
+		{
+			name:   "UpperCase",
+			header: http.Header{"TRACEPARENT": {"a"}},
+			want:   "a",
+		},
+		{
+			name:   "Missing",
+			header: http.Header{"Other": {"a"}},
+			want:   "",
+		},
+		{
+			name:   "First",
+			header: http.Header{"Traceparent": {"a"}, "traceparent": {"b"}},
+			policy: propagation.DuplicateHeaderFirst,
+			want:   "a",
+		},
+		{
+			name:   "Last",
+			header: http.Header{"Traceparent": {"a"}, "traceparent": {"b"}},
+			policy: propagation.DuplicateHeaderLast,
+			want:   "b",
+		},
+		{
+			name:   "Reject",
+			header: http.Header{"Traceparent": {"a", "b"}},
+			policy: propagation.DuplicateHeaderReject,
+			want:   "",
+		},
+		{
+			name:   "RejectSingle",
+			header: http.Header{"traceparent": {"a"}},
+			policy: propagation.DuplicateHeaderReject,
+			want:   "a",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := propagation.NewHeaderCarrier(tc.header, tc.policy)
+			assert.Equal(t, tc.want, c.Get("traceparent"))
+		})
+	}
+}
+
+func TestHeaderCarrierGetTraceState(t *testing.T) {
+	policies := []propagation.DuplicateHeaderPolicy{
+		propagation.DuplicateHeaderFirst,
+		propagation.DuplicateHeaderLast,
+		propagation.DuplicateHeaderReject,
+	}
+	for _, policy := range policies {
+		h := http.Header{"Tracestate": {"a=1", "b=2"}, "tracestate": {"c=3"}}
+		c := propagation.NewHeaderCarrier(h, policy)
+		assert.Equal(t, "a=1,b=2,c=3", c.Get("tracestate"), "policy %d", policy)
+	}
+}
+
+func TestHeaderCarrierGetOther(t *testing.T) {
+	h := http.Header{"Baggage": {"a=1"}, "baggage": {"b=2"}}
+	c := propagation.NewHeaderCarrier(h, propagation.DuplicateHeaderReject)
+	assert.Equal(t, "a=1", c.Get("baggage"))
+}
+
+func TestHeaderCarrierValues(t *testing.T) {
+	h := http.Header{
+		"Baggage": {"a=1"},
+		"baggage": {"b=2"},
+		"BAGGAGE": {"c=3"},
+	}
+	c := propagation.NewHeaderCarrier(h, propagation.DuplicateHeaderReject)
+	got := c.(propagation.ValuesGetter).Values("baggage")
+	assert.Equal(t, []string{"a=1", "c=3", "b=2"}, got)
+}
+
+func TestHeaderCarrierSet(t *testing.T) {
+	h := http.Header{"traceparent": {"a"}, "Other": {"b"}}
+	c := propagation.NewHeaderCarrier(h, propagation.DuplicateHeaderFirst)
+	c.Set("traceparent", "c")
+	assert.Equal(t, http.Header{"Traceparent": {"c"}, "Other": {"b"}}, h)
+	assert.ElementsMatch(t, []string{"Traceparent", "Other"}, c.Keys())
+}
+
+// TestHeaderCarrierTraceContext covers the header name and duplicate header
+// cases of the W3C Trace Context test suite.
+func TestHeaderCarrierTraceContext(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+		policy propagation.DuplicateHeaderPolicy
+		want   string
+	}{
+		{
+			name:   "test_traceparent_header_name",
+			header: http.Header{"TRACEPARENT": {headerCarrierTP0}},
+			want:   "0af7651916cd43dd8448eb211c80319c",
+		},
+		{
+			name:   "test_traceparent_header_name_valid_casing",
+			header: http.Header{"TraceParent": {headerCarrierTP0}},
+			want:   "0af7651916cd43dd8448eb211c80319c",
+		},
+		{
+			name:   "test_traceparent_duplicated",
+			header: http.Header{"Traceparent": {headerCarrierTP0, headerCarrierTP1}},
+			policy: propagation.DuplicateHeaderReject,
+			want:   "00000000000000000000000000000000",
+		},
+		{
+			name:   "test_traceparent_duplicated_casing",
+			header: http.Header{"Traceparent": {headerCarrierTP0}, "traceparent": {headerCarrierTP1}},
+			policy: propagation.DuplicateHeaderReject,
+			want:   "00000000000000000000000000000000",
+		},
+		{
+			name:   "DuplicatedLast",
+			header: http.Header{"Traceparent": {headerCarrierTP0, headerCarrierTP1}},
+			policy: propagation.DuplicateHeaderLast,
+			want:   "4bf92f3577b34da6a3ce929d0e0e4736",
+		},
+	}
+
+	prop := propagation.TraceContext{}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := prop.Extract(t.Context(), propagation.NewHeaderCarrier(tc.header, tc.policy))
+			got := trace.SpanContextFromContext(ctx).TraceID()
+			assert.Equal(t, tc.want, got.String())
+		})
+	}
+}
+
+func TestHeaderCarrierTraceContextTraceState(t *testing.T) {
+	h := http.Header{
+		"Traceparent": {headerCarrierTP0},
+		"Tracestate":  {"foo=1"},
+		"tracestate":  {"bar=2"},
+	}
+	prop := propagation.TraceContext{}
+	ctx := prop.Extract(t.Context(), propagation.NewHeaderCarrier(h, propagation.DuplicateHeaderReject))
+	sc := trace.SpanContextFromContext(ctx)
+	assert.True(t, sc.IsValid())
+	assert.Equal(t, "foo=1,bar=2", sc.TraceState().String())
+}
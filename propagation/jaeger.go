@@ -0,0 +1,122 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	jaegerHeader = "uber-trace-id"
+
+	jaegerFlagSampled = 0x01
+	jaegerFlagDebug   = 0x02
+
+	jaegerTraceIDMaxLen = 32
+	jaegerSpanIDMaxLen  = 16
+)
+
+// Jaeger is a propagator that supports the Jaeger format
+// (https://www.jaegertracing.io/docs/latest/client-libraries/#propagation-format).
+//
+// The span context is propagated with the uber-trace-id header. A Jaeger
+// debug flag is extracted as a sampled span context. Jaeger baggage headers
+// are not propagated, use the [Baggage] propagator instead.
+type Jaeger struct{}
+
+var _ TextMapPropagator = Jaeger{}
+
+// Inject injects the span context from ctx into carrier.
+func (Jaeger) Inject(ctx context.Context, carrier TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	flags := "0"
+	if sc.IsSampled() {
+		flags = "1"
+	}
+	// The parent span ID is deprecated and set to 0.
+	carrier.Set(jaegerHeader, sc.TraceID().String()+":"+sc.SpanID().String()+":0:"+flags)
+}
+
+// Extract reads the Jaeger span context from carrier into a returned
+// Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted span
+// context as the remote SpanContext. If the extracted span context is
+// invalid, the passed ctx will be returned directly instead.
+func (Jaeger) Extract(ctx context.Context, carrier TextMapCarrier) context.Context {
+	sc := extractJaeger(carrier.Get(jaegerHeader))
+	if !sc.IsValid() {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields returns the keys whose values are set with Inject.
+func (Jaeger) Fields() []string {
+	return []string{jaegerHeader}
+}
+
+// extractJaeger returns the span context of the Jaeger header h. An invalid
+// span context is returned if h is not valid.
+//
+// The header has the format {trace-id}:{span-id}:{parent-span-id}:{flags},
+// where the IDs are hex encoded without leading zeros required.
+func extractJaeger(h string) trace.SpanContext {
+	if h == "" {
+		return trace.SpanContext{}
+	}
+	// The header value may be URL encoded.
+	if strings.Contains(h, "%") {
+		var err error
+		if h, err = url.QueryUnescape(h); err != nil {
+			return trace.SpanContext{}
+		}
+	}
+
+	parts := strings.Split(h, ":")
+	if len(parts) != 4 {
+		return trace.SpanContext{}
+	}
+
+	var scc trace.SpanContextConfig
+	var err error
+	scc.TraceID, err = trace.TraceIDFromHex(jaegerPad(parts[0], jaegerTraceIDMaxLen))
+	if err != nil || upperHex(parts[0]) {
+		return trace.SpanContext{}
+	}
+	scc.SpanID, err = trace.SpanIDFromHex(jaegerPad(parts[1], jaegerSpanIDMaxLen))
+	if err != nil || upperHex(parts[1]) {
+		return trace.SpanContext{}
+	}
+	// The parent span ID is deprecated and ignored.
+
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return trace.SpanContext{}
+	}
+	if flags&(jaegerFlagSampled|jaegerFlagDebug) != 0 {
+		scc.TraceFlags = trace.FlagsSampled
+	}
+
+	scc.Remote = true
+	return trace.NewSpanContext(scc)
+}
+
+// jaegerPad left pads id with zeros to n characters. If id is empty or longer
+// than n, it is returned as is and will fail to be parsed.
+func jaegerPad(id string, n int) string {
+	if id == "" || len(id) >= n {
+		return id
+	}
+	return strings.Repeat("0", n-len(id)) + id
+}
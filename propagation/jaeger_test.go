@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestJaegerExtract(t *testing.T) {
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	})
+	padded := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: mustTraceIDFromHex("000000000000000000000000000000ab"),
+		SpanID:  mustSpanIDFromHex("00000000000000cd"),
+		Remote:  true,
+	})
+
+	tests := []struct {
+		name   string
+		header string
+		want   trace.SpanContext
+	}{
+		{"Empty", "", trace.SpanContext{}},
+		{"Sampled", traceIDStr + ":" + spanIDStr + ":0:1", sampled},
+		{"NotSampled", traceIDStr + ":" + spanIDStr + ":0:0", sampled.WithTraceFlags(0)},
+		{"Debug", traceIDStr + ":" + spanIDStr + ":0:2", sampled},
+		{"ParentIgnored", traceIDStr + ":" + spanIDStr + ":" + spanIDStr + ":3", sampled},
+		{"Padded", "ab:cd:0:0", padded},
+		{"URLEncoded", traceIDStr + "%3A" + spanIDStr + "%3A0%3A1", sampled},
+		{"InvalidURLEncoding", traceIDStr + "%zz", trace.SpanContext{}},
+		{"MissingPart", traceIDStr + ":" + spanIDStr + ":1", trace.SpanContext{}},
+		{"TraceIDTooLong", "0" + traceIDStr + ":" + spanIDStr + ":0:1", trace.SpanContext{}},
+		{"ZeroTraceID", "0:" + spanIDStr + ":0:1", trace.SpanContext{}},
+		{"EmptySpanID", traceIDStr + "::0:1", trace.SpanContext{}},
+		{"UpperCase", "AB:" + spanIDStr + ":0:1", trace.SpanContext{}},
+		{"InvalidFlags", traceIDStr + ":" + spanIDStr + ":0:x", trace.SpanContext{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			carrier := propagation.MapCarrier{}
+			if tt.header != "" {
+				carrier["uber-trace-id"] = tt.header
+			}
+			ctx := propagation.Jaeger{}.Extract(t.Context(), carrier)
+			assert.Equal(t, tt.want, trace.SpanContextFromContext(ctx))
+		})
+	}
+}
+
+func TestJaegerInject(t *testing.T) {
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	var p propagation.Jaeger
+	got := propagation.MapCarrier{}
+	p.Inject(trace.ContextWithSpanContext(t.Context(), sc), got)
+	assert.Equal(t, propagation.MapCarrier{"uber-trace-id": traceIDStr + ":" + spanIDStr + ":0:1"}, got)
+	assert.Equal(t, p.Fields(), got.Keys())
+	assert.Equal(t, sc.WithRemote(true), trace.SpanContextFromContext(p.Extract(t.Context(), got)))
+
+	got = propagation.MapCarrier{}
+	p.Inject(trace.ContextWithSpanContext(t.Context(), sc.WithTraceFlags(0)), got)
+	assert.Equal(t, traceIDStr+":"+spanIDStr+":0:0", got["uber-trace-id"])
+
+	got = propagation.MapCarrier{}
+	p.Inject(t.Context(), got)
+	assert.Empty(t, got)
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation_test
+
+import (
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func FuzzTraceContextExtract(f *testing.F) {
+	f.Add("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "key=value")
+	f.Add("ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra", "key=value,key=value")
+	f.Add("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-09-future", "=,привет")
+	f.Add("00-00000000000000000000000000000000-0000000000000000-00", "")
+
+	f.Fuzz(func(t *testing.T, traceparent, tracestate string) {
+		carrier := propagation.MapCarrier{"traceparent": traceparent, "tracestate": tracestate}
+		ctx := propagation.TraceContext{}.Extract(t.Context(), carrier)
+		sc := trace.SpanContextFromContext(ctx)
+		if !sc.IsValid() {
+			return
+		}
+		if !sc.IsRemote() {
+			t.Fatalf("extracted span context is not remote: %q", traceparent)
+		}
+
+		// The extracted span context must be injected as is.
+		out := propagation.MapCarrier{}
+		propagation.TraceContext{}.Inject(ctx, out)
+		got := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(t.Context(), out))
+		if !got.Equal(sc) {
+			t.Fatalf("roundtrip of %q, %q: got %v, want %v", traceparent, tracestate, got, sc)
+		}
+	})
+}
+
+func FuzzBaggageExtract(f *testing.F) {
+	f.Add("key1=val1,key2=val2", "key3=val3")
+	f.Add("key=%FF%FE;p=1", "key=%C3%A9")
+	f.Add("=,;;,key=v=a=l", "key=привет")
+
+	policies := []baggage.InvalidUTF8Policy{
+		baggage.InvalidUTF8Replace,
+		baggage.InvalidUTF8Drop,
+		baggage.InvalidUTF8Error,
+	}
+	f.Fuzz(func(t *testing.T, h1, h2 string) {
+		for _, policy := range policies {
+			p := propagation.NewBaggage(propagation.WithInvalidUTF8(policy))
+			carriers := []propagation.TextMapCarrier{
+				propagation.MapCarrier{"baggage": h1},
+				propagation.HeaderCarrier(http.Header{"Baggage": {h1, h2}}),
+			}
+			for _, carrier := range carriers {
+				bag := baggage.FromContext(p.Extract(t.Context(), carrier))
+				if n := len(bag.String()); n > maxBytesPerBaggageString {
+					t.Fatalf("policy %d: %d bytes exceed the limit: %q, %q", policy, n, h1, h2)
+				}
+			}
+		}
+	})
+}
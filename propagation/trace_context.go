@@ -28,13 +28,60 @@ const (
 // to choose if they want to participate in a trace by modifying the
 // traceparent header and relevant parts of the tracestate header containing
 // their proprietary information.
-type TraceContext struct{}
+//
+// The zero value parses the tracestate header of each extracted request. Use
+// [NewTraceContext] to cache the parsed tracestate headers.
+type TraceContext struct {
+	// cache is a pointer so TraceContext stays comparable.
+	cache *traceStateCache
+}
 
 var (
 	_           TextMapPropagator = TraceContext{}
 	versionPart                   = fmt.Sprintf("%.2X", supportedVersion)
 )
 
+// TraceContextOption configures a TraceContext propagator.
+type TraceContextOption interface {
+	applyTraceContext(traceContextConfig) traceContextConfig
+}
+
+type traceContextConfig struct {
+	traceStateCacheSize int
+}
+
+type traceContextOptionFunc func(traceContextConfig) traceContextConfig
+
+func (fn traceContextOptionFunc) applyTraceContext(c traceContextConfig) traceContextConfig {
+	return fn(c)
+}
+
+// WithTraceStateCache sets the number of the most recently extracted
+// distinct tracestate headers whose parsed TraceState is cached. Requests
+// from the same upstream services often carry identical tracestate headers,
+// the cache avoids parsing them again at high request rates. A value less
+// than or equal to zero disables the cache.
+//
+// By default, the tracestate headers are not cached.
+func WithTraceStateCache(size int) TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		c.traceStateCacheSize = size
+		return c
+	})
+}
+
+// NewTraceContext returns a TraceContext propagator configured with opts.
+func NewTraceContext(opts ...TraceContextOption) TraceContext {
+	var c traceContextConfig
+	for _, opt := range opts {
+		c = opt.applyTraceContext(c)
+	}
+	if c.traceStateCacheSize <= 0 {
+		return TraceContext{}
+	}
+	return TraceContext{cache: newTraceStateCache(c.traceStateCacheSize)}
+}
+
 // Inject injects the trace context from ctx into carrier.
 func (TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 	sc := trace.SpanContextFromContext(ctx)
@@ -47,7 +94,7 @@ func (TraceContext) Inject(ctx context.Context, carrier TextMapCarrier) {
 	}
 
 	// Preserve only the spec-defined flags: sampled (0x01) and random (0x02).
-	flags := sc.TraceFlags() & (trace.FlagsSampled | trace.FlagsRandom)
+	flags := sc.TraceFlags() & trace.FlagsDefined
 
 	var sb strings.Builder
 	sb.Grow(2 + 32 + 16 + 2 + 3)
@@ -77,7 +124,7 @@ func (tc TraceContext) Extract(ctx context.Context, carrier TextMapCarrier) cont
 	return trace.ContextWithRemoteSpanContext(ctx, sc)
 }
 
-func (TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
+func (tc TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 	h := carrier.Get(traceparentHeader)
 	if h == "" {
 		return trace.SpanContext{}
@@ -104,18 +151,17 @@ func (TraceContext) extract(carrier TextMapCarrier) trace.SpanContext {
 	if !extractPart(opts[:], &h, 2) {
 		return trace.SpanContext{}
 	}
-	if version == 0 && (h != "" || opts[0] > 3) {
+	if version == 0 && (h != "" || trace.TraceFlags(opts[0]).Reserved() != 0) {
 		// version 0 does not allow extra fields or reserved flag bits.
 		return trace.SpanContext{}
 	}
 
-	scc.TraceFlags = trace.TraceFlags(opts[0]) & //nolint:gosec // slice size already checked.
-		(trace.FlagsSampled | trace.FlagsRandom)
+	scc.TraceFlags = trace.TraceFlags(opts[0]) & trace.FlagsDefined
 
 	// Ignore the error returned here. Failure to parse tracestate MUST NOT
 	// affect the parsing of traceparent according to the W3C tracecontext
 	// specification.
-	scc.TraceState, _ = trace.ParseTraceState(carrier.Get(tracestateHeader))
+	scc.TraceState = tc.cache.parse(carrier.Get(tracestateHeader))
 	scc.Remote = true
 
 	sc := trace.NewSpanContext(scc)
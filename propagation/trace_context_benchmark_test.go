@@ -54,6 +54,27 @@ func BenchmarkExtract(b *testing.B) {
 	})
 }
 
+func BenchmarkExtractTraceStateCache(b *testing.B) {
+	req, _ := http.NewRequestWithContext(b.Context(), http.MethodGet, "http://example.com", http.NoBody)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	req.Header.Set("tracestate", "vendor1=opaque1,vendor2=opaque2,vendor3=opaque3")
+	for _, bb := range []struct {
+		name       string
+		propagator propagation.TraceContext
+	}{
+		{"Uncached", propagation.TraceContext{}},
+		{"Cached", propagation.NewTraceContext(propagation.WithTraceStateCache(128))},
+	} {
+		b.Run(bb.name, func(b *testing.B) {
+			ctx := b.Context()
+			b.ReportAllocs()
+			for b.Loop() {
+				bb.propagator.Extract(ctx, propagation.HeaderCarrier(req.Header))
+			}
+		})
+	}
+}
+
 func extractSubBenchmarks(b *testing.B, fn func(*testing.B, *http.Request)) {
 	b.Run("Sampled", func(b *testing.B) {
 		req, _ := http.NewRequestWithContext(b.Context(), http.MethodGet, "http://example.com", http.NoBody)
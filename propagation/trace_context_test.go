@@ -186,11 +186,18 @@ func TestExtractValidTraceContext(t *testing.T) {
 		},
 	}
 
+	cached := propagation.NewTraceContext(propagation.WithTraceStateCache(2))
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
 			ctx := t.Context()
 			ctx = prop.Extract(ctx, propagation.HeaderCarrier(tc.header))
 			assert.Equal(t, tc.sc, trace.SpanContextFromContext(ctx))
+
+			// The second extraction uses the cached tracestate.
+			for range 2 {
+				ctx = cached.Extract(t.Context(), propagation.HeaderCarrier(tc.header))
+				assert.Equal(t, tc.sc, trace.SpanContextFromContext(ctx), "cached")
+			}
 		})
 	}
 }
@@ -387,6 +394,12 @@ func TestInvalidSpanContextDropped(t *testing.T) {
 	assert.Empty(t, header.Get("traceparent"), "injected invalid SpanContext")
 }
 
+func TestNewTraceContext(t *testing.T) {
+	assert.Equal(t, propagation.TraceContext{}, propagation.NewTraceContext())
+	assert.Equal(t, propagation.TraceContext{}, propagation.NewTraceContext(propagation.WithTraceStateCache(0)))
+	assert.NotEqual(t, propagation.TraceContext{}, propagation.NewTraceContext(propagation.WithTraceStateCache(1)))
+}
+
 func TestTraceContextFields(t *testing.T) {
 	expected := []string{"traceparent", "tracestate"}
 	assert.Equal(t, expected, propagation.TraceContext{}.Fields())
@@ -0,0 +1,73 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceStateCache is a least recently used cache of parsed tracestate
+// headers. The parsed TraceState values are immutable and shared.
+type traceStateCache struct {
+	size int
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	// lru holds the traceStateEntry of the cached headers, the most recently
+	// used first.
+	lru list.List
+}
+
+type traceStateEntry struct {
+	header string
+	ts     trace.TraceState
+}
+
+func newTraceStateCache(size int) *traceStateCache {
+	return &traceStateCache{size: size, entries: make(map[string]*list.Element, size)}
+}
+
+// parse returns the TraceState parsed from header. The invalid headers are
+// parsed as an empty TraceState.
+//
+// Parsing errors are ignored. Failure to parse tracestate MUST NOT affect the
+// parsing of traceparent according to the W3C tracecontext specification.
+func (c *traceStateCache) parse(header string) trace.TraceState {
+	if c == nil || header == "" {
+		ts, _ := trace.ParseTraceState(header)
+		return ts
+	}
+
+	c.mu.Lock()
+	if e, ok := c.entries[header]; ok {
+		c.lru.MoveToFront(e)
+		ts := e.Value.(*traceStateEntry).ts
+		c.mu.Unlock()
+		return ts
+	}
+	c.mu.Unlock()
+
+	// Parse without holding the lock, concurrent misses of the same header
+	// are parsed more than once.
+	ts, _ := trace.ParseTraceState(header)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[header]; ok {
+		return ts
+	}
+	// Clone header so the cache does not retain the memory of a request.
+	header = strings.Clone(header)
+	c.entries[header] = c.lru.PushFront(&traceStateEntry{header: header, ts: ts})
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*traceStateEntry).header)
+	}
+	return ts
+}
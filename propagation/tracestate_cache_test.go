@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package propagation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTraceStateCache(t *testing.T) {
+	c := newTraceStateCache(2)
+
+	a := c.parse("a=1")
+	assert.Equal(t, "1", a.Get("a"))
+	c.parse("b=2")
+	// Use "a=1" so "b=2" is the least recently used.
+	assert.Equal(t, a, c.parse("a=1"))
+	c.parse("c=3")
+
+	require.Len(t, c.entries, 2)
+	assert.Contains(t, c.entries, "a=1")
+	assert.Contains(t, c.entries, "c=3")
+	assert.NotContains(t, c.entries, "b=2")
+
+	assert.Equal(t, 0, c.parse("invalid").Len())
+	assert.Contains(t, c.entries, "invalid")
+	assert.Equal(t, 0, c.parse("").Len())
+	assert.NotContains(t, c.entries, "")
+}
+
+func TestTraceStateCacheNil(t *testing.T) {
+	var c *traceStateCache
+	assert.Equal(t, "1", c.parse("a=1").Get("a"))
+}
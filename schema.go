@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import "go.opentelemetry.io/otel/internal/global"
+
+// RegisterSchemaURL records that the instrumentation scope named scope emits
+// telemetry following the semantic conventions identified by schemaURL
+// (e.g. go.opentelemetry.io/otel/semconv/v1.26.0.SchemaURL).
+//
+// Exporters and processors performing schema transformations can query the
+// registered schema URLs to select a target schema. Registering an empty
+// schemaURL removes any existing registration for scope.
+//
+// It is safe to call this function concurrently.
+func RegisterSchemaURL(scope, schemaURL string) {
+	global.RegisterSchemaURL(scope, schemaURL)
+}
+
+// SchemaURL returns the schema URL registered for the instrumentation scope
+// named scope and true, or an empty string and false if none is registered.
+func SchemaURL(scope string) (string, bool) {
+	return global.SchemaURL(scope)
+}
+
+// SchemaURLs returns a copy of all registered schema URLs keyed by
+// instrumentation scope name.
+func SchemaURLs() map[string]string {
+	return global.SchemaURLs()
+}
+
+// LatestSchemaURL returns the registered schema URL with the highest version,
+// or an empty string if no schema URL with a parsable version is registered.
+//
+// The version is parsed from the last path segment of the schema URL. This is
+// useful when selecting the target schema all telemetry should be transformed
+// to.
+func LatestSchemaURL() string {
+	return global.LatestSchemaURL()
+}
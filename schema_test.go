@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaURLRegistry(t *testing.T) {
+	const (
+		scope = "go.opentelemetry.io/otel/schema_test"
+		url   = "https://opentelemetry.io/schemas/99.0.0"
+	)
+	t.Cleanup(func() { RegisterSchemaURL(scope, "") })
+
+	RegisterSchemaURL(scope, url)
+
+	got, ok := SchemaURL(scope)
+	assert.True(t, ok)
+	assert.Equal(t, url, got)
+	assert.Equal(t, url, SchemaURLs()[scope])
+	assert.Equal(t, url, LatestSchemaURL())
+}
@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package instrumentation
+
+import "sync"
+
+// ScopeCache is a cache of instrumentation Scopes that can be shared by the
+// TracerProvider, MeterProvider, and LoggerProvider of a process.
+//
+// Providers using the same ScopeCache reference the same Scope value, and the
+// strings and attributes it holds, for equivalent scopes instead of each
+// holding a copy. This reduces the memory used by processes with many scopes,
+// like plugin hosts.
+//
+// A ScopeCache is safe for concurrent use. The zero value is ready to use.
+type ScopeCache struct {
+	mu     sync.Mutex
+	scopes map[Scope]Scope
+}
+
+// NewScopeCache returns a new, empty, ScopeCache.
+func NewScopeCache() *ScopeCache {
+	return &ScopeCache{}
+}
+
+// Intern returns the cached Scope equivalent to s. If no equivalent Scope is
+// cached, s is cached and returned.
+//
+// If c is nil, s is returned.
+func (c *ScopeCache) Intern(s Scope) Scope {
+	if c == nil {
+		return s
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cached, ok := c.scopes[s]; ok {
+		return cached
+	}
+	if c.scopes == nil {
+		c.scopes = make(map[Scope]Scope)
+	}
+	c.scopes[s] = s
+	return s
+}
+
+// Len returns the number of Scopes cached.
+func (c *ScopeCache) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.scopes)
+}
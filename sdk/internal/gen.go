@@ -10,3 +10,5 @@ package internal
 //go:generate gotmpl --body=../../internal/shared/attrnorm/dedup_test.go.tmpl "--data={}" --out=attrnorm/dedup_test.go
 //go:generate gotmpl --body=../../internal/shared/attrnorm/truncate.go.tmpl "--data={}" --out=attrnorm/truncate.go
 //go:generate gotmpl --body=../../internal/shared/attrnorm/truncate_test.go.tmpl "--data={}" --out=attrnorm/truncate_test.go
+//go:generate gotmpl --body=../../internal/shared/volume/volume.go.tmpl "--data={}" --out=volume/volume.go
+//go:generate gotmpl --body=../../internal/shared/volume/volume_test.go.tmpl "--data={}" --out=volume/volume_test.go
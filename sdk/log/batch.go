@@ -6,6 +6,7 @@ package log
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math"
 	"slices"
 	"sync"
@@ -34,10 +35,19 @@ const (
 // Compile-time check BatchProcessor implements Processor.
 var _ Processor = (*BatchProcessor)(nil)
 
+// droppedQueueFull counts the log records dropped because the queue is full.
+var droppedQueueFull = global.NewDropCounter("log_records", "queue_full")
+
 // BatchProcessor is a processor that exports batches of log records.
 //
 // Use [NewBatchProcessor] to create a BatchProcessor. An empty BatchProcessor
 // is shut down by default, no records will be batched or exported.
+//
+// Queued records reference the Resource of their LoggerProvider and the
+// instrumentation scope of their Logger instead of holding a copy of them.
+// When multiple LoggerProviders share a BatchProcessor, use
+// [WithSharedResource] and [WithScopeCache] for the records of all the
+// providers to reference the same Resource and scope values.
 type BatchProcessor struct {
 	// The BatchProcessor is designed to provide the highest throughput of
 	// log records possible while being compatible with OpenTelemetry. The
@@ -83,6 +93,10 @@ type BatchProcessor struct {
 	// batchSize is the minimum number of records needed before an export is
 	// triggered (unless the interval expires).
 	batchSize int
+	// batchBytes is the minimum estimated size, in bytes, of records needed
+	// before an export is triggered (unless the interval expires). It is zero
+	// if batches are not limited in bytes.
+	batchBytes int64
 
 	// pollTrigger triggers the poll goroutine to flush a batch from the queue.
 	// This is sent to when it is known that the queue contains at least one
@@ -105,6 +119,10 @@ type BatchProcessor struct {
 	// inst is the instrumentation for observability (nil when disabled).
 	inst *observ.BLP
 
+	// cfgErr is the error describing the invalid configuration the
+	// processor was created with, if any.
+	cfgErr error
+
 	noCmp [0]func() //nolint: unused  // This is indeed used.
 }
 
@@ -114,17 +132,26 @@ type BatchProcessor struct {
 // All of the exporter's methods are called synchronously.
 func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchProcessor {
 	cfg := newBatchConfig(opts)
+	errs := validateBatchOptions(opts, cfg.maxQSize.Value)
 	if exporter == nil {
 		// Do not panic on nil export.
 		exporter = defaultNoopExporter
+		errs = append([]error{errors.New("nil exporter")}, errs...)
 	}
 
 	b := &BatchProcessor{
 		q:           newQueue(cfg.maxQSize.Value),
 		batchSize:   cfg.expMaxBatchSize.Value,
+		batchBytes:  int64(cfg.expMaxBatchBytes.Value),
 		pollTrigger: make(chan struct{}, 1),
 		pollKill:    make(chan struct{}),
 	}
+	if len(errs) > 0 {
+		b.cfgErr = fmt.Errorf("%w: BatchProcessor: %w", ErrInvalidConfig, errors.Join(errs...))
+	}
+	if b.batchBytes > 0 {
+		b.q.sizeOf = recordSize
+	}
 
 	var err error
 	b.inst, err = observ.NewBLP(
@@ -147,6 +174,8 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	// to ensure each export completes in timeout (instead of all chunked
 	// exports).
 	exporter = newTimeoutExporter(exporter, cfg.expTimeout.Value)
+	// Split batches so each export does not exceed the size in bytes.
+	exporter = newBytesChunkExporter(exporter, b.batchBytes)
 	// Use a chunkExporter to ensure ForceFlush and Shutdown calls are batched
 	// appropriately on export.
 	exporter = newChunkExporter(exporter, cfg.expMaxBatchSize.Value)
@@ -157,6 +186,12 @@ func NewBatchProcessor(exporter Exporter, opts ...BatchProcessorOption) *BatchPr
 	return b
 }
 
+// configErr returns the error describing the invalid configuration b was
+// created with, if any.
+func (b *BatchProcessor) configErr() error {
+	return b.cfgErr
+}
+
 // poll spawns a goroutine to handle interval polling and batch exporting. The
 // returned done chan is closed when the spawned goroutine completes.
 func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
@@ -184,6 +219,7 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 				if b.inst != nil {
 					b.inst.ProcessedQueueFull(ctx, int64(min(math.MaxInt64, d))) // nolint:gosec
 				}
+				droppedQueueFull.Add(int64(min(math.MaxInt64, d))) // nolint:gosec
 				global.Warn("dropped log records", "dropped", d)
 			}
 
@@ -201,7 +237,7 @@ func (b *BatchProcessor) poll(interval time.Duration) (done chan struct{}) {
 				qLen = b.q.Len()
 			}
 
-			if qLen >= b.batchSize {
+			if qLen >= b.batchSize || b.fullBytes() {
 				// There is another full batch ready. Immediately trigger
 				// another export attempt.
 				select {
@@ -227,7 +263,7 @@ func (b *BatchProcessor) OnEmit(_ context.Context, r *Record) error {
 	}
 	// The record is cloned so that changes done by subsequent processors
 	// are not going to lead to a data race.
-	if n := b.q.Enqueue(r.Clone()); n >= b.batchSize {
+	if n := b.q.Enqueue(r.Clone()); n >= b.batchSize || b.fullBytes() {
 		select {
 		case b.pollTrigger <- struct{}{}:
 		default:
@@ -239,6 +275,12 @@ func (b *BatchProcessor) OnEmit(_ context.Context, r *Record) error {
 	return nil
 }
 
+// fullBytes reports whether the queued records make a batch that is full in
+// bytes.
+func (b *BatchProcessor) fullBytes() bool {
+	return b.batchBytes > 0 && b.q.Bytes() >= b.batchBytes
+}
+
 // Shutdown flushes queued log records and the decorated exporter before
 // shutting it down.
 func (b *BatchProcessor) Shutdown(ctx context.Context) error {
@@ -309,6 +351,11 @@ type queue struct {
 	dropped     atomic.Uint64
 	cap, len    int
 	read, write *ring
+
+	// sizeOf, if not nil, returns the estimated size of a Record. It is used
+	// to track the size of the queued Records in bytes.
+	sizeOf func(*Record) int64
+	bytes  int64
 }
 
 func newQueue(size int) *queue {
@@ -327,6 +374,15 @@ func (q *queue) Len() int {
 	return q.len
 }
 
+// Bytes returns the estimated size, in bytes, of the Records in the queue. It
+// returns 0 if the size of the Records is not tracked.
+func (q *queue) Bytes() int64 {
+	q.Lock()
+	defer q.Unlock()
+
+	return q.bytes
+}
+
 // Dropped returns the number of Records dropped during enqueueing since the
 // last time Dropped was called.
 func (q *queue) Dropped() uint64 {
@@ -342,6 +398,14 @@ func (q *queue) Enqueue(r Record) int {
 	q.Lock()
 	defer q.Unlock()
 
+	if q.sizeOf != nil {
+		if q.len == q.cap {
+			// The oldest Record is overwritten.
+			q.bytes -= q.write.size
+		}
+		q.write.size = q.sizeOf(&r)
+		q.bytes += q.write.size
+	}
 	q.write.Value = r
 	q.write = q.write.Next()
 
@@ -370,13 +434,16 @@ func (q *queue) TryDequeue(buf []Record, write func([]Record) bool) int {
 	origRead := q.read
 
 	n := min(len(buf), q.len)
+	var bytes int64
 	for i := range n {
 		buf[i] = q.read.Value // nolint:gosec // n is bounded by len(buf)
+		bytes += q.read.size
 		q.read = q.read.Next()
 	}
 
 	if write(buf[:n]) {
 		q.len -= n
+		q.bytes -= bytes
 	} else {
 		q.read = origRead
 	}
@@ -395,16 +462,18 @@ func (q *queue) Flush() []Record {
 		q.read = q.read.Next()
 	}
 	q.len = 0
+	q.bytes = 0
 
 	return out
 }
 
 type batchConfig struct {
-	maxQSize        setting[int]
-	expInterval     setting[time.Duration]
-	expTimeout      setting[time.Duration]
-	expMaxBatchSize setting[int]
-	expBufferSize   setting[int]
+	maxQSize         setting[int]
+	expInterval      setting[time.Duration]
+	expTimeout       setting[time.Duration]
+	expMaxBatchSize  setting[int]
+	expMaxBatchBytes setting[int]
+	expBufferSize    setting[int]
 }
 
 func newBatchConfig(options []BatchProcessorOption) batchConfig {
@@ -438,6 +507,9 @@ func newBatchConfig(options []BatchProcessorOption) batchConfig {
 		clampMax[int](c.maxQSize.Value),
 		fallback[int](dfltExpMaxBatchSize),
 	)
+	c.expMaxBatchBytes = c.expMaxBatchBytes.Resolve(
+		clearLessThanOne[int](),
+	)
 	c.expBufferSize = c.expBufferSize.Resolve(
 		clearLessThanOne[int](),
 		fallback[int](dfltExpBufferSize),
@@ -446,6 +518,39 @@ func newBatchConfig(options []BatchProcessorOption) batchConfig {
 	return c
 }
 
+// validateBatchOptions returns the errors of the options that are replaced by
+// their defaults, or clamped, when a batchConfig is resolved. maxQSize is the
+// resolved maximum queue size.
+func validateBatchOptions(options []BatchProcessorOption, maxQSize int) []error {
+	var c batchConfig
+	for _, o := range options {
+		c = o.apply(c)
+	}
+
+	var errs []error
+	positive := func(name string, s setting[int]) {
+		if s.Set && s.Value < 1 {
+			errs = append(errs, fmt.Errorf("non-positive %s: %d", name, s.Value))
+		}
+	}
+	positive("max queue size", c.maxQSize)
+	positive("export max batch size", c.expMaxBatchSize)
+	positive("export buffer size", c.expBufferSize)
+	if c.expInterval.Set && c.expInterval.Value <= 0 {
+		errs = append(errs, fmt.Errorf("non-positive export interval: %s", c.expInterval.Value))
+	}
+	if c.expTimeout.Set && c.expTimeout.Value <= 0 {
+		errs = append(errs, fmt.Errorf("non-positive export timeout: %s", c.expTimeout.Value))
+	}
+	if c.expMaxBatchSize.Set && c.expMaxBatchSize.Value > maxQSize {
+		errs = append(errs, fmt.Errorf(
+			"export max batch size %d greater than max queue size %d",
+			c.expMaxBatchSize.Value, maxQSize,
+		))
+	}
+	return errs
+}
+
 // BatchProcessorOption applies a configuration to a [BatchProcessor].
 type BatchProcessorOption interface {
 	apply(batchConfig) batchConfig
@@ -519,6 +624,25 @@ func WithExportMaxBatchSize(size int) BatchProcessorOption {
 	})
 }
 
+// WithExportMaxBatchBytes sets the maximum estimated size, in bytes, of every
+// export. A batch will be split into multiple exports to not exceed this size,
+// and an export is triggered once the queued log records reach this size. A
+// single log record larger than the size is exported on its own.
+//
+// The size of a log record is estimated from its content and approximates
+// its serialized size. It does not account for the encoding of the resource
+// and instrumentation scope, or for the framing of the export request, so
+// size should leave a margin below the limit enforced by the receiver.
+//
+// By default, or if the provided value is less than one, batches are not
+// limited in bytes.
+func WithExportMaxBatchBytes(size int) BatchProcessorOption {
+	return batchOptionFunc(func(cfg batchConfig) batchConfig {
+		cfg.expMaxBatchBytes = newSetting(size)
+		return cfg
+	})
+}
+
 // WithExportBufferSize sets the batch buffer size.
 // Batches will be temporarily kept in a memory buffer until they are exported.
 //
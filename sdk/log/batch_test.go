@@ -23,6 +23,7 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/log/internal/counter"
@@ -30,6 +31,7 @@ import (
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
@@ -90,14 +92,16 @@ func TestNewBatchConfig(t *testing.T) {
 				WithExportInterval(time.Microsecond),
 				WithExportTimeout(time.Hour),
 				WithExportMaxBatchSize(2),
+				WithExportMaxBatchBytes(1024),
 				WithExportBufferSize(3),
 			},
 			want: batchConfig{
-				maxQSize:        newSetting(10),
-				expInterval:     newSetting(time.Microsecond),
-				expTimeout:      newSetting(time.Hour),
-				expMaxBatchSize: newSetting(2),
-				expBufferSize:   newSetting(3),
+				maxQSize:         newSetting(10),
+				expInterval:      newSetting(time.Microsecond),
+				expTimeout:       newSetting(time.Hour),
+				expMaxBatchSize:  newSetting(2),
+				expMaxBatchBytes: newSetting(1024),
+				expBufferSize:    newSetting(3),
 			},
 		},
 		{
@@ -258,6 +262,38 @@ func TestBatchProcessor(t *testing.T) {
 		assert.GreaterOrEqual(t, e.ExportN(), 10)
 	})
 
+	t.Run("OnEmitMaxBatchBytes", func(t *testing.T) {
+		var r Record
+		r.SetBody(attribute.StringValue(strings.Repeat("x", 100)))
+		size := recordSize(&r)
+
+		e := newTestExporter(nil)
+		b := NewBatchProcessor(
+			e,
+			WithMaxQueueSize(100),
+			WithExportMaxBatchSize(100),
+			WithExportMaxBatchBytes(int(3*size)),
+			WithExportInterval(time.Hour),
+			WithExportTimeout(time.Hour),
+		)
+		for range 2 {
+			assert.NoError(t, b.OnEmit(ctx, &r))
+		}
+		assert.Equal(t, 0, e.ExportN(), "export before batch full in bytes")
+
+		for range 5 {
+			assert.NoError(t, b.OnEmit(ctx, &r))
+		}
+		assert.Eventually(t, func() bool {
+			return e.ExportN() > 0
+		}, 2*time.Second, time.Microsecond, "batch full in bytes not flushed")
+
+		assert.NoError(t, b.Shutdown(ctx))
+		for i, records := range e.Records() {
+			assert.LessOrEqualf(t, len(records), 3, "export %d exceeds bytes", i)
+		}
+	})
+
 	t.Run("RetriggerFlushNonBlocking", func(t *testing.T) {
 		e := newTestExporter(nil)
 		e.ExportTrigger = make(chan struct{})
@@ -601,6 +637,35 @@ func TestQueue(t *testing.T) {
 		assert.Equal(t, []Record{r, r}, q.Flush(), "flushed Records")
 	})
 
+	t.Run("Bytes", func(t *testing.T) {
+		const size = 2
+		q := newQueue(size)
+		assert.Equal(t, int64(0), q.Bytes(), "untracked")
+
+		sizeOf := func(r *Record) int64 { return r.Body().AsInt64() }
+		q.sizeOf = sizeOf
+		rec := func(n int64) Record {
+			var r Record
+			r.SetBody(attribute.Int64Value(n))
+			return r
+		}
+
+		_ = q.Enqueue(rec(1))
+		_ = q.Enqueue(rec(2))
+		assert.Equal(t, int64(3), q.Bytes())
+		_ = q.Enqueue(rec(4))
+		assert.Equal(t, int64(6), q.Bytes(), "overwritten record")
+
+		buf := make([]Record, 1)
+		_ = q.TryDequeue(buf, func([]Record) bool { return false })
+		assert.Equal(t, int64(6), q.Bytes(), "failed dequeue")
+		_ = q.TryDequeue(buf, func([]Record) bool { return true })
+		assert.Equal(t, int64(4), q.Bytes(), "dequeue")
+
+		_ = q.Flush()
+		assert.Equal(t, int64(0), q.Bytes(), "flush")
+	})
+
 	t.Run("Dropped", func(t *testing.T) {
 		q := newQueue(1)
 
@@ -717,6 +782,40 @@ func BenchmarkBatchProcessorOnEmit(b *testing.B) {
 
 const blpComponentID int64 = 0
 
+func TestBatchProcessorSharesScopeAndResource(t *testing.T) {
+	e := newTestExporter(nil)
+	t.Cleanup(e.Stop)
+	b := NewBatchProcessor(e, WithExportInterval(time.Hour))
+
+	res := resource.NewSchemaless(attribute.String("service.name", "svc"))
+	cache := instrumentation.NewScopeCache()
+	var providers []*LoggerProvider
+	for range 2 {
+		p := NewLoggerProvider(WithProcessor(b), WithSharedResource(res), WithScopeCache(cache))
+		providers = append(providers, p)
+		for range 3 {
+			p.Logger("scope").Emit(t.Context(), log.Record{})
+		}
+	}
+	require.NoError(t, b.ForceFlush(t.Context()))
+	for _, p := range providers {
+		require.NoError(t, p.Shutdown(t.Context()))
+	}
+
+	var records []Record
+	for _, batch := range e.Records() {
+		records = append(records, batch...)
+	}
+	require.Len(t, records, 6)
+	for i, r := range records {
+		// Records reference the resource and the scope of their Logger, they
+		// are not copied for each record.
+		assert.Samef(t, res, r.resource, "record %d resource", i)
+		assert.Samef(t, records[i/3*3].scope, r.scope, "record %d scope", i)
+		assert.Equalf(t, *records[0].scope, *r.scope, "record %d scope", i)
+	}
+}
+
 func TestBatchProcessorMetricsDisabled(t *testing.T) {
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "false")
 
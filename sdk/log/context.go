@@ -0,0 +1,175 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Compile-time check ContextProcessor implements Processor.
+var _ Processor = (*ContextProcessor)(nil)
+
+// ContextExtractor returns the attributes to add to the log records emitted
+// with ctx, e.g. the request ID or the user ID stored in ctx by a middleware.
+// It is called synchronously for each log record and must not block.
+type ContextExtractor func(ctx context.Context) []attribute.KeyValue
+
+// ContextProcessor is a processor that adds attributes extracted from the
+// context a log record is emitted with to the log record before it is passed
+// to another processor.
+//
+// Log bridges pass the context of the log calls to the Logger, so the values
+// stored in the context by the application are added to the log records of
+// all the bridges the same way. The attributes already set on a log record,
+// e.g. by the bridge, are kept: an extracted attribute with the same key is
+// not added.
+//
+// Use [NewContextProcessor] to create a ContextProcessor.
+type ContextProcessor struct {
+	processor  Processor
+	extractors []ContextExtractor
+}
+
+// ContextProcessorOption configures a ContextProcessor.
+type ContextProcessorOption interface {
+	applyContext(contextConfig) contextConfig
+}
+
+type contextConfig struct {
+	extractors []ContextExtractor
+}
+
+type contextOptionFunc func(contextConfig) contextConfig
+
+func (fn contextOptionFunc) applyContext(c contextConfig) contextConfig {
+	return fn(c)
+}
+
+// WithContextValue adds the value stored in the context with ctxKey, as
+// returned by [context.Context.Value], as the attribute key of the log
+// records.
+//
+// The string, bool, int, int64, float64, and []string values are added as
+// such, the values implementing [fmt.Stringer] as their String, and the other
+// values as formatted by [fmt.Sprint]. Nothing is added if the context holds
+// no value for ctxKey.
+func WithContextValue(key attribute.Key, ctxKey any) ContextProcessorOption {
+	return WithContextExtractor(func(ctx context.Context) []attribute.KeyValue {
+		v := ctx.Value(ctxKey)
+		if v == nil {
+			return nil
+		}
+		return []attribute.KeyValue{contextValue(key, v)}
+	})
+}
+
+// contextValue returns v as the value of the attribute key.
+func contextValue(key attribute.Key, v any) attribute.KeyValue {
+	switch v := v.(type) {
+	case string:
+		return key.String(v)
+	case bool:
+		return key.Bool(v)
+	case int:
+		return key.Int(v)
+	case int64:
+		return key.Int64(v)
+	case float64:
+		return key.Float64(v)
+	case []string:
+		return key.StringSlice(v)
+	case fmt.Stringer:
+		return key.String(v.String())
+	default:
+		return key.String(fmt.Sprint(v))
+	}
+}
+
+// WithContextExtractor adds the attributes returned by f to the log records.
+// The extractors are called in the order they are passed, the first attribute
+// extracted for a key is added. A nil f is ignored.
+func WithContextExtractor(f ContextExtractor) ContextProcessorOption {
+	return contextOptionFunc(func(c contextConfig) contextConfig {
+		if f != nil {
+			c.extractors = append(c.extractors, f)
+		}
+		return c
+	})
+}
+
+// NewContextProcessor returns a new ContextProcessor that passes the log
+// records to processor once the attributes extracted from their context are
+// added. If processor is nil, no log records are processed.
+func NewContextProcessor(processor Processor, opts ...ContextProcessorOption) *ContextProcessor {
+	var c contextConfig
+	for _, o := range opts {
+		c = o.applyContext(c)
+	}
+	return &ContextProcessor{processor: processor, extractors: c.extractors}
+}
+
+// Enabled returns the result of Enabled of the wrapped processor.
+func (p *ContextProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.processor == nil {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit adds the attributes extracted from ctx to record and passes it to
+// the wrapped processor.
+func (p *ContextProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+	p.enrich(ctx, record)
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped processor.
+func (p *ContextProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped processor.
+func (p *ContextProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// enrich adds the attributes extracted from ctx to r, unless r already has
+// an attribute with the same key.
+func (p *ContextProcessor) enrich(ctx context.Context, r *Record) {
+	var attrs []attribute.KeyValue
+	for _, f := range p.extractors {
+		attrs = append(attrs, f(ctx)...)
+	}
+	if len(attrs) == 0 {
+		return
+	}
+
+	seen := make(map[attribute.Key]struct{}, r.AttributesLen()+len(attrs))
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		seen[kv.Key] = struct{}{}
+		return true
+	})
+	n := 0
+	for _, kv := range attrs {
+		if _, ok := seen[kv.Key]; ok || !kv.Valid() {
+			continue
+		}
+		seen[kv.Key] = struct{}{}
+		attrs[n] = kv
+		n++
+	}
+	r.AddAttributes(attrs[:n]...)
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+type (
+	requestIDKey struct{}
+	userIDKey    struct{}
+	timeoutKey   struct{}
+	countKey     struct{}
+)
+
+func TestContextProcessor(t *testing.T) {
+	next := newProcessor("next")
+	p := NewContextProcessor(
+		next,
+		WithContextValue("request.id", requestIDKey{}),
+		WithContextValue("timeout", timeoutKey{}),
+		WithContextValue("count", countKey{}),
+		WithContextExtractor(nil),
+		WithContextExtractor(func(ctx context.Context) []attribute.KeyValue {
+			if id, ok := ctx.Value(userIDKey{}).(int); ok {
+				return []attribute.KeyValue{
+					attribute.Int("user.id", id),
+					attribute.String("request.id", "ignored"),
+				}
+			}
+			return nil
+		}),
+	)
+
+	ctx := context.WithValue(t.Context(), requestIDKey{}, "abc")
+	ctx = context.WithValue(ctx, userIDKey{}, 42)
+	ctx = context.WithValue(ctx, timeoutKey{}, time.Second)
+	ctx = context.WithValue(ctx, countKey{}, uint8(3))
+
+	emit := func(ctx context.Context, attrs ...attribute.KeyValue) map[attribute.Key]attribute.Value {
+		t.Helper()
+		r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+		r.AddAttributes(attrs...)
+		require.NoError(t, p.OnEmit(ctx, &r))
+		got := make(map[attribute.Key]attribute.Value)
+		next.records[len(next.records)-1].WalkAttributes(func(kv attribute.KeyValue) bool {
+			got[kv.Key] = kv.Value
+			return true
+		})
+		return got
+	}
+
+	assert.Equal(t, map[attribute.Key]attribute.Value{
+		"request.id": attribute.StringValue("abc"),
+		"user.id":    attribute.IntValue(42),
+		"timeout":    attribute.StringValue("1s"),
+		"count":      attribute.StringValue("3"),
+		"msg":        attribute.StringValue("hello"),
+	}, emit(ctx, attribute.String("msg", "hello")))
+
+	assert.Equal(t, map[attribute.Key]attribute.Value{
+		"request.id": attribute.StringValue("bridge"),
+		"user.id":    attribute.IntValue(42),
+		"timeout":    attribute.StringValue("1s"),
+		"count":      attribute.StringValue("3"),
+	}, emit(ctx, attribute.String("request.id", "bridge")), "record attributes kept")
+
+	assert.Empty(t, emit(t.Context()), "no context values")
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestContextProcessorNilProcessor(t *testing.T) {
+	p := NewContextProcessor(nil, WithContextValue("request.id", requestIDKey{}))
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(t.Context(), new(Record)))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
+
+func TestContextProcessorEnabled(t *testing.T) {
+	p := NewContextProcessor(newFltrProcessor("disabled", false))
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityError}))
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
+	otellog "go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/sdk/log"
 )
@@ -200,3 +201,53 @@ func (*RedactTokensProcessor) Shutdown(context.Context) error {
 func (*RedactTokensProcessor) ForceFlush(context.Context) error {
 	return nil
 }
+
+// Drop log records before they are batched and limit the size of exports.
+func ExampleNewFilterProcessor() {
+	// Existing exporter that enforces a request size limit of 4 MiB.
+	var exporter log.Exporter
+
+	// Export batches below the limit of the endpoint, leaving a margin for
+	// the encoding of the request.
+	batch := log.NewBatchProcessor(exporter, log.WithExportMaxBatchBytes(3<<20))
+
+	// Drop debug records and health checks before they are enqueued.
+	processor := log.NewFilterProcessor(
+		batch,
+		log.WithMinSeverity(otellog.SeverityInfo),
+		log.WithDropAttribute(func(kv attribute.KeyValue) bool {
+			return kv.Key == "http.route" && kv.Value.AsString() == "/healthz"
+		}),
+	)
+
+	_ = log.NewLoggerProvider(log.WithProcessor(processor))
+}
+
+// requestIDKey is the context key of the request ID set by an HTTP
+// middleware.
+type requestIDKey struct{}
+
+// Add the request ID and the user ID stored in the context to all the log
+// records, whatever the log bridge used.
+func ExampleNewContextProcessor() {
+	// Existing exporter.
+	var exporter log.Exporter
+
+	processor := log.NewContextProcessor(
+		log.NewBatchProcessor(exporter),
+		log.WithContextValue("http.request.id", requestIDKey{}),
+		log.WithContextExtractor(func(ctx context.Context) []attribute.KeyValue {
+			if user, ok := userFromContext(ctx); ok {
+				return []attribute.KeyValue{attribute.String("user.id", user)}
+			}
+			return nil
+		}),
+	)
+
+	_ = log.NewLoggerProvider(log.WithProcessor(processor))
+}
+
+// userFromContext returns the authenticated user of the request.
+func userFromContext(context.Context) (string, bool) {
+	return "", false
+}
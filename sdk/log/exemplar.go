@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Compile-time check ExemplarProcessor implements Processor.
+var _ Processor = (*ExemplarProcessor)(nil)
+
+// ExemplarRecorder records measurements for an emitted log record, e.g.
+// increments an error counter for an error log record. The measurements are
+// to be recorded with ctx: it holds the span context of the log record, so
+// the exemplars sampled by the metric SDK reference the trace of the log
+// record.
+//
+// It is called synchronously for each log record and must not block. The
+// record must not be modified.
+type ExemplarRecorder func(ctx context.Context, record *Record)
+
+// ExemplarProcessor is a processor that records measurements with the span
+// context of the log records before they are passed to another processor.
+//
+// The metric SDK samples the measurements recorded within a sampled span as
+// exemplars, with the trace ID and span ID of the span. Recording the
+// measurements of the log records with their span context, e.g. counting the
+// error log records, makes the exemplars of the metrics reference the traces
+// the log records are correlated with. Telemetry backends can then pivot
+// from a metric to the log records behind it without joining the signals.
+//
+// The span context of a log record is the one set by its trace ID, span ID,
+// and trace flags, e.g. by a log bridge, or the one of the context it is
+// emitted with otherwise.
+//
+// Use [NewExemplarProcessor] to create an ExemplarProcessor.
+type ExemplarProcessor struct {
+	processor Processor
+	recorders []ExemplarRecorder
+}
+
+// ExemplarProcessorOption configures an ExemplarProcessor.
+type ExemplarProcessorOption interface {
+	applyExemplar(exemplarConfig) exemplarConfig
+}
+
+type exemplarConfig struct {
+	recorders []ExemplarRecorder
+}
+
+type exemplarOptionFunc func(exemplarConfig) exemplarConfig
+
+func (fn exemplarOptionFunc) applyExemplar(c exemplarConfig) exemplarConfig {
+	return fn(c)
+}
+
+// WithExemplarRecorder adds f to the recorders called for the log records.
+// The recorders are called in the order they are passed. A nil f is ignored.
+func WithExemplarRecorder(f ExemplarRecorder) ExemplarProcessorOption {
+	return exemplarOptionFunc(func(c exemplarConfig) exemplarConfig {
+		if f != nil {
+			c.recorders = append(c.recorders, f)
+		}
+		return c
+	})
+}
+
+// WithExemplarCounter adds one to counter, with opts, for each log record
+// with a severity greater than or equal to sev, e.g. [log.SeverityError] to
+// count the error log records. Log records with an undefined severity are
+// not counted. A nil counter is ignored.
+func WithExemplarCounter(counter metric.Int64Counter, sev log.Severity, opts ...metric.AddOption) ExemplarProcessorOption {
+	if counter == nil {
+		return WithExemplarRecorder(nil)
+	}
+	return WithExemplarRecorder(func(ctx context.Context, r *Record) {
+		if s := r.Severity(); s != log.SeverityUndefined && s >= sev {
+			counter.Add(ctx, 1, opts...)
+		}
+	})
+}
+
+// NewExemplarProcessor returns a new ExemplarProcessor that passes the log
+// records to processor once the measurements of its recorders are recorded.
+// If processor is nil, no log records are processed.
+func NewExemplarProcessor(processor Processor, opts ...ExemplarProcessorOption) *ExemplarProcessor {
+	var c exemplarConfig
+	for _, o := range opts {
+		c = o.applyExemplar(c)
+	}
+	return &ExemplarProcessor{processor: processor, recorders: c.recorders}
+}
+
+// Enabled returns the result of Enabled of the wrapped processor.
+func (p *ExemplarProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.processor == nil {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit records the measurements of record with its span context and passes
+// it to the wrapped processor.
+func (p *ExemplarProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+	if len(p.recorders) > 0 {
+		rCtx := recordContext(ctx, record)
+		for _, f := range p.recorders {
+			f(rCtx, record)
+		}
+	}
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped processor.
+func (p *ExemplarProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped processor.
+func (p *ExemplarProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// recordContext returns ctx with the span context of r, if r has a valid
+// trace ID and span ID differing from the ones of ctx.
+func recordContext(ctx context.Context, r *Record) context.Context {
+	traceID, spanID := r.TraceID(), r.SpanID()
+	if !traceID.IsValid() || !spanID.IsValid() {
+		return ctx
+	}
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.TraceID() == traceID && sc.SpanID() == spanID && sc.TraceFlags() == r.TraceFlags() {
+		return ctx
+	}
+	return trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: r.TraceFlags(),
+	}))
+}
@@ -0,0 +1,104 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestExemplarProcessor(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	counter, err := mp.Meter("test").Int64Counter("errors")
+	require.NoError(t, err)
+
+	var recorded []trace.SpanContext
+	next := newProcessor("next")
+	p := NewExemplarProcessor(
+		next,
+		WithExemplarCounter(counter, log.SeverityError),
+		WithExemplarCounter(nil, log.SeverityError),
+		WithExemplarRecorder(nil),
+		WithExemplarRecorder(func(ctx context.Context, _ *Record) {
+			recorded = append(recorded, trace.SpanContextFromContext(ctx))
+		}),
+	)
+
+	ctxSC := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	recordSC := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{2},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(t.Context(), ctxSC)
+
+	emit := func(ctx context.Context, sev log.Severity, sc trace.SpanContext) {
+		t.Helper()
+		var r Record
+		r.SetSeverity(sev)
+		r.SetTraceID(sc.TraceID())
+		r.SetSpanID(sc.SpanID())
+		r.SetTraceFlags(sc.TraceFlags())
+		require.NoError(t, p.OnEmit(ctx, &r))
+	}
+	// exemplar returns the value of the counter and the trace ID of its
+	// last exemplar.
+	exemplar := func() (int64, trace.TraceID) {
+		t.Helper()
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		dp := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64]).DataPoints[0]
+		require.NotEmpty(t, dp.Exemplars)
+		return dp.Value, trace.TraceID(dp.Exemplars[len(dp.Exemplars)-1].TraceID)
+	}
+
+	emit(ctx, log.SeverityError, trace.SpanContext{})
+	v, traceID := exemplar()
+	assert.Equal(t, int64(1), v)
+	assert.Equal(t, ctxSC.TraceID(), traceID, "context span")
+
+	emit(ctx, log.SeverityFatal, recordSC)
+	v, traceID = exemplar()
+	assert.Equal(t, int64(2), v)
+	assert.Equal(t, recordSC.TraceID(), traceID, "record span")
+
+	emit(t.Context(), log.SeverityInfo, recordSC)
+	emit(ctx, log.SeverityUndefined, trace.SpanContext{})
+	v, _ = exemplar()
+	assert.Equal(t, int64(2), v, "non-error records counted")
+
+	assert.Len(t, next.records, 4, "records passed")
+	assert.Equal(t, []trace.SpanContext{ctxSC, recordSC, recordSC, ctxSC}, recorded)
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestExemplarProcessorNilProcessor(t *testing.T) {
+	var called bool
+	p := NewExemplarProcessor(nil, WithExemplarRecorder(func(context.Context, *Record) {
+		called = true
+	}))
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(t.Context(), new(Record)))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+	assert.False(t, called)
+}
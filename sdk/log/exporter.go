@@ -104,6 +104,46 @@ func (c chunkExporter) Export(ctx context.Context, records []Record) error {
 	return nil
 }
 
+// bytesChunkExporter wraps an Exporter's Export method so it is called with
+// export payloads no larger than a defined estimated size in bytes.
+type bytesChunkExporter struct {
+	Exporter
+
+	// size is the maximum estimated size, in bytes, of a batch exported.
+	size int64
+}
+
+// newBytesChunkExporter wraps exporter. Calls to the Export will have their
+// records payload chunked so their estimated size does not exceed size. If
+// size is less than or equal to 0, exporter is returned directly.
+func newBytesChunkExporter(exporter Exporter, size int64) Exporter {
+	if size <= 0 {
+		return exporter
+	}
+	return &bytesChunkExporter{Exporter: exporter, size: size}
+}
+
+// Export exports records in chunks with an estimated size no larger than
+// c.size. A record larger than c.size is exported in its own chunk.
+func (c bytesChunkExporter) Export(ctx context.Context, records []Record) error {
+	var start int
+	var n int64
+	for i := range records {
+		size := recordSize(&records[i])
+		if i > start && n+size > c.size {
+			if err := c.Exporter.Export(ctx, records[start:i]); err != nil {
+				return err
+			}
+			start, n = i, 0
+		}
+		n += size
+	}
+	if start < len(records) {
+		return c.Exporter.Export(ctx, records[start:])
+	}
+	return nil
+}
+
 // timeoutExporter wraps an Exporter and ensures any call to Export will have a
 // timeout for the context.
 type timeoutExporter struct {
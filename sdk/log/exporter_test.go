@@ -8,6 +8,7 @@ import (
 	"io"
 	stdlog "log"
 	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -213,6 +214,46 @@ func TestChunker(t *testing.T) {
 	})
 }
 
+func TestBytesChunker(t *testing.T) {
+	newRecord := func(n int) Record {
+		var r Record
+		r.SetBody(attribute.StringValue(strings.Repeat("x", n)))
+		return r
+	}
+	small, large := newRecord(10), newRecord(100)
+	size := recordSize(&small)
+
+	t.Run("ZeroSize", func(t *testing.T) {
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		assert.Same(t, exp, newBytesChunkExporter(exp, 0))
+	})
+
+	t.Run("Chunk", func(t *testing.T) {
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		c := newBytesChunkExporter(exp, 2*size)
+		assert.NoError(t, c.Export(t.Context(), nil))
+		assert.NoError(t, c.Export(t.Context(), []Record{small, small, small, large, small}))
+
+		wantLens := []int{2, 1, 1, 1}
+		records := exp.Records()
+		require.Len(t, records, len(wantLens), "chunks")
+		for i, n := range wantLens {
+			assert.Lenf(t, records[i], n, "chunk %d", i)
+		}
+	})
+
+	t.Run("ExportError", func(t *testing.T) {
+		exp := newTestExporter(assert.AnError)
+		t.Cleanup(exp.Stop)
+		c := newBytesChunkExporter(exp, size)
+		err := c.Export(t.Context(), []Record{small, small})
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Equal(t, 1, exp.ExportN(), "export after error")
+	})
+}
+
 func TestExportSync(t *testing.T) {
 	eventuallyDone := func(t *testing.T, done chan struct{}) {
 		assert.Eventually(t, func() bool {
@@ -0,0 +1,147 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check FilterProcessor implements Processor.
+var _ Processor = (*FilterProcessor)(nil)
+
+// FilterProcessor is a processor that drops log records before they are
+// passed to another processor.
+//
+// It can be composed with a [BatchProcessor] to drop records before they are
+// enqueued, so dropped records do not use the queue or the export budget.
+//
+// Use [NewFilterProcessor] to create a FilterProcessor.
+type FilterProcessor struct {
+	processor   Processor
+	minSeverity log.Severity
+	drop        []func(attribute.KeyValue) bool
+}
+
+// FilterProcessorOption configures a FilterProcessor.
+type FilterProcessorOption interface {
+	applyFilter(filterConfig) filterConfig
+}
+
+type filterConfig struct {
+	minSeverity log.Severity
+	drop        []func(attribute.KeyValue) bool
+}
+
+type filterOptionFunc func(filterConfig) filterConfig
+
+func (fn filterOptionFunc) applyFilter(c filterConfig) filterConfig {
+	return fn(c)
+}
+
+// WithMinSeverity sets the minimum severity of the log records passed on by a
+// FilterProcessor. Log records with a severity less than sev are dropped.
+// Log records with an undefined severity are not dropped based on their
+// severity.
+//
+// By default, log records are not dropped based on their severity.
+func WithMinSeverity(sev log.Severity) FilterProcessorOption {
+	return filterOptionFunc(func(c filterConfig) filterConfig {
+		c.minSeverity = sev
+		return c
+	})
+}
+
+// WithDropAttribute adds a predicate used by a FilterProcessor to drop log
+// records. A log record is dropped if drop returns true for any of its
+// attributes. If this option is passed multiple times, a log record is dropped
+// if any of the predicates matches one of its attributes.
+//
+// The predicates are called synchronously when a log record is emitted and
+// need to be concurrent safe.
+func WithDropAttribute(drop func(attribute.KeyValue) bool) FilterProcessorOption {
+	return filterOptionFunc(func(c filterConfig) filterConfig {
+		if drop != nil {
+			c.drop = append(c.drop, drop)
+		}
+		return c
+	})
+}
+
+// NewFilterProcessor returns a new FilterProcessor that passes the log
+// records not dropped by the configured filters to processor. If processor
+// is nil, no log records are processed.
+func NewFilterProcessor(processor Processor, opts ...FilterProcessorOption) *FilterProcessor {
+	var c filterConfig
+	for _, o := range opts {
+		c = o.applyFilter(c)
+	}
+	return &FilterProcessor{
+		processor:   processor,
+		minSeverity: c.minSeverity,
+		drop:        c.drop,
+	}
+}
+
+// Enabled returns false if log records with param are dropped by the severity
+// filter. Otherwise, it returns the result of Enabled of the wrapped
+// processor.
+func (p *FilterProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.processor == nil || p.belowMin(param.Severity) {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the wrapped processor unless it is dropped.
+func (p *FilterProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.processor == nil || p.dropped(record) {
+		return nil
+	}
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped processor.
+func (p *FilterProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped processor.
+func (p *FilterProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// belowMin reports whether sev is defined and less than the minimum severity.
+func (p *FilterProcessor) belowMin(sev log.Severity) bool {
+	return belowMinSeverity(sev, p.minSeverity)
+}
+
+// dropped reports whether r is dropped by the filters of p.
+func (p *FilterProcessor) dropped(r *Record) bool {
+	if p.belowMin(r.Severity()) {
+		return true
+	}
+	if len(p.drop) == 0 {
+		return false
+	}
+	var drop bool
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		for _, f := range p.drop {
+			if f(kv) {
+				drop = true
+				return false
+			}
+		}
+		return true
+	})
+	return drop
+}
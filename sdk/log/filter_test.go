@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestFilterProcessor(t *testing.T) {
+	next := newProcessor("next")
+	p := NewFilterProcessor(
+		next,
+		WithMinSeverity(log.SeverityInfo),
+		WithDropAttribute(func(kv attribute.KeyValue) bool {
+			return kv.Key == "health_check" && kv.Value.AsBool()
+		}),
+		WithDropAttribute(nil),
+		WithDropAttribute(func(kv attribute.KeyValue) bool {
+			return kv.Key == "user_agent" && kv.Value.AsString() == "probe"
+		}),
+	)
+
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityDebug}))
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityInfo}))
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}), "undefined severity")
+
+	emit := func(body string, sev log.Severity, attrs ...attribute.KeyValue) {
+		t.Helper()
+		r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+		r.SetBody(attribute.StringValue(body))
+		r.SetSeverity(sev)
+		r.AddAttributes(attrs...)
+		require.NoError(t, p.OnEmit(t.Context(), &r))
+	}
+	emit("debug", log.SeverityDebug)
+	emit("info", log.SeverityInfo)
+	emit("undefined", log.SeverityUndefined)
+	emit("health", log.SeverityError, attribute.String("path", "/"), attribute.Bool("health_check", true))
+	emit("probe", log.SeverityError, attribute.String("user_agent", "probe"))
+	emit("user", log.SeverityError, attribute.Bool("health_check", false), attribute.String("user_agent", "browser"))
+
+	var got []string
+	for _, r := range next.records {
+		got = append(got, r.Body().AsString())
+	}
+	assert.Equal(t, []string{"info", "undefined", "user"}, got)
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestFilterProcessorNilProcessor(t *testing.T) {
+	p := NewFilterProcessor(nil)
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(t.Context(), new(Record)))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
+
+func TestFilterProcessorEnabled(t *testing.T) {
+	p := NewFilterProcessor(newFltrProcessor("disabled", false))
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityError}))
+}
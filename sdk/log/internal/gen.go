@@ -12,3 +12,5 @@ package internal
 //go:generate gotmpl --body=../../../internal/shared/attrnorm/truncate_test.go.tmpl "--data={}" --out=attrnorm/truncate_test.go
 //go:generate gotmpl --body=../../../internal/shared/counter/counter.go.tmpl "--data={ \"pkg\": \"go.opentelemetry.io/otel/sdk/log\" }" --out=counter/counter.go
 //go:generate gotmpl --body=../../../internal/shared/counter/counter_test.go.tmpl "--data={}" --out=counter/counter_test.go
+//go:generate gotmpl --body=../../../internal/shared/volume/volume.go.tmpl "--data={}" --out=volume/volume.go
+//go:generate gotmpl --body=../../../internal/shared/volume/volume_test.go.tmpl "--data={}" --out=volume/volume_test.go
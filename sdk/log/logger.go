@@ -34,6 +34,9 @@ type logger struct {
 
 	provider             *LoggerProvider
 	instrumentationScope instrumentation.Scope
+	// minSeverity is the minimum severity of the log records emitted, set by
+	// the ScopeRule matching instrumentationScope.
+	minSeverity log.Severity
 
 	// recCntIncr increments the count of log records created. It will be nil
 	// if observability is disabled.
@@ -44,6 +47,7 @@ func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
 	l := &logger{
 		provider:             p,
 		instrumentationScope: scope,
+		minSeverity:          minSeverity(p.scopeRules, scope),
 	}
 
 	var err error
@@ -55,6 +59,9 @@ func newLogger(p *LoggerProvider, scope instrumentation.Scope) *logger {
 }
 
 func (l *logger) Emit(ctx context.Context, r log.Record) {
+	if belowMinSeverity(r.Severity(), l.minSeverity) {
+		return
+	}
 	newRecord := l.newRecord(ctx, r)
 	for _, p := range l.provider.processors {
 		if err := p.OnEmit(ctx, &newRecord); err != nil {
@@ -70,6 +77,9 @@ func (l *logger) Emit(ctx context.Context, r log.Record) {
 // processed, true will be returned by default. A value of false will only be
 // returned if it can be positively verified that no Processor will process.
 func (l *logger) Enabled(ctx context.Context, param log.EnabledParameters) bool {
+	if belowMinSeverity(param.Severity, l.minSeverity) {
+		return false
+	}
 	p := EnabledParameters{
 		InstrumentationScope: l.instrumentationScope,
 		Severity:             param.Severity,
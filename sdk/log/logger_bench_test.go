@@ -47,8 +47,16 @@ func BenchmarkLoggerEmit(b *testing.B) {
 		attribute.ByteSlice("k10", []byte{1}),
 	)
 
+	// Bridges may emit records with duplicate keys.
+	rDup := r
+	rDup.AddAttributes(
+		attribute.String("k1", "dup"),
+		attribute.Float64("k2", 2.0),
+	)
+
 	require.Equal(b, 5, r.AttributesLen())
 	require.Equal(b, 10, r10.AttributesLen())
+	require.Equal(b, 7, rDup.AttributesLen())
 
 	b.Run("5 attributes", func(b *testing.B) {
 		b.ReportAllocs()
@@ -67,6 +75,15 @@ func BenchmarkLoggerEmit(b *testing.B) {
 			}
 		})
 	})
+
+	b.Run("5 attributes with 2 duplicates", func(b *testing.B) {
+		b.ReportAllocs()
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				logger.Emit(b.Context(), rDup)
+			}
+		})
+	})
 }
 
 func BenchmarkLoggerEmitObservability(b *testing.B) {
@@ -6,6 +6,8 @@ package log
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
 
@@ -33,6 +35,29 @@ type providerConfig struct {
 	attrCntLim    setting[int]
 	attrValLenLim setting[int]
 	allowDupKeys  setting[bool]
+	scopeCache    *instrumentation.ScopeCache
+	scopeRules    []ScopeRule
+
+	// errs are the errors of the invalid options passed.
+	errs []error
+}
+
+// ErrInvalidConfig is wrapped by the errors of invalid options returned by
+// NewLoggerProviderWithErrors. Use errors.Is to match them.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// validate returns an error joining the errors of the invalid options passed
+// and of the invalid configuration of the registered Processors, if any.
+func (c providerConfig) validate() error {
+	errs := slices.Clone(c.errs)
+	for _, p := range c.processors {
+		if v, ok := p.(interface{ configErr() error }); ok {
+			if err := v.configErr(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
 
 type experimentalOption interface {
@@ -75,6 +100,8 @@ type LoggerProvider struct {
 	attributeCountLimit       int
 	attributeValueLengthLimit int
 	allowDupKeys              bool
+	scopeCache                *instrumentation.ScopeCache
+	scopeRules                []ScopeRule
 
 	loggersMu sync.Mutex
 	loggers   map[instrumentation.Scope]*logger
@@ -94,13 +121,49 @@ var _ log.LoggerProvider = (*LoggerProvider)(nil)
 // created. This means the returned LoggerProvider, one created with no
 // Processors, will perform no operations.
 func NewLoggerProvider(opts ...LoggerProviderOption) *LoggerProvider {
+	return newLoggerProvider(newProviderConfig(opts))
+}
+
+// NewLoggerProviderWithErrors returns a new and configured LoggerProvider,
+// the same as NewLoggerProvider, unless opts are invalid. Instead of ignoring
+// or replacing the invalid values like NewLoggerProvider, it returns an error
+// describing all of them. This can be used to catch misconfiguration, e.g.
+// in tests.
+//
+// The options are invalid if:
+//   - a nil Processor is passed
+//   - the Resource passed to WithResource cannot be merged with the
+//     environment Resource
+//   - a BatchProcessor is created with a nil exporter, a max queue size,
+//     export max batch size, export buffer size, export interval, or export
+//     timeout less than one, or an export max batch size greater than its
+//     max queue size.
+//
+// The returned error wraps ErrInvalidConfig. If an error is returned, the
+// registered Processors are shut down.
+func NewLoggerProviderWithErrors(opts ...LoggerProviderOption) (*LoggerProvider, error) {
 	cfg := newProviderConfig(opts)
+	if err := cfg.validate(); err != nil {
+		for _, p := range cfg.processors {
+			if p != nil {
+				_ = p.Shutdown(context.Background())
+			}
+		}
+		return nil, err
+	}
+	return newLoggerProvider(cfg), nil
+}
+
+// newLoggerProvider returns a LoggerProvider configured with cfg.
+func newLoggerProvider(cfg providerConfig) *LoggerProvider {
 	return &LoggerProvider{
 		resource:                  cfg.resource,
 		processors:                cfg.processors,
 		attributeCountLimit:       cfg.attrCntLim.Value,
 		attributeValueLengthLimit: cfg.attrValLenLim.Value,
 		allowDupKeys:              cfg.allowDupKeys.Value,
+		scopeCache:                cfg.scopeCache,
+		scopeRules:                cfg.scopeRules,
 	}
 }
 
@@ -123,12 +186,12 @@ func (p *LoggerProvider) Logger(name string, opts ...log.LoggerOption) log.Logge
 	if !p.allowDupKeys {
 		attrs, _ = attrnorm.Set(attrs)
 	}
-	scope := instrumentation.Scope{
+	scope := p.scopeCache.Intern(instrumentation.Scope{
 		Name:       name,
 		Version:    cfg.InstrumentationVersion(),
 		SchemaURL:  cfg.SchemaURL(),
 		Attributes: attrs,
-	}
+	})
 
 	p.loggersMu.Lock()
 	defer p.loggersMu.Unlock()
@@ -166,15 +229,38 @@ func (p *LoggerProvider) Shutdown(ctx context.Context) error {
 
 // ForceFlush flushes all processors.
 //
+// The processors are flushed concurrently. If ctx is done before all of them
+// are flushed, ForceFlush returns without waiting for the remaining ones: the
+// returned error joins the error of ctx with the errors of the processors
+// flushed so far. Otherwise, it joins the errors of all the processors.
+//
 // This method can be called concurrently.
 func (p *LoggerProvider) ForceFlush(ctx context.Context) error {
 	if p.stopped.Load() {
 		return nil
 	}
 
+	switch len(p.processors) {
+	case 0:
+		return nil
+	case 1:
+		return p.processors[0].ForceFlush(ctx)
+	}
+
+	// Buffered so the flushes not waited for do not leak goroutines.
+	errCh := make(chan error, len(p.processors))
+	for _, proc := range p.processors {
+		go func() { errCh <- proc.ForceFlush(ctx) }()
+	}
+
 	var err error
-	for _, p := range p.processors {
-		err = errors.Join(err, p.ForceFlush(ctx))
+	for range p.processors {
+		select {
+		case e := <-errCh:
+			err = errors.Join(err, e)
+		case <-ctx.Done():
+			return errors.Join(err, ctx.Err())
+		}
 	}
 	return err
 }
@@ -202,11 +288,51 @@ func WithResource(res *resource.Resource) LoggerProviderOption {
 		cfg.resource, err = resource.Merge(resource.Environment(), res)
 		if err != nil {
 			otel.Handle(err)
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: resource: %w", ErrInvalidConfig, err))
 		}
 		return cfg
 	})
 }
 
+// WithSharedResource associates a Resource with a LoggerProvider as is.
+// Unlike [WithResource], res is not merged with the Resource described by the
+// environment, so the same Resource instance can be shared with other
+// providers instead of each holding a copy.
+//
+// Use [resource.Merge] with [resource.Environment] before sharing res to
+// include the Resource described by the environment. If res is nil, the
+// default Resource is used.
+func WithSharedResource(res *resource.Resource) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.resource = res
+		return cfg
+	})
+}
+
+// WithScopeCache configures the LoggerProvider to intern the instrumentation
+// scopes of the Loggers it creates in c. Share c with the TracerProvider and
+// MeterProvider of the process to have them reference the same scope values.
+func WithScopeCache(c *instrumentation.ScopeCache) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.scopeCache = c
+		return cfg
+	})
+}
+
+// WithScopeRules configures the Loggers of the instrumentation scopes matched
+// by rules. The first rule matching the scope of a Logger, in the order they
+// are passed, applies to the Logger. If this option is passed multiple times,
+// the rules of the earlier calls are matched first.
+//
+// By default, no rules are used and all log records are passed to the
+// Processors.
+func WithScopeRules(rules ...ScopeRule) LoggerProviderOption {
+	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		cfg.scopeRules = append(cfg.scopeRules, rules...)
+		return cfg
+	})
+}
+
 // WithProcessor associates Processor with a LoggerProvider.
 //
 // By default, if this option is not used, the LoggerProvider will perform no
@@ -219,6 +345,9 @@ func WithResource(res *resource.Resource) LoggerProviderOption {
 // For testing and debugging, use [NewSimpleProcessor] to synchronously export log records.
 func WithProcessor(processor Processor) LoggerProviderOption {
 	return loggerProviderOptionFunc(func(cfg providerConfig) providerConfig {
+		if processor == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil Processor", ErrInvalidConfig))
+		}
 		cfg.processors = append(cfg.processors, processor)
 		return cfg
 	})
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/testr"
@@ -20,7 +21,9 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/conformancetest"
 	"go.opentelemetry.io/otel/log/noop"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
 
@@ -250,6 +253,29 @@ func TestWithResource(t *testing.T) {
 	}
 }
 
+func TestRecordDeduplication(t *testing.T) {
+	var r log.Record
+	r.AddAttributes(
+		attribute.String("key", "first"),
+		attribute.String("other", "value"),
+		attribute.String("key", "second"),
+	)
+
+	p := newProcessor("processor")
+	NewLoggerProvider(WithProcessor(p)).Logger(t.Name()).Emit(t.Context(), r)
+
+	require.Len(t, p.records, 1)
+	got := p.records[0]
+	assert.Equal(t, 2, got.AttributesLen())
+	assert.Equal(t, 1, got.DuplicateAttributes())
+	got.WalkAttributes(func(kv attribute.KeyValue) bool {
+		if kv.Key == "key" {
+			assert.Equal(t, "second", kv.Value.AsString(), "last value wins")
+		}
+		return true
+	})
+}
+
 func TestMapDeduplication(t *testing.T) {
 	dup := attribute.Map(
 		"map",
@@ -445,6 +471,62 @@ func TestLoggerProviderForceFlush(t *testing.T) {
 		ctx := t.Context()
 		assert.ErrorIs(t, p.ForceFlush(ctx), assert.AnError, "processor error not returned")
 	})
+
+	t.Run("Concurrent", func(t *testing.T) {
+		// Each processor only returns once all of them are flushing.
+		var wg sync.WaitGroup
+		wg.Add(2)
+		flush := func(context.Context) error {
+			wg.Done()
+			wg.Wait()
+			return nil
+		}
+		p := NewLoggerProvider(
+			WithProcessor(flushProcessor{processor: newProcessor("0"), flush: flush}),
+			WithProcessor(flushProcessor{processor: newProcessor("1"), flush: flush}),
+		)
+
+		done := make(chan error)
+		go func() { done <- p.ForceFlush(t.Context()) }()
+		select {
+		case err := <-done:
+			assert.NoError(t, err)
+		case <-time.After(10 * time.Second):
+			t.Fatal("processors not flushed concurrently")
+		}
+	})
+
+	t.Run("Deadline", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+		blocked := flushProcessor{
+			processor: newProcessor("blocked"),
+			flush: func(context.Context) error {
+				<-release
+				return nil
+			},
+		}
+		failed := newProcessor("failed")
+		failed.Err = assert.AnError
+		p := NewLoggerProvider(WithProcessor(blocked), WithProcessor(failed))
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		err := p.ForceFlush(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded, "context error not returned")
+		assert.ErrorIs(t, err, assert.AnError, "partial processor error not returned")
+	})
+}
+
+// flushProcessor is a processor with a custom ForceFlush.
+type flushProcessor struct {
+	*processor
+
+	flush func(context.Context) error
+}
+
+func (p flushProcessor) ForceFlush(ctx context.Context) error {
+	return p.flush(ctx)
 }
 
 func BenchmarkLoggerProviderLogger(b *testing.B) {
@@ -477,3 +559,116 @@ func TestExperimentalOptionSafe(t *testing.T) {
 
 	assert.NotPanics(t, func() { _ = NewLoggerProvider(opt) })
 }
+
+func TestLoggerProviderScopeCache(t *testing.T) {
+	cache := instrumentation.NewScopeCache()
+	cached := cache.Intern(instrumentation.Scope{Name: "scope"})
+
+	p := NewLoggerProvider(WithScopeCache(cache))
+	assert.Equal(t, cached, p.Logger("scope").(*logger).instrumentationScope)
+	_ = p.Logger("other")
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestWithSharedResource(t *testing.T) {
+	t.Setenv(envVarResourceAttributes, "key=value")
+	res := resource.NewSchemaless(attribute.String("service.name", "shared"))
+
+	p := NewLoggerProvider(WithSharedResource(res))
+	assert.Same(t, res, p.resource)
+
+	p = NewLoggerProvider(WithSharedResource(nil))
+	assert.Equal(t, resource.Default(), p.resource)
+}
+
+func TestNewLoggerProviderWithErrors(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		p := newProcessor("valid")
+		lp, err := NewLoggerProviderWithErrors(
+			WithProcessor(p),
+			WithProcessor(NewBatchProcessor(
+				newTestExporter(nil),
+				WithMaxQueueSize(10),
+				WithExportMaxBatchSize(10),
+			)),
+		)
+		require.NoError(t, err)
+		require.NotNil(t, lp)
+		assert.Equal(t, 0, p.shutdownCalls, "Processor shut down")
+		assert.NoError(t, lp.Shutdown(t.Context()))
+	})
+
+	tests := []struct {
+		name string
+		opt  LoggerProviderOption
+		want []string
+	}{
+		{
+			name: "NilProcessor",
+			opt:  WithProcessor(nil),
+			want: []string{"nil Processor"},
+		},
+		{
+			name: "BatchProcessorNilExporter",
+			opt:  WithProcessor(NewBatchProcessor(nil)),
+			want: []string{"BatchProcessor", "nil exporter"},
+		},
+		{
+			name: "BatchProcessorInvalidOptions",
+			opt: WithProcessor(NewBatchProcessor(
+				newTestExporter(nil),
+				WithMaxQueueSize(0),
+				WithExportInterval(-time.Second),
+				WithExportTimeout(0),
+				WithExportBufferSize(-1),
+			)),
+			want: []string{
+				"non-positive max queue size: 0",
+				"non-positive export interval: -1s",
+				"non-positive export timeout: 0s",
+				"non-positive export buffer size: -1",
+			},
+		},
+		{
+			name: "BatchProcessorBatchGreaterThanQueue",
+			opt: WithProcessor(NewBatchProcessor(
+				newTestExporter(nil),
+				WithMaxQueueSize(10),
+				WithExportMaxBatchSize(20),
+			)),
+			want: []string{"export max batch size 20 greater than max queue size 10"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newProcessor(tt.name)
+			lp, err := NewLoggerProviderWithErrors(WithProcessor(p), tt.opt)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+			assert.Nil(t, lp)
+			for _, want := range tt.want {
+				assert.ErrorContains(t, err, want)
+			}
+			assert.Equal(t, 1, p.shutdownCalls, "Processor not shut down")
+		})
+	}
+}
+
+func TestLoggerProviderConformance(t *testing.T) {
+	newLoggerProvider := func() *LoggerProvider {
+		exp := newTestExporter(nil)
+		t.Cleanup(exp.Stop)
+		lp := NewLoggerProvider(WithProcessor(NewSimpleProcessor(exp)))
+		t.Cleanup(func() {
+			//nolint:usetesting // required to avoid getting a canceled context at cleanup.
+			assert.NoError(t, lp.Shutdown(context.Background()))
+		})
+		return lp
+	}
+
+	conformancetest.RunLoggerProviderTests(t, func() log.LoggerProvider {
+		return newLoggerProvider()
+	})
+	conformancetest.RunLoggerTests(t, func() log.Logger {
+		return newLoggerProvider().Logger("conformance")
+	})
+}
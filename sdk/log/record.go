@@ -27,6 +27,10 @@ const (
 	maxUniqueSize = 1028
 )
 
+// droppedAttributes counts the attributes dropped because of the attribute
+// limits, see global.DropCounter.
+var droppedAttributes = global.NewDropCounter("log_record_attributes", "limit")
+
 var logAttrDropped = sync.OnceFunc(func() {
 	global.Warn("limit reached: dropping log Record attributes")
 })
@@ -105,6 +109,10 @@ type Record struct {
 	// were reached.
 	dropped int
 
+	// duplicates is the count of attributes that have been replaced by a
+	// later attribute with the same key.
+	duplicates int
+
 	traceID    trace.TraceID
 	spanID     trace.SpanID
 	traceFlags trace.TraceFlags
@@ -127,6 +135,7 @@ type Record struct {
 func (r *Record) addDropped(n int) {
 	r.dropped += n
 	if n > 0 {
+		droppedAttributes.Add(int64(n))
 		logAttrDropped()
 	}
 }
@@ -216,6 +225,7 @@ func (r *Record) WalkAttributes(f func(attribute.KeyValue) bool) {
 
 // AddAttributes adds attributes to the log record.
 // Attributes in attrs will overwrite any attribute already added to r with the same key.
+// The overwritten attributes are counted by [Record.DuplicateAttributes].
 func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 	n := r.AttributesLen()
 	if n == 0 {
@@ -224,6 +234,7 @@ func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 		if !r.allowDupKeys {
 			attrs, drop = dedup(attrs)
 			if drop > 0 {
+				r.duplicates += drop
 				logKeyValuePairDropped()
 			}
 		}
@@ -281,6 +292,7 @@ func (r *Record) AddAttributes(attrs ...attribute.KeyValue) {
 		}
 
 		if dropped > 0 {
+			r.duplicates += dropped
 			attrs = make([]attribute.KeyValue, len(*unique))
 			copy(attrs, *unique)
 		}
@@ -340,12 +352,15 @@ func (r *Record) addAttrs(attrs []attribute.KeyValue) {
 }
 
 // SetAttributes sets (and overrides) attributes to the log record.
+// Attributes in attrs are deduplicated with the last value of each key
+// kept, the other values are counted by [Record.DuplicateAttributes].
 func (r *Record) SetAttributes(attrs ...attribute.KeyValue) {
 	var drop int
-	r.dropped = 0
+	r.dropped, r.duplicates = 0, 0
 	if !r.allowDupKeys {
 		attrs, drop = dedup(attrs)
 		if drop > 0 {
+			r.duplicates = drop
 			logKeyValuePairDropped()
 		}
 	}
@@ -423,6 +438,17 @@ func (r *Record) DroppedAttributes() int {
 	return r.dropped
 }
 
+// DuplicateAttributes returns the number of attributes replaced by a later
+// attribute with the same key. The key-value pairs of the same map attribute
+// value with duplicate keys are not counted.
+//
+// Attributes are deduplicated, with the last value of each key kept, unless
+// the Record was emitted by a Logger of a LoggerProvider created with
+// [WithAllowKeyDuplication], in which case zero is returned.
+func (r *Record) DuplicateAttributes() int {
+	return r.duplicates
+}
+
 // TraceID returns the trace ID or empty array.
 func (r *Record) TraceID() trace.TraceID {
 	return r.traceID
@@ -620,6 +620,38 @@ func TestRecordDroppedAttributes(t *testing.T) {
 	}
 }
 
+func TestRecordDuplicateAttributes(t *testing.T) {
+	r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	r.AddAttributes(
+		attribute.String("a", "1"),
+		attribute.String("b", "1"),
+		attribute.String("a", "2"),
+	)
+	assert.Equal(t, 1, r.DuplicateAttributes(), "AddAttributes")
+
+	r.AddAttributes(attribute.String("b", "2"), attribute.String("c", "1"), attribute.String("c", "2"))
+	assert.Equal(t, 3, r.DuplicateAttributes(), "second AddAttributes")
+	assert.Equal(t, 0, r.DroppedAttributes())
+	var got []attribute.KeyValue
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		got = append(got, kv)
+		return true
+	})
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("a", "2"),
+		attribute.String("b", "2"),
+		attribute.String("c", "2"),
+	}, got)
+
+	r.SetAttributes(attribute.String("a", "1"), attribute.String("a", "2"))
+	assert.Equal(t, 1, r.DuplicateAttributes(), "SetAttributes")
+
+	r = Record{attributeCountLimit: -1, attributeValueLengthLimit: -1, allowDupKeys: true}
+	r.AddAttributes(attribute.String("a", "1"), attribute.String("a", "2"))
+	assert.Equal(t, 0, r.DuplicateAttributes(), "duplicates allowed")
+	assert.Equal(t, 2, r.AttributesLen())
+}
+
 func TestRecordZeroAttributeCountLimit(t *testing.T) {
 	attrs := []attribute.KeyValue{
 		attribute.String("one", "1"),
@@ -17,6 +17,8 @@ package log
 type ring struct {
 	next, prev *ring
 	Value      Record
+	// size is the estimated size of Value in bytes, if tracked.
+	size int64
 }
 
 func (r *ring) init() *ring {
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"errors"
+)
+
+// Compile-time check RoutingProcessor implements Processor.
+var _ Processor = (*RoutingProcessor)(nil)
+
+// RoutingProcessor is a processor that routes each log record to one of
+// several processors based on the context the record is emitted with.
+//
+// It can be used to send the telemetry of a request to an exporter selected by
+// a value propagated in the context. For example, to send log records to the
+// exporter of the data-residency region of a request.
+//
+// Use [NewRoutingProcessor] to create a RoutingProcessor.
+type RoutingProcessor struct {
+	key      func(context.Context) string
+	routes   map[string]Processor
+	fallback Processor
+}
+
+// NewRoutingProcessor returns a new RoutingProcessor.
+//
+// When a log record is emitted, key is called with the context the record is
+// emitted with and the record is routed to the processor of routes for the
+// returned key. If routes does not contain the key, the record is routed to
+// fallback. If fallback is nil, records not matching any route are not
+// processed.
+//
+// Shutdown and ForceFlush are called for the processor of every route and for
+// fallback. A processor used for multiple routes needs to handle being shut
+// down multiple times.
+func NewRoutingProcessor(key func(context.Context) string, routes map[string]Processor, fallback Processor) *RoutingProcessor {
+	r := make(map[string]Processor, len(routes))
+	for k, p := range routes {
+		if p != nil {
+			r[k] = p
+		}
+	}
+	return &RoutingProcessor{key: key, routes: r, fallback: fallback}
+}
+
+// route returns the processor for ctx, or nil if there is none.
+func (p *RoutingProcessor) route(ctx context.Context) Processor {
+	if p.key != nil {
+		if proc, ok := p.routes[p.key(ctx)]; ok {
+			return proc
+		}
+	}
+	return p.fallback
+}
+
+// Enabled returns the result of Enabled of the processor ctx is routed to. It
+// returns false if ctx is not routed to any processor.
+func (p *RoutingProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	proc := p.route(ctx)
+	return proc != nil && proc.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the processor ctx is routed to.
+func (p *RoutingProcessor) OnEmit(ctx context.Context, record *Record) error {
+	proc := p.route(ctx)
+	if proc == nil {
+		return nil
+	}
+	return proc.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the processors records are routed to.
+func (p *RoutingProcessor) Shutdown(ctx context.Context) error {
+	return p.each(func(proc Processor) error { return proc.Shutdown(ctx) })
+}
+
+// ForceFlush flushes the processors records are routed to.
+func (p *RoutingProcessor) ForceFlush(ctx context.Context) error {
+	return p.each(func(proc Processor) error { return proc.ForceFlush(ctx) })
+}
+
+// each calls f for the processor of every route and the fallback and returns
+// the joined errors.
+func (p *RoutingProcessor) each(f func(Processor) error) error {
+	var err error
+	for _, proc := range p.routes {
+		err = errors.Join(err, f(proc))
+	}
+	if p.fallback != nil {
+		err = errors.Join(err, f(p.fallback))
+	}
+	return err
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+type regionKey struct{}
+
+func region(ctx context.Context) string {
+	r, _ := ctx.Value(regionKey{}).(string)
+	return r
+}
+
+func TestRoutingProcessor(t *testing.T) {
+	eu := newFltrProcessor("eu", true)
+	us := newFltrProcessor("us", false)
+	fallback := newProcessor("fallback")
+	p := NewRoutingProcessor(region, map[string]Processor{"eu": eu, "us": us}, fallback)
+
+	euCtx := context.WithValue(t.Context(), regionKey{}, "eu")
+	usCtx := context.WithValue(t.Context(), regionKey{}, "us")
+
+	assert.True(t, p.Enabled(euCtx, EnabledParameters{}))
+	assert.False(t, p.Enabled(usCtx, EnabledParameters{}))
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}))
+
+	var r Record
+	r.SetBody(attribute.StringValue("eu"))
+	require.NoError(t, p.OnEmit(euCtx, &r))
+	r.SetBody(attribute.StringValue("us"))
+	require.NoError(t, p.OnEmit(usCtx, &r))
+	r.SetBody(attribute.StringValue("other"))
+	require.NoError(t, p.OnEmit(t.Context(), &r))
+
+	require.Len(t, eu.records, 1)
+	assert.Equal(t, "eu", eu.records[0].Body().AsString())
+	require.Len(t, us.records, 1)
+	assert.Equal(t, "us", us.records[0].Body().AsString())
+	require.Len(t, fallback.records, 1)
+	assert.Equal(t, "other", fallback.records[0].Body().AsString())
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	for _, proc := range []*processor{eu.processor, us.processor, fallback} {
+		assert.Equal(t, 1, proc.forceFlushCalls, proc.Name)
+		assert.Equal(t, 1, proc.shutdownCalls, proc.Name)
+	}
+}
+
+func TestRoutingProcessorNoFallback(t *testing.T) {
+	eu := newProcessor("eu")
+	p := NewRoutingProcessor(region, map[string]Processor{"eu": eu}, nil)
+
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	var r Record
+	require.NoError(t, p.OnEmit(t.Context(), &r))
+	assert.Empty(t, eu.records)
+}
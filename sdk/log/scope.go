@@ -0,0 +1,91 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// ScopeRule configures the Loggers of the instrumentation scopes it matches.
+type ScopeRule struct {
+	// Name is the pattern matched against the name of the instrumentation
+	// scope. The "*" wildcard matches any sequence of characters, including
+	// "/", and "?" matches any single byte. All other characters match
+	// themselves. For example, "github.com/ourorg/*" matches all the scopes
+	// named after a package of the github.com/ourorg organization.
+	//
+	// An empty Name matches all scopes.
+	Name string
+
+	// Attributes are the attributes an instrumentation scope needs to have
+	// to match the rule. A scope matches if it has all of Attributes with
+	// equal values, it may have other attributes.
+	Attributes []attribute.KeyValue
+
+	// MinSeverity is the minimum severity of the log records emitted by the
+	// Loggers of the matching scopes. Log records with a severity less than
+	// MinSeverity are dropped. Log records with an undefined severity are not
+	// dropped based on their severity.
+	MinSeverity log.Severity
+}
+
+// matches reports whether scope matches r.
+func (r ScopeRule) matches(scope instrumentation.Scope) bool {
+	if r.Name != "" && !globMatch(r.Name, scope.Name) {
+		return false
+	}
+	for _, kv := range r.Attributes {
+		v, ok := scope.Attributes.Value(kv.Key)
+		if !ok || v.Type() != kv.Value.Type() || v.Emit() != kv.Value.Emit() {
+			return false
+		}
+	}
+	return true
+}
+
+// globMatch reports whether name matches pattern. The "*" wildcard of pattern
+// matches any sequence of characters and "?" matches any single byte.
+func globMatch(pattern, name string) bool {
+	// Iterative matching with backtracking to the last "*".
+	var p, n int
+	star, next := -1, 0
+	for n < len(name) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == name[n]):
+			p++
+			n++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, n
+			p++
+		case star >= 0:
+			// Have the last "*" match one more character.
+			next++
+			p, n = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+// minSeverity returns the minimum severity of the first rule in rules that
+// matches scope. It returns log.SeverityUndefined if no rule matches.
+func minSeverity(rules []ScopeRule, scope instrumentation.Scope) log.Severity {
+	for _, r := range rules {
+		if r.matches(scope) {
+			return r.MinSeverity
+		}
+	}
+	return log.SeverityUndefined
+}
+
+// belowMinSeverity reports whether sev is defined and less than minimum.
+func belowMinSeverity(sev, minimum log.Severity) bool {
+	return sev != log.SeverityUndefined && sev < minimum
+}
@@ -0,0 +1,128 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern, name string
+		want          bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "github.com/ourorg/pkg", true},
+		{"github.com/ourorg/*", "github.com/ourorg/pkg", true},
+		{"github.com/ourorg/*", "github.com/ourorg/pkg/sub", true},
+		{"github.com/ourorg/*", "github.com/ourorg", false},
+		{"github.com/ourorg/*", "github.com/other/pkg", false},
+		{"github.com/*/pkg", "github.com/ourorg/pkg", true},
+		{"github.com/*/pkg", "github.com/ourorg/pkg/sub", false},
+		{"*/pkg*", "github.com/ourorg/pkg/sub", true},
+		{"v?", "v1", true},
+		{"v?", "v10", false},
+		{"a*b*c", "aXbYbZc", true},
+		{"a*b*c", "aXbYbZ", false},
+		{"exact", "exact", true},
+		{"exact", "exactly", false},
+	}
+	for _, tt := range tests {
+		assert.Equalf(t, tt.want, globMatch(tt.pattern, tt.name), "globMatch(%q, %q)", tt.pattern, tt.name)
+	}
+}
+
+func TestScopeRuleMatches(t *testing.T) {
+	scope := instrumentation.Scope{
+		Name:       "github.com/ourorg/pkg",
+		Attributes: attribute.NewSet(attribute.String("team", "core"), attribute.Int("tier", 1)),
+	}
+
+	tests := []struct {
+		name string
+		rule ScopeRule
+		want bool
+	}{
+		{"Empty", ScopeRule{}, true},
+		{"Name", ScopeRule{Name: "github.com/ourorg/*"}, true},
+		{"NameMismatch", ScopeRule{Name: "github.com/other/*"}, false},
+		{"Attributes", ScopeRule{Attributes: []attribute.KeyValue{attribute.String("team", "core")}}, true},
+		{
+			"NameAndAttributes",
+			ScopeRule{
+				Name:       "github.com/*",
+				Attributes: []attribute.KeyValue{attribute.String("team", "core"), attribute.Int("tier", 1)},
+			},
+			true,
+		},
+		{"AttributeValueMismatch", ScopeRule{Attributes: []attribute.KeyValue{attribute.String("team", "web")}}, false},
+		{"AttributeTypeMismatch", ScopeRule{Attributes: []attribute.KeyValue{attribute.String("tier", "1")}}, false},
+		{"AttributeMissing", ScopeRule{Attributes: []attribute.KeyValue{attribute.String("env", "prod")}}, false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.rule.matches(scope), tt.name)
+	}
+}
+
+func TestLoggerProviderWithScopeRules(t *testing.T) {
+	proc := newProcessor("proc")
+	p := NewLoggerProvider(
+		WithProcessor(proc),
+		WithScopeRules(
+			ScopeRule{Name: "github.com/ourorg/noisy", MinSeverity: log.SeverityError},
+			ScopeRule{Name: "github.com/ourorg/*", MinSeverity: log.SeverityWarn},
+		),
+		WithScopeRules(ScopeRule{
+			Attributes:  []attribute.KeyValue{attribute.Bool("debug", true)},
+			MinSeverity: log.SeverityTrace,
+		}),
+	)
+
+	emit := func(l log.Logger, sev log.Severity) {
+		var r log.Record
+		r.SetSeverity(sev)
+		l.Emit(t.Context(), r)
+	}
+
+	noisy := p.Logger("github.com/ourorg/noisy")
+	assert.False(t, noisy.Enabled(t.Context(), log.EnabledParameters{Severity: log.SeverityWarn}))
+	assert.True(t, noisy.Enabled(t.Context(), log.EnabledParameters{Severity: log.SeverityError}))
+	emit(noisy, log.SeverityWarn)
+	emit(noisy, log.SeverityError)
+
+	org := p.Logger("github.com/ourorg/pkg")
+	assert.False(t, org.Enabled(t.Context(), log.EnabledParameters{Severity: log.SeverityInfo}))
+	assert.True(t, org.Enabled(t.Context(), log.EnabledParameters{}), "undefined severity")
+	emit(org, log.SeverityInfo)
+	emit(org, log.SeverityWarn)
+	emit(org, log.SeverityUndefined)
+
+	debug := p.Logger("other", log.WithInstrumentationAttributes(attribute.Bool("debug", true)))
+	emit(debug, log.SeverityTrace)
+
+	other := p.Logger("other")
+	emit(other, log.SeverityTrace)
+
+	var got []string
+	for _, r := range proc.records {
+		got = append(got, r.InstrumentationScope().Name+":"+r.Severity().String())
+	}
+	want := []string{
+		"github.com/ourorg/noisy:ERROR",
+		"github.com/ourorg/pkg:WARN",
+		"github.com/ourorg/pkg:UNDEFINED",
+		"other:TRACE",
+		"other:TRACE",
+	}
+	require.Equal(t, want, got)
+}
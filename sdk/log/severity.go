@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+// Compile-time check SeverityProcessor implements Processor.
+var _ Processor = (*SeverityProcessor)(nil)
+
+// defaultSeverityTexts are the severity texts mapped to a severity by default,
+// in addition to the canonical texts of the severities.
+var defaultSeverityTexts = map[string]log.Severity{
+	"WARNING":  log.SeverityWarn,
+	"ERR":      log.SeverityError,
+	"CRITICAL": log.SeverityFatal,
+}
+
+// SeverityProcessor is a processor that normalizes the severity of log
+// records before they are passed to another processor.
+//
+// Log bridges populate the severity and the severity text of log records
+// inconsistently, while backends often key on one or the other. The
+// SeverityProcessor sets the severity text of a log record with a defined
+// severity to the canonical text of the severity, e.g. "WARN" for
+// [log.SeverityWarn1] and "ERROR2" for [log.SeverityError2]. The severity of
+// a log record with an undefined severity is set from its severity text if
+// the text is mapped to a severity, and its severity text is normalized.
+//
+// The canonical texts are mapped to their severity case-insensitively, as
+// are "WARNING", "ERR", and "CRITICAL". Use [WithSeverityTexts] to map other
+// texts.
+//
+// Use [NewSeverityProcessor] to create a SeverityProcessor.
+type SeverityProcessor struct {
+	processor Processor
+	severity  map[string]log.Severity
+}
+
+// SeverityProcessorOption configures a SeverityProcessor.
+type SeverityProcessorOption interface {
+	applySeverity(severityConfig) severityConfig
+}
+
+type severityConfig struct {
+	texts map[string]log.Severity
+}
+
+type severityOptionFunc func(severityConfig) severityConfig
+
+func (fn severityOptionFunc) applySeverity(c severityConfig) severityConfig {
+	return fn(c)
+}
+
+// WithSeverityTexts maps the severity texts of m to their severity. A
+// SeverityProcessor sets the severity of log records with an undefined
+// severity and one of these texts, compared case-insensitively. The mappings
+// of m replace the default ones for the same text. If this option is passed
+// multiple times, the mappings are merged.
+func WithSeverityTexts(m map[string]log.Severity) SeverityProcessorOption {
+	return severityOptionFunc(func(c severityConfig) severityConfig {
+		if c.texts == nil {
+			c.texts = make(map[string]log.Severity, len(m))
+		}
+		for text, sev := range m {
+			c.texts[strings.ToUpper(text)] = sev
+		}
+		return c
+	})
+}
+
+// NewSeverityProcessor returns a new SeverityProcessor that passes the log
+// records to processor once their severity is normalized. If processor is
+// nil, no log records are processed.
+func NewSeverityProcessor(processor Processor, opts ...SeverityProcessorOption) *SeverityProcessor {
+	var c severityConfig
+	for _, o := range opts {
+		c = o.applySeverity(c)
+	}
+
+	severity := maps.Clone(defaultSeverityTexts)
+	for sev := log.SeverityTrace1; sev <= log.SeverityFatal4; sev++ {
+		severity[sev.String()] = sev
+	}
+	maps.Copy(severity, c.texts)
+
+	return &SeverityProcessor{processor: processor, severity: severity}
+}
+
+// Enabled returns the result of Enabled of the wrapped processor.
+func (p *SeverityProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.processor == nil {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit normalizes the severity of record and passes it to the wrapped
+// processor.
+func (p *SeverityProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+	p.normalize(record)
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped processor.
+func (p *SeverityProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped processor.
+func (p *SeverityProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// normalize sets the severity and the severity text of r consistently.
+func (p *SeverityProcessor) normalize(r *Record) {
+	sev := r.Severity()
+	if sev == log.SeverityUndefined {
+		text := r.SeverityText()
+		if text == "" {
+			return
+		}
+		var ok bool
+		if sev, ok = p.severity[strings.ToUpper(strings.TrimSpace(text))]; !ok {
+			return
+		}
+		r.SetSeverity(sev)
+	}
+	if sev < log.SeverityTrace1 || sev > log.SeverityFatal4 {
+		// Not a severity of the specification, it has no canonical text.
+		return
+	}
+	r.SetSeverityText(sev.String())
+}
@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestSeverityProcessor(t *testing.T) {
+	next := newProcessor("next")
+	p := NewSeverityProcessor(
+		next,
+		WithSeverityTexts(map[string]log.Severity{"notice": log.SeverityInfo2}),
+		WithSeverityTexts(map[string]log.Severity{"Critical": log.SeverityFatal2}),
+	)
+
+	type severity struct {
+		number log.Severity
+		text   string
+	}
+	tests := []struct {
+		name string
+		in   severity
+		want severity
+	}{
+		{"Empty", severity{}, severity{}},
+		{"Number", severity{log.SeverityWarn1, ""}, severity{log.SeverityWarn1, "WARN"}},
+		{"NumberOverridesText", severity{log.SeverityError2, "warning"}, severity{log.SeverityError2, "ERROR2"}},
+		{"Text", severity{log.SeverityUndefined, "debug3"}, severity{log.SeverityDebug3, "DEBUG3"}},
+		{"TextSpaces", severity{log.SeverityUndefined, " Info "}, severity{log.SeverityInfo, "INFO"}},
+		{"DefaultAlias", severity{log.SeverityUndefined, "Warning"}, severity{log.SeverityWarn, "WARN"}},
+		{"CustomAlias", severity{log.SeverityUndefined, "NOTICE"}, severity{log.SeverityInfo2, "INFO2"}},
+		{"ReplacedAlias", severity{log.SeverityUndefined, "critical"}, severity{log.SeverityFatal2, "FATAL2"}},
+		{"UnknownText", severity{log.SeverityUndefined, "verbose"}, severity{log.SeverityUndefined, "verbose"}},
+		{"UnknownNumber", severity{log.Severity(42), "custom"}, severity{log.Severity(42), "custom"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next.records = nil
+
+			var r Record
+			r.SetSeverity(tt.in.number)
+			r.SetSeverityText(tt.in.text)
+			require.NoError(t, p.OnEmit(t.Context(), &r))
+
+			require.Len(t, next.records, 1)
+			got := next.records[0]
+			assert.Equal(t, tt.want, severity{got.Severity(), got.SeverityText()})
+		})
+	}
+
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}))
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestSeverityProcessorNilProcessor(t *testing.T) {
+	p := NewSeverityProcessor(nil)
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(t.Context(), new(Record)))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
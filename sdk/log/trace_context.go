@@ -0,0 +1,154 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Compile-time check TraceContextProcessor implements Processor.
+var _ Processor = (*TraceContextProcessor)(nil)
+
+// TraceContextProcessor is a processor that handles log records based on the
+// trace context they are emitted within before they are passed to another
+// processor.
+//
+// The trace context of a log record is the one set by the Logger from the
+// context the record is emitted with. Log records emitted within a sampled
+// span can be given attributes derived from the kind of the span, see
+// [WithSpanKindAttributes], and log records of unsampled traces can be
+// dropped, see [WithDropUnsampled].
+//
+// Use [NewTraceContextProcessor] to create a TraceContextProcessor.
+type TraceContextProcessor struct {
+	processor     Processor
+	kindAttrs     func(trace.SpanKind) []attribute.KeyValue
+	dropUnsampled bool
+}
+
+// TraceContextOption configures a TraceContextProcessor.
+type TraceContextOption interface {
+	applyTraceContext(traceContextConfig) traceContextConfig
+}
+
+type traceContextConfig struct {
+	kindAttrs     func(trace.SpanKind) []attribute.KeyValue
+	dropUnsampled bool
+}
+
+type traceContextOptionFunc func(traceContextConfig) traceContextConfig
+
+func (fn traceContextOptionFunc) applyTraceContext(c traceContextConfig) traceContextConfig {
+	return fn(c)
+}
+
+// WithSpanKindAttributes sets a function returning the attributes added to
+// the log records emitted within a sampled span of the given kind, e.g. an
+// event domain attribute for server spans. The attributes are only added if
+// the span exposes its kind, as the spans of the OpenTelemetry SDK do.
+//
+// The function is called synchronously when a log record is emitted and
+// needs to be concurrent safe.
+//
+// By default, no attributes are added.
+func WithSpanKindAttributes(f func(kind trace.SpanKind) []attribute.KeyValue) TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		c.kindAttrs = f
+		return c
+	})
+}
+
+// WithDropUnsampled sets a TraceContextProcessor to drop the log records of
+// unsampled traces: those whose trace context is valid but not sampled. Log
+// records without a trace context are not dropped.
+//
+// Unlike the severity filter of a [FilterProcessor], this drops records based
+// on the sampling decision of the trace they belong to, so the logs of a
+// trace are exported if and only if its spans are.
+//
+// By default, log records are not dropped.
+func WithDropUnsampled() TraceContextOption {
+	return traceContextOptionFunc(func(c traceContextConfig) traceContextConfig {
+		c.dropUnsampled = true
+		return c
+	})
+}
+
+// NewTraceContextProcessor returns a new TraceContextProcessor that passes
+// the log records not dropped to processor. If processor is nil, no
+// log records are processed.
+func NewTraceContextProcessor(processor Processor, opts ...TraceContextOption) *TraceContextProcessor {
+	var c traceContextConfig
+	for _, o := range opts {
+		c = o.applyTraceContext(c)
+	}
+	return &TraceContextProcessor{
+		processor:     processor,
+		kindAttrs:     c.kindAttrs,
+		dropUnsampled: c.dropUnsampled,
+	}
+}
+
+// Enabled returns false if log records emitted with ctx are dropped because
+// the span in ctx is not sampled. Otherwise, it returns the result of Enabled
+// of the wrapped processor.
+func (p *TraceContextProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	if p.processor == nil {
+		return false
+	}
+	if p.dropUnsampled && unsampled(trace.SpanContextFromContext(ctx)) {
+		return false
+	}
+	return p.processor.Enabled(ctx, param)
+}
+
+// OnEmit passes record to the wrapped processor unless it is dropped, adding
+// the attributes derived from the kind of the span in ctx if record belongs
+// to it.
+func (p *TraceContextProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.processor == nil {
+		return nil
+	}
+
+	sampled := record.TraceFlags().IsSampled()
+	if p.dropUnsampled && record.TraceID().IsValid() && !sampled {
+		return nil
+	}
+
+	if p.kindAttrs != nil && sampled {
+		span := trace.SpanFromContext(ctx)
+		sc := span.SpanContext()
+		if s, ok := span.(interface{ SpanKind() trace.SpanKind }); ok &&
+			sc.TraceID() == record.TraceID() && sc.SpanID() == record.SpanID() {
+			if attrs := p.kindAttrs(s.SpanKind()); len(attrs) > 0 {
+				record.AddAttributes(attrs...)
+			}
+		}
+	}
+	return p.processor.OnEmit(ctx, record)
+}
+
+// Shutdown shuts down the wrapped processor.
+func (p *TraceContextProcessor) Shutdown(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped processor.
+func (p *TraceContextProcessor) ForceFlush(ctx context.Context) error {
+	if p.processor == nil {
+		return nil
+	}
+	return p.processor.ForceFlush(ctx)
+}
+
+// unsampled reports whether sc is valid and not sampled.
+func unsampled(sc trace.SpanContext) bool {
+	return sc.IsValid() && !sc.IsSampled()
+}
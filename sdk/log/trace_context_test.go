@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// kindSpan is a span exposing its kind as the spans of the SDK do.
+type kindSpan struct {
+	noop.Span
+
+	sc   trace.SpanContext
+	kind trace.SpanKind
+}
+
+func (s kindSpan) SpanContext() trace.SpanContext { return s.sc }
+
+func (s kindSpan) SpanKind() trace.SpanKind { return s.kind }
+
+func TestTraceContextProcessor(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceContextProcessor(
+		next,
+		WithDropUnsampled(),
+		WithSpanKindAttributes(func(kind trace.SpanKind) []attribute.KeyValue {
+			if kind != trace.SpanKindServer {
+				return nil
+			}
+			return []attribute.KeyValue{attribute.String("event.domain", "http")}
+		}),
+	)
+
+	sampled := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	unsampledSC := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{2},
+		SpanID:  trace.SpanID{2},
+	})
+	server := trace.ContextWithSpan(t.Context(), kindSpan{sc: sampled, kind: trace.SpanKindServer})
+	client := trace.ContextWithSpan(t.Context(), kindSpan{sc: sampled, kind: trace.SpanKindClient})
+	dropped := trace.ContextWithSpan(t.Context(), kindSpan{sc: unsampledSC, kind: trace.SpanKindServer})
+
+	assert.True(t, p.Enabled(server, EnabledParameters{}))
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{}), "no span")
+	assert.False(t, p.Enabled(dropped, EnabledParameters{}))
+
+	// The Logger sets the trace context of the records.
+	l := NewLoggerProvider(WithProcessor(p)).Logger(t.Name())
+	emit := func(ctx context.Context, body string) {
+		var r log.Record
+		r.SetBody(attribute.StringValue(body))
+		l.Emit(ctx, r)
+	}
+	emit(server, "server")
+	emit(client, "client")
+	emit(dropped, "unsampled")
+	emit(t.Context(), "no span")
+	emit(trace.ContextWithSpanContext(t.Context(), sampled), "no kind")
+
+	require.Len(t, next.records, 4)
+	var got []string
+	for _, r := range next.records {
+		got = append(got, r.Body().AsString())
+	}
+	assert.Equal(t, []string{"server", "client", "no span", "no kind"}, got)
+
+	r := next.records[0]
+	assert.Equal(t, sampled.TraceID(), r.TraceID())
+	assert.Equal(t, sampled.SpanID(), r.SpanID())
+	assert.True(t, r.TraceFlags().IsSampled())
+	assert.Equal(t, 1, r.AttributesLen())
+	for _, r := range next.records[1:] {
+		assert.Equal(t, 0, r.AttributesLen(), r.Body().AsString())
+	}
+	assert.False(t, next.records[2].TraceID().IsValid())
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 1, next.forceFlushCalls)
+	assert.Equal(t, 1, next.shutdownCalls)
+}
+
+func TestTraceContextProcessorUsesRecordContext(t *testing.T) {
+	next := newProcessor("next")
+	p := NewTraceContextProcessor(
+		next,
+		WithDropUnsampled(),
+		WithSpanKindAttributes(func(trace.SpanKind) []attribute.KeyValue {
+			return []attribute.KeyValue{attribute.String("event.domain", "http")}
+		}),
+	)
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpan(t.Context(), kindSpan{sc: sc, kind: trace.SpanKindServer})
+
+	// The trace context of the record takes precedence over the one of ctx.
+	var r Record
+	r.SetTraceID(trace.TraceID{2})
+	r.SetSpanID(trace.SpanID{2})
+	require.NoError(t, p.OnEmit(ctx, &r))
+	assert.Empty(t, next.records, "record of unsampled trace dropped")
+
+	r.SetTraceFlags(trace.FlagsSampled)
+	require.NoError(t, p.OnEmit(ctx, &r))
+	require.Len(t, next.records, 1)
+	assert.Equal(t, trace.TraceID{2}, next.records[0].TraceID())
+	assert.Equal(t, 0, next.records[0].AttributesLen(), "attributes of another span")
+}
+
+func TestTraceContextProcessorNilProcessor(t *testing.T) {
+	p := NewTraceContextProcessor(nil)
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{}))
+	assert.NoError(t, p.OnEmit(t.Context(), &Record{}))
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.NoError(t, p.Shutdown(t.Context()))
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/internal/volume"
+)
+
+// VolumeUsage is the volume of log records exported within a window of a
+// VolumeGuard.
+type VolumeUsage struct {
+	// Bytes is the estimated size, in bytes, of the log records exported
+	// within the window.
+	Bytes int64
+	// Budget is the size, in bytes, of log records that can be exported
+	// within the window without exceeding the budget.
+	Budget int64
+	// Start is the start time of the window.
+	Start time.Time
+	// Window is the duration of the window.
+	Window time.Duration
+}
+
+// Exceeded reports whether the exported volume exceeds the budget.
+func (u VolumeUsage) Exceeded() bool {
+	return u.Bytes > u.Budget
+}
+
+// VolumeGuardOption configures a VolumeGuard.
+type VolumeGuardOption interface {
+	applyVolumeGuard(volumeGuardConfig) volumeGuardConfig
+}
+
+type volumeGuardConfig struct {
+	window   time.Duration
+	exceeded func(VolumeUsage)
+}
+
+type volumeGuardOptionFunc func(volumeGuardConfig) volumeGuardConfig
+
+func (fn volumeGuardOptionFunc) applyVolumeGuard(c volumeGuardConfig) volumeGuardConfig {
+	return fn(c)
+}
+
+// WithVolumeWindow sets the duration of the windows the budget of a
+// VolumeGuard applies to. A value less than or equal to zero means the
+// default is used.
+//
+// By default, the budget applies to windows of one minute.
+func WithVolumeWindow(d time.Duration) VolumeGuardOption {
+	return volumeGuardOptionFunc(func(c volumeGuardConfig) volumeGuardConfig {
+		c.window = d
+		return c
+	})
+}
+
+// WithVolumeExceeded sets a function called with the usage of a window when
+// the exported volume first exceeds the budget within the window. The
+// function is called synchronously by the exporter and must not block.
+func WithVolumeExceeded(f func(VolumeUsage)) VolumeGuardOption {
+	return volumeGuardOptionFunc(func(c volumeGuardConfig) volumeGuardConfig {
+		c.exceeded = f
+		return c
+	})
+}
+
+// VolumeGuard tracks the volume of exported log records and enforces a budget
+// of bytes per window of time.
+//
+// The volume is tracked by wrapping an Exporter with [VolumeGuard.Exporter].
+// The size of exported log records is estimated from their content, it
+// approximates the size of the records once serialized. The budget is
+// enforced by dropping low severity log records once it is exceeded, see
+// [VolumeGuard.Processor].
+//
+// Use [NewVolumeGuard] to create a VolumeGuard.
+type VolumeGuard struct {
+	tracker *volume.Tracker
+}
+
+// NewVolumeGuard returns a new VolumeGuard enforcing a budget of bytes of log
+// records exported per window.
+func NewVolumeGuard(budget int64, opts ...VolumeGuardOption) *VolumeGuard {
+	var c volumeGuardConfig
+	for _, opt := range opts {
+		c = opt.applyVolumeGuard(c)
+	}
+	var exceeded func(volume.Usage)
+	if c.exceeded != nil {
+		exceeded = func(u volume.Usage) { c.exceeded(VolumeUsage(u)) }
+	}
+	return &VolumeGuard{tracker: volume.NewTracker(budget, c.window, exceeded)}
+}
+
+// Usage returns the usage of the current window.
+func (g *VolumeGuard) Usage() VolumeUsage {
+	return VolumeUsage(g.tracker.Usage())
+}
+
+// Exceeded reports whether the budget of the current window is exceeded.
+func (g *VolumeGuard) Exceeded() bool {
+	return g.tracker.Usage().Exceeded()
+}
+
+// record adds n bytes to the volume of the current window.
+func (g *VolumeGuard) record(n int64) {
+	g.tracker.Record(n)
+}
+
+// Exporter returns an Exporter that exports log records with exporter and
+// records their estimated size in the volume tracked by g. Log records are
+// exported regardless of the budget.
+func (g *VolumeGuard) Exporter(exporter Exporter) Exporter {
+	return &volumeGuardExporter{Exporter: exporter, guard: g}
+}
+
+type volumeGuardExporter struct {
+	Exporter
+
+	guard *VolumeGuard
+}
+
+func (e *volumeGuardExporter) Export(ctx context.Context, records []Record) error {
+	var n int64
+	for i := range records {
+		n += recordSize(&records[i])
+	}
+	e.guard.record(n)
+	return e.Exporter.Export(ctx, records)
+}
+
+// Processor returns a Processor that passes log records to processor, except
+// for the log records with a severity lower than minSeverity while the budget
+// of g is exceeded. Log records with an undefined severity are considered to
+// have the lowest severity.
+func (g *VolumeGuard) Processor(processor Processor, minSeverity log.Severity) Processor {
+	return &volumeGuardProcessor{Processor: processor, guard: g, min: minSeverity}
+}
+
+type volumeGuardProcessor struct {
+	Processor
+
+	guard *VolumeGuard
+	min   log.Severity
+}
+
+func (p *volumeGuardProcessor) dropped(severity log.Severity) bool {
+	return severity < p.min && p.guard.Exceeded()
+}
+
+func (p *volumeGuardProcessor) Enabled(ctx context.Context, param EnabledParameters) bool {
+	return !p.dropped(param.Severity) && p.Processor.Enabled(ctx, param)
+}
+
+func (p *volumeGuardProcessor) OnEmit(ctx context.Context, record *Record) error {
+	if p.dropped(record.Severity()) {
+		return nil
+	}
+	return p.Processor.OnEmit(ctx, record)
+}
+
+// recordFixedSize is the size, in bytes, of the timestamps, severity, IDs,
+// and flags of a log record.
+const recordFixedSize = 8 + 8 + 4 + 16 + 8 + 1
+
+// recordSize returns the estimated size, in bytes, of r once serialized.
+func recordSize(r *Record) int64 {
+	n := int64(recordFixedSize + len(r.SeverityText()) + len(r.EventName()))
+	n += volume.ValueSize(r.Body())
+	r.WalkAttributes(func(kv attribute.KeyValue) bool {
+		n += int64(len(kv.Key)) + volume.ValueSize(kv.Value)
+		return true
+	})
+	return n
+}
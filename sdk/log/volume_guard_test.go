@@ -0,0 +1,84 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package log
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+func TestVolumeGuard(t *testing.T) {
+	var exceeded []VolumeUsage
+	g := NewVolumeGuard(200, WithVolumeExceeded(func(u VolumeUsage) {
+		exceeded = append(exceeded, u)
+	}))
+	now := time.Unix(0, 0)
+	g.tracker.Now = func() time.Time { return now }
+
+	e := newTestExporter(nil)
+	t.Cleanup(e.Stop)
+	exp := g.Exporter(e)
+
+	r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	r.SetBody(attribute.StringValue("message"))
+	r.AddAttributes(attribute.String("key", "value"))
+	size := recordSize(&r)
+	require.Positive(t, size)
+
+	require.NoError(t, exp.Export(t.Context(), []Record{r}))
+	assert.Equal(t, size, g.Usage().Bytes)
+	assert.False(t, g.Exceeded())
+
+	for range 200 / size {
+		require.NoError(t, exp.Export(t.Context(), []Record{r}))
+	}
+	assert.True(t, g.Exceeded())
+	assert.Equal(t, 1+int(200/size), e.ExportN(), "records not exported over budget")
+	require.Len(t, exceeded, 1, "exceeded callback not called once")
+	assert.Equal(t, int64(200), exceeded[0].Budget)
+
+	now = now.Add(time.Minute)
+	assert.False(t, g.Exceeded(), "budget not reset for new window")
+	assert.Equal(t, now, g.Usage().Start)
+}
+
+func TestVolumeGuardProcessor(t *testing.T) {
+	g := NewVolumeGuard(0)
+	proc := newProcessor("guarded")
+	p := g.Processor(proc, log.SeverityWarn)
+
+	var debug, warn Record
+	debug.SetSeverity(log.SeverityDebug)
+	warn.SetSeverity(log.SeverityWarn)
+
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityDebug}))
+	require.NoError(t, p.OnEmit(t.Context(), &debug))
+	require.Len(t, proc.records, 1)
+
+	g.record(1)
+	assert.False(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityDebug}))
+	assert.True(t, p.Enabled(t.Context(), EnabledParameters{Severity: log.SeverityWarn}))
+	require.NoError(t, p.OnEmit(t.Context(), &debug))
+	require.NoError(t, p.OnEmit(t.Context(), &warn))
+	require.Len(t, proc.records, 2)
+	assert.Equal(t, log.SeverityWarn, proc.records[1].Severity())
+}
+
+func TestRecordSize(t *testing.T) {
+	r := Record{attributeCountLimit: -1, attributeValueLengthLimit: -1}
+	base := recordSize(&r)
+	assert.Equal(t, int64(recordFixedSize), base)
+
+	r.SetSeverityText("INFO")
+	r.SetBody(attribute.StringValue("message"))
+	r.AddAttributes(attribute.Int("int", 1), attribute.StringSlice("slice", []string{"a", "bc"}))
+	want := base + 4 + 7 + (3 + 8) + (5 + 3)
+	assert.Equal(t, want, recordSize(&r))
+}
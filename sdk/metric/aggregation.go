@@ -169,6 +169,22 @@ func (e AggregationBase2ExponentialHistogram) copy() Aggregation {
 	return e
 }
 
+// withNoMinMax returns a copy of agg that does not record the min and max of
+// the distribution if it is a histogram aggregation. Otherwise, agg is
+// returned.
+func withNoMinMax(agg Aggregation) Aggregation {
+	switch a := agg.(type) {
+	case AggregationExplicitBucketHistogram:
+		h := a.copy().(AggregationExplicitBucketHistogram)
+		h.NoMinMax = true
+		return h
+	case AggregationBase2ExponentialHistogram:
+		a.NoMinMax = true
+		return a
+	}
+	return agg
+}
+
 const (
 	expoMaxScale = 20
 	expoMinScale = -10
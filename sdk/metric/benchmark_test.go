@@ -12,6 +12,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -517,6 +518,46 @@ func BenchmarkExemplars(b *testing.B) {
 	})
 }
 
+func BenchmarkHistogramRecordCtxless(b *testing.B) {
+	ctx := trace.ContextWithSpanContext(b.Context(), sampledSpanContext)
+	attr := metric.WithAttributeSet(attribute.NewSet(attribute.String("user", "Alice")))
+
+	mp := NewMeterProvider(WithReader(NewManualReader()))
+	m := mp.Meter("BenchmarkHistogramRecordCtxless")
+
+	iHist, err := m.Int64Histogram("int64-histogram")
+	require.NoError(b, err)
+	b.Run("Int64Histogram/Record", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			iHist.Record(ctx, 1, attr)
+		}
+	})
+	iCtxless := iHist.(x.Int64HistogramCtxless)
+	b.Run("Int64Histogram/RecordCtxless", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			iCtxless.RecordCtxless(1, attr)
+		}
+	})
+
+	fHist, err := m.Float64Histogram("float64-histogram")
+	require.NoError(b, err)
+	b.Run("Float64Histogram/Record", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			fHist.Record(ctx, 1, attr)
+		}
+	})
+	fCtxless := fHist.(x.Float64HistogramCtxless)
+	b.Run("Float64Histogram/RecordCtxless", func(b *testing.B) {
+		b.ReportAllocs()
+		for b.Loop() {
+			fCtxless.RecordCtxless(1, attr)
+		}
+	})
+}
+
 func newRM(a metricdata.Aggregation) *metricdata.ResourceMetrics {
 	return &metricdata.ResourceMetrics{
 		ScopeMetrics: []metricdata.ScopeMetrics{
@@ -681,6 +722,60 @@ func BenchmarkEndToEndCounterAdd(b *testing.B) {
 						})
 					})
 					// This case shows the performance of our API + SDK when
+					// recording varying attributes by passing attribute.Set
+					// built with a pooled attribute.SetBuilder.
+					b.Run("Dynamic/SetBuilder", func(b *testing.B) {
+						counter := testCounter(b, mp.provider())
+						b.ReportAllocs()
+						builderPool := sync.Pool{
+							New: func() any { return new(attribute.SetBuilder) },
+						}
+						optionPool := sync.Pool{
+							New: func() any {
+								return metric.WithAttributeSet(*attribute.EmptySet())
+							},
+						}
+						b.RunParallel(func(pb *testing.PB) {
+							for pb.Next() {
+								// Wrap in a function so we can use defer.
+								func() {
+									builder := builderPool.Get().(*attribute.SetBuilder)
+									defer func() {
+										builder.Reset()
+										builderPool.Put(builder)
+									}()
+									attrsSlice := attrPool.Get().(*[]attribute.KeyValue)
+									defer func() {
+										clear(*attrsSlice)
+										*attrsSlice = (*attrsSlice)[:0] // Reset.
+										attrPool.Put(attrsSlice)
+									}()
+									*attrsSlice = appendAttributes(*attrsSlice, attrsLen)
+									builder.Add(*attrsSlice...)
+									addOpt := addOptPool.Get().(*[]metric.AddOption)
+									defer func() {
+										clear(*addOpt)
+										*addOpt = (*addOpt)[:0]
+										addOptPool.Put(addOpt)
+									}()
+
+									set := builder.Set()
+									opt := optionPool.Get().(metric.MeasurementOption)
+									defer optionPool.Put(opt)
+
+									if s, ok := opt.(x.Settable[attribute.Set]); ok {
+										s.Set(set)
+									} else {
+										opt = metric.WithAttributeSet(set)
+									}
+
+									*addOpt = append(*addOpt, opt.(metric.AddOption))
+									counter.Add(ctx, 1, *addOpt...)
+								}()
+							}
+						})
+					})
+					// This case shows the performance of our API + SDK when
 					// following our contributor guidance for recording
 					// varying attributes by passing []attribute.KeyValue:
 					// https://github.com/open-telemetry/opentelemetry-go/blob/main/CONTRIBUTING.md#attribute-and-option-allocation-management
@@ -52,6 +52,19 @@ func (c *cache[K, V]) HasKey(key K) bool {
 	return ok
 }
 
+// Values returns the values stored in the cache, in no particular order.
+//
+// Values is safe to call concurrently.
+func (c *cache[K, V]) Values() []V {
+	c.Lock()
+	defer c.Unlock()
+	vals := make([]V, 0, len(c.data))
+	for _, v := range c.data {
+		vals = append(vals, v)
+	}
+	return vals
+}
+
 // cacheWithErr is a locking storage used to quickly return already computed values and an error.
 //
 // The zero value of a cacheWithErr is empty and ready to use.
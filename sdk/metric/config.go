@@ -6,12 +6,15 @@ package metric
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -19,14 +22,40 @@ import (
 // config contains configuration options for a MeterProvider.
 type config struct {
 	res              *resource.Resource
+	refreshingRes    *resource.Refreshing
 	readers          []Reader
 	views            []View
 	exemplarFilter   exemplar.Filter
 	cardinalityLimit int
+	invalidAction    InvalidMeasurementAction
+	unitValidation   UnitValidation
+	scopeCache       *instrumentation.ScopeCache
+	derived          []DerivedGauge
+
+	// errs are the errors of the invalid options passed.
+	errs []error
 }
 
 const defaultCardinalityLimit = 2000
 
+// ErrInvalidConfig is wrapped by the errors of invalid options returned by
+// NewMeterProviderWithErrors. Use errors.Is to match them.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
+// validate returns an error joining the errors of the invalid options passed
+// and of the invalid configuration of the registered Readers, if any.
+func (c config) validate() error {
+	errs := slices.Clone(c.errs)
+	for _, r := range c.readers {
+		if v, ok := r.(interface{ configErr() error }); ok {
+			if err := v.configErr(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // readerSignals returns a force-flush and shutdown function for a
 // MeterProvider to call in their respective options. All Readers c contains
 // will have their force-flush and shutdown methods unified into returned
@@ -118,7 +147,50 @@ func WithResource(res *resource.Resource) Option {
 		conf.res, err = resource.Merge(resource.Environment(), res)
 		if err != nil {
 			otel.Handle(err)
+			conf.errs = append(conf.errs, fmt.Errorf("%w: resource: %w", ErrInvalidConfig, err))
+		}
+		return conf
+	})
+}
+
+// WithSharedResource associates a Resource with a MeterProvider as is. Unlike
+// [WithResource], res is not merged with the Resource described by the
+// environment, so the same Resource instance can be shared with other
+// providers instead of each holding a copy.
+//
+// Use [resource.Merge] with [resource.Environment] before sharing res to
+// include the Resource described by the environment. If res is nil, the
+// default Resource is used.
+func WithSharedResource(res *resource.Resource) Option {
+	return optionFunc(func(conf config) config {
+		if res == nil {
+			res = resource.Default()
 		}
+		conf.res = res
+		return conf
+	})
+}
+
+// WithRefreshingResource associates the Resource of r with a MeterProvider.
+// The Resource is read from r each time metrics are collected, so attributes
+// that change during the lifetime of the process are reported with their
+// current value, within the TTL of r.
+//
+// This takes precedence over the Resource configured with [WithResource] or
+// [WithSharedResource].
+func WithRefreshingResource(r *resource.Refreshing) Option {
+	return optionFunc(func(conf config) config {
+		conf.refreshingRes = r
+		return conf
+	})
+}
+
+// WithScopeCache configures the MeterProvider to intern the instrumentation
+// scopes of the Meters it creates in c. Share c with the TracerProvider and
+// LoggerProvider of the process to have them reference the same scope values.
+func WithScopeCache(c *instrumentation.ScopeCache) Option {
+	return optionFunc(func(conf config) config {
+		conf.scopeCache = c
 		return conf
 	})
 }
@@ -130,6 +202,7 @@ func WithResource(res *resource.Resource) Option {
 func WithReader(r Reader) Option {
 	return optionFunc(func(cfg config) config {
 		if r == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil Reader", ErrInvalidConfig))
 			return cfg
 		}
 		cfg.readers = append(cfg.readers, r)
@@ -164,6 +237,9 @@ func WithView(views ...View) Option {
 // [exemplar.AlwaysOffFilter].
 func WithExemplarFilter(filter exemplar.Filter) Option {
 	return optionFunc(func(cfg config) config {
+		if filter == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil exemplar Filter", ErrInvalidConfig))
+		}
 		cfg.exemplarFilter = filter
 		return cfg
 	})
@@ -189,6 +265,34 @@ func WithCardinalityLimit(limit int) Option {
 	})
 }
 
+// WithInvalidMeasurementAction sets the action the MeterProvider takes when an
+// instrument makes an invalid measurement. See [InvalidMeasurementAction] for
+// what measurements are considered invalid.
+//
+// Invalid measurements can corrupt aggregations. For example, a single NaN
+// measurement makes the sum of a histogram NaN until it is reset.
+//
+// By default, if this option is not used, invalid measurements are recorded
+// unchanged ([InvalidMeasurementRecord]).
+func WithInvalidMeasurementAction(action InvalidMeasurementAction) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.invalidAction = action
+		return cfg
+	})
+}
+
+// WithUnitValidation sets how the MeterProvider validates the units of the
+// instruments it creates. See [UnitValidation] for the validations.
+//
+// By default, if this option is not used, units are not validated
+// ([UnitValidationNone]).
+func WithUnitValidation(v UnitValidation) Option {
+	return optionFunc(func(cfg config) config {
+		cfg.unitValidation = v
+		return cfg
+	})
+}
+
 func meterProviderOptionsFromEnv() []Option {
 	var opts []Option
 	// https://github.com/open-telemetry/opentelemetry-specification/blob/d4b241f451674e8f611bb589477680341006ad2b/specification/configuration/sdk-environment-variables.md#exemplar
@@ -25,6 +25,8 @@ type reader struct {
 	temporalityFunc          TemporalitySelector
 	aggregationFunc          AggregationSelector
 	cardinalityLimitSelector CardinalityLimitSelector
+	invalidSelector          InvalidMeasurementSelector
+	filter                   attribute.Filter
 	collectFunc              func(context.Context, *metricdata.ResourceMetrics) error
 	forceFlushFunc           func(context.Context) error
 	shutdownFunc             func(context.Context) error
@@ -54,6 +56,15 @@ func (r *reader) cardinalityLimit(kind InstrumentKind) (int, bool) {
 	return 0, true
 }
 
+func (r *reader) invalidMeasurement(kind InstrumentKind) (InvalidMeasurementAction, bool) {
+	if r.invalidSelector != nil {
+		return r.invalidSelector(kind)
+	}
+	return InvalidMeasurementDefault, true
+}
+
+func (r *reader) attributeFilter() attribute.Filter { return r.filter }
+
 func (r *reader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
 	return r.collectFunc(ctx, rm)
 }
@@ -0,0 +1,372 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// DeltaConverter converts consecutive collections of cumulative metric data
+// into delta metric data, for the exporters of backends only accepting delta
+// temporality. It holds the last cumulative value of each series it converts.
+//
+// The delta of a series is the difference between its cumulative value and
+// its previous one, from the time of the previous collection. A series is
+// reset, its cumulative value being reported as the delta since its start
+// time, if its start time changes, if its value decreases while monotonic, or
+// if the boundaries of its histogram change.
+//
+// The first cumulative value of a series is reported as the delta since its
+// start time. A series missing from 10 consecutive collections is forgotten,
+// its cumulative value is reported as the delta since its start time if it
+// is reported again.
+//
+// The cumulative sums, histograms, and exponential histograms are converted.
+// The other metric data is not modified. The minimum and maximum of the
+// converted histograms are unset: they cannot be computed for an interval.
+//
+// The zero value is ready to use. A DeltaConverter is safe for concurrent use.
+type DeltaConverter struct {
+	mu     sync.Mutex
+	gen    uint64
+	series map[seriesKey]*seriesState
+}
+
+// deltaSeriesRetention is the number of consecutive conversions a series can
+// be missing from before a DeltaConverter forgets it.
+const deltaSeriesRetention = 10
+
+// seriesKey identifies a series converted by a DeltaConverter.
+type seriesKey struct {
+	scope instrumentation.Scope
+	name  string
+	attrs attribute.Distinct
+}
+
+// seriesState is the last cumulative value of a series.
+type seriesState struct {
+	// gen is the generation of the last conversion the series was seen in.
+	gen   uint64
+	start time.Time
+	time  time.Time
+	// value is an N, a histogramState[N], or an expHistogramState[N].
+	value any
+}
+
+type histogramState[N int64 | float64] struct {
+	count  uint64
+	sum    N
+	bounds []float64
+	counts []uint64
+}
+
+type expHistogramState[N int64 | float64] struct {
+	count         uint64
+	sum           N
+	scale         int32
+	zeroCount     uint64
+	zeroThreshold float64
+	positive      metricdata.ExponentialBucket
+	negative      metricdata.ExponentialBucket
+}
+
+// Convert converts the cumulative metric data of rm into delta metric data,
+// in place.
+func (c *DeltaConverter) Convert(rm *metricdata.ResourceMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.series == nil {
+		c.series = make(map[seriesKey]*seriesState)
+	}
+	c.gen++
+	for i := range rm.ScopeMetrics {
+		sm := &rm.ScopeMetrics[i]
+		for j := range sm.Metrics {
+			m := &sm.Metrics[j]
+			conv := seriesConverter{c: c, scope: sm.Scope, name: m.Name}
+			switch v := m.Data.(type) {
+			case metricdata.Sum[int64]:
+				m.Data = convertSum(conv, v)
+			case metricdata.Sum[float64]:
+				m.Data = convertSum(conv, v)
+			case metricdata.Histogram[int64]:
+				m.Data = convertHistogram(conv, v)
+			case metricdata.Histogram[float64]:
+				m.Data = convertHistogram(conv, v)
+			case metricdata.ExponentialHistogram[int64]:
+				m.Data = convertExpHistogram(conv, v)
+			case metricdata.ExponentialHistogram[float64]:
+				m.Data = convertExpHistogram(conv, v)
+			}
+		}
+	}
+	for k, s := range c.series {
+		if c.gen-s.gen >= deltaSeriesRetention {
+			delete(c.series, k)
+		}
+	}
+}
+
+// seriesConverter converts the series of a metric.
+type seriesConverter struct {
+	c     *DeltaConverter
+	scope instrumentation.Scope
+	name  string
+}
+
+// state returns the state of the series with attrs, and its previous value.
+// The state is updated with start and t, its value is to be set by the
+// caller. The previous value of an unknown series is zero.
+func (conv seriesConverter) state(attrs attribute.Set, start, t time.Time) (s *seriesState, prev seriesState) {
+	key := seriesKey{scope: conv.scope, name: conv.name, attrs: attrs.Equivalent()}
+	s, ok := conv.c.series[key]
+	if !ok {
+		s = new(seriesState)
+		conv.c.series[key] = s
+	}
+	prev = *s
+	s.gen, s.start, s.time = conv.c.gen, start, t
+	return s, prev
+}
+
+func convertSum[N int64 | float64](conv seriesConverter, s metricdata.Sum[N]) metricdata.Sum[N] {
+	if s.Temporality != metricdata.CumulativeTemporality {
+		return s
+	}
+	for i := range s.DataPoints {
+		dp := &s.DataPoints[i]
+		state, prev := conv.state(dp.Attributes, dp.StartTime, dp.Time)
+		state.value = dp.Value
+		v, ok := prev.value.(N)
+		if ok && prev.start.Equal(dp.StartTime) && (!s.IsMonotonic || dp.Value >= v) {
+			dp.Value -= v
+			dp.StartTime = prev.time
+		}
+	}
+	s.Temporality = metricdata.DeltaTemporality
+	return s
+}
+
+func convertHistogram[N int64 | float64](conv seriesConverter, h metricdata.Histogram[N]) metricdata.Histogram[N] {
+	if h.Temporality != metricdata.CumulativeTemporality {
+		return h
+	}
+	for i := range h.DataPoints {
+		dp := &h.DataPoints[i]
+		state, prev := conv.state(dp.Attributes, dp.StartTime, dp.Time)
+		state.value = histogramState[N]{
+			count:  dp.Count,
+			sum:    dp.Sum,
+			bounds: slices.Clone(dp.Bounds),
+			counts: slices.Clone(dp.BucketCounts),
+		}
+		if v, ok := prev.value.(histogramState[N]); ok && prev.start.Equal(dp.StartTime) && subtractHistogram(dp, v) {
+			dp.StartTime = prev.time
+		}
+	}
+	h.Temporality = metricdata.DeltaTemporality
+	return h
+}
+
+// subtractHistogram subtracts prev from dp and returns true, or returns false
+// and leaves dp unchanged if dp is not a continuation of prev.
+func subtractHistogram[N int64 | float64](dp *metricdata.HistogramDataPoint[N], prev histogramState[N]) bool {
+	if dp.Count < prev.count || !slices.Equal(dp.Bounds, prev.bounds) ||
+		len(dp.BucketCounts) != len(prev.counts) {
+		return false
+	}
+	for i, n := range prev.counts {
+		if dp.BucketCounts[i] < n {
+			return false
+		}
+	}
+	// Do not modify the bucket counts of the collected data in place, they
+	// may be held by the caller.
+	counts := make([]uint64, len(dp.BucketCounts))
+	for i, n := range prev.counts {
+		counts[i] = dp.BucketCounts[i] - n
+	}
+	dp.BucketCounts = counts
+	dp.Count -= prev.count
+	dp.Sum -= prev.sum
+	dp.Min, dp.Max = metricdata.Extrema[N]{}, metricdata.Extrema[N]{}
+	return true
+}
+
+func convertExpHistogram[N int64 | float64](
+	conv seriesConverter,
+	h metricdata.ExponentialHistogram[N],
+) metricdata.ExponentialHistogram[N] {
+	if h.Temporality != metricdata.CumulativeTemporality {
+		return h
+	}
+	for i := range h.DataPoints {
+		dp := &h.DataPoints[i]
+		state, prev := conv.state(dp.Attributes, dp.StartTime, dp.Time)
+		state.value = expHistogramState[N]{
+			count:         dp.Count,
+			sum:           dp.Sum,
+			scale:         dp.Scale,
+			zeroCount:     dp.ZeroCount,
+			zeroThreshold: dp.ZeroThreshold,
+			positive:      cloneBucket(dp.PositiveBucket),
+			negative:      cloneBucket(dp.NegativeBucket),
+		}
+		if v, ok := prev.value.(expHistogramState[N]); ok && prev.start.Equal(dp.StartTime) && subtractExpHistogram(dp, v) {
+			dp.StartTime = prev.time
+		}
+	}
+	h.Temporality = metricdata.DeltaTemporality
+	return h
+}
+
+// subtractExpHistogram subtracts prev from dp and returns true, or returns
+// false and leaves dp unchanged if dp is not a continuation of prev. The
+// buckets are downscaled to the lowest scale of dp and prev.
+func subtractExpHistogram[N int64 | float64](
+	dp *metricdata.ExponentialHistogramDataPoint[N],
+	prev expHistogramState[N],
+) bool {
+	if dp.Count < prev.count || dp.ZeroCount < prev.zeroCount || dp.ZeroThreshold != prev.zeroThreshold {
+		return false
+	}
+	scale := min(dp.Scale, prev.scale)
+	positive, ok := subtractBucket(
+		downscaleBucket(dp.PositiveBucket, dp.Scale-scale),
+		downscaleBucket(prev.positive, prev.scale-scale),
+	)
+	if !ok {
+		return false
+	}
+	negative, ok := subtractBucket(
+		downscaleBucket(dp.NegativeBucket, dp.Scale-scale),
+		downscaleBucket(prev.negative, prev.scale-scale),
+	)
+	if !ok {
+		return false
+	}
+	dp.Scale = scale
+	dp.PositiveBucket, dp.NegativeBucket = positive, negative
+	dp.Count -= prev.count
+	dp.ZeroCount -= prev.zeroCount
+	dp.Sum -= prev.sum
+	dp.Min, dp.Max = metricdata.Extrema[N]{}, metricdata.Extrema[N]{}
+	return true
+}
+
+func cloneBucket(b metricdata.ExponentialBucket) metricdata.ExponentialBucket {
+	return metricdata.ExponentialBucket{Offset: b.Offset, Counts: slices.Clone(b.Counts)}
+}
+
+// downscaleBucket returns the counts of b downscaled by the scale difference
+// by, in a new bucket.
+func downscaleBucket(b metricdata.ExponentialBucket, by int32) metricdata.ExponentialBucket {
+	if by == 0 || len(b.Counts) == 0 {
+		return cloneBucket(b)
+	}
+	// The arithmetic right shift rounds the negative indexes down, as
+	// required.
+	offset := b.Offset >> by
+	last := (b.Offset + int32(len(b.Counts)) - 1) >> by // nolint: gosec  // Bucket counts length fits in an int32.
+	counts := make([]uint64, last-offset+1)
+	for i, n := range b.Counts {
+		counts[((b.Offset+int32(i))>>by)-offset] += n // nolint: gosec  // Bucket index fits in an int32.
+	}
+	return metricdata.ExponentialBucket{Offset: offset, Counts: counts}
+}
+
+// subtractBucket returns cur minus prev, and whether each count of prev is
+// less than or equal to the count of cur at the same index. Both buckets have
+// the same scale.
+func subtractBucket(cur, prev metricdata.ExponentialBucket) (metricdata.ExponentialBucket, bool) {
+	for i, n := range prev.Counts {
+		if n == 0 {
+			continue
+		}
+		j := int(prev.Offset) + i - int(cur.Offset)
+		if j < 0 || j >= len(cur.Counts) || cur.Counts[j] < n {
+			return metricdata.ExponentialBucket{}, false
+		}
+		cur.Counts[j] -= n
+	}
+	return cur, true
+}
+
+// deltaReader is a Reader converting the cumulative metric data it collects
+// into delta metric data.
+type deltaReader struct {
+	Reader
+
+	converter DeltaConverter
+}
+
+// NewDeltaReader returns a Reader collecting the metric data of r and
+// converting its cumulative metric data into delta metric data with a
+// [DeltaConverter]. It is meant for the exporters collecting metric data with
+// a Reader configured with the cumulative temporality, e.g. to export the
+// same data to backends accepting different temporalities.
+//
+// Only the metric data returned by the Collect method of the returned Reader
+// is converted. Use it with a pull Reader, like a [ManualReader]. A
+// [PeriodicReader] exports the metric data it collects without calling the
+// returned Reader, use [NewDeltaExporter] to wrap its Exporter instead.
+//
+// The metric data of the collections returning an error without any scope
+// metrics is not converted.
+func NewDeltaReader(r Reader) Reader {
+	return &deltaReader{Reader: r}
+}
+
+// Collect gathers and returns the metric data of the wrapped Reader, the
+// cumulative metric data converted into delta metric data.
+func (r *deltaReader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	err := r.Reader.Collect(ctx, rm)
+	if err != nil && len(rm.ScopeMetrics) == 0 {
+		return err
+	}
+	r.converter.Convert(rm)
+	return err
+}
+
+// deltaExporter is an Exporter converting the cumulative metric data it
+// exports into delta metric data.
+type deltaExporter struct {
+	Exporter
+
+	converter DeltaConverter
+}
+
+// NewDeltaExporter returns an Exporter exporting the metric data with
+// exporter once its cumulative metric data is converted into delta metric
+// data with a [DeltaConverter]. It is meant to be used with a
+// [PeriodicReader] for the exporters of backends only accepting delta
+// temporality, the state of the conversion being held by the returned
+// Exporter.
+//
+// The returned Exporter selects the cumulative temporality for all instrument
+// kinds so the Reader collects the cumulative metric data to convert. The
+// aggregations are selected by exporter.
+func NewDeltaExporter(exporter Exporter) Exporter {
+	return &deltaExporter{Exporter: exporter}
+}
+
+// Temporality returns CumulativeTemporality for all instrument kinds.
+func (*deltaExporter) Temporality(InstrumentKind) metricdata.Temporality {
+	return metricdata.CumulativeTemporality
+}
+
+// Export converts the cumulative metric data of rm into delta metric data and
+// exports it with the wrapped Exporter.
+func (e *deltaExporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	e.converter.Convert(rm)
+	return e.Exporter.Export(ctx, rm)
+}
@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+var (
+	deltaStart = time.Unix(1000, 0)
+	deltaAttrs = attribute.NewSet(attribute.String("key", "value"))
+)
+
+func deltaRM(data metricdata.Aggregation) *metricdata.ResourceMetrics {
+	return &metricdata.ResourceMetrics{ScopeMetrics: []metricdata.ScopeMetrics{{
+		Scope:   instrumentation.Scope{Name: "test"},
+		Metrics: []metricdata.Metrics{{Name: "metric", Data: data}},
+	}}}
+}
+
+func convertSumPoint(c *DeltaConverter, monotonic bool, start, t time.Time, v int64) metricdata.DataPoint[int64] {
+	rm := deltaRM(metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: monotonic,
+		DataPoints: []metricdata.DataPoint[int64]{
+			{Attributes: deltaAttrs, StartTime: start, Time: t, Value: v},
+		},
+	})
+	c.Convert(rm)
+	sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	if sum.Temporality != metricdata.DeltaTemporality || len(sum.DataPoints) != 1 {
+		panic("invalid conversion")
+	}
+	return sum.DataPoints[0]
+}
+
+func TestDeltaConverterSum(t *testing.T) {
+	var c DeltaConverter
+	t1, t2, t3, t4 := deltaStart.Add(time.Second), deltaStart.Add(2*time.Second),
+		deltaStart.Add(3*time.Second), deltaStart.Add(4*time.Second)
+
+	dp := convertSumPoint(&c, true, deltaStart, t1, 5)
+	assert.Equal(t, int64(5), dp.Value, "first value")
+	assert.Equal(t, deltaStart, dp.StartTime)
+
+	dp = convertSumPoint(&c, true, deltaStart, t2, 8)
+	assert.Equal(t, int64(3), dp.Value)
+	assert.Equal(t, t1, dp.StartTime)
+	assert.Equal(t, t2, dp.Time)
+
+	dp = convertSumPoint(&c, true, deltaStart, t3, 2)
+	assert.Equal(t, int64(2), dp.Value, "monotonic decrease is a reset")
+	assert.Equal(t, deltaStart, dp.StartTime)
+
+	dp = convertSumPoint(&c, true, t3, t4, 4)
+	assert.Equal(t, int64(4), dp.Value, "new start time is a reset")
+	assert.Equal(t, t3, dp.StartTime)
+
+	var nonMonotonic DeltaConverter
+	convertSumPoint(&nonMonotonic, false, deltaStart, t1, 5)
+	dp = convertSumPoint(&nonMonotonic, false, deltaStart, t2, 2)
+	assert.Equal(t, int64(-3), dp.Value)
+}
+
+func TestDeltaConverterRetention(t *testing.T) {
+	var c DeltaConverter
+	convertSumPoint(&c, true, deltaStart, deltaStart.Add(time.Second), 5)
+	empty := &metricdata.ResourceMetrics{}
+	for range deltaSeriesRetention - 1 {
+		c.Convert(empty)
+	}
+	dp := convertSumPoint(&c, true, deltaStart, deltaStart.Add(2*time.Second), 8)
+	assert.Equal(t, int64(3), dp.Value, "series forgotten")
+
+	for range deltaSeriesRetention {
+		c.Convert(empty)
+	}
+	assert.Empty(t, c.series)
+}
+
+func TestDeltaConverterUnconverted(t *testing.T) {
+	var c DeltaConverter
+	data := []metricdata.Aggregation{
+		metricdata.Gauge[int64]{DataPoints: []metricdata.DataPoint[int64]{{Attributes: deltaAttrs, Value: 5}}},
+		metricdata.Sum[float64]{
+			Temporality: metricdata.DeltaTemporality,
+			DataPoints:  []metricdata.DataPoint[float64]{{Attributes: deltaAttrs, Value: 5}},
+		},
+	}
+	for _, d := range data {
+		for range 2 {
+			rm := deltaRM(d)
+			c.Convert(rm)
+			metricdatatest.AssertAggregationsEqual(t, d, rm.ScopeMetrics[0].Metrics[0].Data)
+		}
+	}
+}
+
+func TestDeltaConverterHistogram(t *testing.T) {
+	var c DeltaConverter
+	t1, t2, t3 := deltaStart.Add(time.Second), deltaStart.Add(2*time.Second), deltaStart.Add(3*time.Second)
+	convert := func(ti time.Time, bounds []float64, counts []uint64, sum float64) metricdata.HistogramDataPoint[float64] {
+		var count uint64
+		for _, n := range counts {
+			count += n
+		}
+		rm := deltaRM(metricdata.Histogram[float64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[float64]{{
+				Attributes:   deltaAttrs,
+				StartTime:    deltaStart,
+				Time:         ti,
+				Count:        count,
+				Bounds:       bounds,
+				BucketCounts: counts,
+				Min:          metricdata.NewExtrema(1.),
+				Max:          metricdata.NewExtrema(20.),
+				Sum:          sum,
+			}},
+		})
+		c.Convert(rm)
+		h := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+		require.Equal(t, metricdata.DeltaTemporality, h.Temporality)
+		return h.DataPoints[0]
+	}
+
+	convert(t1, []float64{10}, []uint64{1, 2}, 30)
+	dp := convert(t2, []float64{10}, []uint64{3, 2}, 34)
+	assert.Equal(t, t1, dp.StartTime)
+	assert.Equal(t, uint64(2), dp.Count)
+	assert.Equal(t, []uint64{2, 0}, dp.BucketCounts)
+	assert.Equal(t, 4., dp.Sum)
+	_, ok := dp.Min.Value()
+	assert.False(t, ok, "min")
+	_, ok = dp.Max.Value()
+	assert.False(t, ok, "max")
+
+	dp = convert(t3, []float64{5, 10}, []uint64{3, 0, 2}, 34)
+	assert.Equal(t, deltaStart, dp.StartTime, "new bounds are a reset")
+	assert.Equal(t, uint64(5), dp.Count)
+	_, ok = dp.Min.Value()
+	assert.True(t, ok, "min")
+}
+
+func TestDeltaConverterExponentialHistogram(t *testing.T) {
+	var c DeltaConverter
+	convert := func(scale int32, positive metricdata.ExponentialBucket) metricdata.ExponentialHistogramDataPoint[int64] {
+		var count uint64
+		for _, n := range positive.Counts {
+			count += n
+		}
+		rm := deltaRM(metricdata.ExponentialHistogram[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.ExponentialHistogramDataPoint[int64]{{
+				Attributes:     deltaAttrs,
+				StartTime:      deltaStart,
+				Time:           deltaStart.Add(time.Second),
+				Count:          count,
+				Scale:          scale,
+				PositiveBucket: positive,
+			}},
+		})
+		c.Convert(rm)
+		return rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.ExponentialHistogram[int64]).DataPoints[0]
+	}
+
+	convert(2, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 1}})
+	dp := convert(2, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 3, 1}})
+	assert.Equal(t, uint64(3), dp.Count)
+	assert.Equal(t, int32(2), dp.Scale)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{0, 2, 1}}, dp.PositiveBucket)
+
+	// Downscaled: the index -1 becomes -1, the indexes 0 and 1 are merged into 0.
+	dp = convert(1, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{2, 5}})
+	assert.Equal(t, uint64(2), dp.Count)
+	assert.Equal(t, int32(1), dp.Scale)
+	assert.Equal(t, metricdata.ExponentialBucket{Offset: -1, Counts: []uint64{1, 1}}, dp.PositiveBucket)
+}
+
+func TestNewDeltaReader(t *testing.T) {
+	r := NewDeltaReader(NewManualReader())
+	mp := NewMeterProvider(WithReader(r))
+	counter, err := mp.Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+
+	collect := func() int64 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(t.Context(), &rm))
+		sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.Equal(t, metricdata.DeltaTemporality, sum.Temporality)
+		return sum.DataPoints[0].Value
+	}
+	counter.Add(t.Context(), 5)
+	assert.Equal(t, int64(5), collect())
+	counter.Add(t.Context(), 3)
+	assert.Equal(t, int64(3), collect())
+	assert.Equal(t, int64(0), collect())
+
+	require.NoError(t, mp.Shutdown(t.Context()))
+	var rm metricdata.ResourceMetrics
+	assert.ErrorIs(t, r.Collect(t.Context(), &rm), ErrReaderShutdown)
+}
+
+func TestNewDeltaExporter(t *testing.T) {
+	var got []int64
+	exp := NewDeltaExporter(&fnExporter{
+		temporalityFunc: func(InstrumentKind) metricdata.Temporality {
+			return metricdata.DeltaTemporality
+		},
+		exportFunc: func(_ context.Context, rm *metricdata.ResourceMetrics) error {
+			sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+			if sum.Temporality != metricdata.DeltaTemporality {
+				return errors.New("unexpected temporality")
+			}
+			got = append(got, sum.DataPoints[0].Value)
+			return nil
+		},
+	})
+	// The cumulative metric data is collected to be converted.
+	assert.Equal(t, metricdata.CumulativeTemporality, exp.Temporality(InstrumentKindCounter))
+
+	r := NewPeriodicReader(exp, WithInterval(time.Hour))
+	mp := NewMeterProvider(WithReader(r))
+	counter, err := mp.Meter("test").Int64Counter("counter")
+	require.NoError(t, err)
+
+	counter.Add(t.Context(), 5)
+	require.NoError(t, r.ForceFlush(t.Context()))
+	counter.Add(t.Context(), 3)
+	require.NoError(t, r.ForceFlush(t.Context()))
+	require.NoError(t, r.ForceFlush(t.Context()))
+	require.NoError(t, mp.Shutdown(t.Context()))
+
+	assert.Equal(t, []int64{5, 3, 0, 0}, got)
+}
@@ -0,0 +1,177 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric // import "go.opentelemetry.io/otel/sdk/metric"
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// DerivedGauge describes a float64 gauge computed when metrics are collected
+// from the data of other streams of the same instrumentation scope, e.g. the
+// ratio of the cache hits to all the cache lookups computed from a counter
+// of hits and a counter of misses. It allows to report simple derived metrics
+// without recording rules in the telemetry backends.
+//
+// A data point of the gauge is computed for each attribute set the sum or
+// gauge streams named by Inputs all have a data point for. Its time is the
+// latest time of these data points. The streams are the ones collected by the
+// Reader, i.e. Inputs are the names of the streams once the Views are
+// applied, and their values are delta or cumulative as selected by the
+// Reader. A DerivedGauge can be computed from the DerivedGauges registered
+// before it.
+//
+// The gauge is added to the scopes with all the streams of Inputs and no
+// stream named Name, if at least one of its data points is computed.
+type DerivedGauge struct {
+	// Name is the name of the gauge.
+	Name string
+	// Description is the description of the gauge.
+	Description string
+	// Unit is the unit of the gauge.
+	Unit string
+	// Inputs are the names of the streams the gauge is computed from.
+	Inputs []string
+	// Compute returns the value of a data point of the gauge for the values
+	// of the data points of Inputs, in the same order, and whether the value
+	// is defined, e.g. false for a ratio with a zero denominator. No data
+	// point is reported for an undefined or NaN value.
+	//
+	// Compute is called synchronously by the collection and must not block.
+	// It must not retain values.
+	Compute func(values []float64) (float64, bool)
+}
+
+// WithDerivedGauge registers the DerivedGauge with the MeterProvider. Its data
+// points are computed and reported by each Reader of the MeterProvider.
+//
+// A DerivedGauge without Name, Inputs, or Compute is invalid: it is ignored
+// and NewMeterProviderWithErrors returns an error.
+func WithDerivedGauge(g DerivedGauge) Option {
+	return optionFunc(func(cfg config) config {
+		if g.Name == "" || len(g.Inputs) == 0 || g.Compute == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: derived gauge %q without name, inputs, or compute function",
+				ErrInvalidConfig, g.Name))
+			return cfg
+		}
+		g.Inputs = slices.Clone(g.Inputs)
+		cfg.derived = append(cfg.derived, g)
+		return cfg
+	})
+}
+
+// derivedPoint is an input data point of a DerivedGauge.
+type derivedPoint struct {
+	attrs attribute.Set
+	time  time.Time
+	value float64
+}
+
+// deriveGauges returns metrics with the DerivedGauges computed from metrics
+// appended.
+func deriveGauges(metrics []metricdata.Metrics, gauges []DerivedGauge) []metricdata.Metrics {
+	for _, g := range gauges {
+		if m, ok := deriveGauge(metrics, g); ok {
+			metrics = append(metrics, m)
+		}
+	}
+	return metrics
+}
+
+// deriveGauge returns g computed from metrics, and whether it has a data
+// point.
+func deriveGauge(metrics []metricdata.Metrics, g DerivedGauge) (metricdata.Metrics, bool) {
+	find := func(name string) int {
+		return slices.IndexFunc(metrics, func(m metricdata.Metrics) bool { return m.Name == name })
+	}
+	if find(g.Name) >= 0 {
+		return metricdata.Metrics{}, false
+	}
+
+	var first []derivedPoint
+	// others are the data points of the other inputs by attribute set.
+	others := make([]map[attribute.Distinct]derivedPoint, len(g.Inputs)-1)
+	for i, name := range g.Inputs {
+		idx := find(name)
+		if idx < 0 {
+			return metricdata.Metrics{}, false
+		}
+		points, ok := derivedPoints(metrics[idx].Data)
+		if !ok {
+			return metricdata.Metrics{}, false
+		}
+		if i == 0 {
+			first = points
+			continue
+		}
+		others[i-1] = make(map[attribute.Distinct]derivedPoint, len(points))
+		for _, p := range points {
+			others[i-1][p.attrs.Equivalent()] = p
+		}
+	}
+
+	values := make([]float64, len(g.Inputs))
+	var dps []metricdata.DataPoint[float64]
+	for _, p := range first {
+		values[0] = p.value
+		t, complete := p.time, true
+		for i, m := range others {
+			q, ok := m[p.attrs.Equivalent()]
+			if !ok {
+				complete = false
+				break
+			}
+			values[i+1] = q.value
+			if q.time.After(t) {
+				t = q.time
+			}
+		}
+		if !complete {
+			continue
+		}
+		v, ok := g.Compute(values)
+		if !ok || math.IsNaN(v) {
+			continue
+		}
+		dps = append(dps, metricdata.DataPoint[float64]{Attributes: p.attrs, Time: t, Value: v})
+	}
+	if len(dps) == 0 {
+		return metricdata.Metrics{}, false
+	}
+	return metricdata.Metrics{
+		Name:        g.Name,
+		Description: g.Description,
+		Unit:        g.Unit,
+		Data:        metricdata.Gauge[float64]{DataPoints: dps},
+	}, true
+}
+
+// derivedPoints returns the data points of the sum or gauge data, and whether
+// data is a sum or a gauge.
+func derivedPoints(data metricdata.Aggregation) ([]derivedPoint, bool) {
+	switch v := data.(type) {
+	case metricdata.Sum[int64]:
+		return toDerivedPoints(v.DataPoints), true
+	case metricdata.Sum[float64]:
+		return toDerivedPoints(v.DataPoints), true
+	case metricdata.Gauge[int64]:
+		return toDerivedPoints(v.DataPoints), true
+	case metricdata.Gauge[float64]:
+		return toDerivedPoints(v.DataPoints), true
+	}
+	return nil, false
+}
+
+func toDerivedPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []derivedPoint {
+	out := make([]derivedPoint, len(dps))
+	for i, dp := range dps {
+		out[i] = derivedPoint{attrs: dp.Attributes, time: dp.Time, value: float64(dp.Value)}
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+)
+
+func ratio(values []float64) (float64, bool) {
+	total := values[0] + values[1]
+	if total == 0 {
+		return 0, false
+	}
+	return values[0] / total, true
+}
+
+func TestDerivedGauge(t *testing.T) {
+	reader := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(reader),
+		WithDerivedGauge(DerivedGauge{
+			Name:        "cache.hit_ratio",
+			Description: "Ratio of the cache hits.",
+			Unit:        "1",
+			Inputs:      []string{"cache.hits", "cache.misses"},
+			Compute:     ratio,
+		}),
+		WithDerivedGauge(DerivedGauge{
+			Name:   "cache.miss_ratio",
+			Inputs: []string{"cache.hit_ratio"},
+			Compute: func(values []float64) (float64, bool) {
+				return 1 - values[0], true
+			},
+		}),
+		WithDerivedGauge(DerivedGauge{
+			Name:    "cache.latency",
+			Inputs:  []string{"cache.duration"},
+			Compute: func([]float64) (float64, bool) { return 0, true },
+		}),
+		WithDerivedGauge(DerivedGauge{
+			Name:    "cache.size",
+			Inputs:  []string{"cache.hits"},
+			Compute: func([]float64) (float64, bool) { return 0, true },
+		}),
+	)
+	meter := mp.Meter("cache")
+	hits, err := meter.Int64Counter("cache.hits")
+	require.NoError(t, err)
+	misses, err := meter.Float64Counter("cache.misses")
+	require.NoError(t, err)
+	size, err := meter.Int64Gauge("cache.size")
+	require.NoError(t, err)
+	duration, err := meter.Float64Histogram("cache.duration")
+	require.NoError(t, err)
+
+	a := attribute.NewSet(attribute.String("cache", "a"))
+	b := attribute.NewSet(attribute.String("cache", "b"))
+	c := attribute.NewSet(attribute.String("cache", "c"))
+	hits.Add(t.Context(), 3, metric.WithAttributeSet(a))
+	misses.Add(t.Context(), 1, metric.WithAttributeSet(a))
+	hits.Add(t.Context(), 3, metric.WithAttributeSet(b)) // No misses.
+	hits.Add(t.Context(), 0, metric.WithAttributeSet(c)) // Undefined ratio.
+	misses.Add(t.Context(), 0, metric.WithAttributeSet(c))
+	size.Record(t.Context(), 10)
+	duration.Record(t.Context(), 1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metrics := rm.ScopeMetrics[0].Metrics
+	names := make([]string, len(metrics))
+	for i, m := range metrics {
+		names[i] = m.Name
+	}
+	assert.ElementsMatch(t, []string{
+		"cache.hits", "cache.misses", "cache.size", "cache.duration",
+		"cache.hit_ratio", "cache.miss_ratio",
+	}, names, "histogram input and existing name ignored")
+
+	want := []metricdata.Metrics{
+		{
+			Name:        "cache.hit_ratio",
+			Description: "Ratio of the cache hits.",
+			Unit:        "1",
+			Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+				{Attributes: a, Value: 0.75},
+			}},
+		},
+		{
+			Name: "cache.miss_ratio",
+			Data: metricdata.Gauge[float64]{DataPoints: []metricdata.DataPoint[float64]{
+				{Attributes: a, Value: 0.25},
+			}},
+		},
+	}
+	for i, m := range metrics[len(metrics)-2:] {
+		metricdatatest.AssertEqual(t, want[i], m, metricdatatest.IgnoreTimestamp())
+	}
+	for _, m := range metrics[len(metrics)-2:] {
+		assert.False(t, m.Data.(metricdata.Gauge[float64]).DataPoints[0].Time.IsZero(), "time")
+	}
+
+	// Collected again in the reused ResourceMetrics.
+	hits.Add(t.Context(), 1, metric.WithAttributeSet(a))
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	metrics = rm.ScopeMetrics[0].Metrics
+	require.Len(t, metrics, 6)
+	assert.Equal(t, "cache.hit_ratio", metrics[4].Name)
+	assert.InDelta(t, 0.8, metrics[4].Data.(metricdata.Gauge[float64]).DataPoints[0].Value, 1e-9)
+}
+
+func TestWithDerivedGaugeInvalid(t *testing.T) {
+	compute := func([]float64) (float64, bool) { return 0, true }
+	for _, g := range []DerivedGauge{
+		{Inputs: []string{"a"}, Compute: compute},
+		{Name: "g", Compute: compute},
+		{Name: "g", Inputs: []string{"a"}},
+	} {
+		_, err := NewMeterProviderWithErrors(WithDerivedGauge(g))
+		assert.ErrorIs(t, err, ErrInvalidConfig, "%+v", g)
+	}
+}
@@ -184,6 +184,45 @@ func ExampleNewView_wildcard() {
 	// unit: ms
 }
 
+func ExampleNewView_kinds() {
+	// Create a view that removes the "user.id" attribute recorded by all
+	// counters and histograms with a unit of milliseconds.
+	view := metric.NewView(
+		metric.Instrument{
+			Kinds: []metric.InstrumentKind{
+				metric.InstrumentKindCounter,
+				metric.InstrumentKindHistogram,
+			},
+			Unit: "ms",
+		},
+		metric.Stream{AttributeFilter: attribute.NewDenyKeysFilter("user.id")},
+	)
+
+	// The created view can then be registered with the OpenTelemetry metric
+	// SDK using the WithView option.
+	_ = metric.NewMeterProvider(
+		metric.WithView(view),
+	)
+
+	// Below is an example of how the view will
+	// function in the SDK for certain instruments.
+	_, ok := view(metric.Instrument{
+		Name: "request.duration",
+		Kind: metric.InstrumentKindHistogram,
+		Unit: "ms",
+	})
+	fmt.Println("histogram matched:", ok)
+	_, ok = view(metric.Instrument{
+		Name: "queue.size",
+		Kind: metric.InstrumentKindGauge,
+		Unit: "ms",
+	})
+	fmt.Println("gauge matched:", ok)
+	// Output:
+	// histogram matched: true
+	// gauge matched: false
+}
+
 func ExampleNewView_drop() {
 	// Create a view that drops the "latency" instrument from the "http"
 	// instrumentation library.
@@ -18,11 +18,13 @@ import (
 type ExemplarReservoirProviderSelector func(Aggregation) exemplar.ReservoirProvider
 
 // reservoirFunc returns the appropriately configured exemplar reservoir
-// creation func based on the passed InstrumentKind and filter configuration.
+// creation func based on the passed InstrumentKind, filter, and exemplar
+// attributes configuration.
 func reservoirFunc[N int64 | float64](
 	kind InstrumentKind,
 	provider exemplar.ReservoirProvider,
 	filter exemplar.Filter,
+	attrs aggregate.ExemplarAttributes,
 ) func(attribute.Set) aggregate.FilteredExemplarReservoir[N] {
 	if reflect.ValueOf(filter).Pointer() == reflect.ValueOf(exemplar.AlwaysOffFilter).Pointer() {
 		return aggregate.DropReservoir[N]
@@ -33,8 +35,8 @@ func reservoirFunc[N int64 | float64](
 		// will never record any exemplars.
 		return aggregate.DropReservoir[N]
 	}
-	return func(attrs attribute.Set) aggregate.FilteredExemplarReservoir[N] {
-		return aggregate.NewFilteredExemplarReservoir[N](filter, provider(attrs))
+	return func(set attribute.Set) aggregate.FilteredExemplarReservoir[N] {
+		return aggregate.NewFilteredExemplarReservoir[N](filter, provider(set), attrs)
 	}
 }
 
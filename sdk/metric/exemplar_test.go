@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -117,7 +118,7 @@ func TestReservoirFunc(t *testing.T) {
 				return nil
 			}
 
-			f := reservoirFunc[int64](tc.kind, provider, tc.filter)
+			f := reservoirFunc[int64](tc.kind, provider, tc.filter, aggregate.ExemplarAttributes{})
 			_ = f(*attribute.EmptySet())
 
 			if tc.expectDrop {
@@ -9,7 +9,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
@@ -66,11 +69,27 @@ type Instrument struct {
 	Description string
 	// Kind defines the functional group of the instrument.
 	Kind InstrumentKind
+	// Kinds is the set of functional groups matched when the Instrument is
+	// used as View criteria (see [NewView]). An instrument matches if its
+	// Kind is one of Kinds. This allows a single View to match instruments
+	// of multiple kinds.
+	//
+	// It is not set for the Instruments passed to a View.
+	Kinds []InstrumentKind
 	// Unit is the unit of measurement recorded by the instrument.
 	Unit string
 	// Scope identifies the instrumentation that created the instrument.
 	Scope instrumentation.Scope
 
+	// staleness is the staleness the instrument is created with.
+	staleness time.Duration
+	// deltaObservations is true if the instrument is asynchronous and its
+	// callbacks observe deltas instead of precomputed sums.
+	deltaObservations bool
+	// attributeSets are the attribute sets the instrument is created with
+	// the WithAttributeSets option of go.opentelemetry.io/otel/metric/x.
+	attributeSets []attribute.Set
+
 	// Ensure forward compatibility if non-comparable fields need to be added.
 	nonComparable // nolint: unused
 }
@@ -80,6 +99,7 @@ func (i Instrument) IsEmpty() bool {
 	return i.Name == "" &&
 		i.Description == "" &&
 		i.Kind == instrumentKindUndefined &&
+		len(i.Kinds) == 0 &&
 		i.Unit == "" &&
 		i.Scope == zeroScope
 }
@@ -108,9 +128,11 @@ func (i Instrument) matchesDescription(other Instrument) bool {
 }
 
 // matchesKind returns true if the Kind of i is its zero-value or it equals the
-// Kind of other, otherwise false.
+// Kind of other, and the Kinds of i are empty or contain the Kind of other,
+// otherwise false.
 func (i Instrument) matchesKind(other Instrument) bool {
-	return i.Kind == instrumentKindUndefined || i.Kind == other.Kind
+	return (i.Kind == instrumentKindUndefined || i.Kind == other.Kind) &&
+		(len(i.Kinds) == 0 || slices.Contains(i.Kinds, other.Kind))
 }
 
 // matchesUnit returns true if the Unit of i is its zero-value or it equals the
@@ -144,17 +166,76 @@ type Stream struct {
 	//
 	// Note that attributes filtered out by a View may still appear on Exemplars,
 	// because Exemplars are recorded with the dropped measurement attributes
-	// when View attribute filtering is applied.
+	// when View attribute filtering is applied. Use ExemplarAttributeFilter to
+	// select which of them are recorded.
 	//
 	// Use NewAllowKeysFilter from "go.opentelemetry.io/otel/attribute" to
 	// provide an allow-list of attribute keys here.
 	AttributeFilter attribute.Filter
+	// ExemplarAttributeFilter is an attribute Filter applied to the
+	// measurement attributes filtered out by AttributeFilter. The attributes
+	// it returns true for are recorded as the filtered attributes of the
+	// Exemplars of the stream, the others are discarded. This can be used to
+	// keep context like the endpoint of a request on Exemplars without adding
+	// it to the attributes of the stream.
+	//
+	// If unspecified, all the attributes filtered out by AttributeFilter are
+	// recorded on Exemplars.
+	ExemplarAttributeFilter attribute.Filter
+	// ExemplarSpanNameKey is the key of the filtered attribute of an
+	// Exemplar the name of the span active when its measurement was made is
+	// recorded as. The name is only recorded if the span provides it, as the
+	// spans of go.opentelemetry.io/otel/sdk/trace do.
+	//
+	// If unspecified, the span name is not recorded.
+	ExemplarSpanNameKey attribute.Key
 	// ExemplarReservoirProvider selects the
 	// [go.opentelemetry.io/otel/sdk/metric/exemplar.ReservoirProvider] based
 	// on the [Aggregation].
 	//
 	// If unspecified, [DefaultExemplarReservoirProviderSelector] is used.
 	ExemplarReservoirProviderSelector ExemplarReservoirProviderSelector
+	// InvalidMeasurementAction is the action taken for invalid measurements
+	// made by an instrument.
+	//
+	// If unspecified, or set to [InvalidMeasurementDefault], the action of
+	// the Reader is used.
+	InvalidMeasurementAction InvalidMeasurementAction
+	// NoMinMax indicates whether to not record the min and max of the
+	// distribution if the stream uses a histogram aggregation, including when
+	// the aggregation is selected by the Reader or is the default. It has no
+	// effect on other aggregations.
+	//
+	// This can be used to reduce the size of the data exported for
+	// high-cardinality histograms without having to specify the whole
+	// Aggregation of the stream.
+	NoMinMax bool
+	// CardinalityLimit is the maximum number of distinct attribute sets the
+	// stream aggregates in a collection cycle. Measurements for new attribute
+	// sets once the limit is reached are aggregated into a single overflow
+	// series with the "otel.metric.overflow" attribute set to true.
+	//
+	// If unspecified, or set to 0, the cardinality limit of the Reader is
+	// used. A negative value disables the cardinality limit of the stream.
+	CardinalityLimit int
+	// Staleness is the duration after which an attribute set that has not
+	// been measured is no longer exported, and forgotten, by a stream with a
+	// cumulative last-value aggregation (e.g. of a synchronous Gauge). It
+	// bounds the memory used by gauges recorded for short-lived attribute
+	// sets, such as per-connection gauges.
+	//
+	// If unspecified, or set to 0, the staleness of the instrument is used
+	// (see the WithStaleness option of go.opentelemetry.io/otel/metric/x). A
+	// negative value disables the staleness of the stream.
+	Staleness time.Duration
+
+	// deltaObservations is true if the stream aggregates the observations of
+	// an instrument created with the WithPrecomputedSum(false) option of
+	// go.opentelemetry.io/otel/metric/x.
+	deltaObservations bool
+	// attributeSets are the attribute sets the aggregation of the stream
+	// stores before they are measured.
+	attributeSets []attribute.Set
 }
 
 // instID are the identifying properties of a instrument.
@@ -169,6 +250,10 @@ type instID struct {
 	Unit string
 	// Number is the number type of the stream.
 	Number string
+	// Staleness is the staleness of the stream. Streams that only differ in
+	// their staleness conflict, the attribute sets of their aggregation
+	// would otherwise be removed after the staleness of the first created.
+	Staleness time.Duration
 }
 
 // Returns a normalized copy of the instID i.
@@ -206,23 +291,51 @@ func extractRawKVs[T any](opts []T) []attribute.KeyValue {
 	return rawKVs
 }
 
-func resolveAttributes(configAttrs attribute.Set, rawKVs []attribute.KeyValue) attribute.Set {
+// setBuilderPool holds the SetBuilders used to resolve the attributes of
+// measurements. The Sets the builders keep are reused when measurements are
+// recorded with the same attributes, avoiding an allocation for each of them.
+var setBuilderPool = sync.Pool{
+	New: func() any { return new(attribute.SetBuilder) },
+}
+
+// resolveAttributes returns the attributes of a measurement: the constant
+// attributes of its instrument, overridden by configAttrs, overridden by
+// rawKVs.
+func resolveAttributes(constAttrs, configAttrs attribute.Set, rawKVs []attribute.KeyValue) attribute.Set {
 	configAttrs, _ = attrnorm.Set(configAttrs)
-	if len(rawKVs) == 0 {
+	if constAttrs.Len() == 0 && len(rawKVs) == 0 {
 		return configAttrs
 	}
+	if configAttrs.Len() == 0 && len(rawKVs) == 0 {
+		return constAttrs
+	}
 	rawKVs, _ = attrnorm.KeyValues(rawKVs)
-	merged := make([]attribute.KeyValue, 0, configAttrs.Len()+len(rawKVs))
-	merged = append(merged, configAttrs.ToSlice()...)
-	// rawKVs are appended after configAttrs, meaning they will override any duplicate keys in configAttrs.
+
+	b := setBuilderPool.Get().(*attribute.SetBuilder)
+	defer func() {
+		b.Reset()
+		setBuilderPool.Put(b)
+	}()
+	// constAttrs are added first so the attributes of the measurement
+	// override them.
+	for iter := constAttrs.Iter(); iter.Next(); {
+		b.Add(iter.Attribute())
+	}
+	for iter := configAttrs.Iter(); iter.Next(); {
+		b.Add(iter.Attribute())
+	}
+	// rawKVs are added after configAttrs, meaning they will override any duplicate keys in configAttrs.
 	// This behavior is documented in WithUnsafeAttributes.
-	merged = append(merged, rawKVs...)
+	b.Add(rawKVs...)
 	// TODO(#7743): Defer computing the full attribute.NewSet.
-	return attribute.NewSet(merged...)
+	return b.Set()
 }
 
 type int64Inst struct {
 	measures []aggregate.Measure[int64]
+	// attrs are recorded with all the measurements, see
+	// x.WithMeterAttributes and x.WithInstrumentAttributes.
+	attrs attribute.Set
 
 	embedded.Int64Counter
 	embedded.Int64UpDownCounter
@@ -240,13 +353,31 @@ var (
 func (i *int64Inst) Add(ctx context.Context, val int64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	i.aggregate(ctx, val, resolveAttributes(c.Attributes(), rawKVs))
+	i.aggregate(ctx, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
 }
 
 func (i *int64Inst) Record(ctx context.Context, val int64, opts ...metric.RecordOption) {
 	c := metric.NewRecordConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	i.aggregate(ctx, val, resolveAttributes(c.Attributes(), rawKVs))
+	i.aggregate(ctx, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
+}
+
+// RecordCtxless records val without a context. The measurement is not
+// offered to exemplar reservoirs. This implements the
+// [go.opentelemetry.io/otel/metric/x.Int64HistogramCtxless] interface.
+func (i *int64Inst) RecordCtxless(val int64, opts ...metric.RecordOption) {
+	c := metric.NewRecordConfig(opts)
+	rawKVs := extractRawKVs(opts)
+	i.aggregate(aggregate.Ctxless, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
+}
+
+// withAttributes returns an instrument sharing the measures of i recording
+// attrs with all the measurements.
+func (i *int64Inst) withAttributes(attrs attribute.Set) *int64Inst {
+	if i == nil || attrs.Len() == 0 {
+		return i
+	}
+	return &int64Inst{measures: i.measures, attrs: attrs}
 }
 
 func (i *int64Inst) Enabled(context.Context) bool {
@@ -265,6 +396,9 @@ func (i *int64Inst) aggregate(
 
 type float64Inst struct {
 	measures []aggregate.Measure[float64]
+	// attrs are recorded with all the measurements, see
+	// x.WithMeterAttributes and x.WithInstrumentAttributes.
+	attrs attribute.Set
 
 	embedded.Float64Counter
 	embedded.Float64UpDownCounter
@@ -282,13 +416,31 @@ var (
 func (i *float64Inst) Add(ctx context.Context, val float64, opts ...metric.AddOption) {
 	c := metric.NewAddConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	i.aggregate(ctx, val, resolveAttributes(c.Attributes(), rawKVs))
+	i.aggregate(ctx, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
 }
 
 func (i *float64Inst) Record(ctx context.Context, val float64, opts ...metric.RecordOption) {
 	c := metric.NewRecordConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	i.aggregate(ctx, val, resolveAttributes(c.Attributes(), rawKVs))
+	i.aggregate(ctx, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
+}
+
+// RecordCtxless records val without a context. The measurement is not
+// offered to exemplar reservoirs. This implements the
+// [go.opentelemetry.io/otel/metric/x.Float64HistogramCtxless] interface.
+func (i *float64Inst) RecordCtxless(val float64, opts ...metric.RecordOption) {
+	c := metric.NewRecordConfig(opts)
+	rawKVs := extractRawKVs(opts)
+	i.aggregate(aggregate.Ctxless, val, resolveAttributes(i.attrs, c.Attributes(), rawKVs))
+}
+
+// withAttributes returns an instrument sharing the measures of i recording
+// attrs with all the measurements.
+func (i *float64Inst) withAttributes(attrs attribute.Set) *float64Inst {
+	if i == nil || attrs.Len() == 0 {
+		return i
+	}
+	return &float64Inst{measures: i.measures, attrs: attrs}
 }
 
 func (i *float64Inst) Enabled(context.Context) bool {
@@ -314,6 +466,9 @@ type float64Observable struct {
 	metric.Float64Observable
 	*observable[float64]
 
+	// attrs are recorded with all the observations.
+	attrs attribute.Set
+
 	embedded.Float64ObservableCounter
 	embedded.Float64ObservableUpDownCounter
 	embedded.Float64ObservableGauge
@@ -335,6 +490,9 @@ type int64Observable struct {
 	metric.Int64Observable
 	*observable[int64]
 
+	// attrs are recorded with all the observations.
+	attrs attribute.Set
+
 	embedded.Int64ObservableCounter
 	embedded.Int64ObservableUpDownCounter
 	embedded.Int64ObservableGauge
@@ -402,7 +560,7 @@ func (o *observable[N]) registerable(m *meter) error {
 	if len(o.measures) == 0 {
 		return errEmptyAgg
 	}
-	if m != o.meter {
+	if m.meterState != o.meter.meterState {
 		return fmt.Errorf(
 			"invalid registration: observable %q from Meter %q, registered with Meter %q",
 			o.name,
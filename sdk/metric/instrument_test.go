@@ -5,6 +5,7 @@ package metric
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/x"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
@@ -203,7 +205,7 @@ func TestResolveAttributes(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := resolveAttributes(tt.configAttrs, tt.rawKVs)
+			got := resolveAttributes(*attribute.EmptySet(), tt.configAttrs, tt.rawKVs)
 			require.Equal(t, tt.want, got)
 		})
 	}
@@ -514,3 +516,83 @@ func TestMeterWithUnsafeAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestHistogramRecordCtxless(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r), WithExemplarFilter(exemplar.AlwaysOnFilter))
+	m := mp.Meter("TestHistogramRecordCtxless")
+
+	iHist, err := m.Int64Histogram("int64")
+	require.NoError(t, err)
+	fHist, err := m.Float64Histogram("float64")
+	require.NoError(t, err)
+
+	require.Implements(t, (*x.Int64HistogramCtxless)(nil), iHist)
+	require.Implements(t, (*x.Float64HistogramCtxless)(nil), fHist)
+
+	attrs := attribute.NewSet(attribute.String("k", "v"))
+	x.RecordInt64Ctxless(iHist, 3, metric.WithAttributeSet(attrs))
+	x.RecordFloat64Ctxless(fHist, 4.5, metric.WithAttributeSet(attrs))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+
+	bounds := []float64{0, 5, 10, 25, 50, 75, 100, 250, 500, 750, 1000, 2500, 5000, 7500, 10000}
+	counts := make([]uint64, len(bounds)+1)
+	counts[1] = 1
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[int64]{{
+			Attributes:   attrs,
+			Count:        1,
+			Bounds:       bounds,
+			BucketCounts: counts,
+			Min:          metricdata.NewExtrema[int64](3),
+			Max:          metricdata.NewExtrema[int64](3),
+			Sum:          3,
+		}},
+	}, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Histogram[float64]{
+		Temporality: metricdata.CumulativeTemporality,
+		DataPoints: []metricdata.HistogramDataPoint[float64]{{
+			Attributes:   attrs,
+			Count:        1,
+			Bounds:       bounds,
+			BucketCounts: counts,
+			Min:          metricdata.NewExtrema(4.5),
+			Max:          metricdata.NewExtrema(4.5),
+			Sum:          4.5,
+		}},
+	}, rm.ScopeMetrics[0].Metrics[1].Data, metricdatatest.IgnoreTimestamp())
+}
+
+func TestCounterMeasurementShards(t *testing.T) {
+	t.Setenv("OTEL_GO_X_METRIC_MEASUREMENT_SHARDS", "4")
+
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	c, err := mp.Meter("TestCounterMeasurementShards").Int64Counter("counter")
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for range 100 {
+				c.Add(t.Context(), 1)
+			}
+		})
+	}
+	wg.Wait()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	metricdatatest.AssertAggregationsEqual(t, metricdata.Sum[int64]{
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+		DataPoints:  []metricdata.DataPoint[int64]{{Value: 400}},
+	}, rm.ScopeMetrics[0].Metrics[0].Data, metricdatatest.IgnoreTimestamp())
+}
@@ -47,6 +47,27 @@ type Builder[N int64 | float64] struct {
 	// If AggregationLimit is less than or equal to zero there will not be an
 	// aggregation limit imposed (i.e. unlimited attribute sets).
 	AggregationLimit int
+	// MeasurementShards is the number of shards the measurements of each
+	// attribute set are spread across by the sum aggregate function to avoid
+	// contention between concurrent measurements. The shards are merged when
+	// the aggregation is computed.
+	//
+	// If MeasurementShards is less than or equal to 1, the measurements are
+	// not sharded.
+	MeasurementShards int
+	// Staleness is the duration after which an attribute set that has not
+	// been measured is no longer reported, and forgotten, by the cumulative
+	// last-value aggregate function.
+	//
+	// If Staleness is less than or equal to zero, attribute sets are never
+	// forgotten.
+	Staleness time.Duration
+	// AttributeSets are the attribute sets the sum aggregate function stores,
+	// filtered, when it is built and after each delta collection, so the
+	// measurements of these sets do not store them. They are reported with a
+	// zero value when they are not measured. They are ignored by the other
+	// aggregate functions.
+	AttributeSets []attribute.Set
 }
 
 func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
@@ -59,6 +80,19 @@ func (b Builder[N]) resFunc() func(attribute.Set) FilteredExemplarReservoir[N] {
 
 type fltrMeasure[N int64 | float64] func(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue)
 
+// filteredSets returns the attribute sets the aggregate function stores the
+// measurements of AttributeSets in.
+func (b Builder[N]) filteredSets() []attribute.Set {
+	if b.Filter == nil || len(b.AttributeSets) == 0 {
+		return b.AttributeSets
+	}
+	sets := make([]attribute.Set, len(b.AttributeSets))
+	for i, set := range b.AttributeSets {
+		sets[i], _ = set.Filter(b.Filter)
+	}
+	return sets
+}
+
 func (b Builder[N]) filter(f fltrMeasure[N]) Measure[N] {
 	if b.Filter != nil {
 		fltr := b.Filter // Copy to make it immutable after assignment.
@@ -79,7 +113,7 @@ func (b Builder[N]) LastValue() (Measure[N], ComputeAggregation) {
 		lv := newDeltaLastValue[N](b.AggregationLimit, b.resFunc())
 		return b.filter(lv.measure), lv.collect
 	default:
-		lv := newCumulativeLastValue[N](b.AggregationLimit, b.resFunc())
+		lv := newCumulativeLastValue[N](b.AggregationLimit, b.resFunc(), b.Staleness)
 		return b.filter(lv.measure), lv.collect
 	}
 }
@@ -113,10 +147,15 @@ func (b Builder[N]) PrecomputedSum(monotonic bool) (Measure[N], ComputeAggregati
 func (b Builder[N]) Sum(monotonic bool) (Measure[N], ComputeAggregation) {
 	switch b.Temporality {
 	case metricdata.DeltaTemporality:
-		s := newDeltaSum[N](monotonic, b.AggregationLimit, b.resFunc())
+		s := newDeltaSum[N](monotonic, b.AggregationLimit, b.MeasurementShards, b.resFunc())
+		s.sets = b.filteredSets()
+		for i := range s.hotColdValMap {
+			s.hotColdValMap[i].store(s.sets)
+		}
 		return b.filter(s.measure), s.collect
 	default:
-		s := newCumulativeSum[N](monotonic, b.AggregationLimit, b.resFunc())
+		s := newCumulativeSum[N](monotonic, b.AggregationLimit, b.MeasurementShards, b.resFunc())
+		s.store(b.filteredSets())
 		return b.filter(s.measure), s.collect
 	}
 }
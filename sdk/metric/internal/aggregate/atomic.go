@@ -5,6 +5,7 @@ package aggregate
 
 import (
 	"math"
+	"math/rand/v2"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -57,6 +58,57 @@ func (n *atomicCounter[N]) reset() {
 	n.nInt.Store(0)
 }
 
+// cacheLineSize is the assumed size of a CPU cache line. It is the size of
+// two 64-byte cache lines to also avoid the false sharing caused by CPUs
+// prefetching adjacent cache lines.
+const cacheLineSize = 128
+
+// paddedCounter is an atomicCounter padded to occupy its own cache line.
+type paddedCounter[N int64 | float64] struct {
+	atomicCounter[N]
+	// The atomicCounter is 16 bytes (two 8-byte atomics).
+	_ [cacheLineSize - 16]byte
+}
+
+// shardedCounter is an atomicCounter that spreads additions across shards
+// to avoid cache contention between concurrent writers. The shards are merged
+// when the value is loaded. If it has no shards, all additions are made to a
+// single atomicCounter.
+type shardedCounter[N int64 | float64] struct {
+	base   atomicCounter[N]
+	shards []paddedCounter[N]
+}
+
+// newShardedCounter returns a shardedCounter with n shards. If n is less than
+// or equal to 1, the returned counter is not sharded.
+func newShardedCounter[N int64 | float64](n int) shardedCounter[N] {
+	if n <= 1 {
+		return shardedCounter[N]{}
+	}
+	return shardedCounter[N]{shards: make([]paddedCounter[N], n)}
+}
+
+func (c *shardedCounter[N]) add(value N) {
+	if len(c.shards) == 0 {
+		c.base.add(value)
+		return
+	}
+	// The top-level math/rand/v2 functions use a per-thread generator that
+	// does not contend between writers, and writers running on different
+	// cores are unlikely to pick the same shard at the same time.
+	c.shards[rand.IntN(len(c.shards))].add(value)
+}
+
+// load returns the current value, merging all shards. The caller must ensure
+// all calls to add have returned prior to calling load.
+func (c *shardedCounter[N]) load() N {
+	v := c.base.load()
+	for i := range c.shards {
+		v += c.shards[i].load()
+	}
+	return v
+}
+
 // atomicN is a generic atomic number value.
 type atomicN[N int64 | float64] struct {
 	val atomic.Uint64
@@ -251,16 +303,29 @@ func (m *limitedSyncMap[V]) LoadOrStoreAttr(fltrAttr attribute.Set, newValue fun
 		return actual.(V)
 	}
 
-	if m.aggLimit > 0 && m.len >= m.aggLimit-1 {
+	overflow := m.aggLimit > 0 && m.len >= m.aggLimit-1
+	if overflow {
 		fltrAttr = overflowSet
 	}
 	actual, loaded = m.LoadOrStore(fltrAttr.Equivalent(), newValue(fltrAttr))
 	if !loaded {
 		m.len++
+		if overflow {
+			overflowed.Add(1)
+		}
 	}
 	return actual.(V)
 }
 
+// Delete removes the value stored for key, the Distinct of an attribute set.
+func (m *limitedSyncMap[V]) Delete(key any) {
+	m.lenMux.Lock()
+	defer m.lenMux.Unlock()
+	if _, loaded := m.LoadAndDelete(key); loaded {
+		m.len--
+	}
+}
+
 func (m *limitedSyncMap[V]) Clear() {
 	m.lenMux.Lock()
 	defer m.lenMux.Unlock()
@@ -50,12 +50,47 @@ func TestAtomicSumAddIntConcurrentSafe(t *testing.T) {
 	assert.Equal(t, int64(15), aSum.load())
 }
 
+func TestShardedCounter(t *testing.T) {
+	t.Run("Int64", testShardedCounter[int64])
+	t.Run("Float64", testShardedCounter[float64])
+}
+
+func testShardedCounter[N int64 | float64](t *testing.T) {
+	for _, shards := range []int{0, 1, 8} {
+		c := newShardedCounter[N](shards)
+		if shards > 1 {
+			assert.Len(t, c.shards, shards)
+		} else {
+			assert.Empty(t, c.shards)
+		}
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				for range 100 {
+					c.add(2)
+				}
+			})
+		}
+		wg.Wait()
+		assert.Equal(t, N(2000), c.load(), "shards: %d", shards)
+	}
+}
+
 func BenchmarkAtomicCounter(b *testing.B) {
 	b.Run("Int64", benchmarkAtomicCounter[int64])
 	b.Run("Float64", benchmarkAtomicCounter[float64])
 }
 
 func benchmarkAtomicCounter[N int64 | float64](b *testing.B) {
+	b.Run("sharded add", func(b *testing.B) {
+		a := newShardedCounter[N](8)
+		b.RunParallel(func(pb *testing.PB) {
+			for pb.Next() {
+				a.add(2)
+			}
+		})
+	})
 	b.Run("add", func(b *testing.B) {
 		var a atomicCounter[N]
 		b.RunParallel(func(pb *testing.PB) {
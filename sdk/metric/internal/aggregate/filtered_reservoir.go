@@ -11,8 +11,17 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal/reservoir"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// ctxlessContext is the type of Ctxless.
+type ctxlessContext struct{ context.Context }
+
+// Ctxless is the context measurements made without a context are passed to
+// a Measure with. These measurements are never offered to an exemplar
+// reservoir, which avoids the evaluation of the exemplar filter.
+var Ctxless context.Context = &ctxlessContext{Context: context.Background()}
+
 // FilteredExemplarReservoir wraps a [exemplar.Reservoir] with a filter.
 type FilteredExemplarReservoir[N int64 | float64] interface {
 	// Offer accepts the parameters associated with a measurement. The
@@ -27,10 +36,24 @@ type FilteredExemplarReservoir[N int64 | float64] interface {
 	Collect(dest *[]exemplar.Exemplar)
 }
 
+// ExemplarAttributes configures the filtered attributes of the exemplars
+// recorded by a [FilteredExemplarReservoir].
+type ExemplarAttributes struct {
+	// Filter selects the measurement attributes dropped by the attribute
+	// filter of the aggregation that are recorded. If nil, all the dropped
+	// attributes are recorded.
+	Filter attribute.Filter
+	// SpanNameKey is the key of the attribute the name of the span active
+	// when the measurement was made is recorded as, if the name is known. If
+	// empty, the span name is not recorded.
+	SpanNameKey attribute.Key
+}
+
 // filteredExemplarReservoir handles the pre-sampled exemplar of measurements made.
 type filteredExemplarReservoir[N int64 | float64] struct {
 	filter    exemplar.Filter
 	reservoir exemplar.Reservoir
+	attrs     ExemplarAttributes
 	// The exemplar.Reservoir is not required to be concurrent safe, but
 	// implementations can indicate that they are concurrent-safe by embedding
 	// reservoir.ConcurrentSafe in order to improve performance.
@@ -39,23 +62,30 @@ type filteredExemplarReservoir[N int64 | float64] struct {
 }
 
 // NewFilteredExemplarReservoir creates a [FilteredExemplarReservoir] which only offers values
-// that are allowed by the filter.
+// that are allowed by the filter. The filtered attributes of the exemplars
+// recorded are configured by a.
 func NewFilteredExemplarReservoir[N int64 | float64](
 	f exemplar.Filter,
 	r exemplar.Reservoir,
+	a ExemplarAttributes,
 ) FilteredExemplarReservoir[N] {
 	_, concurrentSafe := r.(reservoir.ConcurrentSafe)
 	return &filteredExemplarReservoir[N]{
 		filter:         f,
 		reservoir:      r,
+		attrs:          a,
 		concurrentSafe: concurrentSafe,
 	}
 }
 
 func (f *filteredExemplarReservoir[N]) Offer(ctx context.Context, val N, attr []attribute.KeyValue) {
+	if ctx == Ctxless {
+		return
+	}
 	if f.filter(ctx) {
 		// only record the current time if we are sampling this measurement.
 		ts := time.Now()
+		attr = f.exemplarAttrs(ctx, attr)
 		if !f.concurrentSafe {
 			f.reservoirMux.Lock()
 			defer f.reservoirMux.Unlock()
@@ -64,6 +94,33 @@ func (f *filteredExemplarReservoir[N]) Offer(ctx context.Context, val N, attr []
 	}
 }
 
+// exemplarAttrs returns the filtered attributes to record in an exemplar of a
+// measurement made with ctx that had the attr attributes dropped.
+func (f *filteredExemplarReservoir[N]) exemplarAttrs(
+	ctx context.Context,
+	attr []attribute.KeyValue,
+) []attribute.KeyValue {
+	if f.attrs.Filter == nil && f.attrs.SpanNameKey == "" {
+		return attr
+	}
+
+	out := make([]attribute.KeyValue, 0, len(attr)+1)
+	for _, kv := range attr {
+		if f.attrs.Filter == nil || f.attrs.Filter(kv) {
+			out = append(out, kv)
+		}
+	}
+	if f.attrs.SpanNameKey != "" {
+		// The span name is not part of the trace API Span, but spans of SDKs
+		// that expose it (e.g. go.opentelemetry.io/otel/sdk/trace) have a
+		// Name method.
+		if s, ok := trace.SpanFromContext(ctx).(interface{ Name() string }); ok {
+			out = append(out, f.attrs.SpanNameKey.String(s.Name()))
+		}
+	}
+	return out
+}
+
 func (f *filteredExemplarReservoir[N]) Collect(dest *[]exemplar.Exemplar) {
 	if !f.concurrentSafe {
 		f.reservoirMux.Lock()
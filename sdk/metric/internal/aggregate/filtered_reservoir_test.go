@@ -10,10 +10,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal/reservoir"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func TestConcurrentSafeFilteredReservoir(t *testing.T) {
@@ -34,7 +36,7 @@ func TestConcurrentSafeFilteredReservoir(t *testing.T) {
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
-			reservoir := NewFilteredExemplarReservoir[int64](exemplar.AlwaysOnFilter, tc.reservoir)
+			reservoir := NewFilteredExemplarReservoir[int64](exemplar.AlwaysOnFilter, tc.reservoir, ExemplarAttributes{})
 			var wg sync.WaitGroup
 			for range 5 {
 				wg.Go(func() {
@@ -52,6 +54,94 @@ func TestConcurrentSafeFilteredReservoir(t *testing.T) {
 	}
 }
 
+func TestFilteredReservoirCtxless(t *testing.T) {
+	r := NewFilteredExemplarReservoir[int64](exemplar.AlwaysOnFilter, exemplar.NewFixedSizeReservoir(1), ExemplarAttributes{})
+	r.Offer(Ctxless, 25, nil)
+
+	var into []exemplar.Exemplar
+	r.Collect(&into)
+	assert.Empty(t, into, "ctxless measurement offered")
+
+	r.Offer(t.Context(), 25, nil)
+	r.Collect(&into)
+	assert.Len(t, into, 1)
+}
+
+type namedSpan struct {
+	trace.Span
+
+	name string
+}
+
+func (s namedSpan) Name() string { return s.name }
+
+func TestFilteredReservoirExemplarAttributes(t *testing.T) {
+	const spanNameKey = attribute.Key("endpoint")
+	dropped := []attribute.KeyValue{
+		attribute.String("http.route", "/users"),
+		attribute.String("user.id", "1234"),
+	}
+	span := namedSpan{Span: trace.SpanFromContext(t.Context()), name: "GET /users"}
+	spanCtx := trace.ContextWithSpan(t.Context(), span)
+
+	for _, tc := range []struct {
+		desc  string
+		ctx   context.Context
+		attrs ExemplarAttributes
+		want  []attribute.KeyValue
+	}{
+		{
+			desc: "Default",
+			ctx:  spanCtx,
+			want: dropped,
+		},
+		{
+			desc:  "Filter",
+			ctx:   spanCtx,
+			attrs: ExemplarAttributes{Filter: attribute.NewAllowKeysFilter("http.route")},
+			want:  dropped[:1],
+		},
+		{
+			desc:  "SpanName",
+			ctx:   spanCtx,
+			attrs: ExemplarAttributes{SpanNameKey: spanNameKey},
+			want:  append(dropped[:2:2], spanNameKey.String("GET /users")),
+		},
+		{
+			desc: "FilterAndSpanName",
+			ctx:  spanCtx,
+			attrs: ExemplarAttributes{
+				Filter:      attribute.NewDenyKeysFilter("user.id"),
+				SpanNameKey: spanNameKey,
+			},
+			want: []attribute.KeyValue{
+				attribute.String("http.route", "/users"),
+				spanNameKey.String("GET /users"),
+			},
+		},
+		{
+			desc:  "SpanNameUnknown",
+			ctx:   t.Context(),
+			attrs: ExemplarAttributes{SpanNameKey: spanNameKey},
+			want:  dropped,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := NewFilteredExemplarReservoir[int64](
+				exemplar.AlwaysOnFilter,
+				exemplar.NewFixedSizeReservoir(1),
+				tc.attrs,
+			)
+			r.Offer(tc.ctx, 25, dropped)
+
+			var got []exemplar.Exemplar
+			r.Collect(&got)
+			require.Len(t, got, 1)
+			assert.Equal(t, tc.want, got[0].FilteredAttributes)
+		})
+	}
+}
+
 type notConcurrentSafeReservoir struct {
 	ex exemplar.Exemplar
 }
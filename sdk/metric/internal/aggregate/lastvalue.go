@@ -5,6 +5,7 @@ package aggregate
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -19,12 +20,24 @@ type lastValuePoint[N int64 | float64] struct {
 	res           FilteredExemplarReservoir[N]
 	startTime     time.Time
 	dropExemplars bool
+
+	// mu guards updated and removed. It is only used if the staleness of the
+	// aggregate is positive so a measurement and the removal of the point as
+	// stale are not interleaved.
+	mu sync.Mutex
+	// updated is the time, in Unix nanoseconds, of the last measurement.
+	updated int64
+	// removed is true once the point is removed from its map as stale.
+	removed bool
 }
 
 // lastValueMap summarizes a set of measurements as the last one made.
 type lastValueMap[N int64 | float64] struct {
 	newRes func(attribute.Set) FilteredExemplarReservoir[N]
 	values limitedSyncMap[*lastValuePoint[N]]
+	// staleness is the duration after which an attribute set not measured
+	// is removed. Attribute sets are never removed if it is not positive.
+	staleness time.Duration
 }
 
 func (s *lastValueMap[N]) measure(
@@ -33,25 +46,66 @@ func (s *lastValueMap[N]) measure(
 	fltrAttr attribute.Set,
 	droppedAttr []attribute.KeyValue,
 ) {
-	lv := s.values.LoadOrStoreAttr(fltrAttr, func(attr attribute.Set) *lastValuePoint[N] {
+	newPoint := func(attr attribute.Set) *lastValuePoint[N] {
 		r := s.newRes(attr)
 		_, isDrop := r.(*dropRes[N])
+		t := now()
 		p := &lastValuePoint[N]{
 			res:           r,
 			attrs:         attr,
-			startTime:     now(),
+			startTime:     t,
 			dropExemplars: isDrop,
+			updated:       t.UnixNano(),
 		}
 		p.value.Store(value)
 		return p
-	})
+	}
 
-	lv.value.Store(value)
+	lv := s.values.LoadOrStoreAttr(fltrAttr, newPoint)
+	if s.staleness <= 0 {
+		lv.value.Store(value)
+	} else {
+		for !s.record(lv, value) {
+			// The point was concurrently removed as stale, the measurement
+			// is recorded in a new one.
+			lv = s.values.LoadOrStoreAttr(fltrAttr, newPoint)
+		}
+	}
 	if !lv.dropExemplars {
 		lv.res.Offer(ctx, value, droppedAttr)
 	}
 }
 
+// record stores value in p if p is not removed. It reports whether value was
+// stored.
+func (*lastValueMap[N]) record(p *lastValuePoint[N], value N) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.removed {
+		return false
+	}
+	p.value.Store(value)
+	p.updated = now().UnixNano()
+	return true
+}
+
+// removeStale removes p, stored for key, if its attribute set has not been
+// measured during the staleness of s before t. It reports whether p was
+// removed.
+func (s *lastValueMap[N]) removeStale(key any, p *lastValuePoint[N], t time.Time) bool {
+	if s.staleness <= 0 {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t.Sub(time.Unix(0, p.updated)) <= s.staleness {
+		return false
+	}
+	p.removed = true
+	s.values.Delete(key)
+	return true
+}
+
 func newDeltaLastValue[N int64 | float64](
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
@@ -145,11 +199,13 @@ type cumulativeLastValue[N int64 | float64] struct {
 func newCumulativeLastValue[N int64 | float64](
 	limit int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
+	staleness time.Duration,
 ) *cumulativeLastValue[N] {
 	return &cumulativeLastValue[N]{
 		lastValueMap: lastValueMap[N]{
-			newRes: r,
-			values: limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+			newRes:    r,
+			values:    limitedSyncMap[*lastValuePoint[N]]{aggLimit: limit},
+			staleness: staleness,
 		},
 		start: now(),
 	}
@@ -170,8 +226,11 @@ func (s *cumulativeLastValue[N]) collect(
 	perSeriesStartTimeEnabled := x.PerSeriesStartTimestamps.Enabled()
 
 	var i int
-	s.values.Range(func(_, value any) bool {
+	s.values.Range(func(key, value any) bool {
 		v := value.(*lastValuePoint[N])
+		if s.removeStale(key, v, t) {
+			return true
+		}
 
 		startTime := s.start
 		if perSeriesStartTimeEnabled {
@@ -189,10 +248,9 @@ func (s *cumulativeLastValue[N]) collect(
 		return true
 	})
 	gData.DataPoints = dPts
-	// TODO (#3006): This will use an unbounded amount of memory if there
-	// are unbounded number of attribute sets being aggregated. Attribute
-	// sets that become "stale" need to be forgotten so this will not
-	// overload the system.
+	// TODO (#3006): Unless a staleness is set, this will use an unbounded
+	// amount of memory if there are unbounded number of attribute sets being
+	// aggregated.
 	*dest = gData
 
 	return i
@@ -5,7 +5,10 @@ package aggregate
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -576,3 +579,90 @@ func BenchmarkLastValue(b *testing.B) {
 	b.Run("Int64", benchmarkAggregate(Builder[int64]{}.PrecomputedLastValue))
 	b.Run("Float64", benchmarkAggregate(Builder[float64]{}.PrecomputedLastValue))
 }
+
+func TestCumulativeLastValueStaleness(t *testing.T) {
+	t.Run("Int64", testCumulativeLastValueStaleness[int64])
+	t.Run("Float64", testCumulativeLastValueStaleness[float64])
+}
+
+func testCumulativeLastValueStaleness[N int64 | float64](t *testing.T) {
+	var current time.Time
+	orig := now
+	now = func() time.Time { return current }
+	t.Cleanup(func() { now = orig })
+
+	current = y2kPlus(0)
+	in, out := Builder[N]{
+		Temporality: metricdata.CumulativeTemporality,
+		Staleness:   time.Minute,
+	}.LastValue()
+
+	ctx := t.Context()
+	in(ctx, 1, alice)
+	in(ctx, 2, bob)
+
+	count := func() int {
+		var got metricdata.Aggregation
+		return out(&got)
+	}
+
+	current = y2kPlus(30)
+	in(ctx, 3, alice)
+	assert.Equal(t, 2, count(), "no attribute set is stale yet")
+
+	current = y2kPlus(61)
+	var got metricdata.Aggregation
+	require.Equal(t, 1, out(&got), "bob is stale")
+	gauge := got.(metricdata.Gauge[N])
+	require.Len(t, gauge.DataPoints, 1)
+	assert.Equal(t, alice, gauge.DataPoints[0].Attributes)
+	assert.Equal(t, N(3), gauge.DataPoints[0].Value)
+
+	current = y2kPlus(120)
+	assert.Equal(t, 0, count(), "alice is stale")
+
+	in(ctx, 4, bob)
+	assert.Equal(t, 1, count(), "bob is measured again")
+}
+
+func TestCumulativeLastValueStalenessConcurrentSafe(t *testing.T) {
+	var current atomic.Int64
+	orig := now
+	now = func() time.Time { return y2kPlus(current.Load()) }
+	t.Cleanup(func() { now = orig })
+
+	const staleness = time.Second
+	lv := newCumulativeLastValue[int64](0, dropExemplars[int64], staleness)
+
+	ctx := t.Context()
+	attrs := alice.Equivalent()
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		var got metricdata.Aggregation
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+			// Make every attribute set stale.
+			current.Add(2)
+			lv.collect(&got)
+		}
+	})
+
+	for i := range int64(10_000) {
+		before := current.Load()
+		lv.measure(ctx, i, alice, nil)
+		v, ok := lv.values.Load(attrs)
+		if current.Load() != before {
+			// The attribute set may be legitimately stale.
+			continue
+		}
+		require.True(t, ok, "measurement %d lost", i)
+		require.Equal(t, i, v.(*lastValuePoint[int64]).value.Load(), "measurement %d lost", i)
+	}
+	close(done)
+	wg.Wait()
+}
@@ -3,13 +3,21 @@
 
 package aggregate
 
-import "go.opentelemetry.io/otel/attribute"
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+)
 
 // overflowSet is the attribute set used to record a measurement when adding
 // another distinct attribute set to the aggregate would exceed the aggregate
 // limit.
 var overflowSet = attribute.NewSet(attribute.Bool("otel.metric.overflow", true))
 
+// overflowed counts the streams that exceeded their aggregation limit. It is
+// incremented once when the overflowSet series of a stream is created, not for
+// each measurement aggregated into it, see global.NewOverflowCounter.
+var overflowed = global.NewOverflowCounter("metric_streams")
+
 // limiter limits aggregate values.
 type limiter[V any] struct {
 	// aggLimit is the maximum number of metric streams that can be aggregated.
@@ -34,6 +42,9 @@ func (l limiter[V]) Attributes(attrs attribute.Set, measurements map[attribute.D
 	if l.aggLimit > 0 {
 		_, exists := measurements[attrs.Equivalent()]
 		if !exists && len(measurements) >= l.aggLimit-1 {
+			if _, ok := measurements[overflowSet.Equivalent()]; !ok {
+				overflowed.Add(1)
+			}
 			return overflowSet
 		}
 	}
@@ -13,7 +13,7 @@ import (
 )
 
 type sumValue[N int64 | float64] struct {
-	n             atomicCounter[N]
+	n             shardedCounter[N]
 	res           FilteredExemplarReservoir[N]
 	attrs         attribute.Set
 	startTime     time.Time
@@ -23,6 +23,9 @@ type sumValue[N int64 | float64] struct {
 type sumValueMap[N int64 | float64] struct {
 	newRes func(attribute.Set) FilteredExemplarReservoir[N]
 	values limitedSyncMap[*sumValue[N]]
+	// shards is the number of shards the measurements of each attribute set
+	// are spread across. Values less than or equal to 1 disable sharding.
+	shards int
 }
 
 func (s *sumValueMap[N]) measure(
@@ -31,16 +34,7 @@ func (s *sumValueMap[N]) measure(
 	fltrAttr attribute.Set,
 	droppedAttr []attribute.KeyValue,
 ) {
-	sv := s.values.LoadOrStoreAttr(fltrAttr, func(attr attribute.Set) *sumValue[N] {
-		r := s.newRes(attr)
-		_, isDrop := r.(*dropRes[N])
-		return &sumValue[N]{
-			res:           r,
-			attrs:         attr,
-			startTime:     now(),
-			dropExemplars: isDrop,
-		}
-	})
+	sv := s.values.LoadOrStoreAttr(fltrAttr, s.newValue)
 	sv.n.add(value)
 	// It is possible for collection to race with measurement and observe the
 	// exemplar in the batch of metrics after the add() for cumulative sums.
@@ -50,12 +44,34 @@ func (s *sumValueMap[N]) measure(
 	}
 }
 
+// store stores a zero sum for each of sets not stored yet.
+func (s *sumValueMap[N]) store(sets []attribute.Set) {
+	for _, fltrAttr := range sets {
+		_ = s.values.LoadOrStoreAttr(fltrAttr, s.newValue)
+	}
+}
+
+// newValue returns a new zero sum for attr.
+func (s *sumValueMap[N]) newValue(attr attribute.Set) *sumValue[N] {
+	r := s.newRes(attr)
+	_, isDrop := r.(*dropRes[N])
+	return &sumValue[N]{
+		n:             newShardedCounter[N](s.shards),
+		res:           r,
+		attrs:         attr,
+		startTime:     now(),
+		dropExemplars: isDrop,
+	}
+}
+
 // newDeltaSum returns an aggregator that summarizes a set of measurements as
 // their arithmetic sum. Each sum is scoped by attributes and the aggregation
-// cycle the measurements were made in.
+// cycle the measurements were made in. The measurements of each sum are spread
+// across the number of shards, if greater than 1.
 func newDeltaSum[N int64 | float64](
 	monotonic bool,
 	limit int,
+	shards int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *deltaSum[N] {
 	return &deltaSum[N]{
@@ -65,10 +81,12 @@ func newDeltaSum[N int64 | float64](
 			{
 				newRes: r,
 				values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				shards: shards,
 			},
 			{
 				newRes: r,
 				values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+				shards: shards,
 			},
 		},
 	}
@@ -81,6 +99,9 @@ type deltaSum[N int64 | float64] struct {
 
 	hcwg          hotColdWaitGroup
 	hotColdValMap [2]sumValueMap[N]
+
+	// sets are the attribute sets stored again after each collection.
+	sets []attribute.Set
 }
 
 func (s *deltaSum[N]) measure(ctx context.Context, value N, fltrAttr attribute.Set, droppedAttr []attribute.KeyValue) {
@@ -119,6 +140,7 @@ func (s *deltaSum[N]) collect(
 		return true
 	})
 	s.hotColdValMap[readIdx].values.Clear()
+	s.hotColdValMap[readIdx].store(s.sets)
 	// The delta collection cycle resets.
 	s.start = t
 
@@ -130,10 +152,12 @@ func (s *deltaSum[N]) collect(
 
 // newCumulativeSum returns an aggregator that summarizes a set of measurements
 // as their arithmetic sum. Each sum is scoped by attributes and the
-// aggregation cycle the measurements were made in.
+// aggregation cycle the measurements were made in. The measurements of each
+// sum are spread across the number of shards, if greater than 1.
 func newCumulativeSum[N int64 | float64](
 	monotonic bool,
 	limit int,
+	shards int,
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *cumulativeSum[N] {
 	return &cumulativeSum[N]{
@@ -142,6 +166,7 @@ func newCumulativeSum[N int64 | float64](
 		sumValueMap: sumValueMap[N]{
 			newRes: r,
 			values: limitedSyncMap[*sumValue[N]]{aggLimit: limit},
+			shards: shards,
 		},
 	}
 }
@@ -210,7 +235,7 @@ func newPrecomputedSum[N int64 | float64](
 	r func(attribute.Set) FilteredExemplarReservoir[N],
 ) *precomputedSum[N] {
 	return &precomputedSum[N]{
-		deltaSum: newDeltaSum(monotonic, limit, r),
+		deltaSum: newDeltaSum(monotonic, limit, 0, r),
 	}
 }
 
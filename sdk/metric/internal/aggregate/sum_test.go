@@ -576,11 +576,53 @@ func testCumulativePrecomputedSum[N int64 | float64]() func(t *testing.T) {
 	})
 }
 
+func TestSumAttributeSets(t *testing.T) {
+	for _, temporality := range []metricdata.Temporality{
+		metricdata.DeltaTemporality,
+		metricdata.CumulativeTemporality,
+	} {
+		t.Run(temporality.String(), func(t *testing.T) {
+			meas, comp := Builder[int64]{
+				Temporality:   temporality,
+				Filter:        attrFltr,
+				AttributeSets: []attribute.Set{alice},
+			}.Sum(true)
+
+			values := func() map[attribute.Set]int64 {
+				var got metricdata.Aggregation
+				comp(&got)
+				out := make(map[attribute.Set]int64)
+				for _, dp := range got.(metricdata.Sum[int64]).DataPoints {
+					out[dp.Attributes] = dp.Value
+				}
+				return out
+			}
+
+			assert.Equal(t, map[attribute.Set]int64{fltrAlice: 0}, values(), "not measured")
+
+			ctx := t.Context()
+			meas(ctx, 2, alice)
+			meas(ctx, 3, bob)
+			assert.Equal(t, map[attribute.Set]int64{fltrAlice: 2, fltrBob: 3}, values())
+
+			want := map[attribute.Set]int64{fltrAlice: 0}
+			if temporality == metricdata.CumulativeTemporality {
+				want = map[attribute.Set]int64{fltrAlice: 2, fltrBob: 3}
+			}
+			assert.Equal(t, want, values(), "after collection")
+		})
+	}
+}
+
 func TestSumConcurrentSafe(t *testing.T) {
 	t.Run("Int64/DeltaSum", testDeltaSumConcurrentSafe[int64]())
 	t.Run("Float64/DeltaSum", testDeltaSumConcurrentSafe[float64]())
 	t.Run("Int64/CumulativeSum", testCumulativeSumConcurrentSafe[int64]())
 	t.Run("Float64/CumulativeSum", testCumulativeSumConcurrentSafe[float64]())
+	t.Run("Int64/ShardedDeltaSum", testShardedSumConcurrentSafe[int64](metricdata.DeltaTemporality))
+	t.Run("Float64/ShardedDeltaSum", testShardedSumConcurrentSafe[float64](metricdata.DeltaTemporality))
+	t.Run("Int64/ShardedCumulativeSum", testShardedSumConcurrentSafe[int64](metricdata.CumulativeTemporality))
+	t.Run("Float64/ShardedCumulativeSum", testShardedSumConcurrentSafe[float64](metricdata.CumulativeTemporality))
 	t.Run("Int64/DeltaPrecomputedSum", testDeltaPrecomputedSumConcurrentSafe[int64]())
 	t.Run("Float64/DeltaPrecomputedSum", testDeltaPrecomputedSumConcurrentSafe[float64]())
 	t.Run("Int64/CumulativePrecomputedSum", testCumulativePrecomputedSumConcurrentSafe[int64]())
@@ -639,6 +681,16 @@ func testCumulativeSumConcurrentSafe[N int64 | float64]() func(t *testing.T) {
 	return testAggregationConcurrentSafe[N](in, out, validateSum[N](false))
 }
 
+func testShardedSumConcurrentSafe[N int64 | float64](temporality metricdata.Temporality) func(t *testing.T) {
+	in, out := Builder[N]{
+		Temporality:       temporality,
+		Filter:            attrFltr,
+		AggregationLimit:  3,
+		MeasurementShards: 4,
+	}.Sum(false)
+	return testAggregationConcurrentSafe[N](in, out, validateSum[N](false))
+}
+
 func testDeltaPrecomputedSumConcurrentSafe[N int64 | float64]() func(t *testing.T) {
 	in, out := Builder[N]{
 		Temporality:      metricdata.DeltaTemporality,
@@ -682,6 +734,19 @@ func BenchmarkSum(b *testing.B) {
 		}.Sum(false)
 	}))
 
+	b.Run("Sharded/Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
+		return Builder[int64]{
+			Temporality:       metricdata.CumulativeTemporality,
+			MeasurementShards: 8,
+		}.Sum(false)
+	}))
+	b.Run("Sharded/Float64/Cumulative", benchmarkAggregate(func() (Measure[float64], ComputeAggregation) {
+		return Builder[float64]{
+			Temporality:       metricdata.CumulativeTemporality,
+			MeasurementShards: 8,
+		}.Sum(false)
+	}))
+
 	b.Run("Precomputed/Int64/Cumulative", benchmarkAggregate(func() (Measure[int64], ComputeAggregation) {
 		return Builder[int64]{
 			Temporality: metricdata.CumulativeTemporality,
@@ -7,6 +7,7 @@ package observ
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -14,8 +15,10 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
 	"go.opentelemetry.io/otel/sdk"
 	"go.opentelemetry.io/otel/sdk/internal/x"
+	mx "go.opentelemetry.io/otel/sdk/metric/internal/x"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
@@ -34,6 +37,7 @@ var (
 		New: func() any {
 			const n = 1 + // component.name
 				1 + // component.type
+				1 + // otel.scope.name
 				1 // error.type
 			s := make([]attribute.KeyValue, 0, n)
 			// Return a pointer to a slice instead of a slice itself
@@ -42,6 +46,14 @@ var (
 		},
 	}
 
+	addOptPool = &sync.Pool{
+		New: func() any {
+			const n = 1 // WithAttributeSet
+			o := make([]metric.AddOption, 0, n)
+			return &o
+		},
+	}
+
 	recordOptPool = &sync.Pool{
 		New: func() any {
 			const n = 1 // WithAttributeSet
@@ -65,9 +77,23 @@ func ComponentName(componentType string, id int64) string {
 	return fmt.Sprintf("%s/%d", componentType, id)
 }
 
+// MeasurementDroppedName is the name of the metric counting the invalid
+// measurements dropped by the metric reader pipeline. It is not defined by
+// the semantic conventions and is only recorded if the experimental pipeline
+// observability is also enabled.
+const MeasurementDroppedName = "sdk.metric.measurement.dropped"
+
+// CallbackDurationName is the name of the metric recording the duration of
+// the callbacks of the observable instruments run by the metric reader
+// pipeline. It is not defined by the semantic conventions and is only
+// recorded if the experimental pipeline observability is also enabled.
+const CallbackDurationName = "sdk.metric.callback.duration"
+
 // Instrumentation is experimental instrumentation for the metric reader.
 type Instrumentation struct {
 	colDuration metric.Float64Histogram
+	dropped     metric.Int64Counter
+	cbDuration  metric.Float64Histogram
 
 	attrs  []attribute.KeyValue
 	recOpt metric.RecordOption
@@ -80,7 +106,9 @@ type Instrumentation struct {
 // The id should be the unique metric reader instance ID. It is used
 // to set the "component.name" attribute.
 //
-// If the experimental observability is disabled, nil is returned.
+// If the experimental observability is disabled, nil is returned. The metrics
+// not defined by the semantic conventions are only recorded if the
+// experimental pipeline observability is also enabled.
 func NewInstrumentation(componentType string, id int64) (*Instrumentation, error) {
 	if !x.Observability.Enabled() {
 		return nil, nil
@@ -102,15 +130,81 @@ func NewInstrumentation(componentType string, id int64) (*Instrumentation, error
 		metric.WithSchemaURL(SchemaURL),
 	)
 
-	colDuration, err := otelconv.NewSDKMetricReaderCollectionDuration(meter)
-	if err != nil {
-		err = fmt.Errorf("failed to create collection duration metric: %w", err)
+	var err error
+	colDuration, e := otelconv.NewSDKMetricReaderCollectionDuration(meter)
+	if e != nil {
+		err = fmt.Errorf("failed to create collection duration metric: %w", e)
 	}
 	i.colDuration = colDuration.Inst()
 
+	pipeline := mx.PipelineObservability.Enabled()
+
+	i.dropped = noop.Int64Counter{}
+	if pipeline {
+		i.dropped, e = meter.Int64Counter(
+			MeasurementDroppedName,
+			metric.WithDescription("The number of invalid measurements dropped before being aggregated."),
+			metric.WithUnit("{measurement}"),
+		)
+		if e != nil {
+			i.dropped = noop.Int64Counter{}
+			e = fmt.Errorf("failed to create dropped measurement metric: %w", e)
+			err = errors.Join(err, e)
+		}
+	}
+
+	i.cbDuration = noop.Float64Histogram{}
+	if pipeline {
+		i.cbDuration, e = meter.Float64Histogram(
+			CallbackDurationName,
+			metric.WithDescription("The duration of the callbacks of the observable instruments."),
+			metric.WithUnit("s"),
+		)
+		if e != nil {
+			i.cbDuration = noop.Float64Histogram{}
+			e = fmt.Errorf("failed to create callback duration metric: %w", e)
+			err = errors.Join(err, e)
+		}
+	}
+
 	return i, err
 }
 
+// CallbackDone records the duration d of a callback registered with a Meter
+// of the instrumentation scope named scope. Any error returned by the
+// callback is provided as err.
+func (i *Instrumentation) CallbackDone(ctx context.Context, scope string, d time.Duration, err error) {
+	attrs := get[attribute.KeyValue](measureAttrsPool)
+	defer put(measureAttrsPool, attrs)
+	*attrs = append(*attrs, i.attrs...)
+	*attrs = append(*attrs, semconv.OTelScopeName(scope))
+	if err != nil {
+		*attrs = append(*attrs, semconv.ErrorType(err))
+	}
+
+	recOpt := get[metric.RecordOption](recordOptPool)
+	defer put(recordOptPool, recOpt)
+	*recOpt = append(*recOpt, metric.WithAttributeSet(attribute.NewSet(*attrs...)))
+
+	i.cbDuration.Record(ctx, d.Seconds(), *recOpt...)
+}
+
+// MeasurementDropped records that a measurement was dropped because it was
+// invalid. The reason describes why the measurement was invalid and is
+// recorded as the error.type attribute.
+func (i *Instrumentation) MeasurementDropped(ctx context.Context, reason string) {
+	attrs := get[attribute.KeyValue](measureAttrsPool)
+	defer put(measureAttrsPool, attrs)
+	*attrs = append(*attrs, i.attrs...)
+	*attrs = append(*attrs, semconv.ErrorTypeKey.String(reason))
+
+	addOpt := get[metric.AddOption](addOptPool)
+	defer put(addOptPool, addOpt)
+	*addOpt = append(*addOpt, metric.WithAttributeSet(attribute.NewSet(*attrs...)))
+
+	i.dropped.Add(ctx, 1, *addOpt...)
+}
+
 // CollectMetrics instruments the collect method of metric reader. It returns an
 // [CollectOp] that must have its [CollectOp.End] method called when the
 // collection end.
@@ -6,6 +6,7 @@ package observ_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,10 @@ func (m *errMeter) Float64Histogram(string, ...mapi.Float64HistogramOption) (map
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Counter(string, ...mapi.Int64CounterOption) (mapi.Int64Counter, error) {
+	return nil, m.err
+}
+
 func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	orig := otel.GetMeterProvider()
 	t.Cleanup(func() { otel.SetMeterProvider(orig) })
@@ -59,11 +64,14 @@ func TestNewInstrumentationObservabilityErrors(t *testing.T) {
 	otel.SetMeterProvider(mp)
 
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+	t.Setenv("OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY", "true")
 
 	_, err := observ.NewInstrumentation(ComponentType, ID)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors should be joined")
 
 	assert.ErrorContains(t, err, "collection duration metric")
+	assert.ErrorContains(t, err, "dropped measurement metric")
+	assert.ErrorContains(t, err, "callback duration metric")
 }
 
 func TestNewInstrumentationObservabilityDisabled(t *testing.T) {
@@ -215,3 +223,85 @@ func BenchmarkInstrumentationCollectMetrics(b *testing.B) {
 	b.Run("NoError", run(nil))
 	b.Run("Error", run(err))
 }
+
+func TestInstrumentationMeasurementDropped(t *testing.T) {
+	t.Setenv("OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY", "true")
+	inst, collect := setup(t)
+
+	inst.MeasurementDropped(t.Context(), "nan")
+	inst.MeasurementDropped(t.Context(), "nan")
+	inst.MeasurementDropped(t.Context(), "negative")
+
+	got := collect()
+	require.Len(t, got.Metrics, 1)
+
+	attrs := func(reason string) attribute.Set {
+		return attribute.NewSet(
+			semconv.OTelComponentName(observ.ComponentName(ComponentType, ID)),
+			semconv.OTelComponentTypeKey.String(ComponentType),
+			semconv.ErrorTypeKey.String(reason),
+		)
+	}
+	want := metricdata.Metrics{
+		Name:        observ.MeasurementDroppedName,
+		Description: "The number of invalid measurements dropped before being aggregated.",
+		Unit:        "{measurement}",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attrs("nan"), Value: 2},
+				{Attributes: attrs("negative"), Value: 1},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, want, got.Metrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestInstrumentationPipelineDisabled(t *testing.T) {
+	// Do not set OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY.
+	inst, collect := setup(t)
+
+	inst.MeasurementDropped(t.Context(), "nan")
+	inst.CallbackDone(t.Context(), "a", time.Second, nil)
+	inst.CollectMetrics(t.Context()).End(nil)
+
+	got := collect()
+	require.Len(t, got.Metrics, 1)
+	assert.Equal(t, otelconv.SDKMetricReaderCollectionDuration{}.Name(), got.Metrics[0].Name)
+}
+
+func TestInstrumentationCallbackDone(t *testing.T) {
+	t.Setenv("OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY", "true")
+	inst, collect := setup(t)
+
+	err := errors.New("callback error")
+	inst.CallbackDone(t.Context(), "a", time.Second, nil)
+	inst.CallbackDone(t.Context(), "a", 2*time.Second, nil)
+	inst.CallbackDone(t.Context(), "b", time.Second, err)
+
+	got := collect()
+	require.Len(t, got.Metrics, 1)
+
+	attrs := func(scope string, err error) attribute.Set {
+		return attribute.NewSet(append(baseAttrs(err), semconv.OTelScopeName(scope))...)
+	}
+	want := metricdata.Metrics{
+		Name:        observ.CallbackDurationName,
+		Description: "The duration of the callbacks of the observable instruments.",
+		Unit:        "s",
+		Data: metricdata.Histogram[float64]{
+			Temporality: metricdata.CumulativeTemporality,
+			DataPoints: []metricdata.HistogramDataPoint[float64]{
+				{Attributes: attrs("a", nil), Count: 2, Sum: 3},
+				{Attributes: attrs("b", err), Count: 1, Sum: 1},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(
+		t, want, got.Metrics[0],
+		metricdatatest.IgnoreTimestamp(),
+		metricdatatest.IgnoreExemplars(),
+		metricdatatest.IgnoreValue(),
+	)
+}
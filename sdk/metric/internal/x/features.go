@@ -3,7 +3,10 @@
 
 package x
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+)
 
 // MetricExportBatchSize is an experimental feature flag that controls the
 // max export batch size for metric data.
@@ -20,3 +23,46 @@ var MetricExportBatchSize = newFeature(
 		return 0, false
 	},
 )
+
+// PipelineObservability is an experimental feature flag that enables the
+// observability metrics of the metric pipeline not defined by the semantic
+// conventions, e.g. the count of the dropped invalid measurements or the
+// duration of the callbacks of the observable instruments. They are
+// only recorded if the SDK observability, OTEL_GO_X_OBSERVABILITY, is also
+// enabled.
+//
+// To enable this feature set the OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY
+// environment variable to the case-insensitive string value of "true".
+var PipelineObservability = newFeature(
+	[]string{"METRIC_PIPELINE_OBSERVABILITY"},
+	func(v string) (string, bool) {
+		if strings.EqualFold(v, "true") {
+			return v, true
+		}
+		return "", false
+	},
+)
+
+// maxMeasurementShards is the maximum number of measurement shards.
+const maxMeasurementShards = 256
+
+// MeasurementShards is an experimental feature flag that controls the number
+// of shards the synchronous Counter and UpDownCounter measurements of an
+// attribute set are spread across. The shards are merged when collected.
+// Sharding removes the contention between cores recording measurements for
+// the same attribute set concurrently, at the cost of memory for each
+// attribute set.
+//
+// To enable this feature set the OTEL_GO_X_METRIC_MEASUREMENT_SHARDS
+// environment variable to an integer value greater than 1. Values greater
+// than 256 are limited to 256.
+var MeasurementShards = newFeature(
+	[]string{"METRIC_MEASUREMENT_SHARDS"},
+	func(v string) (int, bool) {
+		val, err := strconv.Atoi(v)
+		if err != nil || val <= 1 {
+			return 0, false
+		}
+		return min(val, maxMeasurementShards), true
+	},
+)
@@ -38,3 +38,56 @@ func TestMetricExportBatchSize(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelineObservability(t *testing.T) {
+	const key = "OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY"
+	require.Contains(t, PipelineObservability.Keys(), key)
+
+	tests := []struct {
+		value   string
+		enabled bool
+	}{
+		{value: "", enabled: false},
+		{value: "false", enabled: false},
+		{value: "invalid", enabled: false},
+		{value: "true", enabled: true},
+		{value: "TRUE", enabled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Setenv(key, tt.value)
+			assert.Equal(t, tt.enabled, PipelineObservability.Enabled())
+		})
+	}
+}
+
+func TestMeasurementShards(t *testing.T) {
+	const key = "OTEL_GO_X_METRIC_MEASUREMENT_SHARDS"
+	require.Contains(t, MeasurementShards.Keys(), key)
+
+	tests := []struct {
+		name    string
+		value   string
+		enabled bool
+		want    int
+	}{
+		{name: "empty", value: "", enabled: false, want: 0},
+		{name: "invalid", value: "invalid", enabled: false, want: 0},
+		{name: "zero", value: "0", enabled: false, want: 0},
+		{name: "one", value: "1", enabled: false, want: 0},
+		{name: "negative", value: "-10", enabled: false, want: 0},
+		{name: "valid", value: "8", enabled: true, want: 8},
+		{name: "bounded", value: "1000", enabled: true, want: 256},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(key, tt.value)
+			assert.Equal(t, tt.enabled, MeasurementShards.Enabled())
+			got, ok := MeasurementShards.Lookup()
+			assert.Equal(t, tt.enabled, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// InstrumentInfo describes an instrument created by a Meter of a
+// MeterProvider.
+type InstrumentInfo struct {
+	// Scope identifies the instrumentation that created the instrument.
+	Scope instrumentation.Scope
+	// Name is the name the instrument was created with.
+	Name string
+	// Description is the description the instrument was created with.
+	Description string
+	// Unit is the unit the instrument was created with.
+	Unit string
+	// Kind is the kind of the instrument.
+	Kind InstrumentKind
+	// Number is the type of the measurements of the instrument: "int64" or
+	// "float64".
+	Number string
+	// Advice is the advice the instrument was created with.
+	Advice InstrumentAdvice
+}
+
+// InstrumentAdvice is the advice an instrument was created with.
+type InstrumentAdvice struct {
+	// ExplicitBucketBoundaries are the advised bucket boundaries of a
+	// histogram. It is nil if no boundaries were advised.
+	ExplicitBucketBoundaries []float64
+	// AttributeKeys are the advised attribute keys of the instrument. It is
+	// nil if no attribute keys were advised.
+	AttributeKeys []attribute.Key
+}
+
+// Instruments returns the instruments created by the Meters of mp, including
+// instruments that failed validation. Instruments created multiple times with
+// the same identifying fields are only returned once.
+//
+// The instruments are returned sorted by scope name, scope version, and
+// instrument name. This is intended to build debug endpoints and to validate
+// the instruments expected to be created exist in tests.
+//
+// This method is safe to call concurrently.
+func (mp *MeterProvider) Instruments() []InstrumentInfo {
+	var out []InstrumentInfo
+	for _, m := range mp.meters.Values() {
+		out = append(out, m.registered.instruments()...)
+	}
+	slices.SortStableFunc(out, func(a, b InstrumentInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.Scope.Name, b.Scope.Name),
+			cmp.Compare(a.Scope.Version, b.Scope.Version),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return out
+}
+
+// registry records the instruments created by a meter.
+type registry struct {
+	mu    sync.Mutex
+	insts []InstrumentInfo
+}
+
+func (r *registry) add(info InstrumentInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.insts = append(r.insts, info)
+}
+
+func (r *registry) instruments() []InstrumentInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.insts)
+}
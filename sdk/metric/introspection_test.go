@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/x"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+func TestMeterProviderInstruments(t *testing.T) {
+	mp := NewMeterProvider()
+	assert.Empty(t, mp.Instruments())
+
+	m0 := mp.Meter("scope0", api.WithInstrumentationVersion("v1"))
+	_, err := m0.Int64Counter("requests", api.WithUnit("{request}"), api.WithDescription("Requests"))
+	require.NoError(t, err)
+	_, err = m0.Int64Counter("requests", api.WithUnit("{request}"), api.WithDescription("Requests"))
+	require.NoError(t, err)
+	_, err = m0.Float64Histogram("duration", api.WithUnit("s"), api.WithExplicitBucketBoundaries(0.1, 1))
+	require.NoError(t, err)
+
+	m1 := mp.Meter("scope1")
+	_, err = m1.Float64ObservableGauge("temperature", api.WithFloat64Callback(
+		func(context.Context, api.Float64Observer) error { return nil },
+	))
+	require.NoError(t, err)
+
+	scope0 := instrumentation.Scope{Name: "scope0", Version: "v1"}
+	scope1 := instrumentation.Scope{Name: "scope1"}
+	want := []InstrumentInfo{
+		{
+			Scope:  scope0,
+			Name:   "duration",
+			Unit:   "s",
+			Kind:   InstrumentKindHistogram,
+			Number: "float64",
+			Advice: InstrumentAdvice{ExplicitBucketBoundaries: []float64{0.1, 1}},
+		},
+		{
+			Scope:       scope0,
+			Name:        "requests",
+			Description: "Requests",
+			Unit:        "{request}",
+			Kind:        InstrumentKindCounter,
+			Number:      "int64",
+		},
+		{
+			Scope:  scope1,
+			Name:   "temperature",
+			Kind:   InstrumentKindObservableGauge,
+			Number: "float64",
+		},
+	}
+	assert.Equal(t, want, mp.Instruments())
+}
+
+func TestMeterProviderInstrumentsAttributeAdvice(t *testing.T) {
+	mp := NewMeterProvider()
+	_, err := mp.Meter("scope").Int64Counter("counter", x.WithDefaultAttributes("key"))
+	require.NoError(t, err)
+
+	got := mp.Instruments()
+	require.Len(t, got, 1)
+	assert.Equal(t, []attribute.Key{"key"}, got[0].Advice.AttributeKeys)
+}
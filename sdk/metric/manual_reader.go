@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
@@ -32,9 +34,18 @@ type ManualReader struct {
 	isShutdown        bool
 	externalProducers atomic.Value
 
-	temporalitySelector      TemporalitySelector
-	aggregationSelector      AggregationSelector
-	cardinalityLimitSelector CardinalityLimitSelector
+	temporalitySelector        TemporalitySelector
+	aggregationSelector        AggregationSelector
+	cardinalityLimitSelector   CardinalityLimitSelector
+	invalidMeasurementSelector InvalidMeasurementSelector
+
+	// filter is the attribute filter of the streams without one set by a
+	// View, if not nil.
+	filter attribute.Filter
+
+	// cache holds the last collection if the reader is configured with
+	// WithCollectCache, it is nil otherwise.
+	cache *collectCache
 
 	inst *observ.Instrumentation
 }
@@ -46,11 +57,16 @@ var _ = map[Reader]struct{}{&ManualReader{}: {}}
 func NewManualReader(opts ...ManualReaderOption) *ManualReader {
 	cfg := newManualReaderConfig(opts)
 	r := &ManualReader{
-		temporalitySelector:      cfg.temporalitySelector,
-		aggregationSelector:      cfg.aggregationSelector,
-		cardinalityLimitSelector: cfg.cardinalityLimitSelector,
+		temporalitySelector:        cfg.temporalitySelector,
+		aggregationSelector:        cfg.aggregationSelector,
+		cardinalityLimitSelector:   cfg.cardinalityLimitSelector,
+		invalidMeasurementSelector: cfg.invalidMeasurementSelector,
+		filter:                     cfg.attributeFilter,
 	}
 	r.externalProducers.Store(cfg.producers)
+	if cfg.cacheDuration > 0 {
+		r.cache = &collectCache{ttl: cfg.cacheDuration, now: time.Now}
+	}
 
 	var err error
 	r.inst, err = observ.NewInstrumentation(manualReaderType, nextManualReaderID())
@@ -96,6 +112,18 @@ func (mr *ManualReader) cardinalityLimit(kind InstrumentKind) (int, bool) {
 	return mr.cardinalityLimitSelector(kind)
 }
 
+// invalidMeasurement returns the action to take for invalid measurements of
+// kind.
+func (mr *ManualReader) invalidMeasurement(kind InstrumentKind) (InvalidMeasurementAction, bool) {
+	return mr.invalidMeasurementSelector(kind)
+}
+
+// attributeFilter returns the attribute filter of the streams without one
+// set by a View.
+func (mr *ManualReader) attributeFilter() attribute.Filter {
+	return mr.filter
+}
+
 // Shutdown closes any connections and frees any resources used by the reader.
 //
 // This method is safe to call concurrently.
@@ -106,6 +134,9 @@ func (mr *ManualReader) Shutdown(context.Context) error {
 		mr.sdkProducer.Store(produceHolder{
 			produce: shutdownProducer{}.produce,
 		})
+		if mr.cache != nil {
+			mr.cache.reset()
+		}
 		mr.mu.Lock()
 		defer mr.mu.Unlock()
 		mr.isShutdown = true
@@ -122,9 +153,24 @@ func (mr *ManualReader) Shutdown(context.Context) error {
 // Collect will return an error if called after shutdown.
 // Collect will return an error if rm is a nil ResourceMetrics.
 // Collect will return an error if the context's Done channel is closed.
+// If it is closed while the registered callbacks are run, Collect returns
+// without waiting for the running callback and skips the remaining ones. The
+// data collected so far is stored in rm along with the returned error.
+//
+// If the ManualReader is configured with WithCollectCache, the data of a
+// recent collection can be stored in rm instead, see WithCollectCache.
 //
 // This method is safe to call concurrently.
 func (mr *ManualReader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if mr.cache != nil && rm != nil {
+		return mr.cache.collect(ctx, rm, mr.collect)
+	}
+	return mr.collect(ctx, rm)
+}
+
+// collect gathers all metric data related to the Reader from the SDK and
+// other Producers and stores the result in rm.
+func (mr *ManualReader) collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
 	var err error
 	if mr.inst != nil {
 		cp := mr.inst.CollectMetrics(ctx)
@@ -186,18 +232,22 @@ func (mr *ManualReader) MarshalLog() any {
 
 // manualReaderConfig contains configuration options for a ManualReader.
 type manualReaderConfig struct {
-	temporalitySelector      TemporalitySelector
-	aggregationSelector      AggregationSelector
-	cardinalityLimitSelector CardinalityLimitSelector
-	producers                []Producer
+	temporalitySelector        TemporalitySelector
+	aggregationSelector        AggregationSelector
+	cardinalityLimitSelector   CardinalityLimitSelector
+	invalidMeasurementSelector InvalidMeasurementSelector
+	producers                  []Producer
+	cacheDuration              time.Duration
+	attributeFilter            attribute.Filter
 }
 
 // newManualReaderConfig returns a manualReaderConfig configured with options.
 func newManualReaderConfig(opts []ManualReaderOption) manualReaderConfig {
 	cfg := manualReaderConfig{
-		temporalitySelector:      DefaultTemporalitySelector,
-		aggregationSelector:      DefaultAggregationSelector,
-		cardinalityLimitSelector: defaultCardinalityLimitSelector,
+		temporalitySelector:        DefaultTemporalitySelector,
+		aggregationSelector:        DefaultAggregationSelector,
+		cardinalityLimitSelector:   defaultCardinalityLimitSelector,
+		invalidMeasurementSelector: defaultInvalidMeasurementSelector,
 	}
 	for _, opt := range opts {
 		cfg = opt.applyManual(cfg)
@@ -244,3 +294,108 @@ func (t aggregationSelectorOption) applyManual(c manualReaderConfig) manualReade
 	c.aggregationSelector = t.selector
 	return c
 }
+
+// WithCollectCache configures a ManualReader to cache the data of a
+// collection for d. The calls to Collect made within d after a collection
+// completed store its data in their ResourceMetrics instead of collecting
+// again. Concurrent calls to Collect made while no cached data is available
+// wait for a single collection and all store its data. This avoids running
+// the callbacks of observable instruments and aggregating the data for every
+// call, e.g. when several Prometheus servers scrape the same endpoint.
+//
+// The data stored in the ResourceMetrics of the Collect calls served by the
+// same collection is shared between them. It must not be modified.
+//
+// A collection returning an error is not cached. If d is not positive, the
+// data is not cached, which is the default.
+func WithCollectCache(d time.Duration) ManualReaderOption {
+	return collectCacheOption{d: d}
+}
+
+type collectCacheOption struct {
+	d time.Duration
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o collectCacheOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.cacheDuration = o.d
+	return c
+}
+
+// collectCache caches the data of the last collection of a ManualReader.
+type collectCache struct {
+	ttl time.Duration
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+
+	mu sync.Mutex
+	// rm is the data of the last successful collection, made at time at. It
+	// is never modified after it is stored.
+	rm *metricdata.ResourceMetrics
+	at time.Time
+	// pending is closed when the running collection completes. It is nil if
+	// no collection is running.
+	pending chan struct{}
+	// stopped is true once the reader is shut down.
+	stopped bool
+}
+
+// collect stores the cached data in rm if it is not older than the ttl of
+// c. Otherwise, it stores the data of a new collection made with f, or of
+// the collection already running, in rm.
+func (c *collectCache) collect(
+	ctx context.Context,
+	rm *metricdata.ResourceMetrics,
+	f func(context.Context, *metricdata.ResourceMetrics) error,
+) error {
+	for {
+		c.mu.Lock()
+		if c.rm != nil && c.now().Sub(c.at) < c.ttl {
+			*rm = *c.rm
+			c.mu.Unlock()
+			return nil
+		}
+		pending := c.pending
+		if pending == nil {
+			break
+		}
+		c.mu.Unlock()
+
+		// Wait for the running collection and use its data, or collect if it
+		// failed.
+		select {
+		case <-pending:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// The lock is held, start a collection.
+	pending := make(chan struct{})
+	c.pending = pending
+	c.mu.Unlock()
+
+	// Collect in new memory, the cached data can still be read by the
+	// callers it was stored for.
+	next := new(metricdata.ResourceMetrics)
+	err := f(ctx, next)
+
+	c.mu.Lock()
+	if err == nil && !c.stopped {
+		c.rm, c.at = next, c.now()
+	}
+	c.pending = nil
+	close(pending)
+	c.mu.Unlock()
+
+	*rm = *next
+	return err
+}
+
+// reset removes the cached data and stops caching new data.
+func (c *collectCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rm = nil
+	c.stopped = true
+}
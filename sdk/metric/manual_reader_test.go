@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -401,3 +403,103 @@ func BenchmarkManualReaderInstrumentation(b *testing.B) {
 		run(b, true)
 	})
 }
+
+func TestManualReaderCollectCache(t *testing.T) {
+	rdr := NewManualReader(WithCollectCache(time.Minute))
+	now := time.Now()
+	rdr.cache.now = func() time.Time { return now }
+
+	var calls int64
+	mp := NewMeterProvider(WithReader(rdr))
+	_, err := mp.Meter("test").Int64ObservableCounter(
+		"calls",
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			calls++
+			o.Observe(calls)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	value := func(rm *metricdata.ResourceMetrics) int64 {
+		t.Helper()
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.Len(t, sum.DataPoints, 1)
+		return sum.DataPoints[0].Value
+	}
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, rdr.Collect(t.Context(), &rm))
+	assert.Equal(t, int64(1), value(&rm))
+
+	now = now.Add(time.Minute - 1)
+	rm = metricdata.ResourceMetrics{}
+	require.NoError(t, rdr.Collect(t.Context(), &rm))
+	assert.Equal(t, int64(1), value(&rm), "cached collection not used")
+	assert.Equal(t, int64(1), calls, "callback run within cache duration")
+
+	now = now.Add(1)
+	require.NoError(t, rdr.Collect(t.Context(), &rm))
+	assert.Equal(t, int64(2), value(&rm), "expired collection used")
+
+	require.NoError(t, rdr.Shutdown(t.Context()))
+	assert.ErrorIs(t, rdr.Collect(t.Context(), &rm), ErrReaderShutdown)
+}
+
+func TestManualReaderCollectCacheConcurrent(t *testing.T) {
+	rdr := NewManualReader(WithCollectCache(time.Minute))
+
+	var calls atomic.Int64
+	release := make(chan struct{})
+	mp := NewMeterProvider(WithReader(rdr))
+	_, err := mp.Meter("test").Int64ObservableGauge(
+		"gauge",
+		metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+			calls.Add(1)
+			<-release
+			o.Observe(1)
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	const n = 5
+	var wg sync.WaitGroup
+	rms := make([]metricdata.ResourceMetrics, n)
+	errs := make([]error, n)
+	for i := range n {
+		wg.Go(func() { errs[i] = rdr.Collect(t.Context(), &rms[i]) })
+	}
+	// Let the collections start and wait on the first one.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int64(1), calls.Load(), "callback not run once")
+	for i := range n {
+		assert.NoError(t, errs[i])
+		assert.Len(t, rms[i].ScopeMetrics, 1)
+	}
+}
+
+func TestManualReaderCollectCacheError(t *testing.T) {
+	rdr := NewManualReader(WithCollectCache(time.Minute))
+
+	var calls int
+	mp := NewMeterProvider(WithReader(rdr))
+	_, err := mp.Meter("test").Int64ObservableGauge(
+		"gauge",
+		metric.WithInt64Callback(func(context.Context, metric.Int64Observer) error {
+			calls++
+			return assert.AnError
+		}),
+	)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	assert.ErrorIs(t, rdr.Collect(t.Context(), &rm), assert.AnError)
+	assert.ErrorIs(t, rdr.Collect(t.Context(), &rm), assert.AnError)
+	assert.Equal(t, 2, calls, "failed collection cached")
+}
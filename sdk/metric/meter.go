@@ -7,6 +7,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
@@ -14,6 +16,7 @@ import (
 	"go.opentelemetry.io/otel/metric/embedded"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+	"go.opentelemetry.io/otel/sdk/metric/internal/attrnorm"
 )
 
 // ErrInstrumentName indicates the created instrument has an invalid name.
@@ -27,6 +30,17 @@ var ErrInstrumentName = errors.New("invalid instrument name")
 type meter struct {
 	embedded.Meter
 
+	// meterState is shared by all the meters of the instrumentation scope.
+	*meterState
+
+	// attrs are recorded with all the measurements of the instruments
+	// created by the meter.
+	attrs attribute.Set
+}
+
+// meterState is the state of a meter shared by all the meters of its
+// instrumentation scope, whatever their attributes.
+type meterState struct {
 	scope instrumentation.Scope
 	pipes pipelines
 
@@ -37,6 +51,11 @@ type meter struct {
 
 	int64Resolver   resolver[int64]
 	float64Resolver resolver[float64]
+
+	registered registry
+
+	// unitValidation is how the units of the instruments are validated.
+	unitValidation UnitValidation
 }
 
 func newMeter(s instrumentation.Scope, p pipelines) *meter {
@@ -49,7 +68,7 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 	var int64ObservableInsts cacheWithErr[instID, int64Observable]
 	var float64ObservableInsts cacheWithErr[instID, float64Observable]
 
-	return &meter{
+	return &meter{meterState: &meterState{
 		scope:                  s,
 		pipes:                  p,
 		int64Insts:             &int64Insts,
@@ -58,7 +77,34 @@ func newMeter(s instrumentation.Scope, p pipelines) *meter {
 		float64ObservableInsts: &float64ObservableInsts,
 		int64Resolver:          newResolver[int64](p, &viewCache),
 		float64Resolver:        newResolver[float64](p, &viewCache),
+	}}
+}
+
+// withAttributes returns a meter sharing the state of m recording attrs with
+// all the measurements of its instruments, see x.WithMeterAttributes.
+func (m *meter) withAttributes(attrs attribute.Set) *meter {
+	if attrs.Len() == 0 {
+		return m
 	}
+	attrs, _ = attrnorm.Set(attrs)
+	return &meter{meterState: m.meterState, attrs: attrs}
+}
+
+// constantAttributes returns the attributes recorded with all the
+// measurements of an instrument created by m with opts: the attributes of m
+// merged with the ones of the x.WithInstrumentAttributes options of opts.
+func constantAttributes[T any](m *meter, opts []T) attribute.Set {
+	attrs := m.attrs
+	for _, o := range opts {
+		exp, ok := any(o).(interface{ InstrumentAttributes() attribute.Set })
+		if !ok {
+			continue
+		}
+		set, _ := attrnorm.Set(exp.InstrumentAttributes())
+		// The attributes of the later options take precedence.
+		attrs = attrs.Union(&set)
+	}
+	return attrs
 }
 
 // Compile-time check meter implements metric.Meter.
@@ -71,7 +117,16 @@ func (m *meter) Int64Counter(name string, options ...metric.Int64CounterOption)
 	cfg := metric.NewInt64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		0,
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -89,7 +144,16 @@ func (m *meter) Int64UpDownCounter(
 	cfg := metric.NewInt64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		0,
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -104,6 +168,7 @@ func (m *meter) Int64Histogram(name string, options ...metric.Int64HistogramOpti
 	cfg := metric.NewInt64HistogramConfig(options...)
 	p := int64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg, defaultAttributes(options))
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -118,7 +183,16 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 	cfg := metric.NewInt64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := int64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		staleness(options),
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -131,8 +205,10 @@ func (m *meter) Int64Gauge(name string, options ...metric.Int64GaugeOption) (met
 func (m *meter) int64ObservableInstrument(
 	id Instrument,
 	allowedKeys []attribute.Key,
+	attrs attribute.Set,
 	callbacks []metric.Int64Callback,
 ) (int64Observable, error) {
+	id.Unit = checkUnit(m.unitValidation, id.Name, id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -142,7 +218,16 @@ func (m *meter) int64ObservableInstrument(
 	if m.int64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
+	o, err := m.int64ObservableInsts.Lookup(key, func() (int64Observable, error) {
+		m.registered.add(InstrumentInfo{
+			Scope:       m.scope,
+			Name:        id.Name,
+			Description: id.Description,
+			Unit:        id.Unit,
+			Kind:        id.Kind,
+			Number:      "int64",
+			Advice:      InstrumentAdvice{AttributeKeys: allowedKeys},
+		})
 		inst := newInt64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.int64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
@@ -163,13 +248,21 @@ func (m *meter) int64ObservableInstrument(
 			// is not part of the pipeline.
 			insert.pipeline.addInt64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := int64Observer{measures: in}
+				inst := int64Observer{pipe: insert.pipeline, measures: in, attrs: attrs}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(callback{
+					scope:       m.scope,
+					instruments: []string{id.Name},
+					fn:          func(ctx context.Context) error { return fn(ctx, inst) },
+				})
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
 	})
+	// The cached observable is shared by the meters of the scope, the
+	// returned one records the attributes of this meter and options.
+	o.attrs = attrs
+	return o, err
 }
 
 // Int64ObservableCounter returns a new instrument identified by name and
@@ -192,8 +285,10 @@ func (m *meter) Int64ObservableCounter(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
+
+		deltaObservations: !precomputedSum(options),
 	}
-	return m.int64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.int64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 // Int64ObservableUpDownCounter returns a new instrument identified by name and
@@ -216,8 +311,10 @@ func (m *meter) Int64ObservableUpDownCounter(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
+
+		deltaObservations: !precomputedSum(options),
 	}
-	return m.int64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.int64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 // Int64ObservableGauge returns a new instrument identified by name and
@@ -241,7 +338,7 @@ func (m *meter) Int64ObservableGauge(
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
 	}
-	return m.int64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.int64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 // Float64Counter returns a new instrument identified by name and configured
@@ -251,7 +348,16 @@ func (m *meter) Float64Counter(name string, options ...metric.Float64CounterOpti
 	cfg := metric.NewFloat64CounterConfig(options...)
 	const kind = InstrumentKindCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		0,
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -269,7 +375,16 @@ func (m *meter) Float64UpDownCounter(
 	cfg := metric.NewFloat64UpDownCounterConfig(options...)
 	const kind = InstrumentKindUpDownCounter
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		0,
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -287,6 +402,7 @@ func (m *meter) Float64Histogram(
 	cfg := metric.NewFloat64HistogramConfig(options...)
 	p := float64InstProvider{m}
 	i, err := p.lookupHistogram(name, cfg, defaultAttributes(options))
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -301,7 +417,16 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 	cfg := metric.NewFloat64GaugeConfig(options...)
 	const kind = InstrumentKindGauge
 	p := float64InstProvider{m}
-	i, err := p.lookup(kind, name, cfg.Description(), cfg.Unit(), defaultAttributes(options))
+	i, err := p.lookup(
+		kind,
+		name,
+		cfg.Description(),
+		cfg.Unit(),
+		defaultAttributes(options),
+		staleness(options),
+		attributeSets(options),
+	)
+	i = i.withAttributes(constantAttributes(m, options))
 	if err != nil {
 		return i, err
 	}
@@ -314,8 +439,10 @@ func (m *meter) Float64Gauge(name string, options ...metric.Float64GaugeOption)
 func (m *meter) float64ObservableInstrument(
 	id Instrument,
 	allowedKeys []attribute.Key,
+	attrs attribute.Set,
 	callbacks []metric.Float64Callback,
 ) (float64Observable, error) {
+	id.Unit = checkUnit(m.unitValidation, id.Name, id.Unit)
 	key := instID{
 		Name:        id.Name,
 		Description: id.Description,
@@ -325,7 +452,16 @@ func (m *meter) float64ObservableInstrument(
 	if m.float64ObservableInsts.HasKey(key) && len(callbacks) > 0 {
 		warnRepeatedObservableCallbacks(id)
 	}
-	return m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
+	o, err := m.float64ObservableInsts.Lookup(key, func() (float64Observable, error) {
+		m.registered.add(InstrumentInfo{
+			Scope:       m.scope,
+			Name:        id.Name,
+			Description: id.Description,
+			Unit:        id.Unit,
+			Kind:        id.Kind,
+			Number:      "float64",
+			Advice:      InstrumentAdvice{AttributeKeys: allowedKeys},
+		})
 		inst := newFloat64Observable(m, id.Kind, id.Name, id.Description, id.Unit)
 		for _, insert := range m.float64Resolver.inserters {
 			// Connect the measure functions for instruments in this pipeline with the
@@ -346,13 +482,21 @@ func (m *meter) float64ObservableInstrument(
 			// is not part of the pipeline.
 			insert.pipeline.addFloat64Measure(inst.observableID, in)
 			for _, cback := range callbacks {
-				inst := float64Observer{measures: in}
+				inst := float64Observer{pipe: insert.pipeline, measures: in, attrs: attrs}
 				fn := cback
-				insert.addCallback(func(ctx context.Context) error { return fn(ctx, inst) })
+				insert.addCallback(callback{
+					scope:       m.scope,
+					instruments: []string{id.Name},
+					fn:          func(ctx context.Context) error { return fn(ctx, inst) },
+				})
 			}
 		}
 		return inst, validateInstrumentName(id.Name)
 	})
+	// The cached observable is shared by the meters of the scope, the
+	// returned one records the attributes of this meter and options.
+	o.attrs = attrs
+	return o, err
 }
 
 // Float64ObservableCounter returns a new instrument identified by name and
@@ -375,8 +519,10 @@ func (m *meter) Float64ObservableCounter(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableCounter,
 		Scope:       m.scope,
+
+		deltaObservations: !precomputedSum(options),
 	}
-	return m.float64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.float64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 // Float64ObservableUpDownCounter returns a new instrument identified by name
@@ -399,8 +545,10 @@ func (m *meter) Float64ObservableUpDownCounter(
 		Unit:        cfg.Unit(),
 		Kind:        InstrumentKindObservableUpDownCounter,
 		Scope:       m.scope,
+
+		deltaObservations: !precomputedSum(options),
 	}
-	return m.float64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.float64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 // Float64ObservableGauge returns a new instrument identified by name and
@@ -424,7 +572,7 @@ func (m *meter) Float64ObservableGauge(
 		Kind:        InstrumentKindObservableGauge,
 		Scope:       m.scope,
 	}
-	return m.float64ObservableInstrument(id, defaultAttributes(options), cfg.Callbacks())
+	return m.float64ObservableInstrument(id, defaultAttributes(options), constantAttributes(m, options), cfg.Callbacks())
 }
 
 func validateInstrumentName(name string) error {
@@ -521,6 +669,16 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		return noopRegister{}, err
 	}
 
+	names := make([]string, 0, len(validInstruments))
+	for _, inst := range validInstruments {
+		switch o := inst.(type) {
+		case int64Observable:
+			names = append(names, o.name)
+		case float64Observable:
+			names = append(names, o.name)
+		}
+	}
+
 	unregs := make([]func(), len(m.pipes))
 	for ix, pipe := range m.pipes {
 		reg := newObserver(pipe)
@@ -534,8 +692,11 @@ func (m *meter) RegisterCallback(f metric.Callback, insts ...metric.Observable)
 		}
 
 		// Some or all instruments were valid.
-		cBack := func(ctx context.Context) error { return f(ctx, reg) }
-		unregs[ix] = pipe.addMultiCallback(cBack)
+		unregs[ix] = pipe.addMultiCallback(callback{
+			scope:       m.scope,
+			instruments: names,
+			fn:          func(ctx context.Context) error { return f(ctx, reg) },
+		})
 	}
 
 	return unregisterFuncs{f: unregs}, err
@@ -592,9 +753,13 @@ func (r observer) ObserveFloat64(o metric.Float64Observable, v float64, opts ...
 		}
 		return
 	}
+	if !r.pipe.startObservation() {
+		return
+	}
+	defer r.pipe.endObservation()
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	set := resolveAttributes(c.Attributes(), rawKVs)
+	set := resolveAttributes(oImpl.attrs, c.Attributes(), rawKVs)
 	// Access to r.pipe.float64Measure is already guarded by a lock in pipeline.produce.
 	// TODO (#5946): Refactor pipeline and observable measures.
 	measures := r.pipe.float64Measures[oImpl.observableID]
@@ -625,9 +790,13 @@ func (r observer) ObserveInt64(o metric.Int64Observable, v int64, opts ...metric
 		}
 		return
 	}
+	if !r.pipe.startObservation() {
+		return
+	}
+	defer r.pipe.endObservation()
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	set := resolveAttributes(c.Attributes(), rawKVs)
+	set := resolveAttributes(oImpl.attrs, c.Attributes(), rawKVs)
 	// Access to r.pipe.int64Measures is already guarded b a lock in pipeline.produce.
 	// TODO (#5946): Refactor pipeline and observable measures.
 	measures := r.pipe.int64Measures[oImpl.observableID]
@@ -649,13 +818,17 @@ func (p int64InstProvider) aggs(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
+	staleness time.Duration,
+	sets []attribute.Set,
 ) ([]aggregate.Measure[int64], error) {
 	inst := Instrument{
-		Name:        name,
-		Description: desc,
-		Unit:        u,
-		Kind:        kind,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   desc,
+		Unit:          u,
+		Kind:          kind,
+		Scope:         p.scope,
+		staleness:     staleness,
+		attributeSets: sets,
 	}
 	return p.int64Resolver.Aggregators(inst, allowedKeys)
 }
@@ -663,6 +836,7 @@ func (p int64InstProvider) aggs(
 func (p int64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Int64HistogramConfig,
+	u string,
 	allowedKeys []attribute.Key,
 ) ([]aggregate.Measure[int64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
@@ -674,7 +848,7 @@ func (p int64InstProvider) histogramAggs(
 	inst := Instrument{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        u,
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
@@ -687,14 +861,27 @@ func (p int64InstProvider) lookup(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
+	staleness time.Duration,
+	sets []attribute.Set,
 ) (*int64Inst, error) {
+	u = checkUnit(p.unitValidation, name, u)
 	return p.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
+		Staleness:   staleness,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u, allowedKeys)
+		p.registered.add(InstrumentInfo{
+			Scope:       p.scope,
+			Name:        name,
+			Description: desc,
+			Unit:        u,
+			Kind:        kind,
+			Number:      "int64",
+			Advice:      InstrumentAdvice{AttributeKeys: allowedKeys},
+		})
+		aggs, err := p.aggs(kind, name, desc, u, allowedKeys, staleness, sets)
 		return &int64Inst{measures: aggs}, err
 	})
 }
@@ -705,13 +892,26 @@ func (p int64InstProvider) lookupHistogram(
 	cfg metric.Int64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) (*int64Inst, error) {
+	u := checkUnit(p.unitValidation, name, cfg.Unit())
 	return p.int64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        u,
 		Kind:        InstrumentKindHistogram,
 	}, func() (*int64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
+		p.registered.add(InstrumentInfo{
+			Scope:       p.scope,
+			Name:        name,
+			Description: cfg.Description(),
+			Unit:        u,
+			Kind:        InstrumentKindHistogram,
+			Number:      "int64",
+			Advice: InstrumentAdvice{
+				ExplicitBucketBoundaries: slices.Clone(cfg.ExplicitBucketBoundaries()),
+				AttributeKeys:            allowedKeys,
+			},
+		})
+		aggs, err := p.histogramAggs(name, cfg, u, allowedKeys)
 		return &int64Inst{measures: aggs}, err
 	})
 }
@@ -723,13 +923,17 @@ func (p float64InstProvider) aggs(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
+	staleness time.Duration,
+	sets []attribute.Set,
 ) ([]aggregate.Measure[float64], error) {
 	inst := Instrument{
-		Name:        name,
-		Description: desc,
-		Unit:        u,
-		Kind:        kind,
-		Scope:       p.scope,
+		Name:          name,
+		Description:   desc,
+		Unit:          u,
+		Kind:          kind,
+		Scope:         p.scope,
+		staleness:     staleness,
+		attributeSets: sets,
 	}
 	return p.float64Resolver.Aggregators(inst, allowedKeys)
 }
@@ -737,6 +941,7 @@ func (p float64InstProvider) aggs(
 func (p float64InstProvider) histogramAggs(
 	name string,
 	cfg metric.Float64HistogramConfig,
+	u string,
 	allowedKeys []attribute.Key,
 ) ([]aggregate.Measure[float64], error) {
 	boundaries := cfg.ExplicitBucketBoundaries()
@@ -748,7 +953,7 @@ func (p float64InstProvider) histogramAggs(
 	inst := Instrument{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        u,
 		Kind:        InstrumentKindHistogram,
 		Scope:       p.scope,
 	}
@@ -761,14 +966,27 @@ func (p float64InstProvider) lookup(
 	kind InstrumentKind,
 	name, desc, u string,
 	allowedKeys []attribute.Key,
+	staleness time.Duration,
+	sets []attribute.Set,
 ) (*float64Inst, error) {
+	u = checkUnit(p.unitValidation, name, u)
 	return p.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: desc,
 		Unit:        u,
 		Kind:        kind,
+		Staleness:   staleness,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.aggs(kind, name, desc, u, allowedKeys)
+		p.registered.add(InstrumentInfo{
+			Scope:       p.scope,
+			Name:        name,
+			Description: desc,
+			Unit:        u,
+			Kind:        kind,
+			Number:      "float64",
+			Advice:      InstrumentAdvice{AttributeKeys: allowedKeys},
+		})
+		aggs, err := p.aggs(kind, name, desc, u, allowedKeys, staleness, sets)
 		return &float64Inst{measures: aggs}, err
 	})
 }
@@ -779,13 +997,26 @@ func (p float64InstProvider) lookupHistogram(
 	cfg metric.Float64HistogramConfig,
 	allowedKeys []attribute.Key,
 ) (*float64Inst, error) {
+	u := checkUnit(p.unitValidation, name, cfg.Unit())
 	return p.float64Insts.Lookup(instID{
 		Name:        name,
 		Description: cfg.Description(),
-		Unit:        cfg.Unit(),
+		Unit:        u,
 		Kind:        InstrumentKindHistogram,
 	}, func() (*float64Inst, error) {
-		aggs, err := p.histogramAggs(name, cfg, allowedKeys)
+		p.registered.add(InstrumentInfo{
+			Scope:       p.scope,
+			Name:        name,
+			Description: cfg.Description(),
+			Unit:        u,
+			Kind:        InstrumentKindHistogram,
+			Number:      "float64",
+			Advice: InstrumentAdvice{
+				ExplicitBucketBoundaries: slices.Clone(cfg.ExplicitBucketBoundaries()),
+				AttributeKeys:            allowedKeys,
+			},
+		})
+		aggs, err := p.histogramAggs(name, cfg, u, allowedKeys)
 		return &float64Inst{measures: aggs}, err
 	})
 }
@@ -793,23 +1024,79 @@ func (p float64InstProvider) lookupHistogram(
 type int64Observer struct {
 	embedded.Int64Observer
 	measures[int64]
+
+	// pipe is the pipeline running the callback of the observer.
+	pipe *pipeline
+
+	// attrs are recorded with all the observations.
+	attrs attribute.Set
 }
 
 func (o int64Observer) Observe(val int64, opts ...metric.ObserveOption) {
+	if !o.pipe.startObservation() {
+		return
+	}
+	defer o.pipe.endObservation()
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	o.observe(val, resolveAttributes(c.Attributes(), rawKVs))
+	o.observe(val, resolveAttributes(o.attrs, c.Attributes(), rawKVs))
 }
 
 type float64Observer struct {
 	embedded.Float64Observer
 	measures[float64]
+
+	// pipe is the pipeline running the callback of the observer.
+	pipe *pipeline
+
+	// attrs are recorded with all the observations.
+	attrs attribute.Set
 }
 
 func (o float64Observer) Observe(val float64, opts ...metric.ObserveOption) {
+	if !o.pipe.startObservation() {
+		return
+	}
+	defer o.pipe.endObservation()
 	c := metric.NewObserveConfig(opts)
 	rawKVs := extractRawKVs(opts)
-	o.observe(val, resolveAttributes(c.Attributes(), rawKVs))
+	o.observe(val, resolveAttributes(o.attrs, c.Attributes(), rawKVs))
+}
+
+// precomputedSum returns the PrecomputedSum of the last option of opts
+// providing one, or true if none does.
+func precomputedSum[T any](opts []T) bool {
+	precomputed := true
+	for _, o := range opts {
+		if exp, ok := any(o).(interface{ PrecomputedSum() bool }); ok {
+			precomputed = exp.PrecomputedSum()
+		}
+	}
+	return precomputed
+}
+
+// staleness returns the staleness set by the last option of opts providing
+// one, or zero if none does.
+func staleness[T any](opts []T) time.Duration {
+	var d time.Duration
+	for _, o := range opts {
+		if exp, ok := any(o).(interface{ Staleness() time.Duration }); ok {
+			d = exp.Staleness()
+		}
+	}
+	return d
+}
+
+// attributeSets returns the attribute sets of all the options of opts
+// providing some.
+func attributeSets[T any](opts []T) []attribute.Set {
+	var sets []attribute.Set
+	for _, o := range opts {
+		if exp, ok := any(o).(interface{ AttributeSets() []attribute.Set }); ok {
+			sets = append(sets, exp.AttributeSets()...)
+		}
+	}
+	return sets
 }
 
 func defaultAttributes[T any](opts []T) []attribute.Key {
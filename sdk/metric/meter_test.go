@@ -11,6 +11,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/funcr"
@@ -2253,6 +2254,69 @@ func TestHistogramBucketPrecedenceOrdering(t *testing.T) {
 	}
 }
 
+func TestStreamNoMinMax(t *testing.T) {
+	expoSelector := func(InstrumentKind) Aggregation {
+		return AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+	}
+	noMinMax := NewView(Instrument{Name: "no-min-max"}, Stream{NoMinMax: true})
+
+	for _, tt := range []struct {
+		desc   string
+		reader Reader
+		views  []View
+	}{
+		{
+			desc:   "default aggregation",
+			reader: NewManualReader(),
+			views:  []View{noMinMax},
+		},
+		{
+			desc:   "reader aggregation",
+			reader: NewManualReader(WithAggregationSelector(expoSelector)),
+			views:  []View{noMinMax},
+		},
+		{
+			desc:   "view aggregation",
+			reader: NewManualReader(),
+			views: []View{NewView(Instrument{Name: "no-min-max"}, Stream{
+				Aggregation: AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20},
+				NoMinMax:    true,
+			})},
+		},
+	} {
+		t.Run(tt.desc, func(t *testing.T) {
+			meter := NewMeterProvider(
+				WithView(tt.views...),
+				WithReader(tt.reader),
+			).Meter("TestStreamNoMinMax")
+			for _, name := range []string{"no-min-max", "min-max"} {
+				h, err := meter.Float64Histogram(name)
+				require.NoError(t, err)
+				h.Record(t.Context(), 1)
+			}
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, tt.reader.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+			for _, m := range rm.ScopeMetrics[0].Metrics {
+				var minMaxDefined bool
+				switch data := m.Data.(type) {
+				case metricdata.Histogram[float64]:
+					require.Len(t, data.DataPoints, 1)
+					_, minMaxDefined = data.DataPoints[0].Min.Value()
+				case metricdata.ExponentialHistogram[float64]:
+					require.Len(t, data.DataPoints, 1)
+					_, minMaxDefined = data.DataPoints[0].Min.Value()
+				default:
+					t.Fatalf("unexpected data type %T", m.Data)
+				}
+				assert.Equal(t, m.Name == "min-max", minMaxDefined, m.Name)
+			}
+		})
+	}
+}
+
 func TestObservableDropAggregation(t *testing.T) {
 	const (
 		intPrefix         = "observable.int64."
@@ -3054,3 +3118,415 @@ func TestMeterDefaultAttributes_FilterAll(t *testing.T) {
 	}
 	metricdatatest.AssertEqual(t, want, got, metricdatatest.IgnoreTimestamp(), metricdatatest.IgnoreExemplars())
 }
+
+func TestGaugeStaleness(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []metric.Float64GaugeOption
+		views []View
+		want  int
+	}{
+		{name: "NoStaleness", want: 1},
+		{
+			name: "InstrumentStaleness",
+			opts: []metric.Float64GaugeOption{x.WithStaleness(time.Nanosecond)},
+			want: 0,
+		},
+		{
+			name: "ViewStaleness",
+			views: []View{NewView(
+				Instrument{Name: "gauge"},
+				Stream{Staleness: time.Nanosecond},
+			)},
+			want: 0,
+		},
+		{
+			name: "ViewDisablesStaleness",
+			opts: []metric.Float64GaugeOption{x.WithStaleness(time.Nanosecond)},
+			views: []View{NewView(
+				Instrument{Name: "gauge"},
+				Stream{Staleness: -1},
+			)},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewManualReader()
+			mp := NewMeterProvider(WithReader(r), WithView(tt.views...))
+			g, err := mp.Meter("test").Float64Gauge("gauge", tt.opts...)
+			require.NoError(t, err)
+
+			g.Record(t.Context(), 1, metric.WithAttributes(attribute.String("conn", "1")))
+			time.Sleep(time.Millisecond)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, r.Collect(t.Context(), &rm))
+			// Metrics without data points are not exported.
+			var got int
+			for _, sm := range rm.ScopeMetrics {
+				for _, m := range sm.Metrics {
+					gauge, ok := m.Data.(metricdata.Gauge[float64])
+					require.True(t, ok)
+					got += len(gauge.DataPoints)
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGaugeStalenessIdentity(t *testing.T) {
+	r := NewManualReader()
+	m := NewMeterProvider(WithReader(r)).Meter("test")
+	stale, err := m.Float64Gauge("gauge", x.WithStaleness(time.Nanosecond))
+	require.NoError(t, err)
+	kept, err := m.Float64Gauge("gauge")
+	require.NoError(t, err)
+	assert.NotSame(t, stale, kept, "instruments with different stalenesses are the same")
+
+	kept.Record(t.Context(), 1)
+	time.Sleep(time.Millisecond)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	gauge, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64])
+	require.True(t, ok)
+	assert.Len(t, gauge.DataPoints, 1, "the staleness of the first instrument is used")
+}
+
+func TestObservablePrecomputedSum(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []metric.Int64ObservableUpDownCounterOption
+		temporality metricdata.Temporality
+		want        []int64
+	}{
+		{
+			name:        "PrecomputedCumulative",
+			temporality: metricdata.CumulativeTemporality,
+			want:        []int64{2, 2},
+		},
+		{
+			name:        "PrecomputedDelta",
+			temporality: metricdata.DeltaTemporality,
+			want:        []int64{2, 0},
+		},
+		{
+			name:        "DeltaCumulative",
+			opts:        []metric.Int64ObservableUpDownCounterOption{x.WithPrecomputedSum(false)},
+			temporality: metricdata.CumulativeTemporality,
+			want:        []int64{2, 4},
+		},
+		{
+			name:        "DeltaDelta",
+			opts:        []metric.Int64ObservableUpDownCounterOption{x.WithPrecomputedSum(false)},
+			temporality: metricdata.DeltaTemporality,
+			want:        []int64{2, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewManualReader(WithTemporalitySelector(func(InstrumentKind) metricdata.Temporality {
+				return tt.temporality
+			}))
+			mp := NewMeterProvider(WithReader(r))
+			opts := append(tt.opts, metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+				o.Observe(2)
+				return nil
+			}))
+			_, err := mp.Meter("test").Int64ObservableUpDownCounter("queue", opts...)
+			require.NoError(t, err)
+
+			var got []int64
+			for range tt.want {
+				var rm metricdata.ResourceMetrics
+				require.NoError(t, r.Collect(t.Context(), &rm))
+				require.Len(t, rm.ScopeMetrics, 1)
+				require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+				sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+				require.True(t, ok)
+				assert.False(t, sum.IsMonotonic)
+				require.Len(t, sum.DataPoints, 1)
+				got = append(got, sum.DataPoints[0].Value)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestViewDescriptionAndUnit(t *testing.T) {
+	desc := metric.WithDescription("instrument description")
+	unit := metric.WithUnit("milliseconds")
+	i64Callback := metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+		o.Observe(1)
+		return nil
+	})
+	f64Callback := metric.WithFloat64Callback(func(_ context.Context, o metric.Float64Observer) error {
+		o.Observe(1)
+		return nil
+	})
+	create := map[string]func(m metric.Meter, name string) error{
+		"Int64Counter": func(m metric.Meter, name string) error {
+			c, err := m.Int64Counter(name, desc, unit)
+			c.Add(t.Context(), 1)
+			return err
+		},
+		"Int64UpDownCounter": func(m metric.Meter, name string) error {
+			c, err := m.Int64UpDownCounter(name, desc, unit)
+			c.Add(t.Context(), 1)
+			return err
+		},
+		"Int64Histogram": func(m metric.Meter, name string) error {
+			h, err := m.Int64Histogram(name, desc, unit)
+			h.Record(t.Context(), 1)
+			return err
+		},
+		"Int64Gauge": func(m metric.Meter, name string) error {
+			g, err := m.Int64Gauge(name, desc, unit)
+			g.Record(t.Context(), 1)
+			return err
+		},
+		"Int64ObservableCounter": func(m metric.Meter, name string) error {
+			_, err := m.Int64ObservableCounter(name, desc, unit, i64Callback)
+			return err
+		},
+		"Int64ObservableUpDownCounter": func(m metric.Meter, name string) error {
+			_, err := m.Int64ObservableUpDownCounter(name, desc, unit, i64Callback)
+			return err
+		},
+		"Int64ObservableGauge": func(m metric.Meter, name string) error {
+			_, err := m.Int64ObservableGauge(name, desc, unit, i64Callback)
+			return err
+		},
+		"Float64Counter": func(m metric.Meter, name string) error {
+			c, err := m.Float64Counter(name, desc, unit)
+			c.Add(t.Context(), 1)
+			return err
+		},
+		"Float64UpDownCounter": func(m metric.Meter, name string) error {
+			c, err := m.Float64UpDownCounter(name, desc, unit)
+			c.Add(t.Context(), 1)
+			return err
+		},
+		"Float64Histogram": func(m metric.Meter, name string) error {
+			h, err := m.Float64Histogram(name, desc, unit)
+			h.Record(t.Context(), 1)
+			return err
+		},
+		"Float64Gauge": func(m metric.Meter, name string) error {
+			g, err := m.Float64Gauge(name, desc, unit)
+			g.Record(t.Context(), 1)
+			return err
+		},
+		"Float64ObservableCounter": func(m metric.Meter, name string) error {
+			_, err := m.Float64ObservableCounter(name, desc, unit, f64Callback)
+			return err
+		},
+		"Float64ObservableUpDownCounter": func(m metric.Meter, name string) error {
+			_, err := m.Float64ObservableUpDownCounter(name, desc, unit, f64Callback)
+			return err
+		},
+		"Float64ObservableGauge": func(m metric.Meter, name string) error {
+			_, err := m.Float64ObservableGauge(name, desc, unit, f64Callback)
+			return err
+		},
+	}
+	validations := []UnitValidation{UnitValidationNone, UnitValidationNormalize}
+
+	for name, f := range create {
+		for _, v := range validations {
+			t.Run(name+"/"+v.String(), func(t *testing.T) {
+				r := NewManualReader()
+				mp := NewMeterProvider(
+					WithReader(r),
+					WithUnitValidation(v),
+					WithView(
+						NewView(Instrument{Name: "overridden"}, Stream{Description: "view description", Unit: "s"}),
+						NewView(Instrument{Name: "renamed"}, Stream{Name: "new"}),
+					),
+				)
+				m := mp.Meter("test")
+				require.NoError(t, f(m, "overridden"))
+				require.NoError(t, f(m, "renamed"))
+
+				var rm metricdata.ResourceMetrics
+				require.NoError(t, r.Collect(t.Context(), &rm))
+				require.Len(t, rm.ScopeMetrics, 1)
+				got := make(map[string][2]string)
+				for _, m := range rm.ScopeMetrics[0].Metrics {
+					got[m.Name] = [2]string{m.Description, m.Unit}
+				}
+				wantUnit := "milliseconds"
+				if v == UnitValidationNormalize {
+					wantUnit = "ms"
+				}
+				assert.Equal(t, map[string][2]string{
+					"overridden": {"view description", "s"},
+					"new":        {"instrument description", wantUnit},
+				}, got)
+			})
+		}
+	}
+}
+
+func TestMeterAttributes(t *testing.T) {
+	r := NewManualReader()
+	mp := NewMeterProvider(WithReader(r))
+	m := mp.Meter("test", x.WithMeterAttributes(
+		attribute.String("component", "cache"),
+		attribute.String("tier", "1"),
+	))
+	other := mp.Meter("test")
+
+	counter, err := m.Int64Counter("requests", x.WithInstrumentAttributes(attribute.String("tier", "2")))
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1)
+	counter.Add(t.Context(), 1, metric.WithAttributes(attribute.String("component", "db")))
+
+	// The instrument is shared with the other Meter of the scope, but not
+	// its attributes.
+	counter, err = other.Int64Counter("requests")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1)
+
+	gauge, err := m.Float64ObservableGauge("temperature")
+	require.NoError(t, err)
+	_, err = other.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveFloat64(gauge, 20, metric.WithAttributes(attribute.String("room", "a")))
+		return nil
+	}, gauge)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope: instrumentation.Scope{Name: "test"},
+		Metrics: []metricdata.Metrics{
+			{
+				Name: "requests",
+				Data: metricdata.Sum[int64]{
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+					DataPoints: []metricdata.DataPoint[int64]{
+						{
+							Attributes: attribute.NewSet(
+								attribute.String("component", "cache"),
+								attribute.String("tier", "2"),
+							),
+							Value: 1,
+						},
+						{
+							Attributes: attribute.NewSet(
+								attribute.String("component", "db"),
+								attribute.String("tier", "2"),
+							),
+							Value: 1,
+						},
+						{Value: 1},
+					},
+				},
+			},
+			{
+				Name: "temperature",
+				Data: metricdata.Gauge[float64]{
+					DataPoints: []metricdata.DataPoint[float64]{{
+						Attributes: attribute.NewSet(
+							attribute.String("component", "cache"),
+							attribute.String("room", "a"),
+							attribute.String("tier", "1"),
+						),
+						Value: 20,
+					}},
+				},
+			},
+		},
+	}, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestCallbackError(t *testing.T) {
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+	t.Setenv("OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY", "true")
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+	selfReader := NewManualReader()
+	otel.SetMeterProvider(NewMeterProvider(WithReader(selfReader)))
+
+	reader := NewManualReader()
+	m := NewMeterProvider(WithReader(reader)).Meter("scope")
+
+	errSingle := errors.New("single")
+	_, err := m.Int64ObservableGauge("a", metric.WithInt64Callback(
+		func(context.Context, metric.Int64Observer) error { return errSingle },
+	))
+	require.NoError(t, err)
+	b, err := m.Int64ObservableCounter("b")
+	require.NoError(t, err)
+	c, err := m.Float64ObservableGauge("c")
+	require.NoError(t, err)
+	errMulti := errors.New("multi")
+	_, err = m.RegisterCallback(func(context.Context, metric.Observer) error { return errMulti }, b, c)
+	require.NoError(t, err)
+
+	err = reader.Collect(t.Context(), &metricdata.ResourceMetrics{})
+	require.ErrorIs(t, err, errSingle)
+	require.ErrorIs(t, err, errMulti)
+	assert.ErrorContains(t, err, `callback of "scope" for [b, c]: multi`)
+
+	// The errors identify the callbacks that returned them.
+	got := make(map[error][]string)
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		var cbErr *CallbackError
+		require.ErrorAs(t, e, &cbErr)
+		assert.Equal(t, instrumentation.Scope{Name: "scope"}, cbErr.Scope)
+		got[cbErr.Err] = cbErr.Instruments
+	}
+	assert.Equal(t, map[error][]string{
+		errSingle: {"a"},
+		errMulti:  {"b", "c"},
+	}, got)
+
+	// The duration of the callbacks is recorded by scope.
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, selfReader.Collect(t.Context(), &rm))
+	dur := findMetricByName(&rm, "sdk.metric.callback.duration")
+	require.NotNil(t, dur)
+	hist, ok := dur.Data.(metricdata.Histogram[float64])
+	require.True(t, ok)
+	var count uint64
+	for _, dp := range hist.DataPoints {
+		v, _ := dp.Attributes.Value("otel.scope.name")
+		assert.Equal(t, "scope", v.AsString())
+		count += dp.Count
+	}
+	assert.Equal(t, uint64(2), count)
+}
+
+func TestAttributeSets(t *testing.T) {
+	r := NewManualReader()
+	m := NewMeterProvider(WithReader(r)).Meter("test")
+
+	ok := attribute.NewSet(attribute.String("status", "ok"))
+	failed := attribute.NewSet(attribute.String("status", "failed"))
+	counter, err := m.Int64Counter("requests", x.WithAttributeSets(ok, failed))
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1, metric.WithAttributeSet(ok))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	metricdatatest.AssertEqual(t, metricdata.Metrics{
+		Name: "requests",
+		Data: metricdata.Sum[int64]{
+			Temporality: metricdata.CumulativeTemporality,
+			IsMonotonic: true,
+			DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: ok, Value: 1},
+				{Attributes: failed, Value: 0},
+			},
+		},
+	}, rm.ScopeMetrics[0].Metrics[0], metricdatatest.IgnoreTimestamp())
+}
@@ -0,0 +1,47 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricplan_test
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricplan"
+)
+
+func ExamplePlan() {
+	// One attribute set per user: the cardinality of the stream is unbounded.
+	users := make([]attribute.Set, 100)
+	for i := range users {
+		users[i] = attribute.NewSet(attribute.Int("user.id", i))
+	}
+	instruments := []metricplan.Instrument{{
+		InstrumentInfo: sdkmetric.InstrumentInfo{
+			Scope:  instrumentation.Scope{Name: "app"},
+			Name:   "logins",
+			Kind:   sdkmetric.InstrumentKindCounter,
+			Number: "int64",
+		},
+		AttributeSets: users,
+	}}
+
+	// Validate the View removing the attribute before deploying it.
+	view := sdkmetric.NewView(
+		sdkmetric.Instrument{Name: "logins"},
+		sdkmetric.Stream{AttributeFilter: attribute.NewDenyKeysFilter("user.id")},
+	)
+	for _, opts := range [][]sdkmetric.Option{nil, {sdkmetric.WithView(view)}} {
+		r, err := metricplan.Plan(context.Background(), instruments, opts)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Printf("streams: %d, data points: %d\n", len(r.Streams), r.DataPoints)
+	}
+	// Output:
+	// streams: 1, data points: 100
+	// streams: 1, data points: 1
+}
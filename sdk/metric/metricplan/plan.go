@@ -0,0 +1,393 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metricplan simulates the metric streams a MeterProvider produces
+// for a set of instruments and attributes. It is intended to validate the
+// Views, aggregations, and cardinality limits of a MeterProvider in tests or
+// tools before they are deployed: it reports how many streams and data
+// points are exported, an estimate of the size of the export payloads, and
+// the conflicts between the streams.
+package metricplan // import "go.opentelemetry.io/otel/sdk/metric/metricplan"
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/x"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// overflowKey is the attribute of the data point aggregating the
+// measurements over the cardinality limit of a stream.
+const overflowKey = attribute.Key("otel.metric.overflow")
+
+// Instrument is an instrument simulated by Plan.
+type Instrument struct {
+	// InstrumentInfo identifies the instrument and the advice it is created
+	// with. The instruments of a running MeterProvider are returned by its
+	// Instruments method.
+	sdkmetric.InstrumentInfo
+	// AttributeSets are the distinct attribute sets the instrument measures.
+	// A measurement without attributes is simulated if it is empty.
+	AttributeSets []attribute.Set
+}
+
+// Stream is a metric stream exported for the simulated instruments.
+type Stream struct {
+	// Scope is the instrumentation scope of the stream.
+	Scope instrumentation.Scope
+	// Name is the name of the stream.
+	Name string
+	// Description is the description of the stream.
+	Description string
+	// Unit is the unit of the stream.
+	Unit string
+	// Data is the type of the aggregation of the stream, e.g.
+	// "metricdata.Sum[int64]".
+	Data string
+	// DataPoints is the number of data points of the stream.
+	DataPoints int
+	// Overflow is true if the stream reached its cardinality limit: the
+	// measurements of some attribute sets are aggregated in an overflow data
+	// point.
+	Overflow bool
+	// Size is an estimate of the size in bytes of the stream in an OTLP
+	// protobuf payload.
+	Size int
+}
+
+// Conflict are streams of the same scope with the same name. Backends may
+// reject or merge them incorrectly. Use a View to rename one of them.
+type Conflict struct {
+	// Scope is the instrumentation scope of the streams.
+	Scope instrumentation.Scope
+	// Name is the name of the streams.
+	Name string
+	// Streams are the conflicting streams.
+	Streams []Stream
+}
+
+// Report is the result of Plan.
+type Report struct {
+	// Streams are the exported streams sorted by scope name, scope version,
+	// and name. The streams dropped by Views are not exported.
+	Streams []Stream
+	// DataPoints is the total number of data points exported.
+	DataPoints int
+	// Size is an estimate of the size in bytes of the OTLP protobuf payload
+	// of one export of all the streams.
+	Size int
+	// Conflicts are the streams exported with the same identity.
+	Conflicts []Conflict
+}
+
+// Plan simulates the recording of a measurement for each attribute set of
+// each instrument by a MeterProvider configured with opts, collects the
+// measurements once with a ManualReader configured with readerOpts, and
+// reports the exported streams.
+//
+// opts should configure the MeterProvider as it is deployed, e.g. with
+// [sdkmetric.WithView], [sdkmetric.WithResource], and
+// [sdkmetric.WithCardinalityLimit], but not with its Readers: the
+// aggregation and temporality of the deployed Reader are configured with
+// readerOpts instead, e.g. with [sdkmetric.WithAggregationSelector].
+//
+// The returned error joins the errors of the creation of the instruments,
+// e.g. invalid instrument names. The instruments that could be created are
+// reported.
+func Plan(
+	ctx context.Context,
+	instruments []Instrument,
+	opts []sdkmetric.Option,
+	readerOpts ...sdkmetric.ManualReaderOption,
+) (Report, error) {
+	reader := sdkmetric.NewManualReader(readerOpts...)
+	mp := sdkmetric.NewMeterProvider(append(slices.Clip(opts), sdkmetric.WithReader(reader))...)
+	defer func() { _ = mp.Shutdown(ctx) }()
+
+	var errs []error
+	for _, inst := range instruments {
+		if err := record(ctx, mp, inst); err != nil {
+			errs = append(errs, fmt.Errorf("instrument %q: %w", inst.Name, err))
+		}
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &rm); err != nil {
+		errs = append(errs, err)
+	}
+	return report(&rm), errors.Join(errs...)
+}
+
+// record creates inst with mp and records a measurement for each of its
+// attribute sets.
+func record(ctx context.Context, mp *sdkmetric.MeterProvider, inst Instrument) error {
+	meter := mp.Meter(
+		inst.Scope.Name,
+		metric.WithInstrumentationVersion(inst.Scope.Version),
+		metric.WithSchemaURL(inst.Scope.SchemaURL),
+		metric.WithInstrumentationAttributeSet(inst.Scope.Attributes),
+	)
+
+	sets := inst.AttributeSets
+	if len(sets) == 0 {
+		sets = []attribute.Set{*attribute.EmptySet()}
+	}
+	opts := []metric.InstrumentOption{
+		metric.WithDescription(inst.Description),
+		metric.WithUnit(inst.Unit),
+	}
+	if inst.Advice.AttributeKeys != nil {
+		opts = append(opts, x.WithDefaultAttributes(inst.Advice.AttributeKeys...))
+	}
+
+	if inst.Number == "float64" {
+		return recordFloat64(ctx, meter, inst, sets, opts)
+	}
+	return recordInt64(ctx, meter, inst, sets, opts)
+}
+
+func recordInt64(
+	ctx context.Context,
+	meter metric.Meter,
+	inst Instrument,
+	sets []attribute.Set,
+	opts []metric.InstrumentOption,
+) error {
+	var (
+		add func(context.Context, int64, ...metric.AddOption)
+		rec func(context.Context, int64, ...metric.RecordOption)
+		obs metric.Int64Observable
+		err error
+	)
+	switch inst.Kind {
+	case sdkmetric.InstrumentKindCounter:
+		var i metric.Int64Counter
+		i, err = meter.Int64Counter(inst.Name, instrumentOptions[metric.Int64CounterOption](opts)...)
+		add = i.Add
+	case sdkmetric.InstrumentKindUpDownCounter:
+		var i metric.Int64UpDownCounter
+		i, err = meter.Int64UpDownCounter(inst.Name, instrumentOptions[metric.Int64UpDownCounterOption](opts)...)
+		add = i.Add
+	case sdkmetric.InstrumentKindHistogram:
+		hOpts := instrumentOptions[metric.Int64HistogramOption](opts)
+		if b := inst.Advice.ExplicitBucketBoundaries; b != nil {
+			hOpts = append(hOpts, metric.WithExplicitBucketBoundaries(b...))
+		}
+		var i metric.Int64Histogram
+		i, err = meter.Int64Histogram(inst.Name, hOpts...)
+		rec = i.Record
+	case sdkmetric.InstrumentKindGauge:
+		var i metric.Int64Gauge
+		i, err = meter.Int64Gauge(inst.Name, instrumentOptions[metric.Int64GaugeOption](opts)...)
+		rec = i.Record
+	case sdkmetric.InstrumentKindObservableCounter:
+		obs, err = meter.Int64ObservableCounter(inst.Name, instrumentOptions[metric.Int64ObservableCounterOption](opts)...)
+	case sdkmetric.InstrumentKindObservableUpDownCounter:
+		obs, err = meter.Int64ObservableUpDownCounter(
+			inst.Name,
+			instrumentOptions[metric.Int64ObservableUpDownCounterOption](opts)...,
+		)
+	case sdkmetric.InstrumentKindObservableGauge:
+		obs, err = meter.Int64ObservableGauge(inst.Name, instrumentOptions[metric.Int64ObservableGaugeOption](opts)...)
+	default:
+		return fmt.Errorf("unknown instrument kind %d", inst.Kind)
+	}
+	// Instruments with an invalid name are created and record measurements.
+	for _, set := range sets {
+		switch {
+		case add != nil:
+			add(ctx, 1, metric.WithAttributeSet(set))
+		case rec != nil:
+			rec(ctx, 1, metric.WithAttributeSet(set))
+		}
+	}
+	if obs != nil {
+		_, cbErr := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+			for _, set := range sets {
+				o.ObserveInt64(obs, 1, metric.WithAttributeSet(set))
+			}
+			return nil
+		}, obs)
+		err = errors.Join(err, cbErr)
+	}
+	return err
+}
+
+func recordFloat64(
+	ctx context.Context,
+	meter metric.Meter,
+	inst Instrument,
+	sets []attribute.Set,
+	opts []metric.InstrumentOption,
+) error {
+	var (
+		add func(context.Context, float64, ...metric.AddOption)
+		rec func(context.Context, float64, ...metric.RecordOption)
+		obs metric.Float64Observable
+		err error
+	)
+	switch inst.Kind {
+	case sdkmetric.InstrumentKindCounter:
+		var i metric.Float64Counter
+		i, err = meter.Float64Counter(inst.Name, instrumentOptions[metric.Float64CounterOption](opts)...)
+		add = i.Add
+	case sdkmetric.InstrumentKindUpDownCounter:
+		var i metric.Float64UpDownCounter
+		i, err = meter.Float64UpDownCounter(inst.Name, instrumentOptions[metric.Float64UpDownCounterOption](opts)...)
+		add = i.Add
+	case sdkmetric.InstrumentKindHistogram:
+		hOpts := instrumentOptions[metric.Float64HistogramOption](opts)
+		if b := inst.Advice.ExplicitBucketBoundaries; b != nil {
+			hOpts = append(hOpts, metric.WithExplicitBucketBoundaries(b...))
+		}
+		var i metric.Float64Histogram
+		i, err = meter.Float64Histogram(inst.Name, hOpts...)
+		rec = i.Record
+	case sdkmetric.InstrumentKindGauge:
+		var i metric.Float64Gauge
+		i, err = meter.Float64Gauge(inst.Name, instrumentOptions[metric.Float64GaugeOption](opts)...)
+		rec = i.Record
+	case sdkmetric.InstrumentKindObservableCounter:
+		obs, err = meter.Float64ObservableCounter(
+			inst.Name,
+			instrumentOptions[metric.Float64ObservableCounterOption](opts)...,
+		)
+	case sdkmetric.InstrumentKindObservableUpDownCounter:
+		obs, err = meter.Float64ObservableUpDownCounter(
+			inst.Name,
+			instrumentOptions[metric.Float64ObservableUpDownCounterOption](opts)...,
+		)
+	case sdkmetric.InstrumentKindObservableGauge:
+		obs, err = meter.Float64ObservableGauge(inst.Name, instrumentOptions[metric.Float64ObservableGaugeOption](opts)...)
+	default:
+		return fmt.Errorf("unknown instrument kind %d", inst.Kind)
+	}
+	// Instruments with an invalid name are created and record measurements.
+	for _, set := range sets {
+		switch {
+		case add != nil:
+			add(ctx, 1, metric.WithAttributeSet(set))
+		case rec != nil:
+			rec(ctx, 1, metric.WithAttributeSet(set))
+		}
+	}
+	if obs != nil {
+		_, cbErr := meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+			for _, set := range sets {
+				o.ObserveFloat64(obs, 1, metric.WithAttributeSet(set))
+			}
+			return nil
+		}, obs)
+		err = errors.Join(err, cbErr)
+	}
+	return err
+}
+
+// instrumentOptions converts opts to the options of a kind of instrument.
+func instrumentOptions[T any](opts []metric.InstrumentOption) []T {
+	// Keep room for the bucket boundaries of histograms.
+	out := make([]T, 0, len(opts)+1)
+	for _, o := range opts {
+		out = append(out, any(o).(T))
+	}
+	return out
+}
+
+// report returns the Report of the collected rm.
+func report(rm *metricdata.ResourceMetrics) Report {
+	var r Report
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			s := Stream{
+				Scope:       sm.Scope,
+				Name:        m.Name,
+				Description: m.Description,
+				Unit:        m.Unit,
+				Data:        fmt.Sprintf("%T", m.Data),
+				Size:        metricSize(m),
+			}
+			s.DataPoints, s.Overflow = dataPoints(m.Data)
+			r.Streams = append(r.Streams, s)
+			r.DataPoints += s.DataPoints
+		}
+	}
+	r.Size = resourceMetricsSize(rm)
+
+	slices.SortStableFunc(r.Streams, func(a, b Stream) int {
+		return cmp.Or(
+			cmp.Compare(a.Scope.Name, b.Scope.Name),
+			cmp.Compare(a.Scope.Version, b.Scope.Version),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	for i := 0; i < len(r.Streams); {
+		j := i + 1
+		for j < len(r.Streams) && r.Streams[j].Scope == r.Streams[i].Scope && r.Streams[j].Name == r.Streams[i].Name {
+			j++
+		}
+		if j-i > 1 {
+			r.Conflicts = append(r.Conflicts, Conflict{
+				Scope:   r.Streams[i].Scope,
+				Name:    r.Streams[i].Name,
+				Streams: slices.Clone(r.Streams[i:j]),
+			})
+		}
+		i = j
+	}
+	return r
+}
+
+// dataPoints returns the number of data points of data and if one of them is
+// the overflow data point.
+func dataPoints(data metricdata.Aggregation) (int, bool) {
+	switch d := data.(type) {
+	case metricdata.Sum[int64]:
+		return countDataPoints(d.DataPoints, func(dp metricdata.DataPoint[int64]) attribute.Set { return dp.Attributes })
+	case metricdata.Sum[float64]:
+		return countDataPoints(d.DataPoints, func(dp metricdata.DataPoint[float64]) attribute.Set { return dp.Attributes })
+	case metricdata.Gauge[int64]:
+		return countDataPoints(d.DataPoints, func(dp metricdata.DataPoint[int64]) attribute.Set { return dp.Attributes })
+	case metricdata.Gauge[float64]:
+		return countDataPoints(d.DataPoints, func(dp metricdata.DataPoint[float64]) attribute.Set { return dp.Attributes })
+	case metricdata.Histogram[int64]:
+		return countDataPoints(
+			d.DataPoints,
+			func(dp metricdata.HistogramDataPoint[int64]) attribute.Set { return dp.Attributes },
+		)
+	case metricdata.Histogram[float64]:
+		return countDataPoints(
+			d.DataPoints,
+			func(dp metricdata.HistogramDataPoint[float64]) attribute.Set { return dp.Attributes },
+		)
+	case metricdata.ExponentialHistogram[int64]:
+		return countDataPoints(
+			d.DataPoints,
+			func(dp metricdata.ExponentialHistogramDataPoint[int64]) attribute.Set { return dp.Attributes },
+		)
+	case metricdata.ExponentialHistogram[float64]:
+		return countDataPoints(
+			d.DataPoints,
+			func(dp metricdata.ExponentialHistogramDataPoint[float64]) attribute.Set { return dp.Attributes },
+		)
+	}
+	return 0, false
+}
+
+func countDataPoints[DP any](dps []DP, attrs func(DP) attribute.Set) (int, bool) {
+	var overflow bool
+	for _, dp := range dps {
+		set := attrs(dp)
+		if v, ok := set.Value(overflowKey); ok && v.AsBool() {
+			overflow = true
+		}
+	}
+	return len(dps), overflow
+}
@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricplan
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// sets returns n attribute sets with distinct values of key.
+func sets(key string, n int) []attribute.Set {
+	out := make([]attribute.Set, n)
+	for i := range out {
+		out[i] = attribute.NewSet(attribute.String(key, fmt.Sprint(i)))
+	}
+	return out
+}
+
+func instrument(name string, kind sdkmetric.InstrumentKind, number string, attrs []attribute.Set) Instrument {
+	return Instrument{
+		InstrumentInfo: sdkmetric.InstrumentInfo{
+			Scope:  instrumentation.Scope{Name: "test"},
+			Name:   name,
+			Kind:   kind,
+			Number: number,
+		},
+		AttributeSets: attrs,
+	}
+}
+
+func TestPlan(t *testing.T) {
+	instruments := []Instrument{
+		instrument("requests", sdkmetric.InstrumentKindCounter, "int64", sets("route", 10)),
+		instrument("duration", sdkmetric.InstrumentKindHistogram, "float64", sets("route", 10)),
+		instrument("debug", sdkmetric.InstrumentKindUpDownCounter, "int64", sets("id", 3)),
+		instrument("memory", sdkmetric.InstrumentKindObservableGauge, "int64", nil),
+	}
+	views := []sdkmetric.View{
+		sdkmetric.NewView(
+			sdkmetric.Instrument{Name: "debug"},
+			sdkmetric.Stream{Aggregation: sdkmetric.AggregationDrop{}},
+		),
+		sdkmetric.NewView(
+			sdkmetric.Instrument{Name: "duration"},
+			sdkmetric.Stream{AttributeFilter: attribute.NewDenyKeysFilter("route")},
+		),
+	}
+
+	r, err := Plan(t.Context(), instruments, []sdkmetric.Option{
+		sdkmetric.WithView(views...),
+		sdkmetric.WithCardinalityLimit(5),
+	})
+	require.NoError(t, err)
+
+	type stream struct {
+		name       string
+		data       string
+		dataPoints int
+		overflow   bool
+	}
+	var got []stream
+	var size int
+	for _, s := range r.Streams {
+		got = append(got, stream{s.Name, s.Data, s.DataPoints, s.Overflow})
+		assert.Positive(t, s.Size, s.Name)
+		size += s.Size
+	}
+	assert.Equal(t, []stream{
+		{"duration", "metricdata.Histogram[float64]", 1, false},
+		{"memory", "metricdata.Gauge[int64]", 1, false},
+		// The cardinality limit includes the overflow data point.
+		{"requests", "metricdata.Sum[int64]", 5, true},
+	}, got)
+	assert.Equal(t, 7, r.DataPoints)
+	assert.Greater(t, r.Size, size)
+	assert.Empty(t, r.Conflicts)
+}
+
+func TestPlanConflicts(t *testing.T) {
+	instruments := []Instrument{
+		instrument("requests", sdkmetric.InstrumentKindCounter, "int64", nil),
+		instrument("requests", sdkmetric.InstrumentKindCounter, "float64", nil),
+		instrument("http.requests", sdkmetric.InstrumentKindCounter, "int64", nil),
+		instrument("invalid name!", sdkmetric.InstrumentKindCounter, "int64", nil),
+	}
+	instruments[2].Description = "HTTP requests"
+	// A View renaming an instrument to the name of another one. Identical
+	// streams would be aggregated together, not conflict.
+	view := sdkmetric.NewView(
+		sdkmetric.Instrument{Name: "http.requests"},
+		sdkmetric.Stream{Name: "requests"},
+	)
+
+	r, err := Plan(t.Context(), instruments, []sdkmetric.Option{sdkmetric.WithView(view)})
+	assert.ErrorContains(t, err, "invalid name!")
+
+	require.Len(t, r.Conflicts, 1)
+	c := r.Conflicts[0]
+	assert.Equal(t, "requests", c.Name)
+	assert.Equal(t, instrumentation.Scope{Name: "test"}, c.Scope)
+	var data []string
+	for _, s := range c.Streams {
+		data = append(data, s.Data)
+	}
+	assert.ElementsMatch(t, []string{
+		"metricdata.Sum[int64]",
+		"metricdata.Sum[int64]",
+		"metricdata.Sum[float64]",
+	}, data)
+
+	// The instrument with an invalid name is reported.
+	require.Len(t, r.Streams, 4)
+	assert.Equal(t, "invalid name!", r.Streams[0].Name)
+}
+
+func TestPlanReaderOptions(t *testing.T) {
+	instruments := []Instrument{
+		instrument("duration", sdkmetric.InstrumentKindHistogram, "float64", sets("route", 3)),
+	}
+	r, err := Plan(
+		t.Context(),
+		instruments,
+		nil,
+		sdkmetric.WithAggregationSelector(func(sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+			return sdkmetric.AggregationBase2ExponentialHistogram{MaxSize: 160, MaxScale: 20}
+		}),
+	)
+	require.NoError(t, err)
+	require.Len(t, r.Streams, 1)
+	assert.Equal(t, "metricdata.ExponentialHistogram[float64]", r.Streams[0].Data)
+	assert.Equal(t, 3, r.DataPoints)
+}
+
+func TestPlanUnknownKind(t *testing.T) {
+	_, err := Plan(t.Context(), []Instrument{instrument("x", 0, "int64", nil)}, nil)
+	assert.ErrorContains(t, err, "unknown instrument kind")
+}
+
+func TestResourceMetricsSize(t *testing.T) {
+	rm := &metricdata.ResourceMetrics{
+		ScopeMetrics: []metricdata.ScopeMetrics{{
+			Scope: instrumentation.Scope{Name: "lib"},
+			Metrics: []metricdata.Metrics{{
+				Name: "m",
+				Data: metricdata.Gauge[int64]{
+					DataPoints: []metricdata.DataPoint[int64]{{
+						Attributes: attribute.NewSet(attribute.String("k", "v")),
+						Value:      1,
+					}},
+				},
+			}},
+		}},
+	}
+
+	// Each field is a tag, a length for messages and strings, and a value.
+	const (
+		// Key (1+1+1) and string value (1+1+ (1+1+1)).
+		keyValue = 3 + 5
+		// Attributes, times (2*9), and value (9).
+		dataPoint       = (1 + 1 + keyValue) + 18 + 9
+		gauge           = 1 + 1 + dataPoint
+		metric          = (1 + 1 + 1) + (1 + 1 + gauge)
+		scope           = 1 + 1 + 3
+		scopeMetrics    = (1 + 1 + scope) + (1 + 1 + metric)
+		resourceMetrics = 1 + 1 + scopeMetrics
+		request         = 1 + 1 + resourceMetrics
+	)
+	assert.Equal(t, request, resourceMetricsSize(rm))
+}
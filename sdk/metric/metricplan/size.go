@@ -0,0 +1,247 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metricplan // import "go.opentelemetry.io/otel/sdk/metric/metricplan"
+
+import (
+	"math/bits"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// The functions of this file estimate the size of the OTLP protobuf encoding
+// of metric data without depending on the OTLP exporters. The field numbers
+// of all the encoded fields are lower than 16, their tag is a single byte.
+
+// fixed64Size is the size of a fixed64, sfixed64, or double field.
+const fixed64Size = 1 + 8
+
+// timesSize is the size of the start and time fields of a data point.
+const timesSize = 2 * fixed64Size
+
+func varintSize(v uint64) int {
+	return (bits.Len64(v|1) + 6) / 7
+}
+
+// lenSize returns the size of a length-delimited field of n bytes.
+func lenSize(n int) int {
+	return 1 + varintSize(uint64(n)) + n
+}
+
+func stringSize(s string) int {
+	if s == "" {
+		return 0
+	}
+	return lenSize(len(s))
+}
+
+// varintFieldSize returns the size of a varint field with the value v.
+func varintFieldSize(v uint64) int {
+	if v == 0 {
+		return 0
+	}
+	return 1 + varintSize(v)
+}
+
+// packedSize returns the size of a packed repeated field of n values of
+// size each.
+func packedSize(n, size int) int {
+	if n == 0 {
+		return 0
+	}
+	return lenSize(n * size)
+}
+
+func anyValueSize(v attribute.Value) int {
+	switch v.Type() {
+	case attribute.BOOL:
+		return 2
+	case attribute.INT64:
+		return 1 + varintSize(uint64(v.AsInt64())) //nolint:gosec // Two's complement encoding.
+	case attribute.FLOAT64:
+		return fixed64Size
+	case attribute.STRING:
+		return lenSize(len(v.AsString()))
+	case attribute.BOOLSLICE:
+		return arraySize(len(v.AsBoolSlice()) * lenSize(2))
+	case attribute.INT64SLICE:
+		var n int
+		for _, i := range v.AsInt64Slice() {
+			n += lenSize(1 + varintSize(uint64(i))) //nolint:gosec // Two's complement encoding.
+		}
+		return arraySize(n)
+	case attribute.FLOAT64SLICE:
+		return arraySize(len(v.AsFloat64Slice()) * lenSize(fixed64Size))
+	case attribute.STRINGSLICE:
+		var n int
+		for _, s := range v.AsStringSlice() {
+			n += lenSize(lenSize(len(s)))
+		}
+		return arraySize(n)
+	}
+	return 0
+}
+
+// arraySize returns the size of an array_value field holding values of n
+// bytes.
+func arraySize(n int) int {
+	return lenSize(n)
+}
+
+// attributesSize returns the size of the repeated KeyValue field of attrs.
+func attributesSize(attrs attribute.Set) int {
+	var n int
+	for iter := attrs.Iter(); iter.Next(); {
+		kv := iter.Attribute()
+		n += lenSize(stringSize(string(kv.Key)) + lenSize(anyValueSize(kv.Value)))
+	}
+	return n
+}
+
+func exemplarsSize[N int64 | float64](exemplars []metricdata.Exemplar[N]) int {
+	var n int
+	for _, e := range exemplars {
+		var size int
+		for _, kv := range e.FilteredAttributes {
+			size += lenSize(stringSize(string(kv.Key)) + lenSize(anyValueSize(kv.Value)))
+		}
+		size += 2 * fixed64Size
+		if len(e.SpanID) > 0 {
+			size += lenSize(len(e.SpanID))
+		}
+		if len(e.TraceID) > 0 {
+			size += lenSize(len(e.TraceID))
+		}
+		n += lenSize(size)
+	}
+	return n
+}
+
+func dataPointsSize[N int64 | float64](dps []metricdata.DataPoint[N]) int {
+	var n int
+	for _, dp := range dps {
+		size := attributesSize(dp.Attributes) + timesSize + fixed64Size + exemplarsSize(dp.Exemplars)
+		n += lenSize(size)
+	}
+	return n
+}
+
+func histogramDataPointsSize[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) int {
+	var n int
+	for _, dp := range dps {
+		size := attributesSize(dp.Attributes) + timesSize + fixed64Size + fixed64Size
+		size += packedSize(len(dp.BucketCounts), 8) + packedSize(len(dp.Bounds), 8)
+		size += exemplarsSize(dp.Exemplars)
+		if _, ok := dp.Min.Value(); ok {
+			size += fixed64Size
+		}
+		if _, ok := dp.Max.Value(); ok {
+			size += fixed64Size
+		}
+		n += lenSize(size)
+	}
+	return n
+}
+
+func bucketsSize(b metricdata.ExponentialBucket) int {
+	// The offset is a sint32 using the ZigZag encoding.
+	offset := uint64(uint32((b.Offset << 1) ^ (b.Offset >> 31))) //nolint:gosec // ZigZag encoding.
+	var counts int
+	for _, c := range b.Counts {
+		counts += varintSize(c)
+	}
+	size := varintFieldSize(offset)
+	if counts > 0 {
+		size += lenSize(counts)
+	}
+	return lenSize(size)
+}
+
+func exponentialHistogramDataPointsSize[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N]) int {
+	var n int
+	for _, dp := range dps {
+		scale := uint64(uint32((dp.Scale << 1) ^ (dp.Scale >> 31))) //nolint:gosec // ZigZag encoding.
+		size := attributesSize(dp.Attributes) + timesSize + fixed64Size + fixed64Size
+		size += varintFieldSize(scale)
+		if dp.ZeroCount > 0 {
+			size += fixed64Size
+		}
+		size += bucketsSize(dp.PositiveBucket) + bucketsSize(dp.NegativeBucket)
+		size += exemplarsSize(dp.Exemplars)
+		if _, ok := dp.Min.Value(); ok {
+			size += fixed64Size
+		}
+		if _, ok := dp.Max.Value(); ok {
+			size += fixed64Size
+		}
+		n += lenSize(size)
+	}
+	return n
+}
+
+// sumSize returns the size of a Sum with data points of n bytes.
+func sumSize(n int, monotonic bool) int {
+	// The temporality field.
+	n += 2
+	if monotonic {
+		n += 2
+	}
+	return lenSize(n)
+}
+
+// histogramSize returns the size of a Histogram or ExponentialHistogram with
+// data points of n bytes.
+func histogramSize(n int) int {
+	// The temporality field.
+	return lenSize(n + 2)
+}
+
+func dataSize(data metricdata.Aggregation) int {
+	switch d := data.(type) {
+	case metricdata.Gauge[int64]:
+		return lenSize(dataPointsSize(d.DataPoints))
+	case metricdata.Gauge[float64]:
+		return lenSize(dataPointsSize(d.DataPoints))
+	case metricdata.Sum[int64]:
+		return sumSize(dataPointsSize(d.DataPoints), d.IsMonotonic)
+	case metricdata.Sum[float64]:
+		return sumSize(dataPointsSize(d.DataPoints), d.IsMonotonic)
+	case metricdata.Histogram[int64]:
+		return histogramSize(histogramDataPointsSize(d.DataPoints))
+	case metricdata.Histogram[float64]:
+		return histogramSize(histogramDataPointsSize(d.DataPoints))
+	case metricdata.ExponentialHistogram[int64]:
+		return histogramSize(exponentialHistogramDataPointsSize(d.DataPoints))
+	case metricdata.ExponentialHistogram[float64]:
+		return histogramSize(exponentialHistogramDataPointsSize(d.DataPoints))
+	}
+	return 0
+}
+
+// metricSize returns the size of the Metric field of m.
+func metricSize(m metricdata.Metrics) int {
+	return lenSize(stringSize(m.Name) + stringSize(m.Description) + stringSize(m.Unit) + dataSize(m.Data))
+}
+
+func scopeSize(s instrumentation.Scope) int {
+	return lenSize(stringSize(s.Name) + stringSize(s.Version) + attributesSize(s.Attributes))
+}
+
+// resourceMetricsSize returns the size of an export request of rm.
+func resourceMetricsSize(rm *metricdata.ResourceMetrics) int {
+	var n int
+	if rm.Resource != nil {
+		n += lenSize(attributesSize(*rm.Resource.Set()))
+		n += stringSize(rm.Resource.SchemaURL())
+	}
+	for _, sm := range rm.ScopeMetrics {
+		size := scopeSize(sm.Scope) + stringSize(sm.Scope.SchemaURL)
+		for _, m := range sm.Metrics {
+			size += metricSize(m)
+		}
+		n += lenSize(size)
+	}
+	return lenSize(n)
+}
@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
 	"go.opentelemetry.io/otel/sdk/metric/internal/x"
@@ -27,19 +28,25 @@ const (
 
 // periodicReaderConfig contains configuration options for a PeriodicReader.
 type periodicReaderConfig struct {
-	interval                 time.Duration
-	timeout                  time.Duration
-	producers                []Producer
-	cardinalityLimitSelector CardinalityLimitSelector
+	interval                   time.Duration
+	timeout                    time.Duration
+	producers                  []Producer
+	cardinalityLimitSelector   CardinalityLimitSelector
+	invalidMeasurementSelector InvalidMeasurementSelector
+	attributeFilter            attribute.Filter
+
+	// errs are the errors of the invalid options passed.
+	errs []error
 }
 
 // newPeriodicReaderConfig returns a periodicReaderConfig configured with
 // options.
 func newPeriodicReaderConfig(options []PeriodicReaderOption) periodicReaderConfig {
 	c := periodicReaderConfig{
-		interval:                 envDuration(envInterval, defaultInterval),
-		timeout:                  envDuration(envTimeout, defaultTimeout),
-		cardinalityLimitSelector: defaultCardinalityLimitSelector,
+		interval:                   envDuration(envInterval, defaultInterval),
+		timeout:                    envDuration(envTimeout, defaultTimeout),
+		cardinalityLimitSelector:   defaultCardinalityLimitSelector,
+		invalidMeasurementSelector: defaultInvalidMeasurementSelector,
 	}
 	for _, o := range options {
 		c = o.applyPeriodic(c)
@@ -74,6 +81,7 @@ func (o periodicReaderOptionFunc) applyPeriodic(conf periodicReaderConfig) perio
 func WithTimeout(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
+			conf.errs = append(conf.errs, fmt.Errorf("non-positive timeout: %s", d))
 			return conf
 		}
 		conf.timeout = d
@@ -92,6 +100,7 @@ func WithTimeout(d time.Duration) PeriodicReaderOption {
 func WithInterval(d time.Duration) PeriodicReaderOption {
 	return periodicReaderOptionFunc(func(conf periodicReaderConfig) periodicReaderConfig {
 		if d <= 0 {
+			conf.errs = append(conf.errs, fmt.Errorf("non-positive interval: %s", d))
 			return conf
 		}
 		conf.interval = d
@@ -114,13 +123,16 @@ func NewPeriodicReader(exporter Exporter, options ...PeriodicReaderOption) *Peri
 		context.Background(),
 	)
 	r := &PeriodicReader{
-		interval:                 conf.interval,
-		timeout:                  conf.timeout,
-		exporter:                 exporter,
-		flushCh:                  make(chan chan error),
-		cancel:                   cancel,
-		done:                     make(chan struct{}),
-		cardinalityLimitSelector: conf.cardinalityLimitSelector,
+		interval:                   conf.interval,
+		timeout:                    conf.timeout,
+		exporter:                   exporter,
+		flushCh:                    make(chan chan error),
+		cancel:                     cancel,
+		done:                       make(chan struct{}),
+		cardinalityLimitSelector:   conf.cardinalityLimitSelector,
+		invalidMeasurementSelector: conf.invalidMeasurementSelector,
+		filter:                     conf.attributeFilter,
+		cfgErr:                     validatePeriodicReader(exporter, conf),
 		rmPool: sync.Pool{
 			New: func() any {
 				return &metricdata.ResourceMetrics{}
@@ -178,9 +190,37 @@ type PeriodicReader struct {
 
 	rmPool sync.Pool
 
-	cardinalityLimitSelector CardinalityLimitSelector
+	cardinalityLimitSelector   CardinalityLimitSelector
+	invalidMeasurementSelector InvalidMeasurementSelector
+
+	// filter is the attribute filter of the streams without one set by a
+	// View, if not nil.
+	filter attribute.Filter
 
 	inst *observ.Instrumentation
+
+	// cfgErr is the error describing the invalid configuration the reader
+	// was created with, if any.
+	cfgErr error
+}
+
+// validatePeriodicReader returns an error describing why the exporter and
+// conf of a PeriodicReader are invalid, or nil if they are valid.
+func validatePeriodicReader(exporter Exporter, conf periodicReaderConfig) error {
+	errs := conf.errs
+	if exporter == nil {
+		errs = append([]error{errors.New("nil exporter")}, errs...)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: PeriodicReader: %w", ErrInvalidConfig, errors.Join(errs...))
+}
+
+// configErr returns the error describing the invalid configuration r was
+// created with, if any.
+func (r *PeriodicReader) configErr() error {
+	return r.cfgErr
 }
 
 // Compile time check the periodicReader implements Reader and is comparable.
@@ -237,6 +277,18 @@ func (r *PeriodicReader) cardinalityLimit(kind InstrumentKind) (int, bool) {
 	return r.cardinalityLimitSelector(kind)
 }
 
+// invalidMeasurement returns the action to take for invalid measurements of
+// kind.
+func (r *PeriodicReader) invalidMeasurement(kind InstrumentKind) (InvalidMeasurementAction, bool) {
+	return r.invalidMeasurementSelector(kind)
+}
+
+// attributeFilter returns the attribute filter of the streams without one
+// set by a View.
+func (r *PeriodicReader) attributeFilter() attribute.Filter {
+	return r.filter
+}
+
 // collectAndExport gather all metric data related to the periodicReader r from
 // the SDK and exports it with r's exporter.
 func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
@@ -273,6 +325,9 @@ func (r *PeriodicReader) collectAndExport(ctx context.Context) error {
 // Collect will return an error if called after shutdown.
 // Collect will return an error if rm is a nil ResourceMetrics.
 // Collect will return an error if the context's Done channel is closed.
+// If it is closed while the registered callbacks are run, Collect returns
+// without waiting for the running callback and skips the remaining ones. The
+// data collected so far is stored in rm along with the returned error.
 //
 // This method is safe to call concurrently.
 func (r *PeriodicReader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
@@ -413,7 +468,10 @@ func (r *PeriodicReader) Shutdown(ctx context.Context) error {
 			r.rmPool.Put(m)
 		}
 
-		sErr := r.exporter.Shutdown(ctx)
+		var sErr error
+		if r.exporter != nil { // Invalid configuration, see configErr.
+			sErr = r.exporter.Shutdown(ctx)
+		}
 		if err == nil || errors.Is(err, ErrReaderShutdown) {
 			err = sErr
 		}
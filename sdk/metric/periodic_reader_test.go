@@ -737,6 +737,32 @@ func TestPeriodicReaderCollect(t *testing.T) {
 	}
 }
 
+func TestPeriodicReaderShutdownHungCallback(t *testing.T) {
+	rdr := NewPeriodicReader(new(fnExporter), WithTimeout(10*time.Millisecond))
+	mp := NewMeterProvider(WithReader(rdr))
+	meter := mp.Meter("test")
+
+	release := make(chan struct{})
+	t.Cleanup(func() { close(release) })
+	testM, err := meter.Int64ObservableCounter("test")
+	require.NoError(t, err)
+	_, err = meter.RegisterCallback(func(context.Context, metric.Observer) error {
+		// Block until released, ignoring the context.
+		<-release
+		return nil
+	}, testM)
+	require.NoError(t, err)
+
+	done := make(chan error, 1)
+	go func() { done <- rdr.Shutdown(t.Context()) }()
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Shutdown blocked by a hung callback")
+	}
+}
+
 func TestPeriodicReaderInstrumentation(t *testing.T) {
 	// Enable SDK observability.
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
@@ -877,6 +903,10 @@ func (m *errMeter) Float64Histogram(string, ...metric.Float64HistogramOption) (m
 	return nil, m.err
 }
 
+func (m *errMeter) Int64Counter(string, ...metric.Int64CounterOption) (metric.Int64Counter, error) {
+	return nil, m.err
+}
+
 // createMetricDataTestProducer creates a producer using patterns from metricdatatest.
 func createMetricDataTestProducer() testSDKProducer {
 	return testSDKProducer{
@@ -8,8 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
@@ -18,6 +21,8 @@ import (
 	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/internal"
 	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
+	"go.opentelemetry.io/otel/sdk/metric/internal/x"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
 )
@@ -43,6 +48,7 @@ func newPipeline(
 	views []View,
 	exemplarFilter exemplar.Filter,
 	cardinalityLimit int,
+	invalidAction InvalidMeasurementAction,
 ) *pipeline {
 	if res == nil {
 		res = resource.Empty()
@@ -55,6 +61,7 @@ func newPipeline(
 		float64Measures:  map[observableID[float64]][]aggregate.Measure[float64]{},
 		exemplarFilter:   exemplarFilter,
 		cardinalityLimit: cardinalityLimit,
+		invalidAction:    invalidAction,
 		// aggregations is lazy allocated when needed.
 	}
 }
@@ -67,18 +74,65 @@ func newPipeline(
 // to the pipeline.
 type pipeline struct {
 	resource *resource.Resource
+	// refreshing, if not nil, provides the Resource when collecting instead
+	// of resource.
+	refreshing *resource.Refreshing
 
 	reader Reader
 	views  []View
 
 	sync.Mutex
-	int64Measures    map[observableID[int64]][]aggregate.Measure[int64]
-	float64Measures  map[observableID[float64]][]aggregate.Measure[float64]
-	aggregations     map[instrumentation.Scope][]instrumentSync
-	callbacks        []func(context.Context) error
-	multiCallbacks   list.List
+	int64Measures   map[observableID[int64]][]aggregate.Measure[int64]
+	float64Measures map[observableID[float64]][]aggregate.Measure[float64]
+	aggregations    map[instrumentation.Scope][]instrumentSync
+	callbacks       []callback
+	multiCallbacks  list.List
+	// callbacksDone, if not nil, is closed when the callbacks of a previous
+	// collection that was canceled return.
+	callbacksDone chan struct{}
+	// observeMu guards observing.
+	observeMu sync.RWMutex
+	// observing is true while the callbacks of a collection are run. The
+	// observations made when it is false, e.g. by a callback of a canceled
+	// collection that returns late, are dropped so they are not aggregated
+	// in the next collection.
+	observing        bool
 	exemplarFilter   exemplar.Filter
 	cardinalityLimit int
+	invalidAction    InvalidMeasurementAction
+	// derived are the DerivedGauges computed from the collected streams.
+	derived []DerivedGauge
+}
+
+// instrumentation returns the self-observability instrumentation of the
+// pipeline reader, or nil if the reader is not instrumented.
+func (p *pipeline) instrumentation() *observ.Instrumentation {
+	return readerInstrumentation(p.reader)
+}
+
+// readerInstrumentation returns the self-observability instrumentation of r,
+// or nil if r is not instrumented.
+func readerInstrumentation(r Reader) *observ.Instrumentation {
+	switch r := r.(type) {
+	case *ManualReader:
+		return r.inst
+	case *PeriodicReader:
+		return r.inst
+	case *deltaReader:
+		return readerInstrumentation(r.Reader)
+	}
+	return nil
+}
+
+// measurementDropped returns a function that records a dropped invalid
+// measurement with the self-observability instrumentation of the pipeline
+// reader, or nil if the reader is not instrumented.
+func (p *pipeline) measurementDropped() func(context.Context, string) {
+	inst := p.instrumentation()
+	if inst == nil {
+		return nil
+	}
+	return inst.MeasurementDropped
 }
 
 // addInt64Measure adds a new int64 measure to the pipeline for each observer.
@@ -110,11 +164,66 @@ func (p *pipeline) addSync(scope instrumentation.Scope, iSync instrumentSync) {
 	p.aggregations[scope] = append(p.aggregations[scope], iSync)
 }
 
-type multiCallback func(context.Context) error
+// CallbackError is an error of a callback registered for observable
+// instruments. It is returned by the collections the callback failed, or did
+// not complete, in. It identifies the callback with the instrumentation scope
+// and the names of the instruments it was registered for, as the same
+// callback can be registered for multiple instruments.
+type CallbackError struct {
+	// Scope is the instrumentation scope of the Meter the callback is
+	// registered with.
+	Scope instrumentation.Scope
+	// Instruments are the names of the instruments the callback is
+	// registered for.
+	Instruments []string
+	// Err is the error returned by the callback, or the error of the
+	// collection context if the callback did not complete.
+	Err error
+}
+
+func (e *CallbackError) Error() string {
+	return fmt.Sprintf("callback of %q for [%s]: %v", e.Scope.Name, strings.Join(e.Instruments, ", "), e.Err)
+}
+
+func (e *CallbackError) Unwrap() error {
+	return e.Err
+}
+
+// callback is a callback registered with a pipeline.
+type callback struct {
+	// scope and instruments identify the callback in its errors and
+	// self-observability metrics.
+	scope       instrumentation.Scope
+	instruments []string
+
+	fn func(context.Context) error
+}
+
+// run runs c and returns its error as a *CallbackError. The duration of c is
+// recorded with inst if it is not nil.
+func (c callback) run(ctx context.Context, inst *observ.Instrumentation) error {
+	var start time.Time
+	if inst != nil {
+		start = time.Now()
+	}
+	err := c.fn(ctx)
+	if inst != nil {
+		inst.CallbackDone(ctx, c.scope.Name, time.Since(start), err)
+	}
+	if err != nil {
+		return c.error(err)
+	}
+	return nil
+}
+
+// error returns err as a *CallbackError of c.
+func (c callback) error(err error) error {
+	return &CallbackError{Scope: c.scope, Instruments: slices.Clone(c.instruments), Err: err}
+}
 
 // addMultiCallback registers a multi-instrument callback to be run when
 // `produce()` is called.
-func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
+func (p *pipeline) addMultiCallback(c callback) (unregister func()) {
 	p.Lock()
 	defer p.Unlock()
 	e := p.multiCallbacks.PushBack(c)
@@ -129,10 +238,6 @@ func (p *pipeline) addMultiCallback(c multiCallback) (unregister func()) {
 //
 // This method is safe to call concurrently.
 func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics) error {
-	// Only check if context is already cancelled before starting, not inside or after callback loops.
-	// If this method returns after executing some callbacks but before running all aggregations,
-	// internal aggregation state can be corrupted and result in incorrect data returned
-	// by future produce calls.
 	if err := ctx.Err(); err != nil {
 		return err
 	}
@@ -140,22 +245,17 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	p.Lock()
 	defer p.Unlock()
 
-	var err error
-	for _, c := range p.callbacks {
-		// TODO make the callbacks parallel. ( #3034 )
-		if e := c(ctx); e != nil {
-			err = errors.Join(err, e)
-		}
-	}
-	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
-		// TODO make the callbacks parallel. ( #3034 )
-		f := e.Value.(multiCallback)
-		if e := f(ctx); e != nil {
-			err = errors.Join(err, e)
-		}
-	}
+	// The aggregation walk below is always completed once the callbacks have
+	// been run, even if ctx is done. If this method returned after executing
+	// some callbacks but before running all aggregations, internal
+	// aggregation state could be corrupted and result in incorrect data
+	// returned by future produce calls.
+	err := p.runCallbacks(ctx)
 
 	rm.Resource = p.resource
+	if p.refreshing != nil {
+		rm.Resource = p.refreshing.Resource()
+	}
 	rm.ScopeMetrics = internal.ReuseSlice(rm.ScopeMetrics, len(p.aggregations))
 
 	i := 0
@@ -173,6 +273,9 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 			}
 		}
 		rm.ScopeMetrics[i].Metrics = rm.ScopeMetrics[i].Metrics[:j]
+		if len(p.derived) > 0 {
+			rm.ScopeMetrics[i].Metrics = deriveGauges(rm.ScopeMetrics[i].Metrics, p.derived)
+		}
 		if len(rm.ScopeMetrics[i].Metrics) > 0 {
 			rm.ScopeMetrics[i].Scope = scope
 			i++
@@ -184,6 +287,126 @@ func (p *pipeline) produce(ctx context.Context, rm *metricdata.ResourceMetrics)
 	return err
 }
 
+// runCallbacks runs the callbacks registered with p sequentially.
+//
+// If ctx is done before all the callbacks have returned, the callbacks that
+// have not started are skipped and an error wrapping the ctx error is
+// returned without waiting for the running callback to return. The data of
+// the skipped callbacks is missing from the collection. The running callback
+// is waited for by the next call, so callbacks are never run concurrently,
+// and the observations it makes once ctx is done are dropped.
+//
+// This method assumes p.Lock is held by the caller.
+func (p *pipeline) runCallbacks(ctx context.Context) error {
+	if p.callbacksDone != nil {
+		select {
+		case <-p.callbacksDone:
+			p.callbacksDone = nil
+		case <-ctx.Done():
+			return fmt.Errorf("callbacks of a canceled collection still running: %w", ctx.Err())
+		}
+	}
+
+	n := len(p.callbacks) + p.multiCallbacks.Len()
+	if n == 0 {
+		return nil
+	}
+	inst := p.instrumentation()
+	p.setObserving(true)
+	if ctx.Done() == nil {
+		defer p.setObserving(false)
+		// ctx is never canceled, run the callbacks in this goroutine.
+		var err error
+		for _, c := range p.callbacks {
+			// TODO make the callbacks parallel. ( #3034 )
+			if e := c.run(ctx, inst); e != nil {
+				err = errors.Join(err, e)
+			}
+		}
+		for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+			// TODO make the callbacks parallel. ( #3034 )
+			c := e.Value.(callback)
+			if e := c.run(ctx, inst); e != nil {
+				err = errors.Join(err, e)
+			}
+		}
+		return err
+	}
+
+	// Copy the callbacks so they can still be run after p is unlocked.
+	callbacks := make([]callback, 0, n)
+	callbacks = append(callbacks, p.callbacks...)
+	for e := p.multiCallbacks.Front(); e != nil; e = e.Next() {
+		callbacks = append(callbacks, e.Value.(callback))
+	}
+
+	var (
+		err     error
+		skipped bool
+		// running is the index of the running callback, -1 if none has
+		// started.
+		running atomic.Int64
+	)
+	running.Store(-1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i, c := range callbacks {
+			if ctx.Err() != nil {
+				skipped = true
+				return
+			}
+			running.Store(int64(i))
+			// TODO make the callbacks parallel. ( #3034 )
+			if e := c.run(ctx, inst); e != nil {
+				err = errors.Join(err, e)
+			}
+		}
+	}()
+
+	select {
+	case <-done:
+		p.setObserving(false)
+		if skipped {
+			err = errors.Join(err, fmt.Errorf("callbacks not completed: %w", ctx.Err()))
+		}
+		return err
+	case <-ctx.Done():
+		// Drop the observations of the running callback from now on.
+		p.setObserving(false)
+		p.callbacksDone = done
+		if i := running.Load(); i >= 0 {
+			// Report the callback not returning in time.
+			return fmt.Errorf("callbacks not completed: %w", callbacks[i].error(ctx.Err()))
+		}
+		return fmt.Errorf("callbacks not completed: %w", ctx.Err())
+	}
+}
+
+// setObserving sets whether the observations of the callbacks are recorded.
+// It waits for the observations being recorded to complete.
+func (p *pipeline) setObserving(observing bool) {
+	p.observeMu.Lock()
+	defer p.observeMu.Unlock()
+	p.observing = observing
+}
+
+// startObservation reports whether an observation of a callback is
+// recorded. If it is, endObservation needs to be called once it is recorded.
+func (p *pipeline) startObservation() bool {
+	p.observeMu.RLock()
+	if !p.observing {
+		p.observeMu.RUnlock()
+		return false
+	}
+	return true
+}
+
+// endObservation ends an observation started with startObservation.
+func (p *pipeline) endObservation() {
+	p.observeMu.RUnlock()
+}
+
 // inserter facilitates inserting of new instruments from a single scope into a
 // pipeline.
 type inserter[N int64 | float64] struct {
@@ -255,6 +478,14 @@ func (i *inserter[N]) Instrument(
 			continue
 		}
 		matched = true
+		if stream.Staleness == 0 {
+			stream.Staleness = inst.staleness
+		}
+		if stream.AttributeFilter == nil {
+			stream.AttributeFilter = i.pipeline.reader.attributeFilter()
+		}
+		stream.deltaObservations = inst.deltaObservations
+		stream.attributeSets = inst.attributeSets
 		in, id, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 		if e != nil {
 			err = errors.Join(err, e)
@@ -283,6 +514,10 @@ func (i *inserter[N]) Instrument(
 		Name:        inst.Name,
 		Description: inst.Description,
 		Unit:        inst.Unit,
+		Staleness:   inst.staleness,
+
+		deltaObservations: inst.deltaObservations,
+		attributeSets:     inst.attributeSets,
 	}
 	// allowedKeys == nil indicates that the WithDefaultAttributes option was not passed,
 	// and all keys are allowed. An empty (non-nil) slice indicates that the option was passed
@@ -290,6 +525,15 @@ func (i *inserter[N]) Instrument(
 	if allowedKeys != nil {
 		stream.AttributeFilter = attribute.NewAllowKeysFilter(allowedKeys...)
 	}
+	if filter := i.pipeline.reader.attributeFilter(); filter != nil {
+		if allowed := stream.AttributeFilter; allowed != nil {
+			stream.AttributeFilter = func(kv attribute.KeyValue) bool {
+				return allowed(kv) && filter(kv)
+			}
+		} else {
+			stream.AttributeFilter = filter
+		}
+	}
 	in, _, e := i.cachedAggregator(inst.Scope, inst.Kind, stream, readerAggregation)
 	if e != nil {
 		if err == nil {
@@ -306,7 +550,7 @@ func (i *inserter[N]) Instrument(
 
 // addCallback registers a single instrument callback to be run when
 // `produce()` is called.
-func (i *inserter[N]) addCallback(cback func(context.Context) error) {
+func (i *inserter[N]) addCallback(cback callback) {
 	i.pipeline.Lock()
 	defer i.pipeline.Unlock()
 	i.pipeline.callbacks = append(i.pipeline.callbacks, cback)
@@ -375,6 +619,9 @@ func (i *inserter[N]) cachedAggregator(
 		// The view explicitly requested the default aggregation.
 		stream.Aggregation = DefaultAggregationSelector(kind)
 	}
+	if stream.NoMinMax {
+		stream.Aggregation = withNoMinMax(stream.Aggregation)
+	}
 	if stream.ExemplarReservoirProviderSelector == nil {
 		stream.ExemplarReservoirProviderSelector = DefaultExemplarReservoirProviderSelector
 	}
@@ -402,19 +649,32 @@ func (i *inserter[N]) cachedAggregator(
 				kind,
 				stream.ExemplarReservoirProviderSelector(stream.Aggregation),
 				i.pipeline.exemplarFilter,
+				aggregate.ExemplarAttributes{
+					Filter:      stream.ExemplarAttributeFilter,
+					SpanNameKey: stream.ExemplarSpanNameKey,
+				},
 			),
 		}
 		b.Filter = stream.AttributeFilter
 		// A value less than or equal to zero will disable the aggregation
 		// limits for the builder (an all the created aggregates).
-		b.AggregationLimit = i.getCardinalityLimit(kind)
-		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind)
+		b.AggregationLimit = i.getCardinalityLimit(kind, stream)
+		b.MeasurementShards, _ = x.MeasurementShards.Lookup()
+		b.Staleness = max(stream.Staleness, 0)
+		b.AttributeSets = stream.attributeSets
+		in, out, err := i.aggregateFunc(b, stream.Aggregation, kind, stream.deltaObservations)
 		if err != nil {
 			return aggVal[N]{0, nil, err}
 		}
 		if in == nil { // Drop aggregator.
 			return aggVal[N]{0, nil, nil}
 		}
+		in = validMeasure(
+			i.getInvalidMeasurementAction(kind, stream),
+			kind,
+			in,
+			i.pipeline.measurementDropped(),
+		)
 		i.pipeline.addSync(scope, instrumentSync{
 			// Use the first-seen name casing for this and all subsequent
 			// requests of this instrument.
@@ -429,11 +689,16 @@ func (i *inserter[N]) cachedAggregator(
 	return cv.Measure, cv.ID, cv.Err
 }
 
-// getCardinalityLimit returns the cardinality limit for the given instrument kind.
-// When the reader's selector returns fallback = true, the pipeline's global
-// limit is used, then the default if global is unset. When fallback is false,
-// the selector's limit is used (0 or less means unlimited).
-func (i *inserter[N]) getCardinalityLimit(kind InstrumentKind) int {
+// getCardinalityLimit returns the cardinality limit for the stream of the
+// given instrument kind. The limit of the stream takes precedence, a negative
+// value meaning unlimited. Otherwise, when the reader's selector returns
+// fallback = true, the pipeline's global limit is used, then the default if
+// global is unset. When fallback is false, the selector's limit is used (0 or
+// less means unlimited).
+func (i *inserter[N]) getCardinalityLimit(kind InstrumentKind, stream Stream) int {
+	if stream.CardinalityLimit != 0 {
+		return max(stream.CardinalityLimit, 0)
+	}
 	limit, fallback := i.pipeline.reader.cardinalityLimit(kind)
 	if fallback {
 		return i.pipeline.cardinalityLimit
@@ -441,6 +706,21 @@ func (i *inserter[N]) getCardinalityLimit(kind InstrumentKind) int {
 	return limit
 }
 
+// getInvalidMeasurementAction returns the action to take for invalid
+// measurements of the stream for the given instrument kind. The action of the
+// stream takes precedence over the one of the reader, which takes precedence
+// over the one of the pipeline.
+func (i *inserter[N]) getInvalidMeasurementAction(kind InstrumentKind, stream Stream) InvalidMeasurementAction {
+	if stream.InvalidMeasurementAction != InvalidMeasurementDefault {
+		return stream.InvalidMeasurementAction
+	}
+	action, fallback := i.pipeline.reader.invalidMeasurement(kind)
+	if fallback {
+		return i.pipeline.invalidAction
+	}
+	return action
+}
+
 // logConflict validates if an instrument with the same case-insensitive name
 // as id has already been created. If that instrument conflicts with id, a
 // warning is logged.
@@ -460,6 +740,7 @@ func (i *inserter[N]) logConflict(id instID) {
 		"kinds", fmt.Sprintf("%s, %s", existing.Kind, id.Kind),
 		"units", fmt.Sprintf("%s, %s", existing.Unit, id.Unit),
 		"numbers", fmt.Sprintf("%s, %s", existing.Number, id.Number),
+		"stalenesses", fmt.Sprintf("%s, %s", existing.Staleness, id.Staleness),
 	}
 
 	// The specification recommends logging a suggested view to resolve
@@ -477,6 +758,8 @@ func (i *inserter[N]) logConflict(id instID) {
 		stream = `Stream{Name: "{{NEW_NAME}}"}`
 	} else if id.Description != existing.Description {
 		stream = fmt.Sprintf("Stream{Description: %q}", existing.Description)
+	} else if id.Staleness != existing.Staleness {
+		stream = fmt.Sprintf("Stream{Staleness: %d}", existing.Staleness)
 	}
 
 	inst := fmt.Sprintf(
@@ -496,20 +779,23 @@ func (*inserter[N]) instID(kind InstrumentKind, stream Stream) instID {
 		Unit:        stream.Unit,
 		Kind:        kind,
 		Number:      fmt.Sprintf("%T", zero),
+		Staleness:   max(stream.Staleness, 0),
 	}
 }
 
 // aggregateFunc returns new aggregate functions matching agg, kind, and
-// monotonic. If the agg is unknown or temporality is invalid, an error is
-// returned.
+// monotonic. The observations of asynchronous counters are aggregated as
+// deltas if deltaObservations is true, as precomputed sums otherwise. If the
+// agg is unknown or temporality is invalid, an error is returned.
 func (i *inserter[N]) aggregateFunc(
 	b aggregate.Builder[N],
 	agg Aggregation,
 	kind InstrumentKind,
+	deltaObservations bool,
 ) (meas aggregate.Measure[N], comp aggregate.ComputeAggregation, err error) {
 	switch a := agg.(type) {
 	case AggregationDefault:
-		return i.aggregateFunc(b, DefaultAggregationSelector(kind), kind)
+		return i.aggregateFunc(b, DefaultAggregationSelector(kind), kind, deltaObservations)
 	case AggregationDrop:
 		// Return nil in and out to signify the drop aggregator.
 	case AggregationLastValue:
@@ -522,9 +808,17 @@ func (i *inserter[N]) aggregateFunc(
 	case AggregationSum:
 		switch kind {
 		case InstrumentKindObservableCounter:
-			meas, comp = b.PrecomputedSum(true)
+			if deltaObservations {
+				meas, comp = b.Sum(true)
+			} else {
+				meas, comp = b.PrecomputedSum(true)
+			}
 		case InstrumentKindObservableUpDownCounter:
-			meas, comp = b.PrecomputedSum(false)
+			if deltaObservations {
+				meas, comp = b.Sum(false)
+			} else {
+				meas, comp = b.PrecomputedSum(false)
+			}
 		case InstrumentKindCounter, InstrumentKindHistogram:
 			meas, comp = b.Sum(true)
 		default:
@@ -634,10 +928,11 @@ func newPipelines(
 	views []View,
 	exemplarFilter exemplar.Filter,
 	cardinalityLimit int,
+	invalidAction InvalidMeasurementAction,
 ) pipelines {
 	pipes := make([]*pipeline, 0, len(readers))
 	for _, r := range readers {
-		p := newPipeline(res, r, views, exemplarFilter, cardinalityLimit)
+		p := newPipeline(res, r, views, exemplarFilter, cardinalityLimit, invalidAction)
 		r.register(p)
 		pipes = append(pipes, p)
 	}
@@ -13,6 +13,7 @@ import (
 	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/go-logr/logr/funcr"
@@ -44,7 +45,7 @@ func testSumAggregateOutput(
 }
 
 func TestNewPipeline(t *testing.T) {
-	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
 
 	output := metricdata.ResourceMetrics{}
 	err := pipe.produce(t.Context(), &output)
@@ -58,7 +59,7 @@ func TestNewPipeline(t *testing.T) {
 	})
 
 	require.NotPanics(t, func() {
-		pipe.addMultiCallback(func(context.Context) error { return nil })
+		pipe.addMultiCallback(callback{fn: func(context.Context) error { return nil }})
 	})
 
 	err = pipe.produce(t.Context(), &output)
@@ -70,7 +71,7 @@ func TestNewPipeline(t *testing.T) {
 
 func TestPipelineUsesResource(t *testing.T) {
 	res := resource.NewWithAttributes("noSchema", attribute.String("test", "resource"))
-	pipe := newPipeline(res, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(res, nil, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
 
 	output := metricdata.ResourceMetrics{}
 	err := pipe.produce(t.Context(), &output)
@@ -79,7 +80,7 @@ func TestPipelineUsesResource(t *testing.T) {
 }
 
 func TestPipelineConcurrentSafe(t *testing.T) {
-	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
 	ctx := t.Context()
 	var output metricdata.ResourceMetrics
 
@@ -99,7 +100,7 @@ func TestPipelineConcurrentSafe(t *testing.T) {
 		}(i)
 
 		wg.Go(func() {
-			pipe.addMultiCallback(func(context.Context) error { return nil })
+			pipe.addMultiCallback(callback{fn: func(context.Context) error { return nil }})
 		})
 
 		wg.Go(func() {
@@ -138,13 +139,13 @@ func testDefaultViewImplicit[N int64 | float64]() func(t *testing.T) {
 		}{
 			{
 				name: "NoView",
-				pipe: newPipeline(nil, reader, nil, exemplar.AlwaysOffFilter, 0),
+				pipe: newPipeline(nil, reader, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord),
 			},
 			{
 				name: "NoMatchingView",
 				pipe: newPipeline(nil, reader, []View{
 					NewView(Instrument{Name: "foo"}, Stream{Name: "bar"}),
-				}, exemplar.AlwaysOffFilter, 0),
+				}, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord),
 			},
 		}
 
@@ -229,7 +230,7 @@ func TestLogConflictName(t *testing.T) {
 			return instID{Name: tc.existing}
 		})
 
-		i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0), &vc)
+		i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord), &vc)
 		i.logConflict(instID{Name: tc.name})
 
 		if tc.conflict {
@@ -271,7 +272,7 @@ func TestLogConflictSuggestView(t *testing.T) {
 	var vc cache[string, instID]
 	name := strings.ToLower(orig.Name)
 	_ = vc.Lookup(name, func() instID { return orig })
-	i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0), &vc)
+	i := newInserter[int64](newPipeline(nil, nil, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord), &vc)
 
 	viewSuggestion := func(inst instID, stream string) string {
 		return `"NewView(Instrument{` +
@@ -318,6 +319,24 @@ func TestLogConflictSuggestView(t *testing.T) {
 		msg = ""
 	})
 
+	t.Run("Staleness", func(t *testing.T) {
+		inst := instID{
+			Name:        orig.Name,
+			Description: orig.Description,
+			Kind:        orig.Kind,
+			Unit:        orig.Unit,
+			Number:      orig.Number,
+			Staleness:   time.Minute,
+		}
+		i.logConflict(inst)
+		assert.Containsf(t, msg, viewSuggestion(
+			inst, `Stream{Staleness: 0}`,
+		), "no suggestion logged: %v", inst)
+
+		// Reset.
+		msg = ""
+	})
+
 	t.Run("Kind", func(t *testing.T) {
 		inst := instID{
 			Name:        orig.Name,
@@ -376,7 +395,7 @@ func TestInserterCachedAggregatorNameConflict(t *testing.T) {
 	}
 
 	var vc cache[string, instID]
-	pipe := newPipeline(nil, NewManualReader(), nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, NewManualReader(), nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
 	i := newInserter[int64](pipe, &vc)
 
 	readerAggregation := i.readerDefaultAggregation(kind)
@@ -532,6 +551,42 @@ func TestExemplars(t *testing.T) {
 	})
 }
 
+func TestExemplarAttributeFilter(t *testing.T) {
+	r := NewManualReader()
+	v := NewView(Instrument{Name: "requests"}, Stream{
+		AttributeFilter:         attribute.NewAllowKeysFilter("http.method"),
+		ExemplarAttributeFilter: attribute.NewAllowKeysFilter("http.route"),
+		ExemplarReservoirProviderSelector: func(Aggregation) exemplar.ReservoirProvider {
+			return exemplar.FixedSizeReservoirProvider(1)
+		},
+	})
+	mp := NewMeterProvider(
+		WithReader(r),
+		WithView(v),
+		WithExemplarFilter(exemplar.AlwaysOnFilter),
+	)
+	c, err := mp.Meter("TestExemplarAttributeFilter").Int64Counter("requests")
+	require.NoError(t, err)
+	c.Add(t.Context(), 1, metric.WithAttributes(
+		attribute.String("http.method", "GET"),
+		attribute.String("http.route", "/users"),
+		attribute.String("user.id", "1234"),
+	))
+
+	rm := new(metricdata.ResourceMetrics)
+	require.NoError(t, r.Collect(t.Context(), rm))
+	require.Len(t, rm.ScopeMetrics, 1, "ScopeMetrics")
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1, "Metrics")
+	sum := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.Len(t, sum.DataPoints, 1, "DataPoints")
+	dp := sum.DataPoints[0]
+	assert.Equal(t, attribute.NewSet(attribute.String("http.method", "GET")), dp.Attributes)
+	require.Len(t, dp.Exemplars, 1, "Exemplars")
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("http.route", "/users"),
+	}, dp.Exemplars[0].FilteredAttributes)
+}
+
 func TestAddingAndObservingMeasureConcurrentSafe(t *testing.T) {
 	r1 := NewManualReader()
 	r2 := NewManualReader()
@@ -611,7 +666,7 @@ func TestPipelineWithMultipleReaders(t *testing.T) {
 func TestPipelineProduceErrors(t *testing.T) {
 	// Create a test pipeline with aggregations
 	pipeReader := NewManualReader()
-	pipe := newPipeline(nil, pipeReader, nil, exemplar.AlwaysOffFilter, 0)
+	pipe := newPipeline(nil, pipeReader, nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
 
 	// Set up an observable with callbacks
 	var testObsID observableID[int64]
@@ -645,29 +700,29 @@ func TestPipelineProduceErrors(t *testing.T) {
 
 	pipe.callbacks = append(pipe.callbacks,
 		// Callback 1: cancels the context during execution but continues to populate data
-		func(ctx context.Context) error {
+		callback{fn: func(ctx context.Context) error {
 			callbackCounts[0]++
 			for _, m := range pipe.int64Measures[testObsID] {
 				m(ctx, 123, *attribute.EmptySet())
 			}
 			return nil
-		},
+		}},
 		// Callback 2: populates int64 observable data
-		func(context.Context) error {
+		callback{fn: func(context.Context) error {
 			callbackCounts[1]++
 			if shouldCancelContext {
 				cancelCtx()
 			}
 			return nil
-		},
+		}},
 		// Callback 3: return an error
-		func(context.Context) error {
+		callback{fn: func(context.Context) error {
 			callbackCounts[2]++
 			if shouldReturnError {
 				return fmt.Errorf("test callback error")
 			}
 			return nil
-		})
+		}})
 
 	assertMetrics := func(rm *metricdata.ResourceMetrics, expectVal int64) {
 		require.Len(t, rm.ScopeMetrics, 1)
@@ -714,11 +769,11 @@ func TestPipelineProduceErrors(t *testing.T) {
 
 		var rm metricdata.ResourceMetrics
 		err := pipe.produce(ctx, &rm)
-		require.ErrorContains(t, err, "test callback error")
+		require.ErrorIs(t, err, context.Canceled)
 
-		// Even though the context was canceled midway through invoking callbacks,
-		// all remaining callbacks and agg functions are still called
-		assert.Equal(t, [3]int{3, 3, 3}, callbackCounts)
+		// The context was canceled midway through invoking callbacks, the
+		// remaining callbacks are skipped but agg functions are still called
+		assert.Equal(t, [3]int{3, 3, 2}, callbackCounts)
 		assert.Equal(t, 3, aggCallCount)
 	})
 
@@ -729,7 +784,99 @@ func TestPipelineProduceErrors(t *testing.T) {
 
 		// No callbacks or agg functions are called since the context was canceled prior to invoking
 		// the produce method
-		assert.Equal(t, [3]int{3, 3, 3}, callbackCounts)
+		assert.Equal(t, [3]int{3, 3, 2}, callbackCounts)
 		assert.Equal(t, 3, aggCallCount)
 	})
 }
+
+func TestPipelineProduceHungCallback(t *testing.T) {
+	pipe := newPipeline(nil, NewManualReader(), nil, exemplar.AlwaysOffFilter, 0, InvalidMeasurementRecord)
+
+	var aggCallCount int
+	pipe.addSync(instrumentation.Scope{Name: "test"}, instrumentSync{
+		name: "test-metric",
+		compAgg: func(dest *metricdata.Aggregation) int {
+			aggCallCount++
+			*dest = metricdata.Gauge[int64]{
+				DataPoints: []metricdata.DataPoint[int64]{{Value: int64(aggCallCount)}},
+			}
+			return 1
+		},
+	})
+
+	release := make(chan struct{})
+	var calls atomic.Int64
+	pipe.callbacks = append(pipe.callbacks, callback{
+		scope:       instrumentation.Scope{Name: "test"},
+		instruments: []string{"test-metric"},
+		fn: func(context.Context) error {
+			if calls.Add(1) == 1 {
+				// Block until released, ignoring the context.
+				<-release
+			}
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	var rm metricdata.ResourceMetrics
+	err := pipe.produce(ctx, &rm)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	// The error identifies the hung callback.
+	var cbErr *CallbackError
+	require.ErrorAs(t, err, &cbErr)
+	assert.Equal(t, []string{"test-metric"}, cbErr.Instruments)
+	assert.Equal(t, 1, aggCallCount, "aggregation not completed")
+	require.Len(t, rm.ScopeMetrics, 1, "partial data not returned")
+
+	// The hung callback is not run concurrently by the next collection.
+	ctx, cancel = context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, pipe.produce(ctx, &rm), context.DeadlineExceeded)
+	assert.Equal(t, int64(1), calls.Load(), "callback run concurrently")
+
+	close(release)
+	require.NoError(t, pipe.produce(t.Context(), &rm))
+	assert.Equal(t, int64(2), calls.Load(), "callback not run after hung call returned")
+}
+
+func TestPipelineProduceHungCallbackLateObservations(t *testing.T) {
+	r := NewManualReader()
+	m := NewMeterProvider(WithReader(r)).Meter("test")
+
+	release := make(chan struct{})
+	returned := make(chan struct{})
+	var calls atomic.Int64
+	_, err := m.Int64ObservableCounter("counter", metric.WithInt64Callback(
+		func(_ context.Context, o metric.Int64Observer) error {
+			if calls.Add(1) == 1 {
+				defer close(returned)
+				// Block until released, ignoring the context, and observe
+				// after the collection is canceled.
+				<-release
+				o.Observe(100)
+				return nil
+			}
+			o.Observe(10)
+			return nil
+		},
+	))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+	defer cancel()
+	var rm metricdata.ResourceMetrics
+	require.ErrorIs(t, r.Collect(ctx, &rm), context.DeadlineExceeded)
+
+	close(release)
+	<-returned
+
+	require.NoError(t, r.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, sum.DataPoints, 1)
+	assert.Equal(t, int64(10), sum.DataPoints[0].Value, "late observation aggregated")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"sync/atomic"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/metric/embedded"
@@ -22,8 +23,11 @@ import (
 type MeterProvider struct {
 	embedded.MeterProvider
 
-	pipes  pipelines
-	meters cache[instrumentation.Scope, *meter]
+	pipes      pipelines
+	meters     cache[instrumentation.Scope, *meter]
+	scopeCache *instrumentation.ScopeCache
+	// unitValidation is how the units of the instruments are validated.
+	unitValidation UnitValidation
 
 	forceFlush, shutdown func(context.Context) error
 	stopped              atomic.Bool
@@ -39,13 +43,58 @@ var _ metric.MeterProvider = (*MeterProvider)(nil)
 // created. This means the returned MeterProvider, one created with no
 // Readers, will perform no operations.
 func NewMeterProvider(options ...Option) *MeterProvider {
+	return newMeterProvider(newConfig(options))
+}
+
+// NewMeterProviderWithErrors returns a new and configured MeterProvider, the
+// same as NewMeterProvider, unless options are invalid. Instead of ignoring
+// or replacing the invalid values like NewMeterProvider, it returns an error
+// describing all of them. This can be used to catch misconfiguration, e.g.
+// in tests.
+//
+// The options are invalid if:
+//   - a nil Reader or exemplar Filter is passed
+//   - the Resource passed to WithResource cannot be merged with the
+//     environment Resource
+//   - a PeriodicReader is created with a nil exporter or a non-positive
+//     interval or timeout.
+//
+// The returned error wraps ErrInvalidConfig. If an error is returned, the
+// registered Readers are shut down.
+func NewMeterProviderWithErrors(options ...Option) (*MeterProvider, error) {
 	conf := newConfig(options)
+	if err := conf.validate(); err != nil {
+		for _, r := range conf.readers {
+			_ = r.Shutdown(context.Background())
+		}
+		return nil, err
+	}
+	return newMeterProvider(conf), nil
+}
+
+// newMeterProvider returns a MeterProvider configured with conf.
+func newMeterProvider(conf config) *MeterProvider {
 	flush, sdown := conf.readerSignals()
 
+	pipes := newPipelines(
+		conf.res,
+		conf.readers,
+		conf.views,
+		conf.exemplarFilter,
+		conf.cardinalityLimit,
+		conf.invalidAction,
+	)
+	for _, p := range pipes {
+		p.refreshing = conf.refreshingRes
+		p.derived = conf.derived
+	}
 	mp := &MeterProvider{
-		pipes:      newPipelines(conf.res, conf.readers, conf.views, conf.exemplarFilter, conf.cardinalityLimit),
+		pipes:      pipes,
 		forceFlush: flush,
 		shutdown:   sdown,
+		scopeCache: conf.scopeCache,
+
+		unitValidation: conf.unitValidation,
 	}
 	// Log after creation so all readers show correctly they are registered.
 	global.Info(
@@ -78,12 +127,12 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 
 	c := metric.NewMeterConfig(options...)
 	attrs, _ := attrnorm.Set(c.InstrumentationAttributes())
-	s := instrumentation.Scope{
+	s := mp.scopeCache.Intern(instrumentation.Scope{
 		Name:       name,
 		Version:    c.InstrumentationVersion(),
 		SchemaURL:  c.SchemaURL(),
 		Attributes: attrs,
-	}
+	})
 
 	global.Info(
 		"Meter created",
@@ -93,9 +142,26 @@ func (mp *MeterProvider) Meter(name string, options ...metric.MeterOption) metri
 		"Attributes", s.Attributes,
 	)
 
-	return mp.meters.Lookup(s, func() *meter {
-		return newMeter(s, mp.pipes)
+	m := mp.meters.Lookup(s, func() *meter {
+		m := newMeter(s, mp.pipes)
+		m.unitValidation = mp.unitValidation
+		return m
 	})
+	return m.withAttributes(meterAttributes(options))
+}
+
+// meterAttributes returns the merged attributes of the
+// x.WithMeterAttributes options of opts.
+func meterAttributes(opts []metric.MeterOption) attribute.Set {
+	var kvs []attribute.KeyValue
+	for _, o := range opts {
+		if exp, ok := o.(interface{ MeterAttributes() attribute.Set }); ok {
+			set := exp.MeterAttributes()
+			kvs = append(kvs, set.ToSlice()...)
+		}
+	}
+	// NewSet keeps the last value of duplicate keys.
+	return attribute.NewSet(kvs...)
 }
 
 // ForceFlush flushes all pending telemetry.
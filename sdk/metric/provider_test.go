@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr/funcr"
 	"github.com/go-logr/logr/testr"
@@ -17,8 +18,12 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	api "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/conformancetest"
 	"go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/exemplar"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
 )
 
 func TestMeterConcurrentSafe(*testing.T) {
@@ -490,3 +495,210 @@ func TestMeterProviderPerInstrumentCardinalityLimits(t *testing.T) {
 		})
 	}
 }
+
+func TestMeterProviderViewCardinalityLimit(t *testing.T) {
+	const uniqueAttributesCount = 10
+
+	tests := []struct {
+		name       string
+		streamLim  int
+		readerLim  int
+		globalLim  int
+		wantPoints int
+	}{
+		{
+			name:       "view limit takes precedence",
+			streamLim:  3,
+			readerLim:  5,
+			globalLim:  8,
+			wantPoints: 3,
+		},
+		{
+			name:       "negative view limit is unlimited",
+			streamLim:  -1,
+			readerLim:  5,
+			globalLim:  8,
+			wantPoints: uniqueAttributesCount,
+		},
+		{
+			name:       "unset view limit uses reader limit",
+			readerLim:  5,
+			globalLim:  8,
+			wantPoints: 5,
+		},
+		{
+			name:       "unset view and reader limits use global limit",
+			globalLim:  8,
+			wantPoints: 8,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewManualReader(WithCardinalityLimitSelector(func(InstrumentKind) (int, bool) {
+				return tt.readerLim, tt.readerLim == 0
+			}))
+			view := NewView(Instrument{Name: "limited"}, Stream{CardinalityLimit: tt.streamLim})
+			mp := NewMeterProvider(
+				WithReader(reader),
+				WithView(view),
+				WithCardinalityLimit(tt.globalLim),
+			)
+
+			counter, err := mp.Meter("test-meter").Int64Counter("limited")
+			require.NoError(t, err)
+			for i := range uniqueAttributesCount {
+				counter.Add(t.Context(), 1, api.WithAttributes(attribute.Int("key", i)))
+			}
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+			sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			assert.Len(t, sum.DataPoints, tt.wantPoints)
+
+			var total int64
+			for _, dp := range sum.DataPoints {
+				total += dp.Value
+			}
+			assert.Equal(t, int64(uniqueAttributesCount), total, "measurements lost")
+		})
+	}
+}
+
+func TestMeterProviderScopeCache(t *testing.T) {
+	cache := instrumentation.NewScopeCache()
+	cached := cache.Intern(instrumentation.Scope{Name: "scope"})
+
+	mp := NewMeterProvider(WithScopeCache(cache))
+	assert.Equal(t, cached, mp.Meter("scope").(*meter).scope)
+	_ = mp.Meter("other")
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestWithSharedResource(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "key=value")
+	res := resource.NewSchemaless(attribute.String("service.name", "shared"))
+
+	conf := newConfig([]Option{WithSharedResource(res)})
+	assert.Same(t, res, conf.res)
+
+	conf = newConfig([]Option{WithSharedResource(nil)})
+	assert.Equal(t, resource.Default(), conf.res)
+}
+
+// stateDetector detects the "state" attribute with the current value of state.
+type stateDetector struct {
+	state *string
+}
+
+func (d stateDetector) Detect(context.Context) (*resource.Resource, error) {
+	return resource.NewSchemaless(attribute.String("state", *d.state)), nil
+}
+
+func TestMeterProviderWithRefreshingResource(t *testing.T) {
+	state := "running"
+	base := resource.NewSchemaless(attribute.String("base", "value"))
+	reader := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(reader),
+		WithResource(resource.NewSchemaless(attribute.String("ignored", "value"))),
+		WithRefreshingResource(resource.NewRefreshing(base, 0, stateDetector{state: &state})),
+	)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	want := resource.NewSchemaless(
+		attribute.String("base", "value"),
+		attribute.String("state", "running"),
+	)
+	assert.Equal(t, want, rm.Resource)
+
+	state = "terminating"
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	v, ok := rm.Resource.Set().Value("state")
+	assert.True(t, ok)
+	assert.Equal(t, "terminating", v.AsString(), "resource not refreshed on collect")
+
+	require.NoError(t, mp.Shutdown(t.Context()))
+}
+
+func TestNewMeterProviderWithErrors(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		mp, err := NewMeterProviderWithErrors(
+			WithReader(NewManualReader()),
+			WithReader(NewPeriodicReader(new(fnExporter), WithInterval(time.Minute))),
+			WithExemplarFilter(exemplar.AlwaysOffFilter),
+		)
+		require.NoError(t, err)
+		require.NotNil(t, mp)
+		assert.NoError(t, mp.Shutdown(t.Context()))
+	})
+
+	tests := []struct {
+		name string
+		opt  Option
+		want []string
+	}{
+		{
+			name: "NilReader",
+			opt:  WithReader(nil),
+			want: []string{"nil Reader"},
+		},
+		{
+			name: "NilExemplarFilter",
+			opt:  WithExemplarFilter(nil),
+			want: []string{"nil exemplar Filter"},
+		},
+		{
+			name: "PeriodicReaderNilExporter",
+			opt:  WithReader(NewPeriodicReader(nil)),
+			want: []string{"PeriodicReader", "nil exporter"},
+		},
+		{
+			name: "PeriodicReaderInvalidOptions",
+			opt: WithReader(NewPeriodicReader(
+				new(fnExporter),
+				WithInterval(0),
+				WithTimeout(-time.Second),
+			)),
+			want: []string{
+				"non-positive interval: 0s",
+				"non-positive timeout: -1s",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewManualReader()
+			mp, err := NewMeterProviderWithErrors(WithReader(r), tt.opt)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+			assert.Nil(t, mp)
+			for _, want := range tt.want {
+				assert.ErrorContains(t, err, want)
+			}
+			assert.ErrorIs(t, r.Shutdown(t.Context()), ErrReaderShutdown, "Reader not shut down")
+		})
+	}
+}
+
+func TestMeterProviderConformance(t *testing.T) {
+	newMeterProvider := func() *MeterProvider {
+		mp := NewMeterProvider(WithReader(NewManualReader()))
+		t.Cleanup(func() {
+			//nolint:usetesting // required to avoid getting a canceled context at cleanup.
+			assert.NoError(t, mp.Shutdown(context.Background()))
+		})
+		return mp
+	}
+
+	conformancetest.RunMeterProviderTests(t, func() api.MeterProvider {
+		return newMeterProvider()
+	})
+	conformancetest.RunMeterTests(t, func() api.Meter {
+		return newMeterProvider().Meter("conformance")
+	})
+}
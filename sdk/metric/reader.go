@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
@@ -68,6 +69,22 @@ type Reader interface {
 	// Reader methods.
 	cardinalityLimit(InstrumentKind) (limit int, fallback bool)
 
+	// invalidMeasurement returns the action to take for invalid measurements
+	// of an instrument kind. When fallback is true, the pipeline falls back
+	// to the provider's action.
+	//
+	// This method needs to be concurrent safe with itself and all the other
+	// Reader methods.
+	invalidMeasurement(InstrumentKind) (action InvalidMeasurementAction, fallback bool)
+
+	// attributeFilter returns the attribute filter applied to the streams
+	// without an attribute filter set by a View, or nil if all the
+	// attributes are kept.
+	//
+	// This method needs to be concurrent safe with itself and all the other
+	// Reader methods.
+	attributeFilter() attribute.Filter
+
 	// Collect gathers and returns all metric data related to the Reader from
 	// the SDK and stores it in rm. An error is returned if this is called
 	// after Shutdown or if rm is nil.
@@ -229,6 +246,23 @@ func defaultCardinalityLimitSelector(_ InstrumentKind) (int, bool) {
 	return 0, true
 }
 
+// InvalidMeasurementSelector selects the action to take for invalid
+// measurements based on the InstrumentKind.
+//
+// The selector returns (action, fallback). When fallback is true, the
+// pipeline falls back to the provider's action (see
+// [WithInvalidMeasurementAction]). When fallback is false, action is used.
+// To avoid overriding the provider's action, return
+// (InvalidMeasurementDefault, true).
+type InvalidMeasurementSelector func(InstrumentKind) (action InvalidMeasurementAction, fallback bool)
+
+// defaultInvalidMeasurementSelector is the default InvalidMeasurementSelector
+// used if WithInvalidMeasurementSelector is not provided. It falls back to
+// the provider's action for all instrument kinds.
+func defaultInvalidMeasurementSelector(InstrumentKind) (InvalidMeasurementAction, bool) {
+	return InvalidMeasurementDefault, true
+}
+
 // ReaderOption is an option which can be applied to manual or Periodic
 // readers.
 type ReaderOption interface {
@@ -287,3 +321,64 @@ func (o cardinalityLimitSelectorOption) applyPeriodic(c periodicReaderConfig) pe
 	c.cardinalityLimitSelector = o.selector
 	return c
 }
+
+// WithInvalidMeasurementSelector sets the InvalidMeasurementSelector a reader
+// will use to determine the action to take for invalid measurements (NaN,
+// infinite, or negative values for instruments that only accept non-negative
+// values) based on the instrument kind. If this option is not used, the
+// reader will use the action of the MeterProvider.
+//
+// Backends handle invalid values differently. For example, this can be used
+// to drop NaN values for a reader exporting to a backend that rejects them
+// while other readers still record them.
+//
+// See [InvalidMeasurementSelector] for more details.
+func WithInvalidMeasurementSelector(selector InvalidMeasurementSelector) ReaderOption {
+	return invalidMeasurementSelectorOption{selector: selector}
+}
+
+type invalidMeasurementSelectorOption struct {
+	selector InvalidMeasurementSelector
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o invalidMeasurementSelectorOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.invalidMeasurementSelector = o.selector
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o invalidMeasurementSelectorOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.invalidMeasurementSelector = o.selector
+	return c
+}
+
+// WithAttributeFilter sets the attribute filter a reader applies to the
+// measurements of all the instruments, e.g. to only export an approved set of
+// attributes to a backend without configuring a View for each instrument.
+//
+// The filter is applied to the streams of the instruments not matched by any
+// View, in addition to the default attributes advised by the instrument, and
+// to the streams of the matching Views that do not set an AttributeFilter. A
+// View setting an AttributeFilter overrides it.
+//
+// By default, or if filter is nil, all the attributes are kept.
+func WithAttributeFilter(filter attribute.Filter) ReaderOption {
+	return attributeFilterOption{filter: filter}
+}
+
+type attributeFilterOption struct {
+	filter attribute.Filter
+}
+
+// applyManual returns a manualReaderConfig with option applied.
+func (o attributeFilterOption) applyManual(c manualReaderConfig) manualReaderConfig {
+	c.attributeFilter = o.filter
+	return c
+}
+
+// applyPeriodic returns a periodicReaderConfig with option applied.
+func (o attributeFilterOption) applyPeriodic(c periodicReaderConfig) periodicReaderConfig {
+	c.attributeFilter = o.filter
+	return c
+}
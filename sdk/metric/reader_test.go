@@ -11,10 +11,13 @@ import (
 
 	"github.com/go-logr/logr/testr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/x"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -392,3 +395,61 @@ func TestReadersNotRequiredToBeComparable(t *testing.T) {
 	r := noCompareReader{Reader: NewManualReader()}
 	assert.NotPanics(t, func() { _ = NewMeterProvider(WithReader(r)) })
 }
+
+func TestWithAttributeFilter(t *testing.T) {
+	allow := attribute.NewAllowKeysFilter("approved", "advised")
+	filtered := NewManualReader(WithAttributeFilter(allow))
+	all := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(filtered),
+		WithReader(all),
+		WithView(
+			NewView(Instrument{Name: "override"}, Stream{AttributeFilter: attribute.NewAllowKeysFilter("custom")}),
+			NewView(Instrument{Name: "renamed"}, Stream{Name: "view"}),
+		),
+	)
+	m := mp.Meter(t.Name())
+
+	attrs := metric.WithAttributes(
+		attribute.String("approved", "a"),
+		attribute.String("advised", "b"),
+		attribute.String("custom", "c"),
+	)
+	for _, name := range []string{"default", "override", "renamed"} {
+		c, err := m.Int64Counter(name)
+		require.NoError(t, err)
+		c.Add(t.Context(), 1, attrs)
+	}
+	c, err := m.Int64Counter("advice", x.WithDefaultAttributes("advised", "custom"))
+	require.NoError(t, err)
+	c.Add(t.Context(), 1, attrs)
+
+	keys := func(r Reader) map[string][]attribute.Key {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		got := make(map[string][]attribute.Key)
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok)
+			require.Len(t, sum.DataPoints, 1)
+			for _, kv := range sum.DataPoints[0].Attributes.ToSlice() {
+				got[m.Name] = append(got[m.Name], kv.Key)
+			}
+		}
+		return got
+	}
+
+	assert.Equal(t, map[string][]attribute.Key{
+		"default":  {"advised", "approved"},
+		"override": {"custom"},
+		"view":     {"advised", "approved"},
+		"advice":   {"advised"},
+	}, keys(filtered))
+	assert.Equal(t, map[string][]attribute.Key{
+		"default":  {"advised", "approved", "custom"},
+		"override": {"custom"},
+		"view":     {"advised", "approved", "custom"},
+		"advice":   {"advised", "custom"},
+	}, keys(all))
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+)
+
+// ErrInstrumentUnit indicates the created instrument has an invalid unit.
+// Valid units use the case-sensitive UCUM syntax, e.g. "ms", "By", or
+// "{request}".
+var ErrInstrumentUnit = errors.New("invalid instrument unit")
+
+// UnitValidation is how a MeterProvider validates the units of the
+// instruments it creates.
+//
+// Backends convert units based on their UCUM symbol
+// (https://ucum.org/ucum). Instruments measuring the same quantity with
+// different spellings of the same unit, e.g. "ms" and "milliseconds", cannot
+// be converted nor aggregated together.
+//
+// Only the syntax of units and common misspellings of time, data, and
+// percentage units are validated, not that the units are defined by UCUM.
+type UnitValidation uint8
+
+const (
+	// UnitValidationNone does not validate units.
+	UnitValidationNone UnitValidation = iota
+	// UnitValidationReport reports the invalid units of the created
+	// instruments to the global ErrorHandler with an error wrapping
+	// [ErrInstrumentUnit]. The units are used unchanged.
+	UnitValidationReport
+	// UnitValidationNormalize replaces the misspelled units of the created
+	// instruments with their UCUM symbol, e.g. "milliseconds" with "ms" and
+	// "bytes/second" with "By/s", and trims the spaces and lowercases the
+	// annotations, e.g. "{ Request }" is replaced with "{request}". The units
+	// that remain invalid are reported as they are by UnitValidationReport.
+	UnitValidationNormalize
+)
+
+// String returns the string representation of the validation.
+func (v UnitValidation) String() string {
+	switch v {
+	case UnitValidationNone:
+		return "None"
+	case UnitValidationReport:
+		return "Report"
+	case UnitValidationNormalize:
+		return "Normalize"
+	}
+	return "UnitValidation(unknown)"
+}
+
+// unitSymbols are the UCUM symbols of common misspelled units, keyed by the
+// lowercase misspelling.
+var unitSymbols = map[string]string{
+	"nanosecond":   "ns",
+	"nanoseconds":  "ns",
+	"µs":           "us",
+	"μs":           "us",
+	"microsecond":  "us",
+	"microseconds": "us",
+	"msec":         "ms",
+	"millisecond":  "ms",
+	"milliseconds": "ms",
+	"sec":          "s",
+	"second":       "s",
+	"seconds":      "s",
+	"minute":       "min",
+	"minutes":      "min",
+	"hr":           "h",
+	"hour":         "h",
+	"hours":        "h",
+	"day":          "d",
+	"days":         "d",
+	"byte":         "By",
+	"bytes":        "By",
+	"kiby":         "KiBy",
+	"miby":         "MiBy",
+	"giby":         "GiBy",
+	"bits":         "bit",
+	"percent":      "%",
+	"hz":           "Hz",
+	"celsius":      "Cel",
+}
+
+// checkUnit returns the unit of the instrument name to use according to v.
+// Invalid units are reported to the global ErrorHandler.
+func checkUnit(v UnitValidation, name, unit string) string {
+	if v == UnitValidationNone || unit == "" {
+		return unit
+	}
+	if v == UnitValidationNormalize {
+		unit = normalizeUnit(unit)
+	}
+	if err := validateUnit(unit); err != nil {
+		otel.Handle(fmt.Errorf("%w: %q of %s: %w", ErrInstrumentUnit, unit, name, err))
+	}
+	return unit
+}
+
+// normalizeUnit returns unit with its misspelled components replaced with
+// their UCUM symbol and its annotations normalized.
+func normalizeUnit(unit string) string {
+	parts := strings.Split(strings.TrimSpace(unit), "/")
+	for i, p := range parts {
+		p = strings.TrimSpace(p)
+		if s, ok := unitSymbols[strings.ToLower(p)]; ok {
+			p = s
+		} else if len(p) > 1 && p[0] == '{' && p[len(p)-1] == '}' {
+			p = "{" + strings.ToLower(strings.TrimSpace(p[1:len(p)-1])) + "}"
+		}
+		parts[i] = p
+	}
+	return strings.Join(parts, "/")
+}
+
+// validateUnit returns an error if unit is not a syntactically valid UCUM
+// unit or is a misspelled unit.
+func validateUnit(unit string) error {
+	var inAnnotation bool
+	for _, c := range unit {
+		switch {
+		case c < '!' || c > '~':
+			return errors.New("must only contain printable ASCII characters without spaces")
+		case c == '{':
+			if inAnnotation {
+				return errors.New("nested annotation")
+			}
+			inAnnotation = true
+		case c == '}':
+			if !inAnnotation {
+				return errors.New("unopened annotation")
+			}
+			inAnnotation = false
+		}
+	}
+	if inAnnotation {
+		return errors.New("unclosed annotation")
+	}
+
+	for p := range strings.SplitSeq(unit, "/") {
+		if s, ok := unitSymbols[strings.ToLower(p)]; ok && s != p {
+			return fmt.Errorf("use %q instead of %q", s, p)
+		}
+	}
+	return nil
+}
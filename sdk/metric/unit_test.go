@@ -0,0 +1,116 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestNormalizeUnit(t *testing.T) {
+	tests := []struct {
+		unit, want string
+	}{
+		{"ms", "ms"},
+		{" milliseconds ", "ms"},
+		{"Seconds", "s"},
+		{"bytes/second", "By/s"},
+		{"BYTES", "By"},
+		{"{ Request }", "{request}"},
+		{"{request}/s", "{request}/s"},
+		{"percent", "%"},
+		{"1", "1"},
+		{"KiBy", "KiBy"},
+		{"widgets", "widgets"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, normalizeUnit(tt.unit), tt.unit)
+	}
+}
+
+func TestValidateUnit(t *testing.T) {
+	for _, unit := range []string{"", "ms", "By/s", "{request}", "1", "%", "Hz", "Cel", "m/s2"} {
+		assert.NoError(t, validateUnit(unit), unit)
+	}
+	for _, unit := range []string{
+		"milli seconds",
+		"µs",
+		"{request",
+		"request}",
+		"{{request}}",
+		"milliseconds",
+		"bytes/s",
+		"hz",
+	} {
+		assert.Error(t, validateUnit(unit), unit)
+	}
+}
+
+func TestUnitValidation(t *testing.T) {
+	tests := []struct {
+		validation UnitValidation
+		wantUnit   string
+		wantErr    bool
+	}{
+		{UnitValidationNone, "milliseconds", false},
+		{UnitValidationReport, "milliseconds", true},
+		{UnitValidationNormalize, "ms", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.validation.String(), func(t *testing.T) {
+			var errs []error
+			orig := otel.GetErrorHandler()
+			otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { errs = append(errs, err) }))
+			t.Cleanup(func() { otel.SetErrorHandler(orig) })
+
+			r := NewManualReader()
+			mp := NewMeterProvider(WithReader(r), WithUnitValidation(tt.validation))
+			m := mp.Meter("test")
+
+			h, err := m.Float64Histogram("duration", metric.WithUnit("milliseconds"))
+			require.NoError(t, err)
+			h.Record(t.Context(), 1)
+			_, err = m.Int64ObservableGauge(
+				"memory",
+				metric.WithUnit("milliseconds"),
+				metric.WithInt64Callback(func(_ context.Context, o metric.Int64Observer) error {
+					o.Observe(1)
+					return nil
+				}),
+			)
+			require.NoError(t, err)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, r.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 2)
+			for _, m := range rm.ScopeMetrics[0].Metrics {
+				assert.Equal(t, tt.wantUnit, m.Unit, m.Name)
+			}
+
+			if tt.wantErr {
+				require.Len(t, errs, 2)
+				for _, err := range errs {
+					assert.ErrorIs(t, err, ErrInstrumentUnit)
+				}
+			} else {
+				assert.Empty(t, errs)
+			}
+		})
+	}
+}
+
+func TestUnitValidationString(t *testing.T) {
+	assert.Equal(t, "None", UnitValidationNone.String())
+	assert.Equal(t, "Report", UnitValidationReport.String())
+	assert.Equal(t, "Normalize", UnitValidationNormalize.String())
+	assert.Equal(t, "UnitValidation(unknown)", UnitValidation(42).String())
+}
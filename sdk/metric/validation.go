@@ -0,0 +1,149 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"math"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+)
+
+// InvalidMeasurementAction is the action taken when an instrument makes an
+// invalid measurement.
+//
+// A measurement is invalid if it is a NaN or an infinite value, or if it is
+// a negative value made by an instrument that only accepts non-negative
+// values (i.e. Counter, ObservableCounter, and Histogram instruments).
+//
+// The action can be configured for a MeterProvider (see
+// [WithInvalidMeasurementAction]), for a Reader (see
+// [WithInvalidMeasurementSelector]), and for a View (see
+// [Stream.InvalidMeasurementAction]). The most specific configuration that is
+// not [InvalidMeasurementDefault] is used.
+type InvalidMeasurementAction uint8
+
+const (
+	// InvalidMeasurementDefault uses the action configured at the enclosing
+	// level. If no action is configured at any level, invalid measurements
+	// are recorded unchanged.
+	InvalidMeasurementDefault InvalidMeasurementAction = iota
+	// InvalidMeasurementRecord records invalid measurements unchanged.
+	InvalidMeasurementRecord
+	// InvalidMeasurementDrop drops invalid measurements.
+	//
+	// If the experimental self-observability of the SDK is enabled, dropped
+	// measurements are counted by the Reader.
+	InvalidMeasurementDrop
+	// InvalidMeasurementClamp clamps invalid measurements to the closest
+	// valid value: negative values of instruments that only accept
+	// non-negative values are recorded as zero and infinite values are
+	// recorded as the largest finite value with the same sign. NaN values
+	// cannot be clamped and are dropped.
+	InvalidMeasurementClamp
+)
+
+// String returns the string representation of the action.
+func (a InvalidMeasurementAction) String() string {
+	switch a {
+	case InvalidMeasurementDefault:
+		return "Default"
+	case InvalidMeasurementRecord:
+		return "Record"
+	case InvalidMeasurementDrop:
+		return "Drop"
+	case InvalidMeasurementClamp:
+		return "Clamp"
+	}
+	return "InvalidMeasurementAction(unknown)"
+}
+
+// Reasons a measurement is invalid.
+const (
+	invalidNaN      = "nan"
+	invalidInf      = "infinity"
+	invalidNegative = "negative"
+)
+
+// droppedInvalid counts the invalid measurements dropped by reason, see
+// global.DropCounter.
+var droppedInvalid = map[string]*global.DropCounter{
+	invalidNaN:      global.NewDropCounter("metric_measurements", invalidNaN),
+	invalidInf:      global.NewDropCounter("metric_measurements", invalidInf),
+	invalidNegative: global.NewDropCounter("metric_measurements", invalidNegative),
+}
+
+// nonNegative returns true if instruments of kind only accept non-negative
+// measurements.
+func nonNegative(kind InstrumentKind) bool {
+	switch kind {
+	case InstrumentKindCounter, InstrumentKindObservableCounter, InstrumentKindHistogram:
+		return true
+	}
+	return false
+}
+
+// invalid returns the reason value is invalid, or an empty string if it is
+// valid.
+func invalid[N int64 | float64](nonNeg bool, value N) string {
+	switch {
+	case value != value:
+		return invalidNaN
+	case nonNeg && value < 0:
+		return invalidNegative
+	case math.IsInf(float64(value), 0):
+		return invalidInf
+	}
+	return ""
+}
+
+// validMeasure returns meas wrapped so that invalid measurements made by an
+// instrument of kind are handled according to action. The dropped function,
+// if not nil, is called with the reason an invalid measurement is dropped.
+//
+// If action does not drop or clamp invalid measurements, meas is returned
+// unchanged.
+func validMeasure[N int64 | float64](
+	action InvalidMeasurementAction,
+	kind InstrumentKind,
+	meas aggregate.Measure[N],
+	dropped func(context.Context, string),
+) aggregate.Measure[N] {
+	if meas == nil || (action != InvalidMeasurementDrop && action != InvalidMeasurementClamp) {
+		return meas
+	}
+
+	nonNeg := nonNegative(kind)
+	return func(ctx context.Context, value N, s attribute.Set) {
+		reason := invalid(nonNeg, value)
+		if reason == "" {
+			meas(ctx, value, s)
+			return
+		}
+
+		if action == InvalidMeasurementClamp {
+			switch reason {
+			case invalidNegative:
+				meas(ctx, 0, s)
+				return
+			case invalidInf:
+				// Only a float64 can be infinite. Use a variable for the
+				// bound as the constant cannot be represented by an int64.
+				bound := math.MaxFloat64
+				if value < 0 {
+					bound = -bound
+				}
+				meas(ctx, N(bound), s)
+				return
+			}
+		}
+
+		droppedInvalid[reason].Add(1)
+		if dropped != nil {
+			dropped(ctx, reason)
+		}
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package metric
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	api "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/internal/aggregate"
+	"go.opentelemetry.io/otel/sdk/metric/internal/observ"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func TestInvalidMeasurementActionString(t *testing.T) {
+	assert.Equal(t, "Default", InvalidMeasurementDefault.String())
+	assert.Equal(t, "Record", InvalidMeasurementRecord.String())
+	assert.Equal(t, "Drop", InvalidMeasurementDrop.String())
+	assert.Equal(t, "Clamp", InvalidMeasurementClamp.String())
+	assert.Equal(t, "InvalidMeasurementAction(unknown)", InvalidMeasurementAction(255).String())
+}
+
+func TestValidMeasureFloat64(t *testing.T) {
+	tests := []struct {
+		name        string
+		action      InvalidMeasurementAction
+		kind        InstrumentKind
+		value       float64
+		want        []float64
+		wantDropped []string
+	}{
+		{"Drop/Valid", InvalidMeasurementDrop, InstrumentKindCounter, 1.5, []float64{1.5}, nil},
+		{"Drop/NaN", InvalidMeasurementDrop, InstrumentKindGauge, math.NaN(), nil, []string{"nan"}},
+		{"Drop/PosInf", InvalidMeasurementDrop, InstrumentKindGauge, math.Inf(1), nil, []string{"infinity"}},
+		{"Drop/NegInf", InvalidMeasurementDrop, InstrumentKindGauge, math.Inf(-1), nil, []string{"infinity"}},
+		{"Drop/Negative", InvalidMeasurementDrop, InstrumentKindCounter, -1, nil, []string{"negative"}},
+		{"Drop/NegativeAllowed", InvalidMeasurementDrop, InstrumentKindUpDownCounter, -1, []float64{-1}, nil},
+		{"Clamp/Valid", InvalidMeasurementClamp, InstrumentKindHistogram, 1.5, []float64{1.5}, nil},
+		{"Clamp/NaN", InvalidMeasurementClamp, InstrumentKindGauge, math.NaN(), nil, []string{"nan"}},
+		{"Clamp/PosInf", InvalidMeasurementClamp, InstrumentKindGauge, math.Inf(1), []float64{math.MaxFloat64}, nil},
+		{"Clamp/NegInf", InvalidMeasurementClamp, InstrumentKindGauge, math.Inf(-1), []float64{-math.MaxFloat64}, nil},
+		{"Clamp/NegInfNonNegative", InvalidMeasurementClamp, InstrumentKindHistogram, math.Inf(-1), []float64{0}, nil},
+		{"Clamp/Negative", InvalidMeasurementClamp, InstrumentKindObservableCounter, -1, []float64{0}, nil},
+		{"Clamp/NegativeAllowed", InvalidMeasurementClamp, InstrumentKindGauge, -1, []float64{-1}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				got     []float64
+				dropped []string
+			)
+			meas := validMeasure(
+				tt.action,
+				tt.kind,
+				func(_ context.Context, v float64, _ attribute.Set) { got = append(got, v) },
+				func(_ context.Context, reason string) { dropped = append(dropped, reason) },
+			)
+			meas(t.Context(), tt.value, *attribute.EmptySet())
+			assert.Equal(t, tt.want, got, "recorded")
+			assert.Equal(t, tt.wantDropped, dropped, "dropped")
+		})
+	}
+}
+
+func TestValidMeasureInt64(t *testing.T) {
+	var got []int64
+	rec := func(_ context.Context, v int64, _ attribute.Set) { got = append(got, v) }
+
+	validMeasure(InvalidMeasurementDrop, InstrumentKindCounter, rec, nil)(t.Context(), -1, *attribute.EmptySet())
+	assert.Empty(t, got)
+
+	validMeasure(InvalidMeasurementClamp, InstrumentKindCounter, rec, nil)(t.Context(), -1, *attribute.EmptySet())
+	assert.Equal(t, []int64{0}, got)
+
+	got = nil
+	validMeasure(InvalidMeasurementDrop, InstrumentKindUpDownCounter, rec, nil)(t.Context(), -1, *attribute.EmptySet())
+	assert.Equal(t, []int64{-1}, got)
+}
+
+func TestValidMeasureUnchanged(t *testing.T) {
+	for _, action := range []InvalidMeasurementAction{InvalidMeasurementDefault, InvalidMeasurementRecord} {
+		var called int
+		meas := aggregate.Measure[float64](func(context.Context, float64, attribute.Set) { called++ })
+
+		got := validMeasure(action, InstrumentKindCounter, meas, nil)
+		got(t.Context(), math.NaN(), *attribute.EmptySet())
+		assert.Equal(t, 1, called, action.String())
+	}
+
+	assert.Nil(t, validMeasure[float64](InvalidMeasurementDrop, InstrumentKindCounter, nil, nil))
+}
+
+func TestMeterProviderInvalidMeasurementAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []Option
+		wantSum float64
+		wantCnt uint64
+	}{
+		{
+			name:    "Default",
+			wantSum: math.NaN(),
+			wantCnt: 4,
+		},
+		{
+			name:    "Drop",
+			options: []Option{WithInvalidMeasurementAction(InvalidMeasurementDrop)},
+			wantSum: 2,
+			wantCnt: 1,
+		},
+		{
+			name:    "Clamp",
+			options: []Option{WithInvalidMeasurementAction(InvalidMeasurementClamp)},
+			wantSum: math.MaxFloat64,
+			wantCnt: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewManualReader()
+			mp := NewMeterProvider(append(tt.options, WithReader(reader))...)
+
+			hist, err := mp.Meter(t.Name()).Float64Histogram("hist")
+			require.NoError(t, err)
+
+			ctx := t.Context()
+			hist.Record(ctx, 2)
+			hist.Record(ctx, math.NaN())
+			hist.Record(ctx, math.Inf(1))
+			hist.Record(ctx, -3)
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(ctx, &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+			data, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Histogram[float64])
+			require.True(t, ok)
+			require.Len(t, data.DataPoints, 1)
+
+			dp := data.DataPoints[0]
+			assert.Equal(t, tt.wantCnt, dp.Count)
+			if math.IsNaN(tt.wantSum) {
+				assert.True(t, math.IsNaN(dp.Sum), "sum: %v", dp.Sum)
+			} else {
+				assert.Equal(t, tt.wantSum, dp.Sum)
+			}
+		})
+	}
+}
+
+func TestMeterProviderInvalidMeasurementActionObservable(t *testing.T) {
+	reader := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(reader),
+		WithInvalidMeasurementAction(InvalidMeasurementDrop),
+	)
+
+	_, err := mp.Meter(t.Name()).Int64ObservableCounter(
+		"counter",
+		api.WithInt64Callback(func(_ context.Context, o api.Int64Observer) error {
+			o.Observe(-1, api.WithAttributes(attribute.String("key", "negative")))
+			o.Observe(5, api.WithAttributes(attribute.String("key", "positive")))
+			return nil
+		}),
+	)
+	require.NoError(t, err)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+	data, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	require.Len(t, data.DataPoints, 1)
+	assert.Equal(t, int64(5), data.DataPoints[0].Value)
+}
+
+func TestInvalidMeasurementPrecedence(t *testing.T) {
+	dropGauge := func(k InstrumentKind) (InvalidMeasurementAction, bool) {
+		if k == InstrumentKindGauge {
+			return InvalidMeasurementDrop, false
+		}
+		return InvalidMeasurementDefault, true
+	}
+
+	tests := []struct {
+		name    string
+		options []Option
+		reader  []ManualReaderOption
+		want    float64
+	}{
+		{
+			name:   "Reader",
+			reader: []ManualReaderOption{WithInvalidMeasurementSelector(dropGauge)},
+			want:   1,
+		},
+		{
+			name:    "ReaderOverridesProvider",
+			options: []Option{WithInvalidMeasurementAction(InvalidMeasurementClamp)},
+			reader:  []ManualReaderOption{WithInvalidMeasurementSelector(dropGauge)},
+			want:    1,
+		},
+		{
+			name:    "ReaderFallback",
+			options: []Option{WithInvalidMeasurementAction(InvalidMeasurementClamp)},
+			reader: []ManualReaderOption{WithInvalidMeasurementSelector(
+				func(InstrumentKind) (InvalidMeasurementAction, bool) {
+					return InvalidMeasurementDrop, true
+				},
+			)},
+			want: math.MaxFloat64,
+		},
+		{
+			name: "ViewOverridesReader",
+			options: []Option{WithView(NewView(
+				Instrument{Name: "gauge"},
+				Stream{InvalidMeasurementAction: InvalidMeasurementClamp},
+			))},
+			reader: []ManualReaderOption{WithInvalidMeasurementSelector(dropGauge)},
+			want:   math.MaxFloat64,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := NewManualReader(tt.reader...)
+			mp := NewMeterProvider(append(tt.options, WithReader(reader))...)
+
+			gauge, err := mp.Meter(t.Name()).Float64Gauge("gauge")
+			require.NoError(t, err)
+
+			gauge.Record(t.Context(), 1)
+			gauge.Record(t.Context(), math.Inf(1))
+
+			var rm metricdata.ResourceMetrics
+			require.NoError(t, reader.Collect(t.Context(), &rm))
+			require.Len(t, rm.ScopeMetrics, 1)
+			require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+			data, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[float64])
+			require.True(t, ok)
+			require.Len(t, data.DataPoints, 1)
+			assert.Equal(t, tt.want, data.DataPoints[0].Value)
+		})
+	}
+}
+
+func TestInvalidMeasurementPerReader(t *testing.T) {
+	drop := NewManualReader(WithInvalidMeasurementSelector(
+		func(InstrumentKind) (InvalidMeasurementAction, bool) {
+			return InvalidMeasurementDrop, false
+		},
+	))
+	record := NewManualReader()
+	mp := NewMeterProvider(WithReader(drop), WithReader(record))
+
+	counter, err := mp.Meter(t.Name()).Float64Counter("counter")
+	require.NoError(t, err)
+	counter.Add(t.Context(), 1)
+	counter.Add(t.Context(), math.NaN())
+
+	collect := func(r Reader) float64 {
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, r.Collect(t.Context(), &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+		data, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[float64])
+		require.True(t, ok)
+		require.Len(t, data.DataPoints, 1)
+		return data.DataPoints[0].Value
+	}
+
+	assert.Equal(t, 1.0, collect(drop))
+	assert.True(t, math.IsNaN(collect(record)))
+}
+
+func TestInvalidMeasurementDroppedObservability(t *testing.T) {
+	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
+	t.Setenv("OTEL_GO_X_METRIC_PIPELINE_OBSERVABILITY", "true")
+
+	orig := otel.GetMeterProvider()
+	t.Cleanup(func() { otel.SetMeterProvider(orig) })
+	selfReader := NewManualReader()
+	otel.SetMeterProvider(NewMeterProvider(WithReader(selfReader)))
+
+	reader := NewManualReader()
+	mp := NewMeterProvider(
+		WithReader(reader),
+		WithInvalidMeasurementAction(InvalidMeasurementDrop),
+	)
+
+	hist, err := mp.Meter(t.Name()).Float64Histogram("hist")
+	require.NoError(t, err)
+	hist.Record(t.Context(), math.NaN())
+	hist.Record(t.Context(), math.NaN())
+	hist.Record(t.Context(), -1)
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, selfReader.Collect(t.Context(), &rm))
+
+	var got *metricdata.Metrics
+	for _, sm := range rm.ScopeMetrics {
+		for i := range sm.Metrics {
+			if sm.Metrics[i].Name == observ.MeasurementDroppedName {
+				got = &sm.Metrics[i]
+			}
+		}
+	}
+	require.NotNil(t, got, "dropped measurement metric not recorded")
+
+	data, ok := got.Data.(metricdata.Sum[int64])
+	require.True(t, ok)
+	values := make(map[string]int64)
+	for _, dp := range data.DataPoints {
+		v, _ := dp.Attributes.Value(semconv.ErrorTypeKey)
+		values[v.AsString()] = dp.Value
+	}
+	assert.Equal(t, map[string]int64{"nan": 2, "negative": 1}, values)
+}
@@ -6,6 +6,7 @@ package metric
 import (
 	"errors"
 	"regexp"
+	"slices"
 	"strings"
 
 	"go.opentelemetry.io/otel/internal/global"
@@ -40,6 +41,10 @@ type View func(Instrument) (Stream, bool)
 // recognized as matching exactly one character. For example, a pattern of "*"
 // matches all instrument names.
 //
+// The Kinds field of criteria matches instruments of any of the listed kinds.
+// Combined with the Unit field, it allows a single View to, for example, set
+// the Aggregation of all histograms with a unit of "ms".
+//
 // The Stream mask only applies updates for non-zero-value fields. By default,
 // the Instrument the View matches against will be use for the Name,
 // Description, and Unit of the returned Stream and no Aggregation or
@@ -47,6 +52,8 @@ type View func(Instrument) (Stream, bool)
 // of the default. If you need to zero out an Stream field returned from a
 // View, create a View directly.
 func NewView(criteria Instrument, mask Stream) View {
+	// Copy Kinds so changes to the passed slice do not affect the view.
+	criteria.Kinds = slices.Clone(criteria.Kinds)
 	if criteria.IsEmpty() {
 		global.Error(
 			errEmptyView, "dropping view",
@@ -105,7 +112,13 @@ func NewView(criteria Instrument, mask Stream) View {
 				Unit:                              nonZero(mask.Unit, i.Unit),
 				Aggregation:                       agg,
 				AttributeFilter:                   mask.AttributeFilter,
+				ExemplarAttributeFilter:           mask.ExemplarAttributeFilter,
+				ExemplarSpanNameKey:               mask.ExemplarSpanNameKey,
 				ExemplarReservoirProviderSelector: mask.ExemplarReservoirProviderSelector,
+				InvalidMeasurementAction:          mask.InvalidMeasurementAction,
+				NoMinMax:                          mask.NoMinMax,
+				CardinalityLimit:                  mask.CardinalityLimit,
+				Staleness:                         mask.Staleness,
 			}, true
 		}
 		return Stream{}, false
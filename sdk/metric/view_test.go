@@ -191,6 +191,48 @@ func TestNewViewMatch(t *testing.T) {
 				{Kind: InstrumentKindObservableGauge},
 			},
 		},
+		{
+			name:     "Kinds",
+			criteria: Instrument{Kinds: []InstrumentKind{InstrumentKindCounter, InstrumentKindHistogram}},
+			matches: []Instrument{
+				{Kind: InstrumentKindCounter},
+				{Kind: InstrumentKindHistogram},
+				completeIP,
+			},
+			notMatches: []Instrument{
+				{},
+				{Kind: InstrumentKindUpDownCounter},
+				{Kind: InstrumentKindGauge},
+				{Kind: InstrumentKindObservableCounter},
+			},
+		},
+		{
+			name: "KindsAndUnit",
+			criteria: Instrument{
+				Kinds: []InstrumentKind{InstrumentKindHistogram, InstrumentKindCounter},
+				Unit:  "By",
+			},
+			matches: []Instrument{
+				{Kind: InstrumentKindHistogram, Unit: "By"},
+				completeIP,
+			},
+			notMatches: []Instrument{
+				{Kind: InstrumentKindHistogram},
+				{Kind: InstrumentKindHistogram, Unit: "ms"},
+				{Kind: InstrumentKindGauge, Unit: "By"},
+			},
+		},
+		{
+			name: "KindAndKinds",
+			criteria: Instrument{
+				Kind:  InstrumentKindCounter,
+				Kinds: []InstrumentKind{InstrumentKindHistogram},
+			},
+			notMatches: []Instrument{
+				{Kind: InstrumentKindCounter},
+				{Kind: InstrumentKindHistogram},
+			},
+		},
 		{
 			name:     "Unit",
 			criteria: Instrument{Unit: "By"},
@@ -392,6 +434,30 @@ func TestNewViewReplace(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "NoMinMax",
+			mask: Stream{NoMinMax: true},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:        i.Name,
+					Description: i.Description,
+					Unit:        i.Unit,
+					NoMinMax:    true,
+				}
+			},
+		},
+		{
+			name: "CardinalityLimit",
+			mask: Stream{CardinalityLimit: 3},
+			want: func(i Instrument) Stream {
+				return Stream{
+					Name:             i.Name,
+					Description:      i.Description,
+					Unit:             i.Unit,
+					CardinalityLimit: 3,
+				}
+			},
+		},
 		{
 			name: "Complete",
 			mask: Stream{
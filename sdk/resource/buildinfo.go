@@ -0,0 +1,86 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// Build information attribute keys that are not defined by the semantic
+// conventions. The keys match the settings the Go toolchain records in the
+// build information of a binary.
+const (
+	// vcsTimeKey is the modification time of the VCS revision the binary was
+	// built from, in RFC 3339 format.
+	vcsTimeKey = attribute.Key("vcs.time")
+	// vcsModifiedKey is whether the source tree the binary was built from had
+	// local modifications.
+	vcsModifiedKey = attribute.Key("vcs.modified")
+)
+
+type buildInfoProvider func() (*debug.BuildInfo, bool)
+
+var (
+	defaultBuildInfoProvider buildInfoProvider = debug.ReadBuildInfo
+
+	readBuildInfo = defaultBuildInfoProvider
+)
+
+func setDefaultBuildInfoProvider() {
+	setBuildInfoProvider(defaultBuildInfoProvider)
+}
+
+func setBuildInfoProvider(buildInfoProvider buildInfoProvider) {
+	readBuildInfo = buildInfoProvider
+}
+
+type buildInfoDetector struct{}
+
+// Detect returns a *Resource that describes the build of the running binary
+// as recorded by the Go toolchain.
+//
+// The service.version is set to the version of the main module if the binary
+// was built from a tagged version, otherwise to the VCS revision it was built
+// from. The VCS revision, its modification time, whether the source tree had
+// local modifications, and the Go version used for the build are also
+// included when they are recorded. An empty Resource is returned if the binary
+// was not built with module support.
+func (buildInfoDetector) Detect(context.Context) (*Resource, error) {
+	bi, ok := readBuildInfo()
+	if !ok || bi == nil {
+		return Empty(), nil
+	}
+
+	var (
+		attrs    []attribute.KeyValue
+		revision string
+	)
+	for _, s := range bi.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+			attrs = append(attrs, semconv.VCSRefHeadRevision(s.Value))
+		case "vcs.time":
+			attrs = append(attrs, vcsTimeKey.String(s.Value))
+		case "vcs.modified":
+			attrs = append(attrs, vcsModifiedKey.Bool(s.Value == "true"))
+		}
+	}
+
+	version := bi.Main.Version
+	if version == "" || version == "(devel)" {
+		version = revision
+	}
+	if version != "" {
+		attrs = append(attrs, semconv.ServiceVersion(version))
+	}
+	if bi.GoVersion != "" {
+		attrs = append(attrs, semconv.ProcessRuntimeVersion(bi.GoVersion))
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
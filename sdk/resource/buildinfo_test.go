@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource_test
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func TestWithBuildInfo(t *testing.T) {
+	const revision = "0123456789abcdef0123456789abcdef01234567"
+
+	tests := []struct {
+		name string
+		bi   *debug.BuildInfo
+		ok   bool
+		want map[string]string
+	}{
+		{
+			name: "Unavailable",
+			want: map[string]string{},
+		},
+		{
+			name: "Tagged",
+			bi: &debug.BuildInfo{
+				GoVersion: "go1.2.3",
+				Main:      debug.Module{Path: "example.com/svc", Version: "v1.2.3"},
+				Settings: []debug.BuildSetting{
+					{Key: "vcs.revision", Value: revision},
+					{Key: "vcs.time", Value: "2024-01-02T03:04:05Z"},
+					{Key: "vcs.modified", Value: "false"},
+				},
+			},
+			ok: true,
+			want: map[string]string{
+				"service.version":         "v1.2.3",
+				"vcs.ref.head.revision":   revision,
+				"vcs.time":                "2024-01-02T03:04:05Z",
+				"vcs.modified":            "false",
+				"process.runtime.version": "go1.2.3",
+			},
+		},
+		{
+			name: "Development",
+			bi: &debug.BuildInfo{
+				GoVersion: "go1.2.3",
+				Main:      debug.Module{Path: "example.com/svc", Version: "(devel)"},
+				Settings: []debug.BuildSetting{
+					{Key: "vcs.revision", Value: revision},
+					{Key: "vcs.modified", Value: "true"},
+				},
+			},
+			ok: true,
+			want: map[string]string{
+				"service.version":         revision,
+				"vcs.ref.head.revision":   revision,
+				"vcs.modified":            "true",
+				"process.runtime.version": "go1.2.3",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource.SetBuildInfoProvider(func() (*debug.BuildInfo, bool) {
+				return tt.bi, tt.ok
+			})
+			t.Cleanup(resource.SetDefaultBuildInfoProvider)
+
+			res, err := resource.New(t.Context(), resource.WithBuildInfo())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, toMap(res))
+		})
+	}
+}
+
+func TestWithBuildInfoOverridden(t *testing.T) {
+	resource.SetBuildInfoProvider(func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{Main: debug.Module{Version: "v1.2.3"}}, true
+	})
+	t.Cleanup(resource.SetDefaultBuildInfoProvider)
+
+	res, err := resource.New(
+		t.Context(),
+		resource.WithBuildInfo(),
+		resource.WithAttributes(attribute.String("service.version", "v2.0.0")),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"service.version": "v2.0.0"}, toMap(res))
+}
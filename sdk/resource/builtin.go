@@ -23,6 +23,12 @@ type (
 	// resource.New() to explicitly disable them.
 	telemetrySDK struct{}
 
+	// telemetryDistro is a Detector that provides information about the
+	// distribution of the OpenTelemetry SDK used.
+	telemetryDistro struct {
+		name, version string
+	}
+
 	// host is a Detector that provides information about the host
 	// being run on. This Detector is included as a builtin. If
 	// these resource attributes are not wanted, use the
@@ -42,6 +48,7 @@ type (
 
 var (
 	_ Detector = telemetrySDK{}
+	_ Detector = telemetryDistro{}
 	_ Detector = host{}
 	_ Detector = stringDetector{}
 	_ Detector = defaultServiceNameDetector{}
@@ -58,6 +65,16 @@ func (telemetrySDK) Detect(context.Context) (*Resource, error) {
 	), nil
 }
 
+// Detect returns a *Resource that describes the distribution of the
+// OpenTelemetry SDK used.
+func (d telemetryDistro) Detect(context.Context) (*Resource, error) {
+	attrs := []attribute.KeyValue{semconv.TelemetryDistroName(d.name)}
+	if d.version != "" {
+		attrs = append(attrs, semconv.TelemetryDistroVersion(d.version))
+	}
+	return NewWithAttributes(semconv.SchemaURL, attrs...), nil
+}
+
 // Detect returns a *Resource that describes the host being run on.
 func (host) Detect(ctx context.Context) (*Resource, error) {
 	return StringDetector(semconv.SchemaURL, semconv.HostNameKey, os.Hostname).Detect(ctx)
@@ -15,6 +15,8 @@ type config struct {
 	detectors []Detector
 	// SchemaURL to associate with the Resource.
 	schemaURL string
+	// distro is true if the telemetry distribution has been set.
+	distro bool
 }
 
 // Option is the interface that applies a configuration option.
@@ -72,6 +74,35 @@ func WithTelemetrySDK() Option {
 	return WithDetectors(telemetrySDK{})
 }
 
+// WithTelemetryDistro adds the telemetry.distro.name and
+// telemetry.distro.version attributes, describing the distribution of the
+// OpenTelemetry SDK named name with the given version, and the TelemetrySDK
+// attributes to the configured Resource. The version attribute is not added
+// if version is empty, and the option is ignored if name is empty.
+//
+// This option is meant for the authors of distributions built on this SDK.
+// The TelemetrySDK attributes always describe this SDK, distributions must
+// not overwrite them. Only the first WithTelemetryDistro option with a
+// non-empty name is used, the later ones are ignored: a distribution passing
+// its option before the options of its users, or of the distributions built
+// on it, keeps its attributes.
+func WithTelemetryDistro(name, version string) Option {
+	return telemetryDistroOption{name: name, version: version}
+}
+
+type telemetryDistroOption struct {
+	name, version string
+}
+
+func (o telemetryDistroOption) apply(cfg config) config {
+	if cfg.distro || o.name == "" {
+		return cfg
+	}
+	cfg.distro = true
+	cfg.detectors = append(cfg.detectors, telemetrySDK{}, telemetryDistro(o))
+	return cfg
+}
+
 // WithSchemaURL sets the schema URL for the configured resource.
 func WithSchemaURL(schemaURL string) Option {
 	return schemaURLOption(schemaURL)
@@ -181,6 +212,23 @@ func WithProcessRuntimeDescription() Option {
 	return WithDetectors(processRuntimeDescriptionDetector{})
 }
 
+// WithBuildInfo adds attributes describing the build of the running binary,
+// as recorded by the Go toolchain, to the configured Resource.
+//
+// The service.version attribute is set to the version of the main module if
+// the binary was built from a tagged version, otherwise to the VCS revision it
+// was built from. The vcs.ref.head.revision, vcs.time, vcs.modified, and
+// process.runtime.version attributes are added when the information is
+// recorded in the binary.
+//
+// Options are applied in order, with the attributes of later options
+// overriding earlier ones. Use this option before options like WithFromEnv or
+// WithAttributes for the build information to only be used when the service
+// version is not otherwise set.
+func WithBuildInfo() Option {
+	return WithDetectors(buildInfoDetector{})
+}
+
 // WithContainer adds all the Container attributes to the configured Resource.
 // See individual WithContainer* functions to configure specific attributes.
 func WithContainer() Option {
@@ -14,6 +14,8 @@ var (
 	SetOSDescriptionProvider        = setOSDescriptionProvider
 	SetDefaultContainerProviders    = setDefaultContainerProviders
 	SetContainerProviders           = setContainerProviders
+	SetDefaultBuildInfoProvider     = setDefaultBuildInfoProvider
+	SetBuildInfoProvider            = setBuildInfoProvider
 )
 
 var (
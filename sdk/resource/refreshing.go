@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+// now returns the current time. It is a variable so tests can override it.
+var now = time.Now
+
+// Refreshing is a Resource with attributes that are provided by Detectors
+// evaluated when the Resource is read, instead of once when it is created.
+// This is useful for attributes that change during the lifetime of the
+// process, e.g. the lifecycle state of a spot instance.
+//
+// The detected attributes are cached and the Detectors are only evaluated
+// again once the cached attributes are older than the configured TTL.
+//
+// A Refreshing is safe for concurrent use.
+type Refreshing struct {
+	base      *Resource
+	ttl       time.Duration
+	detectors []Detector
+
+	mu      sync.Mutex
+	res     *Resource
+	expires time.Time
+}
+
+// NewRefreshing returns a Refreshing that merges the attributes detected by
+// detectors with base when read. Attributes detected by the detectors take
+// precedence over the ones of base, and the detectors are merged in the
+// order they are passed (see [Merge]).
+//
+// The detected attributes are cached for ttl. If ttl is less than or equal to
+// zero, the detectors are evaluated each time the Resource is read.
+//
+// The detectors are evaluated while the Resource is read, e.g. when telemetry
+// is exported. They should return quickly and must not read the returned
+// Refreshing themselves.
+func NewRefreshing(base *Resource, ttl time.Duration, detectors ...Detector) *Refreshing {
+	return &Refreshing{base: base, ttl: ttl, detectors: detectors}
+}
+
+// Resource returns the Resource with the attributes detected by the
+// Detectors of r merged with its base Resource. The last detected Resource is
+// returned if it is not older than the TTL of r.
+//
+// The Detectors are evaluated as with [Detect]. Any error is passed to the
+// global error handler. A nil Refreshing returns a nil Resource.
+func (r *Refreshing) Resource() *Resource {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t := now()
+	if r.res != nil && t.Before(r.expires) {
+		return r.res
+	}
+
+	detected, err := Detect(context.Background(), r.detectors...)
+	if err != nil {
+		otel.Handle(err)
+	}
+	res, err := Merge(r.base, detected)
+	if err != nil {
+		otel.Handle(err)
+	}
+	r.res = res
+	r.expires = t.Add(r.ttl)
+	return res
+}
@@ -0,0 +1,96 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// countingDetector detects the "state" attribute with the value returned by
+// state, counting its calls.
+type countingDetector struct {
+	calls int
+	state func() string
+	err   error
+}
+
+func (d *countingDetector) Detect(context.Context) (*Resource, error) {
+	d.calls++
+	if d.err != nil {
+		return nil, d.err
+	}
+	return NewSchemaless(attribute.String("state", d.state())), nil
+}
+
+func setNow(t *testing.T, f func() time.Time) {
+	orig := now
+	t.Cleanup(func() { now = orig })
+	now = f
+}
+
+func TestRefreshing(t *testing.T) {
+	var clock time.Time
+	setNow(t, func() time.Time { return clock })
+
+	state := "running"
+	d := &countingDetector{state: func() string { return state }}
+	base := NewWithAttributes("https://example.com", attribute.String("service.name", "svc"), attribute.String("state", "base"))
+	r := NewRefreshing(base, time.Minute, d)
+
+	want := NewWithAttributes(
+		"https://example.com",
+		attribute.String("service.name", "svc"),
+		attribute.String("state", "running"),
+	)
+	assert.Equal(t, want, r.Resource())
+	assert.Equal(t, 1, d.calls)
+
+	state = "terminating"
+	clock = clock.Add(30 * time.Second)
+	assert.Same(t, r.Resource(), r.Resource(), "cached resource not reused")
+	assert.Equal(t, 1, d.calls, "detector evaluated before TTL")
+
+	clock = clock.Add(30 * time.Second)
+	got := r.Resource()
+	assert.Equal(t, 2, d.calls)
+	v, ok := got.Set().Value("state")
+	assert.True(t, ok)
+	assert.Equal(t, "terminating", v.AsString())
+}
+
+func TestRefreshingNoTTL(t *testing.T) {
+	d := &countingDetector{state: func() string { return "running" }}
+	r := NewRefreshing(nil, 0, d)
+	r.Resource()
+	r.Resource()
+	assert.Equal(t, 2, d.calls)
+}
+
+func TestRefreshingError(t *testing.T) {
+	var got []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { got = append(got, err) }))
+
+	errDetect := errors.New("detect")
+	base := NewSchemaless(attribute.String("service.name", "svc"))
+	r := NewRefreshing(base, time.Minute, &countingDetector{err: errDetect})
+	assert.True(t, base.Equal(r.Resource()))
+	if assert.Len(t, got, 1) {
+		assert.ErrorIs(t, got[0], errDetect)
+	}
+}
+
+func TestRefreshingNil(t *testing.T) {
+	var r *Refreshing
+	assert.Nil(t, r.Resource())
+}
@@ -0,0 +1,171 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+)
+
+// detectorsKey is the environment variable name the names of the registered
+// detectors to run are read from.
+const detectorsKey = "OTEL_RESOURCE_DETECTORS"
+
+// Detector names with a special meaning in OTEL_RESOURCE_DETECTORS.
+const (
+	detectorsAll  = "all"
+	detectorsNone = "none"
+)
+
+// registry holds the Detectors registered by name.
+var registry = struct {
+	sync.RWMutex
+	detectors map[string]Detector
+}{
+	detectors: map[string]Detector{
+		"host":      multiDetector{host{}, hostIDDetector{}},
+		"os":        multiDetector{osTypeDetector{}, osDescriptionDetector{}},
+		"container": cgroupContainerIDDetector{},
+		"process": multiDetector{
+			processPIDDetector{},
+			processExecutableNameDetector{},
+			processExecutablePathDetector{},
+			processCommandArgsDetector{},
+			processOwnerDetector{},
+			processRuntimeNameDetector{},
+			processRuntimeVersionDetector{},
+			processRuntimeDescriptionDetector{},
+		},
+		"service":   multiDetector{defaultServiceInstanceIDDetector{}, defaultServiceNameDetector{}},
+		"buildinfo": buildInfoDetector{},
+	},
+}
+
+// multiDetector is a Detector that detects the merged Resource of multiple
+// Detectors.
+type multiDetector []Detector
+
+// Detect returns the Resource detected by all the Detectors of d.
+func (d multiDetector) Detect(ctx context.Context) (*Resource, error) {
+	return Detect(ctx, d...)
+}
+
+// RegisterDetector registers d with name so it can be selected with the
+// OTEL_RESOURCE_DETECTORS environment variable by [NewFromEnv]. Registering a
+// Detector with the name of a registered Detector replaces it. If d is nil,
+// the Detector registered with name is removed.
+//
+// The "host", "os", "process", "container", "service", and "buildinfo"
+// Detectors are registered by default. They detect the attributes added by
+// the [WithHost] and [WithHostID], [WithOS], [WithProcess], [WithContainer],
+// [WithService], and [WithBuildInfo] options respectively. The "all" and
+// "none" names are reserved: registering a Detector with them is ignored and
+// an error is sent to the global ErrorHandler.
+//
+// This function is safe to call concurrently. It is meant to be called from
+// the init function of packages providing Detectors.
+func RegisterDetector(name string, d Detector) {
+	if name == detectorsAll || name == detectorsNone {
+		otel.Handle(fmt.Errorf("resource: detector name %q is reserved, detector not registered", name))
+		return
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if d == nil {
+		delete(registry.detectors, name)
+		return
+	}
+	registry.detectors[name] = d
+}
+
+// NewFromEnv returns a Resource detected by the registered Detectors selected
+// with the OTEL_RESOURCE_DETECTORS environment variable, combined with the
+// default Resource.
+//
+// OTEL_RESOURCE_DETECTORS is a comma-separated list of the names the Detectors
+// were registered with (see [RegisterDetector]). The Detectors run in the
+// listed order. The value "all" selects all the registered Detectors, in the
+// order of their names, and "none" selects none of them, taking precedence
+// over all other names. If the variable is not set or is empty, no registered
+// Detector is run.
+//
+// The default service name and telemetry SDK attributes are detected before
+// the selected Detectors, and the attributes of the OTEL_RESOURCE_ATTRIBUTES
+// and OTEL_SERVICE_NAME environment variables after them, so the environment
+// variables take precedence.
+//
+// If a listed name is not registered, an error wrapping ErrPartialResource is
+// returned along with the Resource detected by the other Detectors. Errors of
+// the Detectors are returned as for [New].
+func NewFromEnv(ctx context.Context) (*Resource, error) {
+	detectors, err := envDetectors(os.Getenv(detectorsKey))
+	res, dErr := New(
+		ctx,
+		WithDetectors(defaultServiceNameDetector{}, telemetrySDK{}),
+		WithDetectors(detectors...),
+		WithFromEnv(),
+	)
+	return res, errors.Join(err, dErr)
+}
+
+// envDetectors returns the registered Detectors selected by v, the value of
+// OTEL_RESOURCE_DETECTORS. An error is returned if v contains names that are
+// not registered.
+func envDetectors(v string) ([]Detector, error) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil, nil
+	}
+
+	registry.RLock()
+	defer registry.RUnlock()
+
+	var (
+		detectors []Detector
+		unknown   []string
+		all, none bool
+	)
+	for name := range strings.SplitSeq(v, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case detectorsAll:
+			all = true
+			continue
+		case detectorsNone:
+			none = true
+			continue
+		}
+		d, ok := registry.detectors[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		detectors = append(detectors, d)
+	}
+
+	var err error
+	if len(unknown) > 0 {
+		err = fmt.Errorf("%w: %s: unknown detectors %q", ErrPartialResource, detectorsKey, unknown)
+	}
+	switch {
+	case none:
+		return nil, err
+	case all:
+		names := slices.Sorted(maps.Keys(registry.detectors))
+		detectors = detectors[:0]
+		for _, n := range names {
+			detectors = append(detectors, registry.detectors[n])
+		}
+	}
+	return detectors, err
+}
@@ -0,0 +1,138 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package resource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func registerTestDetector(t *testing.T, name string, d Detector) {
+	t.Helper()
+
+	registry.RLock()
+	orig, ok := registry.detectors[name]
+	registry.RUnlock()
+
+	RegisterDetector(name, d)
+	t.Cleanup(func() {
+		if ok {
+			RegisterDetector(name, orig)
+			return
+		}
+		RegisterDetector(name, nil)
+	})
+}
+
+func TestRegisterDetectorReserved(t *testing.T) {
+	var got []error
+	orig := otel.GetErrorHandler()
+	t.Cleanup(func() { otel.SetErrorHandler(orig) })
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) { got = append(got, err) }))
+
+	d := StringDetector("", "k", func() (string, error) { return "v", nil })
+	RegisterDetector(detectorsAll, d)
+	RegisterDetector(detectorsNone, d)
+	assert.Len(t, got, 2)
+
+	registry.RLock()
+	_, okAll := registry.detectors[detectorsAll]
+	_, okNone := registry.detectors[detectorsNone]
+	registry.RUnlock()
+	assert.False(t, okAll, "all registered")
+	assert.False(t, okNone, "none registered")
+}
+
+func TestRegisterDetectorNil(t *testing.T) {
+	registerTestDetector(t, "test", StringDetector("", "k", func() (string, error) { return "v", nil }))
+	RegisterDetector("test", nil)
+
+	registry.RLock()
+	_, ok := registry.detectors["test"]
+	registry.RUnlock()
+	assert.False(t, ok)
+}
+
+func TestNewFromEnv(t *testing.T) {
+	registerTestDetector(t, "a", StringDetector("", "key", func() (string, error) { return "a", nil }))
+	registerTestDetector(t, "b", StringDetector("", "key", func() (string, error) { return "b", nil }))
+
+	tests := []struct {
+		name    string
+		env     string
+		want    attribute.KeyValue
+		wantOK  bool
+		wantErr error
+	}{
+		{name: "Unset", env: ""},
+		{name: "None", env: "none"},
+		{name: "NoneTakesPrecedence", env: "a, none"},
+		{name: "Single", env: "a", want: attribute.String("key", "a"), wantOK: true},
+		{name: "ListedOrder", env: "b,a", want: attribute.String("key", "a"), wantOK: true},
+		{name: "Spaces", env: " a , b ", want: attribute.String("key", "b"), wantOK: true},
+		{
+			name:    "Unknown",
+			env:     "a,unknown",
+			want:    attribute.String("key", "a"),
+			wantOK:  true,
+			wantErr: ErrPartialResource,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(detectorsKey, tt.env)
+
+			res, err := NewFromEnv(t.Context())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.ErrorContains(t, err, "unknown")
+			} else {
+				assert.NoError(t, err)
+			}
+			require.NotNil(t, res)
+
+			got, ok := res.Set().Value("key")
+			assert.Equal(t, tt.wantOK, ok)
+			if tt.wantOK {
+				assert.Equal(t, tt.want.Value.AsString(), got.AsString())
+			}
+			assert.True(t, res.Set().HasValue(semconv.ServiceNameKey))
+			assert.Contains(t, res.Attributes(), semconv.TelemetrySDKLanguageGo)
+		})
+	}
+}
+
+func TestNewFromEnvAll(t *testing.T) {
+	registerTestDetector(t, "a", StringDetector("", "key.a", func() (string, error) { return "a", nil }))
+	registerTestDetector(t, "b", StringDetector("", "key.b", func() (string, error) { return "b", nil }))
+	t.Setenv(detectorsKey, "all")
+
+	res, err := NewFromEnv(t.Context())
+	assert.NoError(t, err)
+	require.NotNil(t, res)
+	assert.True(t, res.Set().HasValue("key.a"))
+	assert.True(t, res.Set().HasValue("key.b"))
+	assert.True(t, res.Set().HasValue(semconv.ProcessPIDKey))
+}
+
+func TestNewFromEnvEnvironmentPrecedence(t *testing.T) {
+	registerTestDetector(t, "a", StringDetector("", "key", func() (string, error) { return "a", nil }))
+	t.Setenv(detectorsKey, "a")
+	t.Setenv(resourceAttrKey, "key=env")
+	t.Setenv(svcNameKey, "svc")
+
+	res, err := NewFromEnv(t.Context())
+	require.NoError(t, err)
+
+	got, _ := res.Set().Value("key")
+	assert.Equal(t, "env", got.AsString())
+	got, _ = res.Set().Value(semconv.ServiceNameKey)
+	assert.Equal(t, "svc", got.AsString())
+}
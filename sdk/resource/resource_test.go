@@ -913,6 +913,29 @@ func TestWithService(t *testing.T) {
 	require.True(t, ok, "service.instance.id should be present")
 }
 
+func TestWithTelemetryDistro(t *testing.T) {
+	res, err := resource.New(
+		t.Context(),
+		resource.WithTelemetryDistro("", "ignored"),
+		resource.WithTelemetryDistro("vendor", "1.2.3"),
+		resource.WithTelemetryDistro("other", "4.5.6"),
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		string(semconv.TelemetryDistroNameKey):    "vendor",
+		string(semconv.TelemetryDistroVersionKey): "1.2.3",
+		string(semconv.TelemetrySDKNameKey):       "opentelemetry",
+		string(semconv.TelemetrySDKLanguageKey):   "go",
+		string(semconv.TelemetrySDKVersionKey):    sdk.Version(),
+	}, toMap(res))
+
+	res, err = resource.New(t.Context(), resource.WithTelemetryDistro("vendor", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "vendor", toMap(res)[string(semconv.TelemetryDistroNameKey)])
+	assert.NotContains(t, toMap(res), string(semconv.TelemetryDistroVersionKey))
+}
+
 func TestResourceConcurrentSafe(t *testing.T) {
 	// Creating Resources should also be free of any data races,
 	// because Resources are immutable.
@@ -13,6 +13,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/trace/internal/env"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	"go.opentelemetry.io/otel/trace"
@@ -68,10 +69,18 @@ type batchSpanProcessor struct {
 	e SpanExporter
 	o BatchSpanProcessorOptions
 
+	// me is e if it receives the metadata of the batches, nil otherwise.
+	me MetadataSpanExporter
+
+	// cfgErr is the error describing the invalid configuration the
+	// processor was created with, if any.
+	cfgErr error
+
 	queue   chan ReadOnlySpan
 	dropped atomic.Uint32
 
-	inst *observ.BSP
+	id   int64
+	inst atomic.Pointer[observ.BSP]
 
 	batch      []ReadOnlySpan
 	batchMutex sync.Mutex
@@ -80,6 +89,12 @@ type batchSpanProcessor struct {
 	stopOnce   sync.Once
 	stopCh     chan struct{}
 	stopped    atomic.Bool
+
+	// batchCreated is the time the first span of batch was added, and
+	// exportedDropped the value of dropped when the last batch was exported.
+	// They are only set if me is not nil.
+	batchCreated    time.Time
+	exportedDropped uint32
 }
 
 var _ SpanProcessor = (*batchSpanProcessor)(nil)
@@ -108,21 +123,20 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 	bsp := &batchSpanProcessor{
 		e:      exporter,
 		o:      o,
+		cfgErr: validateBatchSpanProcessor(exporter, o),
 		batch:  make([]ReadOnlySpan, 0, o.MaxExportBatchSize),
 		timer:  time.NewTimer(o.BatchTimeout),
 		queue:  make(chan ReadOnlySpan, o.MaxQueueSize),
 		stopCh: make(chan struct{}),
 	}
+	bsp.me, _ = exporter.(MetadataSpanExporter)
 
-	var err error
-	bsp.inst, err = observ.NewBSP(
-		nextProcessorID(),
-		func() int64 { return int64(len(bsp.queue)) },
-		int64(bsp.o.MaxQueueSize),
-	)
+	bsp.id = nextProcessorID()
+	inst, err := bsp.newInst(nil)
 	if err != nil {
 		otel.Handle(err)
 	}
+	bsp.inst.Store(inst)
 
 	bsp.stopWait.Go(func() {
 		bsp.processQueue()
@@ -132,8 +146,70 @@ func NewBatchSpanProcessor(exporter SpanExporter, options ...BatchSpanProcessorO
 	return bsp
 }
 
+// validateBatchSpanProcessor returns an error describing why the exporter and
+// options o of a BatchSpanProcessor are invalid, or nil if they are valid.
+func validateBatchSpanProcessor(exporter SpanExporter, o BatchSpanProcessorOptions) error {
+	var errs []error
+	if exporter == nil {
+		errs = append(errs, errors.New("nil exporter"))
+	}
+	if o.MaxQueueSize <= 0 {
+		errs = append(errs, fmt.Errorf("non-positive MaxQueueSize: %d", o.MaxQueueSize))
+	}
+	if o.MaxExportBatchSize <= 0 {
+		errs = append(errs, fmt.Errorf("non-positive MaxExportBatchSize: %d", o.MaxExportBatchSize))
+	} else if o.MaxExportBatchSize > o.MaxQueueSize {
+		errs = append(errs, fmt.Errorf(
+			"MaxExportBatchSize %d greater than MaxQueueSize %d",
+			o.MaxExportBatchSize, o.MaxQueueSize,
+		))
+	}
+	if o.BatchTimeout <= 0 {
+		errs = append(errs, fmt.Errorf("non-positive BatchTimeout: %s", o.BatchTimeout))
+	}
+	if o.ExportTimeout < 0 {
+		errs = append(errs, fmt.Errorf("negative ExportTimeout: %s", o.ExportTimeout))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: BatchSpanProcessor: %w", ErrInvalidConfig, errors.Join(errs...))
+}
+
+// configErr returns the error describing the invalid configuration bsp was
+// created with, if any.
+func (bsp *batchSpanProcessor) configErr() error {
+	return bsp.cfgErr
+}
+
+// newInst returns the instrumentation of bsp recording its metrics with mp.
+func (bsp *batchSpanProcessor) newInst(mp metric.MeterProvider) (*observ.BSP, error) {
+	return observ.NewBSP(
+		mp,
+		bsp.id,
+		func() int64 { return int64(len(bsp.queue)) },
+		int64(bsp.o.MaxQueueSize),
+	)
+}
+
+// setMeterProvider configures bsp to record its self-observability metrics
+// with mp, replacing any existing instrumentation.
+func (bsp *batchSpanProcessor) setMeterProvider(mp metric.MeterProvider) error {
+	if bsp.stopped.Load() {
+		return nil
+	}
+	inst, err := bsp.newInst(mp)
+	if old := bsp.inst.Swap(inst); old != nil {
+		err = errors.Join(err, old.Shutdown())
+	}
+	return err
+}
+
 var processorIDCounter atomic.Int64
 
+// droppedQueueFull counts the spans dropped because the queue is full.
+var droppedQueueFull = global.NewDropCounter("spans", "queue_full")
+
 // nextProcessorID returns an identifier for this batch span processor,
 // starting with 0 and incrementing by 1 each time it is called.
 func nextProcessorID() int64 {
@@ -182,8 +258,8 @@ func (bsp *batchSpanProcessor) Shutdown(ctx context.Context) error {
 		case <-ctx.Done():
 			err = ctx.Err()
 		}
-		if bsp.inst != nil {
-			err = errors.Join(err, bsp.inst.Shutdown())
+		if inst := bsp.inst.Swap(nil); inst != nil {
+			err = errors.Join(err, inst.Shutdown())
 		}
 	})
 	return err
@@ -297,10 +373,15 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 
 	if l := len(bsp.batch); l > 0 {
 		global.Debug("exporting spans", "count", len(bsp.batch), "total_dropped", bsp.dropped.Load())
-		if bsp.inst != nil {
-			bsp.inst.Processed(ctx, int64(l))
+		if inst := bsp.inst.Load(); inst != nil {
+			inst.Processed(ctx, int64(l))
+		}
+		var err error
+		if bsp.me != nil {
+			err = bsp.me.ExportSpansWithMetadata(ctx, bsp.batch, bsp.batchMetadata())
+		} else {
+			err = bsp.e.ExportSpans(ctx, bsp.batch)
 		}
-		err := bsp.e.ExportSpans(ctx, bsp.batch)
 
 		// A new batch is always created after exporting, even if the batch failed to be exported.
 		//
@@ -316,6 +397,36 @@ func (bsp *batchSpanProcessor) exportSpans(ctx context.Context) error {
 	return nil
 }
 
+// addToBatch adds s to the batch. The batchMutex must be held.
+func (bsp *batchSpanProcessor) addToBatch(s ReadOnlySpan) {
+	if bsp.me != nil && len(bsp.batch) == 0 {
+		bsp.batchCreated = time.Now()
+	}
+	bsp.batch = append(bsp.batch, s)
+}
+
+// batchMetadata returns the metadata of the batch to export. The batchMutex
+// must be held.
+func (bsp *batchSpanProcessor) batchMetadata() BatchMetadata {
+	dropped := bsp.dropped.Load()
+	md := BatchMetadata{
+		Dropped: uint64(dropped - bsp.exportedDropped),
+		Created: bsp.batchCreated,
+	}
+	bsp.exportedDropped = dropped
+
+	var oldest time.Time
+	for _, s := range bsp.batch {
+		if end := s.EndTime(); oldest.IsZero() || end.Before(oldest) {
+			oldest = end
+		}
+	}
+	if !oldest.IsZero() {
+		md.QueueLatency = max(0, time.Since(oldest))
+	}
+	return md
+}
+
 // processQueue removes spans from the `queue` channel until processor
 // is shut down. It calls the exporter in batches of up to MaxExportBatchSize
 // waiting up to BatchTimeout to form a batch.
@@ -338,7 +449,7 @@ func (bsp *batchSpanProcessor) processQueue() {
 				continue
 			}
 			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
+			bsp.addToBatch(sd)
 			shouldExport := len(bsp.batch) >= bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 			if shouldExport {
@@ -371,7 +482,7 @@ func (bsp *batchSpanProcessor) drainQueue() {
 			}
 
 			bsp.batchMutex.Lock()
-			bsp.batch = append(bsp.batch, sd)
+			bsp.addToBatch(sd)
 			shouldExport := len(bsp.batch) == bsp.o.MaxExportBatchSize
 			bsp.batchMutex.Unlock()
 
@@ -408,8 +519,8 @@ func (bsp *batchSpanProcessor) enqueueBlockOnQueueFull(ctx context.Context, sd R
 	case bsp.queue <- sd:
 		return true
 	case <-ctx.Done():
-		if bsp.inst != nil {
-			bsp.inst.ProcessedQueueFull(ctx, 1)
+		if inst := bsp.inst.Load(); inst != nil {
+			inst.ProcessedQueueFull(ctx, 1)
 		}
 		return false
 	}
@@ -425,8 +536,9 @@ func (bsp *batchSpanProcessor) enqueueDrop(ctx context.Context, sd ReadOnlySpan)
 		return true
 	default:
 		bsp.dropped.Add(1)
-		if bsp.inst != nil {
-			bsp.inst.ProcessedQueueFull(ctx, 1)
+		droppedQueueFull.Add(1)
+		if inst := bsp.inst.Load(); inst != nil {
+			inst.ProcessedQueueFull(ctx, 1)
 		}
 	}
 	return false
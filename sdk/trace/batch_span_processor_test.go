@@ -480,6 +480,48 @@ func TestBatchSpanProcessorForceFlushSucceeds(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+type metadataExporter struct {
+	testBatchExporter
+
+	mds []BatchMetadata
+}
+
+func (e *metadataExporter) ExportSpansWithMetadata(
+	ctx context.Context,
+	spans []ReadOnlySpan,
+	md BatchMetadata,
+) error {
+	e.mds = append(e.mds, md)
+	return e.ExportSpans(ctx, spans)
+}
+
+func TestBatchSpanProcessorBatchMetadata(t *testing.T) {
+	exp := new(metadataExporter)
+	bsp := NewBatchSpanProcessor(exp, WithBatchTimeout(time.Hour))
+	tp := basicTracerProvider(t)
+	tp.RegisterSpanProcessor(bsp)
+	tr := tp.Tracer(t.Name())
+
+	start := time.Now()
+	bsp.(*batchSpanProcessor).dropped.Add(3)
+	_, span := tr.Start(t.Context(), "span", trace.WithTimestamp(start.Add(-time.Second)))
+	span.End(trace.WithTimestamp(start.Add(-time.Second)))
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+
+	_, span = tr.Start(t.Context(), "span")
+	span.End()
+	require.NoError(t, bsp.ForceFlush(t.Context()))
+
+	assert.Equal(t, 2, exp.len())
+	require.Len(t, exp.mds, 2)
+	assert.Equal(t, uint64(3), exp.mds[0].Dropped)
+	assert.False(t, exp.mds[0].Created.Before(start))
+	assert.GreaterOrEqual(t, exp.mds[0].QueueLatency, time.Second)
+	assert.Equal(t, uint64(0), exp.mds[1].Dropped, "dropped since the previous export")
+	assert.False(t, exp.mds[1].Created.Before(exp.mds[0].Created))
+	assert.Less(t, exp.mds[1].QueueLatency, time.Second)
+}
+
 func TestBatchSpanProcessorDropBatchIfFailed(t *testing.T) {
 	te := testBatchExporter{
 		errors: []error{errors.New("fail to export")},
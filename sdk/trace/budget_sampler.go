@@ -0,0 +1,178 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultMaxEndpoints is the default maximum number of endpoints an
+// EndpointBudget sampler tracks.
+const defaultMaxEndpoints = 1000
+
+// EndpointBudgetOption configures an EndpointBudget sampler.
+type EndpointBudgetOption interface {
+	applyEndpointBudget(endpointBudgetConfig) endpointBudgetConfig
+}
+
+type endpointBudgetConfig struct {
+	key          attribute.Key
+	maxEndpoints int
+}
+
+type endpointBudgetOptionFunc func(endpointBudgetConfig) endpointBudgetConfig
+
+func (fn endpointBudgetOptionFunc) applyEndpointBudget(c endpointBudgetConfig) endpointBudgetConfig {
+	return fn(c)
+}
+
+// WithEndpointKey sets the attribute key whose value identifies the endpoint
+// of a span. The attribute needs to be provided when the span is started (see
+// [trace.WithAttributes]). Spans without the attribute are identified by
+// their name.
+//
+// By default, the endpoint of a span is identified by its name.
+func WithEndpointKey(key attribute.Key) EndpointBudgetOption {
+	return endpointBudgetOptionFunc(func(c endpointBudgetConfig) endpointBudgetConfig {
+		c.key = key
+		return c
+	})
+}
+
+// WithMaxEndpoints sets the maximum number of endpoints that are given a
+// budget. The decisions for spans of endpoints seen after this number is
+// reached are delegated to the overflow sampler. A value less than or equal
+// to zero means the default is used.
+//
+// By default, up to 1000 endpoints are given a budget.
+func WithMaxEndpoints(n int) EndpointBudgetOption {
+	return endpointBudgetOptionFunc(func(c endpointBudgetConfig) endpointBudgetConfig {
+		c.maxEndpoints = n
+		return c
+	})
+}
+
+// EndpointBudget returns a sampler that guarantees every endpoint a budget of
+// sampled spans and delegates the decision for the spans exceeding that
+// budget to overflow.
+//
+// The number of spans sampled for an endpoint within its budget decays
+// exponentially with window as time constant. A span is sampled within the
+// budget if this decayed number is at most budget-1 when the span starts.
+// Each endpoint is therefore guaranteed bursts of up to budget spans and a
+// sustained throughput of approximately budget/window spans. This ensures
+// rarely used endpoints are always traced while frequently used endpoints are
+// throttled to the decisions of overflow, e.g. a [TraceIDRatioBased] sampler.
+//
+// If budget or window are not positive, all the decisions are delegated to
+// overflow. If overflow is nil, the spans exceeding the budget are dropped.
+func EndpointBudget(budget float64, window time.Duration, overflow Sampler, opts ...EndpointBudgetOption) Sampler {
+	if overflow == nil {
+		overflow = NeverSample()
+	}
+	c := endpointBudgetConfig{maxEndpoints: defaultMaxEndpoints}
+	for _, opt := range opts {
+		c = opt.applyEndpointBudget(c)
+	}
+	if c.maxEndpoints <= 0 {
+		c.maxEndpoints = defaultMaxEndpoints
+	}
+	return &endpointBudget{
+		budget:    budget,
+		window:    window,
+		overflow:  overflow,
+		cfg:       c,
+		now:       time.Now,
+		endpoints: make(map[string]*endpointUsage),
+	}
+}
+
+type endpointBudget struct {
+	budget   float64
+	window   time.Duration
+	overflow Sampler
+	cfg      endpointBudgetConfig
+
+	// now returns the current time. It is overridden in tests.
+	now func() time.Time
+
+	mu        sync.Mutex
+	endpoints map[string]*endpointUsage
+}
+
+// endpointUsage is the decayed number of spans sampled within the budget of
+// an endpoint.
+type endpointUsage struct {
+	count float64
+	last  time.Time
+}
+
+func (s *endpointBudget) ShouldSample(p SamplingParameters) SamplingResult {
+	if s.budget <= 0 || s.window <= 0 {
+		return s.overflow.ShouldSample(p)
+	}
+
+	if s.take(s.endpoint(p)) {
+		return SamplingResult{
+			Decision:   RecordAndSample,
+			Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+		}
+	}
+	return s.overflow.ShouldSample(p)
+}
+
+// endpoint returns the endpoint of the span described by p.
+func (s *endpointBudget) endpoint(p SamplingParameters) string {
+	if s.cfg.key != "" {
+		// Search in reverse so the last value wins for duplicate keys,
+		// matching the attribute de-duplication of the span.
+		for i := len(p.Attributes) - 1; i >= 0; i-- {
+			if p.Attributes[i].Key == s.cfg.key {
+				return p.Attributes[i].Value.Emit()
+			}
+		}
+	}
+	return p.Name
+}
+
+// take reports whether the budget of endpoint allows another span to be
+// sampled, and if so, counts it against the budget.
+func (s *endpointBudget) take(endpoint string) bool {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	u, ok := s.endpoints[endpoint]
+	if !ok {
+		if len(s.endpoints) >= s.cfg.maxEndpoints {
+			return false
+		}
+		u = &endpointUsage{last: now}
+		s.endpoints[endpoint] = u
+	}
+
+	if elapsed := now.Sub(u.last); elapsed > 0 {
+		u.count *= math.Exp(-float64(elapsed) / float64(s.window))
+		u.last = now
+	}
+	if u.count+1 > s.budget {
+		return false
+	}
+	u.count++
+	return true
+}
+
+func (s *endpointBudget) Description() string {
+	return fmt.Sprintf(
+		"EndpointBudget{budget:%g,window:%s,overflow:%s}",
+		s.budget, s.window, s.overflow.Description(),
+	)
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// newTestEndpointBudget returns an EndpointBudget sampler using the returned
+// clock, which is advanced by calling the returned function.
+func newTestEndpointBudget(
+	budget float64,
+	window time.Duration,
+	opts ...EndpointBudgetOption,
+) (*endpointBudget, func(time.Duration)) {
+	s := EndpointBudget(budget, window, nil, opts...).(*endpointBudget)
+	now := time.Unix(0, 0)
+	s.now = func() time.Time { return now }
+	return s, func(d time.Duration) { now = now.Add(d) }
+}
+
+func sampleN(s Sampler, p SamplingParameters, n int) (sampled int) {
+	for range n {
+		if s.ShouldSample(p).Decision == RecordAndSample {
+			sampled++
+		}
+	}
+	return sampled
+}
+
+func TestEndpointBudget(t *testing.T) {
+	s, advance := newTestEndpointBudget(10, time.Second)
+
+	hot := SamplingParameters{Name: "hot"}
+	rare := SamplingParameters{Name: "rare"}
+	assert.Equal(t, 10, sampleN(s, hot, 100), "burst not limited to budget")
+	assert.Equal(t, 1, sampleN(s, rare, 1), "rare endpoint throttled by hot endpoint")
+
+	// After one time constant, the usage decays to 10/e ≈ 3.7.
+	advance(time.Second)
+	assert.Equal(t, 6, sampleN(s, hot, 100), "budget not restored by decay")
+
+	advance(time.Hour)
+	assert.Equal(t, 10, sampleN(s, hot, 100), "budget not fully restored")
+}
+
+func TestEndpointBudgetOverflow(t *testing.T) {
+	s := EndpointBudget(1, time.Hour, AlwaysSample())
+	p := SamplingParameters{Name: "span"}
+	assert.Equal(t, 10, sampleN(s, p, 10), "overflow sampler not used")
+}
+
+func TestEndpointBudgetDisabled(t *testing.T) {
+	p := SamplingParameters{Name: "span"}
+	assert.Equal(t, 0, sampleN(EndpointBudget(0, time.Second, nil), p, 10))
+	assert.Equal(t, 0, sampleN(EndpointBudget(10, 0, nil), p, 10))
+}
+
+func TestEndpointBudgetWithEndpointKey(t *testing.T) {
+	key := attribute.Key("http.route")
+	s, _ := newTestEndpointBudget(1, time.Second, WithEndpointKey(key))
+
+	users := SamplingParameters{
+		Name:       "GET",
+		Attributes: []attribute.KeyValue{key.String("/users")},
+	}
+	items := SamplingParameters{
+		Name:       "GET",
+		Attributes: []attribute.KeyValue{key.String("/items")},
+	}
+	noKey := SamplingParameters{Name: "GET"}
+	assert.Equal(t, 1, sampleN(s, users, 10))
+	assert.Equal(t, 1, sampleN(s, items, 10))
+	assert.Equal(t, 1, sampleN(s, noKey, 10))
+}
+
+func TestEndpointBudgetWithMaxEndpoints(t *testing.T) {
+	s, _ := newTestEndpointBudget(1, time.Second, WithMaxEndpoints(1))
+
+	assert.Equal(t, 1, sampleN(s, SamplingParameters{Name: "first"}, 1))
+	assert.Equal(t, 0, sampleN(s, SamplingParameters{Name: "second"}, 1))
+}
+
+func TestEndpointBudgetDescription(t *testing.T) {
+	s := EndpointBudget(10, time.Second, TraceIDRatioBased(0.5))
+	assert.Equal(
+		t,
+		"EndpointBudget{budget:10,window:1s,overflow:TraceIDRatioBased{0.5}}",
+		s.Description(),
+	)
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// continuationPropagator encodes the state of a Continuation.
+var continuationPropagator = propagation.NewCompositeTextMapPropagator(
+	propagation.TraceContext{},
+	propagation.Baggage{},
+)
+
+// errInvalidContinuation is returned when a continuation token cannot be
+// decoded.
+var errInvalidContinuation = errors.New("invalid continuation token")
+
+// Continuation is the state of a logical operation that is suspended, e.g. by
+// a workflow engine, so it can be resumed later, possibly by another process.
+//
+// A Continuation holds the SpanContext of the span the operation was
+// suspended in, including its sampling decision and trace state, and the
+// Baggage of the operation. It is serialized as a token with MarshalText,
+// stored with the suspended work, and deserialized with UnmarshalText when
+// the work is resumed.
+//
+// The operation is resumed either as a child of the suspended span, in the
+// same trace, using the context returned by Context as the parent of the
+// resumed span, or in a new trace linked to the suspended span, using the
+// options returned by LinkOptions. In the former case, the sampling decision
+// of the suspended span is kept by the parent-based samplers and the trace
+// ID-based samplers, as it is for spans of remote parents.
+type Continuation struct {
+	// SpanContext is the SpanContext of the suspended span.
+	SpanContext trace.SpanContext
+	// Baggage is the Baggage of the suspended operation.
+	Baggage baggage.Baggage
+}
+
+// ContinuationFromContext returns the Continuation of the span and Baggage
+// in ctx.
+func ContinuationFromContext(ctx context.Context) Continuation {
+	return Continuation{
+		SpanContext: trace.SpanContextFromContext(ctx),
+		Baggage:     baggage.FromContext(ctx),
+	}
+}
+
+// Context returns a copy of ctx containing the Baggage of c and the
+// SpanContext of c as a remote span context. Spans started with the returned
+// context are children of the suspended span.
+func (c Continuation) Context(ctx context.Context) context.Context {
+	if c.Baggage.Len() > 0 {
+		ctx = baggage.ContextWithBaggage(ctx, c.Baggage)
+	}
+	if c.SpanContext.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, c.SpanContext)
+	}
+	return ctx
+}
+
+// LinkOptions returns the options starting a span as the root of a new trace
+// linked to the suspended span. They are meant to be used with a context
+// returned by Context so the Baggage of c is kept.
+//
+// The sampling decision of the new trace is made by the Sampler without
+// regard for the decision of the suspended span.
+func (c Continuation) LinkOptions(attrs ...attribute.KeyValue) []trace.SpanStartOption {
+	opts := []trace.SpanStartOption{trace.WithNewRoot()}
+	if c.SpanContext.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: c.SpanContext, Attributes: attrs}))
+	}
+	return opts
+}
+
+// MarshalText encodes c as a continuation token. The token is a URL query
+// string of the W3C Trace Context and Baggage headers of c.
+func (c Continuation) MarshalText() ([]byte, error) {
+	carrier := propagation.MapCarrier{}
+	continuationPropagator.Inject(c.Context(context.Background()), carrier)
+	values := url.Values{}
+	for k, v := range carrier {
+		values.Set(k, v)
+	}
+	return []byte(values.Encode()), nil
+}
+
+// UnmarshalText decodes the continuation token text, as encoded by
+// MarshalText, into c. The decoded SpanContext is remote.
+//
+// An error is returned if text is not a valid token.
+func (c *Continuation) UnmarshalText(text []byte) error {
+	values, err := url.ParseQuery(string(text))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errInvalidContinuation, err)
+	}
+	carrier := propagation.MapCarrier{}
+	for k := range values {
+		carrier.Set(k, values.Get(k))
+	}
+	ctx := continuationPropagator.Extract(context.Background(), carrier)
+
+	cont := ContinuationFromContext(ctx)
+	if carrier.Get("traceparent") != "" && !cont.SpanContext.IsValid() {
+		return fmt.Errorf("%w: invalid traceparent %q", errInvalidContinuation, carrier.Get("traceparent"))
+	}
+	*c = cont
+	return nil
+}
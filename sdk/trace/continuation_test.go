@@ -0,0 +1,144 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestContinuationRoundTrip(t *testing.T) {
+	member, err := baggage.NewMember("tenant", "acme")
+	require.NoError(t, err)
+	bag, err := baggage.New(member)
+	require.NoError(t, err)
+	ts, err := trace.ParseTraceState("vendor=value")
+	require.NoError(t, err)
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+		TraceState: ts,
+	})
+
+	ctx := baggage.ContextWithBaggage(trace.ContextWithSpanContext(t.Context(), sc), bag)
+	token, err := ContinuationFromContext(ctx).MarshalText()
+	require.NoError(t, err)
+
+	var got Continuation
+	require.NoError(t, got.UnmarshalText(token))
+	assert.Equal(t, sc.WithRemote(true), got.SpanContext)
+	assert.Equal(t, "acme", got.Baggage.Member("tenant").Value())
+
+	// Empty continuations round-trip.
+	token, err = Continuation{}.MarshalText()
+	require.NoError(t, err)
+	assert.Empty(t, token)
+	require.NoError(t, got.UnmarshalText(token))
+	assert.Equal(t, Continuation{}, got)
+}
+
+func TestContinuationUnmarshalTextInvalid(t *testing.T) {
+	for _, token := range []string{"%zz", "traceparent=00-invalid"} {
+		var c Continuation
+		assert.ErrorIs(t, c.UnmarshalText([]byte(token)), errInvalidContinuation, token)
+	}
+}
+
+// suspend starts and ends a span with tp and returns the continuation token of
+// the operation.
+func suspend(t *testing.T, tp *TracerProvider) []byte {
+	t.Helper()
+	ctx, span := tp.Tracer("workflow").Start(t.Context(), "suspend")
+	span.End()
+	token, err := ContinuationFromContext(ctx).MarshalText()
+	require.NoError(t, err)
+	return token
+}
+
+// resume decodes token and starts a span with tp continuing the operation.
+func resume(t *testing.T, tp *TracerProvider, token []byte, opts ...trace.SpanStartOption) (Continuation, trace.Span) {
+	t.Helper()
+	var c Continuation
+	require.NoError(t, c.UnmarshalText(token))
+	ctx := c.Context(context.Background())
+	_, span := tp.Tracer("workflow").Start(ctx, "resume", opts...)
+	span.End()
+	return c, span
+}
+
+func TestContinuationParent(t *testing.T) {
+	before := NewTracerProvider()
+	token := suspend(t, before)
+
+	// The process restarts with a new provider.
+	exp := NewTestExporter()
+	after := NewTracerProvider(WithSyncer(exp))
+	c, _ := resume(t, after, token)
+
+	s, ok := exp.GetSpan("resume")
+	require.True(t, ok)
+	assert.Equal(t, c.SpanContext.TraceID(), s.SpanContext().TraceID())
+	assert.Equal(t, c.SpanContext, s.Parent())
+	assert.True(t, s.Parent().IsRemote())
+}
+
+func TestContinuationLink(t *testing.T) {
+	before := NewTracerProvider()
+	token := suspend(t, before)
+
+	exp := NewTestExporter()
+	after := NewTracerProvider(WithSyncer(exp))
+	var c Continuation
+	require.NoError(t, c.UnmarshalText(token))
+	_, span := after.Tracer("workflow").Start(
+		c.Context(context.Background()),
+		"resume",
+		c.LinkOptions(attribute.String("link.kind", "continuation"))...,
+	)
+	span.End()
+
+	s, ok := exp.GetSpan("resume")
+	require.True(t, ok)
+	assert.False(t, s.Parent().IsValid())
+	assert.NotEqual(t, c.SpanContext.TraceID(), s.SpanContext().TraceID())
+	require.Len(t, s.Links(), 1)
+	assert.Equal(t, c.SpanContext, s.Links()[0].SpanContext)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("link.kind", "continuation")}, s.Links()[0].Attributes)
+}
+
+func TestContinuationSamplerConsistency(t *testing.T) {
+	samplers := map[string]func() Sampler{
+		"ParentBased":       func() Sampler { return ParentBased(TraceIDRatioBased(0.5)) },
+		"TraceIDRatioBased": func() Sampler { return TraceIDRatioBased(0.5) },
+	}
+	for name, sampler := range samplers {
+		t.Run(name, func(t *testing.T) {
+			before := NewTracerProvider(WithSampler(sampler()))
+			after := NewTracerProvider(WithSampler(sampler()))
+
+			var sampled int
+			const n = 200
+			for range n {
+				token := suspend(t, before)
+				c, span := resume(t, after, token)
+				want := c.SpanContext.IsSampled()
+				assert.Equal(t, want, span.SpanContext().IsSampled())
+				if want {
+					sampled++
+				}
+			}
+			// Ensure both decisions are exercised.
+			assert.Positive(t, sampled)
+			assert.Less(t, sampled, n)
+		})
+	}
+}
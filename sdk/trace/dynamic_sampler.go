@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// DynamicSampler is a Sampler delegating its decisions to a Sampler that can
+// be replaced at runtime.
+//
+// Use it as the Sampler of a TracerProvider to change the sampling strategy
+// of the TracerProvider while it is in use, e.g. from a remote configuration
+// service, without creating a new TracerProvider.
+//
+// Use [NewDynamicSampler] to create a DynamicSampler.
+type DynamicSampler struct {
+	delegate atomic.Pointer[samplerHolder]
+}
+
+// samplerHolder holds a Sampler so it can be stored atomically regardless of
+// its concrete type.
+type samplerHolder struct {
+	sampler Sampler
+}
+
+var _ Sampler = (*DynamicSampler)(nil)
+
+// NewDynamicSampler returns a new DynamicSampler delegating its decisions to
+// sampler. If sampler is nil, the decisions are delegated to
+// ParentBased(AlwaysSample()), the default Sampler of a TracerProvider.
+func NewDynamicSampler(sampler Sampler) *DynamicSampler {
+	s := &DynamicSampler{}
+	s.Set(sampler)
+	return s
+}
+
+// Set replaces the Sampler s delegates its decisions to with sampler. The
+// spans started after Set returns are sampled by sampler. If sampler is nil,
+// the decisions are delegated to ParentBased(AlwaysSample()).
+//
+// This method is safe to call concurrently with sampling decisions.
+func (s *DynamicSampler) Set(sampler Sampler) {
+	if sampler == nil {
+		sampler = ParentBased(AlwaysSample())
+	}
+	s.delegate.Store(&samplerHolder{sampler: sampler})
+}
+
+// Sampler returns the Sampler s currently delegates its decisions to.
+func (s *DynamicSampler) Sampler() Sampler {
+	if h := s.delegate.Load(); h != nil {
+		return h.sampler
+	}
+	return ParentBased(AlwaysSample())
+}
+
+// ShouldSample returns the sampling decision of the current Sampler.
+func (s *DynamicSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	return s.Sampler().ShouldSample(p)
+}
+
+// Description returns the description of the current Sampler wrapped in
+// DynamicSampler{}.
+func (s *DynamicSampler) Description() string {
+	return fmt.Sprintf("DynamicSampler{%s}", s.Sampler().Description())
+}
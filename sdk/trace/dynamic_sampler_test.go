@@ -0,0 +1,71 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamicSampler(t *testing.T) {
+	s := NewDynamicSampler(NeverSample())
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSampler(s), WithSyncer(te))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	_, span := tracer.Start(t.Context(), "dropped")
+	span.End()
+	assert.Equal(t, "DynamicSampler{AlwaysOffSampler}", s.Description())
+
+	s.Set(AlwaysSample())
+	_, span = tracer.Start(t.Context(), "sampled")
+	span.End()
+	assert.Equal(t, "DynamicSampler{AlwaysOnSampler}", s.Description())
+
+	require.Equal(t, 1, te.Len())
+	_, ok := te.GetSpan("sampled")
+	assert.True(t, ok)
+}
+
+func TestDynamicSamplerNil(t *testing.T) {
+	s := NewDynamicSampler(nil)
+	assert.Equal(t, ParentBased(AlwaysSample()), s.Sampler())
+
+	s.Set(NeverSample())
+	s.Set(nil)
+	assert.Equal(t, ParentBased(AlwaysSample()), s.Sampler())
+
+	var zero DynamicSampler
+	assert.Equal(t, ParentBased(AlwaysSample()), zero.Sampler())
+}
+
+func TestDynamicSamplerConcurrentSafe(t *testing.T) {
+	s := NewDynamicSampler(AlwaysSample())
+	p := SamplingParameters{ParentContext: t.Context()}
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				s.Set(NeverSample())
+				s.Set(AlwaysSample())
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for range 100 {
+				_ = s.ShouldSample(p)
+				_ = s.Description()
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecordErrorSummary records err as the error of the operation of the span
+// in ctx, whether or not the span was sampled, and returns the SpanContext of
+// the span err is recorded on.
+//
+// If the span is recording, err is recorded on it as an exception event and
+// its status is set to Error. A span that was not sampled cannot be recorded
+// afterwards. Instead, if it was started by a Tracer of this SDK, a new span
+// named name is started and ended by the same Tracer, in a new trace, with
+// a link to the span and err recorded as on a recording span. This error
+// summary span is always sampled, bypassing the Sampler of the
+// TracerProvider, so the errors of the operations that were dropped by
+// sampling are still exported. It is processed and exported like any other
+// span.
+//
+// Nothing is recorded and an invalid SpanContext is returned if err is nil or
+// the span was not started by this SDK.
+//
+// This is meant for head sampling that drops most spans: call it when an
+// operation fails instead of recording the error on its span directly.
+func RecordErrorSummary(ctx context.Context, name string, err error, opts ...trace.EventOption) trace.SpanContext {
+	if err == nil {
+		return trace.SpanContext{}
+	}
+
+	span := trace.SpanFromContext(ctx)
+	if span.IsRecording() {
+		span.RecordError(err, opts...)
+		span.SetStatus(codes.Error, err.Error())
+		return span.SpanContext()
+	}
+
+	nrs, ok := span.(nonRecordingSpan)
+	if !ok || nrs.tracer == nil {
+		return trace.SpanContext{}
+	}
+	s := nrs.tracer.startErrorSummary(ctx, name, nrs.sc)
+	s.RecordError(err, opts...)
+	s.SetStatus(codes.Error, err.Error())
+	s.End()
+	return s.SpanContext()
+}
+
+// startErrorSummary starts a sampled span named name in a new trace, linked
+// to sc, without consulting the Sampler.
+func (tr *tracer) startErrorSummary(ctx context.Context, name string, sc trace.SpanContext) *recordingSpan {
+	// Detach ctx from the dropped span so processors do not see it as the
+	// parent.
+	ctx = trace.ContextWithSpanContext(ctx, trace.SpanContext{})
+
+	tid, sid := tr.idGenerator(trace.SpanKindInternal).NewIDs(ctx)
+	ssc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    tid,
+		SpanID:     sid,
+		TraceFlags: trace.FlagsSampled,
+	})
+	var links []trace.Link
+	if sc.IsValid() {
+		links = append(links, trace.Link{SpanContext: sc})
+	}
+	config := trace.NewSpanStartConfig(trace.WithLinks(links...))
+	s := tr.newRecordingSpan(ctx, trace.SpanContext{}, ssc, name, SamplingResult{Decision: RecordAndSample}, &config, &startExtensions{})
+
+	if tr.inst.Enabled() {
+		newCtx := trace.ContextWithSpan(ctx, s)
+		s.setOrigCtx(newCtx)
+		tr.inst.SpanStarted(newCtx, trace.SpanContext{}, s)
+	}
+	if tr.counts != nil {
+		tr.counts.bypassed.Add(1)
+	}
+	for _, sp := range tr.provider.getSpanProcessors() {
+		sp.sp.OnStart(ctx, s)
+	}
+	for _, sp := range tr.getScopeProcessors() {
+		sp.OnStart(ctx, s)
+	}
+	for _, l := range tr.provider.getSpanListeners() {
+		l.OnSpanStarted(s)
+	}
+	return s
+}
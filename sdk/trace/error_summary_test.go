@@ -0,0 +1,72 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRecordErrorSummary(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSampler(NeverSample()), WithSyncer(te))
+	tracer := tp.Tracer(t.Name())
+	err := errors.New("failed")
+
+	ctx, span := tracer.Start(t.Context(), "operation")
+	require.False(t, span.IsRecording())
+	sc := RecordErrorSummary(ctx, "operation error", err)
+	span.End()
+
+	require.Equal(t, 1, te.Len())
+	got, ok := te.GetSpan("operation error")
+	require.True(t, ok)
+	assert.Equal(t, sc, got.SpanContext())
+	assert.True(t, sc.IsSampled())
+	assert.NotEqual(t, span.SpanContext().TraceID(), sc.TraceID(), "new trace")
+	assert.False(t, got.Parent().IsValid())
+	assert.Equal(t, t.Name(), got.InstrumentationScope().Name)
+	assert.Equal(t, Status{Code: codes.Error, Description: "failed"}, got.Status())
+	require.Len(t, got.Links(), 1)
+	assert.Equal(t, span.SpanContext(), got.Links()[0].SpanContext)
+	require.Len(t, got.Events(), 1)
+	assert.Equal(t, semconv.ExceptionEventName, got.Events()[0].Name)
+}
+
+func TestRecordErrorSummaryRecording(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+
+	ctx, span := tp.Tracer(t.Name()).Start(t.Context(), "operation")
+	sc := RecordErrorSummary(ctx, "operation error", errors.New("failed"))
+	span.End()
+
+	assert.Equal(t, span.SpanContext(), sc)
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+	assert.Equal(t, "operation", got.Name())
+	assert.Equal(t, codes.Error, got.Status().Code)
+	assert.Len(t, got.Events(), 1)
+}
+
+func TestRecordErrorSummaryNoop(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSampler(NeverSample()), WithSyncer(te))
+	ctx, span := tp.Tracer(t.Name()).Start(t.Context(), "operation")
+	defer span.End()
+
+	assert.False(t, RecordErrorSummary(ctx, "operation error", nil).IsValid(), "nil error")
+	assert.False(t, RecordErrorSummary(t.Context(), "operation error", errors.New("failed")).IsValid(), "no span")
+
+	remote := trace.ContextWithRemoteSpanContext(t.Context(), span.SpanContext())
+	assert.False(t, RecordErrorSummary(remote, "operation error", errors.New("failed")).IsValid(), "remote span")
+	assert.Equal(t, 0, te.Len())
+}
@@ -0,0 +1,156 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk"
+)
+
+// eventCountScopeName is the instrumentation scope of the counters of an
+// EventCountSpanProcessor.
+const eventCountScopeName = "go.opentelemetry.io/otel/sdk/trace"
+
+var errEmptyEventName = errors.New("empty event name")
+
+// EventCounter configures a counter of the span events with a name.
+type EventCounter struct {
+	// Event is the name of the counted span events, e.g. "cache.miss".
+	Event string
+	// Name is the name of the counter. If empty, Event is used.
+	Name string
+	// Description is the description of the counter.
+	Description string
+	// AttributeKeys are the keys of the attributes recorded with the
+	// counter. The value of a key is looked up in the attributes of the event
+	// first, then in the attributes of the span. The other attributes are
+	// not recorded to bound the cardinality of the counter.
+	AttributeKeys []attribute.Key
+}
+
+// EventCountSpanProcessor is a SpanProcessor counting the span events with
+// configured names into counters. It provides metrics from existing event
+// instrumentation without changing the instrumented code.
+//
+// The events are counted when the spans end. Only the events of the spans
+// processed by the SDK are counted: the events of non-recording spans and
+// the events dropped because of the span limits are not.
+//
+// Use [NewEventCountSpanProcessor] to create an EventCountSpanProcessor.
+type EventCountSpanProcessor struct {
+	counters map[string][]eventCounter
+}
+
+var _ SpanProcessor = (*EventCountSpanProcessor)(nil)
+
+// eventCounter is the counter of an EventCounter.
+type eventCounter struct {
+	counter metric.Int64Counter
+	keys    []attribute.Key
+}
+
+// NewEventCountSpanProcessor returns a new EventCountSpanProcessor counting
+// the span events configured by counters with counters created with mp. If
+// mp is nil, the global MeterProvider is used.
+//
+// An error is returned for each counter with an empty Event or that could
+// not be created. The returned processor counts the events of the other
+// counters.
+func NewEventCountSpanProcessor(mp metric.MeterProvider, counters ...EventCounter) (*EventCountSpanProcessor, error) {
+	if mp == nil {
+		mp = otel.GetMeterProvider()
+	}
+	meter := mp.Meter(eventCountScopeName, metric.WithInstrumentationVersion(sdk.Version()))
+
+	p := &EventCountSpanProcessor{counters: make(map[string][]eventCounter, len(counters))}
+	var errs []error
+	for _, c := range counters {
+		if c.Event == "" {
+			errs = append(errs, errEmptyEventName)
+			continue
+		}
+		name := c.Name
+		if name == "" {
+			name = c.Event
+		}
+		counter, err := meter.Int64Counter(
+			name,
+			metric.WithDescription(c.Description),
+			metric.WithUnit("{event}"),
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("event %q: %w", c.Event, err))
+			continue
+		}
+		p.counters[c.Event] = append(p.counters[c.Event], eventCounter{
+			counter: counter,
+			keys:    c.AttributeKeys,
+		})
+	}
+	return p, errors.Join(errs...)
+}
+
+// OnStart does nothing.
+func (*EventCountSpanProcessor) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd counts the configured events of s.
+func (p *EventCountSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if len(p.counters) == 0 {
+		return
+	}
+	for _, e := range s.Events() {
+		for _, c := range p.counters[e.Name] {
+			c.counter.Add(context.Background(), 1, metric.WithAttributeSet(c.attributes(e, s)))
+		}
+	}
+}
+
+// attributes returns the attributes with the keys of c of the event e of
+// the span s.
+func (c eventCounter) attributes(e Event, s ReadOnlySpan) attribute.Set {
+	if len(c.keys) == 0 {
+		return *attribute.EmptySet()
+	}
+	attrs := make([]attribute.KeyValue, 0, len(c.keys))
+	var spanAttrs []attribute.KeyValue
+	for _, k := range c.keys {
+		if kv, ok := findAttribute(e.Attributes, k); ok {
+			attrs = append(attrs, kv)
+			continue
+		}
+		if spanAttrs == nil {
+			spanAttrs = s.Attributes()
+		}
+		if kv, ok := findAttribute(spanAttrs, k); ok {
+			attrs = append(attrs, kv)
+		}
+	}
+	return attribute.NewSet(attrs...)
+}
+
+// findAttribute returns the last attribute of attrs with key k.
+func findAttribute(attrs []attribute.KeyValue, k attribute.Key) (attribute.KeyValue, bool) {
+	for i := len(attrs) - 1; i >= 0; i-- {
+		if attrs[i].Key == k {
+			return attrs[i], true
+		}
+	}
+	return attribute.KeyValue{}, false
+}
+
+// Shutdown does nothing. The counters are owned by the MeterProvider.
+func (*EventCountSpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing. The counters are exported by the MeterProvider.
+func (*EventCountSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata/metricdatatest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestEventCountSpanProcessor(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	p, err := NewEventCountSpanProcessor(mp,
+		EventCounter{Event: "cache.miss"},
+		EventCounter{
+			Event:         "exception",
+			Name:          "app.exceptions",
+			Description:   "Exceptions recorded on spans",
+			AttributeKeys: []attribute.Key{"exception.type", "http.route", "missing"},
+		},
+	)
+	require.NoError(t, err)
+
+	tp := NewTracerProvider(WithSpanProcessor(p), WithSampler(AlwaysSample()))
+	tracer := tp.Tracer("test")
+
+	_, span := tracer.Start(t.Context(), "GET /users", trace.WithAttributes(attribute.String("http.route", "/users")))
+	span.AddEvent("cache.miss")
+	span.AddEvent("cache.miss", trace.WithAttributes(attribute.String("key", "user:1")))
+	span.AddEvent("cache.hit")
+	span.AddEvent("exception", trace.WithAttributes(
+		attribute.String("exception.type", "io.EOF"),
+		attribute.String("exception.message", "EOF"),
+	))
+	span.End()
+
+	_, span = tracer.Start(t.Context(), "other")
+	span.AddEvent("exception", trace.WithAttributes(attribute.String("exception.type", "io.EOF")))
+	span.End()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	assert.Equal(t, eventCountScopeName, rm.ScopeMetrics[0].Scope.Name)
+
+	want := []metricdata.Metrics{
+		{
+			Name: "cache.miss",
+			Unit: "{event}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints:  []metricdata.DataPoint[int64]{{Value: 2}},
+			},
+		},
+		{
+			Name:        "app.exceptions",
+			Description: "Exceptions recorded on spans",
+			Unit:        "{event}",
+			Data: metricdata.Sum[int64]{
+				Temporality: metricdata.CumulativeTemporality,
+				IsMonotonic: true,
+				DataPoints: []metricdata.DataPoint[int64]{
+					{
+						Attributes: attribute.NewSet(
+							attribute.String("exception.type", "io.EOF"),
+							attribute.String("http.route", "/users"),
+						),
+						Value: 1,
+					},
+					{
+						Attributes: attribute.NewSet(attribute.String("exception.type", "io.EOF")),
+						Value:      1,
+					},
+				},
+			},
+		},
+	}
+	metricdatatest.AssertEqual(t, metricdata.ScopeMetrics{
+		Scope:   rm.ScopeMetrics[0].Scope,
+		Metrics: want,
+	}, rm.ScopeMetrics[0], metricdatatest.IgnoreTimestamp())
+}
+
+func TestEventCountSpanProcessorNotSampled(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	p, err := NewEventCountSpanProcessor(mp, EventCounter{Event: "cache.miss"})
+	require.NoError(t, err)
+
+	tp := NewTracerProvider(WithSpanProcessor(p), WithSampler(NeverSample()))
+	_, span := tp.Tracer("test").Start(t.Context(), "span")
+	span.AddEvent("cache.miss")
+	span.End()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	assert.Empty(t, rm.ScopeMetrics)
+}
+
+func TestNewEventCountSpanProcessorError(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	p, err := NewEventCountSpanProcessor(mp, EventCounter{}, EventCounter{Event: "retry"})
+	assert.ErrorIs(t, err, errEmptyEventName)
+	require.NotNil(t, p)
+
+	// The valid counters are used.
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	_, span := tp.Tracer("test").Start(t.Context(), "span")
+	span.AddEvent("retry")
+	span.End()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+	assert.Equal(t, "retry", rm.ScopeMetrics[0].Metrics[0].Name)
+}
@@ -15,6 +15,7 @@ type evictedQueue[T any] struct {
 	queue          []T
 	capacity       int
 	droppedCount   int
+	dropCounter    *global.DropCounter
 	logDroppedMsg  string
 	logDroppedOnce sync.Once
 }
@@ -23,6 +24,7 @@ func newEvictedQueueEvent(capacity int) evictedQueue[Event] {
 	// Do not pre-allocate queue, do this lazily.
 	return evictedQueue[Event]{
 		capacity:      capacity,
+		dropCounter:   droppedEvents,
 		logDroppedMsg: "limit reached: dropping trace trace.Event",
 	}
 }
@@ -31,16 +33,30 @@ func newEvictedQueueLink(capacity int) evictedQueue[Link] {
 	// Do not pre-allocate queue, do this lazily.
 	return evictedQueue[Link]{
 		capacity:      capacity,
+		dropCounter:   droppedLinks,
 		logDroppedMsg: "limit reached: dropping trace trace.Link",
 	}
 }
 
+// reserve allocates storage for n values in eq, bounded by the capacity of eq.
+// It is a no-op if n is not positive or storage was already allocated.
+func (eq *evictedQueue[T]) reserve(n int) {
+	if n <= 0 || eq.queue != nil {
+		return
+	}
+	if eq.capacity >= 0 {
+		n = min(n, eq.capacity)
+	}
+	if n > 0 {
+		eq.queue = make([]T, 0, n)
+	}
+}
+
 // add adds value to the evictedQueue eq. If eq is at capacity, the oldest
 // queued value will be discarded and the drop count incremented.
 func (eq *evictedQueue[T]) add(value T) {
 	if eq.capacity == 0 {
-		eq.droppedCount++
-		eq.logDropped()
+		eq.drop()
 		return
 	}
 
@@ -48,12 +64,20 @@ func (eq *evictedQueue[T]) add(value T) {
 		// Drop first-in while avoiding allocating more capacity to eq.queue.
 		copy(eq.queue[:eq.capacity-1], eq.queue[1:])
 		eq.queue = eq.queue[:eq.capacity-1]
-		eq.droppedCount++
-		eq.logDropped()
+		eq.drop()
 	}
 	eq.queue = append(eq.queue, value)
 }
 
+// drop records that a value of eq was dropped.
+func (eq *evictedQueue[T]) drop() {
+	eq.droppedCount++
+	if eq.dropCounter != nil {
+		eq.dropCounter.Add(1)
+	}
+	eq.logDropped()
+}
+
 func (eq *evictedQueue[T]) logDropped() {
 	eq.logDroppedOnce.Do(func() { global.Warn(eq.logDroppedMsg) })
 }
@@ -38,6 +38,27 @@ func TestCopy(t *testing.T) {
 	assert.Equal(t, Event{Name: "value1"}, q.queue[0], "copy update modified queue")
 }
 
+func TestReserve(t *testing.T) {
+	q := newEvictedQueueEvent(3)
+	q.reserve(0)
+	assert.Nil(t, q.queue, "non-positive hint allocated")
+
+	q.reserve(10)
+	assert.Equal(t, 3, cap(q.queue), "hint not bounded by capacity")
+
+	q = newEvictedQueueEvent(-1)
+	q.reserve(10)
+	assert.Equal(t, 10, cap(q.queue), "unlimited queue")
+	q.add(Event{Name: "value1"})
+	q.reserve(20)
+	assert.Equal(t, 10, cap(q.queue), "allocated queue grown")
+	assert.Len(t, q.queue, 1)
+
+	q = newEvictedQueueEvent(0)
+	q.reserve(10)
+	assert.Nil(t, q.queue, "zero capacity queue allocated")
+}
+
 func TestDropCount(t *testing.T) {
 	q := newEvictedQueueEvent(3)
 
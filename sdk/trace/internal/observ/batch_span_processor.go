@@ -8,11 +8,9 @@ import (
 	"errors"
 	"fmt"
 
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk"
-	"go.opentelemetry.io/otel/sdk/internal/x"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
@@ -47,12 +45,18 @@ type BSP struct {
 	processedQueueFullOpts []metric.AddOption
 }
 
-func NewBSP(id int64, qLen func() int64, qMax int64) (*BSP, error) {
-	if !x.Observability.Enabled() {
+// NewBSP returns instrumentation for an OTel SDK BatchSpanProcessor with the
+// provided ID that records its metrics with mp.
+//
+// If mp is nil, the global MeterProvider is used when the experimental
+// observability is enabled, otherwise nil is returned.
+func NewBSP(mp metric.MeterProvider, id int64, qLen func() int64, qMax int64) (*BSP, error) {
+	mp = meterProvider(mp)
+	if mp == nil {
 		return nil, nil
 	}
 
-	meter := otel.GetMeterProvider().Meter(
+	meter := mp.Meter(
 		ScopeName,
 		metric.WithInstrumentationVersion(sdk.Version()),
 		metric.WithSchemaURL(SchemaURL),
@@ -29,11 +29,18 @@ func TestBSPComponentName(t *testing.T) {
 
 func TestNewBSPDisabled(t *testing.T) {
 	// Do not set OTEL_GO_X_OBSERVABILITY
-	bsp, err := observ.NewBSP(id, nil, 0)
+	bsp, err := observ.NewBSP(nil, id, nil, 0)
 	assert.NoError(t, err)
 	assert.Nil(t, bsp)
 }
 
+func TestNewBSPMeterProvider(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, passing a MeterProvider enables it.
+	bsp, err := observ.NewBSP(noop.NewMeterProvider(), id, nil, 0)
+	assert.NoError(t, err)
+	assert.NotNil(t, bsp)
+}
+
 func TestNewBSPErrors(t *testing.T) {
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
@@ -43,7 +50,7 @@ func TestNewBSPErrors(t *testing.T) {
 	mp := &errMeterProvider{err: assert.AnError}
 	otel.SetMeterProvider(mp)
 
-	_, err := observ.NewBSP(id, nil, 0)
+	_, err := observ.NewBSP(nil, id, nil, 0)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 
 	assert.ErrorContains(t, err, "create BSP queue capacity metric")
@@ -93,7 +100,7 @@ func TestBSPCallback(t *testing.T) {
 	collect := setup(t)
 
 	var n int64 = 3
-	bsp, err := observ.NewBSP(id, func() int64 { return n }, 5)
+	bsp, err := observ.NewBSP(nil, id, func() int64 { return n }, 5)
 	require.NoError(t, err)
 	require.NotNil(t, bsp)
 
@@ -123,7 +130,7 @@ func processed(dPts ...metricdata.DataPoint[int64]) metricdata.Metrics {
 func TestBSPProcessed(t *testing.T) {
 	collect := setup(t)
 
-	bsp, err := observ.NewBSP(id, nil, 0)
+	bsp, err := observ.NewBSP(nil, id, nil, 0)
 	require.NoError(t, err)
 	require.NotNil(t, bsp)
 	require.NoError(t, bsp.Shutdown()) // Unregister callback.
@@ -153,7 +160,7 @@ func BenchmarkBSP(b *testing.B) {
 
 	newBSP := func(b *testing.B) *observ.BSP {
 		b.Helper()
-		bsp, err := observ.NewBSP(id, func() int64 { return 3 }, 5)
+		bsp, err := observ.NewBSP(nil, id, func() int64 { return 3 }, 5)
 		require.NoError(b, err)
 		require.NotNil(b, bsp)
 		b.Cleanup(func() {
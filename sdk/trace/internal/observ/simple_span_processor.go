@@ -8,11 +8,9 @@ import (
 	"fmt"
 	"sync"
 
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk"
-	"go.opentelemetry.io/otel/sdk/internal/x"
 	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 )
@@ -44,15 +42,17 @@ func SSPComponentName(id int64) attribute.KeyValue {
 }
 
 // NewSSP returns instrumentation for an OTel SDK SimpleSpanProcessor with the
-// provided ID.
+// provided ID that records its metrics with mp.
 //
-// If the experimental observability is disabled, nil is returned.
-func NewSSP(id int64) (*SSP, error) {
-	if !x.Observability.Enabled() {
+// If mp is nil, the global MeterProvider is used when the experimental
+// observability is enabled, otherwise nil is returned.
+func NewSSP(mp metric.MeterProvider, id int64) (*SSP, error) {
+	mp = meterProvider(mp)
+	if mp == nil {
 		return nil, nil
 	}
 
-	meter := otel.GetMeterProvider().Meter(
+	meter := mp.Meter(
 		ScopeName,
 		metric.WithInstrumentationVersion(sdk.Version()),
 		metric.WithSchemaURL(SchemaURL),
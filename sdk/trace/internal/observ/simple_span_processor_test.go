@@ -33,21 +33,28 @@ func TestNewSSPError(t *testing.T) {
 	mp := &errMeterProvider{err: assert.AnError}
 	otel.SetMeterProvider(mp)
 
-	_, err := observ.NewSSP(sspComponentID)
+	_, err := observ.NewSSP(nil, sspComponentID)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 	assert.ErrorContains(t, err, "create SSP processed spans metric")
 }
 
 func TestNewSSPDisabled(t *testing.T) {
-	ssp, err := observ.NewSSP(sspComponentID)
+	ssp, err := observ.NewSSP(nil, sspComponentID)
 	assert.NoError(t, err)
 	assert.Nil(t, ssp)
 }
 
+func TestNewSSPMeterProvider(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, passing a MeterProvider enables it.
+	ssp, err := observ.NewSSP(noop.NewMeterProvider(), sspComponentID)
+	assert.NoError(t, err)
+	assert.NotNil(t, ssp)
+}
+
 func TestSSPSpanProcessed(t *testing.T) {
 	ctx := t.Context()
 	collect := setup(t)
-	ssp, err := observ.NewSSP(sspComponentID)
+	ssp, err := observ.NewSSP(nil, sspComponentID)
 	assert.NoError(t, err)
 
 	ssp.SpanProcessed(ctx, nil)
@@ -69,7 +76,7 @@ func BenchmarkSSP(b *testing.B) {
 
 	newSSP := func(b *testing.B) *observ.SSP {
 		b.Helper()
-		ssp, err := observ.NewSSP(sspComponentID)
+		ssp, err := observ.NewSSP(nil, sspComponentID)
 		require.NoError(b, err)
 		require.NotNil(b, ssp)
 		return ssp
@@ -22,6 +22,19 @@ var meterOpts = []metric.MeterOption{
 	metric.WithSchemaURL(SchemaURL),
 }
 
+// meterProvider returns mp if it is not nil. Otherwise, the global
+// MeterProvider is returned if the experimental observability is enabled and
+// nil if it is not.
+func meterProvider(mp metric.MeterProvider) metric.MeterProvider {
+	if mp != nil {
+		return mp
+	}
+	if !x.Observability.Enabled() {
+		return nil
+	}
+	return otel.GetMeterProvider()
+}
+
 // Tracer is instrumentation for an OTel SDK Tracer.
 type Tracer struct {
 	enabled bool
@@ -30,11 +43,17 @@ type Tracer struct {
 	started metric.Int64Counter
 }
 
-func NewTracer() (Tracer, error) {
-	if !x.Observability.Enabled() {
+// NewTracer returns instrumentation for an OTel SDK Tracer that records its
+// metrics with mp.
+//
+// If mp is nil, the global MeterProvider is used when the experimental
+// observability is enabled, otherwise disabled instrumentation is returned.
+func NewTracer(mp metric.MeterProvider) (Tracer, error) {
+	mp = meterProvider(mp)
+	if mp == nil {
 		return Tracer{}, nil
 	}
-	meter := otel.GetMeterProvider().Meter(ScopeName, meterOpts...)
+	meter := mp.Meter(ScopeName, meterOpts...)
 
 	var err error
 	l, e := otelconv.NewSDKSpanLive(meter)
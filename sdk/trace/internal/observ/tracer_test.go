@@ -208,11 +208,18 @@ func TestTracerLocalParent(t *testing.T) {
 
 func TestNewTracerObservabilityDisabled(t *testing.T) {
 	// Do not set OTEL_GO_X_OBSERVABILITY
-	tracer, err := observ.NewTracer()
+	tracer, err := observ.NewTracer(nil)
 	assert.NoError(t, err)
 	assert.False(t, tracer.Enabled())
 }
 
+func TestNewTracerMeterProvider(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, passing a MeterProvider enables it.
+	tracer, err := observ.NewTracer(noop.NewMeterProvider())
+	assert.NoError(t, err)
+	assert.True(t, tracer.Enabled())
+}
+
 func TestNewTracerErrors(t *testing.T) {
 	t.Setenv("OTEL_GO_X_OBSERVABILITY", "true")
 
@@ -222,7 +229,7 @@ func TestNewTracerErrors(t *testing.T) {
 	mp := &errMeterProvider{err: assert.AnError}
 	otel.SetMeterProvider(mp)
 
-	_, err := observ.NewTracer()
+	_, err := observ.NewTracer(nil)
 	require.ErrorIs(t, err, assert.AnError, "new instrument errors")
 
 	assert.ErrorContains(t, err, "span live metric")
@@ -238,7 +245,7 @@ func BenchmarkTracer(b *testing.B) {
 	// Ensure deterministic benchmark by using noop meter.
 	otel.SetMeterProvider(noop.NewMeterProvider())
 
-	tracer, err := observ.NewTracer()
+	tracer, err := observ.NewTracer(nil)
 	require.NoError(b, err)
 	require.True(b, tracer.Enabled())
 
@@ -289,7 +296,7 @@ func BenchmarkNewTracer(b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for b.Loop() {
-		tracer, _ = observ.NewTracer()
+		tracer, _ = observ.NewTracer(nil)
 	}
 
 	_ = tracer
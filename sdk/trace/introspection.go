@@ -0,0 +1,155 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"cmp"
+	"slices"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// spanCounts counts the spans of a tracer. A span updates at most two
+// counters: the one of its sampling decision and ended.
+type spanCounts struct {
+	// dropped, recordOnly, and recordAndSample count the decisions of the
+	// Sampler.
+	dropped, recordOnly, recordAndSample atomic.Uint64
+	// bypassed counts the sampled spans started without calling the
+	// Sampler, e.g. the summary spans of WithErrorSummary.
+	bypassed atomic.Uint64
+	// ended counts the recording spans that ended.
+	ended atomic.Uint64
+}
+
+// TracerInfo describes a Tracer created by a TracerProvider and the spans it
+// started.
+//
+// Only the spans recorded by the TracerProvider are counted as started,
+// ended, and sampled. Spans dropped by the Sampler are only counted by
+// Sampling.
+//
+// The spans are only counted if the TracerProvider is created with
+// [WithIntrospection] or its observability is enabled, the counts are zero
+// otherwise.
+type TracerInfo struct {
+	// Scope identifies the instrumentation the Tracer was created for.
+	Scope instrumentation.Scope
+	// SpansStarted is the number of spans started by the Tracer.
+	SpansStarted uint64
+	// SpansEnded is the number of spans started by the Tracer that ended.
+	SpansEnded uint64
+	// SpansSampled is the number of spans started by the Tracer that are
+	// sampled.
+	SpansSampled uint64
+	// Sampling counts the decisions of the Sampler for the spans started by
+	// the Tracer.
+	Sampling SamplingStats
+}
+
+// SamplingStats counts the decisions of a Sampler.
+//
+// Spans not passed to the Sampler, e.g. the spans suppressed by
+// [WithMaxSpanDepth] or [WithMaxSpansPerTrace], are not counted.
+type SamplingStats struct {
+	// Drop is the number of Drop decisions.
+	Drop uint64
+	// RecordOnly is the number of RecordOnly decisions.
+	RecordOnly uint64
+	// RecordAndSample is the number of RecordAndSample decisions.
+	RecordAndSample uint64
+}
+
+// Total returns the number of decisions counted by s.
+func (s SamplingStats) Total() uint64 {
+	return s.Drop + s.RecordOnly + s.RecordAndSample
+}
+
+// SampledRatio returns the ratio of the RecordAndSample decisions to all the
+// decisions counted by s, or zero if no decision is counted.
+func (s SamplingStats) SampledRatio() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(s.RecordAndSample) / float64(total)
+}
+
+// Tracers returns the Tracers created by p and the number of spans they
+// started, ended, and sampled. The difference between the spans started and
+// ended is the number of spans in-flight, e.g. to find spans that are never
+// ended.
+//
+// The Tracers are returned sorted by scope name, version, schema URL, and
+// attributes. This is intended for debugging and self-monitoring, see
+// [WithIntrospection].
+//
+// This method is safe to call concurrently.
+func (p *TracerProvider) Tracers() []TracerInfo {
+	p.mu.Lock()
+	out := make([]TracerInfo, 0, len(p.namedTracer))
+	for _, t := range p.namedTracer {
+		info := TracerInfo{Scope: t.instrumentationScope}
+		if c := t.counts; c != nil {
+			// Load ended first so it is not greater than started.
+			info.SpansEnded = c.ended.Load()
+			info.Sampling = t.samplingStats()
+			bypassed := c.bypassed.Load()
+			info.SpansSampled = info.Sampling.RecordAndSample + bypassed
+			info.SpansStarted = info.SpansSampled + info.Sampling.RecordOnly
+		}
+		out = append(out, info)
+	}
+	p.mu.Unlock()
+
+	enc := attribute.DefaultEncoder()
+	slices.SortFunc(out, func(a, b TracerInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.Scope.Name, b.Scope.Name),
+			cmp.Compare(a.Scope.Version, b.Scope.Version),
+			cmp.Compare(a.Scope.SchemaURL, b.Scope.SchemaURL),
+			cmp.Compare(a.Scope.Attributes.Encoded(enc), b.Scope.Attributes.Encoded(enc)),
+		)
+	})
+	return out
+}
+
+// SamplingStats returns the decisions of the Sampler of p counted for the
+// spans started by all its Tracers, e.g. to verify a change of the sampling
+// configuration takes effect. Use [TracerProvider.Tracers] for the decisions
+// of each Tracer. The decisions are only counted if p is created with
+// [WithIntrospection] or its observability is enabled.
+//
+// When the experimental observability of the SDK is enabled, the decisions
+// are also published as the sampling result of the otel.sdk.span.started
+// metric.
+//
+// This method is safe to call concurrently.
+func (p *TracerProvider) SamplingStats() SamplingStats {
+	var out SamplingStats
+	p.mu.Lock()
+	for _, t := range p.namedTracer {
+		s := t.samplingStats()
+		out.Drop += s.Drop
+		out.RecordOnly += s.RecordOnly
+		out.RecordAndSample += s.RecordAndSample
+	}
+	p.mu.Unlock()
+	return out
+}
+
+// samplingStats returns the decisions of the Sampler counted for the spans of
+// t.
+func (t *tracer) samplingStats() SamplingStats {
+	if t.counts == nil {
+		return SamplingStats{}
+	}
+	return SamplingStats{
+		Drop:            t.counts.dropped.Load(),
+		RecordOnly:      t.counts.recordOnly.Load(),
+		RecordAndSample: t.counts.recordAndSample.Load(),
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracerProviderTracers(t *testing.T) {
+	tp := NewTracerProvider(WithSampler(TraceIDRatioBased(0)), WithIntrospection())
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	assert.Empty(t, tp.Tracers())
+
+	_, s0 := tp.Tracer("scope").Start(t.Context(), "dropped")
+	s0.End()
+	assert.Equal(t, []TracerInfo{{
+		Scope:    instrumentation.Scope{Name: "scope"},
+		Sampling: SamplingStats{Drop: 1},
+	}}, tp.Tracers())
+
+	tp = NewTracerProvider(WithSampler(recordOnlySampler{}), WithIntrospection())
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	_, s1 := tp.Tracer("scope1").Start(t.Context(), "recorded")
+	s1.End()
+
+	_, s2 := tp.Tracer("scope0", trace.WithInstrumentationVersion("v1")).Start(t.Context(), "in-flight")
+	defer s2.End()
+
+	want := []TracerInfo{
+		{
+			Scope:        instrumentation.Scope{Name: "scope0", Version: "v1"},
+			SpansStarted: 1,
+			Sampling:     SamplingStats{RecordOnly: 1},
+		},
+		{
+			Scope:        instrumentation.Scope{Name: "scope1"},
+			SpansStarted: 1,
+			SpansEnded:   1,
+			Sampling:     SamplingStats{RecordOnly: 1},
+		},
+	}
+	assert.Equal(t, want, tp.Tracers())
+}
+
+func TestTracerProviderTracersSampled(t *testing.T) {
+	tp := NewTracerProvider(WithIntrospection())
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	tracer := tp.Tracer("scope")
+	for range 3 {
+		_, s := tracer.Start(t.Context(), "span")
+		s.End()
+		s.End() // Ending a span twice is counted once.
+	}
+
+	got := tp.Tracers()
+	require.Len(t, got, 1)
+	assert.Equal(t, uint64(3), got[0].SpansStarted)
+	assert.Equal(t, uint64(3), got[0].SpansEnded)
+	assert.Equal(t, uint64(3), got[0].SpansSampled)
+}
+
+func TestTracerProviderSamplingStats(t *testing.T) {
+	sampler := NewDynamicSampler(AlwaysSample())
+	tp := NewTracerProvider(WithSampler(sampler), WithIntrospection())
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	assert.Equal(t, SamplingStats{}, tp.SamplingStats())
+
+	start := func(tracer string, n int) {
+		for range n {
+			_, s := tp.Tracer(tracer).Start(t.Context(), "span")
+			s.End()
+		}
+	}
+	start("a", 2)
+	sampler.Set(recordOnlySampler{})
+	start("a", 1)
+	start("b", 3)
+	sampler.Set(NeverSample())
+	start("b", 4)
+
+	want := SamplingStats{Drop: 4, RecordOnly: 4, RecordAndSample: 2}
+	assert.Equal(t, want, tp.SamplingStats())
+	assert.Equal(t, uint64(10), want.Total())
+	assert.InDelta(t, 0.2, want.SampledRatio(), 1e-9)
+	assert.Zero(t, SamplingStats{}.SampledRatio())
+
+	got := tp.Tracers()
+	require.Len(t, got, 2)
+	assert.Equal(t, SamplingStats{RecordOnly: 1, RecordAndSample: 2}, got[0].Sampling)
+	assert.Equal(t, SamplingStats{Drop: 4, RecordOnly: 3}, got[1].Sampling)
+	assert.Equal(t, uint64(3), got[1].SpansStarted, "dropped spans not started")
+}
+
+func TestTracerProviderTracersNotCounted(t *testing.T) {
+	tp := NewTracerProvider()
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, s := tp.Tracer("scope").Start(t.Context(), "span")
+	s.End()
+
+	assert.Equal(t, []TracerInfo{{
+		Scope: instrumentation.Scope{Name: "scope"},
+	}}, tp.Tracers())
+	assert.Equal(t, SamplingStats{}, tp.SamplingStats())
+}
+
+func TestTracerProviderTracersOrder(t *testing.T) {
+	tp := NewTracerProvider()
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	want := []instrumentation.Scope{
+		{Name: "scope"},
+		{Name: "scope", SchemaURL: "https://example.com/1"},
+		{
+			Name:       "scope",
+			SchemaURL:  "https://example.com/1",
+			Attributes: attribute.NewSet(attribute.String("a", "0")),
+		},
+		{
+			Name:       "scope",
+			SchemaURL:  "https://example.com/1",
+			Attributes: attribute.NewSet(attribute.String("a", "1")),
+		},
+		{Name: "scope", Version: "v1"},
+	}
+	for _, i := range []int{3, 0, 4, 2, 1} {
+		s := want[i]
+		tp.Tracer(
+			s.Name,
+			trace.WithInstrumentationVersion(s.Version),
+			trace.WithSchemaURL(s.SchemaURL),
+			trace.WithInstrumentationAttributeSet(s.Attributes),
+		)
+	}
+
+	for range 3 {
+		got := tp.Tracers()
+		require.Len(t, got, len(want))
+		for i, info := range got {
+			assert.Equal(t, want[i], info.Scope, "tracer %d", i)
+		}
+	}
+}
@@ -4,6 +4,8 @@
 package trace
 
 import (
+	"slices"
+
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -21,3 +23,42 @@ type Link struct {
 	// recorded due to configured limits being reached.
 	DroppedAttributeCount int
 }
+
+// removedLinksKey is the attribute key of the number of links of a span
+// removed because they linked to the span itself or duplicated another link.
+// It is not defined by the semantic conventions.
+const removedLinksKey = attribute.Key("span.removed_links")
+
+// redundantLink reports whether l links to s itself or is a duplicate of a
+// link already recorded by s.
+//
+// This method assumes s.mu.Lock is held by the caller.
+func (s *recordingSpan) redundantLink(l Link) bool {
+	sc := l.SpanContext
+	if sc.TraceID() == s.spanContext.TraceID() && sc.SpanID() == s.spanContext.SpanID() {
+		return true
+	}
+	for _, other := range s.links.queue {
+		if other.SpanContext.Equal(sc) &&
+			other.DroppedAttributeCount == l.DroppedAttributeCount &&
+			sameAttributes(other.Attributes, l.Attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameAttributes reports whether a and b hold the same attributes in any
+// order.
+func sameAttributes(a, b []attribute.KeyValue) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if len(a) == 0 {
+		return true
+	}
+	// Clone the attributes as NewSet sorts them in place.
+	sa := attribute.NewSet(slices.Clone(a)...)
+	sb := attribute.NewSet(slices.Clone(b)...)
+	return sa.Equals(&sb)
+}
@@ -0,0 +1,119 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// suppressedDescendantsKey is the attribute key of the number of
+	// descendants of a span not recorded because they exceeded the maximum
+	// depth or number of spans. It is not defined by the semantic
+	// conventions.
+	suppressedDescendantsKey = attribute.Key("span.suppressed_descendants")
+	// suppressedSpansKey is the attribute key of the number of spans of a
+	// local trace not recorded because they exceeded the maximum depth or
+	// number of spans. It is not defined by the semantic conventions.
+	suppressedSpansKey = attribute.Key("trace.suppressed_spans")
+)
+
+// localSpan is the position of a recording span among the spans of its
+// trace started in the process. It is only tracked if the depth or the
+// number of these spans is limited, see WithMaxSpanDepth and
+// WithMaxSpansPerTrace.
+type localSpan struct {
+	// depth is the depth of the span in the process.
+	depth int
+	// trace is the local trace of the span, if the number of spans is
+	// limited.
+	trace *localTrace
+}
+
+// localTrace is the part of a trace started in the process from a local root
+// span, i.e. a span without a recording parent span of the TracerProvider in
+// its context, and all its descendants.
+type localTrace struct {
+	// root is the local root span.
+	root *recordingSpan
+	// spans is the number of recorded spans.
+	spans atomic.Int64
+	// suppressed is the number of suppressed spans.
+	suppressed atomic.Int64
+}
+
+// limitsLocalSpans reports whether the depth or the number of the spans of a
+// local trace is limited.
+func (p *TracerProvider) limitsLocalSpans() bool {
+	return p.maxSpanDepth > 0 || p.maxSpansPerTrace > 0
+}
+
+// localSpan returns the localSpan of a span started with ctx and config. If
+// the span exceeds the maximum depth or number of spans, its last recorded
+// ancestor is returned instead and the span must not be recorded.
+func (tr *tracer) localSpan(ctx context.Context, config *trace.SpanConfig) (localSpan, *recordingSpan) {
+	var parent *recordingSpan
+	if !config.NewRoot() {
+		switch p := trace.SpanFromContext(ctx).(type) {
+		case *recordingSpan:
+			if p.tracer.provider == tr.provider {
+				parent = p
+			}
+		case nonRecordingSpan:
+			// The descendants of a suppressed span are suppressed.
+			if p.ancestor != nil && p.tracer.provider == tr.provider {
+				return localSpan{}, p.ancestor
+			}
+		}
+	}
+
+	if parent == nil {
+		local := localSpan{depth: 1}
+		if tr.provider.maxSpansPerTrace > 0 {
+			local.trace = &localTrace{}
+			local.trace.spans.Store(1)
+		}
+		return local, nil
+	}
+
+	local := localSpan{depth: parent.local.depth + 1, trace: parent.local.trace}
+	if limit := tr.provider.maxSpanDepth; limit > 0 && local.depth > limit {
+		return localSpan{}, parent
+	}
+	if lt := local.trace; lt != nil && lt.spans.Add(1) > int64(tr.provider.maxSpansPerTrace) {
+		lt.spans.Add(-1)
+		return localSpan{}, parent
+	}
+	return local, nil
+}
+
+// newSuppressedSpan returns a non-recording span suppressed because it
+// exceeds the maximum depth or number of spans. It shares the SpanContext of
+// its last recorded ancestor.
+func (tr *tracer) newSuppressedSpan(ancestor *recordingSpan) nonRecordingSpan {
+	ancestor.suppressedDescendants.Add(1)
+	if lt := ancestor.local.trace; lt != nil {
+		lt.suppressed.Add(1)
+	}
+	return nonRecordingSpan{tracer: tr, sc: ancestor.spanContext, ancestor: ancestor}
+}
+
+// suppressedAttributes returns the attributes recording the number of spans
+// suppressed below s.
+func (s *recordingSpan) suppressedAttributes() []attribute.KeyValue {
+	var attrs []attribute.KeyValue
+	if n := s.suppressedDescendants.Load(); n > 0 {
+		attrs = append(attrs, suppressedDescendantsKey.Int64(n))
+	}
+	if lt := s.local.trace; lt != nil && lt.root == s {
+		if n := lt.suppressed.Load(); n > 0 {
+			attrs = append(attrs, suppressedSpansKey.Int64(n))
+		}
+	}
+	return attrs
+}
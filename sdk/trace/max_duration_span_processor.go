@@ -0,0 +1,106 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// MaxDurationExceededKey is the attribute key set to true on spans ended by a
+// MaxDurationSpanProcessor because they exceeded the maximum duration.
+//
+// It is not defined by the semantic conventions.
+const MaxDurationExceededKey = attribute.Key("span.max_duration_exceeded")
+
+// MaxDurationSpanProcessor is a SpanProcessor that ends spans that have not
+// ended within a maximum duration after they started.
+//
+// Spans that are never ended (e.g. because of a missing call to End on an
+// error path) are never exported. This processor ends such leaked spans,
+// marking them with the MaxDurationExceededKey attribute, so they are
+// exported. Ending a span notifies all the processors registered with the
+// TracerProvider, whatever their order.
+//
+// The processor references every span it is notified of until the span ends
+// or the maximum duration elapses.
+//
+// Use [NewMaxDurationSpanProcessor] to create a MaxDurationSpanProcessor.
+type MaxDurationSpanProcessor struct {
+	maxDuration time.Duration
+
+	mu       sync.Mutex
+	timers   map[spanKey]*time.Timer
+	shutdown bool
+}
+
+var _ SpanProcessor = (*MaxDurationSpanProcessor)(nil)
+
+// NewMaxDurationSpanProcessor returns a new MaxDurationSpanProcessor that
+// ends spans still recording maxDuration after they started. If maxDuration
+// is not positive, spans are never ended by the processor.
+func NewMaxDurationSpanProcessor(maxDuration time.Duration) *MaxDurationSpanProcessor {
+	return &MaxDurationSpanProcessor{
+		maxDuration: maxDuration,
+		timers:      make(map[spanKey]*time.Timer),
+	}
+}
+
+// OnStart schedules s to be ended once the maximum duration elapses.
+func (p *MaxDurationSpanProcessor) OnStart(_ context.Context, s ReadWriteSpan) {
+	if p.maxDuration <= 0 {
+		return
+	}
+
+	key := newSpanKey(s.SpanContext())
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.shutdown {
+		return
+	}
+	p.timers[key] = time.AfterFunc(p.maxDuration, func() {
+		p.mu.Lock()
+		_, ok := p.timers[key]
+		delete(p.timers, key)
+		p.mu.Unlock()
+		if !ok || !s.IsRecording() {
+			return
+		}
+
+		s.SetAttributes(MaxDurationExceededKey.Bool(true))
+		s.End()
+	})
+}
+
+// OnEnd stops tracking s.
+func (p *MaxDurationSpanProcessor) OnEnd(s ReadOnlySpan) {
+	key := newSpanKey(s.SpanContext())
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if t, ok := p.timers[key]; ok {
+		t.Stop()
+		delete(p.timers, key)
+	}
+}
+
+// Shutdown stops tracking all the spans. Spans that have not ended are not
+// ended by the processor after Shutdown is called.
+func (p *MaxDurationSpanProcessor) Shutdown(context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.shutdown = true
+	for key, t := range p.timers {
+		t.Stop()
+		delete(p.timers, key)
+	}
+	return nil
+}
+
+// ForceFlush does nothing.
+func (*MaxDurationSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxDurationSpanProcessor(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSpanProcessor(NewMaxDurationSpanProcessor(10*time.Millisecond)),
+		WithSyncer(te),
+	)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	_, ended := tracer.Start(t.Context(), "ended")
+	ended.End()
+	_, leaked := tracer.Start(t.Context(), "leaked")
+
+	require.Eventually(t, func() bool {
+		return !leaked.IsRecording()
+	}, time.Second, time.Millisecond, "leaked span not ended")
+	leaked.End()
+
+	require.Equal(t, 2, te.Len())
+	got, ok := te.GetSpan("ended")
+	require.True(t, ok)
+	assert.NotContains(t, got.Attributes(), MaxDurationExceededKey.Bool(true))
+	got, ok = te.GetSpan("leaked")
+	require.True(t, ok)
+	assert.Contains(t, got.Attributes(), MaxDurationExceededKey.Bool(true))
+}
+
+func TestMaxDurationSpanProcessorShutdown(t *testing.T) {
+	p := NewMaxDurationSpanProcessor(10 * time.Millisecond)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	time.Sleep(20 * time.Millisecond)
+	assert.True(t, span.IsRecording(), "span ended after shutdown")
+	span.End()
+	require.NoError(t, tp.Shutdown(t.Context()))
+}
+
+func TestMaxDurationSpanProcessorDisabled(t *testing.T) {
+	p := NewMaxDurationSpanProcessor(0)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.End()
+	assert.Empty(t, p.timers)
+}
@@ -0,0 +1,201 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PartialSpanKey is the attribute key set to true on the snapshots of spans
+// still in-flight exported by a PartialSpanProcessor. It is not defined by the
+// semantic conventions.
+const PartialSpanKey = attribute.Key("span.partial")
+
+// defaultPartialSpanInterval is the default interval at which a
+// PartialSpanProcessor exports snapshots of in-flight spans.
+const defaultPartialSpanInterval = time.Minute
+
+// PartialSpanProcessor is a SpanProcessor that periodically exports snapshots
+// of the spans that have not ended yet.
+//
+// Long-running spans, like the ones of hours-long jobs, are only exported once
+// they end. This processor makes their current state visible while they are
+// in-flight to debug stuck work. Every interval, the spans started at least
+// one interval before are exported with their current attributes, events,
+// links, and status, an end time set to the time of the snapshot, and the
+// PartialSpanKey attribute set to true. Telemetry backends can replace the
+// snapshots with the complete span, sharing the same span ID, once it is
+// exported when it ends.
+//
+// Only sampled spans are exported. Complete spans are not exported by this
+// processor; register another SpanProcessor, e.g. a BatchSpanProcessor, to
+// export them.
+//
+// Use [NewPartialSpanProcessor] to create a PartialSpanProcessor.
+type PartialSpanProcessor struct {
+	exporter SpanExporter
+	interval time.Duration
+
+	mu    sync.Mutex
+	spans map[spanKey]*recordingSpan
+
+	// exportMu serializes the calls to the exporter.
+	exportMu sync.Mutex
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	done     chan struct{}
+}
+
+var _ SpanProcessor = (*PartialSpanProcessor)(nil)
+
+// spanKey identifies a span.
+type spanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+func newSpanKey(sc trace.SpanContext) spanKey {
+	return spanKey{traceID: sc.TraceID(), spanID: sc.SpanID()}
+}
+
+// NewPartialSpanProcessor returns a new PartialSpanProcessor that exports
+// snapshots of in-flight spans with exporter every interval. If interval is
+// not positive, an interval of 1 minute is used.
+//
+// The PartialSpanProcessor shuts down exporter when it is shut down. Use an
+// exporter not shared with other SpanProcessors.
+func NewPartialSpanProcessor(exporter SpanExporter, interval time.Duration) *PartialSpanProcessor {
+	if interval <= 0 {
+		interval = defaultPartialSpanInterval
+	}
+	p := &PartialSpanProcessor{
+		exporter: exporter,
+		interval: interval,
+		spans:    make(map[spanKey]*recordingSpan),
+		stopCh:   make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+	go p.run()
+	return p
+}
+
+func (p *PartialSpanProcessor) run() {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopCh:
+			return
+		case now := <-ticker.C:
+			p.export(context.Background(), now)
+		}
+	}
+}
+
+// stopped reports whether p is shut down.
+func (p *PartialSpanProcessor) stopped() bool {
+	select {
+	case <-p.stopCh:
+		return true
+	default:
+		return false
+	}
+}
+
+// export exports the snapshots of the spans started at least one interval
+// before now. Nothing is exported once p is shut down.
+func (p *PartialSpanProcessor) export(ctx context.Context, now time.Time) {
+	p.exportMu.Lock()
+	defer p.exportMu.Unlock()
+	if p.stopped() {
+		return
+	}
+
+	var spans []*recordingSpan
+	p.mu.Lock()
+	for _, s := range p.spans {
+		if now.Sub(s.startTime) >= p.interval {
+			spans = append(spans, s)
+		}
+	}
+	p.mu.Unlock()
+
+	snapshots := make([]ReadOnlySpan, 0, len(spans))
+	for _, s := range spans {
+		if sd := s.partialSnapshot(now); sd != nil {
+			snapshots = append(snapshots, sd)
+		}
+	}
+	if len(snapshots) == 0 {
+		return
+	}
+	if err := p.exporter.ExportSpans(ctx, snapshots); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// OnStart tracks s until it ends if it is sampled.
+func (p *PartialSpanProcessor) OnStart(_ context.Context, s ReadWriteSpan) {
+	rs, ok := s.(*recordingSpan)
+	if !ok || !s.SpanContext().IsSampled() {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.spans[newSpanKey(s.SpanContext())] = rs
+}
+
+// OnEnd stops tracking s.
+func (p *PartialSpanProcessor) OnEnd(s ReadOnlySpan) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.spans, newSpanKey(s.SpanContext()))
+}
+
+// Shutdown stops exporting snapshots and shuts down the exporter.
+func (p *PartialSpanProcessor) Shutdown(ctx context.Context) error {
+	var err error
+	p.stopOnce.Do(func() {
+		close(p.stopCh)
+		select {
+		case <-p.done:
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+
+		p.mu.Lock()
+		clear(p.spans)
+		p.mu.Unlock()
+
+		// Wait for any export in progress to return.
+		p.exportMu.Lock()
+		defer p.exportMu.Unlock()
+		err = p.exporter.Shutdown(ctx)
+	})
+	return err
+}
+
+// ForceFlush exports the snapshots of the in-flight spans started at least one
+// interval before. It does nothing once p is shut down.
+func (p *PartialSpanProcessor) ForceFlush(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p.stopped() {
+		return nil
+	}
+	p.export(ctx, time.Now())
+	return nil
+}
@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestPartialSpanProcessor(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSpanProcessor(NewPartialSpanProcessor(te, 10*time.Millisecond)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "long")
+	span.SetAttributes(attribute.String("step", "1"))
+	require.Eventually(t, func() bool { return te.Len() > 0 }, time.Second, time.Millisecond)
+	span.End()
+
+	got := te.Spans()[0]
+	assert.Equal(t, "long", got.Name())
+	assert.Contains(t, got.Attributes(), PartialSpanKey.Bool(true))
+	assert.Contains(t, got.Attributes(), attribute.String("step", "1"))
+	assert.False(t, got.EndTime().Before(got.StartTime()))
+}
+
+func TestPartialSpanProcessorExport(t *testing.T) {
+	te := NewTestExporter()
+	p := NewPartialSpanProcessor(te, time.Hour)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	_, ended := tracer.Start(t.Context(), "ended")
+	ended.End()
+	_, recent := tracer.Start(t.Context(), "recent")
+	defer recent.End()
+
+	require.NoError(t, p.ForceFlush(t.Context()))
+	assert.Equal(t, 0, te.Len(), "span started less than an interval before exported")
+
+	now := time.Now().Add(time.Hour)
+	p.export(t.Context(), now)
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+	assert.Equal(t, "recent", got.Name())
+	assert.Equal(t, now, got.EndTime())
+
+	_, ok := te.GetSpan("ended")
+	assert.False(t, ok, "ended span exported")
+}
+
+func TestPartialSpanProcessorShutdown(t *testing.T) {
+	te := NewTestExporter()
+	p := NewPartialSpanProcessor(te, 0)
+	assert.Equal(t, defaultPartialSpanInterval, p.interval)
+
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	p.export(t.Context(), time.Now().Add(time.Hour))
+	require.Equal(t, 1, te.Len())
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	require.NoError(t, p.Shutdown(t.Context()))
+	assert.Equal(t, 0, te.Len(), "exporter not shut down")
+	p.export(t.Context(), time.Now().Add(time.Hour))
+	assert.Equal(t, 0, te.Len(), "span exported after shutdown")
+	span.End()
+}
+
+func TestPartialSpanProcessorUnsampled(t *testing.T) {
+	te := NewTestExporter()
+	p := NewPartialSpanProcessor(te, time.Hour)
+	tp := NewTracerProvider(WithSampler(NeverSample()), WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	defer span.End()
+	p.export(t.Context(), time.Now().Add(time.Hour))
+	assert.Equal(t, 0, te.Len())
+}
+
+// serialExporter fails the test if ExportSpans is called concurrently or
+// after Shutdown.
+type serialExporter struct {
+	t        *testing.T
+	inFlight atomic.Int32
+	exports  atomic.Int32
+	shutdown atomic.Bool
+}
+
+func (e *serialExporter) ExportSpans(context.Context, []ReadOnlySpan) error {
+	if e.shutdown.Load() {
+		e.t.Error("ExportSpans called after Shutdown")
+	}
+	if e.inFlight.Add(1) > 1 {
+		e.t.Error("ExportSpans called concurrently")
+	}
+	time.Sleep(time.Millisecond)
+	e.inFlight.Add(-1)
+	e.exports.Add(1)
+	return nil
+}
+
+func (e *serialExporter) Shutdown(context.Context) error {
+	e.shutdown.Store(true)
+	return nil
+}
+
+func TestPartialSpanProcessorSerializesExports(t *testing.T) {
+	exp := &serialExporter{t: t}
+	p := NewPartialSpanProcessor(exp, time.Millisecond)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	defer span.End()
+	time.Sleep(2 * time.Millisecond)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Go(func() {
+			for range 5 {
+				assert.NoError(t, p.ForceFlush(t.Context()))
+			}
+		})
+	}
+	wg.Wait()
+	assert.Positive(t, exp.exports.Load())
+
+	require.NoError(t, p.Shutdown(t.Context()))
+	before := exp.exports.Load()
+	assert.NoError(t, p.ForceFlush(t.Context()))
+	assert.Equal(t, before, exp.exports.Load(), "exported after shutdown")
+}
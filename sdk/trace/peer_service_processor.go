@@ -0,0 +1,152 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PeerServiceKey is the attribute key a PeerServiceProcessor annotates client
+// spans with. Its value is the logical name of the service the span calls.
+const PeerServiceKey = attribute.Key("peer.service")
+
+const (
+	serverAddressKey = attribute.Key("server.address")
+	serverPortKey    = attribute.Key("server.port")
+)
+
+// PeerServiceResolver resolves the logical name of the service at a network
+// address.
+type PeerServiceResolver interface {
+	// ResolvePeerService returns the name of the service at address and
+	// port, and whether it is known. The port is zero if the span has no
+	// server.port attribute.
+	//
+	// It is called synchronously when client spans end and must not block.
+	// Resolvers querying the DNS or a service registry should do it in the
+	// background and answer from a cache.
+	ResolvePeerService(address string, port int) (string, bool)
+}
+
+// PeerServiceResolverFunc is a function implementing PeerServiceResolver.
+type PeerServiceResolverFunc func(address string, port int) (string, bool)
+
+var _ PeerServiceResolver = PeerServiceResolverFunc(nil)
+
+// ResolvePeerService returns fn(address, port).
+func (fn PeerServiceResolverFunc) ResolvePeerService(address string, port int) (string, bool) {
+	return fn(address, port)
+}
+
+// StaticPeerServiceResolver returns a PeerServiceResolver resolving the
+// addresses with services. The keys of services are addresses, e.g.
+// "db.internal", or addresses with a port, e.g. "db.internal:5432" or
+// "[::1]:8080". An address with a port takes precedence over the address
+// alone.
+func StaticPeerServiceResolver(services map[string]string) PeerServiceResolver {
+	services = maps.Clone(services)
+	return PeerServiceResolverFunc(func(address string, port int) (string, bool) {
+		if port > 0 {
+			if name, ok := services[net.JoinHostPort(address, strconv.Itoa(port))]; ok {
+				return name, true
+			}
+		}
+		name, ok := services[address]
+		return name, ok
+	})
+}
+
+// PeerServiceProcessor is a SpanProcessor that annotates the ended client
+// spans it passes to another SpanProcessor with the PeerServiceKey attribute
+// resolved from their server.address and server.port attributes. It improves
+// the service maps built by telemetry backends without changing the
+// instrumentation of each client.
+//
+// Only the sampled spans of kind client with a server.address attribute and
+// without a PeerServiceKey attribute are annotated, the other spans are
+// passed unchanged.
+//
+// Use [NewPeerServiceProcessor] to create a PeerServiceProcessor.
+type PeerServiceProcessor struct {
+	next     SpanProcessor
+	resolver PeerServiceResolver
+}
+
+var _ SpanProcessor = (*PeerServiceProcessor)(nil)
+
+// NewPeerServiceProcessor returns a new PeerServiceProcessor that passes
+// spans to next, the ended client spans annotated with the name of the peer
+// service resolved by resolver.
+func NewPeerServiceProcessor(next SpanProcessor, resolver PeerServiceResolver) *PeerServiceProcessor {
+	return &PeerServiceProcessor{next: next, resolver: resolver}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (p *PeerServiceProcessor) OnStart(ctx context.Context, s ReadWriteSpan) {
+	p.next.OnStart(ctx, s)
+}
+
+// OnEnd passes s, annotated with the name of its peer service if resolved,
+// to the wrapped SpanProcessor.
+func (p *PeerServiceProcessor) OnEnd(s ReadOnlySpan) {
+	if p.resolver != nil && s.SpanKind() == trace.SpanKindClient && s.SpanContext().IsSampled() {
+		if name, ok := p.resolve(s.Attributes()); ok {
+			p.next.OnEnd(peerServiceSpan{ReadOnlySpan: s, name: name})
+			return
+		}
+	}
+	p.next.OnEnd(s)
+}
+
+// resolve returns the name of the peer service of a span with attrs.
+func (p *PeerServiceProcessor) resolve(attrs []attribute.KeyValue) (string, bool) {
+	var (
+		address string
+		port    int
+	)
+	for _, kv := range attrs {
+		switch kv.Key {
+		case PeerServiceKey:
+			return "", false
+		case serverAddressKey:
+			address = kv.Value.AsString()
+		case serverPortKey:
+			port = int(kv.Value.AsInt64())
+		}
+	}
+	if address == "" {
+		return "", false
+	}
+	name, ok := p.resolver.ResolvePeerService(address, port)
+	return name, ok && name != ""
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (p *PeerServiceProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanProcessor.
+func (p *PeerServiceProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// peerServiceSpan is a ReadOnlySpan with the PeerServiceKey attribute added.
+type peerServiceSpan struct {
+	ReadOnlySpan
+	name string
+}
+
+// Attributes returns the attributes of the span and the PeerServiceKey
+// attribute.
+func (s peerServiceSpan) Attributes() []attribute.KeyValue {
+	return append(slices.Clone(s.ReadOnlySpan.Attributes()), PeerServiceKey.String(s.name))
+}
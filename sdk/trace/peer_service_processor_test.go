@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestPeerServiceProcessor(t *testing.T) {
+	rec := new(recorder)
+	resolver := StaticPeerServiceResolver(map[string]string{
+		"db.internal":      "postgres",
+		"api.internal:443": "api",
+		"[::1]:8080":       "local",
+	})
+	tp := NewTracerProvider(WithSpanProcessor(NewPeerServiceProcessor(rec, resolver)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	end := func(kind trace.SpanKind, attrs ...attribute.KeyValue) {
+		_, span := tracer.Start(t.Context(), "span", trace.WithSpanKind(kind), trace.WithAttributes(attrs...))
+		span.End()
+	}
+	end(trace.SpanKindClient, serverAddressKey.String("db.internal"), serverPortKey.Int(5432))
+	end(trace.SpanKindClient, serverAddressKey.String("api.internal"), serverPortKey.Int(443))
+	end(trace.SpanKindClient, serverAddressKey.String("::1"), serverPortKey.Int(8080))
+	end(trace.SpanKindClient, serverAddressKey.String("api.internal"), serverPortKey.Int(80))
+	end(trace.SpanKindClient, serverAddressKey.String("db.internal"), PeerServiceKey.String("primary"))
+	end(trace.SpanKindServer, serverAddressKey.String("db.internal"))
+	end(trace.SpanKindClient)
+
+	want := []string{"postgres", "api", "local", "", "primary", "", ""}
+	require.Len(t, *rec, len(want))
+	for i, s := range *rec {
+		var got []string
+		for _, kv := range s.Attributes() {
+			if kv.Key == PeerServiceKey {
+				got = append(got, kv.Value.AsString())
+			}
+		}
+		if want[i] == "" {
+			assert.Empty(t, got, "span %d", i)
+		} else {
+			assert.Equal(t, []string{want[i]}, got, "span %d", i)
+		}
+	}
+}
+
+func TestPeerServiceResolverFunc(t *testing.T) {
+	var calls int
+	resolver := PeerServiceResolverFunc(func(address string, port int) (string, bool) {
+		calls++
+		return address + "-service", port == 0
+	})
+	rec := new(recorder)
+	p := NewPeerServiceProcessor(rec, resolver)
+	tp := NewTracerProvider(WithSampler(recordOnlySampler{}), WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(
+		t.Context(),
+		"span",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(serverAddressKey.String("db")),
+	)
+	span.End()
+	require.Len(t, *rec, 1)
+	assert.Zero(t, calls, "not sampled span resolved")
+
+	name, ok := resolver.ResolvePeerService("db", 0)
+	assert.True(t, ok)
+	assert.Equal(t, "db-service", name)
+}
@@ -7,11 +7,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/internal/attrnorm"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -23,6 +26,10 @@ import (
 
 const defaultTracerName = "go.opentelemetry.io/otel/sdk/tracer"
 
+// ErrInvalidConfig is wrapped by the errors of invalid options returned by
+// NewTracerProviderWithErrors. Use errors.Is to match them.
+var ErrInvalidConfig = errors.New("invalid configuration")
+
 // tracerProviderConfig.
 type tracerProviderConfig struct {
 	// processors contains collection of SpanProcessors that are processing pipeline
@@ -32,20 +39,93 @@ type tracerProviderConfig struct {
 	// registered.
 	processors []SpanProcessor
 
+	// listeners are the SpanListeners notified of the spans.
+	listeners []SpanListener
+
+	// processorFactories return the SpanProcessors of the spans of each
+	// scope.
+	processorFactories []SpanProcessorFactory
+
 	// sampler is the default sampler used when creating new spans.
 	sampler Sampler
 
+	// traceStateHooks update the TraceState of the new spans after the
+	// sampling decision, in order.
+	traceStateHooks []TraceStateHook
+
 	// idGenerator is used to generate all Span and Trace IDs when needed.
 	idGenerator IDGenerator
 
+	// scopeIDGenerator selects the IDGenerator of the spans of a scope and
+	// kind, if not nil.
+	scopeIDGenerator func(instrumentation.Scope, trace.SpanKind) IDGenerator
+
 	// spanLimits defines the attribute, event, and link limits for spans.
 	spanLimits SpanLimits
 
 	// resource contains attributes representing an entity that produces telemetry.
 	resource *resource.Resource
 
+	// lazyResourceOpts are the options used to detect a Resource in the
+	// background, if lazyResource is true.
+	lazyResource     bool
+	lazyResourceOpts []resource.Option
+	lazyResourceWait time.Duration
+
+	// refreshingResource is the Resource evaluated when spans are read, if
+	// not nil.
+	refreshingResource *resource.Refreshing
+
 	// panicRecordingDisabled disables recording exception events from panics.
 	panicRecordingDisabled bool
+
+	// introspection counts the spans of the Tracers.
+	introspection bool
+
+	// sortedAttributes sorts the attributes of ended spans by key.
+	sortedAttributes bool
+
+	// linkDeduplication removes the links of spans to themselves and the
+	// duplicate links.
+	linkDeduplication bool
+
+	// maxSpanDepth is the maximum depth of the recorded spans. Zero means no
+	// limit.
+	maxSpanDepth int
+
+	// maxSpansPerTrace is the maximum number of recorded spans of a local
+	// trace. Zero means no limit.
+	maxSpansPerTrace int
+
+	// startStackTraces records the start stack trace of all the recording
+	// spans, at most startStackTraceRate per second.
+	startStackTraces    bool
+	startStackTraceRate float64
+
+	// scopeCache is the cache the instrumentation scopes of Tracers are
+	// interned in.
+	scopeCache *instrumentation.ScopeCache
+
+	// meterProvider is the MeterProvider self-observability metrics are
+	// recorded with.
+	meterProvider metric.MeterProvider
+
+	// errs are the errors of the invalid options passed.
+	errs []error
+}
+
+// validate returns an error joining the errors of the invalid options passed
+// and of the invalid configuration of the registered SpanProcessors, if any.
+func (cfg tracerProviderConfig) validate() error {
+	errs := slices.Clone(cfg.errs)
+	for _, sp := range cfg.processors {
+		if c, ok := sp.(interface{ configErr() error }); ok {
+			if err := c.configErr(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
 }
 
 // MarshalLog is the marshaling function used by the logging system to represent this Provider.
@@ -75,16 +155,41 @@ type TracerProvider struct {
 	mu             sync.Mutex
 	namedTracer    map[instrumentation.Scope]*tracer
 	spanProcessors atomic.Pointer[spanProcessorStates]
+	spanListeners  atomic.Pointer[[]SpanListener]
 
 	isShutdown atomic.Bool
 
 	// These fields are not protected by the lock mu. They are assumed to be
 	// immutable after creation of the TracerProvider.
 	sampler                Sampler
+	traceStateHooks        []TraceStateHook
 	idGenerator            IDGenerator
 	spanLimits             SpanLimits
-	resource               *resource.Resource
 	panicRecordingDisabled bool
+	introspection          bool
+	sortedAttributes       bool
+	linkDeduplication      bool
+	maxSpanDepth           int
+	maxSpansPerTrace       int
+	startStackTraces       bool
+	scopeCache             *instrumentation.ScopeCache
+	meterProvider          metric.MeterProvider
+
+	// scopeIDGenerator selects the IDGenerator of the spans of a scope and
+	// kind, if not nil.
+	scopeIDGenerator func(instrumentation.Scope, trace.SpanKind) IDGenerator
+
+	// processorFactories return the SpanProcessors of the spans of each
+	// scope.
+	processorFactories []SpanProcessorFactory
+
+	// startStackTraceLimiter limits the rate of the start stack traces
+	// captured.
+	startStackTraceLimiter *tokenBucket
+
+	// resource is the Resource spans are associated with when they are
+	// started.
+	resource atomic.Pointer[spanResource]
 }
 
 var _ trace.TracerProvider = &TracerProvider{}
@@ -104,6 +209,42 @@ type experimentalOption interface {
 // The passed opts are used to override these default values and configure the
 // returned TracerProvider appropriately.
 func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
+	return newTracerProvider(newTracerProviderConfig(opts))
+}
+
+// NewTracerProviderWithErrors returns a new and configured TracerProvider,
+// the same as NewTracerProvider, unless opts are invalid. Instead of ignoring
+// or replacing the invalid values like NewTracerProvider, it returns an error
+// describing all of them. This can be used to catch misconfiguration, e.g.
+// in tests.
+//
+// The options are invalid if:
+//   - a nil SpanProcessor, Sampler, IDGenerator, or TraceStateHook is passed
+//   - the Resource passed to WithResource cannot be merged with the
+//     environment Resource
+//   - a BatchSpanProcessor is created with a nil exporter, a
+//     non-positive MaxQueueSize, MaxExportBatchSize, or BatchTimeout, a
+//     MaxExportBatchSize greater than its MaxQueueSize, or a negative
+//     ExportTimeout.
+//
+// The returned error wraps ErrInvalidConfig. If an error is returned, the
+// registered SpanProcessors are shut down.
+func NewTracerProviderWithErrors(opts ...TracerProviderOption) (*TracerProvider, error) {
+	o := newTracerProviderConfig(opts)
+	if err := o.validate(); err != nil {
+		for _, sp := range o.processors {
+			if sp != nil {
+				_ = sp.Shutdown(context.Background())
+			}
+		}
+		return nil, err
+	}
+	return newTracerProvider(o), nil
+}
+
+// newTracerProviderConfig returns the tracerProviderConfig configured with
+// the environment and opts.
+func newTracerProviderConfig(opts []TracerProviderOption) tracerProviderConfig {
 	o := tracerProviderConfig{
 		spanLimits: NewSpanLimits(),
 	}
@@ -116,23 +257,49 @@ func NewTracerProvider(opts ...TracerProviderOption) *TracerProvider {
 		o = opt.apply(o)
 	}
 
-	o = ensureValidTracerProviderConfig(o)
+	return ensureValidTracerProviderConfig(o)
+}
 
+// newTracerProvider returns a TracerProvider configured with o.
+func newTracerProvider(o tracerProviderConfig) *TracerProvider {
 	tp := &TracerProvider{
 		namedTracer:            make(map[instrumentation.Scope]*tracer),
 		sampler:                o.sampler,
+		traceStateHooks:        o.traceStateHooks,
 		idGenerator:            o.idGenerator,
 		spanLimits:             o.spanLimits,
-		resource:               o.resource,
 		panicRecordingDisabled: o.panicRecordingDisabled,
-	}
+		introspection:          o.introspection,
+		sortedAttributes:       o.sortedAttributes,
+		linkDeduplication:      o.linkDeduplication,
+		maxSpanDepth:           o.maxSpanDepth,
+		maxSpansPerTrace:       o.maxSpansPerTrace,
+		startStackTraces:       o.startStackTraces,
+		scopeCache:             o.scopeCache,
+		meterProvider:          o.meterProvider,
+		scopeIDGenerator:       o.scopeIDGenerator,
+		processorFactories:     o.processorFactories,
+	}
+	rate := float64(defaultStartStackTraceRate)
+	if o.startStackTraces {
+		rate = o.startStackTraceRate
+	}
+	tp.startStackTraceLimiter = newTokenBucket(rate, time.Now)
+	res := &spanResource{base: o.resource, refreshing: o.refreshingResource}
+	if o.lazyResource {
+		res.lazy = newLazyResource(o.resource, o.lazyResourceWait, o.lazyResourceOpts)
+	}
+	tp.resource.Store(res)
 	global.Info("TracerProvider created", "config", o)
 
 	spss := make(spanProcessorStates, 0, len(o.processors))
 	for _, sp := range o.processors {
+		tp.observe(sp)
 		spss = append(spss, newSpanProcessorState(sp))
 	}
 	tp.spanProcessors.Store(&spss)
+	listeners := slices.Clone(o.listeners)
+	tp.spanListeners.Store(&listeners)
 
 	return tp
 }
@@ -154,12 +321,12 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 	if name == "" {
 		name = defaultTracerName
 	}
-	is := instrumentation.Scope{
+	is := p.scopeCache.Intern(instrumentation.Scope{
 		Name:       name,
 		Version:    c.InstrumentationVersion(),
 		SchemaURL:  c.SchemaURL(),
 		Attributes: attrs,
-	}
+	})
 
 	t, ok := func() (trace.Tracer, bool) {
 		p.mu.Lock()
@@ -175,12 +342,17 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 				provider:             p,
 				instrumentationScope: is,
 			}
+			t.idGenerators = p.idGenerators(is)
+			t.processors = p.scopeProcessors(is)
 
 			var err error
-			t.inst, err = observ.NewTracer()
+			t.inst, err = observ.NewTracer(p.meterProvider)
 			if err != nil {
 				otel.Handle(err)
 			}
+			if p.introspection || t.inst.Enabled() {
+				t.counts = new(spanCounts)
+			}
 
 			p.namedTracer[is] = t
 		}
@@ -204,7 +376,7 @@ func (p *TracerProvider) Tracer(name string, opts ...trace.TracerOption) trace.T
 			is.Attributes,
 		)
 	}
-	return t
+	return withSpanDefaults(t, opts)
 }
 
 // RegisterSpanProcessor adds the given SpanProcessor to the list of SpanProcessors.
@@ -220,6 +392,7 @@ func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 		return
 	}
 
+	p.observe(sp)
 	current := p.getSpanProcessors()
 	newSPS := make(spanProcessorStates, 0, len(current)+1)
 	newSPS = append(newSPS, current...)
@@ -227,6 +400,25 @@ func (p *TracerProvider) RegisterSpanProcessor(sp SpanProcessor) {
 	p.spanProcessors.Store(&newSPS)
 }
 
+// selfObserver is a SpanProcessor that records self-observability metrics
+// with a configurable MeterProvider.
+type selfObserver interface {
+	setMeterProvider(metric.MeterProvider) error
+}
+
+// observe configures sp to record its self-observability metrics with the
+// MeterProvider of p, if one is configured.
+func (p *TracerProvider) observe(sp SpanProcessor) {
+	if p.meterProvider == nil {
+		return
+	}
+	if o, ok := sp.(selfObserver); ok {
+		if err := o.setMeterProvider(p.meterProvider); err != nil {
+			otel.Handle(err)
+		}
+	}
+}
+
 // UnregisterSpanProcessor removes the given SpanProcessor from the list of SpanProcessors.
 func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 	// This check prevents calls during a shutdown.
@@ -274,13 +466,8 @@ func (p *TracerProvider) UnregisterSpanProcessor(sp SpanProcessor) {
 // ForceFlush immediately exports all spans that have not yet been exported for
 // all the registered span processors.
 func (p *TracerProvider) ForceFlush(ctx context.Context) error {
-	spss := p.getSpanProcessors()
-	if len(spss) == 0 {
-		return nil
-	}
-
 	var err error
-	for _, sps := range spss {
+	for _, sps := range p.getSpanProcessors() {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -289,6 +476,9 @@ func (p *TracerProvider) ForceFlush(ctx context.Context) error {
 
 		err = errors.Join(err, sps.sp.ForceFlush(ctx))
 	}
+	if len(p.processorFactories) > 0 {
+		err = errors.Join(err, p.forceFlushScopeProcessors(ctx))
+	}
 	return err
 }
 
@@ -321,10 +511,24 @@ func (p *TracerProvider) Shutdown(ctx context.Context) error {
 		})
 		retErr = errors.Join(retErr, err)
 	}
+	if len(p.processorFactories) > 0 {
+		if err := p.shutdownScopeProcessors(ctx); err != nil {
+			retErr = errors.Join(retErr, err)
+		}
+	}
 	p.spanProcessors.Store(&spanProcessorStates{})
+	p.spanListeners.Store(&[]SpanListener{})
+	if l := p.resource.Load().lazy; l != nil {
+		l.stop()
+	}
 	return retErr
 }
 
+// getResource returns the Resource of the TracerProvider.
+func (p *TracerProvider) getResource() *resource.Resource {
+	return p.resource.Load().get()
+}
+
 func (p *TracerProvider) getSpanProcessors() spanProcessorStates {
 	return *p.spanProcessors.Load()
 }
@@ -361,6 +565,9 @@ func WithBatcher(e SpanExporter, opts ...BatchSpanProcessorOption) TracerProvide
 // WithSpanProcessor registers the SpanProcessor with a TracerProvider.
 func WithSpanProcessor(sp SpanProcessor) TracerProviderOption {
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if sp == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil SpanProcessor", ErrInvalidConfig))
+		}
 		cfg.processors = append(cfg.processors, sp)
 		return cfg
 	})
@@ -376,6 +583,121 @@ func WithoutPanicRecording() TracerProviderOption {
 	})
 }
 
+// WithIntrospection configures the TracerProvider to count the spans started
+// and ended by its Tracers and the decisions of its Sampler, reported by
+// [TracerProvider.Tracers] and [TracerProvider.SamplingStats]. The spans are
+// also counted when the observability of the TracerProvider is enabled.
+//
+// Counting adds contended atomic operations when spans start and end. By
+// default, the spans are not counted.
+func WithIntrospection() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.introspection = true
+		return cfg
+	})
+}
+
+// WithSortedAttributes configures the TracerProvider to sort the attributes
+// of ended spans, and of their events and links, by key. This makes the order
+// of exported attributes deterministic, e.g. for golden file tests, instead of
+// depending on the order attributes were set and de-duplicated in.
+//
+// Sorting adds overhead when spans end. It is not recommended outside of
+// testing.
+func WithSortedAttributes() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.sortedAttributes = true
+		return cfg
+	})
+}
+
+// WithLinkDeduplication configures the TracerProvider to remove the links of
+// the recording spans to their own SpanContext and the links duplicating a
+// link already recorded by the span, with the same SpanContext and
+// attributes. It cleans up the data of buggy instrumentation, e.g. of batch
+// processing adding the links of all the messages of a batch to the spans of
+// each message.
+//
+// The number of links removed from a span is recorded in its
+// "span.removed_links" attribute when it ends. It is not defined by the
+// semantic conventions. Removed links are not counted as dropped links.
+//
+// Detecting duplicates compares each link to the links of the span, adding
+// overhead when spans have many links.
+func WithLinkDeduplication() TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.linkDeduplication = true
+		return cfg
+	})
+}
+
+// WithMaxSpanDepth configures the TracerProvider to stop recording the spans
+// nested more than depth levels deep in the process. It protects against
+// pathological recursive instrumentation creating unbounded traces.
+//
+// A span started without a recording parent span of the TracerProvider in
+// its context has a depth of 1, its children have a depth of 2, and so on.
+// The spans with a depth greater than depth, and all their descendants, are
+// non-recording: the Sampler and the SpanProcessors are not called for them.
+// They share the SpanContext of their last recorded ancestor, so the spans
+// started in other processes from their propagated context are children of
+// that ancestor. When the ancestor ends, the number of its suppressed
+// descendants is recorded in its "span.suppressed_descendants" attribute,
+// which is not defined by the semantic conventions.
+//
+// If depth is less than or equal to zero, the depth is not limited. This is
+// the default.
+func WithMaxSpanDepth(depth int) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.maxSpanDepth = max(depth, 0)
+		return cfg
+	})
+}
+
+// WithMaxSpansPerTrace configures the TracerProvider to record at most n
+// spans of a trace in the process. It protects the memory of the process
+// when a bug creates an unbounded number of spans in a trace.
+//
+// The spans are counted from a local root span, a span started without a
+// recording parent span of the TracerProvider in its context, and include
+// all its recorded descendants. Once n spans are recorded, the new
+// descendants are non-recording the same as the spans exceeding the maximum
+// depth, see WithMaxSpanDepth. When the local root span ends, the number of
+// spans of its trace not recorded is recorded in its "trace.suppressed_spans"
+// attribute, which is not defined by the semantic conventions.
+//
+// If n is less than or equal to zero, the number of spans is not limited.
+// This is the default.
+func WithMaxSpansPerTrace(n int) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.maxSpansPerTrace = max(n, 0)
+		return cfg
+	})
+}
+
+// WithStartStackTrace configures the TracerProvider to record the call stack
+// where the recording spans are started in their "code.stacktrace"
+// attribute. It helps to find where unexpected spans originate in a large
+// codebase.
+//
+// The stack traces are abbreviated: the frames of the SDK are omitted and at
+// most 16 frames are recorded. Capturing a stack trace is expensive, at most
+// perSecond stack traces are captured per second. The spans started once
+// the rate is exceeded do not have the attribute.
+//
+// Without this option, only the spans started with the experimental
+// WithStartStackTrace option of go.opentelemetry.io/otel/trace/x have their
+// stack trace recorded, at most 10 per second. With this option, perSecond
+// also limits these spans. If perSecond is not positive, no stack traces are
+// recorded.
+func WithStartStackTrace(perSecond float64) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.startStackTraces = true
+		cfg.startStackTraceRate = perSecond
+		return cfg
+	})
+}
+
 // WithResource returns a TracerProviderOption that will configure the
 // Resource r as a TracerProvider's Resource. The configured Resource is
 // referenced by all the Tracers the TracerProvider creates. It represents the
@@ -389,11 +711,95 @@ func WithResource(r *resource.Resource) TracerProviderOption {
 		cfg.resource, err = resource.Merge(resource.Environment(), r)
 		if err != nil {
 			otel.Handle(err)
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: resource: %w", ErrInvalidConfig, err))
 		}
 		return cfg
 	})
 }
 
+// WithSharedResource returns a TracerProviderOption that will configure the
+// Resource r as a TracerProvider's Resource as is. Unlike [WithResource], r is
+// not merged with the Resource described by the environment, so the same
+// Resource instance can be shared with other providers instead of each
+// holding a copy.
+//
+// Use [resource.Merge] with [resource.Environment] before sharing r to
+// include the Resource described by the environment. If r is nil, the
+// resource.Default() Resource is used.
+func WithSharedResource(r *resource.Resource) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.resource = r
+		return cfg
+	})
+}
+
+// WithScopeCache returns a TracerProviderOption that will configure the
+// TracerProvider to intern the instrumentation scopes of the Tracers it
+// creates in c. Share c with the MeterProvider and LoggerProvider of the
+// process to have them reference the same scope values.
+func WithScopeCache(c *instrumentation.ScopeCache) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.scopeCache = c
+		return cfg
+	})
+}
+
+// WithSelfObservability returns a TracerProviderOption that will configure
+// the TracerProvider, its Tracers, and the BatchSpanProcessor and
+// SimpleSpanProcessor registered with it to record self-observability metrics
+// (e.g. started spans, queue size, and processed spans) with mp.
+//
+// This enables the self-observability metrics regardless of the
+// OTEL_GO_X_OBSERVABILITY environment variable. If mp is nil, the metrics are
+// recorded with the global MeterProvider only when that environment variable
+// enables them, which is the default behavior.
+func WithSelfObservability(mp metric.MeterProvider) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.meterProvider = mp
+		return cfg
+	})
+}
+
+// WithLazyResource returns a TracerProviderOption that will configure the
+// TracerProvider to detect its Resource in the background using the resource
+// options opts (see [resource.New]). The detected Resource is merged with the
+// Resource configured with [WithResource], or the resource.Default() Resource
+// if none is configured.
+//
+// The detection starts when the TracerProvider is created and does not delay
+// its creation. Spans keep a reference to the pending detection and resolve
+// their Resource when it is read (e.g. when exported). Reads made before the
+// detection completes block until maxWait has elapsed since the creation of
+// the TracerProvider at most. After that, reads return the Resource
+// configured without the detection until the detection completes.
+//
+// This is useful to avoid delaying the startup of short-lived processes, like
+// serverless functions, by slow resource detectors, like ones querying a
+// cloud metadata service.
+func WithLazyResource(maxWait time.Duration, opts ...resource.Option) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.lazyResource = true
+		cfg.lazyResourceWait = maxWait
+		cfg.lazyResourceOpts = append(cfg.lazyResourceOpts, opts...)
+		return cfg
+	})
+}
+
+// WithRefreshingResource returns a TracerProviderOption that will configure
+// the TracerProvider to associate spans with the Resource of r. The Resource
+// is read from r each time the Resource of a span is read (e.g. when it is
+// exported), so attributes that change during the lifetime of the process are
+// reported with their current value, within the TTL of r.
+//
+// This takes precedence over the Resource configured with [WithResource],
+// [WithSharedResource], and [WithLazyResource].
+func WithRefreshingResource(r *resource.Refreshing) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.refreshingResource = r
+		return cfg
+	})
+}
+
 // WithIDGenerator returns a TracerProviderOption that will configure the
 // IDGenerator g as a TracerProvider's IDGenerator. The configured IDGenerator
 // is used by the Tracers the TracerProvider creates to generate new Span and
@@ -403,13 +809,53 @@ func WithResource(r *resource.Resource) TracerProviderOption {
 // IDGenerator by default.
 func WithIDGenerator(g IDGenerator) TracerProviderOption {
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
-		if g != nil {
-			cfg.idGenerator = g
+		if g == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil IDGenerator", ErrInvalidConfig))
+			return cfg
 		}
+		cfg.idGenerator = g
+		return cfg
+	})
+}
+
+// WithScopeIDGenerator returns a TracerProviderOption that configures f to
+// select the IDGenerator of the spans of each instrumentation scope and span
+// kind, e.g. to generate the IDs of a vendor format only for the client spans
+// of the scopes calling the services of that vendor. The IDGenerator
+// configured with [WithIDGenerator] is used if f returns nil.
+//
+// The function is called when a Tracer is created, once for each span kind
+// other than [trace.SpanKindUnspecified], the spans started with an
+// unspecified kind being internal spans. It is not called when spans are
+// started.
+//
+// The IDGenerator selected for the kind of a span generates its span ID, and
+// its trace ID if it is a root span. The spans of a trace can have span IDs
+// generated by different IDGenerators.
+func WithScopeIDGenerator(f func(scope instrumentation.Scope, kind trace.SpanKind) IDGenerator) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		cfg.scopeIDGenerator = f
 		return cfg
 	})
 }
 
+// idGenerators returns the IDGenerators of the spans of scope, indexed by
+// span kind, or nil if they all use the IDGenerator of p.
+func (p *TracerProvider) idGenerators(scope instrumentation.Scope) []IDGenerator {
+	if p.scopeIDGenerator == nil {
+		return nil
+	}
+	gens := make([]IDGenerator, trace.SpanKindConsumer+1)
+	for kind := trace.SpanKindInternal; kind <= trace.SpanKindConsumer; kind++ {
+		gens[kind] = p.idGenerator
+		if g := p.scopeIDGenerator(scope, kind); g != nil {
+			gens[kind] = g
+		}
+	}
+	gens[trace.SpanKindUnspecified] = gens[trace.SpanKindInternal]
+	return gens
+}
+
 // WithSampler returns a TracerProviderOption that will configure the Sampler
 // s as a TracerProvider's Sampler. The configured Sampler is used by the
 // Tracers the TracerProvider creates to make their sampling decisions for the
@@ -422,9 +868,47 @@ func WithIDGenerator(g IDGenerator) TracerProviderOption {
 // ParentBased(AlwaysSample) Sampler by default.
 func WithSampler(s Sampler) TracerProviderOption {
 	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
-		if s != nil {
-			cfg.sampler = s
+		if s == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil Sampler", ErrInvalidConfig))
+			return cfg
+		}
+		cfg.sampler = s
+		return cfg
+	})
+}
+
+// TraceStateHook returns the TraceState of a span started by a TracerProvider
+// with the parameters p of its sampling decision r. The TraceState of r is
+// the one returned by the Sampler, or by the previous TraceStateHook.
+//
+// It is called synchronously when the span is started, and must be fast.
+// If the TraceState cannot be updated, e.g. because an entry is invalid, it
+// should return the TraceState of r.
+type TraceStateHook func(p SamplingParameters, r SamplingResult) trace.TraceState
+
+// WithTraceStateHook returns a TracerProviderOption that registers h to
+// update the TraceState of all the spans started by the TracerProvider, the
+// recording and non-recording ones, after the Sampler decided whether to
+// sample them. The hooks are called in the order they are registered.
+//
+// It allows to add or update a vendor entry of the TraceState of the local
+// root and child spans, e.g. recording the local sampling rate, without
+// writing a Sampler wrapping the configured one. For example:
+//
+//	WithTraceStateHook(func(_ SamplingParameters, r SamplingResult) trace.TraceState {
+//		ts, err := r.Tracestate.Insert("vendor", "r:0.1")
+//		if err != nil {
+//			return r.Tracestate
+//		}
+//		return ts
+//	})
+func WithTraceStateHook(h TraceStateHook) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if h == nil {
+			cfg.errs = append(cfg.errs, fmt.Errorf("%w: nil TraceStateHook", ErrInvalidConfig))
+			return cfg
 		}
+		cfg.traceStateHooks = append(cfg.traceStateHooks, h)
 		return cfg
 	})
 }
@@ -9,7 +9,10 @@ import (
 	"errors"
 	"fmt"
 	"math/rand/v2"
+	"strings"
 	"testing"
+	"time"
+	"unsafe"
 
 	"github.com/go-logr/logr/funcr"
 	"github.com/stretchr/testify/assert"
@@ -19,6 +22,11 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/internal/global"
 	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv/v1.43.0/otelconv"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -548,6 +556,54 @@ func TestTracerProviderObservabilityErrorsHandled(t *testing.T) {
 	assert.ErrorIs(t, handler.errs[0], assert.AnError)
 }
 
+func TestWithSelfObservability(t *testing.T) {
+	// Do not set OTEL_GO_X_OBSERVABILITY, the option enables observability.
+	handler.Reset()
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	exp := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSelfObservability(mp),
+		WithSpanProcessor(NewSimpleSpanProcessor(exp)),
+	)
+	tp.RegisterSpanProcessor(NewBatchSpanProcessor(exp))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer("test-tracer").Start(t.Context(), "span")
+	span.End()
+	require.NoError(t, tp.ForceFlush(t.Context()))
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(t.Context(), &rm))
+	require.Len(t, rm.ScopeMetrics, 1)
+
+	var names []string
+	for _, m := range rm.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		otelconv.SDKSpanLive{}.Name(),
+		otelconv.SDKSpanStarted{}.Name(),
+		otelconv.SDKProcessorSpanProcessed{}.Name(),
+		otelconv.SDKProcessorSpanQueueCapacity{}.Name(),
+		otelconv.SDKProcessorSpanQueueSize{}.Name(),
+	}, names)
+	assert.Empty(t, handler.errs)
+}
+
+func TestWithSelfObservabilityErrorsHandled(t *testing.T) {
+	handler.Reset()
+	tp := NewTracerProvider(
+		WithSelfObservability(&errMeterProvider{err: assert.AnError}),
+		WithSpanProcessor(NewSimpleSpanProcessor(NewTestExporter())),
+	)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	require.Len(t, handler.errs, 1)
+	assert.ErrorIs(t, handler.errs[0], assert.AnError)
+}
+
 type errMeterProvider struct {
 	metric.MeterProvider
 
@@ -583,3 +639,169 @@ func TestExperimentalOptionSafe(t *testing.T) {
 
 	assert.NotPanics(t, func() { _ = NewTracerProvider(opt) })
 }
+
+func TestTracerProviderScopeCache(t *testing.T) {
+	cache := instrumentation.NewScopeCache()
+	cached := cache.Intern(instrumentation.Scope{Name: strings.Clone("scope")})
+
+	p := NewTracerProvider(WithScopeCache(cache))
+	got := p.Tracer(strings.Clone("scope")).(*tracer).instrumentationScope
+	assert.Equal(t, cached, got)
+	assert.Same(t, unsafe.StringData(cached.Name), unsafe.StringData(got.Name), "scope name not shared")
+
+	_ = p.Tracer("other")
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestWithSharedResource(t *testing.T) {
+	t.Setenv("OTEL_RESOURCE_ATTRIBUTES", "key=value")
+	res := resource.NewSchemaless(attribute.String("service.name", "shared"))
+
+	p := NewTracerProvider(WithSharedResource(res))
+	assert.Same(t, res, p.resource.Load().base)
+
+	p = NewTracerProvider(WithSharedResource(nil))
+	assert.Equal(t, resource.Default(), p.resource.Load().base)
+}
+
+func TestNewTracerProviderWithErrors(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		sp := &basicSpanProcessor{}
+		tp, err := NewTracerProviderWithErrors(
+			WithSpanProcessor(sp),
+			WithBatcher(&marshalingSpanExporter{}),
+			WithSampler(AlwaysSample()),
+			WithIDGenerator(defaultIDGenerator()),
+		)
+		require.NoError(t, err)
+		require.NotNil(t, tp)
+		assert.False(t, sp.closed, "SpanProcessor shut down")
+		assert.NoError(t, tp.Shutdown(t.Context()))
+	})
+
+	tests := []struct {
+		name string
+		opt  TracerProviderOption
+		want []string
+	}{
+		{
+			name: "NilSpanProcessor",
+			opt:  WithSpanProcessor(nil),
+			want: []string{"nil SpanProcessor"},
+		},
+		{
+			name: "NilSampler",
+			opt:  WithSampler(nil),
+			want: []string{"nil Sampler"},
+		},
+		{
+			name: "NilIDGenerator",
+			opt:  WithIDGenerator(nil),
+			want: []string{"nil IDGenerator"},
+		},
+		{
+			name: "NilTraceStateHook",
+			opt:  WithTraceStateHook(nil),
+			want: []string{"nil TraceStateHook"},
+		},
+		{
+			name: "BatcherNilExporter",
+			opt:  WithBatcher(nil),
+			want: []string{"BatchSpanProcessor", "nil exporter"},
+		},
+		{
+			name: "BatcherInvalidOptions",
+			opt: WithBatcher(
+				&marshalingSpanExporter{},
+				WithMaxExportBatchSize(0),
+				WithBatchTimeout(-time.Second),
+				WithExportTimeout(-time.Second),
+			),
+			want: []string{
+				"non-positive MaxExportBatchSize: 0",
+				"non-positive BatchTimeout: -1s",
+				"negative ExportTimeout: -1s",
+			},
+		},
+		{
+			name: "BatcherBatchGreaterThanQueue",
+			opt: WithBatcher(
+				&marshalingSpanExporter{},
+				WithMaxQueueSize(10),
+				WithMaxExportBatchSize(20),
+			),
+			want: []string{"MaxExportBatchSize 20 greater than MaxQueueSize 10"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sp := &basicSpanProcessor{}
+			tp, err := NewTracerProviderWithErrors(WithSpanProcessor(sp), tt.opt)
+			require.ErrorIs(t, err, ErrInvalidConfig)
+			assert.Nil(t, tp)
+			for _, want := range tt.want {
+				assert.ErrorContains(t, err, want)
+			}
+			assert.True(t, sp.closed, "SpanProcessor not shut down")
+		})
+	}
+
+	t.Run("Multiple", func(t *testing.T) {
+		_, err := NewTracerProviderWithErrors(WithSampler(nil), WithIDGenerator(nil))
+		assert.ErrorContains(t, err, "nil Sampler")
+		assert.ErrorContains(t, err, "nil IDGenerator")
+	})
+}
+
+func TestNewTracerProviderIgnoresInvalidOptions(t *testing.T) {
+	tp := NewTracerProvider(WithSampler(nil), WithIDGenerator(nil))
+	assert.NotNil(t, tp.sampler)
+	assert.NotNil(t, tp.idGenerator)
+}
+
+func TestWithTraceStateHook(t *testing.T) {
+	var decisions []SamplingDecision
+	rate := func(_ SamplingParameters, r SamplingResult) trace.TraceState {
+		decisions = append(decisions, r.Decision)
+		ts, err := r.Tracestate.Insert("vendor", "r:0.5")
+		if err != nil {
+			return r.Tracestate
+		}
+		return ts
+	}
+	// The hooks are called in order.
+	count := func(_ SamplingParameters, r SamplingResult) trace.TraceState {
+		n := len(r.Tracestate.Get("count"))
+		ts, err := r.Tracestate.Insert("count", strings.Repeat("x", n+1))
+		if err != nil {
+			return r.Tracestate
+		}
+		return ts
+	}
+
+	tp := NewTracerProvider(
+		WithSampler(ParentBased(AlwaysSample())),
+		WithTraceStateHook(rate),
+		WithTraceStateHook(count),
+	)
+	tracer := tp.Tracer(t.Name())
+
+	_, root := tracer.Start(t.Context(), "root")
+	parent := root.SpanContext()
+	assert.Equal(t, "count=x,vendor=r:0.5", parent.TraceState().String())
+
+	// The entries of the parent TraceState are updated for the child spans.
+	ctx := trace.ContextWithSpanContext(t.Context(), parent)
+	_, child := tracer.Start(ctx, "child")
+	assert.True(t, child.IsRecording())
+	assert.Equal(t, "count=xx,vendor=r:0.5", child.SpanContext().TraceState().String())
+
+	// The TraceState of the non-recording spans is updated.
+	ctx = trace.ContextWithSpanContext(t.Context(), parent.WithTraceFlags(0))
+	_, nonRecording := tracer.Start(ctx, "non-recording")
+	assert.False(t, nonRecording.IsRecording())
+	assert.Equal(t, "count=xx,vendor=r:0.5", nonRecording.SpanContext().TraceState().String())
+
+	assert.Equal(t, Drop, decisions[len(decisions)-1])
+	assert.Equal(t, RecordAndSample, decisions[len(decisions)-2])
+}
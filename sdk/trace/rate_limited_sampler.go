@@ -0,0 +1,49 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RateLimited returns a Sampler that samples at most spansPerSecond spans per
+// second.
+//
+// The decisions are made with a token bucket holding up to spansPerSecond
+// tokens, and at least one. The bucket starts full and is refilled at a rate
+// of spansPerSecond tokens per second. A span is sampled if a token is
+// available when it starts. Unlike [TraceIDRatioBased], the number of spans
+// sampled does not grow with the traffic, which keeps the telemetry within
+// backend quotas during traffic spikes.
+//
+// The sampler is intended to be used as the root sampler of [ParentBased] so
+// complete traces are sampled. If spansPerSecond is not positive, no spans
+// are sampled.
+func RateLimited(spansPerSecond float64) Sampler {
+	return newRateLimited(spansPerSecond, time.Now)
+}
+
+func newRateLimited(spansPerSecond float64, now func() time.Time) *rateLimited {
+	return &rateLimited{rate: spansPerSecond, bucket: newTokenBucket(spansPerSecond, now)}
+}
+
+type rateLimited struct {
+	rate   float64
+	bucket *tokenBucket
+}
+
+func (s *rateLimited) ShouldSample(p SamplingParameters) SamplingResult {
+	psc := trace.SpanContextFromContext(p.ParentContext)
+	if s.bucket.take() {
+		return SamplingResult{Decision: RecordAndSample, Tracestate: psc.TraceState()}
+	}
+	return SamplingResult{Decision: Drop, Tracestate: psc.TraceState()}
+}
+
+func (s *rateLimited) Description() string {
+	return fmt.Sprintf("RateLimited{%g}", s.rate)
+}
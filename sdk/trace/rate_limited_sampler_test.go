@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestRateLimited(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newRateLimited(2, func() time.Time { return now })
+	p := SamplingParameters{ParentContext: t.Context(), TraceID: trace.TraceID{1}}
+
+	decisions := func(n int) []SamplingDecision {
+		var out []SamplingDecision
+		for range n {
+			out = append(out, s.ShouldSample(p).Decision)
+		}
+		return out
+	}
+
+	assert.Equal(t, []SamplingDecision{RecordAndSample, RecordAndSample, Drop}, decisions(3), "burst")
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, []SamplingDecision{RecordAndSample, Drop}, decisions(2), "refill")
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, []SamplingDecision{RecordAndSample, RecordAndSample, Drop}, decisions(3), "balance capped")
+}
+
+func TestRateLimitedFractional(t *testing.T) {
+	now := time.Unix(0, 0)
+	s := newRateLimited(0.5, func() time.Time { return now })
+	p := SamplingParameters{ParentContext: t.Context()}
+
+	assert.Equal(t, RecordAndSample, s.ShouldSample(p).Decision)
+	now = now.Add(time.Second)
+	assert.Equal(t, Drop, s.ShouldSample(p).Decision)
+	now = now.Add(time.Second)
+	assert.Equal(t, RecordAndSample, s.ShouldSample(p).Decision)
+}
+
+func TestRateLimitedNotPositive(t *testing.T) {
+	p := SamplingParameters{ParentContext: t.Context()}
+	assert.Equal(t, Drop, RateLimited(0).ShouldSample(p).Decision)
+	assert.Equal(t, Drop, RateLimited(-1).ShouldSample(p).Decision)
+}
+
+func TestRateLimitedDescription(t *testing.T) {
+	assert.Equal(t, "RateLimited{2.5}", RateLimited(2.5).Description())
+	assert.Equal(t,
+		"ParentBased{root:RateLimited{10},remoteParentSampled:AlwaysOnSampler,remoteParentNotSampled:AlwaysOffSampler,localParentSampled:AlwaysOnSampler,localParentNotSampled:AlwaysOffSampler}",
+		ParentBased(RateLimited(10)).Description(),
+	)
+}
+
+func TestRateLimitedTraceState(t *testing.T) {
+	ts, err := trace.ParseTraceState("k=v")
+	if !assert.NoError(t, err) {
+		return
+	}
+	psc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceState: ts,
+	})
+	p := SamplingParameters{ParentContext: trace.ContextWithSpanContext(t.Context(), psc)}
+	assert.Equal(t, ts, RateLimited(1).ShouldSample(p).Tracestate)
+}
@@ -0,0 +1,120 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// spanResource is the Resource spans are associated with.
+//
+// Spans capture the spanResource of their TracerProvider when they are
+// started and report it for their whole lifetime, including when they are
+// exported. If the Resource is detected in the background (see
+// WithLazyResource), spans started before the detection completes report the
+// detected Resource once it is available. If the Resource is refreshing (see
+// WithRefreshingResource), spans report its current value when read.
+type spanResource struct {
+	// base is the Resource configured for the TracerProvider.
+	base *resource.Resource
+	// lazy is the background detection of the Resource, if any.
+	lazy *lazyResource
+	// refreshing is the Resource evaluated when read, if any.
+	refreshing *resource.Refreshing
+}
+
+// get returns the Resource. A nil spanResource returns a nil Resource.
+//
+// If the Resource is detected in the background and the detection has not
+// completed, this waits for the detection until the configured maximum wait
+// elapses and then returns the base Resource.
+func (r *spanResource) get() *resource.Resource {
+	if r == nil {
+		return nil
+	}
+	if r.refreshing != nil {
+		return r.refreshing.Resource()
+	}
+	if r.lazy == nil {
+		return r.base
+	}
+	return r.lazy.get(r.base)
+}
+
+// lazyResource is a Resource that is detected in the background.
+type lazyResource struct {
+	// done is closed when res is set.
+	done chan struct{}
+	res  *resource.Resource
+
+	// deadline is the time until which a lookup blocks for the detection to
+	// complete.
+	deadline time.Time
+	cancel   context.CancelFunc
+}
+
+// newLazyResource starts the detection of a Resource using opts in the
+// background. The detected Resource is merged with base. Lookups made before
+// the detection completes block until maxWait has elapsed from now.
+func newLazyResource(base *resource.Resource, maxWait time.Duration, opts []resource.Option) *lazyResource {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &lazyResource{
+		done:     make(chan struct{}),
+		deadline: time.Now().Add(maxWait),
+		cancel:   cancel,
+	}
+	go func() {
+		defer close(l.done)
+		defer cancel()
+
+		l.res = base
+		detected, err := resource.New(ctx, opts...)
+		if err != nil {
+			otel.Handle(err)
+		}
+		if detected == nil {
+			return
+		}
+		merged, err := resource.Merge(base, detected)
+		if err != nil {
+			otel.Handle(err)
+		}
+		if merged != nil {
+			l.res = merged
+		}
+	}()
+	return l
+}
+
+// get returns the detected Resource. If the detection has not completed, it
+// waits until the lookup deadline at most and then returns fallback.
+func (l *lazyResource) get(fallback *resource.Resource) *resource.Resource {
+	select {
+	case <-l.done:
+		return l.res
+	default:
+	}
+
+	wait := time.Until(l.deadline)
+	if wait <= 0 {
+		return fallback
+	}
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-l.done:
+		return l.res
+	case <-t.C:
+		return fallback
+	}
+}
+
+// stop cancels the detection if it has not completed.
+func (l *lazyResource) stop() {
+	l.cancel()
+}
@@ -0,0 +1,112 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// maxMergedResources is the maximum number of merged Resources cached by a
+// ResourceAugmentingExporter.
+const maxMergedResources = 64
+
+// ResourceAttributesFunc returns the attributes added to the Resource of the
+// spans when they are exported, e.g. the current availability zone of a
+// virtual machine that can be live-migrated. It is called once per export and
+// must not block: attributes that are slow to get should be refreshed in the
+// background.
+type ResourceAttributesFunc func(ctx context.Context) []attribute.KeyValue
+
+// ResourceAugmentingExporter is a SpanExporter that adds late-bound attributes
+// to the Resource of the spans before passing them to another SpanExporter.
+// The attributes are got when the spans are exported, so their values are
+// current without rebuilding the TracerProvider.
+//
+// The Resource of the spans is not modified: the spans are exported with a
+// new Resource merging their Resource and the attributes, the attributes
+// taking precedence. The merged Resources are cached until the attributes
+// change.
+//
+// Use [NewResourceAugmentingExporter] to create a ResourceAugmentingExporter.
+type ResourceAugmentingExporter struct {
+	exporter SpanExporter
+	attrs    ResourceAttributesFunc
+
+	mu sync.Mutex
+	// set is the set of the attributes the Resources of merged are merged
+	// with.
+	set attribute.Set
+	// merged are the merged Resources by Resource of the spans.
+	merged map[*resource.Resource]*resource.Resource
+}
+
+var _ SpanExporter = (*ResourceAugmentingExporter)(nil)
+
+// NewResourceAugmentingExporter returns a new ResourceAugmentingExporter that
+// exports the spans with exporter, with the attributes returned by attrs
+// added to their Resource. If attrs is nil, the spans are exported unchanged.
+func NewResourceAugmentingExporter(exporter SpanExporter, attrs ResourceAttributesFunc) *ResourceAugmentingExporter {
+	return &ResourceAugmentingExporter{exporter: exporter, attrs: attrs}
+}
+
+// ExportSpans exports spans with the wrapped SpanExporter, with the
+// attributes returned by the ResourceAttributesFunc added to their Resource.
+func (e *ResourceAugmentingExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if e.attrs == nil || len(spans) == 0 {
+		return e.exporter.ExportSpans(ctx, spans)
+	}
+	set := attribute.NewSet(e.attrs(ctx)...)
+	if set.Len() == 0 {
+		return e.exporter.ExportSpans(ctx, spans)
+	}
+
+	augmented := make([]ReadOnlySpan, len(spans))
+	e.mu.Lock()
+	if !set.Equals(&e.set) || e.merged == nil || len(e.merged) >= maxMergedResources {
+		e.set = set
+		e.merged = make(map[*resource.Resource]*resource.Resource)
+	}
+	for i, s := range spans {
+		augmented[i] = resourceSpan{ReadOnlySpan: s, res: e.merge(s.Resource())}
+	}
+	e.mu.Unlock()
+	return e.exporter.ExportSpans(ctx, augmented)
+}
+
+// merge returns res merged with the attributes of e. It must be called with
+// e.mu held.
+func (e *ResourceAugmentingExporter) merge(res *resource.Resource) *resource.Resource {
+	if m, ok := e.merged[res]; ok {
+		return m
+	}
+	m, err := resource.Merge(res, resource.NewSchemaless(e.set.ToSlice()...))
+	if err != nil {
+		// The schema URLs cannot conflict, the attributes have none.
+		otel.Handle(err)
+		m = res
+	}
+	e.merged[res] = m
+	return m
+}
+
+// Shutdown shuts down the wrapped SpanExporter.
+func (e *ResourceAugmentingExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// resourceSpan is a ReadOnlySpan with a replaced Resource.
+type resourceSpan struct {
+	ReadOnlySpan
+	res *resource.Resource
+}
+
+// Resource returns the replaced Resource of the span.
+func (s resourceSpan) Resource() *resource.Resource {
+	return s.res
+}
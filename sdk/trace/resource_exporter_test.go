@@ -0,0 +1,92 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// resourceRecordingExporter records the Resources of the spans it exports.
+type resourceRecordingExporter struct {
+	resources []*resource.Resource
+	shutdown  bool
+}
+
+func (e *resourceRecordingExporter) ExportSpans(_ context.Context, spans []ReadOnlySpan) error {
+	for _, s := range spans {
+		e.resources = append(e.resources, s.Resource())
+	}
+	return nil
+}
+
+func (e *resourceRecordingExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func TestResourceAugmentingExporter(t *testing.T) {
+	zone := "zone-a"
+	next := &resourceRecordingExporter{}
+	exp := NewResourceAugmentingExporter(next, func(context.Context) []attribute.KeyValue {
+		if zone == "" {
+			return nil
+		}
+		return []attribute.KeyValue{attribute.String("cloud.availability_zone", zone)}
+	})
+
+	res := resource.NewSchemaless(
+		attribute.String("service.name", "svc"),
+		attribute.String("cloud.availability_zone", "zone-0"),
+	)
+	tp := NewTracerProvider(WithResource(res), WithSyncer(exp))
+	export := func() {
+		for range 2 {
+			_, s := tp.Tracer("test").Start(t.Context(), "span")
+			s.End()
+		}
+	}
+	zoneOf := func(r *resource.Resource) string {
+		v, _ := r.Set().Value("cloud.availability_zone")
+		return v.AsString()
+	}
+
+	export()
+	require.Len(t, next.resources, 2)
+	assert.Equal(t, "zone-a", zoneOf(next.resources[0]), "attribute precedence")
+	v, _ := next.resources[0].Set().Value("service.name")
+	assert.Equal(t, "svc", v.AsString())
+	assert.Same(t, next.resources[0], next.resources[1], "merged resource cached")
+
+	zone = "zone-b"
+	export()
+	require.Len(t, next.resources, 4)
+	assert.Equal(t, "zone-b", zoneOf(next.resources[2]), "late-bound attribute")
+
+	zone = ""
+	export()
+	require.Len(t, next.resources, 6)
+	assert.Equal(t, "zone-0", zoneOf(next.resources[4]), "unchanged")
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.True(t, next.shutdown)
+}
+
+func TestResourceAugmentingExporterNilFunc(t *testing.T) {
+	next := &resourceRecordingExporter{}
+	exp := NewResourceAugmentingExporter(next, nil)
+	res := resource.NewSchemaless(attribute.String("service.name", "svc"))
+	tp := NewTracerProvider(WithResource(res), WithSyncer(exp))
+	_, s := tp.Tracer("test").Start(t.Context(), "span")
+	s.End()
+
+	require.Len(t, next.resources, 1)
+	assert.True(t, next.resources[0].Equal(tp.getResource()))
+}
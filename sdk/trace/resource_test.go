@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// blockingDetector detects a Resource with attrs once release is closed.
+type blockingDetector struct {
+	release chan struct{}
+	attrs   []attribute.KeyValue
+}
+
+func (d blockingDetector) Detect(ctx context.Context) (*resource.Resource, error) {
+	select {
+	case <-d.release:
+		return resource.NewSchemaless(d.attrs...), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestWithLazyResource(t *testing.T) {
+	base := resource.NewSchemaless(attribute.String("base", "value"))
+	det := blockingDetector{
+		release: make(chan struct{}),
+		attrs:   []attribute.KeyValue{attribute.String("detected", "value")},
+	}
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithResource(base),
+		WithLazyResource(0, resource.WithDetectors(det)),
+	)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "before")
+	span.End()
+	require.Equal(t, 1, te.Len())
+	before := te.Spans()[0]
+
+	// Detection has not completed and no wait is configured.
+	assert.Equal(t, tp.resource.Load().base, before.Resource())
+
+	close(det.release)
+	require.Eventually(t, func() bool {
+		_, ok := before.Resource().Set().Value("detected")
+		return ok
+	}, time.Second, time.Millisecond, "span resource not resolved after detection")
+
+	got := before.Resource()
+	v, ok := got.Set().Value("base")
+	assert.True(t, ok, "base resource not merged")
+	assert.Equal(t, "value", v.AsString())
+
+	_, span = tp.Tracer(t.Name()).Start(t.Context(), "after")
+	span.End()
+	assert.Equal(t, got, te.Spans()[1].Resource())
+}
+
+func TestWithLazyResourceWaits(t *testing.T) {
+	release := make(chan struct{})
+	close(release)
+	det := blockingDetector{
+		release: release,
+		attrs:   []attribute.KeyValue{attribute.String("detected", "value")},
+	}
+
+	tp := NewTracerProvider(WithLazyResource(time.Minute, resource.WithDetectors(det)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	ro, ok := span.(ReadOnlySpan)
+	require.True(t, ok)
+
+	// Blocks until the detection is complete.
+	_, ok = ro.Resource().Set().Value("detected")
+	assert.True(t, ok, "resource not detected")
+}
+
+func TestWithLazyResourceError(t *testing.T) {
+	errDetector := resource.StringDetector("", "key", func() (string, error) {
+		return "", errors.New("detection failed")
+	})
+
+	tp := NewTracerProvider(WithLazyResource(time.Minute, resource.WithDetectors(errDetector)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	assert.Equal(t, resource.Default(), tp.getResource())
+}
+
+func TestWithLazyResourceShutdown(t *testing.T) {
+	det := blockingDetector{release: make(chan struct{})}
+	tp := NewTracerProvider(WithLazyResource(0, resource.WithDetectors(det)))
+	require.NoError(t, tp.Shutdown(t.Context()))
+
+	select {
+	case <-tp.resource.Load().lazy.done:
+	case <-time.After(time.Second):
+		t.Fatal("detection not stopped by shutdown")
+	}
+	assert.Equal(t, resource.Default(), tp.getResource())
+}
+
+func TestSpanResourcePinnedAtStart(t *testing.T) {
+	original := resource.NewSchemaless(attribute.String("version", "original"))
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithResource(original))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+
+	// Swap the TracerProvider resource while the span is in progress.
+	swapped := resource.NewSchemaless(attribute.String("version", "swapped"))
+	tp.resource.Store(&spanResource{base: swapped})
+
+	ro, ok := span.(ReadOnlySpan)
+	require.True(t, ok)
+	assert.Equal(t, original, ro.Resource(), "in-progress span")
+
+	span.End()
+	require.Equal(t, 1, te.Len())
+	assert.Equal(t, original, te.Spans()[0].Resource(), "exported span")
+
+	_, span = tp.Tracer(t.Name()).Start(t.Context(), "after")
+	span.End()
+	assert.Equal(t, swapped, te.Spans()[1].Resource(), "span started after swap")
+}
+
+// stateDetector detects the "state" attribute with the current value of state.
+type stateDetector struct {
+	state *string
+}
+
+func (d stateDetector) Detect(context.Context) (*resource.Resource, error) {
+	return resource.NewSchemaless(attribute.String("state", *d.state)), nil
+}
+
+func TestWithRefreshingResource(t *testing.T) {
+	state := "running"
+	base := resource.NewSchemaless(attribute.String("base", "value"))
+	r := resource.NewRefreshing(base, 0, stateDetector{state: &state})
+
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithResource(resource.NewSchemaless(attribute.String("ignored", "value"))),
+		WithRefreshingResource(r),
+	)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.End()
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+
+	want := resource.NewSchemaless(
+		attribute.String("base", "value"),
+		attribute.String("state", "running"),
+	)
+	assert.Equal(t, want, got.Resource())
+
+	state = "terminating"
+	v, ok := got.Resource().Set().Value("state")
+	assert.True(t, ok)
+	assert.Equal(t, "terminating", v.AsString(), "resource not refreshed when read")
+	_, ok = got.Resource().Set().Value("ignored")
+	assert.False(t, ok, "WithResource took precedence")
+}
@@ -0,0 +1,121 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+)
+
+// RoutingSpanProcessor is a SpanProcessor that routes each span to one of
+// several SpanProcessors based on the context the span is started with.
+//
+// It can be used to send the telemetry of a request to an exporter selected by
+// a value propagated in the context. For example, to send spans to the
+// exporter of the data-residency region of a request.
+//
+// Use [NewRoutingSpanProcessor] to create a RoutingSpanProcessor.
+type RoutingSpanProcessor struct {
+	key      func(context.Context) string
+	routes   map[string]SpanProcessor
+	fallback SpanProcessor
+
+	// route holds the SpanProcessor a span is routed to on the span itself,
+	// so the routes of the spans that are never ended are released with
+	// them.
+	route    SpanStateKey[SpanProcessor]
+	shutdown atomic.Bool
+}
+
+var _ SpanProcessor = (*RoutingSpanProcessor)(nil)
+
+// NewRoutingSpanProcessor returns a new RoutingSpanProcessor.
+//
+// When a span is started, key is called with the context the span is started
+// with and the span is routed to the SpanProcessor of routes for the returned
+// key. If routes does not contain the key, the span is routed to fallback. If
+// fallback is nil, spans not matching any route are not processed. Only the
+// spans of this SDK can be routed.
+//
+// All the events of a span are handled by the SpanProcessor it is routed to
+// when it is started. Shutdown and ForceFlush are called for the SpanProcessor
+// of every route and for fallback. A SpanProcessor used for multiple routes
+// needs to handle being shut down multiple times.
+func NewRoutingSpanProcessor(
+	key func(context.Context) string,
+	routes map[string]SpanProcessor,
+	fallback SpanProcessor,
+) *RoutingSpanProcessor {
+	r := make(map[string]SpanProcessor, len(routes))
+	for k, sp := range routes {
+		if sp != nil {
+			r[k] = sp
+		}
+	}
+	return &RoutingSpanProcessor{
+		key:      key,
+		routes:   r,
+		fallback: fallback,
+		route:    NewSpanStateKey[SpanProcessor](),
+	}
+}
+
+// lookup returns the SpanProcessor for ctx, or nil if there is none.
+func (p *RoutingSpanProcessor) lookup(ctx context.Context) SpanProcessor {
+	if p.key != nil {
+		if sp, ok := p.routes[p.key(ctx)]; ok {
+			return sp
+		}
+	}
+	return p.fallback
+}
+
+// OnStart routes s to the SpanProcessor for ctx.
+func (p *RoutingSpanProcessor) OnStart(ctx context.Context, s ReadWriteSpan) {
+	if p.shutdown.Load() {
+		return
+	}
+	sp := p.lookup(ctx)
+	if sp == nil {
+		return
+	}
+	p.route.Set(s, sp)
+	sp.OnStart(ctx, s)
+}
+
+// OnEnd passes s to the SpanProcessor it was routed to when started.
+func (p *RoutingSpanProcessor) OnEnd(s ReadOnlySpan) {
+	if p.shutdown.Load() {
+		return
+	}
+	if sp, ok := p.route.Get(s); ok {
+		sp.OnEnd(s)
+	}
+}
+
+// Shutdown shuts down all the SpanProcessors spans are routed to. The spans
+// started before and ended after Shutdown are not passed to them.
+func (p *RoutingSpanProcessor) Shutdown(ctx context.Context) error {
+	p.shutdown.Store(true)
+	return p.each(func(sp SpanProcessor) error { return sp.Shutdown(ctx) })
+}
+
+// ForceFlush flushes all the SpanProcessors spans are routed to.
+func (p *RoutingSpanProcessor) ForceFlush(ctx context.Context) error {
+	return p.each(func(sp SpanProcessor) error { return sp.ForceFlush(ctx) })
+}
+
+// each calls f for the SpanProcessor of every route and the fallback and
+// returns the joined errors.
+func (p *RoutingSpanProcessor) each(f func(SpanProcessor) error) error {
+	var err error
+	for _, sp := range p.routes {
+		err = errors.Join(err, f(sp))
+	}
+	if p.fallback != nil {
+		err = errors.Join(err, f(p.fallback))
+	}
+	return err
+}
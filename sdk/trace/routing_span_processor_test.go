@@ -0,0 +1,107 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type regionKey struct{}
+
+func region(ctx context.Context) string {
+	r, _ := ctx.Value(regionKey{}).(string)
+	return r
+}
+
+func TestRoutingSpanProcessor(t *testing.T) {
+	eu := &testSpanProcessor{name: "eu"}
+	us := &testSpanProcessor{name: "us"}
+	fallback := &testSpanProcessor{name: "fallback"}
+	p := NewRoutingSpanProcessor(region, map[string]SpanProcessor{"eu": eu, "us": us}, fallback)
+
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	tracer := tp.Tracer(t.Name())
+
+	ctx := context.WithValue(t.Context(), regionKey{}, "eu")
+	ctx, parent := tracer.Start(ctx, "eu")
+	// Spans are routed when started even if the context changes afterwards.
+	_, child := tracer.Start(context.WithValue(ctx, regionKey{}, "us"), "us")
+	_, other := tracer.Start(t.Context(), "other")
+	child.End()
+	parent.End()
+	other.End()
+
+	names := func(spans []ReadOnlySpan) []string {
+		var out []string
+		for _, s := range spans {
+			out = append(out, s.Name())
+		}
+		return out
+	}
+	assert.Equal(t, []string{"eu"}, names(eu.spansEnded))
+	assert.Equal(t, []string{"us"}, names(us.spansEnded))
+	assert.Equal(t, []string{"other"}, names(fallback.spansEnded))
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.Equal(t, 1, eu.shutdownCount)
+	assert.Equal(t, 1, us.shutdownCount)
+	assert.Equal(t, 1, fallback.shutdownCount)
+}
+
+func TestRoutingSpanProcessorNoFallback(t *testing.T) {
+	eu := &testSpanProcessor{name: "eu"}
+	p := NewRoutingSpanProcessor(region, map[string]SpanProcessor{"eu": eu}, nil)
+
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.End()
+
+	assert.Empty(t, eu.spansStarted)
+	assert.Empty(t, eu.spansEnded)
+}
+
+// countingSpanProcessor counts the spans it processes without holding them.
+type countingSpanProcessor struct {
+	started, ended int
+}
+
+func (p *countingSpanProcessor) OnStart(context.Context, ReadWriteSpan) { p.started++ }
+func (p *countingSpanProcessor) OnEnd(ReadOnlySpan)                     { p.ended++ }
+func (*countingSpanProcessor) Shutdown(context.Context) error           { return nil }
+func (*countingSpanProcessor) ForceFlush(context.Context) error         { return nil }
+
+func TestRoutingSpanProcessorRouteHeldBySpan(t *testing.T) {
+	sp := &countingSpanProcessor{}
+	p := NewRoutingSpanProcessor(region, nil, sp)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	got, ok := p.route.Get(span.(ReadOnlySpan))
+	require.True(t, ok)
+	assert.Same(t, sp, got)
+
+	span.End()
+	span.End() // Ending a span twice passes it once.
+	assert.Equal(t, 1, sp.ended)
+}
+
+func TestRoutingSpanProcessorShutdown(t *testing.T) {
+	sp := &countingSpanProcessor{}
+	p := NewRoutingSpanProcessor(region, nil, sp)
+	tp := NewTracerProvider(WithSpanProcessor(p))
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	require.NoError(t, p.Shutdown(t.Context()))
+
+	span.End()
+	assert.Equal(t, 0, sp.ended)
+	require.NoError(t, tp.Shutdown(t.Context()))
+}
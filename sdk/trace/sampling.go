@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -30,6 +32,10 @@ type Sampler interface {
 }
 
 // SamplingParameters contains the values passed to a Sampler.
+//
+// Attributes and Links share the slices passed to the Tracer when the span
+// is started, they are not copied for each span. A Sampler must not modify
+// them.
 type SamplingParameters struct {
 	ParentContext context.Context
 	TraceID       trace.TraceID
@@ -37,6 +43,20 @@ type SamplingParameters struct {
 	Kind          trace.SpanKind
 	Attributes    []attribute.KeyValue
 	Links         []trace.Link
+
+	// resource is the Resource of the TracerProvider starting the span.
+	resource *spanResource
+}
+
+// Resource returns the Resource of the TracerProvider starting the span, e.g.
+// for a Sampler keyed on the deployment environment. It returns nil if the
+// SamplingParameters were not created by a TracerProvider.
+//
+// The Resource is only resolved when Resource is called. If the Resource is
+// detected in the background (see WithLazyResource), this waits for the
+// detection the same as the spans do.
+func (p SamplingParameters) Resource() *resource.Resource {
+	return p.resource.get()
 }
 
 // SamplingDecision indicates whether a span is dropped, recorded and/or sampled.
@@ -61,6 +81,12 @@ type SamplingResult struct {
 	Decision   SamplingDecision
 	Attributes []attribute.KeyValue
 	Tracestate trace.TraceState
+
+	// AttributeSet holds attributes added to the span in addition to
+	// Attributes. Samplers returning the same attributes for many spans can
+	// build the Set once and return it to avoid building a slice for each
+	// span. The attributes of AttributeSet are added before Attributes.
+	AttributeSet attribute.Set
 }
 
 type traceIDRatioSampler struct {
@@ -335,3 +361,120 @@ func (ar alwaysRecord) ShouldSample(p SamplingParameters) SamplingResult {
 func (ar alwaysRecord) Description() string {
 	return "AlwaysRecord{root:" + ar.root.Description() + "}"
 }
+
+// SamplingPriorityKey is the attribute key instrumentation can provide as a
+// span start attribute (see [trace.WithAttributes]) to hint the sampling
+// decision of a span to a [PriorityBased] sampler.
+//
+// A positive integer value forces the span to be sampled and a zero value
+// forces the span to be dropped. Any other value, or a value that is not an
+// integer, is ignored.
+const SamplingPriorityKey = attribute.Key("sampling.priority")
+
+// SamplingPriority returns a [SamplingPriorityKey] attribute with priority
+// as its value.
+func SamplingPriority(priority int) attribute.KeyValue {
+	return SamplingPriorityKey.Int(priority)
+}
+
+// PriorityBased returns a sampler decorator that honors the
+// [SamplingPriorityKey] attribute provided when a span is started. If the
+// attribute has a positive value, the span is sampled. If it has a zero value,
+// the span is dropped. Otherwise, the decision is delegated to root.
+//
+// The sampling priority overrides any decision root would make, including
+// decisions based on the parent of the span. To honor the sampling priority of
+// only root spans, use PriorityBased as the root sampler of [ParentBased]
+// instead.
+func PriorityBased(root Sampler) Sampler {
+	return priorityBased{root: root}
+}
+
+type priorityBased struct {
+	root Sampler
+}
+
+func (pb priorityBased) ShouldSample(p SamplingParameters) SamplingResult {
+	// Search in reverse so the last value wins for duplicate keys, matching
+	// the attribute de-duplication of the span.
+	for i := len(p.Attributes) - 1; i >= 0; i-- {
+		attr := p.Attributes[i]
+		if attr.Key != SamplingPriorityKey {
+			continue
+		}
+		if attr.Value.Type() != attribute.INT64 {
+			break
+		}
+
+		switch v := attr.Value.AsInt64(); {
+		case v > 0:
+			return SamplingResult{
+				Decision:   RecordAndSample,
+				Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+			}
+		case v == 0:
+			return SamplingResult{
+				Decision:   Drop,
+				Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+			}
+		}
+		break
+	}
+	return pb.root.ShouldSample(p)
+}
+
+func (pb priorityBased) Description() string {
+	return "PriorityBased{root:" + pb.root.Description() + "}"
+}
+
+// SpanKindBased returns a sampler delegating the sampling decision of a span
+// to the sampler of its kind in samplers, or to def if samplers has no
+// sampler for its kind. Use it to apply different policies to the spans of
+// different kinds, e.g. to sample all consumer spans but a ratio of the
+// server spans:
+//
+//	SpanKindBased(ParentBased(AlwaysSample()), map[trace.SpanKind]Sampler{
+//		trace.SpanKindConsumer: AlwaysSample(),
+//		trace.SpanKindServer:   ParentBased(TraceIDRatioBased(0.1)),
+//	})
+//
+// Spans started without a kind have the [trace.SpanKindInternal] kind. The
+// nil samplers of samplers are ignored. If def is nil, ParentBased with
+// AlwaysSample, the default sampler of a TracerProvider, is used.
+func SpanKindBased(def Sampler, samplers map[trace.SpanKind]Sampler) Sampler {
+	if def == nil {
+		def = ParentBased(AlwaysSample())
+	}
+	sk := spanKindBased{def: def, samplers: make(map[trace.SpanKind]Sampler, len(samplers))}
+	for kind, s := range samplers {
+		if s != nil {
+			sk.samplers[trace.ValidateSpanKind(kind)] = s
+		}
+	}
+	return sk
+}
+
+type spanKindBased struct {
+	def      Sampler
+	samplers map[trace.SpanKind]Sampler
+}
+
+func (sk spanKindBased) ShouldSample(p SamplingParameters) SamplingResult {
+	if s, ok := sk.samplers[trace.ValidateSpanKind(p.Kind)]; ok {
+		return s.ShouldSample(p)
+	}
+	return sk.def.ShouldSample(p)
+}
+
+func (sk spanKindBased) Description() string {
+	var b strings.Builder
+	_, _ = b.WriteString("SpanKindBased{default:")
+	_, _ = b.WriteString(sk.def.Description())
+	for kind := trace.SpanKindInternal; kind <= trace.SpanKindConsumer; kind++ {
+		if s, ok := sk.samplers[kind]; ok {
+			_, _ = fmt.Fprintf(&b, ",%s:%s", kind, s.Description())
+		}
+	}
+	_ = b.WriteByte('}')
+	return b.String()
+}
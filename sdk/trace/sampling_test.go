@@ -11,6 +11,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -319,3 +321,170 @@ func TestDescriptions(t *testing.T) {
 	assert.Equal(t, "TraceIDRatioBased{0}", TraceIDRatioBased(0).Description())
 	assert.Equal(t, "TraceIDRatioBased{0}", TraceIDRatioBased(-0.5).Description())
 }
+
+func TestPriorityBased(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ts, err := trace.ParseTraceState("k=v")
+	require.NoError(t, err)
+	parentCtx := trace.ContextWithSpanContext(
+		t.Context(),
+		trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    traceID,
+			SpanID:     spanID,
+			TraceState: ts,
+		}),
+	)
+
+	testCases := []struct {
+		name  string
+		root  Sampler
+		attrs []attribute.KeyValue
+		want  SamplingDecision
+	}{
+		{
+			name: "NoPriority",
+			root: AlwaysSample(),
+			want: RecordAndSample,
+		},
+		{
+			name:  "PositivePriority",
+			root:  NeverSample(),
+			attrs: []attribute.KeyValue{SamplingPriority(1)},
+			want:  RecordAndSample,
+		},
+		{
+			name:  "ZeroPriority",
+			root:  AlwaysSample(),
+			attrs: []attribute.KeyValue{SamplingPriority(0)},
+			want:  Drop,
+		},
+		{
+			name:  "NegativePriority",
+			root:  RecordingOnly(),
+			attrs: []attribute.KeyValue{SamplingPriority(-1)},
+			want:  RecordOnly,
+		},
+		{
+			name:  "NonIntegerPriority",
+			root:  NeverSample(),
+			attrs: []attribute.KeyValue{SamplingPriorityKey.String("1")},
+			want:  Drop,
+		},
+		{
+			name: "LastValueWins",
+			root: NeverSample(),
+			attrs: []attribute.KeyValue{
+				SamplingPriority(0),
+				attribute.String("key", "value"),
+				SamplingPriority(1),
+			},
+			want: RecordAndSample,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := PriorityBased(tc.root).ShouldSample(SamplingParameters{
+				ParentContext: parentCtx,
+				TraceID:       traceID,
+				Attributes:    tc.attrs,
+			})
+			assert.Equal(t, tc.want, got.Decision)
+			assert.Equal(t, ts, got.Tracestate)
+		})
+	}
+}
+
+func TestPriorityBasedSpan(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithSampler(PriorityBased(ParentBased(NeverSample()))),
+	)
+	tr := tp.Tracer("TestPriorityBasedSpan")
+
+	ctx, parent := tr.Start(t.Context(), "parent", trace.WithAttributes(SamplingPriority(1)))
+	_, child := tr.Start(ctx, "child", trace.WithAttributes(SamplingPriority(0)))
+	child.End()
+	parent.End()
+	_, other := tr.Start(t.Context(), "other")
+	other.End()
+
+	require.Equal(t, 1, te.Len())
+	got := te.Spans()[0]
+	assert.Equal(t, "parent", got.Name())
+	assert.Contains(t, got.Attributes(), SamplingPriority(1))
+}
+
+func TestPriorityBasedDescription(t *testing.T) {
+	assert.Equal(t, "PriorityBased{root:AlwaysOffSampler}", PriorityBased(NeverSample()).Description())
+}
+
+type resourceSampler struct {
+	env string
+}
+
+func (s resourceSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	v, _ := p.Resource().Set().Value("deployment.environment.name")
+	if v.AsString() == s.env {
+		return SamplingResult{Decision: RecordAndSample}
+	}
+	return SamplingResult{Decision: Drop}
+}
+
+func (resourceSampler) Description() string { return "resourceSampler" }
+
+func TestSamplingParametersResource(t *testing.T) {
+	assert.Nil(t, SamplingParameters{}.Resource())
+
+	sampler := resourceSampler{env: "prod"}
+	for _, tc := range []struct {
+		env  string
+		want bool
+	}{
+		{env: "prod", want: true},
+		{env: "staging", want: false},
+	} {
+		res := resource.NewSchemaless(attribute.String("deployment.environment.name", tc.env))
+		tp := NewTracerProvider(WithResource(res), WithSampler(sampler))
+		_, span := tp.Tracer("test").Start(t.Context(), tc.env)
+		assert.Equal(t, tc.want, span.SpanContext().IsSampled(), tc.env)
+		span.End()
+	}
+}
+
+func TestSpanKindBased(t *testing.T) {
+	s := SpanKindBased(NeverSample(), map[trace.SpanKind]Sampler{
+		trace.SpanKindConsumer: AlwaysSample(),
+		trace.SpanKindServer:   RecordingOnly(),
+		trace.SpanKindInternal: AlwaysSample(),
+		trace.SpanKindProducer: nil,
+	})
+
+	for kind, want := range map[trace.SpanKind]SamplingDecision{
+		trace.SpanKindUnspecified: RecordAndSample,
+		trace.SpanKindInternal:    RecordAndSample,
+		trace.SpanKindServer:      RecordOnly,
+		trace.SpanKindClient:      Drop,
+		trace.SpanKindProducer:    Drop,
+		trace.SpanKindConsumer:    RecordAndSample,
+	} {
+		got := s.ShouldSample(SamplingParameters{ParentContext: t.Context(), Kind: kind})
+		assert.Equal(t, want, got.Decision, kind.String())
+	}
+
+	assert.Equal(
+		t,
+		"SpanKindBased{default:AlwaysOffSampler,internal:AlwaysOnSampler,"+
+			"server:RecordingOnly,consumer:AlwaysOnSampler}",
+		s.Description(),
+	)
+}
+
+func TestSpanKindBasedDefault(t *testing.T) {
+	s := SpanKindBased(nil, nil)
+	assert.Equal(t, "SpanKindBased{default:"+ParentBased(AlwaysSample()).Description()+"}", s.Description())
+	got := s.ShouldSample(SamplingParameters{ParentContext: t.Context(), Kind: trace.SpanKindClient})
+	assert.Equal(t, RecordAndSample, got.Decision)
+}
@@ -0,0 +1,317 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultShardRetryInterval is the default duration a shard is not exported
+// to after an export to it failed.
+const defaultShardRetryInterval = 30 * time.Second
+
+// ShardingExporterOption configures a ShardingExporter.
+type ShardingExporterOption interface {
+	applySharding(shardingConfig) shardingConfig
+}
+
+type shardingConfig struct {
+	retryInterval time.Duration
+	transient     func(error) bool
+}
+
+type shardingOptionFunc func(shardingConfig) shardingConfig
+
+func (fn shardingOptionFunc) applySharding(c shardingConfig) shardingConfig {
+	return fn(c)
+}
+
+// WithShardRetryInterval sets the duration a shard is considered unhealthy
+// after an export to it failed with a transient error. The spans of the traces of an unhealthy shard
+// are exported to the other shards until the duration has passed.
+//
+// If d is not positive, the default of 30 seconds is used.
+func WithShardRetryInterval(d time.Duration) ShardingExporterOption {
+	return shardingOptionFunc(func(c shardingConfig) shardingConfig {
+		if d > 0 {
+			c.retryInterval = d
+		}
+		return c
+	})
+}
+
+// WithShardTransientErrors sets the function reporting whether an error
+// returned by the exporter of a shard is transient. Only the shards failing
+// with a transient error are considered unhealthy, the spans are not
+// exported to the other shards for the other errors.
+//
+// By default, the network errors, including timeouts, and the errors
+// implementing a Temporary method returning true are transient. E.g., use
+// this option to make the Unavailable status errors of a gRPC exporter
+// transient. If isTransient is nil, the default is used.
+func WithShardTransientErrors(isTransient func(error) bool) ShardingExporterOption {
+	return shardingOptionFunc(func(c shardingConfig) shardingConfig {
+		if isTransient != nil {
+			c.transient = isTransient
+		}
+		return c
+	})
+}
+
+// isTransientError reports whether err is a network error, including a
+// timeout, or implements a Temporary method returning true.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	var tempErr interface{ Temporary() bool }
+	return errors.As(err, &tempErr) && tempErr.Temporary()
+}
+
+// ShardingExporter is a SpanExporter that shards spans across several
+// SpanExporters by trace ID. All the spans of a trace are exported with the
+// same SpanExporter, e.g. to have all the spans of a trace received by the
+// same collector instance to make tail sampling decisions there. Use an OTLP
+// exporter per collector instance as the shards.
+//
+// Traces are assigned to the shards with rendezvous hashing. The assignment
+// only depends on the trace ID and the position of the shard, so all the
+// processes using the same ordered shards export the spans of a trace to the
+// same shard.
+//
+// When an export to a shard fails with a transient error (see
+// WithShardTransientErrors), e.g. the collector instance is unreachable, the
+// shard is considered unhealthy for the retry interval (see
+// WithShardRetryInterval) and its spans are exported to the shard the traces
+// would be assigned to without it. The other errors, e.g. the spans are
+// rejected as invalid, are returned without exporting the spans to another
+// shard. Only the traces of
+// the unhealthy shard are reassigned, the traces of the other shards keep
+// their shard. If all the shards are unhealthy, the spans are exported to
+// the shards their traces are assigned to regardless of their health.
+//
+// Use [NewShardingExporter] to create a ShardingExporter.
+type ShardingExporter struct {
+	shards        []*exporterShard
+	retryInterval time.Duration
+	transient     func(error) bool
+
+	// now returns the current time. It is replaced in tests.
+	now func() time.Time
+}
+
+var _ SpanExporter = (*ShardingExporter)(nil)
+
+// exporterShard is a SpanExporter of a ShardingExporter.
+type exporterShard struct {
+	// mu serializes the exports with exporter, spans rerouted from another
+	// shard are exported concurrently with the spans of the shard.
+	mu       sync.Mutex
+	exporter SpanExporter
+	// seed is mixed with trace IDs to compute their weight for the shard.
+	seed uint64
+	// unhealthyUntil is the time, in Unix nanoseconds, until which the shard
+	// is considered unhealthy.
+	unhealthyUntil atomic.Int64
+}
+
+// export exports spans with the exporter of the shard.
+func (s *exporterShard) export(ctx context.Context, spans []ReadOnlySpan) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.exporter.ExportSpans(ctx, spans)
+}
+
+// healthy reports whether the shard is healthy at now.
+func (s *exporterShard) healthy(now time.Time) bool {
+	return now.UnixNano() >= s.unhealthyUntil.Load()
+}
+
+// NewShardingExporter returns a new ShardingExporter sharding spans across
+// exporters. The nil exporters are ignored. If exporters is empty, the spans
+// are not exported.
+func NewShardingExporter(exporters []SpanExporter, opts ...ShardingExporterOption) *ShardingExporter {
+	c := shardingConfig{
+		retryInterval: defaultShardRetryInterval,
+		transient:     isTransientError,
+	}
+	for _, o := range opts {
+		c = o.applySharding(c)
+	}
+
+	shards := make([]*exporterShard, 0, len(exporters))
+	for i, e := range exporters {
+		if e == nil {
+			continue
+		}
+		shards = append(shards, &exporterShard{
+			exporter: e,
+			// The seed depends on the position of the exporter passed,
+			// ignoring nil ones would otherwise reassign traces.
+			seed: mix64(uint64(i) + 1),
+		})
+	}
+	return &ShardingExporter{
+		shards:        shards,
+		retryInterval: c.retryInterval,
+		transient:     c.transient,
+		now:           time.Now,
+	}
+}
+
+// ExportSpans exports each span with the shard of its trace. The spans of
+// the different shards are exported concurrently.
+func (e *ShardingExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	if len(e.shards) == 0 || len(spans) == 0 {
+		return nil
+	}
+
+	now := e.now()
+	batches := e.batches(spans, now, nil)
+	if len(batches) == 1 {
+		for i, batch := range batches {
+			return e.export(ctx, i, batch, now)
+		}
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for i, batch := range batches {
+		wg.Go(func() {
+			if err := e.export(ctx, i, batch, now); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+// export exports spans with the shard at index i. If the export fails with
+// a transient error, the shard is marked unhealthy and spans are exported
+// once more with the shards their traces are reassigned to.
+func (e *ShardingExporter) export(ctx context.Context, i int, spans []ReadOnlySpan, now time.Time) error {
+	err := e.shards[i].export(ctx, spans)
+	if err == nil || !e.transient(err) {
+		return err
+	}
+	e.markUnhealthy(i, now)
+	if len(e.shards) == 1 || ctx.Err() != nil {
+		return err
+	}
+
+	var errs []error
+	for j, batch := range e.batches(spans, now, e.shards[i]) {
+		if rErr := e.shards[j].export(ctx, batch); rErr != nil {
+			if e.transient(rErr) {
+				e.markUnhealthy(j, now)
+			}
+			errs = append(errs, rErr)
+		}
+	}
+	if len(errs) == 0 {
+		// All the spans were exported with the other shards.
+		return nil
+	}
+	return fmt.Errorf("sharding exporter: shard %d: %w", i, errors.Join(append([]error{err}, errs...)...))
+}
+
+// markUnhealthy marks the shard at index i unhealthy for the retry interval
+// from now.
+func (e *ShardingExporter) markUnhealthy(i int, now time.Time) {
+	e.shards[i].unhealthyUntil.Store(now.Add(e.retryInterval).UnixNano())
+}
+
+// batches returns the spans grouped by the index of the shard of their trace
+// at now. The exclude shard is not used.
+func (e *ShardingExporter) batches(spans []ReadOnlySpan, now time.Time, exclude *exporterShard) map[int][]ReadOnlySpan {
+	batches := make(map[int][]ReadOnlySpan)
+	var (
+		lastID    trace.TraceID
+		lastShard = -1
+	)
+	for _, s := range spans {
+		id := s.SpanContext().TraceID()
+		// Spans of the same trace are commonly exported together.
+		if lastShard < 0 || id != lastID {
+			lastID, lastShard = id, e.shard(id, now, exclude)
+		}
+		batches[lastShard] = append(batches[lastShard], s)
+	}
+	return batches
+}
+
+// shard returns the index of the shard of the trace with id at now. It is
+// the healthy shard with the highest weight for id, or the shard with the
+// highest weight if none is healthy. The exclude shard is not used.
+func (e *ShardingExporter) shard(id trace.TraceID, now time.Time, exclude *exporterShard) int {
+	h := traceIDHash(id)
+	best, bestHealthy := -1, -1
+	var bestW, bestHealthyW uint64
+	for i, s := range e.shards {
+		if s == exclude {
+			continue
+		}
+		w := mix64(h ^ s.seed)
+		if best < 0 || w > bestW {
+			best, bestW = i, w
+		}
+		if s.healthy(now) && (bestHealthy < 0 || w > bestHealthyW) {
+			bestHealthy, bestHealthyW = i, w
+		}
+	}
+	if bestHealthy >= 0 {
+		return bestHealthy
+	}
+	return best
+}
+
+// Shutdown shuts down the exporters of all the shards.
+func (e *ShardingExporter) Shutdown(ctx context.Context) error {
+	var errs []error
+	for _, s := range e.shards {
+		if err := s.exporter.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// traceIDHash returns a hash of id, the same in all processes.
+func traceIDHash(id trace.TraceID) uint64 {
+	// FNV-1a.
+	h := uint64(14695981039346656037)
+	for _, b := range id {
+		h ^= uint64(b)
+		h *= 1099511628211
+	}
+	return h
+}
+
+// mix64 returns x with its bits mixed (the SplitMix64 finalizer).
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,286 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+type shardExporter struct {
+	mu       sync.Mutex
+	err      error
+	spans    []ReadOnlySpan
+	shutdown bool
+}
+
+func (e *shardExporter) ExportSpans(_ context.Context, spans []ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.err != nil {
+		return e.err
+	}
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *shardExporter) Shutdown(context.Context) error {
+	e.shutdown = true
+	return nil
+}
+
+func (e *shardExporter) setErr(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.err = err
+}
+
+// traceIDs returns the trace IDs of the exported spans and resets them.
+func (e *shardExporter) traceIDs() map[trace.TraceID]int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ids := make(map[trace.TraceID]int)
+	for _, s := range e.spans {
+		ids[s.SpanContext().TraceID()]++
+	}
+	e.spans = nil
+	return ids
+}
+
+func shardingSpans(nTraces, nSpans int) []ReadOnlySpan {
+	var spans []ReadOnlySpan
+	for i := range nTraces {
+		var tid trace.TraceID
+		binary.BigEndian.PutUint64(tid[8:], uint64(i)+1)
+		for j := range nSpans {
+			var sid trace.SpanID
+			binary.BigEndian.PutUint64(sid[:], uint64(j)+1)
+			spans = append(spans, snapshot{spanContext: trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID: tid,
+				SpanID:  sid,
+			})})
+		}
+	}
+	return spans
+}
+
+// assignments returns the index of the exporter each trace was exported with.
+func assignments(t *testing.T, exps []*shardExporter) map[trace.TraceID]int {
+	t.Helper()
+	got := make(map[trace.TraceID]int)
+	for i, e := range exps {
+		for id := range e.traceIDs() {
+			_, dup := got[id]
+			require.False(t, dup, "trace %s exported with multiple shards", id)
+			got[id] = i
+		}
+	}
+	return got
+}
+
+// errUnreachable is a transient error of an unreachable shard.
+var errUnreachable = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+func newShardExporters(n int) ([]*shardExporter, []SpanExporter) {
+	exps := make([]*shardExporter, n)
+	spanExps := make([]SpanExporter, n)
+	for i := range exps {
+		exps[i] = new(shardExporter)
+		spanExps[i] = exps[i]
+	}
+	return exps, spanExps
+}
+
+func TestShardingExporterConsistent(t *testing.T) {
+	const nTraces, nSpans = 300, 3
+	spans := shardingSpans(nTraces, nSpans)
+
+	exps, spanExps := newShardExporters(3)
+	e := NewShardingExporter(spanExps)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+
+	for i, exp := range exps {
+		exp.mu.Lock()
+		n := len(exp.spans)
+		exp.mu.Unlock()
+		assert.Positive(t, n, "shard %d not exported to", i)
+		for id, count := range exp.traceIDs() {
+			assert.Equal(t, nSpans, count, "spans of trace %s split", id)
+		}
+	}
+
+	// Each trace is always assigned the same shard, including by other
+	// exporters with the same shards.
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	want := assignments(t, exps)
+	require.Len(t, want, nTraces)
+
+	other := NewShardingExporter(spanExps)
+	require.NoError(t, other.ExportSpans(t.Context(), spans))
+	assert.Equal(t, want, assignments(t, exps))
+}
+
+func TestShardingExporterNilExporter(t *testing.T) {
+	spans := shardingSpans(100, 1)
+
+	exps, spanExps := newShardExporters(3)
+	require.NoError(t, NewShardingExporter(spanExps).ExportSpans(t.Context(), spans))
+	all := assignments(t, exps)
+
+	// Ignoring a nil exporter does not reassign the traces of the others.
+	spanExps[1] = nil
+	require.NoError(t, NewShardingExporter(spanExps).ExportSpans(t.Context(), spans))
+	got := assignments(t, exps)
+	for id, i := range all {
+		if i != 1 {
+			assert.Equal(t, i, got[id], "trace %s reassigned", id)
+		}
+	}
+}
+
+func TestShardingExporterUnhealthy(t *testing.T) {
+	spans := shardingSpans(300, 2)
+
+	exps, spanExps := newShardExporters(3)
+	e := NewShardingExporter(spanExps, WithShardRetryInterval(time.Minute))
+	now := time.Now()
+	e.now = func() time.Time { return now }
+
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	want := assignments(t, exps)
+
+	// The spans of the failing shard are exported with the other shards and
+	// the other traces keep their shard.
+	exps[0].setErr(errUnreachable)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	got := assignments(t, exps)
+	require.Len(t, got, len(want))
+	for id, i := range want {
+		if i == 0 {
+			assert.NotEqual(t, 0, got[id], "trace %s exported with failing shard", id)
+		} else {
+			assert.Equal(t, i, got[id], "trace %s reassigned", id)
+		}
+	}
+
+	// The unhealthy shard is not exported to until the retry interval passed.
+	exps[0].setErr(nil)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	assert.Equal(t, got, assignments(t, exps))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	assert.Equal(t, want, assignments(t, exps))
+}
+
+func TestShardingExporterAllUnhealthy(t *testing.T) {
+	spans := shardingSpans(10, 1)
+
+	exps, spanExps := newShardExporters(2)
+	e := NewShardingExporter(spanExps)
+	errs := []error{
+		&net.OpError{Op: "dial", Err: errors.New("shard 0")},
+		&net.OpError{Op: "dial", Err: errors.New("shard 1")},
+	}
+	for i, exp := range exps {
+		exp.setErr(errs[i])
+	}
+	err := e.ExportSpans(t.Context(), spans)
+	assert.ErrorIs(t, err, errs[0])
+	assert.ErrorIs(t, err, errs[1])
+
+	// The spans are exported with their shard when all are unhealthy.
+	for _, exp := range exps {
+		exp.setErr(nil)
+	}
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	assert.Len(t, assignments(t, exps), 10)
+}
+
+func TestShardingExporterPermanentError(t *testing.T) {
+	spans := shardingSpans(300, 1)
+
+	exps, spanExps := newShardExporters(3)
+	e := NewShardingExporter(spanExps)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	want := assignments(t, exps)
+
+	// A permanent error is returned and the spans of the shard are not
+	// exported with the other shards.
+	exps[0].setErr(assert.AnError)
+	assert.ErrorIs(t, e.ExportSpans(t.Context(), spans), assert.AnError)
+	got := assignments(t, exps)
+	for id, i := range want {
+		if i == 0 {
+			assert.NotContains(t, got, id, "trace %s exported with another shard", id)
+		} else {
+			assert.Equal(t, i, got[id], "trace %s reassigned", id)
+		}
+	}
+
+	// The shard is still healthy.
+	exps[0].setErr(nil)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	assert.Equal(t, want, assignments(t, exps))
+}
+
+func TestShardingExporterTransientErrors(t *testing.T) {
+	spans := shardingSpans(300, 1)
+
+	exps, spanExps := newShardExporters(2)
+	e := NewShardingExporter(spanExps, WithShardTransientErrors(func(error) bool {
+		return true
+	}))
+
+	// The errors classified as transient make the shard fail over.
+	exps[0].setErr(assert.AnError)
+	require.NoError(t, e.ExportSpans(t.Context(), spans))
+	assert.Empty(t, exps[0].traceIDs())
+	assert.Len(t, exps[1].traceIDs(), 300)
+}
+
+func TestIsTransientError(t *testing.T) {
+	assert.True(t, isTransientError(errUnreachable), "network error")
+	assert.True(t, isTransientError(fmt.Errorf("export: %w", context.DeadlineExceeded)), "deadline")
+	assert.True(t, isTransientError(temporaryError(true)), "temporary")
+	assert.False(t, isTransientError(temporaryError(false)), "not temporary")
+	assert.False(t, isTransientError(assert.AnError), "other")
+}
+
+type temporaryError bool
+
+func (temporaryError) Error() string     { return "temporary" }
+func (e temporaryError) Temporary() bool { return bool(e) }
+
+func TestShardingExporterSingleShard(t *testing.T) {
+	exps, spanExps := newShardExporters(1)
+	e := NewShardingExporter(spanExps)
+	exps[0].setErr(assert.AnError)
+	assert.ErrorIs(t, e.ExportSpans(t.Context(), shardingSpans(2, 1)), assert.AnError)
+}
+
+func TestShardingExporterEmpty(t *testing.T) {
+	e := NewShardingExporter(nil)
+	assert.NoError(t, e.ExportSpans(t.Context(), shardingSpans(2, 1)))
+	assert.NoError(t, e.Shutdown(t.Context()))
+}
+
+func TestShardingExporterShutdown(t *testing.T) {
+	exps, spanExps := newShardExporters(2)
+	require.NoError(t, NewShardingExporter(spanExps).Shutdown(t.Context()))
+	for i, exp := range exps {
+		assert.True(t, exp.shutdown, "shard %d not shut down", i)
+	}
+}
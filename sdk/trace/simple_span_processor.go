@@ -11,6 +11,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -22,7 +23,8 @@ type simpleSpanProcessor struct {
 	exporter   SpanExporter
 	stopOnce   sync.Once
 
-	inst *observ.SSP
+	id   int64
+	inst atomic.Pointer[observ.SSP]
 }
 
 var _ SpanProcessor = (*simpleSpanProcessor)(nil)
@@ -40,17 +42,26 @@ func NewSimpleSpanProcessor(exporter SpanExporter) SpanProcessor {
 		exporter: exporter,
 	}
 
-	var err error
-	ssp.inst, err = observ.NewSSP(nextSimpleProcessorID())
+	ssp.id = nextSimpleProcessorID()
+	inst, err := observ.NewSSP(nil, ssp.id)
 	if err != nil {
 		otel.Handle(err)
 	}
+	ssp.inst.Store(inst)
 
 	global.Warn("SimpleSpanProcessor is not recommended for production use, consider using BatchSpanProcessor instead.")
 
 	return ssp
 }
 
+// setMeterProvider configures ssp to record its self-observability metrics
+// with mp, replacing any existing instrumentation.
+func (ssp *simpleSpanProcessor) setMeterProvider(mp metric.MeterProvider) error {
+	inst, err := observ.NewSSP(mp, ssp.id)
+	ssp.inst.Store(inst)
+	return err
+}
+
 var simpleProcessorIDCounter atomic.Int64
 
 // nextSimpleProcessorID returns an identifier for this simple span processor,
@@ -75,11 +86,11 @@ func (ssp *simpleSpanProcessor) OnEnd(s ReadOnlySpan) {
 		}
 	}
 
-	if ssp.inst != nil {
+	if inst := ssp.inst.Load(); inst != nil {
 		// Add the span to the context to ensure the metric is recorded
 		// with the correct span context.
 		ctx := trace.ContextWithSpanContext(context.Background(), s.SpanContext())
-		ssp.inst.SpanProcessed(ctx, err)
+		inst.SpanProcessed(ctx, err)
 	}
 }
 
@@ -4,6 +4,8 @@
 package trace
 
 import (
+	"cmp"
+	"slices"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -29,12 +31,43 @@ type snapshot struct {
 	droppedAttributeCount int
 	droppedEventCount     int
 	droppedLinkCount      int
-	resource              *resource.Resource
+	resource              *spanResource
 	instrumentationScope  instrumentation.Scope
+
+	// state is the SpanProcessor state of the span, see SpanStateKey.
+	state map[*byte]any
+
+	samplerAttrSet attribute.Set
+	samplerAttrs   []attribute.KeyValue
 }
 
 var _ ReadOnlySpan = snapshot{}
 
+// sortAttributes sorts the attributes of s, and of its events and links, by
+// key. The attribute slices are copied before being sorted so the ones shared
+// with the span are not modified.
+func (s *snapshot) sortAttributes() {
+	s.attributes = sortedAttributes(s.attributes)
+	for i := range s.events {
+		s.events[i].Attributes = sortedAttributes(s.events[i].Attributes)
+	}
+	for i := range s.links {
+		s.links[i].Attributes = sortedAttributes(s.links[i].Attributes)
+	}
+}
+
+// sortedAttributes returns a copy of attrs stably sorted by key.
+func sortedAttributes(attrs []attribute.KeyValue) []attribute.KeyValue {
+	if len(attrs) == 0 {
+		return attrs
+	}
+	attrs = slices.Clone(attrs)
+	slices.SortStableFunc(attrs, func(a, b attribute.KeyValue) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return attrs
+}
+
 func (snapshot) private() {}
 
 // Name returns the name of the span.
@@ -105,7 +138,7 @@ func (s snapshot) InstrumentationLibrary() instrumentation.Library { //nolint:st
 
 // Resource returns information about the entity that produced the span.
 func (s snapshot) Resource() *resource.Resource {
-	return s.resource
+	return s.resource.get()
 }
 
 // DroppedAttributes returns the number of attributes dropped by the span
@@ -114,6 +147,12 @@ func (s snapshot) DroppedAttributes() int {
 	return s.droppedAttributeCount
 }
 
+// SamplerAttributes returns the attributes the Sampler returned when the span
+// was started.
+func (s snapshot) SamplerAttributes() []attribute.KeyValue {
+	return samplerAttributes(s.samplerAttrSet, s.samplerAttrs)
+}
+
 // DroppedLinks returns the number of links dropped by the span due to limits
 // being reached.
 func (s snapshot) DroppedLinks() int {
@@ -11,6 +11,7 @@ import (
 	rt "runtime/trace"
 	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -77,6 +78,12 @@ type ReadOnlySpan interface {
 	// ChildSpanCount returns the count of spans that consider the span a
 	// direct parent.
 	ChildSpanCount() int
+	// SamplerAttributes returns the attributes the Sampler returned when the
+	// span was started, as returned, before the span limits were applied.
+	// They are included in the attributes of the span, this can be used to
+	// debug which of them originated from the Sampler. The returned slice
+	// must not be modified.
+	SamplerAttributes() []attribute.KeyValue
 
 	// A private method to prevent users implementing the
 	// interface and so future additions to it will not
@@ -126,6 +133,19 @@ type recordingSpan struct {
 	// childSpanCount holds the number of child spans created for this span.
 	childSpanCount int
 
+	// local is the position of this span in its local trace. It is only
+	// tracked if the depth or the number of the spans is limited.
+	local localSpan
+
+	// suppressedDescendants is the number of descendants of this span that
+	// were not recorded because they exceeded the maximum depth or number of
+	// spans.
+	suppressedDescendants atomic.Int64
+
+	// removedLinks is the number of links removed because they linked to
+	// this span or duplicated another link, see WithLinkDeduplication.
+	removedLinks atomic.Int64
+
 	// spanContext holds the SpanContext of this span.
 	spanContext trace.SpanContext
 
@@ -139,6 +159,11 @@ type recordingSpan struct {
 	droppedAttributes int
 	logDropAttrsOnce  sync.Once
 
+	// samplerAttrSet and samplerAttrs are the attributes returned by the
+	// Sampler when the span was started. They are not modified after.
+	samplerAttrSet attribute.Set
+	samplerAttrs   []attribute.KeyValue
+
 	// events are stored in FIFO queue capped by configured limit.
 	events evictedQueue[Event]
 
@@ -151,6 +176,14 @@ type recordingSpan struct {
 	// tracer is the SDK tracer that created this span.
 	tracer *tracer
 
+	// resource is the Resource of the TracerProvider when the span was
+	// started.
+	resource *spanResource
+
+	// state holds the values attached by SpanProcessors with a SpanStateKey.
+	// It is copied on write so it can be shared with snapshots.
+	state map[*byte]any
+
 	// origCtx is the context used when starting this span that has the
 	// recordingSpan instance set as the active span. If not nil, it is used
 	// when ending the span to ensure any metrics are recorded with a context
@@ -263,17 +296,77 @@ func (s *recordingSpan) SetAttributes(attributes ...attribute.KeyValue) {
 	// will be deduplicated, optimizing the operation.
 	s.attributes = slices.Grow(s.attributes, len(attributes))
 	for _, a := range attributes {
-		if !a.Valid() {
-			// Drop all invalid attributes.
-			s.addDroppedAttr(1)
-			continue
-		}
-		a = dedupAttr(a)
-		a = attrnorm.Truncate(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
-		s.attributes = append(s.attributes, a)
+		s.appendAttr(a)
+	}
+}
+
+// setStartAttributes sets the attributes returned by the Sampler in sr and
+// the attributes attrs passed when s is started. Unlike calling
+// SetAttributes for each of them, the limits of s are checked once.
+func (s *recordingSpan) setStartAttributes(sr SamplingResult, attrs []attribute.KeyValue) {
+	s.samplerAttrSet = sr.AttributeSet
+	s.samplerAttrs = sr.Attributes
+
+	n := sr.AttributeSet.Len() + len(sr.Attributes) + len(attrs)
+	if n == 0 {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	limit := s.tracer.provider.spanLimits.AttributeCountLimit
+	if limit == 0 {
+		// No attributes allowed.
+		s.addDroppedAttr(n)
+		return
+	}
+
+	if limit > 0 && n > limit {
+		all := make([]attribute.KeyValue, 0, n)
+		all = append(all, sr.AttributeSet.ToSlice()...)
+		all = append(all, sr.Attributes...)
+		all = append(all, attrs...)
+		s.addOverCapAttrs(limit, all)
+		return
+	}
+
+	// The span has no attributes yet, allocate exactly what is needed.
+	s.attributes = make([]attribute.KeyValue, 0, n)
+	for iter := sr.AttributeSet.Iter(); iter.Next(); {
+		s.appendAttr(iter.Attribute())
+	}
+	for _, a := range sr.Attributes {
+		s.appendAttr(a)
+	}
+	for _, a := range attrs {
+		s.appendAttr(a)
 	}
 }
 
+// appendAttr appends a to the attributes of s without de-duplication, or
+// drops it if it is invalid.
+//
+// This method assumes s.mu.Lock is held by the caller.
+func (s *recordingSpan) appendAttr(a attribute.KeyValue) {
+	if !a.Valid() {
+		// Drop all invalid attributes.
+		s.addDroppedAttr(1)
+		return
+	}
+	a = dedupAttr(a)
+	a = attrnorm.Truncate(s.tracer.provider.spanLimits.AttributeValueLengthLimit, a)
+	s.attributes = append(s.attributes, a)
+}
+
+// The counters of the telemetry dropped because of the span limits, see
+// global.DropCounter.
+var (
+	droppedAttributes = global.NewDropCounter("span_attributes", "limit")
+	droppedEvents     = global.NewDropCounter("span_events", "limit")
+	droppedLinks      = global.NewDropCounter("span_links", "limit")
+)
+
 // Declared as a var so tests can override.
 var logDropAttrs = func() {
 	global.Warn("limit reached: dropping trace Span attributes")
@@ -287,6 +380,7 @@ var logDropAttrs = func() {
 // This method assumes s.mu.Lock is held by the caller.
 func (s *recordingSpan) addDroppedAttr(incr int) {
 	s.droppedAttributes += incr
+	droppedAttributes.Add(int64(incr))
 	s.logDropAttrsOnce.Do(logDropAttrs)
 }
 
@@ -376,12 +470,22 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 	// the span's duration in case some operation below takes a while.
 	et := monotonicEndTime(s.startTime)
 
+	if s.tracer.provider.limitsLocalSpans() {
+		s.SetAttributes(s.suppressedAttributes()...)
+	}
+	if n := s.removedLinks.Load(); n > 0 {
+		s.SetAttributes(removedLinksKey.Int64(n))
+	}
+
 	// Lock the span now that we have an end time and see if we need to do any more processing.
 	s.mu.Lock()
 	if !s.isRecording() {
 		s.mu.Unlock()
 		return
 	}
+	if c := s.tracer.counts; c != nil {
+		c.ended.Add(1)
+	}
 
 	config := trace.NewSpanEndConfig(options...)
 	if !s.tracer.provider.panicRecordingDisabled {
@@ -431,12 +535,18 @@ func (s *recordingSpan) End(options ...trace.SpanEndOption) {
 	}
 
 	sps := s.tracer.provider.getSpanProcessors()
-	if len(sps) == 0 {
-		return
+	scoped := s.tracer.getScopeProcessors()
+	if len(sps) > 0 || len(scoped) > 0 {
+		snap := s.snapshot()
+		for _, sp := range sps {
+			sp.sp.OnEnd(snap)
+		}
+		for _, sp := range scoped {
+			sp.OnEnd(snap)
+		}
 	}
-	snap := s.snapshot()
-	for _, sp := range sps {
-		sp.sp.OnEnd(snap)
+	for _, l := range s.tracer.provider.getSpanListeners() {
+		l.OnSpanEnded(s)
 	}
 }
 
@@ -667,20 +777,31 @@ func (s *recordingSpan) InstrumentationLibrary() instrumentation.Library { //nol
 	return s.tracer.instrumentationScope
 }
 
-// Resource returns the Resource associated with the Tracer that created this
-// span.
+// Resource returns the Resource of the TracerProvider that created this span
+// at the time the span was started.
 func (s *recordingSpan) Resource() *resource.Resource {
-	s.mu.Lock()
-	defer s.mu.Unlock()
-	return s.tracer.provider.resource
+	// The resource is set when the span is created and never modified.
+	return s.resource.get()
 }
 
 func (s *recordingSpan) AddLink(link trace.Link) {
-	if s == nil {
+	if s == nil || !validLink(link) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isRecording() {
 		return
 	}
-	if !link.SpanContext.IsValid() && len(link.Attributes) == 0 &&
-		link.SpanContext.TraceState().Len() == 0 {
+	s.addLink(link)
+}
+
+// AddLinks adds links to the span, the same as calling AddLink for each of
+// them, while only acquiring the span lock once. The links are subject to
+// the same limits as links added with AddLink.
+func (s *recordingSpan) AddLinks(links ...trace.Link) {
+	if s == nil || len(links) == 0 {
 		return
 	}
 
@@ -689,7 +810,24 @@ func (s *recordingSpan) AddLink(link trace.Link) {
 	if !s.isRecording() {
 		return
 	}
+	for _, l := range links {
+		if validLink(l) {
+			s.addLink(l)
+		}
+	}
+}
 
+// validLink returns if link refers to a span context or holds any data worth
+// recording.
+func validLink(link trace.Link) bool {
+	return link.SpanContext.IsValid() || len(link.Attributes) > 0 ||
+		link.SpanContext.TraceState().Len() > 0
+}
+
+// addLink adds link to s applying the span limits.
+//
+// This method assumes s.mu.Lock is held by the caller.
+func (s *recordingSpan) addLink(link trace.Link) {
 	attrs, _ := attrnorm.KeyValues(link.Attributes)
 	l := Link{SpanContext: link.SpanContext, Attributes: attrs}
 
@@ -703,7 +841,18 @@ func (s *recordingSpan) AddLink(link trace.Link) {
 		l.DroppedAttributeCount = len(l.Attributes) - limit
 		l.Attributes = l.Attributes[:limit]
 	}
+	if vLimit := s.tracer.provider.spanLimits.AttributeValueLengthLimit; vLimit >= 0 && len(l.Attributes) > 0 {
+		// Do not modify the attributes passed by the caller.
+		l.Attributes = slices.Clone(l.Attributes)
+		for i, a := range l.Attributes {
+			l.Attributes[i] = attrnorm.Truncate(vLimit, a)
+		}
+	}
 
+	if s.tracer.provider.linkDeduplication && s.redundantLink(l) {
+		s.removedLinks.Add(1)
+		return
+	}
 	s.links.add(l)
 }
 
@@ -715,6 +864,20 @@ func (s *recordingSpan) DroppedAttributes() int {
 	return s.droppedAttributes
 }
 
+// SamplerAttributes returns the attributes the Sampler returned when the span
+// was started.
+func (s *recordingSpan) SamplerAttributes() []attribute.KeyValue {
+	return samplerAttributes(s.samplerAttrSet, s.samplerAttrs)
+}
+
+// samplerAttributes returns the attributes of set followed by attrs.
+func samplerAttributes(set attribute.Set, attrs []attribute.KeyValue) []attribute.KeyValue {
+	if set.Len() == 0 {
+		return attrs
+	}
+	return append(set.ToSlice(), attrs...)
+}
+
 // DroppedLinks returns the number of links dropped by the span due to limits
 // being reached.
 func (s *recordingSpan) DroppedLinks() int {
@@ -747,20 +910,45 @@ func (s *recordingSpan) TracerProvider() trace.TracerProvider {
 
 // snapshot creates a read-only copy of the current state of the span.
 func (s *recordingSpan) snapshot() ReadOnlySpan {
-	var sd snapshot
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	return s.snapshotLocked()
+}
+
+// partialSnapshot creates a read-only copy of the current state of the span
+// while it is still recording. The copy is marked with the PartialSpanKey
+// attribute and ends at now. If the span has ended, nil is returned.
+func (s *recordingSpan) partialSnapshot(now time.Time) ReadOnlySpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isRecording() {
+		return nil
+	}
 
+	sd := s.snapshotLocked()
+	sd.endTime = now
+	// Clone the attributes as they can still be updated by the span.
+	sd.attributes = append(slices.Clone(sd.attributes), PartialSpanKey.Bool(true))
+	return sd
+}
+
+// snapshotLocked creates a read-only copy of the current state of the span.
+// The lock s.mu needs to be held.
+func (s *recordingSpan) snapshotLocked() *snapshot {
+	var sd snapshot
 	sd.endTime = s.endTime
+	sd.state = s.state
 	sd.instrumentationScope = s.tracer.instrumentationScope
 	sd.name = s.name
 	sd.parent = s.parent
-	sd.resource = s.tracer.provider.resource
+	sd.resource = s.resource
 	sd.spanContext = s.spanContext
 	sd.spanKind = s.spanKind
 	sd.startTime = s.startTime
 	sd.status = s.status
 	sd.childSpanCount = s.childSpanCount
+	sd.samplerAttrSet = s.samplerAttrSet
+	sd.samplerAttrs = s.samplerAttrs
 
 	if len(s.attributes) > 0 {
 		s.dedupeAttrs()
@@ -773,7 +961,11 @@ func (s *recordingSpan) snapshot() ReadOnlySpan {
 	}
 	if len(s.links.queue) > 0 {
 		sd.links = s.links.copy()
-		sd.droppedLinkCount = s.links.droppedCount
+	}
+	// Links can all be dropped, e.g. if the LinkCountLimit is zero.
+	sd.droppedLinkCount = s.links.droppedCount
+	if s.tracer.provider.sortedAttributes {
+		sd.sortAttributes()
 	}
 	return &sd
 }
@@ -818,6 +1010,11 @@ type nonRecordingSpan struct {
 	// tracer is the SDK tracer that created this span.
 	tracer *tracer
 	sc     trace.SpanContext
+
+	// ancestor is the last recorded ancestor of this span if it was not
+	// recorded because it exceeded the maximum depth or number of spans, nil
+	// otherwise.
+	ancestor *recordingSpan
 }
 
 var _ trace.Span = nonRecordingSpan{}
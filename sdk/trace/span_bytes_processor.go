@@ -0,0 +1,80 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/internal/volume"
+)
+
+// SpanBytesKey is the attribute key a SpanBytesProcessor annotates spans with.
+// Its value is the estimated size, in bytes, of the span once serialized.
+//
+// It is not defined by the semantic conventions.
+const SpanBytesKey = attribute.Key("span.bytes")
+
+// SpanBytesProcessor is a SpanProcessor that annotates the ended spans it
+// passes to another SpanProcessor with the SpanBytesKey attribute.
+//
+// The attribute lets telemetry backends attribute the cost of the telemetry to
+// the services and endpoints producing it. The size is an estimate of the
+// encoded span, not of a specific wire format.
+//
+// Use [NewSpanBytesProcessor] to create a SpanBytesProcessor.
+type SpanBytesProcessor struct {
+	next SpanProcessor
+}
+
+var _ SpanProcessor = (*SpanBytesProcessor)(nil)
+
+// NewSpanBytesProcessor returns a new SpanBytesProcessor that passes spans to
+// next. Ended spans are passed to next with the SpanBytesKey attribute added.
+func NewSpanBytesProcessor(next SpanProcessor) *SpanBytesProcessor {
+	return &SpanBytesProcessor{next: next}
+}
+
+// OnStart passes s to the wrapped SpanProcessor.
+func (p *SpanBytesProcessor) OnStart(ctx context.Context, s ReadWriteSpan) {
+	p.next.OnStart(ctx, s)
+}
+
+// OnEnd passes s, annotated with its estimated size, to the wrapped
+// SpanProcessor.
+func (p *SpanBytesProcessor) OnEnd(s ReadOnlySpan) {
+	if !s.SpanContext().IsSampled() {
+		p.next.OnEnd(s)
+		return
+	}
+	size := spanSize(s) + int64(len(SpanBytesKey)) + volume.ValueFixedSize
+	attrs := slices.DeleteFunc(slices.Clone(s.Attributes()), func(kv attribute.KeyValue) bool {
+		return kv.Key == SpanBytesKey
+	})
+	p.next.OnEnd(sizedSpan{ReadOnlySpan: s, attrs: append(attrs, SpanBytesKey.Int64(size))})
+}
+
+// Shutdown shuts down the wrapped SpanProcessor.
+func (p *SpanBytesProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the wrapped SpanProcessor.
+func (p *SpanBytesProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// sizedSpan is a ReadOnlySpan with the SpanBytesKey attribute added.
+type sizedSpan struct {
+	ReadOnlySpan
+	// attrs are the attributes of the span and the SpanBytesKey attribute.
+	attrs []attribute.KeyValue
+}
+
+// Attributes returns the attributes of the span and the SpanBytesKey
+// attribute.
+func (s sizedSpan) Attributes() []attribute.KeyValue {
+	return s.attrs
+}
@@ -0,0 +1,63 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func spanBytes(t *testing.T, s ReadOnlySpan) int64 {
+	t.Helper()
+	var (
+		n     int64
+		found int
+	)
+	for _, kv := range s.Attributes() {
+		if kv.Key == SpanBytesKey {
+			n = kv.Value.AsInt64()
+			found++
+		}
+	}
+	require.Equal(t, 1, found, "span not annotated once")
+	return n
+}
+
+func TestSpanBytesProcessor(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(WithSpanProcessor(NewSpanBytesProcessor(rec)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	_, small := tracer.Start(t.Context(), "small")
+	small.End()
+	_, large := tracer.Start(t.Context(), "large")
+	large.SetAttributes(attribute.String("key", "a long attribute value"))
+	large.AddEvent("event")
+	large.End()
+
+	require.Len(t, *rec, 2)
+	smallSize, largeSize := spanBytes(t, (*rec)[0]), spanBytes(t, (*rec)[1])
+	assert.Positive(t, smallSize)
+	assert.Greater(t, largeSize, smallSize)
+	assert.Contains(t, (*rec)[1].Attributes(), attribute.String("key", "a long attribute value"))
+}
+
+func TestSpanBytesProcessorReplacesAttribute(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(WithSpanProcessor(NewSpanBytesProcessor(rec)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.SetAttributes(SpanBytesKey.Int64(-1))
+	span.End()
+
+	require.Len(t, *rec, 1)
+	assert.NotEqual(t, int64(-1), spanBytes(t, (*rec)[0]))
+}
@@ -3,7 +3,10 @@
 
 package trace
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // SpanExporter handles the delivery of spans to external receivers. This is
 // the final component in the trace export pipeline.
@@ -34,3 +37,33 @@ type SpanExporter interface {
 	// DO NOT CHANGE: any modification will not be backwards compatible and
 	// must never be done outside of a new major release.
 }
+
+// BatchMetadata describes a batch of spans exported by a batch span
+// processor.
+type BatchMetadata struct {
+	// Dropped is the number of spans the processor dropped, because its
+	// queue was full, since it exported the previous batch.
+	Dropped uint64
+	// Created is the time the first span of the batch was added to it.
+	Created time.Time
+	// QueueLatency is the time between the end of the span of the batch
+	// that ended first and the export of the batch. It is the longest delay
+	// added by the processor to the spans of the batch.
+	QueueLatency time.Duration
+}
+
+// MetadataSpanExporter is a SpanExporter receiving the metadata of the
+// batches it exports.
+//
+// A batch span processor (see [NewBatchSpanProcessor]) exports its batches
+// with ExportSpansWithMetadata instead of ExportSpans if its exporter
+// implements this interface, so the exporter can measure the delays and
+// losses of spans in the SDK.
+type MetadataSpanExporter interface {
+	SpanExporter
+
+	// ExportSpansWithMetadata exports a batch of spans, described by md.
+	//
+	// It is called as ExportSpans is, with the same requirements.
+	ExportSpansWithMetadata(ctx context.Context, spans []ReadOnlySpan, md BatchMetadata) error
+}
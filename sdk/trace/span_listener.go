@@ -0,0 +1,83 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import "slices"
+
+// SpanListener is notified when the recording spans of a TracerProvider start
+// and end. It is a lighter alternative to a SpanProcessor for the features
+// observing spans without exporting them, e.g. live dashboards or gauges of
+// the in-flight operations: it cannot modify the spans, has no lifecycle, and
+// can be registered and unregistered at any time.
+//
+// The methods are called synchronously, after the SpanProcessors, by the
+// goroutine starting or ending the span. They must be safe for concurrent use
+// and should not block.
+type SpanListener interface {
+	// OnSpanStarted is called when s is started.
+	OnSpanStarted(s ReadOnlySpan)
+	// OnSpanEnded is called when s is ended.
+	OnSpanEnded(s ReadOnlySpan)
+}
+
+// WithSpanListener registers the SpanListener with a TracerProvider. A nil
+// SpanListener is ignored.
+func WithSpanListener(l SpanListener) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if l != nil {
+			cfg.listeners = append(cfg.listeners, l)
+		}
+		return cfg
+	})
+}
+
+// RegisterSpanListener adds l to the SpanListeners of the TracerProvider. It
+// is notified of the spans started and ended after the call, the spans
+// started before may be reported ended without having been reported
+// started. A nil SpanListener is ignored.
+func (p *TracerProvider) RegisterSpanListener(l SpanListener) {
+	if l == nil || p.isShutdown.Load() {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.isShutdown.Load() {
+		return
+	}
+
+	current := p.getSpanListeners()
+	listeners := make([]SpanListener, 0, len(current)+1)
+	listeners = append(listeners, current...)
+	listeners = append(listeners, l)
+	p.spanListeners.Store(&listeners)
+}
+
+// UnregisterSpanListener removes l from the SpanListeners of the
+// TracerProvider. It is not notified of the spans started or ended after the
+// call returns.
+func (p *TracerProvider) UnregisterSpanListener(l SpanListener) {
+	if p.isShutdown.Load() {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.isShutdown.Load() {
+		return
+	}
+
+	current := p.getSpanListeners()
+	idx := slices.Index(current, l)
+	if idx < 0 {
+		return
+	}
+	listeners := slices.Delete(slices.Clone(current), idx, idx+1)
+	p.spanListeners.Store(&listeners)
+}
+
+func (p *TracerProvider) getSpanListeners() []SpanListener {
+	if l := p.spanListeners.Load(); l != nil {
+		return *l
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type inFlightListener struct {
+	started, ended atomic.Int64
+	names          []string
+}
+
+func (l *inFlightListener) OnSpanStarted(ReadOnlySpan) { l.started.Add(1) }
+
+func (l *inFlightListener) OnSpanEnded(s ReadOnlySpan) {
+	l.ended.Add(1)
+	l.names = append(l.names, s.Name())
+}
+
+func (l *inFlightListener) inFlight() int64 { return l.started.Load() - l.ended.Load() }
+
+func TestSpanListener(t *testing.T) {
+	l := new(inFlightListener)
+	tp := NewTracerProvider(WithSpanListener(l), WithSpanListener(nil))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	ctx, parent := tracer.Start(t.Context(), "parent")
+	_, child := tracer.Start(ctx, "child")
+	assert.Equal(t, int64(2), l.inFlight())
+	child.End()
+	parent.End()
+	assert.Equal(t, int64(0), l.inFlight())
+	assert.Equal(t, []string{"child", "parent"}, l.names)
+
+	// Not recording spans are not notified.
+	dropping := NewTracerProvider(WithSampler(NeverSample()), WithSpanListener(l))
+	_, span := dropping.Tracer(t.Name()).Start(t.Context(), "dropped")
+	span.End()
+	assert.Equal(t, int64(2), l.started.Load())
+}
+
+func TestRegisterSpanListener(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	tracer := tp.Tracer(t.Name())
+
+	l := new(inFlightListener)
+	_, before := tracer.Start(t.Context(), "before")
+	tp.RegisterSpanListener(l)
+	tp.RegisterSpanListener(nil)
+	_, span := tracer.Start(t.Context(), "span")
+	assert.Equal(t, int64(1), l.started.Load())
+	span.End()
+	before.End()
+	assert.Equal(t, []string{"span", "before"}, l.names)
+
+	tp.UnregisterSpanListener(l)
+	tp.UnregisterSpanListener(new(inFlightListener))
+	_, span = tracer.Start(t.Context(), "after")
+	span.End()
+	assert.Equal(t, int64(1), l.started.Load())
+	assert.Len(t, l.names, 2)
+	assert.Equal(t, 3, te.Len(), "SpanProcessors still called")
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	tp.RegisterSpanListener(l)
+	assert.Empty(t, tp.getSpanListeners())
+}
@@ -0,0 +1,219 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"sync"
+
+	"go.opentelemetry.io/otel/internal/global"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// defaultSpanNameLimit is the default number of distinct span names of an
+// instrumentation scope above which a SpanNameGuard reports the scope.
+const defaultSpanNameLimit = 100
+
+// defaultSpanNamePattern matches UUIDs, hex encoded IDs of at least 16
+// characters, and numeric path segments, e.g. "/users/42".
+var defaultSpanNamePattern = regexp.MustCompile(
+	`[0-9a-fA-F]{8}(-[0-9a-fA-F]{4}){3}-[0-9a-fA-F]{12}|[0-9a-fA-F]{16,}|/[0-9]+(/|$)`,
+)
+
+// SpanNameViolation is a span name reported by a SpanNameGuard.
+type SpanNameViolation struct {
+	// Scope is the instrumentation scope of the span.
+	Scope instrumentation.Scope
+	// Name is the name of the span.
+	Name string
+	// Pattern is the pattern matched by Name, or nil if the scope exceeded
+	// the limit of distinct span names.
+	Pattern *regexp.Regexp
+	// Distinct is the number of distinct span names of the scope seen. The
+	// names are no longer counted once the limit is exceeded.
+	Distinct int
+}
+
+// SpanNameGuardOption configures a SpanNameGuard.
+type SpanNameGuardOption interface {
+	applySpanNameGuard(spanNameGuardConfig) spanNameGuardConfig
+}
+
+type spanNameGuardConfig struct {
+	limit    int
+	patterns []*regexp.Regexp
+	report   func(SpanNameViolation)
+}
+
+type spanNameGuardOptionFunc func(spanNameGuardConfig) spanNameGuardConfig
+
+func (fn spanNameGuardOptionFunc) applySpanNameGuard(c spanNameGuardConfig) spanNameGuardConfig {
+	return fn(c)
+}
+
+// WithSpanNameLimit sets the number of distinct span names of an
+// instrumentation scope above which a SpanNameGuard reports the scope. A
+// value less than or equal to zero means the default is used.
+//
+// By default, a scope is reported above 100 distinct span names.
+func WithSpanNameLimit(n int) SpanNameGuardOption {
+	return spanNameGuardOptionFunc(func(c spanNameGuardConfig) spanNameGuardConfig {
+		c.limit = n
+		return c
+	})
+}
+
+// WithSpanNamePatterns sets the patterns of the span names embedding
+// high-cardinality values a SpanNameGuard reports, replacing the default
+// ones. The nil patterns are ignored.
+//
+// By default, the span names containing a UUID, a hex encoded ID of at least
+// 16 characters, or a numeric path segment (e.g. "GET /users/42") are
+// reported.
+func WithSpanNamePatterns(patterns ...*regexp.Regexp) SpanNameGuardOption {
+	return spanNameGuardOptionFunc(func(c spanNameGuardConfig) spanNameGuardConfig {
+		c.patterns = slices.DeleteFunc(slices.Clone(patterns), func(re *regexp.Regexp) bool { return re == nil })
+		return c
+	})
+}
+
+// WithSpanNameViolation sets the function a SpanNameGuard calls to report a
+// span name. The function is called synchronously when spans end and must
+// not block.
+//
+// By default, the violations are logged as warnings.
+func WithSpanNameViolation(f func(SpanNameViolation)) SpanNameGuardOption {
+	return spanNameGuardOptionFunc(func(c spanNameGuardConfig) spanNameGuardConfig {
+		c.report = f
+		return c
+	})
+}
+
+// SpanNameGuard is a SpanProcessor that reports the span names likely to
+// have a high cardinality: the names of the instrumentation scopes with more
+// distinct span names than a limit, and the names embedding IDs. Such names
+// cause cardinality explosions in backends grouping spans by name, they
+// should be replaced with low-cardinality names, the IDs being set as
+// attributes.
+//
+// The SpanNameGuard is intended to be used during development and in
+// tests: it holds the distinct span names of each scope up to the limit,
+// and matches each span name against the patterns. Each scope is reported
+// at most once for exceeding the limit, and at most once per pattern.
+//
+// Use [NewSpanNameGuard] to create a SpanNameGuard.
+type SpanNameGuard struct {
+	cfg spanNameGuardConfig
+
+	mu     sync.Mutex
+	scopes map[instrumentation.Scope]*spanNameScope
+}
+
+// spanNameScope is the state of a SpanNameGuard for a scope.
+type spanNameScope struct {
+	// names are the distinct names seen, until the limit is exceeded.
+	names    map[string]struct{}
+	distinct int
+	// matched are the patterns of cfg already reported.
+	matched []bool
+}
+
+var _ SpanProcessor = (*SpanNameGuard)(nil)
+
+// NewSpanNameGuard returns a new SpanNameGuard.
+func NewSpanNameGuard(opts ...SpanNameGuardOption) *SpanNameGuard {
+	c := spanNameGuardConfig{
+		limit:    defaultSpanNameLimit,
+		patterns: []*regexp.Regexp{defaultSpanNamePattern},
+	}
+	for _, opt := range opts {
+		c = opt.applySpanNameGuard(c)
+	}
+	if c.limit <= 0 {
+		c.limit = defaultSpanNameLimit
+	}
+	if c.report == nil {
+		c.report = logSpanNameViolation
+	}
+	return &SpanNameGuard{cfg: c, scopes: make(map[instrumentation.Scope]*spanNameScope)}
+}
+
+func logSpanNameViolation(v SpanNameViolation) {
+	if v.Pattern == nil {
+		global.Warn(
+			"high-cardinality span names: too many distinct names",
+			"scope", v.Scope.Name,
+			"name", v.Name,
+			"distinct", v.Distinct,
+		)
+		return
+	}
+	global.Warn(
+		"high-cardinality span name: name contains an ID",
+		"scope", v.Scope.Name,
+		"name", v.Name,
+		"pattern", v.Pattern.String(),
+	)
+}
+
+// OnStart does nothing.
+func (*SpanNameGuard) OnStart(context.Context, ReadWriteSpan) {}
+
+// OnEnd checks the name of s, it may have been updated after s started.
+func (g *SpanNameGuard) OnEnd(s ReadOnlySpan) {
+	scope, name := s.InstrumentationScope(), s.Name()
+
+	var violations []SpanNameViolation
+	g.mu.Lock()
+	state, ok := g.scopes[scope]
+	if !ok {
+		state = &spanNameScope{
+			names:   make(map[string]struct{}),
+			matched: make([]bool, len(g.cfg.patterns)),
+		}
+		g.scopes[scope] = state
+	}
+	if _, ok := state.names[name]; !ok && state.names != nil {
+		state.names[name] = struct{}{}
+		state.distinct++
+		if state.distinct > g.cfg.limit {
+			violations = append(violations, SpanNameViolation{
+				Scope:    scope,
+				Name:     name,
+				Distinct: state.distinct,
+			})
+			// The names are no longer needed.
+			state.names = nil
+		}
+	}
+	for i, re := range g.cfg.patterns {
+		if state.matched[i] || !re.MatchString(name) {
+			continue
+		}
+		state.matched[i] = true
+		violations = append(violations, SpanNameViolation{
+			Scope:    scope,
+			Name:     name,
+			Pattern:  re,
+			Distinct: state.distinct,
+		})
+	}
+	g.mu.Unlock()
+
+	for _, v := range violations {
+		g.cfg.report(v)
+	}
+}
+
+// Shutdown does nothing.
+func (*SpanNameGuard) Shutdown(context.Context) error {
+	return nil
+}
+
+// ForceFlush does nothing.
+func (*SpanNameGuard) ForceFlush(context.Context) error {
+	return nil
+}
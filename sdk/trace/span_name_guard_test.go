@@ -0,0 +1,79 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpanNameGuard(t *testing.T) {
+	var got []SpanNameViolation
+	g := NewSpanNameGuard(
+		WithSpanNameLimit(3),
+		WithSpanNameViolation(func(v SpanNameViolation) { got = append(got, v) }),
+	)
+	tp := NewTracerProvider(WithSpanProcessor(g))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	start := func(scope, name string) {
+		_, span := tp.Tracer(scope).Start(t.Context(), name)
+		span.End()
+	}
+	for range 10 {
+		start("static", "GET /users/{id}")
+	}
+	start("ids", "GET /users/42")
+	start("ids", "GET /users/43")
+	start("ids", "order 7b6f04e2-8a1c-4c5e-9d3b-0f6a2f1e9c11")
+	start("ids", "job 4bf92f3577b34da6")
+	start("ids", "GET /orders/1/items")
+
+	require.Len(t, got, 2)
+	assert.Equal(t, "ids", got[0].Scope.Name)
+	assert.Equal(t, "GET /users/42", got[0].Name)
+	assert.Equal(t, defaultSpanNamePattern, got[0].Pattern)
+	assert.Equal(t, 1, got[0].Distinct)
+
+	assert.Equal(t, "ids", got[1].Scope.Name)
+	assert.Equal(t, "job 4bf92f3577b34da6", got[1].Name)
+	assert.Nil(t, got[1].Pattern, "limit exceeded")
+	assert.Equal(t, 4, got[1].Distinct)
+}
+
+func TestSpanNameGuardSetName(t *testing.T) {
+	var got []string
+	g := NewSpanNameGuard(WithSpanNameViolation(func(v SpanNameViolation) { got = append(got, v.Name) }))
+	tp := NewTracerProvider(WithSpanProcessor(g))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "GET")
+	span.SetName("GET /users/42")
+	span.End()
+	assert.Equal(t, []string{"GET /users/42"}, got)
+}
+
+func TestSpanNameGuardPatterns(t *testing.T) {
+	var got []string
+	g := NewSpanNameGuard(
+		WithSpanNamePatterns(regexp.MustCompile(`user-[0-9]+`), nil, regexp.MustCompile(`^tmp`)),
+		WithSpanNameViolation(func(v SpanNameViolation) { got = append(got, v.Pattern.String()) }),
+	)
+	tp := NewTracerProvider(WithSpanProcessor(g))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	for i := range 3 {
+		_, span := tp.Tracer(t.Name()).Start(t.Context(), fmt.Sprintf("tmp user-%d", i))
+		span.End()
+	}
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "GET /users/42")
+	span.End()
+	// Each pattern is reported once per scope.
+	assert.Equal(t, []string{`user-[0-9]+`, `^tmp`}, got)
+}
@@ -0,0 +1,101 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// SpanProcessorFactory returns the SpanProcessor of the spans of the Tracers
+// created for scope, or nil if the spans of scope are not processed by a
+// SpanProcessor of the factory.
+//
+// It is called once per instrumentation scope, when the first Tracer of the
+// scope is created, while the TracerProvider is locked: it must not call the
+// TracerProvider.
+type SpanProcessorFactory func(scope instrumentation.Scope) SpanProcessor
+
+// WithSpanProcessorFactory registers the SpanProcessorFactory with a
+// TracerProvider. The SpanProcessors it returns only process the spans of the
+// scope they are returned for, after the SpanProcessors registered with the
+// TracerProvider. Use it to instantiate heavyweight SpanProcessors, e.g.
+// debug recorders, only for selected scopes, without any overhead for the
+// spans of the other scopes.
+//
+// The SpanProcessors returned are flushed and shut down by the
+// TracerProvider, after the SpanProcessors registered with it. The
+// factories are called in the order they are registered. A nil factory is
+// ignored.
+func WithSpanProcessorFactory(f SpanProcessorFactory) TracerProviderOption {
+	return traceProviderOptionFunc(func(cfg tracerProviderConfig) tracerProviderConfig {
+		if f != nil {
+			cfg.processorFactories = append(cfg.processorFactories, f)
+		}
+		return cfg
+	})
+}
+
+// scopeProcessors returns the SpanProcessors returned by the factories of p
+// for scope. It must be called with p.mu held.
+func (p *TracerProvider) scopeProcessors(scope instrumentation.Scope) []SpanProcessor {
+	var sps []SpanProcessor
+	for _, f := range p.processorFactories {
+		if sp := f(scope); sp != nil {
+			p.observe(sp)
+			sps = append(sps, sp)
+		}
+	}
+	return sps
+}
+
+// getScopeProcessors returns the SpanProcessors of the scope of tr, or nil
+// once the TracerProvider is shut down.
+func (tr *tracer) getScopeProcessors() []SpanProcessor {
+	if len(tr.processors) == 0 || tr.provider.isShutdown.Load() {
+		return nil
+	}
+	return tr.processors
+}
+
+// forceFlushScopeProcessors flushes the SpanProcessors of the scopes of the
+// Tracers of p.
+func (p *TracerProvider) forceFlushScopeProcessors(ctx context.Context) error {
+	var sps []SpanProcessor
+	p.mu.Lock()
+	for _, t := range p.namedTracer {
+		sps = append(sps, t.processors...)
+	}
+	p.mu.Unlock()
+
+	var err error
+	for _, sp := range sps {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+		err = errors.Join(err, sp.ForceFlush(ctx))
+	}
+	return err
+}
+
+// shutdownScopeProcessors shuts down the SpanProcessors of the scopes of the
+// Tracers of p. It must be called with p.mu held.
+func (p *TracerProvider) shutdownScopeProcessors(ctx context.Context) error {
+	var err error
+	for _, t := range p.namedTracer {
+		for _, sp := range t.processors {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			err = errors.Join(err, sp.Shutdown(ctx))
+		}
+	}
+	return err
+}
@@ -0,0 +1,89 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+)
+
+// flushCountingProcessor is a testSpanProcessor counting its flushes.
+type flushCountingProcessor struct {
+	testSpanProcessor
+	flushCount int
+	err        error
+}
+
+func (p *flushCountingProcessor) ForceFlush(context.Context) error {
+	p.flushCount++
+	return p.err
+}
+
+func (p *flushCountingProcessor) Shutdown(ctx context.Context) error {
+	_ = p.testSpanProcessor.Shutdown(ctx)
+	return p.err
+}
+
+func TestSpanProcessorFactory(t *testing.T) {
+	var scopes []instrumentation.Scope
+	debug := &flushCountingProcessor{}
+	all := &flushCountingProcessor{}
+	tp := NewTracerProvider(
+		WithSpanProcessor(all),
+		WithSpanProcessorFactory(nil),
+		WithSpanProcessorFactory(func(scope instrumentation.Scope) SpanProcessor {
+			scopes = append(scopes, scope)
+			if scope.Name == "debug" {
+				return debug
+			}
+			return nil
+		}),
+	)
+
+	for range 2 {
+		_, s := tp.Tracer("debug").Start(t.Context(), "debug span")
+		s.End()
+	}
+	_, s := tp.Tracer("other").Start(t.Context(), "other span")
+	s.End()
+
+	assert.Equal(t, []instrumentation.Scope{{Name: "debug"}, {Name: "other"}}, scopes, "called once per scope")
+	assert.Len(t, all.spansStarted, 3)
+	assert.Len(t, all.spansEnded, 3)
+	require.Len(t, debug.spansStarted, 2)
+	require.Len(t, debug.spansEnded, 2)
+	assert.Equal(t, "debug span", debug.spansEnded[0].Name())
+
+	require.NoError(t, tp.ForceFlush(t.Context()))
+	assert.Equal(t, 1, all.flushCount)
+	assert.Equal(t, 1, debug.flushCount)
+
+	tracer := tp.Tracer("debug")
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.Equal(t, 1, all.shutdownCount)
+	assert.Equal(t, 1, debug.shutdownCount)
+
+	_, s = tracer.Start(t.Context(), "after shutdown")
+	s.End()
+	assert.Len(t, debug.spansStarted, 2, "not processed after shutdown")
+	assert.Len(t, debug.spansEnded, 2, "not processed after shutdown")
+}
+
+func TestSpanProcessorFactoryErrors(t *testing.T) {
+	errFlush := errors.New("scope processor")
+	sp := &flushCountingProcessor{err: errFlush}
+	tp := NewTracerProvider(WithSpanProcessorFactory(func(instrumentation.Scope) SpanProcessor {
+		return sp
+	}))
+	tp.Tracer("scope")
+
+	assert.ErrorIs(t, tp.ForceFlush(t.Context()), errFlush)
+	assert.ErrorIs(t, tp.Shutdown(t.Context()), errFlush)
+}
@@ -0,0 +1,76 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import "maps"
+
+// SpanStateKey identifies state of type T a SpanProcessor attaches to a span
+// in its OnStart method and retrieves in its OnEnd method, e.g. measurements
+// taken when the span started to compute their change over its lifetime.
+// This avoids the processor having to keep the state of all the active spans
+// itself.
+//
+// Each key returned by NewSpanStateKey is distinct, so the state attached by
+// a processor is only accessible by the holders of its key. The state is not
+// exported.
+type SpanStateKey[T any] struct {
+	// id is a pointer to make the key unique. It must not be a pointer to a
+	// zero-size type which are not guaranteed to be distinct.
+	id *byte
+}
+
+// NewSpanStateKey returns a new SpanStateKey distinct from all the other
+// keys.
+func NewSpanStateKey[T any]() SpanStateKey[T] {
+	return SpanStateKey[T]{id: new(byte)}
+}
+
+// Set attaches v to s with the key k, replacing any value previously
+// attached with k. The value is only attached if s is a span of this SDK
+// that has not ended.
+func (k SpanStateKey[T]) Set(s ReadWriteSpan, v T) {
+	if r, ok := s.(*recordingSpan); ok && k.id != nil {
+		r.setState(k.id, v)
+	}
+}
+
+// Get returns the value attached to s with the key k and true, or the zero
+// value of T and false if no value is attached. s can be the span passed to
+// the OnStart or OnEnd method of a SpanProcessor.
+func (k SpanStateKey[T]) Get(s ReadOnlySpan) (T, bool) {
+	var state map[*byte]any
+	switch r := s.(type) {
+	case *recordingSpan:
+		state = r.stateMap()
+	case snapshot:
+		state = r.state
+	case *snapshot:
+		state = r.state
+	}
+
+	v, ok := state[k.id].(T)
+	return v, ok
+}
+
+// setState sets the processor state with the key id.
+func (s *recordingSpan) setState(id *byte, v any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.isRecording() {
+		return
+	}
+	// The map is copied on write so the state of the snapshot of an ended
+	// span can be read without holding the span lock.
+	state := make(map[*byte]any, len(s.state)+1)
+	maps.Copy(state, s.state)
+	state[id] = v
+	s.state = state
+}
+
+// stateMap returns the processor state of s.
+func (s *recordingSpan) stateMap() map[*byte]any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.state
+}
@@ -0,0 +1,93 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stateProcessor is a SpanProcessor that attaches the number of the span it
+// started to the span and reads it when the span ends.
+type stateProcessor struct {
+	key     SpanStateKey[int]
+	started int
+	ended   []int
+	missing int
+}
+
+func (p *stateProcessor) OnStart(_ context.Context, s ReadWriteSpan) {
+	p.started++
+	p.key.Set(s, p.started)
+}
+
+func (p *stateProcessor) OnEnd(s ReadOnlySpan) {
+	v, ok := p.key.Get(s)
+	if !ok {
+		p.missing++
+		return
+	}
+	p.ended = append(p.ended, v)
+}
+
+func (*stateProcessor) Shutdown(context.Context) error   { return nil }
+func (*stateProcessor) ForceFlush(context.Context) error { return nil }
+
+func TestSpanStateKey(t *testing.T) {
+	p0 := &stateProcessor{key: NewSpanStateKey[int]()}
+	p1 := &stateProcessor{key: NewSpanStateKey[int]()}
+	tracer := NewTracerProvider(WithSpanProcessor(p0), WithSpanProcessor(p1)).Tracer("TestSpanStateKey")
+
+	_, s0 := tracer.Start(t.Context(), "span0")
+	_, s1 := tracer.Start(t.Context(), "span1")
+	s1.End()
+	s0.End()
+
+	// Each processor only reads the state it attached.
+	assert.Equal(t, []int{2, 1}, p0.ended)
+	assert.Equal(t, []int{2, 1}, p1.ended)
+	assert.Zero(t, p0.missing)
+	assert.Zero(t, p1.missing)
+}
+
+func TestSpanStateKeyReplace(t *testing.T) {
+	key := NewSpanStateKey[string]()
+	te := NewTestExporter()
+	tracer := NewTracerProvider(WithSyncer(te)).Tracer("TestSpanStateKeyReplace")
+
+	_, span := tracer.Start(t.Context(), "span")
+	s := span.(ReadWriteSpan)
+	_, ok := key.Get(s)
+	assert.False(t, ok, "state before set")
+
+	key.Set(s, "a")
+	key.Set(s, "b")
+	v, ok := key.Get(s)
+	assert.True(t, ok)
+	assert.Equal(t, "b", v)
+
+	other := NewSpanStateKey[string]()
+	_, ok = other.Get(s)
+	assert.False(t, ok, "state read with another key")
+
+	span.End()
+	key.Set(s, "c")
+	require.Len(t, te.Spans(), 1)
+	v, _ = key.Get(te.Spans()[0])
+	assert.Equal(t, "b", v, "state changed after the span ended")
+}
+
+func TestSpanStateKeyZeroValue(t *testing.T) {
+	var key SpanStateKey[int]
+	tracer := NewTracerProvider().Tracer("TestSpanStateKeyZeroValue")
+	_, span := tracer.Start(t.Context(), "span")
+	s := span.(ReadWriteSpan)
+
+	key.Set(s, 1)
+	_, ok := key.Get(s)
+	assert.False(t, ok)
+}
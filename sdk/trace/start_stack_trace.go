@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultStartStackTraceRate is the maximum number of start stack traces
+	// captured per second for the spans started with
+	// x.WithStartStackTrace when WithStartStackTrace is not used.
+	defaultStartStackTraceRate = 10
+
+	// maxStartStackFrames is the maximum number of frames of a start stack
+	// trace.
+	maxStartStackFrames = 16
+)
+
+// takeStartStackTrace reports whether the start stack trace of a span can be
+// captured within the rate limit of tp.
+func (tp *TracerProvider) takeStartStackTrace() bool {
+	return tp.startStackTraceLimiter != nil && tp.startStackTraceLimiter.take()
+}
+
+// startStackTrace returns the abbreviated call stack of the caller starting
+// a span. The frames of the SDK are omitted, and at most
+// maxStartStackFrames frames are returned in the format of
+// runtime/debug.Stack.
+func startStackTrace() string {
+	// Leave room for the frames of the SDK.
+	var pcs [maxStartStackFrames + 16]uintptr
+	n := runtime.Callers(2, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var b strings.Builder
+	var count int
+	for {
+		f, more := frames.Next()
+		if count == 0 && sdkFrame(f) {
+			if !more {
+				break
+			}
+			continue
+		}
+		if count == maxStartStackFrames {
+			b.WriteString("...\n")
+			break
+		}
+		b.WriteString(f.Function)
+		b.WriteString("\n\t")
+		b.WriteString(f.File)
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(f.Line))
+		b.WriteByte('\n')
+		count++
+		if !more {
+			break
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// sdkFrame reports whether f is a frame of the SDK or of the global
+// delegating Tracer starting a span.
+func sdkFrame(f runtime.Frame) bool {
+	if strings.HasSuffix(f.File, "_test.go") {
+		return false
+	}
+	return strings.HasPrefix(f.Function, "go.opentelemetry.io/otel/sdk/trace.") ||
+		strings.HasPrefix(f.Function, "go.opentelemetry.io/otel/internal/global.")
+}
@@ -0,0 +1,94 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// startStackTraces returns the start stack trace of the ended spans of te by
+// span name.
+func startStackTraces(te *testExporter) map[string]string {
+	out := make(map[string]string)
+	for _, s := range te.Spans() {
+		out[s.Name()] = ""
+		for _, kv := range s.Attributes() {
+			if kv.Key == semconv.CodeStacktraceKey {
+				out[s.Name()] = kv.Value.AsString()
+			}
+		}
+	}
+	return out
+}
+
+func TestWithStartStackTrace(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithStartStackTrace(1))
+	tracer := tp.Tracer(t.Name())
+
+	_, first := tracer.Start(t.Context(), "first")
+	// The rate of the stack traces is limited.
+	_, second := tracer.Start(t.Context(), "second")
+	first.End()
+	second.End()
+
+	got := startStackTraces(te)
+	// The frames of the SDK are omitted.
+	assert.True(t, strings.HasPrefix(got["first"], "go.opentelemetry.io/otel/sdk/trace.TestWithStartStackTrace\n\t"), got["first"])
+	assert.Contains(t, got["first"], "start_stack_trace_test.go:")
+	assert.Empty(t, got["second"])
+}
+
+// startStackTraceOption mirrors the experimental x.WithStartStackTrace option
+// of go.opentelemetry.io/otel/trace/x.
+type startStackTraceOption struct {
+	trace.SpanStartOption
+	enabled bool
+}
+
+func (startStackTraceOption) Experimental() {}
+
+func (o startStackTraceOption) StartStackTrace() bool { return o.enabled }
+
+func TestWithStartStackTraceSpanOption(t *testing.T) {
+	te := NewTestExporter()
+	tracer := NewTracerProvider(WithSyncer(te)).Tracer(t.Name())
+
+	_, span := tracer.Start(t.Context(), "span", startStackTraceOption{enabled: true})
+	span.End()
+	_, other := tracer.Start(t.Context(), "other", startStackTraceOption{enabled: true}, startStackTraceOption{})
+	other.End()
+
+	got := startStackTraces(te)
+	assert.NotEmpty(t, got["span"])
+	assert.Empty(t, got["other"])
+
+	// A non-positive rate disables the stack traces.
+	te.Reset()
+	tracer = NewTracerProvider(WithSyncer(te), WithStartStackTrace(0)).Tracer(t.Name())
+	_, span = tracer.Start(t.Context(), "span", startStackTraceOption{enabled: true})
+	span.End()
+	assert.Empty(t, startStackTraces(te)["span"])
+}
+
+func recurse(n int, f func() string) string {
+	if n == 0 {
+		return f()
+	}
+	return recurse(n-1, f)
+}
+
+func TestStartStackTraceFrames(t *testing.T) {
+	st := recurse(2*maxStartStackFrames, startStackTrace)
+	lines := strings.Split(st, "\n")
+	require.Len(t, lines, 2*maxStartStackFrames+1)
+	assert.Equal(t, "...", lines[len(lines)-1])
+}
@@ -0,0 +1,304 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultMaxTraces is the default maximum number of traces buffered by a
+// TailSamplingProcessor.
+const defaultMaxTraces = 10000
+
+// TailSamplingPolicy decides whether a complete trace is kept by a
+// TailSamplingProcessor.
+type TailSamplingPolicy interface {
+	// ShouldSample returns whether the trace made of spans is kept. The spans
+	// are in the order they ended. The returned value is not retained.
+	ShouldSample(spans []ReadOnlySpan) bool
+
+	// Description returns information describing the TailSamplingPolicy.
+	Description() string
+}
+
+type tailSamplingPolicyFunc struct {
+	desc string
+	fn   func([]ReadOnlySpan) bool
+}
+
+func (p tailSamplingPolicyFunc) ShouldSample(spans []ReadOnlySpan) bool { return p.fn(spans) }
+
+func (p tailSamplingPolicyFunc) Description() string { return p.desc }
+
+// LatencyPolicy returns a TailSamplingPolicy that keeps traces lasting at
+// least threshold, from the start of their earliest span to the end of their
+// latest span.
+func LatencyPolicy(threshold time.Duration) TailSamplingPolicy {
+	return tailSamplingPolicyFunc{
+		desc: fmt.Sprintf("LatencyPolicy{%s}", threshold),
+		fn: func(spans []ReadOnlySpan) bool {
+			var start, end time.Time
+			for _, s := range spans {
+				if start.IsZero() || s.StartTime().Before(start) {
+					start = s.StartTime()
+				}
+				if s.EndTime().After(end) {
+					end = s.EndTime()
+				}
+			}
+			return end.Sub(start) >= threshold
+		},
+	}
+}
+
+// ErrorPolicy returns a TailSamplingPolicy that keeps traces with at least one
+// span with an Error status.
+func ErrorPolicy() TailSamplingPolicy {
+	return tailSamplingPolicyFunc{
+		desc: "ErrorPolicy",
+		fn: func(spans []ReadOnlySpan) bool {
+			for _, s := range spans {
+				if s.Status().Code == codes.Error {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
+
+// AttributePolicy returns a TailSamplingPolicy that keeps traces with at least
+// one span having the attribute kv.
+func AttributePolicy(kv attribute.KeyValue) TailSamplingPolicy {
+	return tailSamplingPolicyFunc{
+		desc: fmt.Sprintf("AttributePolicy{%s=%s}", kv.Key, kv.Value.Emit()),
+		fn: func(spans []ReadOnlySpan) bool {
+			for _, s := range spans {
+				for _, a := range s.Attributes() {
+					if a.Key == kv.Key && a.Value == kv.Value {
+						return true
+					}
+				}
+			}
+			return false
+		},
+	}
+}
+
+// RateLimitPolicy returns a TailSamplingPolicy that keeps at most
+// tracesPerSecond traces per second. Bursts of up to tracesPerSecond traces,
+// and at least one, are kept.
+//
+// It is intended to be used after the other policies of a
+// TailSamplingProcessor to keep a sample of the traces they do not keep.
+func RateLimitPolicy(tracesPerSecond float64) TailSamplingPolicy {
+	return newRateLimitPolicy(tracesPerSecond, time.Now)
+}
+
+func newRateLimitPolicy(tracesPerSecond float64, now func() time.Time) TailSamplingPolicy {
+	bucket := newTokenBucket(tracesPerSecond, now)
+	return tailSamplingPolicyFunc{
+		desc: fmt.Sprintf("RateLimitPolicy{%g}", tracesPerSecond),
+		fn:   func([]ReadOnlySpan) bool { return bucket.take() },
+	}
+}
+
+// TailSamplingProcessorOption configures a TailSamplingProcessor.
+type TailSamplingProcessorOption interface {
+	applyTailSampling(tailSamplingConfig) tailSamplingConfig
+}
+
+type tailSamplingConfig struct {
+	maxTraces int
+}
+
+type tailSamplingOptionFunc func(tailSamplingConfig) tailSamplingConfig
+
+func (fn tailSamplingOptionFunc) applyTailSampling(c tailSamplingConfig) tailSamplingConfig {
+	return fn(c)
+}
+
+// WithMaxTraces sets the maximum number of traces a TailSamplingProcessor
+// buffers spans of. When the limit is reached, the decision for the trace
+// buffered the longest is made with the spans it has. The same number of
+// decisions is remembered to handle the spans ending after the root span of
+// their trace.
+//
+// If n is not positive, the default of 10000 is used.
+func WithMaxTraces(n int) TailSamplingProcessorOption {
+	return tailSamplingOptionFunc(func(c tailSamplingConfig) tailSamplingConfig {
+		if n > 0 {
+			c.maxTraces = n
+		}
+		return c
+	})
+}
+
+// TailSamplingProcessor is a SpanProcessor that decides whether to keep
+// traces once they are complete, instead of when they start like a Sampler.
+//
+// The sampled spans that end are buffered by trace. When the local root span
+// of a trace ends, the trace is kept if any of the TailSamplingPolicy of the
+// processor keeps it, and its spans are passed to another SpanProcessor.
+// Spans of the trace ending later follow the same decision. Otherwise, the
+// spans are dropped.
+//
+// The spans are only buffered by the TailSamplingProcessor, they are not
+// exported. Register the SpanProcessor exporting them, e.g. a
+// BatchSpanProcessor, as the next SpanProcessor instead of with the
+// TracerProvider. Spans need to be sampled by the Sampler of the
+// TracerProvider to be buffered; use AlwaysSample to decide on all traces.
+//
+// Use [NewTailSamplingProcessor] to create a TailSamplingProcessor.
+type TailSamplingProcessor struct {
+	next      SpanProcessor
+	policies  []TailSamplingPolicy
+	maxTraces int
+
+	mu sync.Mutex
+	// traces holds the pending traces, ordered by the time their first span
+	// ended.
+	traces  *list.List // *pendingTrace
+	pending map[trace.TraceID]*list.Element
+	// decided is a ring buffer of the last trace decisions.
+	decided  map[trace.TraceID]bool
+	ring     []trace.TraceID
+	ringNext int
+}
+
+var _ SpanProcessor = (*TailSamplingProcessor)(nil)
+
+type pendingTrace struct {
+	id    trace.TraceID
+	spans []ReadOnlySpan
+}
+
+// NewTailSamplingProcessor returns a new TailSamplingProcessor that passes
+// the spans of the traces kept by any of policies to next.
+func NewTailSamplingProcessor(next SpanProcessor, policies []TailSamplingPolicy, opts ...TailSamplingProcessorOption) *TailSamplingProcessor {
+	c := tailSamplingConfig{maxTraces: defaultMaxTraces}
+	for _, o := range opts {
+		c = o.applyTailSampling(c)
+	}
+	return &TailSamplingProcessor{
+		next:      next,
+		policies:  policies,
+		maxTraces: c.maxTraces,
+		traces:    list.New(),
+		pending:   make(map[trace.TraceID]*list.Element),
+		decided:   make(map[trace.TraceID]bool),
+		ring:      make([]trace.TraceID, c.maxTraces),
+	}
+}
+
+// OnStart passes s to the next SpanProcessor.
+func (p *TailSamplingProcessor) OnStart(ctx context.Context, s ReadWriteSpan) {
+	p.next.OnStart(ctx, s)
+}
+
+// OnEnd buffers s until the decision for its trace is made.
+func (p *TailSamplingProcessor) OnEnd(s ReadOnlySpan) {
+	sc := s.SpanContext()
+	if !sc.IsSampled() {
+		return
+	}
+
+	var keep, evicted []ReadOnlySpan
+	func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		id := sc.TraceID()
+		if decision, ok := p.decided[id]; ok {
+			if decision {
+				keep = []ReadOnlySpan{s}
+			}
+			return
+		}
+
+		var pt *pendingTrace
+		if e, ok := p.pending[id]; ok {
+			pt = e.Value.(*pendingTrace)
+		} else {
+			if p.traces.Len() >= p.maxTraces {
+				evicted = p.decideLocked(p.traces.Front())
+			}
+			pt = &pendingTrace{id: id}
+			p.pending[id] = p.traces.PushBack(pt)
+		}
+		pt.spans = append(pt.spans, s)
+
+		if parent := s.Parent(); !parent.IsValid() || parent.IsRemote() {
+			keep = p.decideLocked(p.pending[id])
+		}
+	}()
+
+	for _, span := range evicted {
+		p.next.OnEnd(span)
+	}
+	for _, span := range keep {
+		p.next.OnEnd(span)
+	}
+}
+
+// decideLocked makes the decision for the pending trace e, and returns its
+// spans if it is kept. The lock p.mu needs to be held.
+func (p *TailSamplingProcessor) decideLocked(e *list.Element) []ReadOnlySpan {
+	pt := p.traces.Remove(e).(*pendingTrace)
+	delete(p.pending, pt.id)
+
+	keep := p.shouldSample(pt.spans)
+	if old := p.ring[p.ringNext]; old.IsValid() {
+		delete(p.decided, old)
+	}
+	p.ring[p.ringNext] = pt.id
+	p.ringNext = (p.ringNext + 1) % len(p.ring)
+	p.decided[pt.id] = keep
+
+	if !keep {
+		return nil
+	}
+	return pt.spans
+}
+
+func (p *TailSamplingProcessor) shouldSample(spans []ReadOnlySpan) bool {
+	for _, policy := range p.policies {
+		if policy.ShouldSample(spans) {
+			return true
+		}
+	}
+	return false
+}
+
+// Shutdown makes the decision for all the pending traces with the spans they
+// have, and shuts down the next SpanProcessor.
+func (p *TailSamplingProcessor) Shutdown(ctx context.Context) error {
+	var keep []ReadOnlySpan
+	func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		for p.traces.Len() > 0 {
+			keep = append(keep, p.decideLocked(p.traces.Front())...)
+		}
+	}()
+
+	for _, s := range keep {
+		p.next.OnEnd(s)
+	}
+	return p.next.Shutdown(ctx)
+}
+
+// ForceFlush flushes the next SpanProcessor. Pending traces are not flushed,
+// the decision for them is made once they are complete.
+func (p *TailSamplingProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
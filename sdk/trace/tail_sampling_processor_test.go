@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func spanNames(spans []ReadOnlySpan) []string {
+	names := make([]string, len(spans))
+	for i, s := range spans {
+		names[i] = s.Name()
+	}
+	return names
+}
+
+func TestTailSamplingProcessor(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(WithSpanProcessor(
+		NewTailSamplingProcessor(rec, []TailSamplingPolicy{ErrorPolicy()}),
+	))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root := tracer.Start(t.Context(), "ok-root")
+	_, child := tracer.Start(ctx, "ok-child")
+	child.End()
+	root.End()
+	assert.Empty(t, *rec, "trace without error kept")
+
+	ctx, root = tracer.Start(t.Context(), "error-root")
+	_, child = tracer.Start(ctx, "error-child")
+	child.SetStatus(codes.Error, "failure")
+	child.End()
+	assert.Empty(t, *rec, "spans passed before the root span ended")
+	_, late := tracer.Start(ctx, "error-late")
+	root.End()
+	assert.Equal(t, []string{"error-child", "error-root"}, spanNames(*rec))
+
+	late.End()
+	assert.Equal(t, []string{"error-child", "error-root", "error-late"}, spanNames(*rec))
+}
+
+func TestTailSamplingProcessorMaxTraces(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(WithSpanProcessor(NewTailSamplingProcessor(
+		rec,
+		[]TailSamplingPolicy{AttributePolicy(attribute.Bool("keep", true))},
+		WithMaxTraces(1),
+	)))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root0 := tracer.Start(t.Context(), "root0")
+	_, child0 := tracer.Start(ctx, "child0", trace.WithAttributes(attribute.Bool("keep", true)))
+	child0.End()
+
+	ctx, root1 := tracer.Start(t.Context(), "root1")
+	_, child1 := tracer.Start(ctx, "child1")
+	child1.End()
+	assert.Equal(t, []string{"child0"}, spanNames(*rec), "oldest trace not decided")
+
+	root1.End()
+	root0.End()
+	assert.Equal(t, []string{"child0"}, spanNames(*rec), "decision not remembered")
+}
+
+func TestTailSamplingProcessorShutdown(t *testing.T) {
+	rec := new(recorder)
+	p := NewTailSamplingProcessor(rec, []TailSamplingPolicy{LatencyPolicy(0)})
+	tp := NewTracerProvider(WithSpanProcessor(p))
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root := tracer.Start(t.Context(), "root")
+	_, child := tracer.Start(ctx, "child")
+	child.End()
+
+	require.NoError(t, tp.Shutdown(t.Context()))
+	assert.Equal(t, []string{"child"}, spanNames(*rec), "pending trace not decided")
+	root.End()
+}
+
+func TestTailSamplingProcessorUnsampled(t *testing.T) {
+	rec := new(recorder)
+	tp := NewTracerProvider(
+		WithSampler(NeverSample()),
+		WithSpanProcessor(NewTailSamplingProcessor(rec, []TailSamplingPolicy{LatencyPolicy(0)})),
+	)
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.End()
+	assert.Empty(t, *rec)
+}
+
+func TestLatencyPolicy(t *testing.T) {
+	start := time.Unix(0, 0)
+	spans := []ReadOnlySpan{
+		&snapshot{startTime: start.Add(time.Second), endTime: start.Add(2 * time.Second)},
+		&snapshot{startTime: start, endTime: start.Add(time.Second)},
+	}
+	assert.True(t, LatencyPolicy(2*time.Second).ShouldSample(spans))
+	assert.False(t, LatencyPolicy(2*time.Second+1).ShouldSample(spans))
+	assert.Equal(t, "LatencyPolicy{2s}", LatencyPolicy(2*time.Second).Description())
+}
+
+func TestAttributePolicy(t *testing.T) {
+	p := AttributePolicy(attribute.String("key", "value"))
+	assert.Equal(t, "AttributePolicy{key=value}", p.Description())
+	assert.True(t, p.ShouldSample([]ReadOnlySpan{
+		&snapshot{},
+		&snapshot{attributes: []attribute.KeyValue{attribute.String("key", "value")}},
+	}))
+	assert.False(t, p.ShouldSample([]ReadOnlySpan{
+		&snapshot{attributes: []attribute.KeyValue{attribute.String("key", "other")}},
+	}))
+}
+
+func TestRateLimitPolicy(t *testing.T) {
+	now := time.Unix(0, 0)
+	p := newRateLimitPolicy(2, func() time.Time { return now })
+	assert.Equal(t, "RateLimitPolicy{2}", p.Description())
+
+	assert.True(t, p.ShouldSample(nil))
+	assert.True(t, p.ShouldSample(nil))
+	assert.False(t, p.ShouldSample(nil), "burst exceeded")
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, p.ShouldSample(nil))
+	assert.False(t, p.ShouldSample(nil))
+
+	assert.False(t, RateLimitPolicy(0).ShouldSample(nil))
+}
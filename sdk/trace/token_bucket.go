@@ -0,0 +1,59 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// tokenBucket limits the rate of events with a token bucket. The bucket holds
+// up to rate tokens, and at least one. It starts full and is refilled at a
+// rate of rate tokens per second.
+type tokenBucket struct {
+	rate  float64
+	burst float64
+
+	// now returns the current time. It is overridden in tests.
+	now func() time.Time
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, now func() time.Time) *tokenBucket {
+	burst := math.Max(rate, 1)
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		now:    now,
+		tokens: burst,
+		last:   now(),
+	}
+}
+
+// take reports whether a token is available, and if so, removes it from the
+// bucket. No token is ever available if the rate is not positive.
+func (b *tokenBucket) take() bool {
+	if b.rate <= 0 {
+		return false
+	}
+
+	now := b.now()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// The bucket is not refilled if the clock goes backwards.
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(2, func() time.Time { return now })
+
+	assert.True(t, b.take())
+	assert.True(t, b.take())
+	assert.False(t, b.take(), "burst")
+
+	now = now.Add(-time.Hour)
+	assert.False(t, b.take(), "refilled when the clock goes backwards")
+
+	now = now.Add(time.Hour + 500*time.Millisecond)
+	assert.True(t, b.take(), "refill")
+	assert.False(t, b.take())
+}
+
+func TestTokenBucketNotPositive(t *testing.T) {
+	now := time.Unix(0, 0)
+	b := newTokenBucket(0, func() time.Time { return now })
+	assert.False(t, b.take())
+	now = now.Add(time.Hour)
+	assert.False(t, b.take())
+}
@@ -5,6 +5,7 @@ package trace
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
@@ -455,6 +456,90 @@ func TestSamplerAttributesLocalChildSpan(t *testing.T) {
 	assert.Equal(t, []attribute.KeyValue{attribute.Int("callCount", 1)}, gotSpan1.Attributes())
 }
 
+type resultSampler struct {
+	result SamplingResult
+}
+
+func (s resultSampler) ShouldSample(SamplingParameters) SamplingResult { return s.result }
+func (resultSampler) Description() string                              { return "resultSampler" }
+
+func TestSamplerAttributes(t *testing.T) {
+	set := attribute.NewSet(attribute.String("set", "a"), attribute.String("shared", "set"))
+	sampler := resultSampler{result: SamplingResult{
+		Decision:     RecordAndSample,
+		AttributeSet: set,
+		Attributes:   []attribute.KeyValue{attribute.String("slice", "b")},
+	}}
+
+	tests := []struct {
+		name      string
+		limit     int
+		wantAttrs []attribute.KeyValue
+		wantDrop  int
+	}{
+		{
+			name:  "Unlimited",
+			limit: -1,
+			wantAttrs: []attribute.KeyValue{
+				attribute.String("set", "a"),
+				attribute.String("shared", "start"),
+				attribute.String("slice", "b"),
+				attribute.String("start", "c"),
+			},
+		},
+		{
+			name:  "OverCapacity",
+			limit: 3,
+			wantAttrs: []attribute.KeyValue{
+				attribute.String("set", "a"),
+				attribute.String("shared", "start"),
+				attribute.String("slice", "b"),
+			},
+			wantDrop: 1,
+		},
+		{
+			name:     "NoAttributes",
+			limit:    0,
+			wantDrop: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits := NewSpanLimits()
+			limits.AttributeCountLimit = tt.limit
+			te := NewTestExporter()
+			tp := NewTracerProvider(
+				WithSampler(sampler),
+				WithSyncer(te),
+				WithRawSpanLimits(limits),
+				WithSortedAttributes(),
+			)
+			_, span := tp.Tracer(t.Name()).Start(
+				t.Context(),
+				"span",
+				trace.WithAttributes(
+					attribute.String("shared", "start"),
+					attribute.String("start", "c"),
+				),
+			)
+
+			want := []attribute.KeyValue{
+				attribute.String("set", "a"),
+				attribute.String("shared", "set"),
+				attribute.String("slice", "b"),
+			}
+			assert.Equal(t, want, span.(ReadOnlySpan).SamplerAttributes())
+			span.End()
+
+			got := te.Spans()
+			require.Len(t, got, 1)
+			assert.Equal(t, tt.wantAttrs, got[0].Attributes())
+			assert.Equal(t, tt.wantDrop, got[0].DroppedAttributes())
+			assert.Equal(t, want, got[0].SamplerAttributes())
+		})
+	}
+}
+
 func TestSpanSetAttributes(t *testing.T) {
 	attrs := [...]attribute.KeyValue{
 		attribute.String("key1", "value1"),
@@ -688,6 +773,54 @@ func TestEvents(t *testing.T) {
 	}
 }
 
+// eventCapacityOption mirrors the experimental x.WithEventCapacity option of
+// go.opentelemetry.io/otel/trace/x.
+type eventCapacityOption struct {
+	trace.SpanStartOption
+	n int
+}
+
+func (eventCapacityOption) Experimental() {}
+
+func (o eventCapacityOption) EventCapacity() int { return o.n }
+
+// linkCapacityOption mirrors the experimental x.WithLinkCapacity option of
+// go.opentelemetry.io/otel/trace/x.
+type linkCapacityOption struct {
+	trace.SpanStartOption
+	n int
+}
+
+func (linkCapacityOption) Experimental() {}
+
+func (o linkCapacityOption) LinkCapacity() int { return o.n }
+
+func TestEventLinkCapacity(t *testing.T) {
+	sl := NewSpanLimits()
+	sl.LinkCountLimit = 2
+	tp := NewTracerProvider(WithSpanLimits(sl), WithResource(resource.Empty()))
+	tr := tp.Tracer("EventLinkCapacity")
+
+	_, span := tr.Start(context.Background(), "span")
+	s := span.(*recordingSpan)
+	assert.Nil(t, s.events.queue, "events allocated without hint")
+	assert.Nil(t, s.links.queue, "links allocated without hint")
+
+	_, span = tr.Start(
+		context.Background(), "span",
+		eventCapacityOption{n: 64},
+		linkCapacityOption{n: 8},
+	)
+	s = span.(*recordingSpan)
+	assert.Equal(t, 64, cap(s.events.queue), "events capacity")
+	assert.Equal(t, 2, cap(s.links.queue), "links capacity not bounded by limit")
+
+	for i := range 64 {
+		s.AddEvent(strconv.Itoa(i))
+	}
+	assert.Equal(t, 64, cap(s.events.queue), "events storage grown")
+}
+
 func TestEventsOverLimit(t *testing.T) {
 	te := NewTestExporter()
 	sl := NewSpanLimits()
@@ -927,6 +1060,7 @@ func cmpDiff(x, y any) string {
 	return cmp.Diff(
 		x, y,
 		cmp.AllowUnexported(snapshot{}),
+		cmp.Transformer("spanResource", func(r *spanResource) *resource.Resource { return r.get() }),
 		cmp.AllowUnexported(attribute.Value{}),
 		cmp.AllowUnexported(Event{}),
 		cmp.AllowUnexported(trace.TraceState{}),
@@ -1504,7 +1638,7 @@ func TestWithResource(t *testing.T) {
 					attribute.String("key1", "value1"),
 				},
 				spanKind:             trace.SpanKindInternal,
-				resource:             tc.want,
+				resource:             &spanResource{base: tc.want},
 				instrumentationScope: instrumentation.Scope{Name: "WithResource"},
 			}
 			if diff := cmpDiff(got, want); diff != "" {
@@ -1660,6 +1794,52 @@ func TestSpanWithoutPanicRecording(t *testing.T) {
 	assert.Empty(t, spans[0].Events())
 }
 
+func TestWithSortedAttributes(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithSortedAttributes())
+	linked := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{1},
+		SpanID:  trace.SpanID{1},
+	})
+	_, span := tp.Tracer(t.Name()).Start(
+		t.Context(),
+		"span",
+		trace.WithAttributes(attribute.Int("c", 1), attribute.Int("a", 1)),
+		trace.WithLinks(trace.Link{
+			SpanContext: linked,
+			Attributes:  []attribute.KeyValue{attribute.Int("z", 1), attribute.Int("y", 1)},
+		}),
+	)
+	span.SetAttributes(attribute.Int("b", 1), attribute.Int("c", 2))
+	unsorted := []attribute.KeyValue{attribute.Int("x", 1), attribute.Int("w", 1)}
+	span.AddEvent("event", trace.WithAttributes(unsorted...))
+	span.End()
+
+	spans := te.Spans()
+	require.Len(t, spans, 1)
+	got := spans[0]
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("a", 1),
+		attribute.Int("b", 1),
+		attribute.Int("c", 2),
+	}, got.Attributes())
+	require.Len(t, got.Events(), 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("w", 1),
+		attribute.Int("x", 1),
+	}, got.Events()[0].Attributes)
+	require.Len(t, got.Links(), 1)
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("y", 1),
+		attribute.Int("z", 1),
+	}, got.Links()[0].Attributes)
+
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.Int("x", 1),
+		attribute.Int("w", 1),
+	}, unsorted, "user attributes modified")
+}
+
 func TestSpanCapturesPanicWithStackTrace(t *testing.T) {
 	te := NewTestExporter()
 	tp := NewTracerProvider(WithSyncer(te), WithResource(resource.Empty()))
@@ -2084,6 +2264,50 @@ func TestWithIDGenerator(t *testing.T) {
 	}
 }
 
+func TestWithScopeIDGenerator(t *testing.T) {
+	def := &testIDGenerator{traceIDHigh: 1, spanID: 1}
+	aws := &testIDGenerator{traceIDHigh: 2, spanID: 1 << 32}
+	var calls []trace.SpanKind
+	tp := NewTracerProvider(
+		WithIDGenerator(def),
+		WithScopeIDGenerator(func(scope instrumentation.Scope, kind trace.SpanKind) IDGenerator {
+			calls = append(calls, kind)
+			if scope.Name == "aws" && kind == trace.SpanKindClient {
+				return aws
+			}
+			return nil
+		}),
+	)
+
+	high := func(s trace.Span) uint64 {
+		tid := s.SpanContext().TraceID()
+		return binary.BigEndian.Uint64(tid[:8])
+	}
+
+	tracer := tp.Tracer("aws")
+	_ = tp.Tracer("aws")
+	assert.Equal(t, []trace.SpanKind{
+		trace.SpanKindInternal,
+		trace.SpanKindServer,
+		trace.SpanKindClient,
+		trace.SpanKindProducer,
+		trace.SpanKindConsumer,
+	}, calls, "called once per kind when the tracer is created")
+
+	ctx, root := tracer.Start(t.Context(), "root")
+	assert.Equal(t, uint64(1), high(root), "default root")
+	_, client := tracer.Start(ctx, "client", trace.WithSpanKind(trace.SpanKindClient))
+	assert.Equal(t, root.SpanContext().TraceID(), client.SpanContext().TraceID(), "same trace")
+	sid := client.SpanContext().SpanID()
+	assert.Equal(t, uint64(1<<32), binary.BigEndian.Uint64(sid[:]), "client span ID")
+
+	_, client = tracer.Start(t.Context(), "client", trace.WithSpanKind(trace.SpanKindClient))
+	assert.Equal(t, uint64(2), high(client), "client root")
+
+	_, other := tp.Tracer("other").Start(t.Context(), "client", trace.WithSpanKind(trace.SpanKindClient))
+	assert.Equal(t, uint64(1), high(other), "other scope")
+}
+
 func TestIDsRoundTrip(t *testing.T) {
 	gen := defaultIDGenerator()
 
@@ -3028,3 +3252,295 @@ func BenchmarkTraceStart(b *testing.B) {
 		})
 	}
 }
+
+func TestSpanAddLinksLimits(t *testing.T) {
+	te := NewTestExporter()
+	sl := NewSpanLimits()
+	sl.LinkCountLimit = 2
+	sl.AttributeValueLengthLimit = 2
+	tp := NewTracerProvider(WithRawSpanLimits(sl), WithSyncer(te), WithResource(resource.Empty()))
+
+	attrs := []attribute.KeyValue{attribute.String("k", "value")}
+	span := startSpan(tp, "AddLinks", trace.WithLinks(trace.Link{SpanContext: sc}))
+	span.(*recordingSpan).AddLinks(
+		trace.Link{SpanContext: sc, Attributes: attrs},
+		trace.Link{}, // Invalid, not recorded.
+		trace.Link{SpanContext: sc, Attributes: attrs},
+	)
+	assert.Equal(t, "value", attrs[0].Value.AsString(), "caller attributes modified")
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	want := []Link{
+		{SpanContext: sc, Attributes: []attribute.KeyValue{attribute.String("k", "va")}},
+		{SpanContext: sc, Attributes: []attribute.KeyValue{attribute.String("k", "va")}},
+	}
+	assert.Equal(t, want, got.Links())
+	assert.Equal(t, 1, got.DroppedLinks())
+}
+
+func TestSpanDroppedLinksNoLinkCapacity(t *testing.T) {
+	te := NewTestExporter()
+	sl := NewSpanLimits()
+	sl.LinkCountLimit = 0
+	tp := NewTracerProvider(WithRawSpanLimits(sl), WithSyncer(te), WithResource(resource.Empty()))
+
+	span := startSpan(tp, "DroppedLinks")
+	span.AddLink(trace.Link{SpanContext: sc})
+
+	got, err := endSpan(te, span)
+	require.NoError(t, err)
+	assert.Empty(t, got.Links())
+	assert.Equal(t, 1, got.DroppedLinks())
+}
+
+func TestWithMaxSpanDepth(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithMaxSpanDepth(2))
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root := tracer.Start(t.Context(), "root")
+	ctx, child := tracer.Start(ctx, "child")
+	assert.True(t, child.IsRecording())
+
+	// Descendants beyond the depth limit are suppressed and share the
+	// SpanContext of the last recorded ancestor.
+	deepCtx, deep := tracer.Start(ctx, "deep")
+	assert.False(t, deep.IsRecording())
+	assert.Equal(t, child.SpanContext(), deep.SpanContext())
+	_, deeper := tracer.Start(deepCtx, "deeper")
+	assert.False(t, deeper.IsRecording())
+	_, deep2 := tracer.Start(ctx, "deep2")
+	assert.False(t, deep2.IsRecording())
+
+	// A new root starts at depth 1.
+	_, newRoot := tracer.Start(deepCtx, "new root", trace.WithNewRoot())
+	assert.True(t, newRoot.IsRecording())
+
+	for _, s := range []trace.Span{deeper, deep, deep2, child, newRoot, root} {
+		s.End()
+	}
+
+	spans := te.Spans()
+	require.Len(t, spans, 3)
+	var names []string
+	for _, s := range spans {
+		names = append(names, s.Name())
+	}
+	assert.Equal(t, []string{"child", "new root", "root"}, names)
+	assert.Equal(t, []attribute.KeyValue{suppressedDescendantsKey.Int64(3)}, spans[0].Attributes())
+	assert.Empty(t, spans[1].Attributes())
+	assert.Empty(t, spans[2].Attributes())
+}
+
+func TestWithMaxSpanDepthOtherProvider(t *testing.T) {
+	tp := NewTracerProvider(WithMaxSpanDepth(1))
+	ctx, parent := NewTracerProvider().Tracer(t.Name()).Start(t.Context(), "parent")
+	defer parent.End()
+
+	// The depth of the spans of another TracerProvider is not counted.
+	ctx, span := tp.Tracer(t.Name()).Start(ctx, "span")
+	defer span.End()
+	assert.True(t, span.IsRecording())
+
+	_, child := tp.Tracer(t.Name()).Start(ctx, "child")
+	defer child.End()
+	assert.False(t, child.IsRecording())
+}
+
+func TestWithMaxSpansPerTrace(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithMaxSpansPerTrace(3))
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root := tracer.Start(t.Context(), "root")
+	childCtx, child := tracer.Start(ctx, "child")
+	_, sibling := tracer.Start(ctx, "sibling")
+	assert.True(t, sibling.IsRecording())
+
+	// The trace already has 3 recorded spans.
+	overflowCtx, overflow := tracer.Start(childCtx, "overflow")
+	assert.False(t, overflow.IsRecording())
+	assert.Equal(t, child.SpanContext(), overflow.SpanContext())
+	_, overflowChild := tracer.Start(overflowCtx, "overflow child")
+	assert.False(t, overflowChild.IsRecording())
+	_, overflow2 := tracer.Start(ctx, "overflow2")
+	assert.False(t, overflow2.IsRecording())
+
+	// Other traces are not limited.
+	_, other := tracer.Start(t.Context(), "other")
+	assert.True(t, other.IsRecording())
+
+	for _, s := range []trace.Span{overflowChild, overflow, overflow2, sibling, child, other, root} {
+		s.End()
+	}
+
+	attrs := make(map[string][]attribute.KeyValue)
+	for _, s := range te.Spans() {
+		attrs[s.Name()] = s.Attributes()
+	}
+	assert.Equal(t, map[string][]attribute.KeyValue{
+		"root": {
+			suppressedDescendantsKey.Int64(1),
+			suppressedSpansKey.Int64(3),
+		},
+		"child":   {suppressedDescendantsKey.Int64(2)},
+		"sibling": nil,
+		"other":   nil,
+	}, attrs)
+}
+
+func TestWithMaxSpansPerTraceNotSampled(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(
+		WithSyncer(te),
+		WithMaxSpansPerTrace(2),
+		WithSampler(nameSampler{drop: "dropped"}),
+	)
+	tracer := tp.Tracer(t.Name())
+
+	ctx, root := tracer.Start(t.Context(), "root")
+	_, dropped := tracer.Start(ctx, "dropped")
+	assert.False(t, dropped.IsRecording())
+
+	// The spans dropped by the Sampler are not counted.
+	_, child := tracer.Start(ctx, "child")
+	assert.True(t, child.IsRecording())
+	child.End()
+	dropped.End()
+	root.End()
+	assert.Len(t, te.Spans(), 2)
+}
+
+// nameSampler drops the spans with a name and samples the others.
+type nameSampler struct {
+	drop string
+}
+
+func (s nameSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	if p.Name == s.drop {
+		return SamplingResult{Decision: Drop}
+	}
+	return SamplingResult{Decision: RecordAndSample}
+}
+
+func (nameSampler) Description() string { return "nameSampler" }
+
+// defaultSpanKindOption mirrors the experimental x.WithDefaultSpanKind option
+// of go.opentelemetry.io/otel/trace/x.
+type defaultSpanKindOption struct {
+	trace.TracerOption
+	kind trace.SpanKind
+}
+
+func (defaultSpanKindOption) Experimental() {}
+
+func (o defaultSpanKindOption) DefaultSpanKind() trace.SpanKind { return o.kind }
+
+// defaultSpanAttributesOption mirrors the experimental
+// x.WithDefaultSpanAttributes option of go.opentelemetry.io/otel/trace/x.
+type defaultSpanAttributesOption struct {
+	trace.TracerOption
+	attrs []attribute.KeyValue
+}
+
+func (defaultSpanAttributesOption) Experimental() {}
+
+func (o defaultSpanAttributesOption) DefaultSpanAttributes() []attribute.KeyValue { return o.attrs }
+
+func TestTracerDefaultSpanOptions(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithIntrospection())
+	tracer := tp.Tracer(
+		t.Name(),
+		defaultSpanKindOption{kind: trace.SpanKindClient},
+		defaultSpanAttributesOption{attrs: []attribute.KeyValue{attribute.String("db.system", "redis")}},
+		defaultSpanAttributesOption{attrs: []attribute.KeyValue{attribute.Int("retry", 0)}},
+	)
+
+	_, s := tracer.Start(t.Context(), "default")
+	s.End()
+	_, s = tracer.Start(
+		t.Context(),
+		"override",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.Int("retry", 1)),
+	)
+	s.End()
+	// The tracer of the scope without defaults does not use them.
+	_, s = tp.Tracer(t.Name()).Start(t.Context(), "plain")
+	s.End()
+
+	spans := te.Spans()
+	require.Len(t, spans, 3)
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	assert.Equal(t, []attribute.KeyValue{
+		attribute.String("db.system", "redis"),
+		attribute.Int("retry", 0),
+	}, spans[0].Attributes())
+	assert.Equal(t, trace.SpanKindProducer, spans[1].SpanKind())
+	assert.ElementsMatch(t, []attribute.KeyValue{
+		attribute.String("db.system", "redis"),
+		attribute.Int("retry", 1),
+	}, spans[1].Attributes())
+	assert.Equal(t, trace.SpanKindInternal, spans[2].SpanKind())
+	assert.Empty(t, spans[2].Attributes())
+
+	// The tracers share the span counts of their scope.
+	tracers := tp.Tracers()
+	require.Len(t, tracers, 1)
+	assert.Equal(t, uint64(3), tracers[0].SpansStarted)
+}
+
+func TestWithLinkDeduplication(t *testing.T) {
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te), WithLinkDeduplication())
+	tracer := tp.Tracer(t.Name())
+
+	sc := func(b byte) trace.SpanContext {
+		return trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: trace.TraceID{b},
+			SpanID:  trace.SpanID{b},
+		})
+	}
+	k1v1, k2v2 := attribute.String("k1", "v1"), attribute.String("k2", "v2")
+	_, span := tracer.Start(t.Context(), "span", trace.WithLinks(
+		trace.Link{SpanContext: sc(1)},
+		trace.Link{SpanContext: sc(1)},
+		trace.Link{SpanContext: sc(2), Attributes: []attribute.KeyValue{k1v1, k2v2}},
+	))
+	// The order of the attributes does not matter.
+	span.AddLink(trace.Link{SpanContext: sc(2), Attributes: []attribute.KeyValue{k2v2, k1v1}})
+	// Links with other attributes are not duplicates.
+	span.AddLink(trace.Link{SpanContext: sc(2), Attributes: []attribute.KeyValue{k1v1}})
+	// Self-link.
+	span.AddLink(trace.Link{SpanContext: span.SpanContext()})
+	span.End()
+
+	spans := te.Spans()
+	require.Len(t, spans, 1)
+	got := spans[0]
+	assert.Equal(t, []Link{
+		{SpanContext: sc(1)},
+		{SpanContext: sc(2), Attributes: []attribute.KeyValue{k1v1, k2v2}},
+		{SpanContext: sc(2), Attributes: []attribute.KeyValue{k1v1}},
+	}, got.Links())
+	assert.Equal(t, 0, got.DroppedLinks())
+	assert.Contains(t, got.Attributes(), attribute.Int64("span.removed_links", 3))
+}
+
+func TestLinksNotDeduplicatedByDefault(t *testing.T) {
+	te := NewTestExporter()
+	tracer := NewTracerProvider(WithSyncer(te)).Tracer(t.Name())
+
+	_, span := tracer.Start(t.Context(), "span")
+	link := trace.Link{SpanContext: span.SpanContext()}
+	span.AddLink(link)
+	span.AddLink(link)
+	span.End()
+
+	spans := te.Spans()
+	require.Len(t, spans, 1)
+	assert.Len(t, spans[0].Links(), 2)
+	assert.Empty(t, spans[0].Attributes())
+}
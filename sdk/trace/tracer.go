@@ -5,10 +5,13 @@ package trace
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/sdk/instrumentation"
 	"go.opentelemetry.io/otel/sdk/trace/internal/observ"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
 	"go.opentelemetry.io/otel/trace"
 	"go.opentelemetry.io/otel/trace/embedded"
 )
@@ -20,6 +23,19 @@ type tracer struct {
 	instrumentationScope instrumentation.Scope
 
 	inst observ.Tracer
+
+	// idGenerators are the IDGenerators of the spans by kind, or nil if the
+	// IDGenerator of the provider is used for all spans.
+	idGenerators []IDGenerator
+
+	// processors are the SpanProcessors returned by the
+	// SpanProcessorFactories of the provider for the scope of the tracer.
+	// They process the spans after the SpanProcessors of the provider.
+	processors []SpanProcessor
+
+	// counts count the spans of the tracer. It is nil unless the
+	// introspection or the observability of the TracerProvider is enabled.
+	counts *spanCounts
 }
 
 var _ trace.Tracer = &tracer{}
@@ -35,6 +51,7 @@ func (tr *tracer) Start(
 	options ...trace.SpanStartOption,
 ) (context.Context, trace.Span) {
 	config := trace.NewSpanStartConfig(options...)
+	ext := newStartExtensions(options)
 
 	if ctx == nil {
 		// Prevent trace.ContextWithSpan from panicking.
@@ -48,7 +65,7 @@ func (tr *tracer) Start(
 		}
 	}
 
-	s := tr.newSpan(ctx, name, &config)
+	s := tr.newSpan(ctx, name, &config, &ext)
 	newCtx := trace.ContextWithSpan(ctx, s)
 	if tr.inst.Enabled() {
 		if o, ok := s.(interface{ setOrigCtx(context.Context) }); ok {
@@ -69,6 +86,12 @@ func (tr *tracer) Start(
 			// Use original context.
 			sp.sp.OnStart(ctx, rw)
 		}
+		for _, sp := range tr.getScopeProcessors() {
+			sp.OnStart(ctx, rw)
+		}
+		for _, l := range tr.provider.getSpanListeners() {
+			l.OnSpanStarted(rw)
+		}
 	}
 	if rtt, ok := s.(runtimeTracer); ok {
 		newCtx = rtt.runtimeTrace(newCtx)
@@ -77,6 +100,99 @@ func (tr *tracer) Start(
 	return newCtx, s
 }
 
+// idGenerator returns the IDGenerator of the spans of kind.
+func (tr *tracer) idGenerator(kind trace.SpanKind) IDGenerator {
+	if tr.idGenerators == nil || int(kind) >= len(tr.idGenerators) || kind < 0 {
+		return tr.provider.idGenerator
+	}
+	return tr.idGenerators[kind]
+}
+
+// startExtensions are the span start options of the experimental
+// go.opentelemetry.io/otel/trace/x package passed to Start. They are found by
+// their methods as the SDK does not depend on that package.
+type startExtensions struct {
+	// stackTrace is set by the x.WithStartStackTrace option.
+	stackTrace bool
+	// eventCapacity and linkCapacity are set by the x.WithEventCapacity and
+	// x.WithLinkCapacity options. A value less than or equal to zero means
+	// no hint was provided.
+	eventCapacity int
+	linkCapacity  int
+}
+
+// newStartExtensions returns the startExtensions of options. The last option
+// passed takes precedence.
+func newStartExtensions(options []trace.SpanStartOption) startExtensions {
+	var ext startExtensions
+	for _, o := range options {
+		if exp, ok := o.(interface{ StartStackTrace() bool }); ok {
+			ext.stackTrace = exp.StartStackTrace()
+		}
+		if exp, ok := o.(interface{ EventCapacity() int }); ok {
+			ext.eventCapacity = exp.EventCapacity()
+		}
+		if exp, ok := o.(interface{ LinkCapacity() int }); ok {
+			ext.linkCapacity = exp.LinkCapacity()
+		}
+	}
+	return ext
+}
+
+// defaultsTracer is a tracer starting spans with the default span options
+// of the TracerConfig it was returned for. The tracers of a scope share the
+// same tracer but not their defaults.
+type defaultsTracer struct {
+	*tracer
+
+	// defaults are passed to Start before the options of the caller so the
+	// latter take precedence.
+	defaults []trace.SpanStartOption
+}
+
+// withSpanDefaults returns t starting spans with the default span options
+// of the x.WithDefaultSpanKind and x.WithDefaultSpanAttributes options of
+// opts.
+func withSpanDefaults(t trace.Tracer, opts []trace.TracerOption) trace.Tracer {
+	tr, ok := t.(*tracer)
+	if !ok {
+		return t
+	}
+	var (
+		kind  trace.SpanKind
+		attrs []attribute.KeyValue
+	)
+	for _, o := range opts {
+		if exp, ok := o.(interface{ DefaultSpanKind() trace.SpanKind }); ok {
+			kind = exp.DefaultSpanKind()
+		}
+		if exp, ok := o.(interface{ DefaultSpanAttributes() []attribute.KeyValue }); ok {
+			attrs = append(attrs, exp.DefaultSpanAttributes()...)
+		}
+	}
+	var defaults []trace.SpanStartOption
+	if kind != trace.SpanKindUnspecified {
+		defaults = append(defaults, trace.WithSpanKind(kind))
+	}
+	if len(attrs) > 0 {
+		defaults = append(defaults, trace.WithAttributes(attrs...))
+	}
+	if len(defaults) == 0 {
+		return tr
+	}
+	return &defaultsTracer{tracer: tr, defaults: slices.Clip(defaults)}
+}
+
+// Start starts a span as tracer.Start does with the default span options of
+// t followed by options.
+func (t *defaultsTracer) Start(
+	ctx context.Context,
+	name string,
+	options ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, name, append(t.defaults, options...)...)
+}
+
 type runtimeTracer interface {
 	// runtimeTrace starts a "runtime/trace".Task for the span and
 	// returns a context containing the task.
@@ -84,7 +200,7 @@ type runtimeTracer interface {
 }
 
 // newSpan returns a new configured span.
-func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanConfig) trace.Span {
+func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanConfig, ext *startExtensions) trace.Span {
 	// If told explicitly to make this a new root use a zero value SpanContext
 	// as a parent which contains an invalid trace ID and is not remote.
 	var psc trace.SpanContext
@@ -94,26 +210,41 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 		psc = trace.SpanContextFromContext(ctx)
 	}
 
+	var local localSpan
+	if tr.provider.limitsLocalSpans() {
+		var ancestor *recordingSpan
+		local, ancestor = tr.localSpan(ctx, config)
+		if ancestor != nil {
+			return tr.newSuppressedSpan(ancestor)
+		}
+	}
+
 	// If there is a valid parent trace ID, use it to ensure the continuity of
 	// the trace. Always generate a new span ID so other components can rely
 	// on a unique span ID, even if the Span is non-recording.
 	var tid trace.TraceID
 	var sid trace.SpanID
 	if !psc.TraceID().IsValid() {
-		tid, sid = tr.provider.idGenerator.NewIDs(ctx)
+		tid, sid = tr.idGenerator(config.SpanKind()).NewIDs(ctx)
 	} else {
 		tid = psc.TraceID()
-		sid = tr.provider.idGenerator.NewSpanID(ctx, tid)
+		sid = tr.idGenerator(config.SpanKind()).NewSpanID(ctx, tid)
 	}
 
-	samplingResult := tr.provider.sampler.ShouldSample(SamplingParameters{
+	params := SamplingParameters{
 		ParentContext: ctx,
 		TraceID:       tid,
 		Name:          name,
 		Kind:          config.SpanKind(),
 		Attributes:    config.Attributes(),
 		Links:         config.Links(),
-	})
+		resource:      tr.provider.resource.Load(),
+	}
+	samplingResult := tr.provider.sampler.ShouldSample(params)
+	for _, h := range tr.provider.traceStateHooks {
+		samplingResult.Tracestate = h(params, samplingResult)
+	}
+	tr.countDecision(samplingResult)
 
 	scc := trace.SpanContextConfig{
 		TraceID:    tid,
@@ -128,9 +259,33 @@ func (tr *tracer) newSpan(ctx context.Context, name string, config *trace.SpanCo
 	sc := trace.NewSpanContext(scc)
 
 	if !isRecording(samplingResult) {
+		if local.trace != nil {
+			// Only the recorded spans are counted.
+			local.trace.spans.Add(-1)
+		}
 		return tr.newNonRecordingSpan(sc)
 	}
-	return tr.newRecordingSpan(ctx, psc, sc, name, samplingResult, config)
+	s := tr.newRecordingSpan(ctx, psc, sc, name, samplingResult, config, ext)
+	if local.trace != nil && local.trace.root == nil {
+		local.trace.root = s
+	}
+	s.local = local
+	return s
+}
+
+// countDecision counts the decision of sr if the spans of tr are counted.
+func (tr *tracer) countDecision(sr SamplingResult) {
+	if tr.counts == nil {
+		return
+	}
+	switch {
+	case isSampled(sr):
+		tr.counts.recordAndSample.Add(1)
+	case isRecording(sr):
+		tr.counts.recordOnly.Add(1)
+	default:
+		tr.counts.dropped.Add(1)
+	}
 }
 
 // newRecordingSpan returns a new configured recordingSpan.
@@ -140,6 +295,7 @@ func (tr *tracer) newRecordingSpan(
 	name string,
 	sr SamplingResult,
 	config *trace.SpanConfig,
+	ext *startExtensions,
 ) *recordingSpan {
 	startTime := config.Timestamp()
 	if startTime.IsZero() {
@@ -163,14 +319,20 @@ func (tr *tracer) newRecordingSpan(
 		events:      newEvictedQueueEvent(tr.provider.spanLimits.EventCountLimit),
 		links:       newEvictedQueueLink(tr.provider.spanLimits.LinkCountLimit),
 		tracer:      tr,
+		resource:    tr.provider.resource.Load(),
 	}
+	// Unlike attributes, events and links are only pre-allocated when the
+	// instrumentation hints how many will be recorded.
+	s.events.reserve(ext.eventCapacity)
+	s.links.reserve(ext.linkCapacity)
 
-	for _, l := range config.Links() {
-		s.AddLink(l)
-	}
+	s.AddLinks(config.Links()...)
+
+	s.setStartAttributes(sr, config.Attributes())
 
-	s.SetAttributes(sr.Attributes...)
-	s.SetAttributes(config.Attributes()...)
+	if (tr.provider.startStackTraces || ext.stackTrace) && tr.provider.takeStartStackTrace() {
+		s.SetAttributes(semconv.CodeStacktrace(startStackTrace()))
+	}
 
 	if tr.inst.Enabled() {
 		// Propagate any existing values from the context with the new span to
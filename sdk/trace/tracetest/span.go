@@ -164,3 +164,7 @@ func (s spanSnapshot) InstrumentationScope() instrumentation.Scope {
 func (s spanSnapshot) InstrumentationLibrary() instrumentation.Library { //nolint:staticcheck // This method needs to be define for backwards compatibility
 	return s.instrumentationScope
 }
+
+// SamplerAttributes returns nil, a SpanStub does not record which of its
+// attributes originated from a Sampler.
+func (spanSnapshot) SamplerAttributes() []attribute.KeyValue { return nil }
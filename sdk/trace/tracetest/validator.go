@@ -0,0 +1,256 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AttributeRegistry describes the span attributes defined by a set of
+// semantic conventions.
+type AttributeRegistry struct {
+	// Types maps attribute keys to the type their values are required to
+	// have. Attributes with keys not in Types are not type checked.
+	Types map[attribute.Key]attribute.Type
+	// Rules are the attribute requirements evaluated for every span.
+	Rules []AttributeRule
+}
+
+// AttributeRule requires attributes to be present on the spans it applies
+// to.
+type AttributeRule struct {
+	// Name identifies the rule in reported violations.
+	Name string
+	// Applies returns true if the rule applies to the span. If nil, the rule
+	// applies to all spans.
+	Applies func(sdktrace.ReadOnlySpan) bool
+	// Required are the keys of the attributes the span is required to have.
+	Required []attribute.Key
+}
+
+// SemconvRegistry returns an AttributeRegistry for the stable HTTP and
+// database span semantic conventions of
+// [go.opentelemetry.io/otel/semconv/v1.43.0].
+//
+// HTTP server and client spans are identified by their span kind and the
+// presence of the http.request.method attribute. Database client spans are
+// identified by their span kind and the presence of the db.system.name
+// attribute.
+func SemconvRegistry() AttributeRegistry {
+	return AttributeRegistry{
+		Types: map[attribute.Key]attribute.Type{
+			semconv.HTTPRequestMethodKey:      attribute.STRING,
+			semconv.HTTPResponseStatusCodeKey: attribute.INT64,
+			semconv.HTTPRouteKey:              attribute.STRING,
+			semconv.ServerAddressKey:          attribute.STRING,
+			semconv.ServerPortKey:             attribute.INT64,
+			semconv.ClientAddressKey:          attribute.STRING,
+			semconv.ClientPortKey:             attribute.INT64,
+			semconv.URLFullKey:                attribute.STRING,
+			semconv.URLPathKey:                attribute.STRING,
+			semconv.URLQueryKey:               attribute.STRING,
+			semconv.URLSchemeKey:              attribute.STRING,
+			semconv.UserAgentOriginalKey:      attribute.STRING,
+			semconv.NetworkProtocolVersionKey: attribute.STRING,
+			semconv.ErrorTypeKey:              attribute.STRING,
+			semconv.DBSystemNameKey:           attribute.STRING,
+			semconv.DBNamespaceKey:            attribute.STRING,
+			semconv.DBCollectionNameKey:       attribute.STRING,
+			semconv.DBOperationNameKey:        attribute.STRING,
+			semconv.DBQueryTextKey:            attribute.STRING,
+			semconv.DBResponseStatusCodeKey:   attribute.STRING,
+		},
+		Rules: []AttributeRule{
+			{
+				Name:    "http.server",
+				Applies: spanWith(trace.SpanKindServer, semconv.HTTPRequestMethodKey),
+				Required: []attribute.Key{
+					semconv.HTTPRequestMethodKey,
+					semconv.URLPathKey,
+					semconv.URLSchemeKey,
+				},
+			},
+			{
+				Name:    "http.client",
+				Applies: spanWith(trace.SpanKindClient, semconv.HTTPRequestMethodKey),
+				Required: []attribute.Key{
+					semconv.HTTPRequestMethodKey,
+					semconv.ServerAddressKey,
+					semconv.ServerPortKey,
+					semconv.URLFullKey,
+				},
+			},
+			{
+				Name:    "db.client",
+				Applies: spanWith(trace.SpanKindClient, semconv.DBSystemNameKey),
+				Required: []attribute.Key{
+					semconv.DBSystemNameKey,
+				},
+			},
+		},
+	}
+}
+
+// spanWith returns a function that returns true for spans of kind with an
+// attribute with key.
+func spanWith(kind trace.SpanKind, key attribute.Key) func(sdktrace.ReadOnlySpan) bool {
+	return func(s sdktrace.ReadOnlySpan) bool {
+		if s.SpanKind() != kind {
+			return false
+		}
+		for _, kv := range s.Attributes() {
+			if kv.Key == key {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Violation is a span attribute that does not conform to an
+// AttributeRegistry.
+type Violation struct {
+	// SpanName is the name of the span with the violation.
+	SpanName string
+	// SpanContext is the SpanContext of the span with the violation.
+	SpanContext trace.SpanContext
+	// Key is the key of the non-conforming attribute.
+	Key attribute.Key
+	// Rule is the name of the AttributeRule requiring the attribute. It is
+	// empty if the attribute has an invalid type.
+	Rule string
+	// Type is the type of the attribute value if it has an invalid type.
+	Type attribute.Type
+	// Want is the required type of the attribute value if it has an invalid
+	// type.
+	Want attribute.Type
+}
+
+// Error returns a description of the violation.
+func (v Violation) Error() string {
+	if v.Rule != "" {
+		return fmt.Sprintf("span %q: missing attribute %q required by %s", v.SpanName, v.Key, v.Rule)
+	}
+	return fmt.Sprintf("span %q: attribute %q has type %s, want %s", v.SpanName, v.Key, v.Type, v.Want)
+}
+
+// ValidatingProcessor is a SpanProcessor that validates the attributes of
+// ended spans against an AttributeRegistry and records the violations found.
+//
+// It is intended to be used during development and in tests to catch
+// instrumentation that drifts from the semantic conventions. It should not be
+// used in production as every span is validated.
+type ValidatingProcessor struct {
+	registry AttributeRegistry
+
+	mu         sync.Mutex
+	violations []Violation
+}
+
+var _ sdktrace.SpanProcessor = (*ValidatingProcessor)(nil)
+
+// NewValidatingProcessor returns a new ValidatingProcessor that validates
+// spans against registry.
+func NewValidatingProcessor(registry AttributeRegistry) *ValidatingProcessor {
+	return &ValidatingProcessor{registry: registry}
+}
+
+// OnStart does nothing.
+func (*ValidatingProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd validates the attributes of s and records any violation found.
+//
+// This method is safe to be called concurrently.
+func (p *ValidatingProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	violations := p.validate(s)
+	if len(violations) == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.violations = append(p.violations, violations...)
+}
+
+func (p *ValidatingProcessor) validate(s sdktrace.ReadOnlySpan) []Violation {
+	var violations []Violation
+	newViolation := func(key attribute.Key) Violation {
+		return Violation{SpanName: s.Name(), SpanContext: s.SpanContext(), Key: key}
+	}
+
+	attrs := s.Attributes()
+	present := make(map[attribute.Key]struct{}, len(attrs))
+	for _, kv := range attrs {
+		present[kv.Key] = struct{}{}
+		want, ok := p.registry.Types[kv.Key]
+		if !ok || kv.Value.Type() == want {
+			continue
+		}
+		v := newViolation(kv.Key)
+		v.Type, v.Want = kv.Value.Type(), want
+		violations = append(violations, v)
+	}
+
+	for _, rule := range p.registry.Rules {
+		if rule.Applies != nil && !rule.Applies(s) {
+			continue
+		}
+		for _, key := range rule.Required {
+			if _, ok := present[key]; ok {
+				continue
+			}
+			v := newViolation(key)
+			v.Rule = rule.Name
+			violations = append(violations, v)
+		}
+	}
+	return violations
+}
+
+// Violations returns a copy of the violations recorded.
+//
+// This method is safe to be called concurrently.
+func (p *ValidatingProcessor) Violations() []Violation {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Violation(nil), p.violations...)
+}
+
+// Err returns all the violations recorded joined into a single error, or nil
+// if no violation was recorded.
+//
+// This method is safe to be called concurrently.
+func (p *ValidatingProcessor) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	errs := make([]error, len(p.violations))
+	for i, v := range p.violations {
+		errs[i] = v
+	}
+	return errors.Join(errs...)
+}
+
+// Reset clears the recorded violations.
+//
+// This method is safe to be called concurrently.
+func (p *ValidatingProcessor) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.violations = nil
+}
+
+// Shutdown does nothing.
+func (*ValidatingProcessor) Shutdown(context.Context) error { return nil }
+
+// ForceFlush does nothing.
+func (*ValidatingProcessor) ForceFlush(context.Context) error { return nil }
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package tracetest
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestValidatingProcessorSemconv(t *testing.T) {
+	tests := []struct {
+		name string
+		span SpanStub
+		want []Violation
+	}{
+		{
+			name: "NotApplicable",
+			span: SpanStub{
+				Name:       "internal",
+				SpanKind:   trace.SpanKindInternal,
+				Attributes: []attribute.KeyValue{attribute.String("key", "value")},
+			},
+		},
+		{
+			name: "HTTPServerValid",
+			span: SpanStub{
+				Name:     "GET /",
+				SpanKind: trace.SpanKindServer,
+				Attributes: []attribute.KeyValue{
+					semconv.HTTPRequestMethodGet,
+					semconv.URLPath("/"),
+					semconv.URLScheme("https"),
+					semconv.HTTPResponseStatusCode(200),
+				},
+			},
+		},
+		{
+			name: "HTTPServerMissing",
+			span: SpanStub{
+				Name:       "GET",
+				SpanKind:   trace.SpanKindServer,
+				Attributes: []attribute.KeyValue{semconv.HTTPRequestMethodGet},
+			},
+			want: []Violation{
+				{SpanName: "GET", Key: semconv.URLPathKey, Rule: "http.server"},
+				{SpanName: "GET", Key: semconv.URLSchemeKey, Rule: "http.server"},
+			},
+		},
+		{
+			name: "HTTPClientInvalidType",
+			span: SpanStub{
+				Name:     "GET",
+				SpanKind: trace.SpanKindClient,
+				Attributes: []attribute.KeyValue{
+					semconv.HTTPRequestMethodGet,
+					semconv.ServerAddress("example.com"),
+					semconv.ServerPortKey.String("443"),
+					semconv.URLFull("https://example.com"),
+				},
+			},
+			want: []Violation{
+				{SpanName: "GET", Key: semconv.ServerPortKey, Type: attribute.STRING, Want: attribute.INT64},
+			},
+		},
+		{
+			name: "DBClientMissing",
+			span: SpanStub{
+				Name:       "SELECT",
+				SpanKind:   trace.SpanKindClient,
+				Attributes: []attribute.KeyValue{semconv.DBSystemNameKey.Int(1)},
+			},
+			want: []Violation{
+				{SpanName: "SELECT", Key: semconv.DBSystemNameKey, Type: attribute.INT64, Want: attribute.STRING},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewValidatingProcessor(SemconvRegistry())
+			p.OnEnd(tt.span.Snapshot())
+			assert.Equal(t, tt.want, p.Violations())
+			if tt.want == nil {
+				assert.NoError(t, p.Err())
+			} else {
+				assert.Error(t, p.Err())
+			}
+		})
+	}
+}
+
+func TestValidatingProcessorCustomRule(t *testing.T) {
+	p := NewValidatingProcessor(AttributeRegistry{
+		Rules: []AttributeRule{{Name: "all", Required: []attribute.Key{"required"}}},
+	})
+
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(p))
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), "span")
+	span.End()
+	_, span = tp.Tracer(t.Name()).Start(
+		t.Context(),
+		"valid",
+		trace.WithAttributes(attribute.Bool("required", true)),
+	)
+	span.End()
+
+	got := p.Violations()
+	require.Len(t, got, 1)
+	assert.Equal(t, "span", got[0].SpanName)
+	assert.Equal(t, attribute.Key("required"), got[0].Key)
+	assert.True(t, got[0].SpanContext.IsValid())
+	assert.EqualError(t, p.Err(), `span "span": missing attribute "required" required by all`)
+
+	p.Reset()
+	assert.Empty(t, p.Violations())
+	assert.NoError(t, p.Err())
+}
+
+func TestViolationError(t *testing.T) {
+	v := Violation{SpanName: "name", Key: "key", Type: attribute.STRING, Want: attribute.BOOL}
+	assert.EqualError(t, v, `span "name": attribute "key" has type STRING, want BOOL`)
+}
+
+func TestValidatingProcessorConcurrentSafe(*testing.T) {
+	p := NewValidatingProcessor(AttributeRegistry{
+		Rules: []AttributeRule{{Name: "all", Required: []attribute.Key{"required"}}},
+	})
+	s := SpanStub{Name: "span"}.Snapshot()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			p.OnEnd(s)
+			_ = p.Violations()
+			_ = p.Err()
+		})
+	}
+	wg.Wait()
+}
@@ -0,0 +1,181 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/internal/volume"
+)
+
+// VolumeUsage is the volume of spans exported within a window of a
+// VolumeGuard.
+type VolumeUsage struct {
+	// Bytes is the estimated size, in bytes, of the spans exported within
+	// the window.
+	Bytes int64
+	// Budget is the size, in bytes, of spans that can be exported within the
+	// window without exceeding the budget.
+	Budget int64
+	// Start is the start time of the window.
+	Start time.Time
+	// Window is the duration of the window.
+	Window time.Duration
+}
+
+// Exceeded reports whether the exported volume exceeds the budget.
+func (u VolumeUsage) Exceeded() bool {
+	return u.Bytes > u.Budget
+}
+
+// VolumeGuardOption configures a VolumeGuard.
+type VolumeGuardOption interface {
+	applyVolumeGuard(volumeGuardConfig) volumeGuardConfig
+}
+
+type volumeGuardConfig struct {
+	window   time.Duration
+	exceeded func(VolumeUsage)
+}
+
+type volumeGuardOptionFunc func(volumeGuardConfig) volumeGuardConfig
+
+func (fn volumeGuardOptionFunc) applyVolumeGuard(c volumeGuardConfig) volumeGuardConfig {
+	return fn(c)
+}
+
+// WithVolumeWindow sets the duration of the windows the budget of a
+// VolumeGuard applies to. A value less than or equal to zero means the
+// default is used.
+//
+// By default, the budget applies to windows of one minute.
+func WithVolumeWindow(d time.Duration) VolumeGuardOption {
+	return volumeGuardOptionFunc(func(c volumeGuardConfig) volumeGuardConfig {
+		c.window = d
+		return c
+	})
+}
+
+// WithVolumeExceeded sets a function called with the usage of a window when
+// the exported volume first exceeds the budget within the window. The
+// function is called synchronously by the exporter and must not block.
+func WithVolumeExceeded(f func(VolumeUsage)) VolumeGuardOption {
+	return volumeGuardOptionFunc(func(c volumeGuardConfig) volumeGuardConfig {
+		c.exceeded = f
+		return c
+	})
+}
+
+// VolumeGuard tracks the volume of exported spans and enforces a budget of
+// bytes per window of time.
+//
+// The volume is tracked by wrapping a SpanExporter with [VolumeGuard.Exporter].
+// The size of exported spans is estimated from their content, it
+// approximates the size of the spans once serialized. The budget is enforced
+// by sampling with a different Sampler once it is exceeded, see
+// [VolumeGuard.Sampler]. Because the volume is only known once spans are
+// exported, the budget can be exceeded by the spans in progress when the
+// budget is reached.
+//
+// Use [NewVolumeGuard] to create a VolumeGuard.
+type VolumeGuard struct {
+	tracker *volume.Tracker
+}
+
+// NewVolumeGuard returns a new VolumeGuard enforcing a budget of bytes of
+// spans exported per window.
+func NewVolumeGuard(budget int64, opts ...VolumeGuardOption) *VolumeGuard {
+	var c volumeGuardConfig
+	for _, opt := range opts {
+		c = opt.applyVolumeGuard(c)
+	}
+	var exceeded func(volume.Usage)
+	if c.exceeded != nil {
+		exceeded = func(u volume.Usage) { c.exceeded(VolumeUsage(u)) }
+	}
+	return &VolumeGuard{tracker: volume.NewTracker(budget, c.window, exceeded)}
+}
+
+// Usage returns the usage of the current window.
+func (g *VolumeGuard) Usage() VolumeUsage {
+	return VolumeUsage(g.tracker.Usage())
+}
+
+// Exceeded reports whether the budget of the current window is exceeded.
+func (g *VolumeGuard) Exceeded() bool {
+	return g.tracker.Usage().Exceeded()
+}
+
+// record adds n bytes to the volume of the current window.
+func (g *VolumeGuard) record(n int64) {
+	g.tracker.Record(n)
+}
+
+// Exporter returns a SpanExporter that exports spans with exporter and
+// records their estimated size in the volume tracked by g. Spans are exported
+// regardless of the budget.
+func (g *VolumeGuard) Exporter(exporter SpanExporter) SpanExporter {
+	return &volumeGuardExporter{SpanExporter: exporter, guard: g}
+}
+
+type volumeGuardExporter struct {
+	SpanExporter
+
+	guard *VolumeGuard
+}
+
+func (e *volumeGuardExporter) ExportSpans(ctx context.Context, spans []ReadOnlySpan) error {
+	var n int64
+	for _, s := range spans {
+		n += spanSize(s)
+	}
+	e.guard.record(n)
+	return e.SpanExporter.ExportSpans(ctx, spans)
+}
+
+// Sampler returns a Sampler that delegates the sampling decisions to within
+// while the budget of g is not exceeded, and to exceeded otherwise. For
+// example, exceeded can be a [TraceIDRatioBased] sampler with a lower ratio
+// than within to sample harder once the budget is exceeded.
+func (g *VolumeGuard) Sampler(within, exceeded Sampler) Sampler {
+	return volumeGuardSampler{guard: g, within: within, exceeded: exceeded}
+}
+
+type volumeGuardSampler struct {
+	guard            *VolumeGuard
+	within, exceeded Sampler
+}
+
+func (s volumeGuardSampler) ShouldSample(p SamplingParameters) SamplingResult {
+	if s.guard.Exceeded() {
+		return s.exceeded.ShouldSample(p)
+	}
+	return s.within.ShouldSample(p)
+}
+
+func (s volumeGuardSampler) Description() string {
+	return "VolumeGuard{within:" + s.within.Description() +
+		",exceeded:" + s.exceeded.Description() + "}"
+}
+
+// Fixed sizes, in bytes, used to estimate the size of a span.
+const (
+	spanFixedSize  = 16 + 8 + 8 + 8 + 8 + 4 // IDs, parent ID, timestamps, and kind.
+	eventFixedSize = 8                      // Timestamp.
+	linkFixedSize  = 16 + 8                 // IDs.
+)
+
+// spanSize returns the estimated size, in bytes, of s once serialized.
+func spanSize(s ReadOnlySpan) int64 {
+	n := int64(spanFixedSize + len(s.Name()) + len(s.Status().Description))
+	n += volume.AttributesSize(s.Attributes())
+	for _, e := range s.Events() {
+		n += eventFixedSize + int64(len(e.Name)) + volume.AttributesSize(e.Attributes)
+	}
+	for _, l := range s.Links() {
+		n += linkFixedSize + int64(len(l.SpanContext.TraceState().String())) + volume.AttributesSize(l.Attributes)
+	}
+	return n
+}
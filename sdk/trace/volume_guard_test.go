@@ -0,0 +1,85 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// endedSpan returns a snapshot of a span named name ended with the
+// attributes and events of opts.
+func endedSpan(t *testing.T, name string, opts ...trace.SpanStartOption) ReadOnlySpan {
+	t.Helper()
+	te := NewTestExporter()
+	tp := NewTracerProvider(WithSyncer(te))
+	t.Cleanup(func() { require.NoError(t, tp.Shutdown(context.Background())) })
+	_, span := tp.Tracer(t.Name()).Start(t.Context(), name, opts...)
+	span.End()
+	require.Equal(t, 1, te.Len())
+	return te.Spans()[0]
+}
+
+func TestVolumeGuard(t *testing.T) {
+	var exceeded []VolumeUsage
+	g := NewVolumeGuard(200, WithVolumeExceeded(func(u VolumeUsage) {
+		exceeded = append(exceeded, u)
+	}))
+	now := time.Unix(0, 0)
+	g.tracker.Now = func() time.Time { return now }
+
+	te := NewTestExporter()
+	exp := g.Exporter(te)
+	span := endedSpan(t, "span", trace.WithAttributes(attribute.String("key", "value")))
+	size := spanSize(span)
+	require.Positive(t, size)
+
+	require.NoError(t, exp.ExportSpans(t.Context(), []ReadOnlySpan{span}))
+	assert.Equal(t, size, g.Usage().Bytes)
+	assert.False(t, g.Exceeded())
+
+	for range 200 / size {
+		require.NoError(t, exp.ExportSpans(t.Context(), []ReadOnlySpan{span}))
+	}
+	assert.True(t, g.Exceeded())
+	assert.Equal(t, 1+int(200/size), te.Len(), "spans not exported over budget")
+	require.Len(t, exceeded, 1, "exceeded callback not called once")
+	assert.Equal(t, int64(200), exceeded[0].Budget)
+
+	now = now.Add(time.Minute)
+	assert.False(t, g.Exceeded(), "budget not reset for new window")
+	assert.Equal(t, now, g.Usage().Start)
+}
+
+func TestVolumeGuardSampler(t *testing.T) {
+	g := NewVolumeGuard(0)
+	s := g.Sampler(AlwaysSample(), NeverSample())
+	assert.Equal(t, "VolumeGuard{within:AlwaysOnSampler,exceeded:AlwaysOffSampler}", s.Description())
+
+	p := SamplingParameters{Name: "span"}
+	assert.Equal(t, RecordAndSample, s.ShouldSample(p).Decision)
+
+	g.record(1)
+	assert.Equal(t, Drop, s.ShouldSample(p).Decision)
+}
+
+func TestSpanSize(t *testing.T) {
+	base := spanSize(endedSpan(t, ""))
+	assert.Equal(t, int64(spanFixedSize), base)
+
+	span := endedSpan(t, "name", trace.WithAttributes(
+		attribute.String("str", "value"),
+		attribute.Int("int", 1),
+		attribute.StringSlice("slice", []string{"a", "bc"}),
+	))
+	want := base + 4 + (3 + 5) + (3 + 8) + (5 + 3)
+	assert.Equal(t, want, spanSize(span))
+}
@@ -0,0 +1,18 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry_test
+
+import (
+	"fmt"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+func Example() {
+	reg := semconv.Registry()
+
+	a, ok := reg.Lookup(semconv.HTTPRequestMethodKey)
+	fmt.Println(ok, a.Type, a.Stability, a.Deprecated(), len(a.Members) > 0)
+	// Output: true string stable false true
+}
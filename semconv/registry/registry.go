@@ -0,0 +1,241 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registry provides the metadata of the attributes of the
+// OpenTelemetry semantic conventions: their types, stability, deprecation,
+// and replacements.
+//
+// Each semconv package generated from a version of the semantic conventions,
+// e.g. go.opentelemetry.io/otel/semconv/v1.43.0, provides a Registry function
+// returning the Registry of that version. It is meant to build tooling, e.g.
+// validators, migrators, or code generators, without parsing the
+// specification at runtime.
+package registry // import "go.opentelemetry.io/otel/semconv/registry"
+
+import (
+	"iter"
+	"slices"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Type is the type of the value of an attribute, as named by the semantic
+// conventions.
+type Type string
+
+// The types of the values of the attributes.
+const (
+	TypeString       Type = "string"
+	TypeInt          Type = "int"
+	TypeDouble       Type = "double"
+	TypeBoolean      Type = "boolean"
+	TypeStringSlice  Type = "string[]"
+	TypeIntSlice     Type = "int[]"
+	TypeDoubleSlice  Type = "double[]"
+	TypeBooleanSlice Type = "boolean[]"
+	// TypeAny is the type of the attributes accepting any value, e.g. a map.
+	TypeAny Type = "any"
+)
+
+// AttributeType returns the attribute.Type of the values of type t, or
+// attribute.INVALID if t has no equivalent attribute.Type.
+func (t Type) AttributeType() attribute.Type {
+	switch t {
+	case TypeString:
+		return attribute.STRING
+	case TypeInt:
+		return attribute.INT64
+	case TypeDouble:
+		return attribute.FLOAT64
+	case TypeBoolean:
+		return attribute.BOOL
+	case TypeStringSlice:
+		return attribute.STRINGSLICE
+	case TypeIntSlice:
+		return attribute.INT64SLICE
+	case TypeDoubleSlice:
+		return attribute.FLOAT64SLICE
+	case TypeBooleanSlice:
+		return attribute.BOOLSLICE
+	default:
+		return attribute.INVALID
+	}
+}
+
+// Stability is the stability of a semantic convention.
+type Stability string
+
+// The stability levels of the semantic conventions.
+const (
+	StabilityStable           Stability = "stable"
+	StabilityReleaseCandidate Stability = "release_candidate"
+	StabilityDevelopment      Stability = "development"
+	StabilityAlpha            Stability = "alpha"
+)
+
+// DeprecationReason is the reason an attribute is deprecated.
+type DeprecationReason string
+
+// The reasons of the deprecations.
+const (
+	// DeprecationRenamed means the attribute is replaced by the attribute
+	// RenamedTo of its Deprecation.
+	DeprecationRenamed DeprecationReason = "renamed"
+	// DeprecationObsoleted means the attribute is no longer used.
+	DeprecationObsoleted DeprecationReason = "obsoleted"
+	// DeprecationUncategorized means the attribute is deprecated for another
+	// reason, described by the Note of its Deprecation.
+	DeprecationUncategorized DeprecationReason = "uncategorized"
+)
+
+// Deprecation describes the deprecation of an attribute.
+type Deprecation struct {
+	// Reason is the reason the attribute is deprecated.
+	Reason DeprecationReason
+	// RenamedTo is the key of the attribute replacing the deprecated one, if
+	// Reason is DeprecationRenamed.
+	RenamedTo attribute.Key
+	// Note describes the deprecation.
+	Note string
+}
+
+// Member is a value of an enum attribute.
+type Member struct {
+	// Value is the value.
+	Value attribute.Value
+	// Stability is the stability of the value.
+	Stability Stability
+	// Deprecated is true if the value is deprecated.
+	Deprecated bool
+}
+
+// Attribute describes an attribute of the semantic conventions.
+type Attribute struct {
+	// Key is the key of the attribute. If Template is true, it is the prefix
+	// of the keys of the attribute, e.g. "http.request.header" for
+	// "http.request.header.content-type".
+	Key attribute.Key
+	// Type is the type of the values of the attribute. It is the type of the
+	// Members of an enum attribute.
+	Type Type
+	// Template is true if the attribute is a template: its keys are Key
+	// followed by a dot and a suffix.
+	Template bool
+	// Stability is the stability of the attribute.
+	Stability Stability
+	// Members are the well-known values of an enum attribute, nil if the
+	// attribute is not an enum.
+	Members []Member
+	// Deprecation describes the deprecation of the attribute, nil if the
+	// attribute is not deprecated.
+	Deprecation *Deprecation
+}
+
+// Deprecated returns whether the attribute is deprecated.
+func (a Attribute) Deprecated() bool {
+	return a.Deprecation != nil
+}
+
+// Registry holds the attributes of a version of the semantic conventions.
+//
+// A Registry is immutable and safe for concurrent use.
+type Registry struct {
+	schemaURL  string
+	attributes []Attribute
+	index      map[attribute.Key]int
+}
+
+// New returns a Registry of the attributes of the semantic conventions with
+// the schema URL schemaURL. The attributes with the same key as a previous
+// attribute are ignored.
+//
+// New is used by the generated semconv packages, tooling should use their
+// Registry function.
+func New(schemaURL string, attributes []Attribute) *Registry {
+	r := &Registry{
+		schemaURL:  schemaURL,
+		attributes: make([]Attribute, 0, len(attributes)),
+		index:      make(map[attribute.Key]int, len(attributes)),
+	}
+	for _, a := range attributes {
+		if _, ok := r.index[a.Key]; ok {
+			continue
+		}
+		r.index[a.Key] = len(r.attributes)
+		r.attributes = append(r.attributes, a)
+	}
+	slices.SortFunc(r.attributes, func(a, b Attribute) int {
+		return strings.Compare(string(a.Key), string(b.Key))
+	})
+	// Update the index once sorted.
+	for i, a := range r.attributes {
+		r.index[a.Key] = i
+	}
+	return r
+}
+
+// SchemaURL returns the schema URL of the version of the semantic
+// conventions of r.
+func (r *Registry) SchemaURL() string {
+	return r.schemaURL
+}
+
+// Attributes returns an iterator over the attributes of r, sorted by key.
+func (r *Registry) Attributes() iter.Seq[Attribute] {
+	return func(yield func(Attribute) bool) {
+		for _, a := range r.attributes {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+// Lookup returns the attribute of r with key, and whether it exists. If no
+// attribute has the key, the template attribute with the longest Key prefix
+// of key is returned, e.g. "http.request.header" for
+// "http.request.header.content-type".
+func (r *Registry) Lookup(key attribute.Key) (Attribute, bool) {
+	if i, ok := r.index[key]; ok {
+		return r.attributes[i], true
+	}
+	k := string(key)
+	for {
+		i := strings.LastIndexByte(k, '.')
+		if i <= 0 {
+			return Attribute{}, false
+		}
+		k = k[:i]
+		if i, ok := r.index[attribute.Key(k)]; ok && r.attributes[i].Template {
+			return r.attributes[i], true
+		}
+	}
+}
+
+// Replacement returns the key of the attribute replacing the deprecated
+// attribute with key, following the successive renames, and whether such
+// an attribute exists. False is returned if the attribute is unknown, not
+// deprecated, or not renamed.
+func (r *Registry) Replacement(key attribute.Key) (attribute.Key, bool) {
+	var renamed bool
+	// Bound the number of renames followed in case of a cycle.
+	for range len(r.attributes) {
+		a, ok := r.Lookup(key)
+		if !ok || a.Deprecation == nil || a.Deprecation.Reason != DeprecationRenamed ||
+			a.Deprecation.RenamedTo == "" {
+			break
+		}
+		if a.Template {
+			// Preserve the suffix of the key of a template attribute.
+			key = a.Deprecation.RenamedTo + key[len(a.Key):]
+		} else {
+			key = a.Deprecation.RenamedTo
+		}
+		renamed = true
+	}
+	if !renamed {
+		return "", false
+	}
+	return key, true
+}
@@ -0,0 +1,102 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package registry
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var testRegistry = New("https://opentelemetry.io/schemas/1.0.0", []Attribute{
+	{Key: "http.request.method", Type: TypeString, Stability: StabilityStable},
+	{Key: "http.method", Type: TypeString, Stability: StabilityDevelopment, Deprecation: &Deprecation{
+		Reason:    DeprecationRenamed,
+		RenamedTo: "http.request.method",
+	}},
+	{Key: "old.method", Type: TypeString, Stability: StabilityDevelopment, Deprecation: &Deprecation{
+		Reason:    DeprecationRenamed,
+		RenamedTo: "http.method",
+	}},
+	{Key: "http.request.header", Type: TypeStringSlice, Template: true, Stability: StabilityStable},
+	{Key: "http.request.headers", Type: TypeStringSlice, Template: true, Deprecation: &Deprecation{
+		Reason:    DeprecationRenamed,
+		RenamedTo: "http.request.header",
+	}},
+	{Key: "cycle.a", Deprecation: &Deprecation{Reason: DeprecationRenamed, RenamedTo: "cycle.b"}},
+	{Key: "cycle.b", Deprecation: &Deprecation{Reason: DeprecationRenamed, RenamedTo: "cycle.a"}},
+	{Key: "obsolete", Deprecation: &Deprecation{Reason: DeprecationObsoleted, Note: "Removed."}},
+	{Key: "http.request.method", Type: TypeInt},
+})
+
+func TestRegistryAttributes(t *testing.T) {
+	assert.Equal(t, "https://opentelemetry.io/schemas/1.0.0", testRegistry.SchemaURL())
+
+	var keys []attribute.Key
+	for a := range testRegistry.Attributes() {
+		keys = append(keys, a.Key)
+	}
+	assert.True(t, slices.IsSorted(keys), "not sorted")
+	assert.Len(t, keys, 8, "duplicate not ignored")
+}
+
+func TestRegistryLookup(t *testing.T) {
+	a, ok := testRegistry.Lookup("http.request.method")
+	require.True(t, ok)
+	assert.Equal(t, TypeString, a.Type, "first duplicate not kept")
+	assert.False(t, a.Deprecated())
+
+	a, ok = testRegistry.Lookup("http.request.header.content-type")
+	require.True(t, ok)
+	assert.Equal(t, attribute.Key("http.request.header"), a.Key)
+
+	a, ok = testRegistry.Lookup("obsolete")
+	require.True(t, ok)
+	assert.True(t, a.Deprecated())
+
+	for _, key := range []attribute.Key{"unknown", "http.request.method.x", "http", ""} {
+		_, ok = testRegistry.Lookup(key)
+		assert.False(t, ok, key)
+	}
+}
+
+func TestRegistryReplacement(t *testing.T) {
+	tests := []struct {
+		key  attribute.Key
+		want attribute.Key
+		ok   bool
+	}{
+		{"http.method", "http.request.method", true},
+		{"old.method", "http.request.method", true},
+		{"http.request.headers.accept", "http.request.header.accept", true},
+		{"http.request.method", "", false},
+		{"obsolete", "", false},
+		{"unknown", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := testRegistry.Replacement(tt.key)
+		assert.Equal(t, tt.ok, ok, tt.key)
+		assert.Equal(t, tt.want, got, tt.key)
+	}
+
+	// Cycles are not followed indefinitely.
+	_, ok := testRegistry.Replacement("cycle.a")
+	assert.True(t, ok)
+}
+
+func TestTypeAttributeType(t *testing.T) {
+	assert.Equal(t, attribute.STRING, TypeString.AttributeType())
+	assert.Equal(t, attribute.INT64, TypeInt.AttributeType())
+	assert.Equal(t, attribute.FLOAT64, TypeDouble.AttributeType())
+	assert.Equal(t, attribute.BOOL, TypeBoolean.AttributeType())
+	assert.Equal(t, attribute.STRINGSLICE, TypeStringSlice.AttributeType())
+	assert.Equal(t, attribute.INT64SLICE, TypeIntSlice.AttributeType())
+	assert.Equal(t, attribute.FLOAT64SLICE, TypeDoubleSlice.AttributeType())
+	assert.Equal(t, attribute.BOOLSLICE, TypeBooleanSlice.AttributeType())
+	assert.Equal(t, attribute.INVALID, TypeAny.AttributeType())
+}
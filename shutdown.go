@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+)
+
+var (
+	shutdownHooksMu sync.Mutex
+	shutdownHooks   []func(context.Context) error
+)
+
+// RegisterShutdownHook registers hook to be called by Shutdown.
+//
+// Use it to shut down the providers that are not global in this package,
+// e.g. the global LoggerProvider of go.opentelemetry.io/otel/log/global, or
+// any other resource to release when the application shuts down. A nil hook
+// is ignored.
+func RegisterShutdownHook(hook func(context.Context) error) {
+	if hook == nil {
+		return
+	}
+	shutdownHooksMu.Lock()
+	defer shutdownHooksMu.Unlock()
+	shutdownHooks = append(shutdownHooks, hook)
+}
+
+// Shutdown shuts down the global providers and calls the registered shutdown
+// hooks. It is meant to be called once when the application terminates, so
+// an application only using the global API does not need to keep references
+// to the providers it registered.
+//
+// The hooks registered with RegisterShutdownHook are called first, in the
+// reverse order of their registration as deferred functions are, and are
+// then unregistered. Then the global TracerProvider and MeterProvider are
+// shut down if they have a Shutdown(context.Context) error method, as the
+// providers of the SDK do. Shutting down an SDK provider flushes the
+// telemetry it holds.
+//
+// All the hooks and providers are shut down even if some fail. The returned
+// error joins their errors. ctx should have a deadline so Shutdown does not
+// block indefinitely.
+func Shutdown(ctx context.Context) error {
+	shutdownHooksMu.Lock()
+	hooks := shutdownHooks
+	shutdownHooks = nil
+	shutdownHooksMu.Unlock()
+
+	var errs []error
+	for _, hook := range slices.Backward(hooks) {
+		errs = append(errs, hook(ctx))
+	}
+
+	type shutdowner interface {
+		Shutdown(context.Context) error
+	}
+	if s, ok := GetTracerProvider().(shutdowner); ok {
+		errs = append(errs, s.Shutdown(ctx))
+	}
+	if s, ok := GetMeterProvider().(shutdowner); ok {
+		errs = append(errs, s.Shutdown(ctx))
+	}
+	return errors.Join(errs...)
+}
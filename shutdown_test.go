@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+)
+
+type shutdownTracerProvider struct {
+	tracenoop.TracerProvider
+
+	calls *[]string
+	err   error
+}
+
+func (p shutdownTracerProvider) Shutdown(context.Context) error {
+	*p.calls = append(*p.calls, "tracer provider")
+	return p.err
+}
+
+type shutdownMeterProvider struct {
+	metricnoop.MeterProvider
+
+	calls *[]string
+}
+
+func (p shutdownMeterProvider) Shutdown(context.Context) error {
+	*p.calls = append(*p.calls, "meter provider")
+	return nil
+}
+
+// resetShutdown restores the global providers and clears the shutdown hooks
+// once t is done.
+func resetShutdown(t *testing.T) {
+	tp, mp := GetTracerProvider(), GetMeterProvider()
+	t.Cleanup(func() {
+		SetTracerProvider(tp)
+		SetMeterProvider(mp)
+		shutdownHooksMu.Lock()
+		shutdownHooks = nil
+		shutdownHooksMu.Unlock()
+	})
+}
+
+func TestShutdown(t *testing.T) {
+	resetShutdown(t)
+
+	var calls []string
+	errTP := errors.New("tracer provider")
+	errHook := errors.New("hook")
+	SetTracerProvider(shutdownTracerProvider{calls: &calls, err: errTP})
+	SetMeterProvider(shutdownMeterProvider{calls: &calls})
+
+	RegisterShutdownHook(func(context.Context) error {
+		calls = append(calls, "hook 1")
+		return nil
+	})
+	RegisterShutdownHook(nil)
+	RegisterShutdownHook(func(context.Context) error {
+		calls = append(calls, "hook 2")
+		return errHook
+	})
+
+	err := Shutdown(t.Context())
+	assert.ErrorIs(t, err, errTP)
+	assert.ErrorIs(t, err, errHook)
+	assert.Equal(t, []string{"hook 2", "hook 1", "tracer provider", "meter provider"}, calls)
+
+	// The hooks are only called once.
+	calls = nil
+	assert.ErrorIs(t, Shutdown(t.Context()), errTP)
+	assert.Equal(t, []string{"tracer provider", "meter provider"}, calls)
+}
+
+func TestShutdownWithoutShutdownMethod(t *testing.T) {
+	resetShutdown(t)
+	SetTracerProvider(tracenoop.NewTracerProvider())
+	SetMeterProvider(metricnoop.NewMeterProvider())
+	assert.NoError(t, Shutdown(t.Context()))
+}
@@ -16,6 +16,24 @@ func Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
 	return GetTracerProvider().Tracer(name, opts...)
 }
 
+// TracerAuto returns a Tracer from the global TracerProvider named after the
+// import path of the package calling TracerAuto. The version of the module
+// containing that package, as recorded in the build information of the
+// binary, is used as the instrumentation version unless opts provide one.
+//
+// This allows instrumentation libraries to consistently name their scope
+// without repeating their import path. For example, calling TracerAuto from
+// the package "example.com/foo" is equivalent to:
+//
+//	otel.Tracer("example.com/foo", trace.WithInstrumentationVersion(version))
+func TracerAuto(opts ...trace.TracerOption) trace.Tracer {
+	name, version := global.CallerScope(1)
+	if version != "" {
+		opts = append([]trace.TracerOption{trace.WithInstrumentationVersion(version)}, opts...)
+	}
+	return GetTracerProvider().Tracer(name, opts...)
+}
+
 // GetTracerProvider returns the registered global trace provider.
 // If none is registered then an instance of NoopTracerProvider is returned.
 //
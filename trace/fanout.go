@@ -0,0 +1,143 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace // import "go.opentelemetry.io/otel/trace"
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	// FanoutIndexKey is the attribute Key of the index of the branch of a
+	// fanout a span started by StartFanout is the span of.
+	FanoutIndexKey = attribute.Key("fanout.index")
+
+	// FanoutCountKey is the attribute Key of the number of branches of a
+	// fanout, set on the parent span by Fanout.Join.
+	FanoutCountKey = attribute.Key("fanout.count")
+
+	// FanoutSuccessCountKey is the attribute Key of the number of branches of
+	// a fanout that succeeded, set on the parent span by Fanout.Join.
+	FanoutSuccessCountKey = attribute.Key("fanout.success_count")
+
+	// FanoutSlowestKey is the attribute Key of the index of the slowest
+	// branch of a fanout, set on the parent span by Fanout.Join.
+	FanoutSlowestKey = attribute.Key("fanout.slowest")
+)
+
+// Fanout is the set of the sibling spans of the concurrent branches of an
+// operation, e.g. the requests sent to several shards, started by
+// StartFanout.
+//
+// Each branch ends its span with Done, and the operation waits for all the
+// branches before calling Join.
+type Fanout struct {
+	parent Span
+	spans  []Span
+	start  time.Time
+
+	mu        sync.Mutex
+	durations []time.Duration
+	errs      []error
+	done      []bool
+	joined    bool
+}
+
+// StartFanout starts n sibling spans named name with tracer, children of the
+// span in ctx, one for each branch of a fanout. It returns the contexts
+// containing the spans, to pass to the goroutines of the branches, and the
+// Fanout to end them.
+//
+// The spans are started with opts and the FanoutIndexKey attribute set to
+// their index. The branches are expected to end before the operation of the
+// span in ctx: if they outlive it, start them in new traces linked to it
+// with WithNewRoot and WithLinks instead.
+func StartFanout(ctx context.Context, tracer Tracer, name string, n int, opts ...SpanStartOption) ([]context.Context, *Fanout) {
+	n = max(n, 0)
+	f := &Fanout{
+		parent:    SpanFromContext(ctx),
+		spans:     make([]Span, n),
+		start:     time.Now(),
+		durations: make([]time.Duration, n),
+		errs:      make([]error, n),
+		done:      make([]bool, n),
+	}
+	ctxs := make([]context.Context, n)
+	for i := range n {
+		o := append(opts[:len(opts):len(opts)], WithAttributes(FanoutIndexKey.Int(i)))
+		ctxs[i], f.spans[i] = tracer.Start(ctx, name, o...)
+	}
+	return ctxs, f
+}
+
+// Done ends the span of the branch i. If err is not nil, it is recorded on
+// the span and the span status is set to Error.
+//
+// Done does nothing if i is not the index of a branch, if it was already
+// called for the branch, or if Join was called. It is safe to call
+// concurrently.
+func (f *Fanout) Done(i int, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if i < 0 || i >= len(f.spans) || f.done[i] || f.joined {
+		return
+	}
+	f.end(i, err)
+}
+
+// end ends the span of the branch i. It must be called with mu held.
+func (f *Fanout) end(i int, err error) {
+	f.done[i] = true
+	f.durations[i] = time.Since(f.start)
+	f.errs[i] = err
+	span := f.spans[i]
+	if err != nil {
+		span.RecordError(err)
+		SetSpanStatus(span, ErrorStatus(err))
+	}
+	span.End()
+}
+
+// Join ends the spans of the branches Done was not called for, sets the
+// FanoutCountKey, FanoutSuccessCountKey, and FanoutSlowestKey attributes on
+// the parent span, and returns the errors of the branches joined with
+// [errors.Join].
+//
+// The branches not done are not counted as succeeded and their duration is
+// the one until Join is called. Join must be called once all the branches are
+// done, only the first call has an effect.
+func (f *Fanout) Join() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.joined {
+		return nil
+	}
+	f.joined = true
+
+	success, slowest := 0, -1
+	for i := range f.spans {
+		if !f.done[i] {
+			f.end(i, nil)
+		} else if f.errs[i] == nil {
+			success++
+		}
+		if slowest < 0 || f.durations[i] > f.durations[slowest] {
+			slowest = i
+		}
+	}
+
+	attrs := []attribute.KeyValue{
+		FanoutCountKey.Int(len(f.spans)),
+		FanoutSuccessCountKey.Int(success),
+	}
+	if slowest >= 0 {
+		attrs = append(attrs, FanoutSlowestKey.Int(slowest))
+	}
+	f.parent.SetAttributes(attrs...)
+	return errors.Join(f.errs...)
+}
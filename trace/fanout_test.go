@@ -0,0 +1,113 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+type fanoutSpan struct {
+	statusSpan
+
+	parent SpanContext
+	sc     SpanContext
+	errs   []error
+	ended  bool
+}
+
+func (s *fanoutSpan) SpanContext() SpanContext { return s.sc }
+
+func (s *fanoutSpan) RecordError(err error, _ ...EventOption) { s.errs = append(s.errs, err) }
+
+func (s *fanoutSpan) End(...SpanEndOption) { s.ended = true }
+
+type fanoutTracer struct {
+	noopTracer
+
+	mu    sync.Mutex
+	spans []*fanoutSpan
+}
+
+func (t *fanoutTracer) Start(ctx context.Context, _ string, opts ...SpanStartOption) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cfg := NewSpanStartConfig(opts...)
+	s := &fanoutSpan{
+		statusSpan: statusSpan{recording: true, attrs: cfg.Attributes()},
+		parent:     SpanContextFromContext(ctx),
+		sc:         SpanContext{traceID: [16]byte{1}, spanID: [8]byte{byte(len(t.spans) + 2)}},
+	}
+	t.spans = append(t.spans, s)
+	return ContextWithSpan(ctx, s), s
+}
+
+func TestFanout(t *testing.T) {
+	parent := &fanoutSpan{
+		statusSpan: statusSpan{recording: true},
+		sc:         SpanContext{traceID: [16]byte{1}, spanID: [8]byte{1}},
+	}
+	ctx := ContextWithSpan(t.Context(), parent)
+	tracer := &fanoutTracer{}
+
+	ctxs, f := StartFanout(ctx, tracer, "shard", 3, WithSpanKind(SpanKindClient))
+	require.Len(t, ctxs, 3)
+	require.Len(t, tracer.spans, 3)
+	for i, s := range tracer.spans {
+		assert.Equal(t, parent.sc, s.parent, "sibling of the parent")
+		assert.Equal(t, s, SpanFromContext(ctxs[i]))
+		assert.Equal(t, []attribute.KeyValue{FanoutIndexKey.Int(i)}, s.attrs)
+	}
+
+	errFailed := errors.New("failed")
+	var wg sync.WaitGroup
+	for i := range ctxs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			switch i {
+			case 0:
+				f.Done(i, nil)
+			case 1:
+				f.Done(i, errFailed)
+			}
+		}()
+	}
+	wg.Wait()
+	f.Done(-1, nil)
+	f.Done(0, errFailed)
+
+	assert.ErrorIs(t, f.Join(), errFailed)
+	assert.NoError(t, f.Join(), "joined once")
+	f.Done(2, errFailed)
+
+	for _, s := range tracer.spans {
+		assert.True(t, s.ended)
+	}
+	assert.Empty(t, tracer.spans[0].errs)
+	assert.Equal(t, []error{errFailed}, tracer.spans[1].errs)
+	assert.Equal(t, codes.Error, tracer.spans[1].code)
+	assert.Empty(t, tracer.spans[2].errs, "ended by Join")
+
+	assert.Equal(t, []attribute.KeyValue{
+		FanoutCountKey.Int(3),
+		FanoutSuccessCountKey.Int(1),
+		FanoutSlowestKey.Int(2),
+	}, parent.attrs)
+	assert.False(t, parent.ended)
+}
+
+func TestFanoutEmpty(t *testing.T) {
+	ctxs, f := StartFanout(t.Context(), &fanoutTracer{}, "shard", -1)
+	assert.Empty(t, ctxs)
+	assert.NoError(t, f.Join())
+}
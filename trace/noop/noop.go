@@ -30,28 +30,80 @@ var (
 )
 
 // TracerProvider is an OpenTelemetry No-Op TracerProvider.
-type TracerProvider struct{ embedded.TracerProvider }
+type TracerProvider struct {
+	embedded.TracerProvider
 
-// NewTracerProvider returns a TracerProvider that does not record any telemetry.
-func NewTracerProvider() TracerProvider {
-	return TracerProvider{}
+	strict bool
+}
+
+// TracerProviderOption applies an option to a TracerProvider.
+type TracerProviderOption interface {
+	apply(TracerProvider) TracerProvider
+}
+
+type tracerProviderOptionFunc func(TracerProvider) TracerProvider
+
+func (fn tracerProviderOptionFunc) apply(tp TracerProvider) TracerProvider {
+	return fn(tp)
+}
+
+// WithSpanContextPassThrough sets whether the spans started by the Tracers of
+// the TracerProvider carry the span context found in the parent context.
+//
+// When enabled, the default, a span started with a parent context containing
+// a valid span context is a non-recording span with that span context, and
+// is stored in the returned context. This is the behavior the specification
+// requires of the API when no SDK is installed: it keeps the incoming trace
+// context propagated through the application, and bridges and propagators
+// rely on it.
+//
+// When disabled, the TracerProvider is strictly no-op: the spans it starts
+// always have an empty span context, regardless of the parent context, and
+// the returned context holds that empty span. Use it to stop propagating the
+// incoming trace context.
+func WithSpanContextPassThrough(enabled bool) TracerProviderOption {
+	return tracerProviderOptionFunc(func(tp TracerProvider) TracerProvider {
+		tp.strict = !enabled
+		return tp
+	})
+}
+
+// NewTracerProvider returns a TracerProvider that does not record any
+// telemetry. By default, the spans it starts carry the span context of their
+// parent (see WithSpanContextPassThrough).
+func NewTracerProvider(opts ...TracerProviderOption) TracerProvider {
+	var tp TracerProvider
+	for _, opt := range opts {
+		tp = opt.apply(tp)
+	}
+	return tp
 }
 
 // Tracer returns an OpenTelemetry Tracer that does not record any telemetry.
-func (TracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
-	return Tracer{}
+func (tp TracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return Tracer{strict: tp.strict}
 }
 
 // Tracer is an OpenTelemetry No-Op Tracer.
-type Tracer struct{ embedded.Tracer }
+type Tracer struct {
+	embedded.Tracer
+
+	strict bool
+}
 
 // Start creates a span. The created span will be set in a child context of ctx
 // and returned with the span.
 //
 // If ctx contains a span context, the returned span will also contain that
 // span context. If the span context in ctx is for a non-recording span, that
-// span instance will be returned directly.
-func (Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+// span instance will be returned directly. If the Tracer was created by a
+// TracerProvider with WithSpanContextPassThrough(false), the returned span
+// always contains an empty span context instead.
+func (t Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if t.strict {
+		return trace.ContextWithSpan(ctx, strictSpanInstance), strictSpanInstance
+	}
+
 	span := trace.SpanFromContext(ctx)
 
 	// If the parent context contains a non-zero span context, that span
@@ -72,13 +124,17 @@ func (Tracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (
 	return trace.ContextWithSpan(ctx, span), span
 }
 
-var noopSpanInstance trace.Span = Span{}
+var (
+	noopSpanInstance   trace.Span = Span{}
+	strictSpanInstance trace.Span = Span{strict: true}
+)
 
 // Span is an OpenTelemetry No-Op Span.
 type Span struct {
 	embedded.Span
 
-	sc trace.SpanContext
+	sc     trace.SpanContext
+	strict bool
 }
 
 // SpanContext returns an empty span context.
@@ -108,5 +164,6 @@ func (Span) AddLink(trace.Link) {}
 // SetName does nothing.
 func (Span) SetName(string) {}
 
-// TracerProvider returns a No-Op TracerProvider.
-func (Span) TracerProvider() trace.TracerProvider { return TracerProvider{} }
+// TracerProvider returns a No-Op TracerProvider, configured as the
+// TracerProvider of the Tracer that started the span.
+func (s Span) TracerProvider() trace.TracerProvider { return TracerProvider{strict: s.strict} }
@@ -100,6 +100,46 @@ func TestTracerStartPropagatesSpanContext(t *testing.T) {
 	assert.False(t, span.IsRecording(), "recording span returned")
 }
 
+func TestWithSpanContextPassThrough(t *testing.T) {
+	assert.Equal(t, NewTracerProvider(), NewTracerProvider(WithSpanContextPassThrough(true)))
+	assert.Equal(t, TracerProvider{}, NewTracerProvider(WithSpanContextPassThrough(true)))
+
+	tp := NewTracerProvider(WithSpanContextPassThrough(false))
+	assert.NotEqual(t, TracerProvider{}, tp)
+	assert.Equal(t, tp, NewTracerProvider(WithSpanContextPassThrough(true), WithSpanContextPassThrough(false)))
+}
+
+func TestTracerStartStrict(t *testing.T) {
+	tracer := NewTracerProvider(WithSpanContextPassThrough(false)).Tracer("")
+
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID([16]byte{1}),
+		SpanID:     trace.SpanID([8]byte{1}),
+		TraceFlags: trace.FlagsSampled,
+	})
+	parents := map[string]trace.Span{
+		"None":         nil,
+		"NonRecording": Span{sc: spanCtx},
+		"Recording":    recordingSpan{Span: Span{sc: spanCtx}},
+	}
+	for name, parent := range parents {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+			if parent != nil {
+				ctx = trace.ContextWithSpan(ctx, parent)
+			}
+			ctx, span := tracer.Start(ctx, "test_span")
+			assert.Equal(t, trace.SpanContext{}, span.SpanContext(), "span context passed through")
+			assert.Equal(t, trace.SpanContext{}, trace.SpanContextFromContext(ctx), "span context passed through")
+			assert.False(t, span.IsRecording())
+
+			// Children of the span are strict no-op spans too.
+			_, child := span.TracerProvider().Tracer("").Start(trace.ContextWithSpanContext(ctx, spanCtx), "child")
+			assert.Equal(t, trace.SpanContext{}, child.SpanContext(), "span context passed through")
+		})
+	}
+}
+
 func BenchmarkNoopInstance(b *testing.B) {
 	tracer := NewTracerProvider().Tracer("")
 	ctx := trace.ContextWithSpanContext(b.Context(), trace.SpanContext{})
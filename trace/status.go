@@ -0,0 +1,64 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+// Status is the status of a Span along with machine-readable details
+// describing it.
+type Status struct {
+	// Code is the status code of the Span.
+	Code codes.Code
+	// Description describes the status. It is only used when Code is
+	// codes.Error.
+	Description string
+	// Details are attributes describing the status (e.g. the "error.type"
+	// semantic conventions attribute). They are set as attributes of the
+	// Span the Status is set on.
+	Details []attribute.KeyValue
+}
+
+// ErrorStatus returns an Error Status for err. The Status description is the
+// err message and its details contain the "error.type" semantic conventions
+// attribute set to the Go type of err, followed by details. The "error.type"
+// attribute can be overridden by passing it in details.
+//
+// If err is nil, an Unset Status with details is returned.
+func ErrorStatus(err error, details ...attribute.KeyValue) Status {
+	if err == nil {
+		return Status{Code: codes.Unset, Details: details}
+	}
+
+	d := make([]attribute.KeyValue, 0, len(details)+1)
+	d = append(d, semconv.ErrorTypeKey.String(fmt.Sprintf("%T", err)))
+	d = append(d, details...)
+	return Status{
+		Code:        codes.Error,
+		Description: err.Error(),
+		Details:     d,
+	}
+}
+
+// SetSpanStatus sets status as the status of span. The status details are set
+// as attributes of span, overwriting attributes with the same keys, before
+// the status code and description are set with the SetStatus method of span.
+//
+// The details are set even if span ignores the status because it already
+// has a higher status (OK > Error > Unset). If span is not recording, nothing
+// is set.
+func SetSpanStatus(span Span, status Status) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+	if len(status.Details) > 0 {
+		span.SetAttributes(status.Details...)
+	}
+	span.SetStatus(status.Code, status.Description)
+}
@@ -0,0 +1,78 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package trace
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+)
+
+type statusSpan struct {
+	Span
+
+	recording   bool
+	attrs       []attribute.KeyValue
+	code        codes.Code
+	description string
+	statusSet   bool
+}
+
+func (s *statusSpan) IsRecording() bool { return s.recording }
+
+func (s *statusSpan) SetAttributes(kv ...attribute.KeyValue) {
+	s.attrs = append(s.attrs, kv...)
+}
+
+func (s *statusSpan) SetStatus(code codes.Code, description string) {
+	s.code, s.description, s.statusSet = code, description, true
+}
+
+type testError struct{}
+
+func (testError) Error() string { return "test error" }
+
+func TestErrorStatus(t *testing.T) {
+	s := ErrorStatus(testError{}, semconv.HTTPResponseStatusCode(503))
+	assert.Equal(t, codes.Error, s.Code)
+	assert.Equal(t, "test error", s.Description)
+	assert.Equal(t, []attribute.KeyValue{
+		semconv.ErrorTypeKey.String("trace.testError"),
+		semconv.HTTPResponseStatusCode(503),
+	}, s.Details)
+
+	s = ErrorStatus(nil, semconv.HTTPResponseStatusCode(200))
+	assert.Equal(t, Status{
+		Code:    codes.Unset,
+		Details: []attribute.KeyValue{semconv.HTTPResponseStatusCode(200)},
+	}, s)
+}
+
+func TestSetSpanStatus(t *testing.T) {
+	span := &statusSpan{recording: true}
+	SetSpanStatus(span, ErrorStatus(errors.New("failed"), semconv.ErrorTypeKey.String("timeout")))
+
+	assert.True(t, span.statusSet)
+	assert.Equal(t, codes.Error, span.code)
+	assert.Equal(t, "failed", span.description)
+	// The last value of a key wins when set as span attributes.
+	assert.Equal(t, []attribute.KeyValue{
+		semconv.ErrorTypeKey.String("*errors.errorString"),
+		semconv.ErrorTypeKey.String("timeout"),
+	}, span.attrs)
+}
+
+func TestSetSpanStatusNotRecording(t *testing.T) {
+	span := &statusSpan{}
+	SetSpanStatus(span, ErrorStatus(errors.New("failed")))
+	assert.False(t, span.statusSet)
+	assert.Empty(t, span.attrs)
+
+	assert.NotPanics(t, func() { SetSpanStatus(nil, Status{Code: codes.Ok}) })
+}
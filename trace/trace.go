@@ -5,6 +5,8 @@ package trace
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 )
 
 const (
@@ -17,6 +19,11 @@ const (
 	// least 56 bits of randomness (W3C Trace Context Level 2).
 	FlagsRandom = TraceFlags(0x02)
 
+	// FlagsDefined is a bitmask with all the flags defined by the W3C Trace
+	// Context specification set. The other bits are reserved for future
+	// versions of the specification.
+	FlagsDefined = FlagsSampled | FlagsRandom
+
 	errInvalidHexID errorConst = "trace-id and span-id can only contain [0-9a-f] characters, all lowercase"
 
 	errInvalidTraceIDLength errorConst = "hex encoded trace-id must have length equals to 32"
@@ -24,6 +31,9 @@ const (
 
 	errInvalidSpanIDLength errorConst = "hex encoded span-id must have length equals to 16"
 	errNilSpanID           errorConst = "span-id can't be all zero"
+
+	errReservedTraceFlags      errorConst = "trace-flags has reserved bits set"
+	errInvalidTraceFlagsLength errorConst = "hex encoded trace-flags must have length equals to 2"
 )
 
 type errorConst string
@@ -210,6 +220,47 @@ func (tf TraceFlags) WithRandom(random bool) TraceFlags { // nolint:revive  // r
 	return tf &^ FlagsRandom
 }
 
+// Has reports whether all the bits of flags are set in the TraceFlags.
+//
+// It can be used to read any flag, including the flags reserved by the
+// current version of the W3C Trace Context specification.
+func (tf TraceFlags) Has(flags TraceFlags) bool {
+	return tf&flags == flags
+}
+
+// With sets the bits of flags in a new copy of the TraceFlags if set is true,
+// or clears them otherwise.
+//
+// It can be used to write any flag, including the flags reserved by the
+// current version of the W3C Trace Context specification.
+func (tf TraceFlags) With(flags TraceFlags, set bool) TraceFlags { // nolint:revive  // set is not a control flag.
+	if set {
+		return tf | flags
+	}
+	return tf &^ flags
+}
+
+// Reserved returns the bits of the TraceFlags that are not defined by the W3C
+// Trace Context specification, see [FlagsDefined].
+func (tf TraceFlags) Reserved() TraceFlags {
+	return tf &^ FlagsDefined
+}
+
+// TraceFlagsFromHex returns the TraceFlags encoded by the 2 lowercase hex
+// digits of h, as in the trace-flags field of a W3C traceparent header. All
+// the bits are returned, including the reserved ones.
+func TraceFlagsFromHex(h string) (TraceFlags, error) {
+	if len(h) != 2 {
+		return 0, errInvalidTraceFlagsLength
+	}
+	hi, lo := hexRev[h[0]], hexRev[h[1]]
+	// Invalid hex characters are 0xff in hexRev.
+	if (hi|lo)&0xf0 != 0 {
+		return 0, errInvalidHexID
+	}
+	return TraceFlags(hi<<4 | lo), nil
+}
+
 // MarshalJSON implements a custom marshal function to encode TraceFlags
 // as a hex string.
 func (tf TraceFlags) MarshalJSON() ([]byte, error) {
@@ -251,6 +302,36 @@ func NewSpanContext(config SpanContextConfig) SpanContext {
 	}
 }
 
+// NewSpanContextStrict constructs a SpanContext using values from the provided
+// SpanContextConfig, as [NewSpanContext] does, after validating them.
+//
+// An error is returned if the trace ID or span ID consist of zeros only, if
+// trace flags other than [FlagsSampled] and [FlagsRandom] are set, or if the
+// trace state is not valid. The error describes all the invalid values and
+// the returned SpanContext is empty.
+//
+// This is intended for bridges that construct a SpanContext from the values
+// of another system and need to know why they are not valid.
+func NewSpanContextStrict(config SpanContextConfig) (SpanContext, error) {
+	var errs []error
+	if !config.TraceID.IsValid() {
+		errs = append(errs, errNilTraceID)
+	}
+	if !config.SpanID.IsValid() {
+		errs = append(errs, errNilSpanID)
+	}
+	if reserved := config.TraceFlags.Reserved(); reserved != 0 {
+		errs = append(errs, fmt.Errorf("%w: %s", errReservedTraceFlags, reserved))
+	}
+	if _, err := ParseTraceState(config.TraceState.String()); err != nil {
+		errs = append(errs, err)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return SpanContext{}, err
+	}
+	return NewSpanContext(config), nil
+}
+
 // SpanContext contains identifying trace information about a Span.
 type SpanContext struct {
 	traceID    TraceID
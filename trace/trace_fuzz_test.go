@@ -71,3 +71,30 @@ func FuzzSpanIDFromHex(f *testing.F) {
 		}
 	})
 }
+
+func FuzzParseTraceState(f *testing.F) {
+	f.Add("key1=value1,key2=value2")
+	f.Add("tenant@vendor=value, key=\tvalue ,")
+	f.Add("key=val=ue,KEY=value,key=value")
+	f.Add("=,,key=привет")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		ts, err := ParseTraceState(s)
+		if err != nil {
+			return
+		}
+		if n := ts.Len(); n > maxListMembers {
+			t.Fatalf("%d list-members exceed the limit: %q", n, s)
+		}
+
+		// The encoded TraceState must be decoded to the same TraceState.
+		enc := ts.String()
+		got, err := ParseTraceState(enc)
+		if err != nil {
+			t.Fatalf("invalid encoding %q of %q: %v", enc, s, err)
+		}
+		if got.String() != enc {
+			t.Fatalf("roundtrip of %q: got %q, want %q", s, got.String(), enc)
+		}
+	})
+}
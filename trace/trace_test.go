@@ -504,6 +504,49 @@ func TestTraceFlagsWithRandom(t *testing.T) {
 	}
 }
 
+func TestTraceFlagsBits(t *testing.T) {
+	const vendor = TraceFlags(0x80)
+
+	tf := FlagsSampled.With(vendor, true)
+	assert.Equal(t, TraceFlags(0x81), tf)
+	assert.True(t, tf.Has(vendor))
+	assert.True(t, tf.Has(FlagsSampled|vendor))
+	assert.False(t, tf.Has(FlagsRandom|vendor))
+	assert.Equal(t, vendor, tf.Reserved())
+
+	tf = tf.With(FlagsRandom, true).With(FlagsSampled|vendor, false)
+	assert.Equal(t, FlagsRandom, tf)
+	assert.Equal(t, TraceFlags(0), tf.Reserved())
+	assert.True(t, TraceFlags(0).Has(0))
+}
+
+func TestTraceFlagsFromHex(t *testing.T) {
+	for _, testcase := range []struct {
+		hex     string
+		want    TraceFlags
+		wantErr error
+	}{
+		{hex: "00", want: 0},
+		{hex: "01", want: FlagsSampled},
+		{hex: "03", want: FlagsSampled | FlagsRandom},
+		{hex: "ff", want: 0xff},
+		{hex: "a5", want: 0xa5},
+		{hex: "A5", wantErr: errInvalidHexID},
+		{hex: "0g", wantErr: errInvalidHexID},
+		{hex: "1", wantErr: errInvalidTraceFlagsLength},
+		{hex: "001", wantErr: errInvalidTraceFlagsLength},
+	} {
+		t.Run(testcase.hex, func(t *testing.T) {
+			got, err := TraceFlagsFromHex(testcase.hex)
+			assert.ErrorIs(t, err, testcase.wantErr)
+			assert.Equal(t, testcase.want, got)
+			if err == nil {
+				assert.Equal(t, testcase.hex, got.String())
+			}
+		})
+	}
+}
+
 func TestStringTraceID(t *testing.T) {
 	for _, testcase := range []struct {
 		name string
@@ -621,6 +664,63 @@ func TestNewSpanContext(t *testing.T) {
 	}
 }
 
+func TestNewSpanContextStrict(t *testing.T) {
+	valid := SpanContextConfig{
+		TraceID:    TraceID([16]byte{1}),
+		SpanID:     SpanID([8]byte{42}),
+		TraceFlags: FlagsSampled | FlagsRandom,
+		TraceState: TraceState{list: []member{{"foo", "bar"}}},
+		Remote:     true,
+	}
+	sc, err := NewSpanContextStrict(valid)
+	assert.NoError(t, err)
+	assert.Equal(t, NewSpanContext(valid), sc)
+
+	testCases := []struct {
+		name    string
+		config  SpanContextConfig
+		wantErr []error
+		wantMsg string
+	}{
+		{
+			name:    "Empty",
+			config:  SpanContextConfig{},
+			wantErr: []error{errNilTraceID, errNilSpanID},
+		},
+		{
+			name: "ReservedFlags",
+			config: SpanContextConfig{
+				TraceID:    valid.TraceID,
+				SpanID:     valid.SpanID,
+				TraceFlags: 0x85,
+			},
+			wantErr: []error{errReservedTraceFlags},
+			wantMsg: "84",
+		},
+		{
+			name: "InvalidTraceState",
+			config: SpanContextConfig{
+				TraceID:    valid.TraceID,
+				SpanID:     valid.SpanID,
+				TraceState: TraceState{list: []member{{"Invalid Key", "bar"}}},
+			},
+			wantErr: []error{errInvalidMember},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sc, err := NewSpanContextStrict(tc.config)
+			assert.Equal(t, SpanContext{}, sc)
+			for _, want := range tc.wantErr {
+				assert.ErrorIs(t, err, want)
+			}
+			if tc.wantMsg != "" {
+				assert.ErrorContains(t, err, tc.wantMsg)
+			}
+		})
+	}
+}
+
 func TestSpanContextDerivation(t *testing.T) {
 	from := SpanContext{}
 	to := SpanContext{traceID: TraceID([16]byte{1})}
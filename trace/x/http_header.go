@@ -0,0 +1,185 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package x contains experimental trace options.
+package x
+
+import (
+	"net/textproto"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// HTTPHeaderRedacted is the value recorded in place of the values of a
+// redacted HTTP header.
+const HTTPHeaderRedacted = "REDACTED"
+
+// defaultRedactedHTTPHeaders are the HTTP headers redacted by an
+// HTTPHeaderCapture unless configured otherwise. They commonly contain
+// credentials.
+var defaultRedactedHTTPHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Proxy-Authorization",
+	"Set-Cookie",
+}
+
+// HTTPHeaderCapture converts selected HTTP request and response headers into
+// the "http.request.header.<key>" and "http.response.header.<key>" span
+// attributes defined by the semantic conventions, <key> being the lowercase
+// header name.
+//
+// The values of the redacted headers are replaced by HTTPHeaderRedacted. By
+// default, the Authorization, Cookie, Proxy-Authorization, and Set-Cookie
+// headers are redacted.
+//
+// An HTTPHeaderCapture is meant to be created once by HTTP instrumentation
+// and is safe for concurrent use.
+type HTTPHeaderCapture struct {
+	request  []capturedHeader
+	response []capturedHeader
+}
+
+// capturedHeader is an HTTP header captured by an HTTPHeaderCapture.
+type capturedHeader struct {
+	// name is the canonical name of the header.
+	name string
+	// key is the attribute key of the header values.
+	key      attribute.Key
+	redacted bool
+}
+
+// HTTPHeaderCaptureOption applies an option to an HTTPHeaderCapture.
+type HTTPHeaderCaptureOption interface {
+	applyHTTPHeaderCapture(httpHeaderCaptureConfig) httpHeaderCaptureConfig
+}
+
+type httpHeaderCaptureConfig struct {
+	request  []string
+	response []string
+	redacted []string
+}
+
+type httpHeaderCaptureOptionFunc func(httpHeaderCaptureConfig) httpHeaderCaptureConfig
+
+func (fn httpHeaderCaptureOptionFunc) applyHTTPHeaderCapture(cfg httpHeaderCaptureConfig) httpHeaderCaptureConfig {
+	return fn(cfg)
+}
+
+// NewHTTPHeaderCapture returns an HTTPHeaderCapture configured with opts. No
+// header is captured unless selected with WithHTTPRequestHeaders or
+// WithHTTPResponseHeaders.
+func NewHTTPHeaderCapture(opts ...HTTPHeaderCaptureOption) *HTTPHeaderCapture {
+	cfg := httpHeaderCaptureConfig{redacted: defaultRedactedHTTPHeaders}
+	for _, opt := range opts {
+		cfg = opt.applyHTTPHeaderCapture(cfg)
+	}
+
+	redacted := make(map[string]struct{}, len(cfg.redacted))
+	for _, name := range cfg.redacted {
+		redacted[textproto.CanonicalMIMEHeaderKey(name)] = struct{}{}
+	}
+	return &HTTPHeaderCapture{
+		request:  capturedHeaders("http.request.header.", cfg.request, redacted),
+		response: capturedHeaders("http.response.header.", cfg.response, redacted),
+	}
+}
+
+// capturedHeaders returns the deduplicated headers with the names captured
+// in attributes with keys starting with prefix.
+func capturedHeaders(prefix string, names []string, redacted map[string]struct{}) []capturedHeader {
+	var headers []capturedHeader
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		name = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		_, r := redacted[name]
+		headers = append(headers, capturedHeader{
+			name:     name,
+			key:      attribute.Key(prefix + strings.ToLower(name)),
+			redacted: r,
+		})
+	}
+	return headers
+}
+
+// WithHTTPRequestHeaders adds the HTTP request headers with names to capture.
+// The names are case-insensitive.
+func WithHTTPRequestHeaders(names ...string) HTTPHeaderCaptureOption {
+	return httpHeaderCaptureOptionFunc(func(cfg httpHeaderCaptureConfig) httpHeaderCaptureConfig {
+		cfg.request = append(cfg.request, names...)
+		return cfg
+	})
+}
+
+// WithHTTPResponseHeaders adds the HTTP response headers with names to
+// capture. The names are case-insensitive.
+func WithHTTPResponseHeaders(names ...string) HTTPHeaderCaptureOption {
+	return httpHeaderCaptureOptionFunc(func(cfg httpHeaderCaptureConfig) httpHeaderCaptureConfig {
+		cfg.response = append(cfg.response, names...)
+		return cfg
+	})
+}
+
+// WithHTTPRedactedHeaders sets the names of the HTTP headers whose values
+// are redacted, replacing the default ones. The names are case-insensitive.
+//
+// Calling it without names disables the redaction. Make sure the captured
+// headers do not contain credentials or other sensitive data in that case.
+func WithHTTPRedactedHeaders(names ...string) HTTPHeaderCaptureOption {
+	return httpHeaderCaptureOptionFunc(func(cfg httpHeaderCaptureConfig) httpHeaderCaptureConfig {
+		cfg.redacted = names
+		return cfg
+	})
+}
+
+// RequestAttributes returns the attributes of the captured headers present
+// in h, the headers of an HTTP request. h is expected to have canonical keys
+// as an http.Header does.
+func (c *HTTPHeaderCapture) RequestAttributes(h map[string][]string) []attribute.KeyValue {
+	if c == nil {
+		return nil
+	}
+	return headerAttributes(c.request, h)
+}
+
+// ResponseAttributes returns the attributes of the captured headers present
+// in h, the headers of an HTTP response. h is expected to have canonical keys
+// as an http.Header does.
+func (c *HTTPHeaderCapture) ResponseAttributes(h map[string][]string) []attribute.KeyValue {
+	if c == nil {
+		return nil
+	}
+	return headerAttributes(c.response, h)
+}
+
+func headerAttributes(headers []capturedHeader, h map[string][]string) []attribute.KeyValue {
+	if len(headers) == 0 || len(h) == 0 {
+		return nil
+	}
+
+	var attrs []attribute.KeyValue
+	for _, hdr := range headers {
+		vals, ok := h[hdr.name]
+		if !ok {
+			continue
+		}
+		if hdr.redacted {
+			redacted := make([]string, len(vals))
+			for i := range redacted {
+				redacted[i] = HTTPHeaderRedacted
+			}
+			vals = redacted
+		}
+		attrs = append(attrs, hdr.key.StringSlice(vals))
+	}
+	return attrs
+}
@@ -0,0 +1,111 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+)
+
+func TestHTTPHeaderCapture(t *testing.T) {
+	req := http.Header{}
+	req.Set("Content-Type", "application/json")
+	req.Add("X-Forwarded-For", "10.0.0.1")
+	req.Add("X-Forwarded-For", "10.0.0.2")
+	req.Set("Authorization", "Bearer secret")
+	req.Set("Cookie", "session=secret")
+
+	resp := http.Header{}
+	resp.Set("Content-Type", "text/plain")
+	resp.Set("Set-Cookie", "session=secret")
+
+	tests := []struct {
+		name     string
+		opts     []HTTPHeaderCaptureOption
+		wantReq  []attribute.KeyValue
+		wantResp []attribute.KeyValue
+	}{
+		{
+			name: "NoHeaders",
+		},
+		{
+			name: "Selected",
+			opts: []HTTPHeaderCaptureOption{
+				WithHTTPRequestHeaders("content-type", "X-FORWARDED-FOR", "X-Missing"),
+				WithHTTPResponseHeaders("Content-Type"),
+			},
+			wantReq: []attribute.KeyValue{
+				attribute.StringSlice("http.request.header.content-type", []string{"application/json"}),
+				attribute.StringSlice("http.request.header.x-forwarded-for", []string{"10.0.0.1", "10.0.0.2"}),
+			},
+			wantResp: []attribute.KeyValue{
+				attribute.StringSlice("http.response.header.content-type", []string{"text/plain"}),
+			},
+		},
+		{
+			name: "Duplicates",
+			opts: []HTTPHeaderCaptureOption{
+				WithHTTPRequestHeaders("Content-Type", " content-type ", ""),
+				WithHTTPRequestHeaders("CONTENT-TYPE"),
+			},
+			wantReq: []attribute.KeyValue{
+				attribute.StringSlice("http.request.header.content-type", []string{"application/json"}),
+			},
+		},
+		{
+			name: "DefaultRedaction",
+			opts: []HTTPHeaderCaptureOption{
+				WithHTTPRequestHeaders("Authorization", "Cookie"),
+				WithHTTPResponseHeaders("Set-Cookie"),
+			},
+			wantReq: []attribute.KeyValue{
+				attribute.StringSlice("http.request.header.authorization", []string{HTTPHeaderRedacted}),
+				attribute.StringSlice("http.request.header.cookie", []string{HTTPHeaderRedacted}),
+			},
+			wantResp: []attribute.KeyValue{
+				attribute.StringSlice("http.response.header.set-cookie", []string{HTTPHeaderRedacted}),
+			},
+		},
+		{
+			name: "CustomRedaction",
+			opts: []HTTPHeaderCaptureOption{
+				WithHTTPRequestHeaders("Authorization", "X-Forwarded-For"),
+				WithHTTPRedactedHeaders("x-forwarded-for"),
+			},
+			wantReq: []attribute.KeyValue{
+				attribute.StringSlice("http.request.header.authorization", []string{"Bearer secret"}),
+				attribute.StringSlice("http.request.header.x-forwarded-for", []string{HTTPHeaderRedacted, HTTPHeaderRedacted}),
+			},
+		},
+		{
+			name: "NoRedaction",
+			opts: []HTTPHeaderCaptureOption{
+				WithHTTPRequestHeaders("Cookie"),
+				WithHTTPRedactedHeaders(),
+			},
+			wantReq: []attribute.KeyValue{
+				attribute.StringSlice("http.request.header.cookie", []string{"session=secret"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewHTTPHeaderCapture(tt.opts...)
+			assert.Equal(t, tt.wantReq, c.RequestAttributes(req))
+			assert.Equal(t, tt.wantResp, c.ResponseAttributes(resp))
+		})
+	}
+}
+
+func TestHTTPHeaderCaptureNil(t *testing.T) {
+	var c *HTTPHeaderCapture
+	h := http.Header{"Content-Type": {"text/plain"}}
+	assert.Nil(t, c.RequestAttributes(h))
+	assert.Nil(t, c.ResponseAttributes(h))
+}
@@ -0,0 +1,53 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// LinkSampledKey is the attribute Key describing whether the span context a
+// Link refers to was sampled.
+//
+// It is not defined by the semantic conventions.
+const LinkSampledKey = attribute.Key("link.sampled")
+
+// SampledLinkFromContext returns a link encapsulating the SpanContext in the
+// provided ctx, as trace.LinkFromContext does. The link attributes contain
+// the LinkSampledKey attribute set to whether the SpanContext was sampled,
+// followed by attrs.
+//
+// This is useful to correlate spans with the spans of messages they process
+// when the linked spans may not have been recorded, e.g. for batch consumers.
+func SampledLinkFromContext(ctx context.Context, attrs ...attribute.KeyValue) trace.Link {
+	sc := trace.SpanContextFromContext(ctx)
+	a := make([]attribute.KeyValue, 0, len(attrs)+1)
+	a = append(a, LinkSampledKey.Bool(sc.IsSampled()))
+	a = append(a, attrs...)
+	return trace.Link{SpanContext: sc, Attributes: a}
+}
+
+// AddLinks adds links to span after it was started.
+//
+// If span implements an AddLinks(...trace.Link) method, it is used to add all
+// the links at once. Otherwise, the links are added one at a time with the
+// AddLink method of span. The links are subject to the limits of span, the
+// same as links added with AddLink. If span is nil or not recording, nothing
+// is added.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get the links added at once.
+func AddLinks(span trace.Span, links ...trace.Link) {
+	if span == nil || len(links) == 0 || !span.IsRecording() {
+		return
+	}
+	if s, ok := span.(interface{ AddLinks(...trace.Link) }); ok {
+		s.AddLinks(links...)
+		return
+	}
+	for _, l := range links {
+		span.AddLink(l)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestSampledLinkFromContext(t *testing.T) {
+	k1v1 := attribute.String("key1", "value1")
+	for _, sampled := range []bool{true, false} {
+		cfg := trace.SpanContextConfig{
+			TraceID: trace.TraceID{1},
+			SpanID:  trace.SpanID{1},
+			Remote:  true,
+		}
+		if sampled {
+			cfg.TraceFlags = trace.FlagsSampled
+		}
+		spanCtx := trace.NewSpanContext(cfg)
+
+		ctx := trace.ContextWithRemoteSpanContext(t.Context(), spanCtx)
+		link := SampledLinkFromContext(ctx, k1v1)
+
+		assert.True(t, spanCtx.Equal(link.SpanContext), "span context")
+		assert.Equal(t, []attribute.KeyValue{LinkSampledKey.Bool(sampled), k1v1}, link.Attributes)
+	}
+}
+
+type linksSpan struct {
+	noop.Span
+
+	recording bool
+	links     []trace.Link
+	batches   int
+}
+
+func (s *linksSpan) IsRecording() bool { return s.recording }
+
+func (s *linksSpan) AddLink(l trace.Link) { s.links = append(s.links, l) }
+
+type batchLinksSpan struct {
+	linksSpan
+}
+
+func (s *batchLinksSpan) AddLinks(l ...trace.Link) {
+	s.batches++
+	s.links = append(s.links, l...)
+}
+
+func TestAddLinks(t *testing.T) {
+	links := []trace.Link{{Attributes: []attribute.KeyValue{attribute.Int("n", 1)}}, {}}
+
+	s := &linksSpan{recording: true}
+	AddLinks(s, links...)
+	assert.Equal(t, links, s.links, "AddLink")
+
+	bs := &batchLinksSpan{linksSpan{recording: true}}
+	AddLinks(bs, links...)
+	assert.Equal(t, links, bs.links, "AddLinks")
+	assert.Equal(t, 1, bs.batches, "AddLinks calls")
+
+	nr := &linksSpan{}
+	AddLinks(nr, links...)
+	assert.Empty(t, nr.links, "non-recording span")
+
+	assert.NotPanics(t, func() { AddLinks(nil, links...) })
+}
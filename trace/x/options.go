@@ -0,0 +1,142 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type defaultSpanAttributesOption struct {
+	trace.TracerOption
+	attrs []attribute.KeyValue
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (defaultSpanAttributesOption) Experimental() {}
+
+// DefaultSpanAttributes returns the attributes of the option.
+func (o defaultSpanAttributesOption) DefaultSpanAttributes() []attribute.KeyValue {
+	return o.attrs
+}
+
+// WithDefaultSpanAttributes returns a trace.TracerOption that specifies
+// attributes the implementation should add to all the spans started by the
+// Tracer, as if they were passed with trace.WithAttributes before the
+// options passed to Start. This lets instrumentation wrappers set constant
+// attributes, e.g. the name of the wrapped component, without passing them
+// to every Start call.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get them added before the
+// attributes passed to Start, which take precedence for duplicate keys.
+//
+// If the option is passed multiple times, the attributes are appended in the
+// order they are passed.
+func WithDefaultSpanAttributes(attrs ...attribute.KeyValue) trace.TracerOption {
+	return defaultSpanAttributesOption{attrs: slices.Clone(attrs)}
+}
+
+type defaultSpanKindOption struct {
+	trace.TracerOption
+	kind trace.SpanKind
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (defaultSpanKindOption) Experimental() {}
+
+// DefaultSpanKind returns the SpanKind of the option.
+func (o defaultSpanKindOption) DefaultSpanKind() trace.SpanKind {
+	return o.kind
+}
+
+// WithDefaultSpanKind returns a trace.TracerOption that specifies the
+// SpanKind the implementation should use for the spans started by the Tracer
+// without a trace.WithSpanKind option.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get it used for these spans.
+//
+// If the option is passed multiple times, the last SpanKind passed is used.
+func WithDefaultSpanKind(kind trace.SpanKind) trace.TracerOption {
+	return defaultSpanKindOption{kind: kind}
+}
+
+type startStackTraceOption struct {
+	trace.SpanStartOption
+	enabled bool
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (startStackTraceOption) Experimental() {}
+
+// StartStackTrace reports whether the option requests the call stack to be
+// recorded.
+func (o startStackTraceOption) StartStackTrace() bool {
+	return o.enabled
+}
+
+// WithStartStackTrace returns a trace.SpanStartOption that sets whether the
+// call stack where the Span is started is recorded with the Span, e.g. to
+// find where unexpected spans originate in a large codebase. Capturing a
+// stack trace is expensive, implementations can limit the rate of the
+// captures or ignore the option.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get it recorded in the
+// "code.stacktrace" attribute, at the rate limited by the WithStartStackTrace
+// TracerProviderOption of that package.
+//
+// If the option is passed multiple times, the last value passed is used.
+func WithStartStackTrace(b bool) trace.SpanStartOption {
+	return startStackTraceOption{enabled: b}
+}
+
+type eventCapacityOption struct {
+	trace.SpanStartOption
+	n int
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (eventCapacityOption) Experimental() {}
+
+// EventCapacity returns the number of events of the option.
+func (o eventCapacityOption) EventCapacity() int {
+	return o.n
+}
+
+// WithEventCapacity returns a trace.SpanStartOption that hints the number of
+// events a Span is expected to record. Implementations can use it to allocate
+// the storage for the events once when the Span is started instead of growing
+// it as events are added. It does not limit the number of events a Span can
+// record.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get the storage allocated,
+// bounded by the EventCountLimit of the SpanLimits.
+//
+// If the option is passed multiple times, the last number passed is used.
+func WithEventCapacity(n int) trace.SpanStartOption {
+	return eventCapacityOption{n: n}
+}
+
+type linkCapacityOption struct {
+	trace.SpanStartOption
+	n int
+}
+
+// Experimental prevents the API from panicking when the option is used.
+func (linkCapacityOption) Experimental() {}
+
+// LinkCapacity returns the number of links of the option.
+func (o linkCapacityOption) LinkCapacity() int {
+	return o.n
+}
+
+// WithLinkCapacity returns a trace.SpanStartOption that hints the number of
+// links a Span is expected to record. Implementations can use it to allocate
+// the storage for the links once when the Span is started instead of growing
+// it as links are added. It does not limit the number of links a Span can
+// record.
+// Users of [go.opentelemetry.io/otel/sdk/trace] get the storage allocated,
+// bounded by the LinkCountLimit of the SpanLimits.
+//
+// If the option is passed multiple times, the last number passed is used.
+func WithLinkCapacity(n int) trace.SpanStartOption {
+	return linkCapacityOption{n: n}
+}
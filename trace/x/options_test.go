@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestWithDefaultSpanAttributes(t *testing.T) {
+	a := attribute.String("a", "1")
+	attrs := []attribute.KeyValue{a}
+	opt := WithDefaultSpanAttributes(attrs...)
+	// The attributes are copied.
+	attrs[0] = attribute.String("b", "2")
+
+	o, ok := opt.(interface{ DefaultSpanAttributes() []attribute.KeyValue })
+	require.True(t, ok, "expected DefaultSpanAttributes method")
+	assert.Equal(t, []attribute.KeyValue{a}, o.DefaultSpanAttributes())
+
+	// The API ignores the experimental option.
+	assert.NotPanics(t, func() { _ = trace.NewTracerConfig(opt) })
+}
+
+func TestWithDefaultSpanKind(t *testing.T) {
+	opt := WithDefaultSpanKind(trace.SpanKindClient)
+
+	o, ok := opt.(interface{ DefaultSpanKind() trace.SpanKind })
+	require.True(t, ok, "expected DefaultSpanKind method")
+	assert.Equal(t, trace.SpanKindClient, o.DefaultSpanKind())
+
+	assert.NotPanics(t, func() { _ = trace.NewTracerConfig(opt) })
+}
+
+func TestWithStartStackTrace(t *testing.T) {
+	opt := WithStartStackTrace(true)
+
+	o, ok := opt.(interface{ StartStackTrace() bool })
+	require.True(t, ok, "expected StartStackTrace method")
+	assert.True(t, o.StartStackTrace())
+
+	assert.NotPanics(t, func() { _ = trace.NewSpanStartConfig(opt) })
+}
+
+func TestWithEventCapacity(t *testing.T) {
+	opt := WithEventCapacity(16)
+
+	o, ok := opt.(interface{ EventCapacity() int })
+	require.True(t, ok, "expected EventCapacity method")
+	assert.Equal(t, 16, o.EventCapacity())
+
+	assert.NotPanics(t, func() { _ = trace.NewSpanStartConfig(opt) })
+}
+
+func TestWithLinkCapacity(t *testing.T) {
+	opt := WithLinkCapacity(4)
+
+	o, ok := opt.(interface{ LinkCapacity() int })
+	require.True(t, ok, "expected LinkCapacity method")
+	assert.Equal(t, 4, o.LinkCapacity())
+
+	assert.NotPanics(t, func() { _ = trace.NewSpanStartConfig(opt) })
+}
@@ -30,3 +30,21 @@ func TestMultipleGlobalTracerProvider(t *testing.T) {
 	got := GetTracerProvider()
 	assert.Equal(t, p2, got)
 }
+
+type nameRecordingTracerProvider struct {
+	embedded.TracerProvider
+
+	name string
+}
+
+func (p *nameRecordingTracerProvider) Tracer(name string, _ ...trace.TracerOption) trace.Tracer {
+	p.name = name
+	return noop.NewTracerProvider().Tracer(name)
+}
+
+func TestTracerAuto(t *testing.T) {
+	p := &nameRecordingTracerProvider{}
+	SetTracerProvider(p)
+	_ = TracerAuto()
+	assert.Equal(t, "go.opentelemetry.io/otel", p.name)
+}